@@ -6,23 +6,45 @@ import (
 	api_neutrino "github.com/pkt-cash/pktd/apiv1/neutrino"
 	"github.com/pkt-cash/pktd/apiv1/util"
 	api_wallet "github.com/pkt-cash/pktd/apiv1/wallet"
+	"github.com/pkt-cash/pktd/apiv1/wallets"
 	"github.com/pkt-cash/pktd/btcutil/util/mailbox"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
 	"github.com/pkt-cash/pktd/neutrino"
 	"github.com/pkt-cash/pktd/pktwallet/wallet"
 )
 
+// WalletCategory defines the wallet category, this must be done before the wallet
+// is opened because the wallet registers some of its endpoints by itself.
+func WalletCategory(a *apiv1.Apiv1) *apiv1.Apiv1 {
+	return apiv1.DefineCategory(a, "wallet", "APIs for management of on-chain (non-Lightning) payments")
+}
+
 func Register(
 	a *apiv1.Apiv1,
+	walletCat *apiv1.Apiv1,
 	w *wallet.Wallet,
 	neutrinoCS *neutrino.ChainService,
 	startLightning *mailbox.Mailbox[*lightning.StartLightning],
+	walletsConf wallets.Config,
 ) {
 	api_wallet.Register(
-		apiv1.DefineCategory(a, "wallet", "APIs for management of on-chain (non-Lightning) payments"),
+		walletCat,
 		w,
 		startLightning,
 	)
+	wallets.Register(
+		apiv1.DefineCategory(a, "wallets",
+			`
+			Load, create and unload named wallets
+
+			Several wallets can be used at the same time, they all share the same chain
+			backend. The endpoints of each named wallet are under /wallet/<name>/.
+			`),
+		walletCat,
+		w,
+		neutrinoCS,
+		walletsConf,
+	)
 	lightning.Register(
 		apiv1.DefineCategory(a, "lightning", "The Lightning daemon component"),
 		w,
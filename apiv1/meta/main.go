@@ -234,4 +234,8 @@ func Register(
 		`,
 		r.version,
 	)
+	apiv1.RegisterTokenEndpoints(
+		apiv1.DefineCategory(a, "token",
+			"Management of the tokens which are used to authenticate to the REST API"),
+	)
 }
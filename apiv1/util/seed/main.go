@@ -37,13 +37,17 @@ func create(in *walletunlocker_pb.GenSeedRequest) (*walletunlocker_pb.GenSeedRes
 	}
 	encipheredSeed := cipherSeed.Encrypt(seedPassphrase)
 
-	mnemonic, err := encipheredSeed.Words("english")
+	lang := in.Language
+	if lang == "" {
+		lang = seedwords.DefaultLanguage
+	}
+	mnemonic, err := encipheredSeed.Words(lang)
 	if err != nil {
 		return nil, err
 	}
 
 	return &walletunlocker_pb.GenSeedResponse{
-		Seed: strings.Split(mnemonic, " "),
+		Seed: strings.Fields(mnemonic),
 	}, nil
 }
 
@@ -69,7 +73,7 @@ func changepassphrase(
 		return nil, er.New("Current seed is required in the request")
 	}
 
-	currentSeedCiphered, err := seedwords.SeedFromWords(mnemonic)
+	currentSeedCiphered, lang, err := seedwords.SeedFromWordsLang(mnemonic, req.Language)
 	if err != nil {
 		return nil, err
 	}
@@ -93,13 +97,53 @@ func changepassphrase(
 	newCipheredSeed := currentSeed.Encrypt(newSeedCipherPass)
 
 	//	get the mnemonic for the new ciphered seed
-	mnemonic, err = newCipheredSeed.Words("english")
+	mnemonic, err = newCipheredSeed.Words(lang)
 	if err != nil {
 		return nil, err
 	}
 
 	return &rpc_pb.ChangeSeedPassphraseResponse{
-		Seed: strings.Split(mnemonic, " "),
+		Seed: strings.Fields(mnemonic),
+	}, nil
+}
+
+func split(req *rpc_pb.SplitSeedRequest) (*rpc_pb.SplitSeedResponse, er.R) {
+	seedEnc, lang, err := seedwords.SeedFromWordsLang(strings.Join(req.Seed, " "), "")
+	if err != nil {
+		return nil, err
+	}
+	defer seedEnc.Zero()
+	if req.Language != "" {
+		lang = req.Language
+	}
+	shares, err := seedEnc.Split(int(req.Threshold), int(req.Shares), lang)
+	if err != nil {
+		return nil, err
+	}
+	out := &rpc_pb.SplitSeedResponse{}
+	for _, s := range shares {
+		out.Shares = append(out.Shares, &rpc_pb.SeedShare{Words: strings.Fields(s)})
+	}
+	return out, nil
+}
+
+func combine(req *rpc_pb.CombineSeedRequest) (*rpc_pb.CombineSeedResponse, er.R) {
+	shares := make([]string, 0, len(req.Shares))
+	for _, s := range req.Shares {
+		shares = append(shares, strings.Join(s.Words, " "))
+	}
+	seedEnc, lang, err := seedwords.SeedFromShares(shares)
+	if err != nil {
+		return nil, err
+	}
+	defer seedEnc.Zero()
+	mnemonic, err := seedEnc.Words(lang)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc_pb.CombineSeedResponse{
+		Seed:            strings.Fields(mnemonic),
+		NeedsPassphrase: seedEnc.NeedsPassphrase(),
 	}, nil
 }
 
@@ -115,6 +159,8 @@ func Register(
 
 		This allows you to statelessly create a new wallet seed.
 		This seed can then be used to initialize a wallet.
+		The seed words may be in any of the BIP-39 languages, the
+		language is detected automatically when the seed is used.
 		`,
 		create,
 	)
@@ -129,4 +175,28 @@ func Register(
 		`,
 		changepassphrase,
 	)
+	apiv1.Endpoint(
+		a,
+		"split",
+		`
+		Split a seed into shares, some number of which recover it
+
+		Each share is 20 words, any threshold of the shares can be
+		combined to recover the seed but fewer reveal nothing about it.
+		The seed remains encrypted with its passphrase, so the passphrase
+		is still needed to use the recovered seed.
+		`,
+		split,
+	)
+	apiv1.Endpoint(
+		a,
+		"combine",
+		`
+		Recover a seed from the shares made by split
+
+		The shares may be given in any order, the language is detected
+		automatically.
+		`,
+		combine,
+	)
 }
@@ -73,6 +73,33 @@ func (r *rpc) _import(req *rpc_pb.ImportPrivKeyRequest) (*rpc_pb.ImportPrivKeyRe
 	}, err
 }
 
+func (r *rpc) importxpub(req *rpc_pb.ImportXpubRequest) (*rpc_pb.ImportXpubResponse, er.R) {
+	// All of the wallet's key scopes use coin type 0, the purpose is
+	// inferred from the key if it is not specified.
+	scope := waddrmgr.KeyScope{Purpose: req.Purpose}
+	props, addrs, err := r.w.ImportAccountPubKey(
+		scope, req.AccountName, req.ExtendedPubKey, req.GapLimit, nil, req.Rescan,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpc_pb.ImportXpubResponse{
+		AccountName:   props.AccountName,
+		AccountNumber: props.AccountNumber,
+		KeyScope:      props.KeyScope.String(),
+	}
+	// Receive addresses are derived first, followed by the same number of
+	// change addresses.
+	for i, addr := range addrs {
+		if i < len(addrs)/2 {
+			resp.Addresses = append(resp.Addresses, addr.EncodeAddress())
+		} else {
+			resp.ChangeAddresses = append(resp.ChangeAddresses, addr.EncodeAddress())
+		}
+	}
+	return resp, nil
+}
+
 func (r *rpc) balances(
 	in *rpc_pb.GetAddressBalancesRequest,
 ) (*rpc_pb.GetAddressBalancesResponse, er.R) {
@@ -246,6 +273,22 @@ func Register(
 		`,
 		r._import,
 	)
+	apiv1.Endpoint(
+		a,
+		"importxpub",
+		`
+		Imports an extended public key as a watch-only account
+
+		Creates a watch-only account from an account level extended public key
+		(xpub, ypub or zpub), for example one exported from a hardware wallet or
+		an offline signer. The wallet derives and watches the account's addresses
+		so it can track the balance and history, and the account's coins can be
+		used to fund PSBTs with wallet/psbt/fund, but the wallet cannot sign for
+		them. Coins of watch-only accounts are never selected when the wallet
+		creates a transaction which it signs itself.
+		`,
+		r.importxpub,
+	)
 	apiv1.Endpoint(
 		a,
 		"signmessage",
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkt-cash/pktd/apiv1/lightning"
 	"github.com/pkt-cash/pktd/apiv1/wallet/address"
+	"github.com/pkt-cash/pktd/apiv1/wallet/psbt"
 	"github.com/pkt-cash/pktd/apiv1/wallet/transaction"
 	"github.com/pkt-cash/pktd/apiv1/wallet/unspent"
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -216,6 +217,19 @@ func Register(
 			"Create and manage on-chain transactions with the wallet"),
 		w,
 	)
+	psbt.Register(
+		apiv1.DefineCategory(walletCat, "psbt",
+			`
+			Partially Signed Bitcoin Transactions (BIP174)
+
+			PSBTs make it possible to build a transaction in several steps, for example
+			funding it from a watch-only wallet, signing it with a hardware wallet or
+			with several parties each signing their own inputs, and then combining and
+			finalizing it before it is published.
+			`,
+		),
+		w,
+	)
 	unspent.Register(
 		apiv1.DefineCategory(walletCat, "unspent",
 			"Detected unspent transactions associated with one of our wallet addresses"),
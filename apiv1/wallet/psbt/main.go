@@ -0,0 +1,413 @@
+package psbt
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/psbt"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/lnd/describetxn"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
+	"github.com/pkt-cash/pktd/pktwallet/wallet"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+type rpc struct {
+	w *wallet.Wallet
+}
+
+func parsePsbt(b []byte) (*psbt.Packet, er.R) {
+	if len(b) == 0 {
+		return nil, er.New("psbt is required")
+	}
+	p, err := psbt.NewFromRawBytes(bytes.NewReader(b), false)
+	if err != nil {
+		return nil, er.Errorf("could not parse PSBT: %v", err)
+	}
+	return p, nil
+}
+
+func serializePsbt(p *psbt.Packet) ([]byte, er.R) {
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		return nil, er.Errorf("error serializing PSBT: %v", err)
+	}
+	return b.Bytes(), nil
+}
+
+func parseOutPoint(op *rpc_pb.OutPoint) (*wire.OutPoint, er.R) {
+	var hash *chainhash.Hash
+	var err er.R
+	if len(op.TxidBytes) > 0 {
+		hash, err = chainhash.NewHash(op.TxidBytes)
+	} else {
+		hash, err = chainhash.NewHashFromStr(op.TxidStr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return wire.NewOutPoint(hash, op.OutputIndex), nil
+}
+
+// template creates the PSBT which is to be funded, either from the one which
+// was passed in or from the outputs and inputs.
+func (r *rpc) template(req *rpc_pb.PsbtFundRequest) (*psbt.Packet, er.R) {
+	if len(req.Psbt) > 0 {
+		if len(req.Outputs) > 0 || len(req.Inputs) > 0 {
+			return nil, er.New("outputs and inputs cannot be used together with psbt")
+		}
+		return parsePsbt(req.Psbt)
+	}
+	if len(req.Outputs) == 0 {
+		return nil, er.New("either psbt or outputs is required")
+	}
+
+	// Map order is random, the addresses are sorted so that the same request
+	// always makes the same PSBT.
+	addrStrs := make([]string, 0, len(req.Outputs))
+	for addrStr := range req.Outputs {
+		addrStrs = append(addrStrs, addrStr)
+	}
+	sort.Strings(addrStrs)
+
+	txOut := make([]*wire.TxOut, 0, len(req.Outputs))
+	for _, addrStr := range addrStrs {
+		amt := req.Outputs[addrStr]
+		addr, err := btcutil.DecodeAddress(addrStr, r.w.ChainParams())
+		if err != nil {
+			return nil, er.Errorf("error parsing address [%s]: %v", addrStr, err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		txOut = append(txOut, wire.NewTxOut(int64(amt), pkScript))
+	}
+
+	txIn := make([]*wire.OutPoint, 0, len(req.Inputs))
+	sequences := make([]uint32, 0, len(req.Inputs))
+	for _, in := range req.Inputs {
+		op, err := parseOutPoint(in)
+		if err != nil {
+			return nil, er.Errorf("error parsing outpoint: %v", err)
+		}
+		txIn = append(txIn, op)
+		sequences = append(sequences, constants.MaxTxInSequenceNum)
+	}
+	return psbt.New(txIn, txOut, 2, 0, sequences)
+}
+
+func (r *rpc) fund(req *rpc_pb.PsbtFundRequest) (*rpc_pb.PsbtFundResponse, er.R) {
+	packet, err := r.template(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, in := range packet.UnsignedTx.TxIn {
+		if r.w.LockedOutpoint(in.PreviousOutPoint) {
+			return nil, er.Errorf("input [%s] is locked", in.PreviousOutPoint.String())
+		}
+	}
+
+	feePerKb := txrules.DefaultRelayFeePerKb
+	if req.FeePerKb > 0 {
+		feePerKb = btcutil.Amount(req.FeePerKb)
+	}
+	var fromAddrs []btcutil.Address
+	for _, a := range req.FromAddress {
+		addr, err := btcutil.DecodeAddress(a, r.w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		fromAddrs = append(fromAddrs, addr)
+	}
+	changeIndex, err := r.w.FundPsbtFrom(packet, 0, fromAddrs, feePerKb, req.Autolock)
+	if err != nil {
+		return nil, er.Errorf("wallet couldn't fund PSBT: %v", err)
+	}
+	b, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make([]*rpc_pb.OutPoint, 0, len(packet.UnsignedTx.TxIn))
+	for _, in := range packet.UnsignedTx.TxIn {
+		op := in.PreviousOutPoint
+		inputs = append(inputs, &rpc_pb.OutPoint{
+			TxidBytes:   op.Hash[:],
+			TxidStr:     op.Hash.String(),
+			OutputIndex: op.Index,
+		})
+	}
+
+	return &rpc_pb.PsbtFundResponse{
+		Psbt:              b,
+		ChangeOutputIndex: changeIndex,
+		Inputs:            inputs,
+	}, nil
+}
+
+func (r *rpc) sign(req *rpc_pb.PsbtRequest) (*rpc_pb.PsbtSignResponse, er.R) {
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := r.w.SignPsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+	b, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc_pb.PsbtSignResponse{
+		Psbt:         b,
+		SignedInputs: signed,
+	}, nil
+}
+
+func (r *rpc) finalize(req *rpc_pb.PsbtFinalizeRequest) (*rpc_pb.PsbtFinalizeResponse, er.R) {
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	if req.NoSign {
+		err = psbt.MaybeFinalizeAll(packet)
+	} else {
+		err = r.w.FinalizePsbt(packet)
+	}
+	if err != nil {
+		return nil, er.Errorf("error finalizing PSBT: %v", err)
+	}
+	b, err := serializePsbt(packet)
+	if err != nil {
+		return nil, err
+	}
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, er.Errorf("error extracting final transaction: %v", err)
+	}
+	var raw bytes.Buffer
+	if err := finalTx.Serialize(&raw); err != nil {
+		return nil, err
+	}
+	return &rpc_pb.PsbtFinalizeResponse{
+		Psbt:       b,
+		RawFinalTx: raw.Bytes(),
+		Txid:       finalTx.TxHash().String(),
+	}, nil
+}
+
+func (r *rpc) combine(req *rpc_pb.PsbtCombineRequest) (*rpc_pb.PsbtCombineResponse, er.R) {
+	packets := make([]*psbt.Packet, 0, len(req.Psbts))
+	for _, b := range req.Psbts {
+		p, err := parsePsbt(b)
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, p)
+	}
+	combined, err := psbt.Combine(packets...)
+	if err != nil {
+		return nil, err
+	}
+	b, err := serializePsbt(combined)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc_pb.PsbtCombineResponse{Psbt: b}, nil
+}
+
+// prevTxGetter supplies previous transactions to describetxn, first from the
+// PSBT itself and then from the wallet.
+func (r *rpc) prevTxGetter(p *psbt.Packet) func(map[string]*wire.MsgTx) er.R {
+	return func(txns map[string]*wire.MsgTx) er.R {
+		for _, in := range p.Inputs {
+			if in.NonWitnessUtxo != nil {
+				h := in.NonWitnessUtxo.TxHash().String()
+				if _, ok := txns[h]; ok {
+					txns[h] = in.NonWitnessUtxo
+				}
+			}
+		}
+		for k, v := range txns {
+			if v != nil {
+				continue
+			}
+			hash, err := chainhash.NewHashFromStr(k)
+			if err != nil {
+				return err
+			}
+			details, err := wallet.UnstableAPI(r.w).TxDetails(hash)
+			if err != nil {
+				return err
+			} else if details != nil {
+				txns[k] = &details.MsgTx
+			}
+		}
+		return nil
+	}
+}
+
+func bip32Derivations(in []*psbt.Bip32Derivation) []*rpc_pb.PsbtBip32Derivation {
+	out := make([]*rpc_pb.PsbtBip32Derivation, 0, len(in))
+	for _, d := range in {
+		out = append(out, &rpc_pb.PsbtBip32Derivation{
+			Pubkey:               d.PubKey,
+			MasterKeyFingerprint: d.MasterKeyFingerprint,
+			Path:                 d.Bip32Path,
+		})
+	}
+	return out
+}
+
+func (r *rpc) decode(req *rpc_pb.PsbtRequest) (*rpc_pb.PsbtDecodeResponse, er.R) {
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	params := r.w.ChainParams()
+	txi, err := describetxn.Describe(r.prevTxGetter(packet), *packet.UnsignedTx, params, true)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &rpc_pb.PsbtDecodeResponse{Tx: txi, Sfee: "unknown"}
+	for i, in := range packet.Inputs {
+		prevOut := packet.UnsignedTx.TxIn[i].PreviousOutPoint
+		pi := &rpc_pb.PsbtInput{
+			HasFullTx:       in.NonWitnessUtxo != nil,
+			SighashType:     uint32(in.SighashType),
+			RedeemScript:    in.RedeemScript,
+			WitnessScript:   in.WitnessScript,
+			Bip32Derivation: bip32Derivations(in.Bip32Derivation),
+			Final:           len(in.FinalScriptSig) > 0 || len(in.FinalScriptWitness) > 0,
+		}
+		if in.WitnessUtxo != nil {
+			pi.Utxo = describetxn.DescribeOutput(in.WitnessUtxo, prevOut.Index, params)
+		} else if in.NonWitnessUtxo != nil && int(prevOut.Index) < len(in.NonWitnessUtxo.TxOut) {
+			pi.Utxo = describetxn.DescribeOutput(
+				in.NonWitnessUtxo.TxOut[prevOut.Index], prevOut.Index, params)
+		}
+		for _, ps := range in.PartialSigs {
+			pi.PartialSigPubkeys = append(pi.PartialSigPubkeys, ps.PubKey)
+		}
+		out.Inputs = append(out.Inputs, pi)
+	}
+	for _, o := range packet.Outputs {
+		out.Outputs = append(out.Outputs, &rpc_pb.PsbtOutput{
+			RedeemScript:    o.RedeemScript,
+			WitnessScript:   o.WitnessScript,
+			Bip32Derivation: bip32Derivations(o.Bip32Derivation),
+		})
+	}
+
+	if inputSum, err := psbt.SumUtxoInputValues(packet); err == nil {
+		fee := inputSum
+		for _, txOut := range packet.UnsignedTx.TxOut {
+			fee -= txOut.Value
+		}
+		out.Sfee = strconv.FormatInt(fee, 10)
+	}
+	return out, nil
+}
+
+func (r *rpc) analyze(req *rpc_pb.PsbtRequest) (*rpc_pb.PsbtAnalyzeResponse, er.R) {
+	packet, err := parsePsbt(req.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	return r.w.AnalyzePsbt(packet)
+}
+
+func Register(a *apiv1.Apiv1, w *wallet.Wallet) {
+	r := rpc{w: w}
+	apiv1.Endpoint(
+		a,
+		"fund",
+		`
+		Fund a PSBT with inputs from the wallet
+
+		Creates a PSBT (Partially Signed Bitcoin Transaction) which pays the requested
+		outputs, either from a PSBT template or from a map of addresses and amounts.
+		If no inputs are given then the wallet selects coins, coins of watch-only
+		accounts are only selected if their addresses are given in from_address.
+		Otherwise the given inputs must belong to the wallet, watch-only addresses
+		included, and be sufficient to pay the outputs and the fee. A change output
+		is added if necessary.
+		The inputs are not signed, use /wallet/psbt/sign or an external signer. Use the
+		autolock field to prevent the inputs from being spent by other transactions in
+		the meantime.
+		`,
+		r.fund,
+	)
+	apiv1.Endpoint(
+		a,
+		"sign",
+		`
+		Add this wallet's signatures to a PSBT
+
+		Adds a partial signature to every input which spends a coin that this wallet
+		holds the private key for, filling in the previous output if it is missing.
+		Inputs belonging to other parties and watch-only inputs are left as they are,
+		so the resulting PSBT can be passed on to other signers and then combined
+		with /wallet/psbt/combine.
+		`,
+		r.sign,
+	)
+	apiv1.Endpoint(
+		a,
+		"finalize",
+		`
+		Finalize a PSBT and extract the transaction
+
+		Signs any remaining inputs which belong to the wallet, unless no_sign is set,
+		then finalizes every input and extracts the final transaction which can be
+		published with /wallet/transaction/publish. This fails if any input is
+		missing signatures from another party.
+		`,
+		r.finalize,
+	)
+	apiv1.Endpoint(
+		a,
+		"combine",
+		`
+		Combine several PSBTs of the same transaction
+
+		Merges PSBTs which were each updated or signed by a different participant
+		into a single PSBT containing all of their signatures and data.
+		`,
+		r.combine,
+	)
+	apiv1.Endpoint(
+		a,
+		"decode",
+		`
+		Decode a PSBT
+
+		Returns a description of the unsigned transaction and the data, such as
+		previous outputs, partial signatures and scripts, which is attached to
+		each input and output.
+		`,
+		r.decode,
+	)
+	apiv1.Endpoint(
+		a,
+		"analyze",
+		`
+		Analyze the state of a PSBT
+
+		Reports, for each input, whether the previous output is known, whether it
+		belongs to this wallet and whether it is finalized, along with the role
+		(updater, signer, finalizer or extractor) which must act next. Also estimates
+		the size and fee rate of the final transaction.
+		`,
+		r.analyze,
+	)
+}
@@ -2,6 +2,9 @@ package transaction
 
 import (
 	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
 
 	"github.com/pkt-cash/pktd/btcjson"
 	"github.com/pkt-cash/pktd/btcutil"
@@ -14,7 +17,10 @@ import (
 	"github.com/pkt-cash/pktd/pktlog/log"
 	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
 	"github.com/pkt-cash/pktd/pktwallet/wallet"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/dbstructs"
 	"github.com/pkt-cash/pktd/txscript"
 	"github.com/pkt-cash/pktd/wire"
 )
@@ -68,8 +74,26 @@ func (r *rpc) getTransaction(req *rpc_pb.GetTransactionRequest) (*rpc_pb.GetTran
 	if err != nil {
 		return nil, err
 	}
+	replacedBy := ""
 	if details == nil {
-		return nil, btcjson.ErrRPCNoTxInfo.Default()
+		// A transaction which was replaced by fee is no longer part of the
+		// wallet's history but it is still recorded.
+		replaced, err := r.w.ReplacedTx(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if replaced == nil {
+			return nil, btcjson.ErrRPCNoTxInfo.Default()
+		}
+		details = &wtxmgr.TxDetails{
+			TxRecord: replaced.TxRecord,
+			Block:    wtxmgr.BlockMeta{Block: dbstructs.Block{Height: -1}},
+		}
+		replacedBy = replaced.ReplacedBy.String()
+	}
+	replaces, err := r.w.Replaces(txHash)
+	if err != nil {
+		return nil, err
 	}
 
 	syncBlock := r.w.Manager.SyncedTo()
@@ -91,6 +115,14 @@ func (r *rpc) getTransaction(req *rpc_pb.GetTransactionRequest) (*rpc_pb.GetTran
 		Time:            details.Received.Unix(),
 		TimeReceived:    details.Received.Unix(),
 		WalletConflicts: []string{},
+		ReplacedBy:      replacedBy,
+	}
+	if replacedBy != "" {
+		transaction.WalletConflicts = append(transaction.WalletConflicts, replacedBy)
+	}
+	for _, h := range replaces {
+		transaction.Replaces = append(transaction.Replaces, h.String())
+		transaction.WalletConflicts = append(transaction.WalletConflicts, h.String())
 	}
 
 	if details.Block.Height != -1 {
@@ -210,19 +242,34 @@ func (r *rpc) createTransaction(req *rpc_pb.CreateTransactionRequest) (*rpc_pb.C
 	if err != nil {
 		return nil, err
 	}
+	coinSelection, err := wallet.ParseCoinSelection(req.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
 	maxinputs := int(req.MaxInputs)
 	sendmode := wallet.SendModeSigned
 	if !req.Sign {
 		sendmode = wallet.SendModeUnsigned
 	}
-	tx, err := sendOutputs(r.w, amounts, vote, &fromaddresses, minconf, txrules.DefaultRelayFeePerKb, sendmode, &req.ChangeAddress, inputminheight, maxinputs)
+	txr, err := prepareTxReq(r.w, amounts, vote, &fromaddresses, minconf, txrules.DefaultRelayFeePerKb,
+		sendmode, &req.ChangeAddress, inputminheight, maxinputs)
+	if err != nil {
+		return nil, err
+	}
+	txr.CoinSelection = coinSelection
+	if err := (timeLocks{req.LockTime, req.RelativeLockBlocks, req.RelativeLockSeconds}).apply(txr); err != nil {
+		return nil, err
+	}
+	tx, err := sendTxRequest(r.w, txr)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, in := range tx.Tx.TxIn {
 		op := in.PreviousOutPoint
-		r.w.LockOutpoint(op, autolock)
+		if err := r.w.LockOutpoint(op, autolock); err != nil {
+			return nil, err
+		}
 	}
 
 	var transaction []byte
@@ -241,7 +288,9 @@ func (r *rpc) createTransaction(req *rpc_pb.CreateTransactionRequest) (*rpc_pb.C
 	}
 
 	return &rpc_pb.CreateTransactionResponse{
-		Transaction: transaction,
+		Transaction:   transaction,
+		CoinSelection: tx.CoinSelection,
+		Waste:         int64(tx.Waste),
 	}, nil
 }
 
@@ -290,14 +339,302 @@ func (r *rpc) sendFrom(req *rpc_pb.SendFromRequest) (*rpc_pb.SendFromResponse, e
 	}
 
 	maxinputs := int(req.MaxInputs)
+	coinSelection, err := wallet.ParseCoinSelection(req.CoinSelection)
+	if err != nil {
+		return nil, err
+	}
 
-	tx, err := sendPairs(r.w, amounts, &fromaddresses, minconf, txrules.DefaultRelayFeePerKb, maxinputs, minheight)
+	tx, err := sendPairs(r.w, amounts, &fromaddresses, minconf, txrules.DefaultRelayFeePerKb, maxinputs, minheight,
+		req.Replaceable, coinSelection, timeLocks{req.LockTime, req.RelativeLockBlocks, req.RelativeLockSeconds})
 	if err != nil {
 		return nil, err
 	}
 
 	return &rpc_pb.SendFromResponse{
-		TxHash: tx,
+		TxHash:        tx.Tx.TxHash().String(),
+		CoinSelection: tx.CoinSelection,
+		Waste:         int64(tx.Waste),
+		Scheduled:     tx.Scheduled,
+	}, nil
+}
+
+func (r *rpc) sendMany(req *rpc_pb.TransactionSendManyRequest) (*rpc_pb.TransactionSendManyResponse, er.R) {
+	if len(req.Outputs) == 0 {
+		return nil, er.New("at least one output is required")
+	}
+	if req.MinConf < 0 {
+		return nil, er.New("minconf must be positive")
+	}
+	if len(req.Label) > wtxmgr.TxLabelLimit {
+		return nil, er.Errorf("label is [%d] characters, the limit is [%d]",
+			len(req.Label), wtxmgr.TxLabelLimit)
+	}
+	vote, err := r.w.NetworkStewardVote(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		return nil, err
+	}
+	if vote == nil {
+		vote = &waddrmgr.NetworkStewardVote{}
+	}
+
+	outputs := make([]*wire.TxOut, 0, len(req.Outputs)+1)
+	var subtractFeeFrom []int
+	for i, o := range req.Outputs {
+		if o.Amount == 0 {
+			return nil, er.Errorf("output [%d]: amount must be positive", i)
+		} else if len(o.Label) > wtxmgr.TxLabelLimit {
+			return nil, er.Errorf("output [%d]: label is [%d] characters, the limit is [%d]",
+				i, len(o.Label), wtxmgr.TxLabelLimit)
+		}
+		addr, err := btcutil.DecodeAddress(o.Address, r.w.ChainParams())
+		if err != nil {
+			return nil, er.Errorf("output [%d]: cannot decode address: %s", i, err)
+		}
+		pkScript, err := txscript.PayToAddrScriptWithVote(addr, vote.VoteFor, vote.VoteAgainst)
+		if err != nil {
+			return nil, er.Errorf("output [%d]: cannot create txout script: %s", i, err)
+		}
+		outputs = append(outputs, wire.NewTxOut(int64(o.Amount), pkScript))
+		if o.SubtractFee {
+			subtractFeeFrom = append(subtractFeeFrom, i)
+		}
+	}
+	if len(req.OpReturn) > 0 {
+		if len(req.OpReturn) > txscript.MaxDataCarrierSize {
+			return nil, er.Errorf("op_return data is [%d] bytes, the limit is [%d]",
+				len(req.OpReturn), txscript.MaxDataCarrierSize)
+		}
+		script, err := txscript.NullDataScript(req.OpReturn)
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, wire.NewTxOut(0, script))
+	}
+
+	feePerKb := txrules.DefaultRelayFeePerKb
+	if req.FeePerKb > 0 {
+		feePerKb = btcutil.Amount(req.FeePerKb)
+	}
+	txr, err := prepareTxReq(r.w, map[string]btcutil.Amount{}, nil, &req.FromAddress,
+		req.MinConf, feePerKb, wallet.SendModeBcasted, nil, 0, int(req.MaxInputs))
+	if err != nil {
+		return nil, err
+	}
+	txr.Outputs = outputs
+	txr.SubtractFeeFrom = subtractFeeFrom
+	txr.Replaceable = req.Replaceable
+	txr.Label = req.Label
+	if err := (timeLocks{req.LockTime, req.RelativeLockBlocks, req.RelativeLockSeconds}).apply(txr); err != nil {
+		return nil, err
+	}
+	tx, err := sendTxRequest(r.w, txr)
+	if err != nil {
+		return nil, err
+	}
+
+	res, labels := sendManyResponse(req, tx)
+	// The transaction is already sent or scheduled, so failing to store the
+	// labels is not an error of the request.
+	txHash := tx.Tx.TxHash()
+	if err := r.w.SetOutputLabels(&txHash, labels); err != nil {
+		log.Warnf("Unable to store the output labels of [%s]: %s", res.Txid, err)
+	}
+	return res, nil
+}
+
+// sendManyVout is where the output at index i of the request ended up in the
+// transaction. The outputs keep their order, except that the change output is
+// swapped with a random output, so the output which was at the change position
+// is now the last one.
+func sendManyVout(tx *txauthor.AuthoredTx, i int) int {
+	if i == tx.ChangeIndex {
+		return len(tx.Tx.TxOut) - 1
+	}
+	return i
+}
+
+// sendManyResponse describes the outputs of a transaction made by sendMany,
+// and returns the labels of the outputs by vout. The amounts are taken from
+// the transaction because the fee may have been subtracted from them.
+func sendManyResponse(
+	req *rpc_pb.TransactionSendManyRequest,
+	tx *txauthor.AuthoredTx,
+) (*rpc_pb.TransactionSendManyResponse, map[uint32]string) {
+	totalOut := int64(0)
+	for _, out := range tx.Tx.TxOut {
+		totalOut += out.Value
+	}
+	res := &rpc_pb.TransactionSendManyResponse{
+		Txid:         tx.Tx.TxHash().String(),
+		OpReturnVout: -1,
+		ChangeVout:   int32(tx.ChangeIndex),
+		FeeUnits:     uint64(int64(tx.TotalInput) - totalOut),
+		Scheduled:    tx.Scheduled,
+	}
+	labels := make(map[uint32]string)
+	for i, o := range req.Outputs {
+		vout := sendManyVout(tx, i)
+		res.Vouts = append(res.Vouts, &rpc_pb.TransactionSendManyVout{
+			Index:   uint32(i),
+			Vout:    uint32(vout),
+			Address: o.Address,
+			Amount:  uint64(tx.Tx.TxOut[vout].Value),
+			Label:   o.Label,
+		})
+		if o.Label != "" {
+			labels[uint32(vout)] = o.Label
+		}
+	}
+	if len(req.OpReturn) > 0 {
+		res.OpReturnVout = int32(sendManyVout(tx, len(req.Outputs)))
+	}
+	return res, labels
+}
+
+var exportColumns = []string{
+	"txid", "height", "block_hash", "time", "date", "account", "account_name", "category",
+	"amount", "fee", "balance", "addresses", "label", "output_labels", "contacts",
+}
+
+func exportRecord(row *rpc_pb.TransactionExportRow) []string {
+	return []string{
+		row.Txid,
+		strconv.FormatInt(int64(row.Height), 10),
+		row.BlockHash,
+		strconv.FormatInt(row.Time, 10),
+		row.Date,
+		strconv.FormatUint(uint64(row.Account), 10),
+		row.AccountName,
+		row.Category,
+		strconv.FormatInt(row.Amount, 10),
+		strconv.FormatInt(row.Fee, 10),
+		strconv.FormatInt(row.Balance, 10),
+		strings.Join(row.Addresses, ";"),
+		row.Label,
+		strings.Join(row.OutputLabels, ";"),
+		strings.Join(row.Contacts, ";"),
+	}
+}
+
+func csvLine(record []string) (string, er.R) {
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	if err := cw.Write(record); err != nil {
+		return "", er.E(err)
+	}
+	cw.Flush()
+	return buf.String(), er.E(cw.Error())
+}
+
+func checkExportFormat(req *rpc_pb.TransactionExportRequest) er.R {
+	switch req.Format {
+	case "", "csv", "json":
+		return nil
+	default:
+		return er.Errorf("Unknown format [%s], expecting csv or json", req.Format)
+	}
+}
+
+func (r *rpc) exportTransactions(req *rpc_pb.TransactionExportRequest) (*rpc_pb.TransactionExportResponse, er.R) {
+	if err := checkExportFormat(req); err != nil {
+		return nil, err
+	}
+	rows, err := r.w.ExportTransactions(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Format == "json" {
+		return &rpc_pb.TransactionExportResponse{Rows: rows}, nil
+	}
+	var sb strings.Builder
+	for i := -1; i < len(rows); i++ {
+		record := exportColumns
+		if i >= 0 {
+			record = exportRecord(rows[i])
+		}
+		line, err := csvLine(record)
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString(line)
+	}
+	return &rpc_pb.TransactionExportResponse{Csv: sb.String()}, nil
+}
+
+func (r *rpc) streamExport(
+	req *rpc_pb.TransactionExportRequest,
+	quit <-chan struct{},
+) (<-chan *rpc_pb.TransactionExportChunk, er.R) {
+	if err := checkExportFormat(req); err != nil {
+		return nil, err
+	}
+	out := make(chan *rpc_pb.TransactionExportChunk)
+	go func() {
+		defer close(out)
+		send := func(c *rpc_pb.TransactionExportChunk) bool {
+			select {
+			case out <- c:
+				return true
+			case <-quit:
+				return false
+			}
+		}
+		isCsv := req.Format != "json"
+		if isCsv {
+			line, _ := csvLine(exportColumns)
+			if !send(&rpc_pb.TransactionExportChunk{Csv: line}) {
+				return
+			}
+		}
+		// The rows are sent as the history is read, so a failure part way
+		// through can only end the stream early.
+		err := r.w.StreamTransactions(req, func(row *rpc_pb.TransactionExportRow) bool {
+			c := &rpc_pb.TransactionExportChunk{Row: row}
+			if isCsv {
+				line, err := csvLine(exportRecord(row))
+				if err != nil {
+					log.Warnf("Unable to export [%s] as CSV: %s", row.Txid, err)
+					return false
+				}
+				c = &rpc_pb.TransactionExportChunk{Csv: line}
+			}
+			return send(c)
+		})
+		if err != nil {
+			log.Warnf("Unable to export the transactions: %s", err)
+		}
+	}()
+	return out, nil
+}
+
+func (r *rpc) bumpFee(req *rpc_pb.TransactionBumpFeeRequest) (*rpc_pb.TransactionBumpFeeResponse, er.R) {
+	txHash, err := chainhash.NewHashFromStr(req.Txid)
+	if err != nil {
+		return nil, btcjson.ErrRPCDecodeHexString.New("Transaction hash string decode failed", err)
+	}
+	var mode wallet.BumpFeeMode
+	switch req.Mode {
+	case "", "auto":
+		mode = wallet.BumpFeeAuto
+	case "rbf":
+		mode = wallet.BumpFeeRBF
+	case "cpfp":
+		mode = wallet.BumpFeeCPFP
+	default:
+		return nil, er.Errorf("Unknown mode [%s], expecting rbf or cpfp", req.Mode)
+	}
+	res, err := r.w.BumpFee(txHash, btcutil.Amount(req.FeePerKb), mode)
+	if err != nil {
+		if waddrmgr.ErrLocked.Is(err) {
+			return nil, er.New("Enter the wallet passphrase with `./bin/pldctl unlock` first")
+		}
+		return nil, err
+	}
+	return &rpc_pb.TransactionBumpFeeResponse{
+		Txid:     res.Tx.TxHash().String(),
+		Mode:     res.Mode.String(),
+		FeeUnits: uint64(res.Fee),
+		FeePerKb: uint64(res.FeePerKb),
 	}, nil
 }
 
@@ -376,22 +713,21 @@ func Register(a *apiv1.Apiv1, w *wallet.Wallet) {
 		r.sendvote,
 	)
 
-	// TODO(cjd): This is not written right, needs to be addressed
-	// apiv1.Endpoint(
-	// 	walletTransaction,
-	// 	"sendmany",
-	// 	`
-	// 	Send PKT on-chain to multiple addresses
-
-	// 	SendMany handles a request for a transaction that creates multiple specified
-	// 	outputs in parallel. If neither target_conf, or sat_per_byte are set, then
-	// 	the internal wallet will consult its fee model to determine a fee for the
-	// 	default confirmation target.
-	// 	`,
-	// 	withRpc(c, func(rs *LightningRPCServer, req *rpc_pb.SendManyRequest) (*rpc_pb.SendManyResponse, er.R) {
-	// 		return rs.SendMany(context.TODO(), req)
-	// 	}),
-	// )
+	apiv1.Endpoint(
+		a,
+		"sendmany",
+		`
+		Authors, signs, and sends a transaction which pays many outputs
+
+		Each output may carry a label, which is returned with it in the response
+		and stored in the address book as the label of the output,
+		and may be marked to have the fee subtracted from it rather than paid by the
+		inputs. An OP_RETURN output with arbitrary data may be added. The response
+		tells the position (vout) of each requested output in the transaction.
+		`,
+		r.sendMany,
+	)
+
 	apiv1.Endpoint(
 		a,
 		"decode",
@@ -421,6 +757,49 @@ func Register(a *apiv1.Apiv1, w *wallet.Wallet) {
 		`,
 		r.w.GetTransactions1,
 	)
+	apiv1.Endpoint(
+		a,
+		"export",
+		`
+		Export the history of the wallet for accounting, as CSV or JSON
+
+		There is one row for each account which each transaction changed, with the
+		change to the balance of the account, the share of the fee which the account
+		paid, the balance of the account after the transaction, the category (send,
+		receive, generate or immature) and the labels and contacts from the address
+		book. Amounts are in atomic units. Rows are ordered by height and then by
+		txid, and the running balances are computed over the whole history, so the
+		export is the same after a resync and whatever range is requested.
+		Unconfirmed transactions are excluded unless include_unconfirmed is set.
+		With ?stream the rows (or CSV lines, the first being the header) are
+		streamed one per message.
+		`,
+		r.exportTransactions,
+	)
+	apiv1.StreamFunc(
+		a,
+		"export",
+		`
+		Stream the history of the wallet for accounting, see /wallet/transaction/export
+		`,
+		r.streamExport,
+	)
+	apiv1.Endpoint(
+		a,
+		"bumpfee",
+		`
+		Accelerate an unconfirmed wallet transaction by paying a higher fee
+
+		In rbf mode the transaction is replaced by one spending the same inputs, the
+		additional fee is taken from the change and the change is dropped if it becomes
+		dust. This requires the transaction to signal replaceability, see the replaceable
+		field of /wallet/transaction/sendfrom. In cpfp mode an output of the transaction
+		is spent back to the same address by a child transaction paying a fee high enough
+		for both. By default rbf is used if the transaction can be replaced, otherwise cpfp,
+		which is also used if the change of the transaction is too small to pay the fee.
+		`,
+		r.bumpFee,
+	)
 	apiv1.Endpoint(
 		a,
 		"publish",
@@ -0,0 +1,83 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+func sendManyTx(values ...int64) *txauthor.AuthoredTx {
+	tx := wire.NewMsgTx(constants.TxVersion)
+	for _, v := range values {
+		tx.AddTxOut(wire.NewTxOut(v, []byte{byte(len(tx.TxOut))}))
+	}
+	return &txauthor.AuthoredTx{Tx: tx, TotalInput: btcutil.Amount(10000), ChangeIndex: -1}
+}
+
+func TestSendManyResponse(t *testing.T) {
+	req := &rpc_pb.TransactionSendManyRequest{
+		Outputs: []*rpc_pb.TransactionSendManyOutput{
+			{Address: "a", Amount: 1000, Label: "first"},
+			{Address: "b", Amount: 2000, SubtractFee: true},
+			{Address: "c", Amount: 3000, Label: "third"},
+		},
+		OpReturn: []byte("hello"),
+	}
+
+	// The fee of 500 was taken from the second output and the change, which
+	// was added last, was then swapped with the second output.
+	tx := sendManyTx(1000, 1500, 3000, 0, 4000)
+	tx.Tx.TxOut[1], tx.Tx.TxOut[4] = tx.Tx.TxOut[4], tx.Tx.TxOut[1]
+	tx.ChangeIndex = 1
+
+	res, labels := sendManyResponse(req, tx)
+	if res.ChangeVout != 1 {
+		t.Errorf("change vout is %d, expected 1", res.ChangeVout)
+	}
+	if res.OpReturnVout != 3 {
+		t.Errorf("op_return vout is %d, expected 3", res.OpReturnVout)
+	}
+	if res.FeeUnits != 500 {
+		t.Errorf("fee is %d, expected 500", res.FeeUnits)
+	}
+	expected := []struct {
+		vout   uint32
+		amount uint64
+	}{{0, 1000}, {4, 1500}, {2, 3000}}
+	if len(res.Vouts) != len(expected) {
+		t.Fatalf("got %d vouts, expected %d", len(res.Vouts), len(expected))
+	}
+	for i, e := range expected {
+		v := res.Vouts[i]
+		if v.Index != uint32(i) || v.Vout != e.vout || v.Amount != e.amount ||
+			v.Address != req.Outputs[i].Address {
+			t.Errorf("output [%d]: got %+v, expected vout %d amount %d",
+				i, v, e.vout, e.amount)
+		}
+	}
+	if len(labels) != 2 || labels[0] != "first" || labels[2] != "third" {
+		t.Errorf("unexpected labels %v", labels)
+	}
+}
+
+func TestSendManyResponseNoChange(t *testing.T) {
+	req := &rpc_pb.TransactionSendManyRequest{
+		Outputs: []*rpc_pb.TransactionSendManyOutput{
+			{Address: "a", Amount: 4000},
+			{Address: "b", Amount: 5000},
+		},
+	}
+	res, _ := sendManyResponse(req, sendManyTx(4000, 5000))
+	if res.ChangeVout != -1 || res.OpReturnVout != -1 || res.FeeUnits != 1000 {
+		t.Errorf("unexpected response %+v", res)
+	}
+	for i, v := range res.Vouts {
+		if v.Vout != uint32(i) {
+			t.Errorf("output [%d] is at vout %d", i, v.Vout)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package transaction
 import (
 	"math"
 
+	"github.com/pkt-cash/pktd/blockchain"
 	"github.com/pkt-cash/pktd/btcjson"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -18,28 +19,75 @@ import (
 	"github.com/pkt-cash/pktd/txscript/opcode"
 	"github.com/pkt-cash/pktd/txscript/scriptbuilder"
 	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
 	"github.com/pkt-cash/pktd/wire/ruleerror"
 )
 
 // sendPairs creates and sends payment transactions.
-// It returns the transaction hash in string format upon success
+// It returns the transaction upon success
 // All errors are returned in btcjson.RPCError format
 func sendPairs(w *wallet.Wallet, amounts map[string]btcutil.Amount,
-	fromAddressses *[]string, minconf int32, feeSatPerKb btcutil.Amount, maxInputs, inputMinHeight int) (string, er.R) {
+	fromAddressses *[]string, minconf int32, feeSatPerKb btcutil.Amount, maxInputs, inputMinHeight int,
+	replaceable bool, coinSelection wallet.CoinSelection, locks timeLocks) (*txauthor.AuthoredTx, er.R) {
 
 	vote, err := w.NetworkStewardVote(0, waddrmgr.KeyScopeBIP0044)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	tx, err := sendOutputs(w, amounts, vote, fromAddressses, minconf, feeSatPerKb, wallet.SendModeBcasted, nil, inputMinHeight, maxInputs)
+	req, err := prepareTxReq(w, amounts, vote, fromAddressses, minconf, feeSatPerKb,
+		wallet.SendModeBcasted, nil, inputMinHeight, maxInputs)
+	if err != nil {
+		return nil, err
+	}
+	req.Replaceable = replaceable
+	req.CoinSelection = coinSelection
+	if err := locks.apply(req); err != nil {
+		return nil, err
+	}
+	tx, err := sendTxRequest(w, req)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	if tx.Scheduled {
+		log.Infof("Scheduled transaction [%s]", log.Txid(tx.Tx.TxHash().String()))
+	} else {
+		log.Infof("Successfully sent transaction [%s]", log.Txid(tx.Tx.TxHash().String()))
 	}
+	return tx, nil
+}
 
-	txHashStr := tx.Tx.TxHash().String()
-	log.Infof("Successfully sent transaction [%s]", log.Txid(txHashStr))
-	return txHashStr, nil
+// timeLocks are the lock_time, relative_lock_blocks and relative_lock_seconds
+// fields of a request.
+type timeLocks struct {
+	lockTime uint32
+	blocks   uint32
+	seconds  uint32
+}
+
+// apply sets the nLockTime and the sequence numbers of a transaction request.
+func (l timeLocks) apply(req *wallet.CreateTxReq) er.R {
+	req.LockTime = l.lockTime
+	if l.blocks > 0 && l.seconds > 0 {
+		return er.New("relative_lock_blocks and relative_lock_seconds can't both be set")
+	} else if l.blocks > 0 {
+		if l.blocks > constants.SequenceLockTimeMask {
+			return er.Errorf("relative_lock_blocks is [%d], the limit is [%d]",
+				l.blocks, constants.SequenceLockTimeMask)
+		}
+		req.Sequence = blockchain.LockTimeToSequence(false, l.blocks)
+	} else if l.seconds > 0 {
+		limit := uint32(constants.SequenceLockTimeMask) << constants.SequenceLockTimeGranularity
+		if l.seconds > limit {
+			return er.Errorf("relative_lock_seconds is [%d], the limit is [%d]", l.seconds, limit)
+		}
+		// Round up so the coins are locked at least as long as requested.
+		granule := uint32(1) << constants.SequenceLockTimeGranularity
+		seconds := (l.seconds + granule - 1) / granule * granule
+		req.Sequence = blockchain.LockTimeToSequence(true, seconds)
+	}
+	return nil
 }
 
 func mkVoteScript(willingCandidate bool, voteFor []byte) ([]byte, er.R) {
@@ -72,11 +120,10 @@ func prepareTxReq(
 		Label:          "",
 	}
 	if inputMinHeight > 0 {
-		// TODO(cjd): Ideally we would expose the comparator choice to the
-		// API consumer, but this is an API break. When we're using inputMinHeight
-		// it's normally because we're trying to do multiple createtransaction
-		// requests without double-spending, so it's important to prefer oldest
-		// in this case.
+		// When we're using inputMinHeight it's normally because we're trying
+		// to do multiple createtransaction requests without double-spending,
+		// so it's important to prefer oldest in this case. Other strategies
+		// can be chosen with CreateTxReq.CoinSelection.
 		req.InputComparator = wallet.PreferOldest
 	}
 	var err er.R
@@ -115,26 +162,6 @@ func mkAmount(amtf float64) (btcutil.Amount, er.R) {
 	}
 }
 
-func sendOutputs(
-	w *wallet.Wallet,
-	amounts map[string]btcutil.Amount,
-	vote *waddrmgr.NetworkStewardVote,
-	fromAddressses *[]string,
-	minconf int32,
-	feeSatPerKb btcutil.Amount,
-	sendMode wallet.SendMode,
-	changeAddress *string,
-	inputMinHeight int,
-	maxInputs int,
-) (*txauthor.AuthoredTx, er.R) {
-	if req, err := prepareTxReq(w, amounts, vote, fromAddressses, minconf, feeSatPerKb,
-		sendMode, changeAddress, inputMinHeight, maxInputs); err != nil {
-		return nil, err
-	} else {
-		return sendTxRequest(w, req)
-	}
-}
-
 func sendTxRequest(w *wallet.Wallet, req *wallet.CreateTxReq) (*txauthor.AuthoredTx, er.R) {
 	tx, err := w.SendOutputs(*req)
 	if err != nil {
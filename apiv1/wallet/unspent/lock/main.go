@@ -1,8 +1,11 @@
 package lock
 
 import (
+	"time"
+
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/help_pb"
 	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
 	"github.com/pkt-cash/pktd/pktwallet/wallet"
@@ -13,18 +16,23 @@ type rpc struct {
 	w        *wallet.Wallet
 }
 
-func (r *rpc) listlockunspent(_ *rpc_pb.Null) (*rpc_pb.ListLockUnspentResponse, er.R) {
-	list := r.w.LockedOutpoints()
-	lu := make(map[string][]*rpc_pb.OutPoint)
-	for _, l := range list {
-		lu[l.LockName] = append(lu[l.LockName], &rpc_pb.OutPoint{TxidStr: l.Txid, OutputIndex: l.Vout})
+func (r *rpc) listlockunspent(in *rpc_pb.ListLockUnspentRequest) (*rpc_pb.ListLockUnspentResponse, er.R) {
+	var lockName *string
+	if in.Lockname != "" {
+		lockName = &in.Lockname
 	}
-	out := make([]*rpc_pb.LockedUtxos, 0, len(lu))
-	for name, ops := range lu {
-		out = append(out, &rpc_pb.LockedUtxos{
-			LockName: name,
-			Utxos:    ops,
-		})
+	list := r.w.LockedOutpoints(lockName)
+	lu := make(map[string]*rpc_pb.LockedUtxos)
+	out := make([]*rpc_pb.LockedUtxos, 0)
+	for _, l := range list {
+		group := lu[l.LockName]
+		if group == nil {
+			group = &rpc_pb.LockedUtxos{LockName: l.LockName}
+			lu[l.LockName] = group
+			out = append(out, group)
+		}
+		group.Utxos = append(group.Utxos, &rpc_pb.OutPoint{TxidStr: l.Txid, OutputIndex: l.Vout})
+		group.Expires = append(group.Expires, l.Expires)
 	}
 	return &rpc_pb.ListLockUnspentResponse{
 		LockedUnspents: out,
@@ -37,6 +45,10 @@ func (r *rpc) lockunspent(in *rpc_pb.LockUnspentRequest) (*rpc_pb.LockUnspentRes
 	if in.Lockname != "" {
 		lockname = in.Lockname
 	}
+	var expiry time.Time
+	if in.ExpireSec > 0 {
+		expiry = time.Now().Add(time.Duration(in.ExpireSec) * time.Second)
+	}
 	transactions := in.Transactions
 	for _, input := range transactions {
 		txHash, err := chainhash.NewHashFromStr(input.TxidStr)
@@ -44,7 +56,9 @@ func (r *rpc) lockunspent(in *rpc_pb.LockUnspentRequest) (*rpc_pb.LockUnspentRes
 			return nil, err
 		}
 		op := wire.OutPoint{Hash: *txHash, Index: uint32(input.OutputIndex)}
-		w.LockOutpoint(op, lockname)
+		if err := w.LockOutpointUntil(op, lockname, expiry); err != nil {
+			return nil, err
+		}
 	}
 	return nil, nil
 }
@@ -52,7 +66,9 @@ func (r *rpc) lockunspent(in *rpc_pb.LockUnspentRequest) (*rpc_pb.LockUnspentRes
 func (r *rpc) unlockunspent(in *rpc_pb.LockUnspentRequest) (*rpc_pb.Null, er.R) {
 	w := r.w
 	if in.Lockname != "" {
-		w.ResetLockedOutpoints(&in.Lockname)
+		if err := w.ResetLockedOutpoints(&in.Lockname); err != nil {
+			return nil, err
+		}
 	}
 	transactions := in.Transactions
 	for _, input := range transactions {
@@ -61,15 +77,16 @@ func (r *rpc) unlockunspent(in *rpc_pb.LockUnspentRequest) (*rpc_pb.Null, er.R)
 			return nil, err
 		}
 		op := wire.OutPoint{Hash: *txHash, Index: uint32(input.OutputIndex)}
-		w.UnlockOutpoint(op)
+		if err := w.UnlockOutpoint(op); err != nil {
+			return nil, err
+		}
 	}
 
 	return nil, nil
 }
 
 func (r *rpc) unlockallunspent(_ *rpc_pb.Null) (*rpc_pb.Null, er.R) {
-	r.w.ResetLockedOutpoints(nil)
-	return nil, nil
+	return nil, r.w.ResetLockedOutpoints(nil)
 }
 
 func Register(a *apiv1.Apiv1, w *wallet.Wallet) {
@@ -81,9 +98,11 @@ func Register(a *apiv1.Apiv1, w *wallet.Wallet) {
 		List utxos which are locked
 
 		Returns an set of outpoints marked as locked by using /wallet/unspent/lock/create
-		These are batched by group name.
+		These are batched by group name. If a lock name is specified, only the
+		outpoints locked with that name are listed.
 		`,
 		r.listlockunspent,
+		help_pb.F_ALLOW_GET,
 	)
 	apiv1.Endpoint(
 		a,
@@ -93,6 +112,8 @@ func Register(a *apiv1.Apiv1, w *wallet.Wallet) {
 
 		You may optionally specify a group name. You may call this endpoint
 		multiple times with the same group name to add more unspents to the group.
+		Locks are stored in the wallet and remain after a restart, unless an
+		expiration is specified they remain until they are deleted.
 		NOTE: The lock group name "none" is reserved.
 		`,
 		r.lockunspent,
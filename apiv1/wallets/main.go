@@ -0,0 +1,311 @@
+package wallets
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkt-cash/pktd/apiv1/lightning"
+	api_wallet "github.com/pkt-cash/pktd/apiv1/wallet"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/lock"
+	"github.com/pkt-cash/pktd/btcutil/util/mailbox"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
+	"github.com/pkt-cash/pktd/neutrino"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/wallet"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
+)
+
+// Config describes where named wallets are stored.
+type Config struct {
+	// The directory where named wallets are stored as wallet_<name>.db
+	Dir string
+
+	// The path of the main wallet, which cannot be loaded again as a named wallet
+	MainWalletPath string
+
+	// The names of the wallets to load at startup
+	Load []string
+}
+
+// Same as the recovery window of the main wallet
+const recoveryWindow = 256
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+type loadedWallet struct {
+	loader *wallet.Loader
+	w      *wallet.Wallet
+	path   string
+}
+
+type rpc struct {
+	walletCat  *apiv1.Apiv1
+	mainWallet *wallet.Wallet
+	neutrinoCS *neutrino.ChainService
+	conf       Config
+	wallets    lock.GenMutex[map[string]*loadedWallet]
+}
+
+func info(name string, lw *loadedWallet) *rpc_pb.LoadedWallet {
+	return &rpc_pb.LoadedWallet{
+		Name:         name,
+		Path:         lw.path,
+		Locked:       lw.w.Locked(),
+		SyncedHeight: lw.w.Manager.SyncedTo().Height,
+	}
+}
+
+// Check that the wallet name is usable and return the path of its database.
+// Must be called with the wallets locked.
+func (r *rpc) checkName(name string, wallets map[string]*loadedWallet) (string, er.R) {
+	if !validName.MatchString(name) {
+		return "", er.Errorf("Invalid wallet name [%s], it must be 1 to 64 letters, "+
+			"numbers, - or _", name)
+	}
+	if _, ok := wallets[name]; ok {
+		return "", wallet.ErrLoaded.New(name, nil)
+	}
+	path := wallet.WalletDbPath(r.conf.Dir, name)
+	if path == r.conf.MainWalletPath {
+		return "", er.Errorf("Wallet [%s] is the main wallet, it is always loaded", name)
+	}
+	if apiv1.IsRegistered(r.walletCat, "wallet/"+name) {
+		return "", er.Errorf("Wallet name [%s] cannot be used because /wallet/%s "+
+			"is an existing endpoint", name, name)
+	}
+	return path, nil
+}
+
+// Open or create the wallet and register its endpoints under /wallet/<name>/.
+// Must be called with the wallets locked.
+func (r *rpc) open(
+	name string,
+	path string,
+	wallets map[string]*loadedWallet,
+	open func(l *wallet.Loader, cat *apiv1.Apiv1) (*wallet.Wallet, er.R),
+) (*loadedWallet, er.R) {
+	cat := apiv1.DefineCategory(r.walletCat, name,
+		"APIs for management of on-chain payments using the wallet ["+name+"]")
+	loader := wallet.NewLoader(r.mainWallet.ChainParams(), r.conf.Dir, name, false, recoveryWindow)
+	w, err := open(loader, cat)
+	if err != nil {
+		if err := apiv1.DeregisterCategory(r.walletCat, "wallet/"+name); err != nil {
+			log.Warnf("Unable to deregister wallet [%s]: [%s]", name, err)
+		}
+		return nil, err
+	}
+	w.SynchronizeRPC(r.neutrinoCS)
+
+	// Named wallets never run Lightning, so nothing is ever sent to this mailbox.
+	noLightning := mailbox.NewMailbox[*lightning.StartLightning](nil)
+	api_wallet.Register(cat, w, &noLightning)
+
+	lw := &loadedWallet{loader: loader, w: w, path: path}
+	wallets[name] = lw
+	log.Infof("Loaded wallet [%s] from [%s]", name, path)
+	return lw, nil
+}
+
+func (r *rpc) load(req *rpc_pb.LoadWalletRequest) (*rpc_pb.LoadedWallet, er.R) {
+	var out *rpc_pb.LoadedWallet
+	err := r.wallets.In(func(wallets *map[string]*loadedWallet) er.R {
+		path, err := r.checkName(req.Name, *wallets)
+		if err != nil {
+			return err
+		}
+		if _, errr := os.Stat(path); os.IsNotExist(errr) {
+			return er.Errorf("Wallet [%s] does not exist, no such file [%s]", req.Name, path)
+		}
+		lw, err := r.open(req.Name, path, *wallets,
+			func(l *wallet.Loader, cat *apiv1.Apiv1) (*wallet.Wallet, er.R) {
+				return l.OpenExistingWallet([]byte(wallet.InsecurePubPassphrase), false, cat)
+			})
+		if err != nil {
+			return err
+		}
+		out = info(req.Name, lw)
+		return nil
+	})
+	return out, err
+}
+
+func (r *rpc) create(req *rpc_pb.CreateWalletRequest) (*rpc_pb.CreateWalletResponse, er.R) {
+	privPass := []byte(req.WalletPassphrase)
+	if len(req.WalletPassphraseBin) > 0 {
+		privPass = req.WalletPassphraseBin
+	}
+	if len(privPass) == 0 {
+		return nil, er.New("A wallet passphrase is required")
+	}
+	seedPass := []byte(req.SeedPassphrase)
+	if len(req.SeedPassphraseBin) > 0 {
+		seedPass = req.SeedPassphraseBin
+	}
+
+	var seed *seedwords.Seed
+	var seedWords []string
+	if len(req.WalletSeed) > 0 || len(req.WalletSeedShares) > 0 {
+		if len(req.WalletSeed) > 0 && len(req.WalletSeedShares) > 0 {
+			return nil, er.New("Only one of wallet_seed and wallet_seed_shares may be provided")
+		}
+		shares := make([]string, 0, len(req.WalletSeedShares))
+		for _, sh := range req.WalletSeedShares {
+			shares = append(shares, strings.Join(sh.Words, " "))
+		}
+		seedEnc, err := seedwords.SeedFromWordsOrShares(strings.Join(req.WalletSeed, " "), shares)
+		if err != nil {
+			return nil, err
+		}
+		defer seedEnc.Zero()
+		if seedEnc.NeedsPassphrase() && len(seedPass) == 0 {
+			return nil, er.New("The provided seed requires a passphrase")
+		}
+		if seed, err = seedEnc.Decrypt(seedPass, false); err != nil {
+			return nil, err
+		}
+	} else {
+		s, err := seedwords.RandomSeed()
+		if err != nil {
+			return nil, err
+		}
+		seed = s
+		seedEnc := seed.Encrypt(privPass)
+		words, err := seedEnc.Words("english")
+		seedEnc.Zero()
+		if err != nil {
+			return nil, err
+		}
+		seedWords = strings.Split(words, " ")
+	}
+	defer seed.Zero()
+
+	var out *rpc_pb.CreateWalletResponse
+	err := r.wallets.In(func(wallets *map[string]*loadedWallet) er.R {
+		path, err := r.checkName(req.Name, *wallets)
+		if err != nil {
+			return err
+		}
+		lw, err := r.open(req.Name, path, *wallets,
+			func(l *wallet.Loader, cat *apiv1.Apiv1) (*wallet.Wallet, er.R) {
+				return l.CreateNewWallet(
+					[]byte(wallet.InsecurePubPassphrase), privPass,
+					nil, time.Now(), seed, cat,
+				)
+			})
+		if err != nil {
+			return err
+		}
+		out = &rpc_pb.CreateWalletResponse{
+			Wallet: info(req.Name, lw),
+			Seed:   seedWords,
+		}
+		return nil
+	})
+	return out, err
+}
+
+func (r *rpc) unload(req *rpc_pb.UnloadWalletRequest) (*rpc_pb.Null, er.R) {
+	return nil, r.wallets.In(func(wallets *map[string]*loadedWallet) er.R {
+		lw, ok := (*wallets)[req.Name]
+		if !ok {
+			return wallet.ErrNotLoaded.New(req.Name, nil)
+		}
+		if err := apiv1.DeregisterCategory(r.walletCat, "wallet/"+req.Name); err != nil {
+			log.Warnf("Unable to deregister wallet [%s]: [%s]", req.Name, err)
+		}
+		delete(*wallets, req.Name)
+		if err := lw.loader.DetachWallet(); err != nil {
+			return err
+		}
+		log.Infof("Unloaded wallet [%s]", req.Name)
+		return nil
+	})
+}
+
+func (r *rpc) list(*rpc_pb.Null) (*rpc_pb.ListWalletsResponse, er.R) {
+	out := &rpc_pb.ListWalletsResponse{
+		Wallets: []*rpc_pb.LoadedWallet{
+			info("", &loadedWallet{w: r.mainWallet, path: r.conf.MainWalletPath}),
+		},
+	}
+	r.wallets.In(func(wallets *map[string]*loadedWallet) er.R {
+		for name, lw := range *wallets {
+			out.Wallets = append(out.Wallets, info(name, lw))
+		}
+		return nil
+	})
+	sort.Slice(out.Wallets, func(i, j int) bool {
+		return out.Wallets[i].Name < out.Wallets[j].Name
+	})
+	return out, nil
+}
+
+func Register(
+	a *apiv1.Apiv1,
+	walletCat *apiv1.Apiv1,
+	mainWallet *wallet.Wallet,
+	neutrinoCS *neutrino.ChainService,
+	conf Config,
+) {
+	r := rpc{
+		walletCat:  walletCat,
+		mainWallet: mainWallet,
+		neutrinoCS: neutrinoCS,
+		conf:       conf,
+		wallets:    lock.NewGenMutex(make(map[string]*loadedWallet), "wallets"),
+	}
+	apiv1.Endpoint(
+		a,
+		"",
+		`
+		List the loaded wallets
+
+		The main wallet, which has no name, is always loaded and its endpoints are
+		under /wallet/. Each named wallet has the same endpoints under /wallet/<name>/.
+		`,
+		r.list,
+	)
+	apiv1.Endpoint(
+		a,
+		"create",
+		`
+		Create and load a new named wallet
+
+		The wallet is stored as wallet_<name>.db in the pktwallet directory and it shares
+		the Neutrino chain backend with all other wallets. If no seed is provided then a
+		new seed is generated and returned, encrypted with the wallet passphrase.
+		`,
+		r.create,
+	)
+	apiv1.Endpoint(
+		a,
+		"load",
+		`
+		Load an existing named wallet
+
+		Once the wallet is loaded, its endpoints are available under /wallet/<name>/.
+		`,
+		r.load,
+	)
+	apiv1.Endpoint(
+		a,
+		"unload",
+		`
+		Unload a named wallet
+
+		The wallet is stopped, its database is closed and its endpoints are removed.
+		`,
+		r.unload,
+	)
+
+	for _, name := range conf.Load {
+		if _, err := r.load(&rpc_pb.LoadWalletRequest{Name: name}); err != nil {
+			log.Errorf("Unable to load wallet [%s]: [%s]", name, err)
+		}
+	}
+}
@@ -52,6 +52,7 @@ type LockedUnspent struct {
 	Txid     string `json:"txid"`
 	Vout     uint32 `json:"vout"`
 	LockName string `json:"lockname"`
+	Expires  int64  `json:"expires,omitempty"`
 }
 
 // CreateRawTransactionCmd defines the createrawtransaction JSON-RPC command.
@@ -0,0 +1,138 @@
+package psbt
+
+// The Combiner is the role of BIP174 which merges several PSBTs describing the
+// same unsigned transaction, each of which may have been updated or signed by
+// a different participant, into one PSBT containing the union of their data.
+
+import (
+	"bytes"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// Combine merges the passed packets into a new packet. All packets must have
+// the same unsigned transaction. Where two packets contain different values for
+// the same key, the value from the first packet is kept, partial signatures,
+// BIP32 derivations and unknowns are merged by key.
+func Combine(packets ...*Packet) (*Packet, er.R) {
+	if len(packets) == 0 {
+		return nil, er.New("no PSBTs to combine")
+	}
+	for _, p := range packets {
+		if err := VerifyInputOutputLen(p, false, false); err != nil {
+			return nil, err
+		}
+	}
+
+	txHash := packets[0].UnsignedTx.TxHash()
+	out, err := NewFromUnsignedTx(packets[0].UnsignedTx.Copy())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range packets {
+		if p.UnsignedTx.TxHash() != txHash {
+			return nil, ErrDifferentTransactions.Default()
+		}
+		for i := range p.Inputs {
+			combineInput(&out.Inputs[i], &p.Inputs[i])
+		}
+		for i := range p.Outputs {
+			combineOutput(&out.Outputs[i], &p.Outputs[i])
+		}
+		for _, u := range p.Unknowns {
+			if !hasUnknown(out.Unknowns, u.Key) {
+				out.Unknowns = append(out.Unknowns, u)
+			}
+		}
+	}
+
+	if err := out.SanityCheck(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func combineInput(dst, src *PInput) {
+	if dst.NonWitnessUtxo == nil {
+		dst.NonWitnessUtxo = src.NonWitnessUtxo
+	}
+	if dst.WitnessUtxo == nil {
+		dst.WitnessUtxo = src.WitnessUtxo
+	}
+	for _, ps := range src.PartialSigs {
+		found := false
+		for _, x := range dst.PartialSigs {
+			if bytes.Equal(x.PubKey, ps.PubKey) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst.PartialSigs = append(dst.PartialSigs, ps)
+		}
+	}
+	if dst.SighashType == 0 {
+		dst.SighashType = src.SighashType
+	}
+	if dst.RedeemScript == nil {
+		dst.RedeemScript = src.RedeemScript
+	}
+	if dst.WitnessScript == nil {
+		dst.WitnessScript = src.WitnessScript
+	}
+	dst.Bip32Derivation = combineBip32(dst.Bip32Derivation, src.Bip32Derivation)
+	if dst.FinalScriptSig == nil {
+		dst.FinalScriptSig = src.FinalScriptSig
+	}
+	if dst.FinalScriptWitness == nil {
+		dst.FinalScriptWitness = src.FinalScriptWitness
+	}
+	for _, u := range src.Unknowns {
+		found := false
+		for _, x := range dst.Unknowns {
+			if bytes.Equal(x.Key, u.Key) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst.Unknowns = append(dst.Unknowns, u)
+		}
+	}
+}
+
+func combineOutput(dst, src *POutput) {
+	if dst.RedeemScript == nil {
+		dst.RedeemScript = src.RedeemScript
+	}
+	if dst.WitnessScript == nil {
+		dst.WitnessScript = src.WitnessScript
+	}
+	dst.Bip32Derivation = combineBip32(dst.Bip32Derivation, src.Bip32Derivation)
+}
+
+func combineBip32(dst, src []*Bip32Derivation) []*Bip32Derivation {
+	for _, d := range src {
+		found := false
+		for _, x := range dst {
+			if bytes.Equal(x.PubKey, d.PubKey) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst = append(dst, d)
+		}
+	}
+	return dst
+}
+
+func hasUnknown(unknowns []Unknown, key []byte) bool {
+	for _, u := range unknowns {
+		if bytes.Equal(u.Key, key) {
+			return true
+		}
+	}
+	return false
+}
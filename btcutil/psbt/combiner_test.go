@@ -0,0 +1,79 @@
+package psbt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/util"
+)
+
+// TestCombine checks that combining the outputs of the two signers in the
+// BIP174 test vectors yields a PSBT which finalizes to the expected
+// transaction.
+func TestCombine(t *testing.T) {
+	parse := func(h string) *Packet {
+		b, err := util.DecodeHex(h)
+		if err != nil {
+			t.Fatalf("Unable to decode hex: %v", err)
+		}
+		p, err := NewFromRawBytes(bytes.NewReader(b), false)
+		if err != nil {
+			t.Fatalf("Unable to parse PSBT: %v", err)
+		}
+		return p
+	}
+	signer1 := parse(signerPsbtData["signer1Result"])
+	signer2 := parse(signerPsbtData["signer2Result"])
+
+	combined, err := Combine(signer1, signer2)
+	if err != nil {
+		t.Fatalf("Unable to combine: %v", err)
+	}
+	for i, in := range combined.Inputs {
+		if len(in.PartialSigs) != 2 {
+			t.Fatalf("Input %d has %d partial sigs, expected 2", i, len(in.PartialSigs))
+		}
+	}
+
+	// Combining with itself changes nothing
+	again, err := Combine(combined, combined)
+	if err != nil {
+		t.Fatalf("Unable to combine: %v", err)
+	}
+	var b1, b2 bytes.Buffer
+	if err := combined.Serialize(&b1); err != nil {
+		t.Fatalf("Unable to serialize: %v", err)
+	}
+	if err := again.Serialize(&b2); err != nil {
+		t.Fatalf("Unable to serialize: %v", err)
+	}
+	if !bytes.Equal(b1.Bytes(), b2.Bytes()) {
+		t.Fatalf("Combining a PSBT with itself changed it")
+	}
+
+	// The combined PSBT has everything which is needed to finalize.
+	if err := MaybeFinalizeAll(combined); err != nil {
+		t.Fatalf("Unable to finalize: %v", err)
+	}
+	tx, err := Extract(combined)
+	if err != nil {
+		t.Fatalf("Unable to extract: %v", err)
+	}
+	var txb bytes.Buffer
+	if err := tx.Serialize(&txb); err != nil {
+		t.Fatalf("Unable to serialize tx: %v", err)
+	}
+	expected, err := util.DecodeHex(finalizerPsbtData["network"])
+	if err != nil {
+		t.Fatalf("Unable to decode hex: %v", err)
+	}
+	if !bytes.Equal(txb.Bytes(), expected) {
+		t.Fatalf("Extracted transaction does not match the expected result")
+	}
+
+	// PSBTs of different transactions cannot be combined
+	other := parse(finalizerPsbtData["twoOfThree"])
+	if _, err := Combine(signer1, other); !ErrDifferentTransactions.Is(err) {
+		t.Fatalf("Expected ErrDifferentTransactions, got %v", err)
+	}
+}
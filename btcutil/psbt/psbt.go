@@ -105,6 +105,11 @@ var (
 	// scriptwitness given is not supported by this codebase, or is otherwise
 	// not valid.
 	ErrUnsupportedScriptType = PsbtError.CodeWithDetail("ErrUnsupportedScriptType", "Unsupported script type")
+
+	// ErrDifferentTransactions indicates that PSBTs which were to be
+	// combined do not describe the same unsigned transaction.
+	ErrDifferentTransactions = PsbtError.CodeWithDetail("ErrDifferentTransactions",
+		"PSBTs do not have the same unsigned transaction")
 )
 
 // Unknown is a struct encapsulating a key-value pair for which the key type is
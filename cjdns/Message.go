@@ -63,7 +63,7 @@ func decode(bytes []byte) (Message, error) {
 	routeHeader := RouteHeader{}
 	routeHeader, err := routeHeader.parse(routeHeaderBytes)
 	if err != nil {
-		log.Errorf("Error parsing CJDNS message route header: ", err)
+		log.Errorf("Error parsing CJDNS message route header: %v", err)
 	}
 
 	var dataHeaderBytes []byte = nil
@@ -73,11 +73,11 @@ func decode(bytes []byte) (Message, error) {
 		x += DataHeaderSize
 		dataHeader, err = dataHeader.parse(dataHeaderBytes)
 		if err != nil {
-			log.Errorf("Error parsing CJDNS message data header: ", err)
+			log.Errorf("Error parsing CJDNS message data header: %v", err)
 		}
 	}
 	if x > len(bytes) {
-		log.Errorf("Error parsing CJDNS message, message not long enough to be decoded: ", err)
+		log.Errorf("Error parsing CJDNS message, message not long enough to be decoded: %v", err)
 		return Message{}, err
 	}
 	dataBytes := bytes[x:]
@@ -96,7 +96,7 @@ func (rh *RouteHeader) parse(hdrBytes []byte) (RouteHeader, error) {
 
 	if err != nil {
 		switchHeader = SwitchHeader{}
-		log.Errorf("Error parsing CJDNS message switch header: ", err)
+		log.Errorf("Error parsing CJDNS message switch header: %v", err)
 	}
 	var ip net.IP = nil
 	if !isCtrl {
@@ -126,7 +126,7 @@ func isAllZero(bytes []byte) bool {
 func stringToKeyBytes(key string) []byte {
 	bytes, err := Base32_decode(strings.TrimSuffix(key, ".k"))
 	if err != nil {
-		log.Errorf("Error decoding key: ", err)
+		log.Errorf("Error decoding key: %v", err)
 		return nil
 	} else {
 		return bytes
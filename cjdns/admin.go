@@ -0,0 +1,303 @@
+package cjdns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zeebo/bencode"
+)
+
+// The admin API of cjdns is reached through its socket (cjdroute.sock). Each
+// call is a bencoded dictionary {"q": <function>, "args": {...}, "txid": ...}
+// and cjdns answers with a dictionary which has the same txid, and an "error"
+// which is "none" when the call succeeded. Functions which return lists are
+// paged, the response has "more" when there is another page.
+
+const adminTimeout = 5 * time.Second
+
+// Admin is a client of the cjdns admin API, calls are made one at a time.
+type Admin struct {
+	mu      sync.Mutex
+	path    string
+	conn    net.Conn
+	dec     *bencode.Decoder
+	timeout time.Duration
+}
+
+// NodeInfo is the result of Core_nodeInfo.
+type NodeInfo struct {
+	MyIp6          string `bencode:"myIp6"`
+	MyAddr         string `bencode:"myAddr"`
+	EncodingScheme []struct {
+		BitCount  int64  `bencode:"bitCount"`
+		Prefix    string `bencode:"prefix"`
+		PrefixLen int64  `bencode:"prefixLen"`
+	} `bencode:"encodingScheme"`
+}
+
+// PeerStat is one peer in the result of InterfaceController_peerStats.
+type PeerStat struct {
+	Addr               string `bencode:"addr"`
+	Lladdr             string `bencode:"lladdr"`
+	State              string `bencode:"state"`
+	User               string `bencode:"user"`
+	BytesIn            int64  `bencode:"bytesIn"`
+	BytesOut           int64  `bencode:"bytesOut"`
+	RecvKbps           int64  `bencode:"recvKbps"`
+	SendKbps           int64  `bencode:"sendKbps"`
+	IsIncoming         int64  `bencode:"isIncoming"`
+	Duplicates         int64  `bencode:"duplicates"`
+	LostPackets        int64  `bencode:"lostPackets"`
+	ReceivedOutOfRange int64  `bencode:"receivedOutOfRange"`
+	Last               int64  `bencode:"last"`
+}
+
+// Handler is a port which receives the messages of a content type, from
+// UpperDistributor_listHandlers.
+type Handler struct {
+	Type    int64 `bencode:"type"`
+	UdpPort int64 `bencode:"udpPort"`
+}
+
+// PingResult is the result of SwitchPinger_ping and RouterModule_pingNode.
+type PingResult struct {
+	Result  string `bencode:"result"`
+	Ms      int64  `bencode:"ms"`
+	Path    string `bencode:"path"`
+	Addr    string `bencode:"addr"`
+	Version int64  `bencode:"version"`
+}
+
+type adminError struct {
+	Error string `bencode:"error"`
+	Txid  string `bencode:"txid"`
+	More  int64  `bencode:"more"`
+}
+
+// DialAdmin connects to the admin socket of cjdns, the connection is made again
+// if it breaks.
+func DialAdmin(path string) (*Admin, error) {
+	a := &Admin{path: path, timeout: adminTimeout}
+	if err := a.dial(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// NewAdmin makes calls over a connection which is already open.
+func NewAdmin(conn net.Conn) *Admin {
+	return &Admin{conn: conn, dec: bencode.NewDecoder(conn), timeout: adminTimeout}
+}
+
+func (a *Admin) dial() error {
+	conn, err := net.Dial("unix", a.path)
+	if err != nil {
+		return err
+	}
+	a.conn = conn
+	a.dec = bencode.NewDecoder(conn)
+	return nil
+}
+
+// Close closes the connection to cjdns.
+func (a *Admin) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.conn == nil {
+		return nil
+	}
+	err := a.conn.Close()
+	a.conn = nil
+	return err
+}
+
+// Call calls a function of the admin API and decodes the response into res,
+// which may be nil. An error is returned if cjdns reports one.
+func (a *Admin) Call(q string, args map[string]interface{}, res interface{}) error {
+	_, err := a.call(q, args, res)
+	return err
+}
+
+func (a *Admin) call(q string, args map[string]interface{}, res interface{}) (*adminError, error) {
+	txid := strconv.Itoa(generateRandomNumber())
+	msg := map[string]interface{}{"q": q, "txid": txid}
+	if args != nil {
+		msg["args"] = args
+	}
+	bytes, err := bencode.EncodeBytes(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.conn == nil {
+		if a.path == "" {
+			return nil, errors.New("cjdns admin connection is closed")
+		} else if err := a.dial(); err != nil {
+			return nil, err
+		}
+	}
+	raw, err := a.roundTrip(bytes, txid)
+	if err != nil {
+		// The stream may be left in the middle of a response.
+		a.conn.Close()
+		a.conn = nil
+		return nil, fmt.Errorf("cjdns %s: %v", q, err)
+	}
+	var head adminError
+	if err := bencode.DecodeBytes(raw, &head); err != nil {
+		return nil, fmt.Errorf("cjdns %s: %v", q, err)
+	} else if head.Error != "" && head.Error != "none" {
+		return nil, fmt.Errorf("cjdns %s: %s", q, head.Error)
+	}
+	if res != nil {
+		if err := bencode.DecodeBytes(raw, res); err != nil {
+			return nil, fmt.Errorf("cjdns %s: %v", q, err)
+		}
+	}
+	return &head, nil
+}
+
+// roundTrip writes a call and reads responses until the one with its txid,
+// responses to earlier calls which timed out are dropped.
+func (a *Admin) roundTrip(msg []byte, txid string) (bencode.RawMessage, error) {
+	deadline := time.Now().Add(a.timeout)
+	a.conn.SetDeadline(deadline)
+	if _, err := a.conn.Write(msg); err != nil {
+		return nil, err
+	}
+	for {
+		var raw bencode.RawMessage
+		if err := a.dec.Decode(&raw); err != nil {
+			if e, ok := err.(net.Error); ok && e.Timeout() {
+				return nil, errors.New("timeout waiting for a response")
+			}
+			return nil, err
+		}
+		var head adminError
+		if err := bencode.DecodeBytes(raw, &head); err != nil {
+			return nil, err
+		} else if head.Txid == txid {
+			return raw, nil
+		}
+	}
+}
+
+// Ping checks that cjdns answers.
+func (a *Admin) Ping() error {
+	var res struct {
+		Q string `bencode:"q"`
+	}
+	if err := a.Call("ping", nil, &res); err != nil {
+		return err
+	} else if res.Q != "pong" {
+		return fmt.Errorf("cjdns ping: unexpected response [%s]", res.Q)
+	}
+	return nil
+}
+
+// NodeInfo returns the address and the key of this node.
+func (a *Admin) NodeInfo() (*NodeInfo, error) {
+	var res NodeInfo
+	if err := a.Call("Core_nodeInfo", nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// PeerStats returns the peers of this node, from all pages.
+func (a *Admin) PeerStats() ([]PeerStat, error) {
+	var out []PeerStat
+	for page := 0; ; page++ {
+		var res struct {
+			Peers []PeerStat `bencode:"peers"`
+		}
+		head, err := a.call("InterfaceController_peerStats", map[string]interface{}{"page": page}, &res)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, res.Peers...)
+		if head.More == 0 {
+			return out, nil
+		}
+	}
+}
+
+// DisconnectPeer drops the peering with the node which has this key.
+func (a *Admin) DisconnectPeer(pubkey string) error {
+	return a.Call("InterfaceController_disconnectPeer", map[string]interface{}{"pubkey": pubkey}, nil)
+}
+
+// Lookup returns the path to a node, from its IPv6 address.
+func (a *Admin) Lookup(addr string) (string, error) {
+	var res struct {
+		Result string `bencode:"result"`
+	}
+	if err := a.Call("RouterModule_lookup", map[string]interface{}{"address": addr}, &res); err != nil {
+		return "", err
+	}
+	return res.Result, nil
+}
+
+// PingNode pings a node through the router, by IPv6 address or path.
+func (a *Admin) PingNode(path string, timeoutMs int64) (*PingResult, error) {
+	args := map[string]interface{}{"path": path}
+	if timeoutMs > 0 {
+		args["timeout"] = timeoutMs
+	}
+	var res PingResult
+	if err := a.Call("RouterModule_pingNode", args, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// SwitchPing pings the switch of the node at the end of a path.
+func (a *Admin) SwitchPing(path string, timeoutMs int64) (*PingResult, error) {
+	args := map[string]interface{}{"path": path}
+	if timeoutMs > 0 {
+		args["timeout"] = timeoutMs
+	}
+	var res PingResult
+	if err := a.Call("SwitchPinger_ping", args, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ListHandlers returns the ports which receive messages from cjdns, from all
+// pages.
+func (a *Admin) ListHandlers() ([]Handler, error) {
+	var out []Handler
+	for page := 0; ; page++ {
+		var res struct {
+			Handlers []Handler `bencode:"handlers"`
+		}
+		head, err := a.call("UpperDistributor_listHandlers", map[string]interface{}{"page": page}, &res)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, res.Handlers...)
+		if head.More == 0 {
+			return out, nil
+		}
+	}
+}
+
+// RegisterHandler sends the messages of a content type to a UDP port.
+func (a *Admin) RegisterHandler(contentType, udpPort int64) error {
+	return a.Call("UpperDistributor_registerHandler", map[string]interface{}{
+		"contentType": contentType,
+		"udpPort":     udpPort,
+	}, nil)
+}
+
+// UnregisterHandler stops sending messages to a UDP port.
+func (a *Admin) UnregisterHandler(udpPort int64) error {
+	return a.Call("UpperDistributor_unregisterHandler", map[string]interface{}{"udpPort": udpPort}, nil)
+}
@@ -0,0 +1,87 @@
+package cjdns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
+)
+
+// TestAdmin makes the calls of the admin API against the fake cjdns, through
+// the client and through the endpoints.
+func TestAdmin(t *testing.T) {
+	f, admin := newFakeAdmin(t)
+	c := newCjdns(admin, &apiv1.Apiv1{})
+	c.Device = "tun7"
+	var keys []string
+	for i := byte(1); i <= 3; i++ {
+		keys = append(keys, f.addPeer(t, i, int64(i)*1000, int64(i)*2000))
+	}
+
+	if err := admin.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	info, err := c.nodeInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Ipv6 != f.ip6 || info.Version != 21 || info.Device != "tun7" || !strings.HasSuffix(f.addr, info.Pubkey) {
+		t.Fatalf("unexpected node info %v", info)
+	}
+
+	// Three peers come in two pages.
+	peers, err := c.peerStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers.Peers) != 3 || peers.Peers[2].Pubkey != keys[2] || peers.Peers[2].BytesOut != 6000 ||
+		!peers.Peers[0].Incoming || peers.Peers[1].Incoming {
+		t.Fatalf("unexpected peers %v", peers)
+	}
+
+	path, err := c.lookup(&rpc_pb.CjdnsLookupRequest{CjdnsAddr: peers.Peers[1].Ipv6})
+	if err != nil || path.Path != "0000.0000.0000.0012" {
+		t.Fatalf("unexpected lookup %v %v", path, err)
+	}
+	if _, err := c.lookup(&rpc_pb.CjdnsLookupRequest{CjdnsAddr: "fc00::1"}); err == nil ||
+		!strings.Contains(err.Message(), "not found") {
+		t.Fatalf("expected the error of cjdns, got %v", err)
+	}
+	pong, err := c.switchPing(&rpc_pb.CjdnsSwitchPingRequest{Path: path.Path})
+	if err != nil || pong.Result != "pong" || pong.Version != 21 {
+		t.Fatalf("unexpected switch ping %v %v", pong, err)
+	}
+	if res, err := c.Ping(peers.Peers[0].Ipv6); err != nil || !strings.HasPrefix(res, peers.Peers[0].Ipv6) {
+		t.Fatalf("unexpected ping %v %v", res, err)
+	}
+
+	if err := c.RegisterHandler(ContentType_RESERVED, 4000); err != nil {
+		t.Fatal(err)
+	}
+	handlers, err := c.handlers()
+	if err != nil || len(handlers.Handlers) != 1 || handlers.Handlers[0].ContentType != ContentType_RESERVED {
+		t.Fatalf("unexpected handlers %v %v", handlers, err)
+	}
+	if err := c.UnregisterHandler(4000); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.UnregisterHandler(4000); err == nil {
+		t.Fatalf("expected a missing handler not to be unregistered")
+	}
+
+	// A response to an earlier call is skipped.
+	f.mu.Lock()
+	f.stale = true
+	f.mu.Unlock()
+	if err := c.DisconnectPeer(keys[0]); err != nil {
+		t.Fatal(err)
+	}
+	nodes := c.GetNodes()
+	if len(nodes) != 2 || nodes[0].CjdnsPubKey != keys[1] {
+		t.Fatalf("expected the peer to be disconnected, got %v", nodes)
+	}
+	if err := admin.Call("Core_unknown", nil, nil); err == nil {
+		t.Fatalf("expected an unknown function to fail")
+	}
+}
@@ -0,0 +1,599 @@
+package cjdns
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// Bandwidth is sold between cjdns peers for Lightning micropayments. cjdns
+// counts the bytes which are sent to and received from each peer, the counters
+// are read periodically and each node charges its peers for the bytes which it
+// sent to them, at its own price per MB. A node which buys bandwidth asks each
+// peer for its price, and if it is not more than the highest price which it
+// agreed to pay, it meters the bytes which it receives from the peer at that
+// price. Every settle interval, the buyer asks the peer for an invoice of what
+// it owes and pays it, and the seller credits the peer once the invoice is
+// settled. A peer which owes more than the grace amount can be disconnected.
+//
+// Messages, sent with the same content type as the invoice requests:
+//
+//   {"q": "bw_price", "txid": ...}                 -> {"bw_price": <msat per MB>, "txid": ...}
+//   {"q": "bw_invoice_req", "amt_msat": ..., ...}  -> an invoice response, or an error
+
+const (
+	CutoffNone       = ""
+	CutoffDisconnect = "disconnect"
+
+	bytesPerMB = 1000000
+
+	bandwidthMemo    = "cjdns bandwidth"
+	bandwidthTick    = 10 * time.Second
+	bandwidthTimeout = 30 * time.Second
+
+	defaultSettleIntervalSec = 60
+	defaultMinSettleMsat     = 1000
+	defaultGraceMsat         = 10000
+
+	// Invoices which a peer may have pending at once
+	maxPendingInvoices = 5
+
+	peerStateEstablished = "ESTABLISHED"
+)
+
+type BandwidthPriceRequest struct {
+	CjdnsAddr   string
+	CjdnsPubKey string
+	Txid        string
+}
+
+type BandwidthPriceResponse struct {
+	CjdnsAddr string
+	Price     uint64
+}
+
+type BandwidthInvoiceRequest struct {
+	CjdnsAddr   string
+	CjdnsPubKey string
+	Txid        string
+	AmountMsat  uint64
+}
+
+type bwPeer struct {
+	rec *rpc_pb.CjdnsBandwidthPeer
+
+	// The counters of cjdns at the last reading, they restart from zero when
+	// the peer reconnects.
+	metered    bool
+	counterIn  uint64
+	counterOut uint64
+
+	// Bytes times msat per MB which were not charged yet because they are
+	// worth less than one msat.
+	chargeRem uint64
+	owedRem   uint64
+
+	// Invoices issued to the peer which are not settled, by payment hash, and
+	// the number of invoices which are being created.
+	invoices map[string]uint64
+	reserved int
+}
+
+type bandwidth struct {
+	mu         sync.Mutex
+	cfg        *rpc_pb.CjdnsBandwidthConfig
+	peers      map[string]*bwPeer
+	waiting    map[string]chan InvoiceResponse
+	lastSettle time.Time
+}
+
+func newBandwidth() *bandwidth {
+	cfg, _ := bandwidthDefaults(&rpc_pb.CjdnsBandwidthConfig{})
+	return &bandwidth{
+		cfg:     cfg,
+		peers:   make(map[string]*bwPeer),
+		waiting: make(map[string]chan InvoiceResponse),
+	}
+}
+
+func bandwidthDefaults(in *rpc_pb.CjdnsBandwidthConfig) (*rpc_pb.CjdnsBandwidthConfig, er.R) {
+	cfg := proto.Clone(in).(*rpc_pb.CjdnsBandwidthConfig)
+	if cfg.SettleIntervalSec == 0 {
+		cfg.SettleIntervalSec = defaultSettleIntervalSec
+	}
+	if cfg.MinSettleMsat == 0 {
+		cfg.MinSettleMsat = defaultMinSettleMsat
+	}
+	if cfg.GraceMsat == 0 {
+		cfg.GraceMsat = defaultGraceMsat
+	}
+	if cfg.Cutoff != CutoffNone && cfg.Cutoff != CutoffDisconnect {
+		return nil, er.Errorf("unknown cutoff [%s], expecting \"\" or \"%s\"", cfg.Cutoff, CutoffDisconnect)
+	}
+	return cfg, nil
+}
+
+// normalizeAddr returns the canonical form of a cjdns IPv6 address, so the
+// address derived from a key matches the one in a route header.
+func normalizeAddr(addr string) string {
+	if ip := net.ParseIP(strings.Trim(addr, "[]")); ip != nil {
+		return ip.String()
+	}
+	return addr
+}
+
+// peerKey returns the address and the key of a peer from its cjdns address,
+// which looks like v21.0000.0000.0000.0013.<key>.k
+func peerKey(peer *CjdnsPeer) (string, string, error) {
+	parts := strings.Split(peer.addr, ".")
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("invalid cjdns peer address [%s]", peer.addr)
+	}
+	pubkey := strings.Join(parts[len(parts)-2:], ".")
+	ip, err := publicToIp6(pubkey)
+	if err != nil {
+		return "", "", err
+	}
+	return normalizeAddr(ip), pubkey, nil
+}
+
+func (b *bandwidth) peer(addr string) *bwPeer {
+	p, ok := b.peers[addr]
+	if !ok {
+		p = &bwPeer{
+			rec:      &rpc_pb.CjdnsBandwidthPeer{CjdnsAddr: addr},
+			invoices: make(map[string]uint64),
+		}
+		b.peers[addr] = p
+	}
+	return p
+}
+
+// charge converts bytes to msat at a price per MB, keeping the remainder.
+func charge(bytes, price uint64, rem *uint64) uint64 {
+	*rem += bytes * price
+	msat := *rem / bytesPerMB
+	*rem %= bytesPerMB
+	return msat
+}
+
+// meter adds the traffic which cjdns counted since the last reading. The
+// first reading of a peer is only a baseline, traffic before metering began is
+// not charged.
+func (b *bandwidth) meter(peers []CjdnsPeer) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i := range peers {
+		addr, pubkey, err := peerKey(&peers[i])
+		if err != nil {
+			log.Debugf("Skipping cjdns peer [%s]: %v", peers[i].addr, err)
+			continue
+		}
+		in, out := peers[i].bytesIn, peers[i].bytesOut
+		p := b.peer(addr)
+		if !p.metered {
+			p.metered = true
+			p.counterIn, p.counterOut = in, out
+		}
+		p.rec.CjdnsPubkey = pubkey
+		p.rec.State = peers[i].state
+		dIn, dOut := in-p.counterIn, out-p.counterOut
+		if in < p.counterIn || out < p.counterOut {
+			// The peer reconnected and the counters restarted.
+			dIn, dOut = in, out
+		}
+		p.counterIn, p.counterOut = in, out
+		p.rec.BytesIn += dIn
+		p.rec.BytesOut += dOut
+		p.rec.ChargedMsat += charge(dOut, b.cfg.PriceMsatPerMb, &p.chargeRem)
+		p.rec.OwedMsat += charge(dIn, p.rec.BuyPriceMsatPerMb, &p.owedRem)
+	}
+}
+
+// unpaid is what the peer was charged and did not pay.
+func (p *bwPeer) unpaid() uint64 {
+	if p.rec.ReceivedMsat >= p.rec.ChargedMsat {
+		return 0
+	}
+	return p.rec.ChargedMsat - p.rec.ReceivedMsat
+}
+
+// due is what we owe the peer and did not pay.
+func (p *bwPeer) due() uint64 {
+	if p.rec.PaidMsat >= p.rec.OwedMsat {
+		return 0
+	}
+	return p.rec.OwedMsat - p.rec.PaidMsat
+}
+
+// overdue tells whether the peer owes more than the grace and should be cut
+// off.
+func (b *bandwidth) overdue(p *bwPeer) bool {
+	return b.cfg.Cutoff != CutoffNone && p.unpaid() > b.cfg.GraceMsat
+}
+
+// setPeerPrice records the price of a peer, which we pay only if it is not more
+// than our maximum.
+func (b *bandwidth) setPeerPrice(addr string, price uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p := b.peer(normalizeAddr(addr))
+	if b.cfg.MaxPriceMsatPerMb == 0 || price > b.cfg.MaxPriceMsatPerMb {
+		if price > 0 && p.rec.BuyPriceMsatPerMb != 0 {
+			log.Infof("Not buying bandwidth from [%s] at [%d] msat per MB", addr, price)
+		}
+		price = 0
+	}
+	p.rec.BuyPriceMsatPerMb = price
+}
+
+// reserveInvoice reserves an invoice for a peer, for what it asked to pay but
+// no more than what it owes and is not invoiced yet. It returns the amount of
+// the invoice, which must then be added or released.
+func (b *bandwidth) reserveInvoice(addr string, msat uint64) (uint64, er.R) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p := b.peer(normalizeAddr(addr))
+	if len(p.invoices)+p.reserved >= maxPendingInvoices {
+		return 0, er.Errorf("Too many pending invoices, at most [%d] are issued", maxPendingInvoices)
+	}
+	unpaid := p.unpaid()
+	if msat == 0 || unpaid <= p.rec.PendingMsat {
+		return 0, er.New("Nothing is owed")
+	}
+	if rest := unpaid - p.rec.PendingMsat; msat > rest {
+		msat = rest
+	}
+	p.reserved++
+	p.rec.PendingMsat += msat
+	return msat, nil
+}
+
+func (b *bandwidth) releaseInvoice(addr string, msat uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p := b.peer(normalizeAddr(addr))
+	p.reserved--
+	p.rec.PendingMsat -= msat
+}
+
+func (b *bandwidth) addInvoice(addr string, rHash []byte, msat uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p := b.peer(normalizeAddr(addr))
+	p.reserved--
+	p.invoices[hex.EncodeToString(rHash)] = msat
+}
+
+func (b *bandwidth) expect(txid string) chan InvoiceResponse {
+	ch := make(chan InvoiceResponse, 1)
+	b.mu.Lock()
+	b.waiting[txid] = ch
+	b.mu.Unlock()
+	return ch
+}
+
+// deliver passes an invoice response to the settlement which requested it,
+// it returns false if the response was not for a bandwidth invoice.
+func (b *bandwidth) deliver(res InvoiceResponse) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ch, ok := b.waiting[res.Txid]
+	if ok {
+		delete(b.waiting, res.Txid)
+		ch <- res
+	}
+	return ok
+}
+
+func (b *bandwidth) forget(txid string) {
+	b.mu.Lock()
+	delete(b.waiting, txid)
+	b.mu.Unlock()
+}
+
+func (b *bandwidth) setConfig(in *rpc_pb.CjdnsBandwidthConfig) (*rpc_pb.CjdnsBandwidthConfig, er.R) {
+	cfg, err := bandwidthDefaults(in)
+	if err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cfg = cfg
+	if cfg.MaxPriceMsatPerMb == 0 {
+		for _, p := range b.peers {
+			p.rec.BuyPriceMsatPerMb = 0
+		}
+	}
+	return cfg, nil
+}
+
+func (b *bandwidth) config() *rpc_pb.CjdnsBandwidthConfig {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return proto.Clone(b.cfg).(*rpc_pb.CjdnsBandwidthConfig)
+}
+
+func (b *bandwidth) list() *rpc_pb.CjdnsBandwidthPeers {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := &rpc_pb.CjdnsBandwidthPeers{}
+	for _, p := range b.peers {
+		out.Peers = append(out.Peers, proto.Clone(p.rec).(*rpc_pb.CjdnsBandwidthPeer))
+	}
+	sort.Slice(out.Peers, func(i, j int) bool {
+		return out.Peers[i].CjdnsAddr < out.Peers[j].CjdnsAddr
+	})
+	return out
+}
+
+func (b *bandwidth) status() *rpc_pb.CjdnsBandwidthStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	st := &rpc_pb.CjdnsBandwidthStatus{Config: proto.Clone(b.cfg).(*rpc_pb.CjdnsBandwidthConfig)}
+	for _, p := range b.peers {
+		st.BytesIn += p.rec.BytesIn
+		st.BytesOut += p.rec.BytesOut
+		st.ChargedMsat += p.rec.ChargedMsat
+		st.ReceivedMsat += p.rec.ReceivedMsat
+		st.OwedMsat += p.rec.OwedMsat
+		st.PaidMsat += p.rec.PaidMsat
+	}
+	return st
+}
+
+// sendMessage sends an encoded message through cjdns.
+func (c *Cjdns) sendMessage(data []byte) error {
+	conn, err := c.getSendConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write(data)
+	return err
+}
+
+func (c *Cjdns) sendBandwidthPriceQuery(addr, pubkey string) error {
+	txid := strconv.Itoa(generateRandomNumber()) + "/1"
+	return c.sendMessage(encodeMsg(map[string]interface{}{
+		"q":    "bw_price",
+		"txid": txid,
+	}, addr, pubkey))
+}
+
+func (c *Cjdns) sendBandwidthPrice(req BandwidthPriceRequest) error {
+	return c.sendMessage(encodeMsg(map[string]interface{}{
+		"bw_price": c.bw.config().PriceMsatPerMb,
+		"txid":     req.Txid,
+	}, req.CjdnsAddr, req.CjdnsPubKey))
+}
+
+// handleBandwidthInvoiceRequest issues an invoice to a peer which wants to pay
+// for bandwidth. The invoice is never for more than the peer owes, so a peer
+// which meters a little more than we do still gets an invoice.
+func (c *Cjdns) handleBandwidthInvoiceRequest(lnd lndRpcServer, req BandwidthInvoiceRequest) error {
+	invReq := InvoiceRequest{CjdnsAddr: req.CjdnsAddr, CjdnsPubKey: req.CjdnsPubKey, Txid: req.Txid}
+	if c.bw.config().PriceMsatPerMb == 0 {
+		return c.SendCjdnsInvoiceResponse(invReq, nil, &rpc_pb.RestError{Message: "Bandwidth is not sold"})
+	}
+	msat, errr := c.bw.reserveInvoice(req.CjdnsAddr, req.AmountMsat)
+	if errr != nil {
+		return c.SendCjdnsInvoiceResponse(invReq, nil, &rpc_pb.RestError{Message: errr.Message()})
+	}
+	res, err := lnd.LndAddInvoice(context.TODO(), &rpc_pb.Invoice{
+		Memo:      bandwidthMemo,
+		ValueMsat: int64(msat),
+	})
+	if err != nil {
+		c.bw.releaseInvoice(req.CjdnsAddr, msat)
+		log.Warnf("Unable to create a bandwidth invoice for [%s]: %v", req.CjdnsAddr, err)
+		return c.SendCjdnsInvoiceResponse(invReq, nil, &rpc_pb.RestError{Message: "Error creating invoice"})
+	}
+	c.bw.addInvoice(req.CjdnsAddr, res.RHash, msat)
+	return c.SendCjdnsInvoiceResponse(invReq, res, nil)
+}
+
+// collectInvoices credits the peers for the bandwidth invoices which were
+// settled.
+func (c *Cjdns) collectInvoices(lnd lndRpcServer) {
+	type pending struct {
+		addr  string
+		rHash string
+	}
+	var all []pending
+	c.bw.mu.Lock()
+	for addr, p := range c.bw.peers {
+		for h := range p.invoices {
+			all = append(all, pending{addr, h})
+		}
+	}
+	c.bw.mu.Unlock()
+
+	for _, inv := range all {
+		rHash, _ := hex.DecodeString(inv.rHash)
+		res, err := lnd.LndLookupInvoice(context.TODO(), &rpc_pb.PaymentHash{RHash: rHash})
+		if err != nil {
+			log.Warnf("Unable to look up bandwidth invoice [%s]: %v", inv.rHash, err)
+			continue
+		}
+		if res.State != rpc_pb.Invoice_SETTLED && res.State != rpc_pb.Invoice_CANCELED {
+			continue
+		}
+		c.bw.mu.Lock()
+		p := c.bw.peers[inv.addr]
+		msat := p.invoices[inv.rHash]
+		delete(p.invoices, inv.rHash)
+		p.rec.PendingMsat -= msat
+		if res.State == rpc_pb.Invoice_SETTLED {
+			p.rec.ReceivedMsat += uint64(res.AmtPaidMsat)
+			p.rec.LastSettledSec = time.Now().Unix()
+		}
+		c.bw.mu.Unlock()
+	}
+}
+
+// payBandwidth asks a peer for an invoice of what we owe it and pays it.
+func (c *Cjdns) payBandwidth(lnd lndRpcServer, addr, pubkey string, due uint64) er.R {
+	txid := strconv.Itoa(generateRandomNumber()) + "/1"
+	ch := c.bw.expect(txid)
+	defer c.bw.forget(txid)
+	if err := c.sendMessage(encodeMsg(map[string]interface{}{
+		"q":        "bw_invoice_req",
+		"amt_msat": due,
+		"txid":     txid,
+	}, addr, pubkey)); err != nil {
+		return er.E(err)
+	}
+	var res InvoiceResponse
+	select {
+	case res = <-ch:
+	case <-time.After(bandwidthTimeout):
+		return er.New("timeout waiting for an invoice")
+	case <-c.quit:
+		return er.New("shutting down")
+	}
+	if res.Error != "" {
+		return er.New(res.Error)
+	}
+	payReq, err := lnd.LndDecodePayReq(context.TODO(), &rpc_pb.PayReqString{PayReq: res.PaymentRequest})
+	if err != nil {
+		return err
+	} else if uint64(payReq.NumMsat) > due {
+		return er.Errorf("invoice of [%d] msat, only [%d] msat is owed", payReq.NumMsat, due)
+	}
+	sent, err := lnd.LndSendPaymentSync(context.TODO(), &rpc_pb.SendRequest{PaymentRequest: res.PaymentRequest})
+	if err != nil {
+		return err
+	} else if sent.PaymentError != "" {
+		return er.New(sent.PaymentError)
+	}
+	c.bw.mu.Lock()
+	p := c.bw.peer(addr)
+	p.rec.PaidMsat += uint64(payReq.NumMsat)
+	p.rec.LastSettledSec = time.Now().Unix()
+	c.bw.mu.Unlock()
+	return nil
+}
+
+type bwPeerRef struct {
+	addr   string
+	pubkey string
+	due    uint64
+}
+
+// overduePeers returns the connected peers which owe more than the grace.
+// They are returned every time they are connected, because cjdns re-peers
+// automatically after a disconnect.
+func (b *bandwidth) overduePeers() []bwPeerRef {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var out []bwPeerRef
+	for addr, p := range b.peers {
+		if !b.overdue(p) {
+			p.rec.CutOff = false
+			continue
+		}
+		if p.rec.State == peerStateEstablished && p.rec.CjdnsPubkey != "" {
+			out = append(out, bwPeerRef{addr, p.rec.CjdnsPubkey, p.due()})
+		}
+	}
+	return out
+}
+
+// cutOffPeers disconnects the peers which don't pay for bandwidth.
+func (c *Cjdns) cutOffPeers() {
+	for _, ref := range c.bw.overduePeers() {
+		log.Infof("Disconnecting cjdns peer [%s] which didn't pay for bandwidth", ref.addr)
+		err := c.DisconnectPeer(ref.pubkey)
+		c.bw.mu.Lock()
+		if err != nil {
+			c.bw.peers[ref.addr].rec.LastError = err.Error()
+		} else {
+			c.bw.peers[ref.addr].rec.CutOff = true
+		}
+		c.bw.mu.Unlock()
+	}
+}
+
+// settleBandwidth collects the payments of the peers and pays the peers which
+// we owe.
+func (c *Cjdns) settleBandwidth(lnd lndRpcServer) {
+	c.collectInvoices(lnd)
+
+	var pay, query []bwPeerRef
+	c.bw.mu.Lock()
+	cfg := c.bw.cfg
+	for addr, p := range c.bw.peers {
+		ref := bwPeerRef{addr, p.rec.CjdnsPubkey, p.due()}
+		if p.rec.State != peerStateEstablished || ref.pubkey == "" {
+			continue
+		}
+		if cfg.MaxPriceMsatPerMb > 0 {
+			// The price is asked again every time, in case it changed.
+			query = append(query, ref)
+			if ref.due >= cfg.MinSettleMsat {
+				pay = append(pay, ref)
+			}
+		}
+	}
+	c.bw.mu.Unlock()
+
+	for _, ref := range query {
+		if err := c.sendBandwidthPriceQuery(ref.addr, ref.pubkey); err != nil {
+			log.Debugf("Unable to ask [%s] for its bandwidth price: %v", ref.addr, err)
+		}
+	}
+	for _, ref := range pay {
+		err := c.payBandwidth(lnd, ref.addr, ref.pubkey, ref.due)
+		c.bw.mu.Lock()
+		if err != nil {
+			log.Infof("Unable to pay [%s] for bandwidth: %v", ref.addr, err)
+			c.bw.peers[ref.addr].rec.LastError = err.Message()
+		} else {
+			c.bw.peers[ref.addr].rec.LastError = ""
+		}
+		c.bw.mu.Unlock()
+	}
+}
+
+// bandwidthLoop meters the peers and cuts off those which don't pay at every
+// tick, and settles periodically, for as long as cjdns is running.
+func (c *Cjdns) bandwidthLoop(lnd lndRpcServer) {
+	t := time.NewTicker(bandwidthTick)
+	defer t.Stop()
+	for {
+		select {
+		case <-c.quit:
+			return
+		case <-t.C:
+		}
+		cfg := c.bw.config()
+		if cfg.PriceMsatPerMb == 0 && cfg.MaxPriceMsatPerMb == 0 {
+			continue
+		}
+		peers, err := c.PeerStats()
+		if err != nil {
+			log.Debugf("Unable to meter cjdns peers: %v", err)
+			continue
+		}
+		c.bw.meter(peers)
+		c.cutOffPeers()
+		interval := time.Duration(cfg.SettleIntervalSec) * time.Second
+		if time.Since(c.bw.lastSettle) < interval {
+			continue
+		}
+		c.bw.lastSettle = time.Now()
+		c.settleBandwidth(lnd)
+	}
+}
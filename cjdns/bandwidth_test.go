@@ -0,0 +1,158 @@
+package cjdns
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+)
+
+// testBandwidthPeer returns the address and the key of a peer, and a function
+// which makes its cjdns stats.
+func testBandwidthPeer(t *testing.T) (string, string, func(in, out uint64) []CjdnsPeer) {
+	key := make([]byte, 32)
+	key[0] = 1
+	pubkey := Base32_encode(key) + ".k"
+	ip, err := publicToIp6(pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ip, pubkey, func(in, out uint64) []CjdnsPeer {
+		return []CjdnsPeer{{
+			addr:     "v21.0000.0000.0000.0013." + pubkey,
+			state:    peerStateEstablished,
+			bytesIn:  in,
+			bytesOut: out,
+		}}
+	}
+}
+
+// TestBandwidthMeter meters a peer across a reconnection, as a seller and as a
+// buyer, and checks when it is overdue.
+func TestBandwidthMeter(t *testing.T) {
+	ip, pubkey, stats := testBandwidthPeer(t)
+
+	b := newBandwidth()
+	if _, err := b.setConfig(&rpc_pb.CjdnsBandwidthConfig{Cutoff: "throttle"}); err == nil {
+		t.Fatalf("expected an unknown cutoff to be rejected")
+	}
+	if _, err := b.setConfig(&rpc_pb.CjdnsBandwidthConfig{
+		PriceMsatPerMb:    2000,
+		MaxPriceMsatPerMb: 1000,
+		GraceMsat:         1000,
+		Cutoff:            CutoffDisconnect,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first reading is a baseline.
+	b.meter(stats(3000000, 5000000))
+	b.meter(stats(3000000, 5500000))
+	// The counters restart when the peer reconnects.
+	b.meter(stats(0, 250000))
+	p := b.peers[normalizeAddr(ip)]
+	if p == nil || p.rec.CjdnsPubkey != pubkey {
+		t.Fatalf("expected the peer to be metered by address, got %v", b.list())
+	}
+	if p.rec.BytesOut != 750000 || p.rec.ChargedMsat != 1500 || p.rec.OwedMsat != 0 {
+		t.Fatalf("expected 750000 bytes charged 1500 msat, got %v", p.rec)
+	}
+	if !b.overdue(p) {
+		t.Fatalf("expected a peer which owes more than the grace to be overdue")
+	}
+	p.rec.ReceivedMsat = 1000
+	if b.overdue(p) {
+		t.Fatalf("expected a peer which owes less than the grace not to be overdue")
+	}
+
+	// A price above the maximum is refused.
+	b.setPeerPrice(ip, 1500)
+	b.meter(stats(1000000, 250000))
+	if p.rec.OwedMsat != 0 {
+		t.Fatalf("expected nothing to be owed above the maximum price, got %v", p.rec)
+	}
+	b.setPeerPrice(ip, 800)
+	b.meter(stats(2500000, 250000))
+	if p.rec.BuyPriceMsatPerMb != 800 || p.rec.OwedMsat != 1200 || p.due() != 1200 {
+		t.Fatalf("expected 1200 msat to be owed, got %v", p.rec)
+	}
+
+	st := b.status()
+	if len(b.list().Peers) != 1 || st.BytesIn != 2500000 || st.ChargedMsat != 1500 || st.OwedMsat != 1200 {
+		t.Fatalf("unexpected totals %v", st)
+	}
+}
+
+// TestBandwidthCutoff checks that an overdue peer is cut off again each time it
+// reconnects, until it pays.
+func TestBandwidthCutoff(t *testing.T) {
+	ip, pubkey, stats := testBandwidthPeer(t)
+	b := newBandwidth()
+	if _, err := b.setConfig(&rpc_pb.CjdnsBandwidthConfig{
+		PriceMsatPerMb: 2000,
+		GraceMsat:      1000,
+		Cutoff:         CutoffDisconnect,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	b.meter(stats(0, 0))
+	b.meter(stats(0, 1000000))
+	p := b.peers[normalizeAddr(ip)]
+	for i := 0; i < 2; i++ {
+		if refs := b.overduePeers(); len(refs) != 1 || refs[0].pubkey != pubkey {
+			t.Fatalf("expected the overdue peer to be cut off, got %v", refs)
+		}
+		// It was disconnected and cjdns peered with it again.
+		p.rec.CutOff = true
+		b.meter(stats(0, 0))
+	}
+	p.rec.State = "UNRESPONSIVE"
+	if refs := b.overduePeers(); len(refs) != 0 {
+		t.Fatalf("expected a peer which is not connected to be left alone, got %v", refs)
+	}
+	p.rec.ReceivedMsat = 2000
+	if refs := b.overduePeers(); len(refs) != 0 || p.rec.CutOff {
+		t.Fatalf("expected a peer which paid not to be cut off, got %v", p.rec)
+	}
+}
+
+// TestBandwidthInvoiceLimit checks that the invoices issued to a peer are not
+// for more than it owes and that there are not too many of them.
+func TestBandwidthInvoiceLimit(t *testing.T) {
+	ip, _, stats := testBandwidthPeer(t)
+	b := newBandwidth()
+	if _, err := b.setConfig(&rpc_pb.CjdnsBandwidthConfig{PriceMsatPerMb: 2000}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.reserveInvoice(ip, 100); err == nil {
+		t.Fatalf("expected an invoice to be refused when nothing is owed")
+	}
+	b.meter(stats(0, 0))
+	b.meter(stats(0, 1000000))
+
+	msat, err := b.reserveInvoice(ip, 1500)
+	if err != nil || msat != 1500 {
+		t.Fatalf("expected an invoice of 1500 msat, got %d %v", msat, err)
+	}
+	b.addInvoice(ip, []byte{1}, msat)
+	if msat, err = b.reserveInvoice(ip, 1000); err != nil || msat != 500 {
+		t.Fatalf("expected the invoice to be cut to 500 msat, got %d %v", msat, err)
+	}
+	b.releaseInvoice(ip, msat)
+	if _, err := b.reserveInvoice(ip, 0); err == nil {
+		t.Fatalf("expected an invoice of nothing to be refused")
+	}
+
+	b.meter(stats(0, 10000000))
+	for i := 1; i < maxPendingInvoices; i++ {
+		if _, err := b.reserveInvoice(ip, 100); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := b.reserveInvoice(ip, 100); err == nil {
+		t.Fatalf("expected more than %d pending invoices to be refused", maxPendingInvoices)
+	}
+	p := b.peers[normalizeAddr(ip)]
+	if p.rec.PendingMsat != 1500+100*(maxPendingInvoices-1) {
+		t.Fatalf("unexpected pending amount %v", p.rec)
+	}
+}
@@ -6,9 +6,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
-	"strings"
 	"sync"
-	"time"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
@@ -19,15 +17,19 @@ import (
 
 type Cjdns struct {
 	SocketPath          string
-	Socket              net.Conn
+	admin               *Admin
 	Device              string
 	IPv6                string
 	ListeningPort       int
 	ListenConn          *net.UDPConn
 	api                 apiv1.Apiv1
 	lndPeers            []CjdnsNode
-	socketLock          sync.Mutex
 	invoiceResponseChan chan InvoiceResponse
+	bw                  *bandwidth
+	pr                  *peering
+	lndPubkeyLock       sync.Mutex
+	lndPubkeyWaiting    map[string]chan LndPubkeyResponse
+	quit                chan struct{}
 }
 
 type LndPubkeyRequest struct {
@@ -63,9 +65,11 @@ type InvoiceResponse struct {
 }
 
 type CjdnsPeer struct {
-	addr   string
-	lladdr string
-	state  string
+	addr     string
+	lladdr   string
+	state    string
+	bytesIn  uint64
+	bytesOut uint64
 }
 
 type CjdnsMessage struct {
@@ -73,6 +77,9 @@ type CjdnsMessage struct {
 	invoiceRes InvoiceResponse
 	lndPubReq  LndPubkeyRequest
 	lndPubRes  LndPubkeyResponse
+	bwPriceReq BandwidthPriceRequest
+	bwPriceRes BandwidthPriceResponse
+	bwInvReq   BandwidthInvoiceRequest
 }
 
 // var CjdnsInvoiceResponse InvoiceResponse
@@ -83,44 +90,63 @@ type lndRpcServer interface {
 	LndListPeers(ctx context.Context, in *rpc_pb.ListPeersRequest) (*rpc_pb.ListPeersResponse, er.R)
 	LndConnectPeer(ctx context.Context, in *rpc_pb.ConnectPeerRequest) (*rpc_pb.Null, er.R)
 	LndAddInvoice(ctx context.Context, in *rpc_pb.Invoice) (*rpc_pb.AddInvoiceResponse, er.R)
+	LndLookupInvoice(ctx context.Context, in *rpc_pb.PaymentHash) (*rpc_pb.Invoice, er.R)
+	LndDecodePayReq(ctx context.Context, in *rpc_pb.PayReqString) (*rpc_pb.PayReq, er.R)
+	LndSendPaymentSync(ctx context.Context, in *rpc_pb.SendRequest) (*rpc_pb.SendResponse, er.R)
+	LndListChannels(ctx context.Context, in *rpc_pb.ListChannelsRequest) (*rpc_pb.ListChannelsResponse, er.R)
+	LndPendingChannels(ctx context.Context, in *rpc_pb.Null) (*rpc_pb.PendingChannelsResponse, er.R)
+	LndWalletBalance(ctx context.Context, in *rpc_pb.Null) (*rpc_pb.WalletBalanceResponse, er.R)
+	LndOpenChannelSync(ctx context.Context, in *rpc_pb.OpenChannelRequest) (*rpc_pb.ChannelPoint, er.R)
 	LndPeerPort() int
 	LndIdentityPubkey() string
 }
 
 func NewCjdnsHandler(socket string, api *apiv1.Apiv1) (*Cjdns, er.R) {
 	log.Infof("Starting CJDNS message handler...")
-	//TODO: check if cjdns is running and if tun0 is up
-	device := "tun0"
-	ipv6, err := getDeviceAddr(device)
+	admin, err := DialAdmin(socket)
 	if err != nil {
-		return nil, er.New("Error getting CJDNS address at device tun0")
+		return nil, er.New("Error connecting to CJDNS socket")
 	}
-
-	conn, err := net.Dial("unix", socket)
+	info, err := admin.NodeInfo()
 	if err != nil {
-		return nil, er.New("Error connecting to CJDNS socket")
+		admin.Close()
+		return nil, er.Errorf("Error getting CJDNS node info: %v", err)
 	}
-
-	invoiceResponseChan := make(chan InvoiceResponse)
-	cjdns := Cjdns{
-		Device:              device,
-		IPv6:                ipv6,
-		SocketPath:          socket,
-		Socket:              conn,
-		api:                 *api,
-		invoiceResponseChan: invoiceResponseChan,
+	device, err := findDevice(info.MyIp6)
+	if err != nil {
+		admin.Close()
+		return nil, er.Errorf("Error finding the CJDNS device with address %s: %v", info.MyIp6, err)
 	}
+
+	cjdns := newCjdns(admin, api)
+	cjdns.SocketPath = socket
+	cjdns.Device = device
+	cjdns.IPv6 = info.MyIp6
 	// Unregister all handlers
 	udpPorts, _ := cjdns.ListHandlers()
 	for _, port := range udpPorts {
 		cjdns.UnregisterHandler(port)
 	}
-	log.Infof("CJDNS message handler initialized successfully")
-	return &cjdns, nil
+	log.Infof("CJDNS message handler initialized successfully on %s", device)
+	return cjdns, nil
+}
+
+func newCjdns(admin *Admin, api *apiv1.Apiv1) *Cjdns {
+	return &Cjdns{
+		admin:               admin,
+		api:                 *api,
+		invoiceResponseChan: make(chan InvoiceResponse),
+		bw:                  newBandwidth(),
+		pr:                  newPeering(),
+		lndPubkeyWaiting:    make(map[string]chan LndPubkeyResponse),
+		quit:                make(chan struct{}),
+	}
 }
 
 func (c *Cjdns) Start(lnd lndRpcServer) er.R {
-	c.registerRpc()
+	c.registerRpc(lnd)
+	go c.bandwidthLoop(lnd)
+	go c.peeringLoop(lnd)
 
 	go func() {
 		for {
@@ -164,7 +190,9 @@ func (c *Cjdns) Start(lnd lndRpcServer) er.R {
 				}
 			} else if cjdnsMessage.invoiceRes.Txid != "" {
 				log.Tracef("CJDNS invoice response: %v", cjdnsMessage.invoiceRes)
-				c.invoiceResponseChan <- cjdnsMessage.invoiceRes
+				if !c.bw.deliver(cjdnsMessage.invoiceRes) {
+					c.invoiceResponseChan <- cjdnsMessage.invoiceRes
+				}
 			} else if cjdnsMessage.lndPubReq.CjdnsAddr != "" {
 				log.Tracef("CJDNS lnd pubkey request: %s", cjdnsMessage.lndPubReq.CjdnsAddr)
 				idPubHex := lnd.LndIdentityPubkey()
@@ -172,69 +200,35 @@ func (c *Cjdns) Start(lnd lndRpcServer) er.R {
 				c.SendLndPubkeyResponse(idPubHex, cjdnsMessage.lndPubReq, port)
 			} else if cjdnsMessage.lndPubRes.lndPubkey != "" {
 				log.Tracef("CJDNS lnd pubkey response with pubkey: %s for: %s", cjdnsMessage.lndPubRes.lndPubkey, cjdnsMessage.lndPubRes.CjdnsAddr)
-				lndAddress := rpc_pb.LightningAddress{
-					Pubkey: cjdnsMessage.lndPubRes.lndPubkey,
-					Host:   cjdnsMessage.lndPubRes.CjdnsAddr,
+				c.deliverLndPubkey(cjdnsMessage.lndPubRes)
+				c.connectLnd(lnd, cjdnsMessage.lndPubRes)
+			} else if cjdnsMessage.bwPriceReq.CjdnsAddr != "" {
+				if err := c.sendBandwidthPrice(cjdnsMessage.bwPriceReq); err != nil {
+					log.Warnf("Error sending bandwidth price: %v", err)
 				}
-				connectRequest := &rpc_pb.ConnectPeerRequest{
-					Addr: &lndAddress,
-				}
-				log.Infof("Connecting to LND peer...: %s", connectRequest.Addr)
-				_, err := lnd.LndConnectPeer(context.TODO(), connectRequest)
-				if err != nil {
-					if !strings.Contains(err.Message(), "already connected") {
-						log.Warnf("Error connecting to LND peer: %s", err.Message())
-					} else {
-						log.Infof("Already connected to LND peer: %s", cjdnsMessage.lndPubRes.CjdnsAddr)
-					}
-				} else {
-					log.Infof("Connected to LND peer: %s", cjdnsMessage.lndPubRes.CjdnsAddr)
+			} else if cjdnsMessage.bwPriceRes.CjdnsAddr != "" {
+				c.bw.setPeerPrice(cjdnsMessage.bwPriceRes.CjdnsAddr, cjdnsMessage.bwPriceRes.Price)
+			} else if cjdnsMessage.bwInvReq.CjdnsAddr != "" {
+				if err := c.handleBandwidthInvoiceRequest(lnd, cjdnsMessage.bwInvReq); err != nil {
+					log.Warnf("Error sending bandwidth invoice: %v", err)
 				}
 			}
 		}
 	}()
-	// MAX_LND_PEER_CONNECTIONS := 5
-	// go func() {
-	// 	// for {
-	// 		log.Debugf("Checking lnd peer connections...")
-	// 		listPeersResponse, err := lnd.LndListPeers(context.TODO(), &rpc_pb.ListPeersRequest{})
-	// 		if err != nil {
-	// 			log.Errorf("Error listing lnd peers: %v", err)
-	// 		}
-	// 		if len(listPeersResponse.Peers) < MAX_LND_PEER_CONNECTIONS {
-	// 			log.Infof("Will try to connect to request Lnd connection from CJDNS nodes.")
-	// 			nodes := c.GetNodes()
-	// 			for _, node := range nodes {
-	// 				if node.lndPubkey == "" {
-	// 					log.Debugf("Sending Cjdns lnd pubkey query to: %v %v", node.CjdnsAddr, node.CjdnsPubKey)
-	// 					err := c.sendLndPubkeyQuery(node.CjdnsAddr, node.CjdnsPubKey)
-	// 					if err != nil {
-	// 						log.Errorf("Error sending CJDNS lnd pubkey query: %v", err)
-	// 					}
-	// 					time.Sleep(time.Second * 5)
-	// 				}
-	// 			}
-	// 		} else {
-	// 			log.Infof("LND peer connection established.")
-	// 			//return to establish multiple connections
-	// 		}
-	// 	// }
-	// }()
-
 	return nil
 }
 
 func (c *Cjdns) sendLndPubkeyQuery(cjdnsNodeIp string, cjdnsNodePubkey string) error {
 	conn, err := c.getSendConn()
 	if err != nil {
-		log.Errorf("Error getting CJDNS UDP connection:", err)
+		log.Errorf("Error getting CJDNS UDP connection: %v", err)
 		return err
 	}
 	data, _ := createLndQueryRequest(cjdnsNodeIp, cjdnsNodePubkey)
 	// Send data
 	_, err = conn.Write(data)
 	if err != nil {
-		log.Errorf("Error sending CJDNS UDP packet:", err)
+		log.Errorf("Error sending CJDNS UDP packet: %v", err)
 		return err
 	}
 	defer conn.Close()
@@ -469,6 +463,44 @@ func (c *Cjdns) ListenCjdnsMessages() (chan CjdnsMessage, error) {
 						cjdnsMessage.lndPubRes = lndPubkeyResponse
 						cjdnsMsgChan <- cjdnsMessage
 						return
+					} else if message.ContentBenc.(map[string]interface{})["q"] == "bw_price" {
+						log.Debugf("Received CJDNS bandwidth price request")
+						txid, ok := message.ContentBenc.(map[string]interface{})["txid"].(string)
+						if !ok {
+							log.Debugf("Dropping CJDNS bandwidth price request without a txid")
+							return
+						}
+						cjdnsMessage.bwPriceReq = BandwidthPriceRequest{
+							CjdnsAddr:   message.RouteHeader.IP.String(),
+							CjdnsPubKey: message.RouteHeader.PublicKey,
+							Txid:        txid,
+						}
+						cjdnsMsgChan <- cjdnsMessage
+						return
+					} else if message.ContentBenc.(map[string]interface{})["q"] == "bw_invoice_req" {
+						log.Debugf("Received CJDNS bandwidth invoice request")
+						txid, okTxid := message.ContentBenc.(map[string]interface{})["txid"].(string)
+						amount, okAmount := message.ContentBenc.(map[string]interface{})["amt_msat"].(int64)
+						if !okTxid || !okAmount || amount <= 0 {
+							log.Debugf("Dropping malformed CJDNS bandwidth invoice request: %v", message.ContentBenc)
+							return
+						}
+						cjdnsMessage.bwInvReq = BandwidthInvoiceRequest{
+							CjdnsAddr:   message.RouteHeader.IP.String(),
+							CjdnsPubKey: message.RouteHeader.PublicKey,
+							Txid:        txid,
+							AmountMsat:  uint64(amount),
+						}
+						cjdnsMsgChan <- cjdnsMessage
+						return
+					} else if price, ok := message.ContentBenc.(map[string]interface{})["bw_price"].(int64); ok {
+						log.Debugf("Received CJDNS bandwidth price: %d", price)
+						cjdnsMessage.bwPriceRes = BandwidthPriceResponse{
+							CjdnsAddr: message.RouteHeader.IP.String(),
+							Price:     uint64(price),
+						}
+						cjdnsMsgChan <- cjdnsMessage
+						return
 					} else {
 						log.Warn("Received CJDNS invoice response with unknown format. Try upgrading pld...")
 						log.Warnf("Message.ContentBenc: %v", message.ContentBenc)
@@ -596,66 +628,22 @@ func createInvoiceRequest(receiverIP string, receiverPubkey string, amount uint6
 
 func (c *Cjdns) Ping(node string) (string, error) {
 	log.Tracef("Ping Cjdns node: %v", node)
-	err := error(nil)
-	if c.Socket == nil {
-		return "", errors.New("CJDNS connection is nil")
-	}
-
-	ping := map[string]string{
-		"q": "ping",
-	}
-	pingNode := map[string]interface{}{
-		"q":    "RouterModule_pingNode",
-		"args": map[string]string{"path": "fc93:1145:f24c:ee59:4a09:288e:ada8:0901"},
-	}
-	var bytes []byte
-	if node != "" {
-		bytes, err = bencode.EncodeBytes(pingNode)
-		if err != nil {
-			return "", err
-		}
-	} else {
-		bytes, err = bencode.EncodeBytes(ping)
-		if err != nil {
+	if node == "" {
+		if err := c.admin.Ping(); err != nil {
 			return "", err
 		}
+		return "pong", nil
 	}
-
-	_, err = c.Socket.Write(bytes)
+	res, err := c.admin.PingNode(node, 0)
 	if err != nil {
 		return "", err
+	} else if res.Result != "pong" {
+		return "", errors.New("CJDNS ping " + res.Result)
 	}
-
-	// Listen for response
-	c.Socket.SetReadDeadline(time.Now().Add(1 * time.Second))
-	buf := make([]byte, 1024)
-	n, err := c.Socket.Read(buf)
-
-	var response map[string]interface{}
-	if n > 0 {
-		err := bencode.DecodeBytes(buf, &response)
-		if err != nil {
-			return "", err
-		}
-		// check if response has "addr" and "ms" fields
-		if addr, ok := response["addr"].(string); ok {
-			res := addr + " ms:" + fmt.Sprintf("%d", response["ms"].(int64))
-			return res, nil
-		} else if q, ok := response["q"].(string); ok && q == "pong" {
-			return q, nil
-		}
-		return "", nil
-	}
-	if e, ok := err.(interface{ Timeout() bool }); ok && e.Timeout() {
-		return "", errors.New("CJDNS connection timeout")
-	} else if err != nil {
-		return "", err
-	}
-
-	return "", nil
+	return res.Addr + " ms:" + fmt.Sprintf("%d", res.Ms), nil
 }
 
-func (c *Cjdns) registerRpc() {
+func (c *Cjdns) registerRpc(lnd lndRpcServer) {
 	cjdnsCategory := c.api.Category("cjdns")
 	apiv1.Endpoint(
 		cjdnsCategory,
@@ -677,6 +665,154 @@ func (c *Cjdns) registerRpc() {
 			return c.CjdnsInvoiceRequest(req)
 		},
 	)
+	apiv1.Endpoint(
+		cjdnsCategory,
+		"keysend",
+		`
+		Pay a cjdns node without an invoice
+
+		The Lightning node of the cjdns node is asked for with an lnd_pubkey
+		query and sent a keysend payment, with an optional message.
+		`,
+		func(req *rpc_pb.CjdnsKeysendRequest) (*rpc_pb.CjdnsKeysendResponse, er.R) {
+			return c.Keysend(lnd, req)
+		},
+	)
+	apiv1.Endpoint(
+		cjdnsCategory,
+		"nodeinfo",
+		`
+		Get the address, the key and the tun device of this cjdns node
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.CjdnsNodeInfo, er.R) {
+			return c.nodeInfo()
+		},
+	)
+	apiv1.Endpoint(
+		cjdnsCategory,
+		"peers",
+		`
+		Get the peers of this cjdns node and their traffic
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.CjdnsPeerStatsList, er.R) {
+			return c.peerStats()
+		},
+	)
+	apiv1.Endpoint(
+		cjdnsCategory,
+		"lookup",
+		`
+		Find the path to a cjdns node from its IPv6 address
+		`,
+		c.lookup,
+	)
+	apiv1.Endpoint(
+		cjdnsCategory,
+		"switchping",
+		`
+		Ping the switch of the cjdns node at the end of a path
+		`,
+		c.switchPing,
+	)
+	apiv1.Endpoint(
+		cjdnsCategory,
+		"handlers",
+		`
+		Get the UDP ports which receive messages from cjdns, by content type
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.CjdnsHandlers, er.R) {
+			return c.handlers()
+		},
+	)
+	bandwidthCategory := apiv1.DefineCategory(
+		cjdnsCategory,
+		"bandwidth",
+		`
+		Sell and buy bandwidth between cjdns peers for Lightning payments
+
+		Each node charges its peers for the bytes which it sends to them, at its
+		price per MB. A node which buys bandwidth asks its peers for their price
+		and pays those whose price is not more than its maximum, for the bytes
+		which it receives from them. Usage is settled with Lightning payments
+		every settle interval, and a peer which owes more than the grace can be
+		disconnected. Amounts are in millisatoshis.
+		`,
+	)
+	apiv1.Endpoint(
+		bandwidthCategory,
+		"",
+		`
+		Get the prices and the totals of all peers
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.CjdnsBandwidthStatus, er.R) {
+			return c.bw.status(), nil
+		},
+	)
+	apiv1.Endpoint(
+		bandwidthCategory,
+		"config",
+		`
+		Set the price of bandwidth, the maximum price to pay and the settlement
+
+		A price of 0 means bandwidth is not sold, and a maximum price of 0 means
+		it is not bought. Usage is settled every 60 seconds once it is worth
+		1000 msat, and a peer may owe 10000 msat before it is cut off, unless
+		other values are given. With the disconnect cutoff a peer which owes
+		more is disconnected each time it reconnects, until it pays. The config
+		is not saved, it is back to the defaults when pld restarts.
+		`,
+		c.bw.setConfig,
+	)
+	apiv1.Endpoint(
+		bandwidthCategory,
+		"peers",
+		`
+		Get the metered usage, the price and the balance of each peer
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.CjdnsBandwidthPeers, er.R) {
+			return c.bw.list(), nil
+		},
+	)
+	peeringCategory := apiv1.DefineCategory(
+		cjdnsCategory,
+		"peering",
+		`
+		Keep Lightning peers among the cjdns neighbours
+
+		When there are fewer Lightning peers than the target, the cjdns
+		neighbours are asked for their Lightning node and connected. A node
+		which doesn't answer or can't be connected is tried again later, waiting
+		twice as long after each failure. Channels can be opened to the
+		connected neighbours within the same limits as autopilot, and a
+		neighbour which a channel can't be opened to waits in the same way.
+		`,
+	)
+	apiv1.Endpoint(
+		peeringCategory,
+		"",
+		`
+		Get the config and the cjdns neighbours which were asked for their Lightning node
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.CjdnsPeeringStatus, er.R) {
+			return c.pr.status(), nil
+		},
+	)
+	apiv1.Endpoint(
+		peeringCategory,
+		"config",
+		`
+		Set the number of Lightning peers to keep and the channels to open
+
+		A target of 0 means the neighbours are not connected automatically.
+		Channels are only opened with open_channels, no more than 5 in all and
+		1 at a time, while less than 0.6 of the funds is in channels, unless
+		other values are given. The funds are split between the channels which
+		may still be opened, each of at least 20000 sat and at most
+		max_chan_size_sat, or lnd's maxchansize if it is 0. The config is not
+		saved, only the target can be set when pld starts, with --cjdnspeers.
+		`,
+		c.pr.setConfig,
+	)
 }
 
 func (c *Cjdns) PingCjdns(req *rpc_pb.CjdnsPingRequest) (*rpc_pb.CjdnsPingResponse, er.R) {
@@ -710,7 +846,7 @@ func (c *Cjdns) CjdnsInvoiceRequest(req *rpc_pb.CjdnsPaymentInvoiceRequest) (*rp
 		log.Errorf("Cjdns invoice response txid %s, does not match request txid %s", response.Txid, txid)
 		return nil, er.New("Cjdns invoice response txid does not match request txid")
 	} else if response.Error != "" {
-		log.Infof("Cjdns invoice response error: ", response.Error)
+		log.Infof("Cjdns invoice response error: %v", response.Error)
 		return nil, er.New(response.Error)
 	} else {
 		return &rpc_pb.CjdnsPaymentInvoiceResponse{
@@ -720,8 +856,23 @@ func (c *Cjdns) CjdnsInvoiceRequest(req *rpc_pb.CjdnsPaymentInvoiceRequest) (*rp
 	}
 }
 
+// SetPeering sets the number of Lightning peers to keep among the cjdns
+// neighbours, and the channels to open to them.
+func (c *Cjdns) SetPeering(cfg *rpc_pb.CjdnsPeeringConfig) er.R {
+	_, err := c.pr.setConfig(cfg)
+	return err
+}
+
+// SetMaxChanSize sets the largest channel which peering opens when its
+// max_chan_size_sat is 0, normally lnd's maxchansize.
+func (c *Cjdns) SetMaxChanSize(sat int64) {
+	c.pr.setMaxChanSize(sat)
+}
+
 func (c *Cjdns) Stop() er.R {
 	//TODO: unregister handlers
+	close(c.quit)
+	c.admin.Close()
 	return nil
 }
 
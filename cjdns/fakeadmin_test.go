@@ -0,0 +1,194 @@
+package cjdns
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/zeebo/bencode"
+)
+
+// fakeAdmin answers the admin API calls like cjdns does, from a table of peers,
+// routes and handlers, so the package can be tested without a running cjdns.
+type fakeAdmin struct {
+	mu       sync.Mutex
+	ip6      string
+	addr     string
+	peers    []map[string]interface{}
+	pageSize int
+	routes   map[string]string
+	handlers map[int64]int64
+	calls    []string
+
+	// Answer the next call with a response to another call first, as if an
+	// earlier call had timed out.
+	stale bool
+}
+
+// newFakeAdmin returns a fake cjdns and a client which is connected to it.
+func newFakeAdmin(t *testing.T) (*fakeAdmin, *Admin) {
+	key := make([]byte, 32)
+	pubkey := Base32_encode(key) + ".k"
+	ip6, err := publicToIp6(pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeAdmin{
+		ip6:      ip6,
+		addr:     "v21.0000.0000.0000.0001." + pubkey,
+		pageSize: 2,
+		routes:   make(map[string]string),
+		handlers: make(map[int64]int64),
+	}
+	server, client := net.Pipe()
+	go f.serve(server)
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+	return f, NewAdmin(client)
+}
+
+// addPeer adds a peer which has the key made of this byte, and a route to it.
+func (f *fakeAdmin) addPeer(t *testing.T, b byte, bytesIn, bytesOut int64) string {
+	key := make([]byte, 32)
+	key[0] = b
+	pubkey := Base32_encode(key) + ".k"
+	ip6, err := publicToIp6(pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.peers = append(f.peers, map[string]interface{}{
+		"addr":       "v21.0000.0000.0000.001" + string('0'+b) + "." + pubkey,
+		"lladdr":     "192.168.1.1:5000" + string('0'+b),
+		"state":      peerStateEstablished,
+		"bytesIn":    bytesIn,
+		"bytesOut":   bytesOut,
+		"isIncoming": int64(b % 2),
+	})
+	f.routes[ip6] = "0000.0000.0000.001" + string('0'+b)
+	return pubkey
+}
+
+func (f *fakeAdmin) serve(conn net.Conn) {
+	dec := bencode.NewDecoder(conn)
+	for {
+		var msg map[string]interface{}
+		if err := dec.Decode(&msg); err != nil {
+			return
+		}
+		q, _ := msg["q"].(string)
+		args, _ := msg["args"].(map[string]interface{})
+		f.mu.Lock()
+		f.calls = append(f.calls, q)
+		stale := f.stale
+		f.stale = false
+		res := f.handle(q, args)
+		f.mu.Unlock()
+
+		if stale {
+			out, _ := bencode.EncodeBytes(map[string]interface{}{"error": "none", "txid": "stale"})
+			if _, err := conn.Write(out); err != nil {
+				return
+			}
+		}
+		res["txid"] = msg["txid"]
+		if _, ok := res["error"]; !ok {
+			res["error"] = "none"
+		}
+		out, err := bencode.EncodeBytes(res)
+		if err != nil {
+			return
+		}
+		if _, err := conn.Write(out); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeAdmin) page(args map[string]interface{}, n int) (int, int, bool) {
+	page, _ := args["page"].(int64)
+	start := int(page) * f.pageSize
+	if start > n {
+		start = n
+	}
+	end := start + f.pageSize
+	if end >= n {
+		return start, n, false
+	}
+	return start, end, true
+}
+
+func (f *fakeAdmin) handle(q string, args map[string]interface{}) map[string]interface{} {
+	switch q {
+	case "ping":
+		return map[string]interface{}{"q": "pong"}
+
+	case "Core_nodeInfo":
+		return map[string]interface{}{"myIp6": f.ip6, "myAddr": f.addr}
+
+	case "InterfaceController_peerStats":
+		start, end, more := f.page(args, len(f.peers))
+		peers := []interface{}{}
+		for _, p := range f.peers[start:end] {
+			peers = append(peers, p)
+		}
+		res := map[string]interface{}{"peers": peers, "total": int64(len(f.peers))}
+		if more {
+			res["more"] = int64(1)
+		}
+		return res
+
+	case "InterfaceController_disconnectPeer":
+		pubkey, _ := args["pubkey"].(string)
+		for i, p := range f.peers {
+			if _, key, err := peerKey(&CjdnsPeer{addr: p["addr"].(string)}); err == nil && key == pubkey {
+				f.peers = append(f.peers[:i], f.peers[i+1:]...)
+				return map[string]interface{}{}
+			}
+		}
+		return map[string]interface{}{"error": "no such peer"}
+
+	case "RouterModule_lookup":
+		addr, _ := args["address"].(string)
+		if path, ok := f.routes[addr]; ok {
+			return map[string]interface{}{"result": path}
+		}
+		return map[string]interface{}{"error": "not found"}
+
+	case "RouterModule_pingNode", "SwitchPinger_ping":
+		path, _ := args["path"].(string)
+		for addr, p := range f.routes {
+			if p == path || addr == path {
+				return map[string]interface{}{
+					"result": "pong", "ms": int64(3), "path": p, "addr": addr, "version": int64(21),
+				}
+			}
+		}
+		return map[string]interface{}{"result": "timeout", "ms": int64(1000)}
+
+	case "UpperDistributor_listHandlers":
+		handlers := []interface{}{}
+		for port, ct := range f.handlers {
+			handlers = append(handlers, map[string]interface{}{"type": ct, "udpPort": port})
+		}
+		return map[string]interface{}{"handlers": handlers}
+
+	case "UpperDistributor_registerHandler":
+		port, _ := args["udpPort"].(int64)
+		ct, _ := args["contentType"].(int64)
+		f.handlers[port] = ct
+		return map[string]interface{}{}
+
+	case "UpperDistributor_unregisterHandler":
+		port, _ := args["udpPort"].(int64)
+		if _, ok := f.handlers[port]; !ok {
+			return map[string]interface{}{"error": "no such handler"}
+		}
+		delete(f.handlers, port)
+		return map[string]interface{}{}
+	}
+	return map[string]interface{}{"error": "no such function"}
+}
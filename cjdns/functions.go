@@ -1,199 +1,63 @@
 package cjdns
 
 import (
-	"errors"
+	"strconv"
 	"strings"
-	"time"
 
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
 	"github.com/pkt-cash/pktd/pktlog/log"
-	"github.com/zeebo/bencode"
 )
 
-
 func (c *Cjdns) ListHandlers() ([]int, error) {
-	message := map[string]interface{}{
-		"q":    "UpperDistributor_listHandlers",
-		"page": 0,
-	}
-
-	bytes, err := bencode.EncodeBytes(message)
+	handlers, err := c.admin.ListHandlers()
 	if err != nil {
 		return nil, err
 	}
-
-    c.socketLock.Lock()
-    
-	_, err = c.Socket.Write(bytes)
-	if err != nil {
-		return nil, err
+	udpPorts := []int{}
+	for _, h := range handlers {
+		udpPorts = append(udpPorts, int(h.UdpPort))
 	}
-	c.Socket.SetReadDeadline(time.Now().Add(1 * time.Second))
-	buf := make([]byte, 1024)
-	resultChan := make(chan []int, 1)
-	var response map[string]interface{}
-	go func(resultChan chan<- []int) {
-		n, err := c.Socket.Read(buf)
-		if err != nil {
-			return
-		}
-		if n > 0 {
-			err := bencode.DecodeBytes(buf, &response)
-			if err != nil {
-				log.Errorf("Error decoding response: %s", err)
-				return
-			}
-			if handlers, ok := response["handlers"].(interface{}); ok {
-				udpPorts := []int{}
-				for _, handler := range handlers.([]interface{}) {
-					udpPort := handler.(map[string]interface{})["udpPort"].(int64)
-					udpPorts = append(udpPorts, int(udpPort))
-				}
-				resultChan <- udpPorts
-			}
-		}
-	}(resultChan)
-	defer c.socketLock.Unlock()
-	return <-resultChan, nil
+	return udpPorts, nil
 }
 
 func (c *Cjdns) PeerStats() ([]CjdnsPeer, error) {
-	c.socketLock.Lock()
-	// log.Infof("Getting Cjdns peer stats...")
-	cjdnsPeers := []CjdnsPeer{}
-	message := map[string]interface{}{
-		"q":    "InterfaceController_peerStats",
-		"page": 0,
-	}
-	bytes, err := bencode.EncodeBytes(message)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = c.Socket.Write(bytes)
+	stats, err := c.admin.PeerStats()
 	if err != nil {
 		return nil, err
 	}
-
-	c.Socket.SetReadDeadline(time.Now().Add(2 * time.Second))
-	buf := make([]byte, 1024)
-	resultChan := make(chan []CjdnsPeer)
-	var response map[string]interface{}
-	go func() {
-		data := []byte{}
-		for {
-			n, err := c.Socket.Read(buf)
-			// log.Infof("Read %v bytes from Cjdns socket", buf)
-			if n > 0 {
-				// log.Debugf("Read %v bytes from Cjdns socket", n)
-				data = append(data, buf[:n]...)
-				if err != nil {
-					log.Errorf("Error reading from CJDNS socket: %v", err)
-					resultChan <- nil
-				}
-			} else if n == 0 {
-				// log.Debugf("Read 0 bytes from Cjdns socket, breaking")
-				break
-			}
-		}
-		err = bencode.DecodeBytes(data, &response)
-		// log.Debugf("Cjdns peer stats response: %v", response)
-		if err != nil {
-			log.Errorf("Error decoding response: %v", err)
-		}
-		// log.Debugf("PeerStats response: %v", response)
-		if peers, ok := response["peers"].(interface{}); ok {
-			for _, peer := range peers.([]interface{}) {
-				p := peer.(map[string]interface{})
-				cjdnsPeer := CjdnsPeer{
-					addr:   p["addr"].(string),
-					lladdr: p["lladdr"].(string),
-					state:  p["state"].(string),
-				}
-				cjdnsPeers = append(cjdnsPeers, cjdnsPeer)
-				//return here if we want to connect to only one node
-			}
-			resultChan <- cjdnsPeers
-			return // here if we want to try to connect to all nodes
-		}
-	}()
-
-	peers := <-resultChan
-	if peers == nil {
-		return nil, errors.New("timeout reading cjdns socket")
+	cjdnsPeers := []CjdnsPeer{}
+	for _, p := range stats {
+		cjdnsPeers = append(cjdnsPeers, CjdnsPeer{
+			addr:     p.Addr,
+			lladdr:   p.Lladdr,
+			state:    p.State,
+			bytesIn:  uint64(p.BytesIn),
+			bytesOut: uint64(p.BytesOut),
+		})
 	}
-	defer c.socketLock.Unlock()
 	return cjdnsPeers, nil
 }
 
 func (c *Cjdns) RegisterHandler(contentType int64, udpPort int64) error {
-	c.socketLock.Lock()
-	message := map[string]interface{}{
-		"q":    "UpperDistributor_registerHandler",
-		"args": map[string]int64{"contentType": contentType, "udpPort": udpPort},
-	}
-	bytes, err := bencode.EncodeBytes(message)
+	err := c.admin.RegisterHandler(contentType, udpPort)
 	if err != nil {
-		log.Errorf("Error encoding CJDNS message:", err)
-		return err
+		log.Errorf("Error registering CJDNS handler: %v", err)
 	}
+	return err
+}
 
-    
-    
-	_, err = c.Socket.Write(bytes)
-	if err != nil {
-		log.Errorf("Error writing to CJDNS socket:", err)
-		return err
-	}
-	c.Socket.SetReadDeadline(time.Now().Add(1 * time.Second))
-	buf := make([]byte, 1024)
-	var response map[string]interface{}
-	n, err := c.Socket.Read(buf)
-	if err != nil {
-		return err
-	}
-	if n > 0 {
-	 	bencode.DecodeBytes(buf[:n], &response)
-	}
-	defer c.socketLock.Unlock()
-	return nil
+// DisconnectPeer drops the cjdns peering with the node which has this key.
+func (c *Cjdns) DisconnectPeer(pubkey string) error {
+	return c.admin.DisconnectPeer(pubkey)
 }
 
 func (c *Cjdns) UnregisterHandler(udpPort int) error {
-	message := map[string]interface{}{
-		"q":    "UpperDistributor_unregisterHandler",
-		"args": map[string]int{"udpPort": udpPort},
-	}
-	bytes, err := bencode.EncodeBytes(message)
+	err := c.admin.UnregisterHandler(int64(udpPort))
 	if err != nil {
-		log.Errorf("Error encoding CJDNS message:", err)
-		return err
+		log.Errorf("Error unregistering CJDNS handler: %v", err)
 	}
-
-    c.socketLock.Lock()
-    
-	_, err = c.Socket.Write(bytes)
-	if err != nil {
-		log.Errorf("Error writing to CJDNS socket:", err)
-		return err
-	}
-	c.Socket.SetReadDeadline(time.Now().Add(1 * time.Second))
-	buf := make([]byte, 1024)
-
-	var response map[string]interface{}
-	n, err := c.Socket.Read(buf)
-	if err != nil {
-		return err
-	}
-	if n > 0 {
-		err := bencode.DecodeBytes(buf[:n], &response)
-		// log.Debugf("UnregisterHandler response: %v", response)
-		if err != nil {
-			log.Error("Error decoding response: %s", err)
-			return err
-		}
-	}
-	defer c.socketLock.Unlock()
-	return nil
+	return err
 }
 
 func (c *Cjdns) GetNodes() []CjdnsNode {
@@ -224,3 +88,97 @@ func (c *Cjdns) GetNodes() []CjdnsNode {
 
 	return nodes
 }
+
+// addrVersion returns the protocol version in a cjdns address, which looks like
+// v21.0000.0000.0000.0013.<key>.k
+func addrVersion(addr string) uint32 {
+	v, err := strconv.ParseUint(strings.TrimPrefix(strings.SplitN(addr, ".", 2)[0], "v"), 10, 32)
+	if err != nil {
+		return 0
+	}
+	return uint32(v)
+}
+
+func (c *Cjdns) nodeInfo() (*rpc_pb.CjdnsNodeInfo, er.R) {
+	info, err := c.admin.NodeInfo()
+	if err != nil {
+		return nil, er.E(err)
+	}
+	res := &rpc_pb.CjdnsNodeInfo{
+		Ipv6:    info.MyIp6,
+		Addr:    info.MyAddr,
+		Version: addrVersion(info.MyAddr),
+		Device:  c.Device,
+	}
+	if _, pubkey, err := peerKey(&CjdnsPeer{addr: info.MyAddr}); err == nil {
+		res.Pubkey = pubkey
+	}
+	return res, nil
+}
+
+func (c *Cjdns) peerStats() (*rpc_pb.CjdnsPeerStatsList, er.R) {
+	stats, err := c.admin.PeerStats()
+	if err != nil {
+		return nil, er.E(err)
+	}
+	res := &rpc_pb.CjdnsPeerStatsList{}
+	for _, p := range stats {
+		ps := &rpc_pb.CjdnsPeerStats{
+			Addr:               p.Addr,
+			Lladdr:             p.Lladdr,
+			State:              p.State,
+			User:               p.User,
+			BytesIn:            uint64(p.BytesIn),
+			BytesOut:           uint64(p.BytesOut),
+			RecvKbps:           uint32(p.RecvKbps),
+			SendKbps:           uint32(p.SendKbps),
+			Incoming:           p.IsIncoming != 0,
+			Duplicates:         uint64(p.Duplicates),
+			LostPackets:        uint64(p.LostPackets),
+			ReceivedOutOfRange: uint64(p.ReceivedOutOfRange),
+			LastMs:             p.Last,
+		}
+		if ip, pubkey, err := peerKey(&CjdnsPeer{addr: p.Addr}); err == nil {
+			ps.Ipv6 = ip
+			ps.Pubkey = pubkey
+		}
+		res.Peers = append(res.Peers, ps)
+	}
+	return res, nil
+}
+
+func (c *Cjdns) lookup(req *rpc_pb.CjdnsLookupRequest) (*rpc_pb.CjdnsLookupResponse, er.R) {
+	path, err := c.admin.Lookup(req.CjdnsAddr)
+	if err != nil {
+		return nil, er.E(err)
+	}
+	return &rpc_pb.CjdnsLookupResponse{Path: path}, nil
+}
+
+func (c *Cjdns) switchPing(req *rpc_pb.CjdnsSwitchPingRequest) (*rpc_pb.CjdnsSwitchPingResponse, er.R) {
+	res, err := c.admin.SwitchPing(req.Path, req.TimeoutMs)
+	if err != nil {
+		return nil, er.E(err)
+	}
+	return &rpc_pb.CjdnsSwitchPingResponse{
+		Result:  res.Result,
+		Ms:      res.Ms,
+		Path:    res.Path,
+		Version: uint32(res.Version),
+	}, nil
+}
+
+func (c *Cjdns) handlers() (*rpc_pb.CjdnsHandlers, er.R) {
+	handlers, err := c.admin.ListHandlers()
+	if err != nil {
+		return nil, er.E(err)
+	}
+	res := &rpc_pb.CjdnsHandlers{}
+	for _, h := range handlers {
+		res.Handlers = append(res.Handlers, &rpc_pb.CjdnsHandler{
+			ContentType: h.Type,
+			UdpPort:     h.UdpPort,
+		})
+	}
+	return res, nil
+}
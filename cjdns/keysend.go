@@ -0,0 +1,146 @@
+package cjdns
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/lnd/lntypes"
+	"github.com/pkt-cash/pktd/lnd/record"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+// A cjdns node is paid without an invoice: its Lightning node is found with
+// the lnd_pubkey query, unless it already answered one, and it is sent a
+// keysend payment, which carries the preimage in a custom record. The receiver
+// makes an invoice for the payment when it arrives.
+
+const (
+	keysendTimeout = 30 * time.Second
+
+	// The receiver keeps the message as the memo of the invoice, which can't
+	// be longer.
+	keysendMaxMessage = 1024
+)
+
+// expectLndPubkey returns a channel which receives the response to the
+// lnd_pubkey query with this txid.
+func (c *Cjdns) expectLndPubkey(txid string) chan LndPubkeyResponse {
+	ch := make(chan LndPubkeyResponse, 1)
+	c.lndPubkeyLock.Lock()
+	c.lndPubkeyWaiting[txid] = ch
+	c.lndPubkeyLock.Unlock()
+	return ch
+}
+
+func (c *Cjdns) forgetLndPubkey(txid string) {
+	c.lndPubkeyLock.Lock()
+	delete(c.lndPubkeyWaiting, txid)
+	c.lndPubkeyLock.Unlock()
+}
+
+// deliverLndPubkey passes a response to the query which is waiting for it, if
+// any.
+func (c *Cjdns) deliverLndPubkey(res LndPubkeyResponse) {
+	c.lndPubkeyLock.Lock()
+	defer c.lndPubkeyLock.Unlock()
+	if ch, ok := c.lndPubkeyWaiting[res.Txid]; ok {
+		delete(c.lndPubkeyWaiting, res.Txid)
+		ch <- res
+	}
+}
+
+// resolveLndPubkey returns the identity key of the Lightning node of a cjdns
+// node.
+func (c *Cjdns) resolveLndPubkey(addr, pubkey string) (string, er.R) {
+	addr = normalizeAddr(addr)
+	if n, ok := c.pr.known(addr); ok && n.LndPubkey != "" {
+		return n.LndPubkey, nil
+	}
+	if pubkey == "" {
+		for _, n := range c.GetNodes() {
+			if normalizeAddr(n.CjdnsAddr) == addr {
+				pubkey = n.CjdnsPubKey
+			}
+		}
+		if pubkey == "" {
+			return "", er.Errorf("cjdns node [%s] is not a peer, cjdns_pubkey is required", addr)
+		}
+	} else if ip, err := publicToIp6(pubkey); err != nil {
+		return "", er.E(err)
+	} else if normalizeAddr(ip) != addr {
+		return "", er.Errorf("cjdns_pubkey [%s] is not the key of [%s]", pubkey, addr)
+	}
+
+	data, txid := createLndQueryRequest(addr, pubkey)
+	ch := c.expectLndPubkey(txid)
+	defer c.forgetLndPubkey(txid)
+	if err := c.sendMessage(data); err != nil {
+		return "", er.E(err)
+	}
+	select {
+	case res := <-ch:
+		return res.lndPubkey, nil
+	case <-time.After(keysendTimeout):
+		return "", er.Errorf("cjdns node [%s] did not tell its Lightning node", addr)
+	case <-c.quit:
+		return "", er.New("shutting down")
+	}
+}
+
+// Keysend pays a cjdns node without an invoice.
+func (c *Cjdns) Keysend(lnd lndRpcServer, req *rpc_pb.CjdnsKeysendRequest) (*rpc_pb.CjdnsKeysendResponse, er.R) {
+	if req.AmtMsat == 0 {
+		return nil, er.New("amt_msat is required")
+	} else if len(req.Message) > keysendMaxMessage {
+		return nil, er.Errorf("message is [%d] bytes, the limit is [%d]", len(req.Message), keysendMaxMessage)
+	}
+	lndPubkey, err := c.resolveLndPubkey(req.CjdnsAddr, req.CjdnsPubkey)
+	if err != nil {
+		return nil, err
+	}
+	dest, errr := hex.DecodeString(lndPubkey)
+	if errr != nil {
+		return nil, er.Errorf("invalid Lightning key [%s] from [%s]", lndPubkey, req.CjdnsAddr)
+	}
+
+	var preimage lntypes.Preimage
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return nil, er.E(err)
+	}
+	hash := preimage.Hash()
+	records := map[uint64][]byte{record.KeySendType: preimage[:]}
+	if req.Message != "" {
+		records[record.KeySendMessageType] = []byte(req.Message)
+	}
+	sendReq := &rpc_pb.SendRequest{
+		Dest:              dest,
+		AmtMsat:           int64(req.AmtMsat),
+		PaymentHash:       hash[:],
+		DestCustomRecords: records,
+		DestFeatures:      []rpc_pb.FeatureBit{rpc_pb.FeatureBit_TLV_ONION_OPT},
+	}
+	if req.FeeLimitMsat > 0 {
+		sendReq.FeeLimit = &rpc_pb.FeeLimit{Limit: &rpc_pb.FeeLimit_FixedMsat{FixedMsat: req.FeeLimitMsat}}
+	}
+	log.Infof("Sending a keysend payment of [%d] msat to [%s]", req.AmtMsat, req.CjdnsAddr)
+	sent, err := lnd.LndSendPaymentSync(context.TODO(), sendReq)
+	if err != nil {
+		return nil, err
+	} else if sent.PaymentError != "" {
+		return nil, er.New(sent.PaymentError)
+	}
+	res := &rpc_pb.CjdnsKeysendResponse{
+		LndPubkey:       dest,
+		PaymentHash:     hash[:],
+		PaymentPreimage: sent.PaymentPreimage,
+		AmtMsat:         int64(req.AmtMsat),
+	}
+	if sent.PaymentRoute != nil {
+		res.FeeMsat = sent.PaymentRoute.TotalFeesMsat
+	}
+	return res, nil
+}
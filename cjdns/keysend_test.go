@@ -0,0 +1,82 @@
+package cjdns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
+	"github.com/pkt-cash/pktd/lnd/record"
+)
+
+// keysendLnd records the payments which are sent, the other calls are not
+// expected.
+type keysendLnd struct {
+	lndRpcServer
+	sent []*rpc_pb.SendRequest
+}
+
+func (l *keysendLnd) LndSendPaymentSync(_ context.Context, in *rpc_pb.SendRequest) (*rpc_pb.SendResponse, er.R) {
+	l.sent = append(l.sent, in)
+	preimage := in.DestCustomRecords[record.KeySendType]
+	return &rpc_pb.SendResponse{
+		PaymentPreimage: preimage,
+		PaymentHash:     in.PaymentHash,
+		PaymentRoute:    &rpc_pb.Route{TotalFeesMsat: 12},
+	}, nil
+}
+
+// TestKeysend pays a cjdns peer whose Lightning node is known, and checks the
+// keysend records of the payment.
+func TestKeysend(t *testing.T) {
+	f, admin := newFakeAdmin(t)
+	c := newCjdns(admin, &apiv1.Apiv1{})
+	pubkey := f.addPeer(t, 1, 0, 0)
+	ip, err := publicToIp6(pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lndPubkey := "02" + hex.EncodeToString(make([]byte, 32))
+	c.pr.update(c.GetNodes(), nil, nil)
+	c.pr.answered(ip, lndPubkey, "["+ip+"]:9735")
+
+	lnd := &keysendLnd{}
+	if _, err := c.Keysend(lnd, &rpc_pb.CjdnsKeysendRequest{CjdnsAddr: ip}); err == nil {
+		t.Fatalf("expected a payment without an amount to be rejected")
+	}
+	res, errr := c.Keysend(lnd, &rpc_pb.CjdnsKeysendRequest{
+		CjdnsAddr:    ip,
+		AmtMsat:      5000,
+		Message:      "for the tunnel",
+		FeeLimitMsat: 100,
+	})
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	if len(lnd.sent) != 1 {
+		t.Fatalf("expected one payment, got %d", len(lnd.sent))
+	}
+	sent := lnd.sent[0]
+	preimage := sent.DestCustomRecords[record.KeySendType]
+	hash := sha256.Sum256(preimage)
+	if hex.EncodeToString(sent.Dest) != lndPubkey || sent.AmtMsat != 5000 ||
+		hex.EncodeToString(sent.PaymentHash) != hex.EncodeToString(hash[:]) ||
+		string(sent.DestCustomRecords[record.KeySendMessageType]) != "for the tunnel" ||
+		sent.FeeLimit.GetFixedMsat() != 100 {
+		t.Fatalf("unexpected payment %v", sent)
+	}
+	if res.FeeMsat != 12 || hex.EncodeToString(res.PaymentHash) != hex.EncodeToString(hash[:]) {
+		t.Fatalf("unexpected response %v", res)
+	}
+
+	// A node which is not a peer needs its key, which must match the address.
+	if _, err := c.resolveLndPubkey("fc00::1", ""); err == nil {
+		t.Fatalf("expected a node which is not a peer to need its key")
+	}
+	if _, err := c.resolveLndPubkey("fc00::1", pubkey); err == nil {
+		t.Fatalf("expected a key which doesn't match the address to be rejected")
+	}
+}
@@ -0,0 +1,456 @@
+package cjdns
+
+import (
+	"context"
+	"encoding/hex"
+	"math"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/lnd/autopilot"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// Lightning peers are kept among the cjdns neighbours. Every peering tick, if
+// there are fewer Lightning peers than the target, the neighbours which are
+// not connected are asked for their Lightning node with an lnd_pubkey query,
+// and connected once they answer. A node which doesn't answer, or can't be
+// connected, is tried again after a delay which doubles with each failure.
+// Optionally, channels are opened to the connected neighbours within the same
+// constraints as autopilot uses, and a neighbour which a channel can't be
+// opened to is tried again with the same backoff.
+
+const (
+	peeringTick       = 30 * time.Second
+	peeringBackoffMin = 30 * time.Second
+	peeringBackoffMax = time.Hour
+
+	defaultMinChanSizeSat  = 20000
+	defaultMaxChannels     = 5
+	defaultAllocation      = 0.6
+	defaultMaxPendingOpens = 1
+
+	// The largest channel when neither max_chan_size_sat nor lnd's maxchansize
+	// are set, this is lnd's MaxBtcFundingAmount.
+	defaultMaxChanSizeSat = 1<<24 - 1
+)
+
+type peering struct {
+	mu       sync.Mutex
+	cfg      *rpc_pb.CjdnsPeeringConfig
+	nodes    map[string]*rpc_pb.CjdnsPeeringNode
+	lndPeers uint32
+
+	// The largest channel when max_chan_size_sat is 0
+	maxChanSize btcutil.Amount
+}
+
+func newPeering() *peering {
+	cfg, _ := peeringDefaults(&rpc_pb.CjdnsPeeringConfig{})
+	return &peering{
+		cfg:         cfg,
+		nodes:       make(map[string]*rpc_pb.CjdnsPeeringNode),
+		maxChanSize: defaultMaxChanSizeSat,
+	}
+}
+
+func peeringDefaults(in *rpc_pb.CjdnsPeeringConfig) (*rpc_pb.CjdnsPeeringConfig, er.R) {
+	cfg := proto.Clone(in).(*rpc_pb.CjdnsPeeringConfig)
+	if cfg.MinChanSizeSat == 0 {
+		cfg.MinChanSizeSat = defaultMinChanSizeSat
+	}
+	if cfg.MaxChannels == 0 {
+		cfg.MaxChannels = defaultMaxChannels
+	}
+	if cfg.Allocation == 0 {
+		cfg.Allocation = defaultAllocation
+	}
+	if cfg.MaxPendingOpens == 0 {
+		cfg.MaxPendingOpens = defaultMaxPendingOpens
+	}
+	if cfg.MinChanSizeSat < 0 || cfg.MaxChanSizeSat < 0 {
+		return nil, er.New("channel sizes must not be negative")
+	} else if cfg.MaxChanSizeSat != 0 && cfg.MaxChanSizeSat < cfg.MinChanSizeSat {
+		return nil, er.Errorf("max_chan_size_sat [%d] is less than min_chan_size_sat [%d]",
+			cfg.MaxChanSizeSat, cfg.MinChanSizeSat)
+	} else if cfg.Allocation < 0 || cfg.Allocation > 1 {
+		return nil, er.Errorf("allocation [%v] must be between 0 and 1", cfg.Allocation)
+	} else if cfg.MaxChannels > math.MaxUint16 || cfg.MaxPendingOpens > math.MaxUint16 {
+		return nil, er.New("max_channels and max_pending_opens must be less than 65536")
+	}
+	return cfg, nil
+}
+
+// peeringBackoff is how long to wait before trying a node again, after it
+// failed this many times in a row.
+func peeringBackoff(failures uint32) time.Duration {
+	d := peeringBackoffMin
+	for i := uint32(1); i < failures && d < peeringBackoffMax; i++ {
+		d *= 2
+	}
+	if d > peeringBackoffMax {
+		d = peeringBackoffMax
+	}
+	return d
+}
+
+func (p *peering) setConfig(in *rpc_pb.CjdnsPeeringConfig) (*rpc_pb.CjdnsPeeringConfig, er.R) {
+	cfg, err := peeringDefaults(in)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cfg = cfg
+	return proto.Clone(cfg).(*rpc_pb.CjdnsPeeringConfig), nil
+}
+
+// setMaxChanSize sets the largest channel when max_chan_size_sat is 0, it is
+// lnd's maxchansize.
+func (p *peering) setMaxChanSize(sat int64) {
+	if sat <= 0 {
+		return
+	}
+	p.mu.Lock()
+	p.maxChanSize = btcutil.Amount(sat)
+	p.mu.Unlock()
+}
+
+func (p *peering) config() *rpc_pb.CjdnsPeeringConfig {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return proto.Clone(p.cfg).(*rpc_pb.CjdnsPeeringConfig)
+}
+
+func (p *peering) status() *rpc_pb.CjdnsPeeringStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	st := &rpc_pb.CjdnsPeeringStatus{
+		Config:   proto.Clone(p.cfg).(*rpc_pb.CjdnsPeeringConfig),
+		LndPeers: p.lndPeers,
+	}
+	for _, n := range p.nodes {
+		st.Nodes = append(st.Nodes, proto.Clone(n).(*rpc_pb.CjdnsPeeringNode))
+	}
+	sort.Slice(st.Nodes, func(i, j int) bool {
+		return st.Nodes[i].CjdnsAddr < st.Nodes[j].CjdnsAddr
+	})
+	return st
+}
+
+// update replaces the nodes with the current cjdns neighbours and marks those
+// which are Lightning peers, or have channels, by their Lightning key. The
+// failures of a node are reset when it gets connected, those of a connected
+// node are failures to open a channel.
+func (p *peering) update(neighbours []CjdnsNode, lndPeers, channels map[string]bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	nodes := make(map[string]*rpc_pb.CjdnsPeeringNode, len(neighbours))
+	for _, nb := range neighbours {
+		addr := normalizeAddr(nb.CjdnsAddr)
+		n, ok := p.nodes[addr]
+		if !ok {
+			n = &rpc_pb.CjdnsPeeringNode{CjdnsAddr: addr}
+		}
+		wasConnected := n.Connected
+		n.CjdnsPubkey = nb.CjdnsPubKey
+		n.Connected = n.LndPubkey != "" && lndPeers[n.LndPubkey]
+		n.HasChannel = n.LndPubkey != "" && channels[n.LndPubkey]
+		if n.Connected && !wasConnected {
+			n.Failures = 0
+			n.NextAttemptSec = 0
+			n.LastError = ""
+		}
+		nodes[addr] = n
+	}
+	p.nodes = nodes
+	p.lndPeers = uint32(len(lndPeers))
+}
+
+// attempt picks the nodes to try now, as many as are missing to reach the
+// target, preferring those which already told us their Lightning node. Each one
+// is counted as a failure until it is connected, so a node which doesn't
+// answer waits longer every time.
+func (p *peering) attempt(now time.Time) []*rpc_pb.CjdnsPeeringNode {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.lndPeers >= p.cfg.TargetPeers {
+		return nil
+	}
+	var due []*rpc_pb.CjdnsPeeringNode
+	for _, n := range p.nodes {
+		if !n.Connected && n.NextAttemptSec <= now.Unix() {
+			due = append(due, n)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if (due[i].LndPubkey != "") != (due[j].LndPubkey != "") {
+			return due[i].LndPubkey != ""
+		} else if due[i].Failures != due[j].Failures {
+			return due[i].Failures < due[j].Failures
+		}
+		return due[i].CjdnsAddr < due[j].CjdnsAddr
+	})
+	if missing := int(p.cfg.TargetPeers - p.lndPeers); len(due) > missing {
+		due = due[:missing]
+	}
+	out := make([]*rpc_pb.CjdnsPeeringNode, 0, len(due))
+	for _, n := range due {
+		n.Failures++
+		n.LastAttemptSec = now.Unix()
+		n.NextAttemptSec = now.Add(peeringBackoff(n.Failures)).Unix()
+		out = append(out, proto.Clone(n).(*rpc_pb.CjdnsPeeringNode))
+	}
+	return out
+}
+
+// answered records the Lightning node of a cjdns node, it returns false if the
+// cjdns node is not a neighbour.
+func (p *peering) answered(addr, lndPubkey, host string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n, ok := p.nodes[normalizeAddr(addr)]
+	if ok {
+		n.LndPubkey = lndPubkey
+		n.LndHost = host
+	}
+	return ok
+}
+
+// known returns a copy of a neighbour, if it is one.
+func (p *peering) known(addr string) (*rpc_pb.CjdnsPeeringNode, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n, ok := p.nodes[normalizeAddr(addr)]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(n).(*rpc_pb.CjdnsPeeringNode), true
+}
+
+func (p *peering) result(addr string, err er.R) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n, ok := p.nodes[normalizeAddr(addr)]
+	if !ok {
+		return
+	} else if err != nil {
+		n.LastError = err.Message()
+		return
+	}
+	n.Connected = true
+	n.Failures = 0
+	n.NextAttemptSec = 0
+	n.LastError = ""
+}
+
+// opened records the result of opening a channel to a node, a node which
+// failed waits longer each time before it is tried again.
+func (p *peering) opened(addr string, now time.Time, err er.R) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n, ok := p.nodes[normalizeAddr(addr)]
+	if !ok {
+		return
+	} else if err != nil {
+		n.Failures++
+		n.LastAttemptSec = now.Unix()
+		n.NextAttemptSec = now.Add(peeringBackoff(n.Failures)).Unix()
+		n.LastError = err.Message()
+		return
+	}
+	n.HasChannel = true
+	n.Failures = 0
+	n.NextAttemptSec = 0
+	n.LastError = ""
+}
+
+// channelCandidates are the connected nodes which have no channel with us and
+// are not waiting after a failure.
+func (p *peering) channelCandidates(now time.Time) []*rpc_pb.CjdnsPeeringNode {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var out []*rpc_pb.CjdnsPeeringNode
+	for _, n := range p.nodes {
+		if n.Connected && !n.HasChannel && n.NextAttemptSec <= now.Unix() {
+			out = append(out, proto.Clone(n).(*rpc_pb.CjdnsPeeringNode))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CjdnsAddr < out[j].CjdnsAddr })
+	return out
+}
+
+// connectLnd connects to the Lightning node which a cjdns node told us about.
+func (c *Cjdns) connectLnd(lnd lndRpcServer, res LndPubkeyResponse) {
+	host, _, _ := net.SplitHostPort(res.CjdnsAddr)
+	c.pr.answered(host, res.lndPubkey, res.CjdnsAddr)
+	connectRequest := &rpc_pb.ConnectPeerRequest{
+		Addr: &rpc_pb.LightningAddress{
+			Pubkey: res.lndPubkey,
+			Host:   res.CjdnsAddr,
+		},
+	}
+	log.Infof("Connecting to LND peer...: %s", connectRequest.Addr)
+	_, err := lnd.LndConnectPeer(context.TODO(), connectRequest)
+	if err != nil {
+		if !strings.Contains(err.Message(), "already connected") {
+			log.Warnf("Error connecting to LND peer: %s", err.Message())
+			c.pr.result(host, err)
+			return
+		}
+		log.Infof("Already connected to LND peer: %s", res.CjdnsAddr)
+	} else {
+		log.Infof("Connected to LND peer: %s", res.CjdnsAddr)
+	}
+	c.pr.result(host, nil)
+}
+
+// localChannels returns our open and pending channels in the form which the
+// autopilot constraints use, the number of pending opens, and the Lightning
+// keys of the nodes which we have channels with.
+func localChannels(lnd lndRpcServer) ([]autopilot.LocalChannel, uint16, map[string]bool, er.R) {
+	open, err := lnd.LndListChannels(context.TODO(), &rpc_pb.ListChannelsRequest{})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	pending, err := lnd.LndPendingChannels(context.TODO(), &rpc_pb.Null{})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	var chans []autopilot.LocalChannel
+	keys := make(map[string]bool)
+	for _, ch := range open.Channels {
+		chans = append(chans, autopilot.LocalChannel{Balance: btcutil.Amount(ch.LocalBalance)})
+		keys[hex.EncodeToString(ch.RemotePubkey)] = true
+	}
+	for _, ch := range pending.PendingOpenChannels {
+		if ch.Channel == nil {
+			continue
+		}
+		chans = append(chans, autopilot.LocalChannel{Balance: btcutil.Amount(ch.Channel.LocalBalance)})
+		keys[hex.EncodeToString(ch.Channel.RemoteNodePub)] = true
+	}
+	return chans, uint16(len(pending.PendingOpenChannels)), keys, nil
+}
+
+// openChannels opens channels to the connected cjdns neighbours which have none,
+// within the budget of the autopilot constraints. The budget is split between
+// the channels which may be opened, and no channel is larger than
+// max_chan_size_sat, or lnd's maxchansize if it is 0.
+func (c *Cjdns) openChannels(lnd lndRpcServer, cfg *rpc_pb.CjdnsPeeringConfig,
+	chans []autopilot.LocalChannel, pendingOpens uint16, now time.Time) {
+
+	maxChanSize := btcutil.Amount(cfg.MaxChanSizeSat)
+	if maxChanSize == 0 {
+		c.pr.mu.Lock()
+		maxChanSize = c.pr.maxChanSize
+		c.pr.mu.Unlock()
+	}
+	constraints := autopilot.NewConstraints(btcutil.Amount(cfg.MinChanSizeSat), maxChanSize,
+		uint16(cfg.MaxChannels), uint16(cfg.MaxPendingOpens), cfg.Allocation)
+	if pendingOpens >= constraints.MaxPendingOpens() {
+		return
+	}
+	candidates := c.pr.channelCandidates(now)
+	if len(candidates) == 0 {
+		return
+	}
+	bal, err := lnd.LndWalletBalance(context.TODO(), &rpc_pb.Null{})
+	if err != nil {
+		log.Warnf("Unable to get the wallet balance to open channels: %v", err)
+		return
+	}
+	funds, num := constraints.ChannelBudget(chans, btcutil.Amount(bal.ConfirmedBalance))
+	if slots := uint32(constraints.MaxPendingOpens() - pendingOpens); num > slots {
+		num = slots
+	}
+	for _, n := range candidates {
+		if num == 0 {
+			return
+		}
+		amt := funds / btcutil.Amount(num)
+		if amt > constraints.MaxChanSize() {
+			amt = constraints.MaxChanSize()
+		}
+		if amt < constraints.MinChanSize() {
+			return
+		}
+		nodePubkey, errr := hex.DecodeString(n.LndPubkey)
+		if errr != nil {
+			continue
+		}
+		log.Infof("Opening a channel of [%d] to cjdns neighbour [%s]", int64(amt), n.CjdnsAddr)
+		_, err := lnd.LndOpenChannelSync(context.TODO(), &rpc_pb.OpenChannelRequest{
+			NodePubkey:         nodePubkey,
+			LocalFundingAmount: int64(amt),
+		})
+		c.pr.opened(n.CjdnsAddr, now, err)
+		if err != nil {
+			log.Warnf("Unable to open a channel to [%s]: %v", n.CjdnsAddr, err)
+			continue
+		}
+		funds -= amt
+		num--
+	}
+}
+
+// runPeering connects to cjdns neighbours until there are as many Lightning
+// peers as the target, and opens channels to them if configured.
+func (c *Cjdns) runPeering(lnd lndRpcServer) {
+	cfg := c.pr.config()
+	if cfg.TargetPeers == 0 {
+		return
+	}
+	peers, err := lnd.LndListPeers(context.TODO(), &rpc_pb.ListPeersRequest{})
+	if err != nil {
+		log.Warnf("Error listing lnd peers: %v", err)
+		return
+	}
+	lndPeers := make(map[string]bool, len(peers.Peers))
+	for _, p := range peers.Peers {
+		lndPeers[hex.EncodeToString(p.PubKey)] = true
+	}
+	chans, pendingOpens, withChannel, err := localChannels(lnd)
+	if err != nil {
+		log.Warnf("Error listing lnd channels: %v", err)
+		return
+	}
+	c.pr.update(c.GetNodes(), lndPeers, withChannel)
+
+	now := time.Now()
+	for _, n := range c.pr.attempt(now) {
+		if n.LndPubkey != "" {
+			go c.connectLnd(lnd, LndPubkeyResponse{CjdnsAddr: n.LndHost, lndPubkey: n.LndPubkey})
+			continue
+		}
+		log.Debugf("Sending Cjdns lnd pubkey query to: %v %v", n.CjdnsAddr, n.CjdnsPubkey)
+		if err := c.sendLndPubkeyQuery(n.CjdnsAddr, n.CjdnsPubkey); err != nil {
+			c.pr.result(n.CjdnsAddr, er.E(err))
+		}
+	}
+	if cfg.OpenChannels {
+		c.openChannels(lnd, cfg, chans, pendingOpens, now)
+	}
+}
+
+// peeringLoop keeps the Lightning peers among the cjdns neighbours, for as long
+// as cjdns is running.
+func (c *Cjdns) peeringLoop(lnd lndRpcServer) {
+	t := time.NewTicker(peeringTick)
+	defer t.Stop()
+	for {
+		select {
+		case <-c.quit:
+			return
+		case <-t.C:
+		}
+		c.runPeering(lnd)
+	}
+}
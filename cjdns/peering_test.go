@@ -0,0 +1,184 @@
+package cjdns
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+)
+
+// TestPeeringAttempt picks the neighbours to connect up to the target, and
+// checks that those which fail wait longer each time.
+func TestPeeringAttempt(t *testing.T) {
+	var nodes []CjdnsNode
+	for i := byte(1); i <= 3; i++ {
+		key := make([]byte, 32)
+		key[0] = i
+		pubkey := Base32_encode(key) + ".k"
+		ip, err := publicToIp6(pubkey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, CjdnsNode{CjdnsAddr: ip, CjdnsPubKey: pubkey})
+	}
+
+	p := newPeering()
+	if _, err := p.setConfig(&rpc_pb.CjdnsPeeringConfig{MinChanSizeSat: 2, MaxChanSizeSat: 1}); err == nil {
+		t.Fatalf("expected a max channel size below the min to be rejected")
+	}
+	_, err := p.setConfig(&rpc_pb.CjdnsPeeringConfig{TargetPeers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg := p.config(); cfg.MaxChannels != defaultMaxChannels || cfg.Allocation != defaultAllocation {
+		t.Fatalf("expected the defaults, got %v", cfg)
+	}
+
+	now := time.Now()
+	// One Lightning peer which is not a cjdns neighbour.
+	p.update(nodes, map[string]bool{"02aa": true}, nil)
+	// The node which told us its Lightning node is tried first.
+	p.answered(nodes[2].CjdnsAddr, "02cc", "["+nodes[2].CjdnsAddr+"]:9735")
+	try := p.attempt(now)
+	if len(try) != 1 || try[0].LndPubkey != "02cc" || try[0].Failures != 1 {
+		t.Fatalf("expected to connect the node which answered, got %v", try)
+	}
+	p.result(nodes[2].CjdnsAddr, er.New("connection refused"))
+
+	p.update(nodes, map[string]bool{"02aa": true}, nil)
+	try = p.attempt(now)
+	if len(try) != 1 || try[0].LndPubkey != "" {
+		t.Fatalf("expected to query a node which failed less, got %v", try)
+	}
+	queried := try[0].CjdnsAddr
+
+	// No answer, the next attempt waits twice as long.
+	later := now.Add(peeringBackoffMin)
+	if _, err := p.setConfig(&rpc_pb.CjdnsPeeringConfig{TargetPeers: 4}); err != nil {
+		t.Fatal(err)
+	}
+	p.update(nodes, map[string]bool{"02aa": true}, nil)
+	var failed *rpc_pb.CjdnsPeeringNode
+	for _, n := range p.attempt(later) {
+		if n.CjdnsAddr == queried {
+			failed = n
+		}
+	}
+	if failed == nil || failed.Failures != 2 || failed.NextAttemptSec != later.Add(2*peeringBackoffMin).Unix() {
+		t.Fatalf("expected [%s] to be retried with a longer wait, got %v", queried, failed)
+	}
+	if d := peeringBackoff(100); d != peeringBackoffMax {
+		t.Fatalf("expected the wait to be capped, got %v", d)
+	}
+
+	// Once the target is reached, nothing is tried.
+	if _, err := p.setConfig(&rpc_pb.CjdnsPeeringConfig{TargetPeers: 2}); err != nil {
+		t.Fatal(err)
+	}
+	p.update(nodes, map[string]bool{"02aa": true, "02cc": true}, map[string]bool{"02cc": true})
+	if try := p.attempt(later.Add(peeringBackoffMax)); len(try) != 0 {
+		t.Fatalf("expected no attempt at the target, got %v", try)
+	}
+	st := p.status()
+	if st.LndPeers != 2 || len(st.Nodes) != 3 {
+		t.Fatalf("unexpected status %v", st)
+	}
+	for _, n := range st.Nodes {
+		if n.LndPubkey == "02cc" && (!n.Connected || !n.HasChannel || n.Failures != 0 || n.LastError != "") {
+			t.Fatalf("expected the connected node to be reset, got %v", n)
+		}
+	}
+	if len(p.channelCandidates(later)) != 0 {
+		t.Fatalf("expected no channel to open to a node which has one")
+	}
+}
+
+// channelLnd has a wallet balance and records the channels which are opened,
+// the first opens fail.
+type channelLnd struct {
+	lndRpcServer
+	balance int64
+	fail    int
+	opened  []*rpc_pb.OpenChannelRequest
+}
+
+func (l *channelLnd) LndWalletBalance(_ context.Context, _ *rpc_pb.Null) (*rpc_pb.WalletBalanceResponse, er.R) {
+	return &rpc_pb.WalletBalanceResponse{ConfirmedBalance: l.balance}, nil
+}
+
+func (l *channelLnd) LndOpenChannelSync(_ context.Context, in *rpc_pb.OpenChannelRequest) (*rpc_pb.ChannelPoint, er.R) {
+	l.opened = append(l.opened, in)
+	if len(l.opened) <= l.fail {
+		return nil, er.New("not enough funds")
+	}
+	return &rpc_pb.ChannelPoint{}, nil
+}
+
+// TestPeeringOpenChannels splits the budget between the channels, caps them at
+// the largest channel, and checks that a node which a channel can't be opened
+// to waits before it is tried again.
+func TestPeeringOpenChannels(t *testing.T) {
+	var nodes []CjdnsNode
+	lndPeers := make(map[string]bool)
+	c := &Cjdns{pr: newPeering()}
+	for i := byte(1); i <= 3; i++ {
+		key := make([]byte, 32)
+		key[0] = i
+		pubkey := Base32_encode(key) + ".k"
+		ip, err := publicToIp6(pubkey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, CjdnsNode{CjdnsAddr: ip, CjdnsPubKey: pubkey})
+		lndPubkey := "02" + hex.EncodeToString(key)
+		lndPeers[lndPubkey] = true
+		c.pr.update(nodes, nil, nil)
+		c.pr.answered(ip, lndPubkey, "["+ip+"]:9735")
+	}
+	c.pr.update(nodes, lndPeers, nil)
+	cfg, err := c.pr.setConfig(&rpc_pb.CjdnsPeeringConfig{TargetPeers: 3, MaxPendingOpens: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 60% of the funds for 2 channels, the first open fails.
+	now := time.Now()
+	lnd := &channelLnd{balance: 1000000, fail: 1}
+	c.openChannels(lnd, cfg, nil, 0, now)
+	if len(lnd.opened) != 3 {
+		t.Fatalf("expected 3 opens, got %v", lnd.opened)
+	}
+	for _, req := range lnd.opened {
+		if req.LocalFundingAmount != 300000 {
+			t.Fatalf("expected channels of 300000, got %v", req)
+		}
+	}
+	var failed *rpc_pb.CjdnsPeeringNode
+	for _, n := range c.pr.status().Nodes {
+		if !n.HasChannel {
+			failed = n
+		}
+	}
+	if failed == nil || failed.Failures != 1 || failed.LastError == "" ||
+		failed.NextAttemptSec != now.Add(peeringBackoffMin).Unix() {
+		t.Fatalf("expected the failed node to wait, got %v", failed)
+	}
+	if len(c.pr.channelCandidates(now)) != 0 {
+		t.Fatalf("expected the failed node not to be tried again at once")
+	}
+	if len(c.pr.channelCandidates(now.Add(peeringBackoffMin))) != 1 {
+		t.Fatalf("expected the failed node to be tried again after the backoff")
+	}
+
+	// The channels are no larger than lnd's maxchansize.
+	c.pr.setMaxChanSize(100000)
+	c.pr.update(nodes, lndPeers, nil)
+	lnd = &channelLnd{balance: 1000000}
+	c.openChannels(lnd, cfg, nil, 0, now.Add(peeringBackoffMin))
+	if len(lnd.opened) != 2 || lnd.opened[0].LocalFundingAmount != 100000 {
+		t.Fatalf("expected channels of 100000, got %v", lnd.opened)
+	}
+}
@@ -75,33 +75,27 @@ func (c *Cjdns) getSendConn() (*net.UDPConn, error) {
 	return conn, nil
 }
 
-func getDeviceAddr(device string) (string, error) {
+// findDevice returns the name of the network interface which has the address of
+// this cjdns node, the tun device which cjdns created.
+func findDevice(ipv6 string) (string, error) {
+	ip := net.ParseIP(ipv6)
+	if ip == nil {
+		return "", errors.New("invalid address")
+	}
 	ifaces, err := net.Interfaces()
 	if err != nil {
 		log.Errorf("Error getting interfaces for CJDNS connection: %v", err)
 		return "", err
 	}
-	deviceAddr := ""
 	for _, iface := range ifaces {
-		if iface.Name == device {
-			addrs, err := iface.Addrs()
-			if err != nil {
-				log.Errorf("Error getting CJDNS addresses at tun0: %v", err)
-				return "", err
-			}
-
-			for _, addr := range addrs {
-				ip, _, err := net.ParseCIDR(addr.String())
-				if err != nil {
-					log.Errorf("Error parsing CIDR: %v", err)
-					return "", err
-				}
-				deviceAddr = ip.String()
-				break
-			}
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
 		}
-		if deviceAddr != "" {
-			return deviceAddr, nil
+		for _, addr := range addrs {
+			if ipn, ok := addr.(*net.IPNet); ok && ipn.IP.Equal(ip) {
+				return iface.Name, nil
+			}
 		}
 	}
 	return "", errors.New("device not found")
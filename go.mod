@@ -35,6 +35,7 @@ require (
 	golang.org/x/crypto v0.13.0
 	golang.org/x/net v0.10.0
 	golang.org/x/sys v0.12.0
+	golang.org/x/text v0.13.0
 	golang.org/x/time v0.0.0-20180412165947-fbb02b2291d2
 	google.golang.org/grpc v1.44.0
 	google.golang.org/protobuf v1.27.1
@@ -56,7 +57,6 @@ require (
 	github.com/rogpeppe/fastuuid v1.2.0 // indirect
 	golang.org/x/exp v0.0.0-20230905200255-921286631fa9 // indirect
 	golang.org/x/term v0.12.0 // indirect
-	golang.org/x/text v0.13.0 // indirect
 	google.golang.org/genproto v0.0.0-20220204002441-d6cc3cc0770e // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
 	gopkg.in/errgo.v1 v1.0.1 // indirect
@@ -0,0 +1,175 @@
+package cert
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/lock"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+const (
+	// DefaultRenewBefore is how long before expiration an auto-generated
+	// certificate will be replaced.
+	DefaultRenewBefore = 30 * 24 * time.Hour
+
+	// How often to check whether the certificate needs to be renewed or
+	// reloaded from disk.
+	autoCertCheckInterval = 12 * time.Hour
+)
+
+// AutoCertConfig describes where the certificate is stored and what it should
+// contain if it needs to be generated.
+type AutoCertConfig struct {
+	Org             string
+	CertPath        string
+	KeyPath         string
+	ExtraIPs        []string
+	ExtraDomains    []string
+	DisableAutofill bool
+
+	// If true, regenerate the certificate when the IPs and domains which
+	// it would contain have changed.
+	AutoRefresh bool
+}
+
+// AutoCert serves a certificate from disk, if there is none then a self-signed
+// certificate is generated and it will be regenerated before it expires. If
+// the certificate on disk is not self-signed then it is never overwritten, but
+// it is reloaded periodically so that externally renewed certificates are
+// picked up without a restart.
+type AutoCert struct {
+	conf AutoCertConfig
+	cert lock.GenRwLock[*tls.Certificate]
+}
+
+func isSelfSigned(c *x509.Certificate) bool {
+	return c.CheckSignatureFrom(c) == nil
+}
+
+// needsRegen determines whether the certificate at the configured paths
+// should be replaced by a newly generated one.
+func (a *AutoCert) needsRegen() (bool, er.R) {
+	if _, err := os.Stat(a.conf.CertPath); os.IsNotExist(err) {
+		return true, nil
+	}
+	if _, err := os.Stat(a.conf.KeyPath); os.IsNotExist(err) {
+		return true, nil
+	}
+	_, parsed, errr := LoadCert(a.conf.CertPath, a.conf.KeyPath)
+	if errr != nil {
+		return false, er.Errorf("Unable to load TLS certificate [%s]: [%s]",
+			a.conf.CertPath, errr)
+	}
+	if !isSelfSigned(parsed) {
+		return false, nil
+	}
+	if time.Until(parsed.NotAfter) < DefaultRenewBefore {
+		log.Infof("TLS certificate [%s] expires at [%s], renewing",
+			a.conf.CertPath, parsed.NotAfter)
+		return true, nil
+	}
+	if a.conf.AutoRefresh {
+		outdated, err := IsOutdated(parsed, a.conf.ExtraIPs,
+			a.conf.ExtraDomains, a.conf.DisableAutofill)
+		if err != nil {
+			return false, err
+		}
+		if outdated {
+			log.Infof("TLS certificate [%s] IPs or domains have changed, "+
+				"regenerating", a.conf.CertPath)
+		}
+		return outdated, nil
+	}
+	return false, nil
+}
+
+// refresh generates the certificate if needed and then (re)loads it from disk.
+func (a *AutoCert) refresh() er.R {
+	if regen, err := a.needsRegen(); err != nil {
+		return err
+	} else if regen {
+		if err := GenCertPair(a.conf.Org, a.conf.CertPath, a.conf.KeyPath,
+			a.conf.ExtraIPs, a.conf.ExtraDomains, a.conf.DisableAutofill,
+			DefaultAutogenValidity); err != nil {
+			return err
+		}
+		log.Infof("Generated TLS certificate [%s]", a.conf.CertPath)
+	}
+	certData, _, errr := LoadCert(a.conf.CertPath, a.conf.KeyPath)
+	if errr != nil {
+		return er.Errorf("Unable to load TLS certificate [%s]: [%s]",
+			a.conf.CertPath, errr)
+	}
+	return a.cert.W().In(func(c **tls.Certificate) er.R {
+		*c = &certData
+		return nil
+	})
+}
+
+// NewAutoCert loads, or generates, the certificate described by conf.
+func NewAutoCert(conf AutoCertConfig) (*AutoCert, er.R) {
+	a := &AutoCert{
+		conf: conf,
+		cert: lock.NewGenRwLock[*tls.Certificate](nil, "AutoCert"),
+	}
+	if err := a.refresh(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetCertificate returns the current certificate, it is suitable for use as
+// tls.Config.GetCertificate.
+func (a *AutoCert) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	var out *tls.Certificate
+	a.cert.R().In(func(c **tls.Certificate) er.R {
+		out = *c
+		return nil
+	})
+	return out, nil
+}
+
+// TLSConfig returns a server TLS configuration which uses this certificate.
+// If clientCAPath is non-empty, clients must present a certificate which is
+// signed by one of the CA certificates in that PEM file.
+func (a *AutoCert) TLSConfig(clientCAPath string) (*tls.Config, er.R) {
+	conf := &tls.Config{
+		GetCertificate: a.GetCertificate,
+		CipherSuites:   tlsCipherSuites,
+		MinVersion:     tls.VersionTLS12,
+	}
+	if clientCAPath == "" {
+		return conf, nil
+	}
+	pem, errr := os.ReadFile(clientCAPath)
+	if errr != nil {
+		return nil, er.E(errr)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, er.Errorf("No certificates found in [%s]", clientCAPath)
+	}
+	conf.ClientCAs = pool
+	conf.ClientAuth = tls.RequireAndVerifyClientCert
+	return conf, nil
+}
+
+// Run periodically renews or reloads the certificate until quit is closed.
+func (a *AutoCert) Run(quit <-chan struct{}) {
+	t := time.NewTicker(autoCertCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-t.C:
+		}
+		if err := a.refresh(); err != nil {
+			log.Warnf("Unable to refresh TLS certificate: %v", err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package cert_test
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/lnd/cert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAutoCertRenew checks that a certificate is generated if missing and
+// that it is regenerated when it is close to expiring.
+func TestAutoCertRenew(t *testing.T) {
+	tempDir := t.TempDir()
+	conf := cert.AutoCertConfig{
+		Org:          "autocert test",
+		CertPath:     filepath.Join(tempDir, "tls.cert"),
+		KeyPath:      filepath.Join(tempDir, "tls.key"),
+		ExtraDomains: extraDomains,
+	}
+
+	ac, err := cert.NewAutoCert(conf)
+	util.RequireNoErr(t, err)
+	c, errr := ac.GetCertificate(nil)
+	require.NoError(t, errr)
+	require.NotNil(t, c)
+
+	// A certificate which is not near expiry is reused.
+	_, first, errr := cert.LoadCert(conf.CertPath, conf.KeyPath)
+	require.NoError(t, errr)
+	_, err = cert.NewAutoCert(conf)
+	util.RequireNoErr(t, err)
+	_, second, errr := cert.LoadCert(conf.CertPath, conf.KeyPath)
+	require.NoError(t, errr)
+	require.Equal(t, first.SerialNumber, second.SerialNumber)
+
+	// Replace it with one which expires soon, it should be renewed.
+	err = cert.GenCertPair(
+		conf.Org, conf.CertPath, conf.KeyPath, nil, extraDomains,
+		false, time.Hour,
+	)
+	util.RequireNoErr(t, err)
+	_, err = cert.NewAutoCert(conf)
+	util.RequireNoErr(t, err)
+	_, renewed, errr := cert.LoadCert(conf.CertPath, conf.KeyPath)
+	require.NoError(t, errr)
+	require.True(t, time.Until(renewed.NotAfter) > cert.DefaultRenewBefore)
+}
+
+// TestAutoCertClientCA checks that setting a client CA requires clients to
+// present a certificate.
+func TestAutoCertClientCA(t *testing.T) {
+	tempDir := t.TempDir()
+	ac, err := cert.NewAutoCert(cert.AutoCertConfig{
+		Org:      "autocert test",
+		CertPath: filepath.Join(tempDir, "tls.cert"),
+		KeyPath:  filepath.Join(tempDir, "tls.key"),
+	})
+	util.RequireNoErr(t, err)
+
+	conf, err := ac.TLSConfig("")
+	util.RequireNoErr(t, err)
+	require.Equal(t, tls.NoClientCert, conf.ClientAuth)
+
+	// Any PEM certificate will do as a CA for the purpose of the test.
+	conf, err = ac.TLSConfig(filepath.Join(tempDir, "tls.cert"))
+	util.RequireNoErr(t, err)
+	require.Equal(t, tls.RequireAndVerifyClientCert, conf.ClientAuth)
+	require.NotNil(t, conf.ClientCAs)
+
+	_, err = ac.TLSConfig(filepath.Join(tempDir, "tls.key"))
+	util.RequireErr(t, err)
+}
@@ -10,8 +10,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"net/http"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -25,27 +25,63 @@ func bright(str string) string {
 	return log.Bright + str + log.Reset
 }
 
-//	show a fancy output for the master help
-func getMasterHelp(pldServer string) er.R {
-
-	response, errr := http.Get(pldServer + "/api/v1/help")
-	if errr != nil {
-		return er.New("Fail executing pld command: " + errr.Error())
+//	get the master help, which lists the categories and commands
+func (c *pldClient) getMasterCategory() (*help_pb.Category, er.R) {
+	if c.master != nil {
+		return c.master, nil
 	}
 
-	responsePayload, err := io.ReadAll(response.Body)
+	response, err := c.do("GET", "/api/v1/help", "", nil)
 	if err != nil {
-		return er.Errorf("Fail reading command response payload from pld server: %s", err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responsePayload, errr := io.ReadAll(response.Body)
+	if errr != nil {
+		return nil, er.Errorf("Fail reading command response payload from pld server: %s", errr)
 	}
 	if err := checkForServerError(responsePayload); err != nil {
-		return er.Errorf("Unable to get help master help from the server, is [%s] a pld instance?\nError: [%s]",
-			pldServer, err.Message())
+		return nil, er.Errorf("Unable to get help master help from the server, is [%s] a pld instance?\nError: [%s]",
+			c.server, err.Message())
 	}
 
 	mainCat := help_pb.Category{}
 	if err := protojson.Unmarshal(responsePayload, &mainCat); err != nil {
-		return er.Errorf("Failed to unmarshal help response payload [%s]", err)
+		return nil, er.Errorf("Failed to unmarshal help response payload [%s]", err)
+	}
+	c.master = &mainCat
+	return c.master, nil
+}
+
+//	the commands of all categories
+func (c *pldClient) getCommands() ([]string, er.R) {
+	mainCat, err := c.getMasterCategory()
+	if err != nil {
+		return nil, err
+	}
+	var commands []string
+	var walk func(category *help_pb.Category)
+	walk = func(category *help_pb.Category) {
+		for _, endpoint := range category.Endpoints {
+			commands = append(commands, strings.TrimPrefix(endpoint.HelpPath, "/api/v1/help/"))
+		}
+		for _, subcategory := range category.Categories {
+			walk(subcategory)
+		}
+	}
+	walk(mainCat)
+	sort.Strings(commands)
+	return commands, nil
+}
+
+//	show a fancy output for the master help
+func (c *pldClient) getMasterHelp() er.R {
+	mainCat, err := c.getMasterCategory()
+	if err != nil {
+		return err
 	}
+	pldServer := c.server
 
 	//var masterHelp = help.RESTMaster_help()
 
@@ -64,6 +100,19 @@ func getMasterHelp(pldServer string) er.R {
 		"or " + bright("pldctl unlock --start_lightning")+" to start lightning",
 		"which will prompt you for your wallet password.",
 		"",
+		"Options of a command which are messages are given as " + bright("--field.subfield=value"),
+		"or as JSON with " + bright("--field='{\"subfield\":\"value\"}'") + ", repeated options are",
+		"given once per element or as a JSON array with " + bright("--field='[\"a\",\"b\"]'") + ".",
+		"Bytes are base64 unless they are prefixed with " + bright("hex:") + " or " + bright("text:") + ".",
+		"",
+		bright("pldctl shell") + " opens an interactive shell where commands and their options",
+		"can be completed with the tab key, and " + bright("-output=table") + " shows responses",
+		"as tables rather than JSON.",
+		"",
+		"The server, token and TLS settings can be saved as a profile with",
+		bright("pldctl -pld_server=https://host:8080 -token_file=admin.token profile save <name>"),
+		"and used with " + bright("pldctl -profile=<name>") + ", see " + bright("pldctl profile list") + ".",
+		"",
 		"In addition, each command corresponds to an RPC endpoint which",
 		"can be requested directly. So for example the command:",
 		bright("pldctl meta/getinfo") + " is the same as API request:",
@@ -126,26 +175,29 @@ func showCategory(name string, category *help_pb.Category, indent string) {
 	}
 }
 
-func getEndpointHelp(helpUrl string) (*help_pb.EndpointHelp, er.R) {
-	out := help_pb.EndpointHelp{}
-	req, err := http.NewRequest("GET", helpUrl, nil)
+func (c *pldClient) getEndpointHelp(command string) (*help_pb.EndpointHelp, er.R) {
+	if help, ok := c.helpCache[command]; ok {
+		return help, nil
+	}
+	res, err := c.do("GET", "/api/v1/help/"+command, "application/protobuf", nil)
 	if err != nil {
-		return nil, er.E(err)
+		return nil, err
 	}
-	req.Header.Add("Content-Type", "application/protobuf")
-	if res, err := http.DefaultClient.Do(req); err != nil {
-		return nil, er.E(err)
-	} else if res.StatusCode != 200 {
+	defer res.Body.Close()
+
+	out := help_pb.EndpointHelp{}
+	if res.StatusCode != 200 {
 		if res.StatusCode == 404 {
 			return nil, er.Errorf("No such endpoint, try `pldctl help` for a list")
 		}
 		return nil, er.Errorf("Unexpected status code: [%d] from url [%s]",
-			res.StatusCode, helpUrl)
+			res.StatusCode, c.server+"/api/v1/help/"+command)
 	} else if b, err := ioutil.ReadAll(res.Body); err != nil {
 		return nil, er.E(err)
 	} else if err := proto.Unmarshal(b, &out); err != nil {
 		return nil, er.E(err)
 	}
+	c.helpCache[command] = &out
 	return &out, nil
 }
 
@@ -156,7 +208,7 @@ func printCommandHelp(endpointHelp *help_pb.EndpointHelp) er.R {
 	}
 	fmt.Fprintf(os.Stdout, "\n")
 
-	if len(endpointHelp.Request.Fields) > 0 {
+	if endpointHelp.Request != nil && len(endpointHelp.Request.Fields) > 0 {
 		fmt.Fprintf(os.Stdout, "OPTIONS:\n")
 		for _, requestField := range endpointHelp.Request.Fields {
 			showField("", requestField)
@@ -169,51 +221,48 @@ func printCommandHelp(endpointHelp *help_pb.EndpointHelp) er.R {
 //	show help line on a specific command CLI argument
 func showField(fieldHierarchy string, requestField *help_pb.Field) {
 
-	if len(requestField.Type.Fields) == 0 {
-
-		var commandOption string
+	commandOption := "--" + requestField.Name
+	if len(fieldHierarchy) > 0 {
+		commandOption = "--" + fieldHierarchy + "." + requestField.Name
+	}
 
-		if len(fieldHierarchy) == 0 {
-			commandOption = "--" + requestField.Name
-		} else {
-			commandOption = "--" + fieldHierarchy + "." + requestField.Name
+	//	the fields of a nested message are options of their own
+	if isMessage(requestField.Type) && !requestField.Repeated {
+		for _, requestSubField := range requestField.Type.Fields {
+			showField(commandOption[2:], requestSubField)
 		}
+		return
+	}
 
-		switch requestField.Type.Name {
-		case "bool":
-
-		case "[]byte":
-			commandOption += "=value"
-		case "string":
-			commandOption += "=value"
-		case "uint32":
-			commandOption += "=value"
-		case "int32":
-			commandOption += "=value"
-		case "uint64":
-			commandOption += "=value"
-		case "int64":
-			commandOption += "=value"
+	switch {
+	case mapValue(requestField) != nil:
+		commandOption += ".key=value"
+	case isMessage(requestField.Type):
+		commandOption += "='[{...}]'"
+	case isEnum(requestField.Type):
+		var names []string
+		for _, varient := range requestField.Type.Fields {
+			names = append(names, varient.Name)
 		}
+		commandOption += "=" + strings.Join(names, "|")
+	case requestField.Type.Name == "bool" && !requestField.Repeated:
+	case requestField.Type.Name == bytesTypeName:
+		commandOption += "=base64|hex:value"
+	default:
+		commandOption += "=value"
+	}
+	if requestField.Repeated && mapValue(requestField) == nil && !isMessage(requestField.Type) {
+		commandOption += " ..."
+	}
 
-		if len(requestField.Description) == 0 {
-			fmt.Fprintf(os.Stdout, "  %s\n", commandOption)
-		} else {
-			for i, description := range requestField.Description {
-				if i == 0 {
-					fmt.Fprintf(os.Stdout, "  %s - %s\n", commandOption, description)
-				} else {
-					fmt.Fprintf(os.Stdout, "    %s\n", description)
-				}
-			}
-		}
+	if len(requestField.Description) == 0 {
+		fmt.Fprintf(os.Stdout, "  %s\n", commandOption)
 	} else {
-
-		for _, requestSubField := range requestField.Type.Fields {
-			if len(fieldHierarchy) == 0 {
-				showField(requestField.Name, requestSubField)
+		for i, description := range requestField.Description {
+			if i == 0 {
+				fmt.Fprintf(os.Stdout, "  %s - %s\n", commandOption, description)
 			} else {
-				showField(fieldHierarchy+"."+requestField.Name, requestSubField)
+				fmt.Fprintf(os.Stdout, "    %s\n", description)
 			}
 		}
 	}
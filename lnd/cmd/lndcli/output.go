@@ -0,0 +1,182 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lndcli/output.go  -  Oct-17-2026
+//
+//	Show the response of a command as JSON or as a table
+////////////////////////////////////////////////////////////////////////////////
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+)
+
+func printResponse(w io.Writer, responsePayload []byte, output string) {
+	if output == outputTable {
+		var v interface{}
+		if err := decodeJSON(string(responsePayload), &v); err == nil {
+			printTable(w, v)
+			return
+		}
+	}
+	fmt.Fprintf(w, "%s\n", responsePayload)
+}
+
+//	the fields of a response are shown as rows of name and value, with the name of a
+//	nested field being it's path. A list of messages is shown after them as a table with
+//	a column for each field.
+func printTable(w io.Writer, v interface{}) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+
+	switch value := v.(type) {
+	case map[string]interface{}:
+		var lists []string
+		rows := make(map[string]string)
+		var names []string
+		flattenTable("", value, rows, &names, &lists)
+		for _, name := range names {
+			fmt.Fprintf(tw, "%s\t%s\n", name, rows[name])
+		}
+		tw.Flush()
+		for _, path := range lists {
+			fmt.Fprintf(w, "\n%s\n", path)
+			printList(w, lookupPath(value, path).([]interface{}))
+		}
+	case []interface{}:
+		tw.Flush()
+		printList(w, value)
+	default:
+		fmt.Fprintf(tw, "%s\n", formatCell(value))
+	}
+}
+
+//	flatten the fields of a message into rows, the lists of messages are only named
+//	because they are shown as tables
+func flattenTable(prefix string, m map[string]interface{}, rows map[string]string, names, lists *[]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		name := k
+		if prefix != "" {
+			name = prefix + "." + k
+		}
+		switch value := m[k].(type) {
+		case map[string]interface{}:
+			flattenTable(name, value, rows, names, lists)
+		case []interface{}:
+			if isListOfMessages(value) {
+				*lists = append(*lists, name)
+				continue
+			}
+			rows[name] = formatCell(value)
+			*names = append(*names, name)
+		default:
+			rows[name] = formatCell(value)
+			*names = append(*names, name)
+		}
+	}
+}
+
+func isListOfMessages(list []interface{}) bool {
+	if len(list) == 0 {
+		return false
+	}
+	for _, elem := range list {
+		if _, ok := elem.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func lookupPath(m map[string]interface{}, path string) interface{} {
+	var v interface{} = m
+	for _, k := range strings.Split(path, ".") {
+		v = v.(map[string]interface{})[k]
+	}
+	return v
+}
+
+//	show a list as a table, with a column for each field which any of it's messages has
+func printList(w io.Writer, list []interface{}) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	defer tw.Flush()
+	if !isListOfMessages(list) {
+		for _, elem := range list {
+			fmt.Fprintf(tw, "%s\n", formatCell(elem))
+		}
+		return
+	}
+
+	var columns []string
+	seen := make(map[string]bool)
+	table := make([]map[string]string, 0, len(list))
+	for _, elem := range list {
+		rows := make(map[string]string)
+		var names, lists []string
+		flattenTable("", elem.(map[string]interface{}), rows, &names, &lists)
+		//	nested lists of messages are too big for a cell, they're shown as JSON
+		for _, path := range lists {
+			rows[path] = formatCell(lookupPath(elem.(map[string]interface{}), path))
+			names = append(names, path)
+		}
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				columns = append(columns, name)
+			}
+		}
+		table = append(table, rows)
+	}
+
+	fmt.Fprintf(tw, "%s\n", strings.Join(columns, "\t"))
+	for _, rows := range table {
+		cells := make([]string, 0, len(columns))
+		for _, column := range columns {
+			cells = append(cells, rows[column])
+		}
+		fmt.Fprintf(tw, "%s\n", strings.Join(cells, "\t"))
+	}
+}
+
+func formatCell(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		if value {
+			return "true"
+		}
+		return "false"
+	case []interface{}:
+		if !isListOfMessages(value) {
+			cells := make([]string, 0, len(value))
+			for _, elem := range value {
+				cells = append(cells, formatCell(elem))
+			}
+			return strings.Join(cells, ", ")
+		}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,275 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lndcli/profile.go  -  Oct-17-2026
+//
+//	Connection settings of the pld client, which can be saved as named profiles
+////////////////////////////////////////////////////////////////////////////////
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/help_pb"
+)
+
+const defaultPldServer = "http://localhost:8080"
+
+var (
+	//	profiles are kept in ~/.pldctl/profiles.json
+	defaultProfilesPath = filepath.Join(btcutil.AppDataDir("pldctl", false), "profiles.json")
+
+	//	the token and certificate which a local pld creates with --restauth and --resttls
+	defaultLndDir    = filepath.Join(btcutil.AppDataDir("pktwallet", false), "lnd")
+	defaultTokenPath = filepath.Join(defaultLndDir, "data", "admin.token")
+	defaultTLSCert   = filepath.Join(defaultLndDir, "tls.cert")
+)
+
+//	how to connect to a pld server
+type connection struct {
+	Server        string `json:"server"`
+	Token         string `json:"token,omitempty"`
+	TokenFile     string `json:"token_file,omitempty"`
+	TLSCert       string `json:"tls_cert,omitempty"`
+	TLSClientCert string `json:"tls_client_cert,omitempty"`
+	TLSClientKey  string `json:"tls_client_key,omitempty"`
+	Insecure      bool   `json:"insecure,omitempty"`
+	Output        string `json:"output,omitempty"`
+}
+
+type profiles struct {
+	Default  string                 `json:"default,omitempty"`
+	Profiles map[string]*connection `json:"profiles"`
+}
+
+//	a missing profiles file is the same as one with no profiles
+func loadProfiles(path string) (*profiles, er.R) {
+	p := &profiles{Profiles: make(map[string]*connection)}
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	} else if err != nil {
+		return nil, er.E(err)
+	}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, er.Errorf("Unable to parse [%s]: [%s]", path, err)
+	}
+	if p.Profiles == nil {
+		p.Profiles = make(map[string]*connection)
+	}
+	return p, nil
+}
+
+//	profiles may contain tokens, so only the user can read them
+func saveProfiles(path string, p *profiles) er.R {
+	b, err := json.MarshalIndent(p, "", "    ")
+	if err != nil {
+		return er.E(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return er.E(err)
+	}
+	return er.E(os.WriteFile(path, append(b, '\n'), 0600))
+}
+
+//	manage the saved profiles:
+//	profile list, profile save <name>, profile remove <name>, profile default <name>
+func profileCommand(path string, conn *connection, arguments []string) er.R {
+	p, err := loadProfiles(path)
+	if err != nil {
+		return err
+	}
+	if len(arguments) == 0 || arguments[0] == "list" {
+		return listProfiles(os.Stdout, p)
+	}
+	if len(arguments) != 2 {
+		return er.Errorf("usage: pldctl profile list|save <name>|remove <name>|default <name>")
+	}
+	name := arguments[1]
+	switch arguments[0] {
+	case "save":
+		saved := *conn
+		p.Profiles[name] = &saved
+		if len(p.Profiles) == 1 {
+			p.Default = name
+		}
+	case "remove":
+		if _, ok := p.Profiles[name]; !ok {
+			return er.Errorf("No such profile [%s]", name)
+		}
+		delete(p.Profiles, name)
+		if p.Default == name {
+			p.Default = ""
+		}
+	case "default":
+		if _, ok := p.Profiles[name]; !ok && name != "" {
+			return er.Errorf("No such profile [%s]", name)
+		}
+		p.Default = name
+	default:
+		return er.Errorf("Unknown profile command [%s], try list, save, remove or default", arguments[0])
+	}
+	return saveProfiles(path, p)
+}
+
+func listProfiles(w io.Writer, p *profiles) er.R {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "\tNAME\tSERVER\tAUTH\tTLS\n")
+	for _, name := range names {
+		conn := p.Profiles[name]
+		def := ""
+		if name == p.Default {
+			def = "*"
+		}
+		auth := ""
+		if conn.Token != "" {
+			auth = "token"
+		} else if conn.TokenFile != "" {
+			auth = conn.TokenFile
+		}
+		tlsInfo := conn.TLSCert
+		if conn.Insecure {
+			tlsInfo = "insecure"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", def, name, conn.Server, auth, tlsInfo)
+	}
+	return er.E(tw.Flush())
+}
+
+//	a client of one pld server
+type pldClient struct {
+	server             string
+	token              string
+	http               *http.Client
+	output             string
+	showRequestPayload bool
+
+	//	the help is fetched once
+	master    *help_pb.Category
+	helpCache map[string]*help_pb.EndpointHelp
+}
+
+func isLocalServer(server *url.URL) bool {
+	host := server.Hostname()
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func newClient(conn *connection) (*pldClient, er.R) {
+	server := conn.Server
+	if server == "" {
+		server = defaultPldServer
+	}
+	//	if a protocol is missing from pld_server, assume HTTP as default
+	if !strings.HasPrefix(server, "http://") && !strings.HasPrefix(server, "https://") {
+		server = "http://" + server
+	}
+	server = strings.TrimSuffix(server, "/")
+	serverURL, errr := url.Parse(server)
+	if errr != nil {
+		return nil, er.Errorf("Invalid pld server [%s]: [%s]", server, errr)
+	}
+
+	c := &pldClient{
+		server:    server,
+		token:     conn.Token,
+		output:    conn.Output,
+		helpCache: make(map[string]*help_pb.EndpointHelp),
+	}
+
+	//	the admin token of a pld on this machine is used unless another is given,
+	//	it is never sent anywhere else
+	tokenFile := conn.TokenFile
+	if c.token == "" && tokenFile == "" && isLocalServer(serverURL) && fileExists(defaultTokenPath) {
+		tokenFile = defaultTokenPath
+	}
+	if c.token == "" && tokenFile != "" {
+		b, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return nil, er.Errorf("Unable to read the token: [%s]", err)
+		}
+		c.token = strings.TrimSpace(string(b))
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: conn.Insecure}
+	certFile := conn.TLSCert
+	if certFile == "" && serverURL.Scheme == "https" && isLocalServer(serverURL) && fileExists(defaultTLSCert) {
+		certFile = defaultTLSCert
+	}
+	if certFile != "" {
+		pem, err := os.ReadFile(certFile)
+		if err != nil {
+			return nil, er.Errorf("Unable to read the TLS certificate: [%s]", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, er.Errorf("No certificates found in [%s]", certFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if conn.TLSClientCert != "" || conn.TLSClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(conn.TLSClientCert, conn.TLSClientKey)
+		if err != nil {
+			return nil, er.Errorf("Unable to load the TLS client certificate: [%s]", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	c.http = &http.Client{Transport: transport}
+
+	switch c.output {
+	case "":
+		c.output = outputJSON
+	case outputJSON, outputTable:
+	default:
+		return nil, er.Errorf("Unknown output [%s], use %s or %s", c.output, outputJSON, outputTable)
+	}
+	return c, nil
+}
+
+//	send a request to pld, with the token if there is one
+func (c *pldClient) do(method, path, contentType string, body io.Reader) (*http.Response, er.R) {
+	req, err := http.NewRequest(method, c.server+path, body)
+	if err != nil {
+		return nil, er.E(err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, er.New("fail executing pld command: " + err.Error())
+	}
+	return res, nil
+}
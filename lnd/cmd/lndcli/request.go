@@ -0,0 +1,430 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lndcli/request.go  -  Oct-17-2026
+//
+//	Build the JSON request payload of a command from its CLI arguments
+////////////////////////////////////////////////////////////////////////////////
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/help_pb"
+)
+
+//	the arguments of a command are --field=value, where the field is found in the
+//	request type of the endpoint's help, and the value is parsed according to it's type:
+//
+//	--field             a bool is set to true
+//	--a.b=value         a field of a nested message
+//	--field=[1,2,3]     a repeated field is a JSON array, or the option is given once per element
+//	--field={"a":1}     a message is a JSON object, parsed with the same rules as the options
+//	--field.key=value   an entry of a map
+//	--field=hex:0a0b    bytes are base64 unless they're prefixed with hex: or text:
+//	--field=NAME        an enum is the name of one of it's values, or it's number
+const (
+	enumVarientTypeName = "ENUM_VARIENT"
+	bytesTypeName       = "[]byte"
+)
+
+//	based on pld's command path, parse the CLI arguments to build the request payload
+func buildRequest(endpointHelp *help_pb.EndpointHelp, arguments []string) (map[string]interface{}, er.R) {
+	request := make(map[string]interface{})
+	for _, argument := range arguments {
+		if err := setArgument(endpointHelp.Request, request, argument); err != nil {
+			return nil, er.Errorf("invalid command argument: %s: %s", argument, err)
+		}
+	}
+	return request, nil
+}
+
+//	the request payload is empty when the command can be invoked by GET and there are no arguments
+func formatRequestPayload(endpointHelp *help_pb.EndpointHelp, arguments []string) (string, er.R) {
+	if len(arguments) == 0 && util.Contains(endpointHelp.Features, help_pb.F_ALLOW_GET) {
+		return "", nil
+	}
+	request, err := buildRequest(endpointHelp, arguments)
+	if err != nil {
+		return "", err
+	}
+	payload, errr := json.Marshal(request)
+	if errr != nil {
+		return "", er.E(errr)
+	}
+	return string(payload), nil
+}
+
+//	an enum is a type whose fields are all the enum's values
+func isEnum(t *help_pb.Type) bool {
+	if t == nil || len(t.Fields) == 0 {
+		return false
+	}
+	for _, f := range t.Fields {
+		if f.Type == nil || f.Type.Name != enumVarientTypeName {
+			return false
+		}
+	}
+	return true
+}
+
+func isMessage(t *help_pb.Type) bool {
+	return t != nil && len(t.Fields) > 0 && !isEnum(t)
+}
+
+//	maps are described as a repeated message of key and value
+func mapValue(f *help_pb.Field) *help_pb.Field {
+	if !f.Repeated || f.Type == nil || !strings.HasSuffix(f.Type.Name, "Entry") || len(f.Type.Fields) != 2 {
+		return nil
+	}
+	if findField(f.Type, "key") == nil {
+		return nil
+	}
+	return findField(f.Type, "value")
+}
+
+func findField(t *help_pb.Type, name string) *help_pb.Field {
+	if t == nil {
+		return nil
+	}
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+//	parse a single --field=value argument into the request
+func setArgument(requestType *help_pb.Type, request map[string]interface{}, argument string) error {
+	if !strings.HasPrefix(argument, "--") {
+		return errors.New("options must be given as --field=value")
+	}
+	name, value, hasValue := strings.Cut(argument[2:], "=")
+	path := strings.Split(name, ".")
+
+	t := requestType
+	obj := request
+	for i, part := range path {
+		f := findField(t, part)
+		if f == nil {
+			return fmt.Errorf("no such field [%s]", strings.Join(path[:i+1], "."))
+		}
+		rest := path[i+1:]
+		switch {
+		case len(rest) == 0:
+			return setField(obj, f, value, hasValue)
+
+		case mapValue(f) != nil:
+			if !hasValue {
+				return fmt.Errorf("[%s] requires a value", name)
+			}
+			key := strings.Join(rest, ".")
+			if _, err := parseScalar(findField(f.Type, "key").Type, key); err != nil {
+				return fmt.Errorf("invalid key [%s]: %s", key, err)
+			}
+			v, err := parseScalar(mapValue(f).Type, value)
+			if err != nil {
+				return err
+			}
+			m, ok := obj[f.Name].(map[string]interface{})
+			if !ok {
+				m = make(map[string]interface{})
+				obj[f.Name] = m
+			}
+			m[key] = v
+			return nil
+
+		case isMessage(f.Type) && !f.Repeated:
+			child, ok := obj[f.Name].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				obj[f.Name] = child
+			}
+			t = f.Type
+			obj = child
+
+		case f.Repeated:
+			return fmt.Errorf("[%s] is a list, it must be given as a JSON array", strings.Join(path[:i+1], "."))
+
+		default:
+			return fmt.Errorf("[%s] has no fields", strings.Join(path[:i+1], "."))
+		}
+	}
+	return nil
+}
+
+//	set the value of a field, a repeated field which is not given as a JSON array is
+//	appended to
+func setField(obj map[string]interface{}, f *help_pb.Field, value string, hasValue bool) error {
+	if !hasValue {
+		if f.Type.Name == "bool" && !f.Repeated {
+			obj[f.Name] = true
+			return nil
+		}
+		return fmt.Errorf("a value is required, use --%s=value", f.Name)
+	}
+
+	trimmed := strings.TrimSpace(value)
+	if f.Repeated && mapValue(f) == nil && !strings.HasPrefix(trimmed, "[") {
+		v, err := parseScalarOrJSON(f.Type, value)
+		if err != nil {
+			return err
+		}
+		list, _ := obj[f.Name].([]interface{})
+		obj[f.Name] = append(list, v)
+		return nil
+	}
+	if f.Repeated || isMessage(f.Type) {
+		var v interface{}
+		if err := decodeJSON(trimmed, &v); err != nil {
+			return err
+		}
+		converted, err := convertField(f, v)
+		if err != nil {
+			return err
+		}
+		obj[f.Name] = converted
+		return nil
+	}
+	v, err := parseScalar(f.Type, value)
+	if err != nil {
+		return err
+	}
+	obj[f.Name] = v
+	return nil
+}
+
+//	an element of a repeated message is given as a JSON object
+func parseScalarOrJSON(t *help_pb.Type, value string) (interface{}, error) {
+	if !isMessage(t) {
+		return parseScalar(t, value)
+	}
+	var v interface{}
+	if err := decodeJSON(value, &v); err != nil {
+		return nil, err
+	}
+	return convertValue(t, v)
+}
+
+func decodeJSON(value string, v interface{}) error {
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON [%s]: %s", value, err)
+	} else if dec.More() {
+		return fmt.Errorf("invalid JSON [%s]: unexpected data after the value", value)
+	}
+	return nil
+}
+
+//	check a field given as JSON against the field's type, and convert the values which
+//	pld expects in a different form, such as bytes
+func convertField(f *help_pb.Field, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if value := mapValue(f); value != nil {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("[%s] is a map, it must be a JSON object", f.Name)
+		}
+		out := make(map[string]interface{}, len(m))
+		for key, elem := range m {
+			converted, err := convertValue(value.Type, elem)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %s", f.Name, key, err)
+			}
+			out[key] = converted
+		}
+		return out, nil
+	}
+	if f.Repeated {
+		list, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("[%s] is a list, it must be a JSON array", f.Name)
+		}
+		out := make([]interface{}, 0, len(list))
+		for i, elem := range list {
+			converted, err := convertValue(f.Type, elem)
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %s", f.Name, i, err)
+			}
+			out = append(out, converted)
+		}
+		return out, nil
+	}
+	converted, err := convertValue(f.Type, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", f.Name, err)
+	}
+	return converted, nil
+}
+
+func convertValue(t *help_pb.Type, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if isMessage(t) {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("[%s] must be a JSON object", t.Name)
+		}
+		out := make(map[string]interface{}, len(m))
+		for name, elem := range m {
+			f := findField(t, name)
+			if f == nil {
+				return nil, fmt.Errorf("no such field [%s]", name)
+			}
+			converted, err := convertField(f, elem)
+			if err != nil {
+				return nil, err
+			}
+			out[name] = converted
+		}
+		return out, nil
+	}
+	switch value := v.(type) {
+	case string:
+		return parseScalar(t, value)
+	case json.Number:
+		if t.Name == "string" || t.Name == bytesTypeName {
+			return nil, fmt.Errorf("[%s] must be a string", value)
+		}
+		return parseScalar(t, string(value))
+	case bool:
+		if t.Name != "bool" {
+			return nil, fmt.Errorf("[%v] is not a %s", value, t.Name)
+		}
+		return value, nil
+	}
+	if t.Name == "" || (len(t.Fields) == 0 && !isScalar(t.Name)) {
+		//	a type which the help doesn't describe, such as a circular reference
+		return v, nil
+	}
+	return nil, fmt.Errorf("unexpected value for a %s", t.Name)
+}
+
+func isScalar(name string) bool {
+	switch name {
+	case "bool", "string", bytesTypeName, "int32", "int64", "uint32", "uint64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+//	parse a value given on the command line according to it's type
+func parseScalar(t *help_pb.Type, value string) (interface{}, error) {
+	if isEnum(t) {
+		for _, v := range t.Fields {
+			if strings.EqualFold(v.Name, value) {
+				return v.Name, nil
+			}
+		}
+		if n, err := strconv.ParseInt(value, 10, 32); err == nil {
+			return json.Number(strconv.FormatInt(n, 10)), nil
+		}
+		names := make([]string, 0, len(t.Fields))
+		for _, v := range t.Fields {
+			names = append(names, v.Name)
+		}
+		return nil, fmt.Errorf("[%s] is not one of %s", value, strings.Join(names, ", "))
+	}
+
+	switch t.Name {
+	case "bool":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not true or false", value)
+		}
+		return b, nil
+
+	case "string":
+		return value, nil
+
+	case bytesTypeName:
+		b, err := parseBytes(value)
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.EncodeToString(b), nil
+
+	case "int32", "int64":
+		bits := 64
+		if t.Name == "int32" {
+			bits = 32
+		}
+		n, err := strconv.ParseInt(value, 10, bits)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not a valid %s", value, t.Name)
+		}
+		return json.Number(strconv.FormatInt(n, 10)), nil
+
+	case "uint32", "uint64":
+		bits := 64
+		if t.Name == "uint32" {
+			bits = 32
+		}
+		n, err := strconv.ParseUint(value, 10, bits)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not a valid %s", value, t.Name)
+		}
+		return json.Number(strconv.FormatUint(n, 10)), nil
+
+	case "float32", "float64":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not a valid number", value)
+		}
+		return json.Number(strconv.FormatFloat(f, 'g', -1, 64)), nil
+	}
+
+	//	a type which the help doesn't describe is passed as it is
+	if json.Valid([]byte(value)) {
+		return json.RawMessage(value), nil
+	}
+	return value, nil
+}
+
+//	bytes are base64 like in pld's JSON, or hex or text with a prefix
+func parseBytes(value string) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(value, "hex:"):
+		b, err := hex.DecodeString(value[len("hex:"):])
+		if err != nil {
+			return nil, fmt.Errorf("[%s] is not valid hex", value)
+		}
+		return b, nil
+
+	case strings.HasPrefix(value, "text:"):
+		return []byte(value[len("text:"):]), nil
+
+	case strings.HasPrefix(value, "base64:"):
+		value = value[len("base64:"):]
+	}
+	for _, enc := range []*base64.Encoding{
+		base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding,
+	} {
+		if b, err := enc.DecodeString(value); err == nil {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("[%s] is not valid base64, use hex: or text: for other encodings", value)
+}
+
+//	indent a payload to show it to the user
+func indentPayload(payload string) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(payload), "", "    "); err != nil {
+		return payload
+	}
+	return out.String()
+}
@@ -0,0 +1,186 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lndcli/request_test.go  -  Oct-17-2026
+//
+//	unit tests for the request builder, using help which doesn't need a pld
+////////////////////////////////////////////////////////////////////////////////
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/help_pb"
+)
+
+func scalar(name, typeName string, repeated bool) *help_pb.Field {
+	return &help_pb.Field{Name: name, Repeated: repeated, Type: &help_pb.Type{Name: typeName}}
+}
+
+//	help of an endpoint with a field of every kind
+func testEndpointHelp() *help_pb.EndpointHelp {
+	varient := &help_pb.Type{Name: enumVarientTypeName}
+	return &help_pb.EndpointHelp{
+		Path: "/api/v1/test/command",
+		Request: &help_pb.Type{
+			Name: "test_Request",
+			Fields: []*help_pb.Field{
+				scalar("show", "bool", false),
+				scalar("label", "string", false),
+				scalar("amount", "int64", false),
+				scalar("target_conf", "uint32", false),
+				scalar("passphrase_bin", bytesTypeName, false),
+				scalar("addresses", "string", true),
+				{
+					Name: "feature",
+					Type: &help_pb.Type{Name: "test_Feature", Fields: []*help_pb.Field{
+						{Name: "DATALOSS_PROTECT_REQ", Type: varient},
+						{Name: "TLV_ONION_OPT", Type: varient},
+					}},
+				},
+				{
+					Name: "channel_point",
+					Type: &help_pb.Type{Name: "test_ChannelPoint", Fields: []*help_pb.Field{
+						scalar("funding_txid_str", "string", false),
+						scalar("output_index", "uint32", false),
+					}},
+				},
+				{
+					Name:     "outputs",
+					Repeated: true,
+					Type: &help_pb.Type{Name: "test_Output", Fields: []*help_pb.Field{
+						scalar("address", "string", false),
+						scalar("amount", "int64", false),
+						scalar("script", bytesTypeName, false),
+					}},
+				},
+				{
+					Name:     "records",
+					Repeated: true,
+					Type: &help_pb.Type{Name: "test_Request_RecordsEntry", Fields: []*help_pb.Field{
+						scalar("key", "uint64", false),
+						scalar("value", bytesTypeName, false),
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	help := testEndpointHelp()
+	for _, test := range []struct {
+		name      string
+		arguments []string
+		expected  string
+	}{
+		{
+			name:      "scalars",
+			arguments: []string{"--show", "--label=a \"quoted\" label", "--amount=-5", "--target_conf=6"},
+			expected:  `{"amount":-5,"label":"a \"quoted\" label","show":true,"target_conf":6}`,
+		},
+		{
+			name:      "bytes",
+			arguments: []string{"--passphrase_bin=hex:70617373"},
+			expected:  `{"passphrase_bin":"cGFzcw=="}`,
+		},
+		{
+			name:      "text bytes",
+			arguments: []string{"--passphrase_bin=text:pass"},
+			expected:  `{"passphrase_bin":"cGFzcw=="}`,
+		},
+		{
+			name:      "base64 bytes",
+			arguments: []string{"--passphrase_bin=cGFzcw"},
+			expected:  `{"passphrase_bin":"cGFzcw=="}`,
+		},
+		{
+			name:      "repeated option",
+			arguments: []string{"--addresses=pkt1a", "--addresses=pkt1b"},
+			expected:  `{"addresses":["pkt1a","pkt1b"]}`,
+		},
+		{
+			name:      "JSON array",
+			arguments: []string{`--addresses=["pkt1a", "pkt1b"]`},
+			expected:  `{"addresses":["pkt1a","pkt1b"]}`,
+		},
+		{
+			name:      "enum",
+			arguments: []string{"--feature=tlv_onion_opt"},
+			expected:  `{"feature":"TLV_ONION_OPT"}`,
+		},
+		{
+			name:      "nested options",
+			arguments: []string{"--channel_point.funding_txid_str=abcd", "--channel_point.output_index=1"},
+			expected:  `{"channel_point":{"funding_txid_str":"abcd","output_index":1}}`,
+		},
+		{
+			name:      "nested JSON",
+			arguments: []string{`--channel_point={"funding_txid_str":"abcd","output_index":"2"}`},
+			expected:  `{"channel_point":{"funding_txid_str":"abcd","output_index":2}}`,
+		},
+		{
+			name:      "repeated messages",
+			arguments: []string{`--outputs=[{"address":"pkt1a","amount":10,"script":"hex:00"}]`, `--outputs={"address":"pkt1b"}`},
+			expected:  `{"outputs":[{"address":"pkt1a","amount":10,"script":"AA=="},{"address":"pkt1b"}]}`,
+		},
+		{
+			name:      "map entries",
+			arguments: []string{"--records.65536=hex:01", `--records.65537=text:hi`},
+			expected:  `{"records":{"65536":"AQ==","65537":"aGk="}}`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := formatRequestPayload(help, test.arguments)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.expected {
+				t.Errorf("got %s, want %s", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestBuildRequestErrors(t *testing.T) {
+	help := testEndpointHelp()
+	for _, test := range []struct {
+		argument string
+		expected string
+	}{
+		{"--unknown=1", "no such field [unknown]"},
+		{"--channel_point.unknown=1", "no such field [channel_point.unknown]"},
+		{"label=x", "options must be given as --field=value"},
+		{"--label", "a value is required"},
+		{"--amount=1.5", "is not a valid int64"},
+		{"--target_conf=4294967296", "is not a valid uint32"},
+		{"--feature=NOPE", "is not one of DATALOSS_PROTECT_REQ, TLV_ONION_OPT"},
+		{"--passphrase_bin=hex:zz", "is not valid hex"},
+		{"--passphrase_bin=not base64!", "is not valid base64"},
+		{"--outputs.address=pkt1a", "is a list, it must be given as a JSON array"},
+		{`--outputs=[{"address":5}]`, "must be a string"},
+		{`--outputs=[{"addr":"pkt1a"}]`, "no such field [addr]"},
+		{`--channel_point={"output_index":1`, "invalid JSON"},
+		{"--records.key=hex:01", "invalid key [key]"},
+		{"--show.x", "has no fields"},
+	} {
+		_, err := formatRequestPayload(help, []string{test.argument})
+		if err == nil {
+			t.Errorf("%s: expected an error", test.argument)
+		} else if !strings.Contains(err.Message(), test.expected) {
+			t.Errorf("%s: got [%s], want [%s]", test.argument, err.Message(), test.expected)
+		}
+	}
+}
+
+//	a command which can be invoked by GET has no payload when there are no arguments
+func TestRequestPayloadGet(t *testing.T) {
+	help := testEndpointHelp()
+	if got, err := formatRequestPayload(help, nil); err != nil || got != "{}" {
+		t.Fatalf("got %s %v, want {}", got, err)
+	}
+	help.Features = []help_pb.F{help_pb.F_ALLOW_GET}
+	if got, err := formatRequestPayload(help, nil); err != nil || got != "" {
+		t.Fatalf("got %s %v, want an empty payload", got, err)
+	}
+}
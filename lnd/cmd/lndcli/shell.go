@@ -0,0 +1,267 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lndcli/shell.go  -  Oct-17-2026
+//
+//	Interactive shell of the pld client, with completion of commands and options
+////////////////////////////////////////////////////////////////////////////////
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/help_pb"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const shellPrompt = "pld> "
+
+//	commands of the shell which are not pld endpoints
+var shellCommands = []string{"exit", "help", "output", "quit", "unlock"}
+
+//	run the commands which are typed, until exit or Ctrl-D
+func (c *pldClient) shell() er.R {
+	fd := int(os.Stdin.Fd())
+
+	//	when the input is not a terminal, the commands are read one per line
+	if !terminal.IsTerminal(fd) {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if c.runShellLine(scanner.Text()) {
+				return nil
+			}
+		}
+		return er.E(scanner.Err())
+	}
+
+	fmt.Fprintf(os.Stdout, "Connected to %s, press tab to complete and type exit to leave\n", c.server)
+	t := terminal.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, shellPrompt)
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		newLine, newPos, candidates := c.complete(line, pos)
+		if len(candidates) > 1 && newLine == line {
+			fmt.Fprintf(t, "%s\n", strings.Join(candidates, "  "))
+		}
+		return newLine, newPos, true
+	}
+
+	for {
+		//	the terminal is only raw while a line is read, so that the commands print
+		//	and read passwords as usual
+		state, err := terminal.MakeRaw(fd)
+		if err != nil {
+			return er.E(err)
+		}
+		if width, height, err := terminal.GetSize(fd); err == nil {
+			t.SetSize(width, height)
+		}
+		line, errr := t.ReadLine()
+		terminal.Restore(fd, state)
+		if errr == io.EOF {
+			fmt.Fprintf(os.Stdout, "\n")
+			return nil
+		} else if errr != nil {
+			return er.E(errr)
+		}
+		if c.runShellLine(line) {
+			return nil
+		}
+	}
+}
+
+//	run one line of the shell, returns true when the shell should exit
+func (c *pldClient) runShellLine(line string) bool {
+	arguments, errr := splitArguments(line)
+	if errr != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", errr)
+		return false
+	}
+	if len(arguments) == 0 {
+		return false
+	}
+	switch arguments[0] {
+	case "exit", "quit":
+		return true
+
+	case "output":
+		if len(arguments) == 2 && (arguments[1] == outputJSON || arguments[1] == outputTable) {
+			c.output = arguments[1]
+		} else if len(arguments) != 1 {
+			fmt.Fprintf(os.Stderr, "ERROR: usage: output %s|%s\n", outputJSON, outputTable)
+			return false
+		}
+		fmt.Fprintf(os.Stdout, "output is %s\n", c.output)
+		return false
+	}
+	if err := c.run(arguments); err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err.Message())
+	}
+	return false
+}
+
+//	split a line into arguments like a shell does, so JSON can be quoted
+func splitArguments(line string) ([]string, error) {
+	var arguments []string
+	var current strings.Builder
+	inArgument := false
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArgument = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArgument = true
+		case r == ' ' || r == '\t':
+			if inArgument {
+				arguments = append(arguments, current.String())
+				current.Reset()
+				inArgument = false
+			}
+		default:
+			current.WriteRune(r)
+			inArgument = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	} else if escaped {
+		return nil, errors.New("unterminated escape")
+	}
+	if inArgument {
+		arguments = append(arguments, current.String())
+	}
+	return arguments, nil
+}
+
+//	complete the word before the cursor, the first word is a command and the others are
+//	it's options. The candidates are returned so they can be shown when the word can't
+//	be completed any further.
+func (c *pldClient) complete(line string, pos int) (string, int, []string) {
+	before := line[:pos]
+	start := strings.LastIndexAny(before, " \t") + 1
+	word := before[start:]
+	previous := strings.Fields(before[:start])
+
+	var candidates []string
+	switch {
+	case len(previous) == 0:
+		candidates = matching(c.completeCommands(), word)
+	case len(previous) == 1 && previous[0] == "help":
+		candidates = matching(c.completeCommands(), word)
+	case strings.HasPrefix(word, "--"):
+		if help, err := c.getEndpointHelp(previous[0]); err == nil && help.Request != nil {
+			candidates = matching(commandOptions(help.Request, word), word)
+		}
+	}
+	if len(candidates) == 0 {
+		return line, pos, nil
+	}
+
+	completion := commonPrefix(candidates)
+	if len(candidates) == 1 && !strings.HasSuffix(completion, "=") &&
+		!strings.HasSuffix(completion, ".") && !strings.HasSuffix(completion, "/") {
+		completion += " "
+	}
+	return line[:start] + completion + line[pos:], start + len(completion), candidates
+}
+
+func (c *pldClient) completeCommands() []string {
+	commands, err := c.getCommands()
+	if err != nil {
+		return shellCommands
+	}
+	return append(append([]string{}, shellCommands...), commands...)
+}
+
+//	the options of a request, an option which is being given a value is completed with
+//	the values of an enum
+func commandOptions(requestType *help_pb.Type, word string) []string {
+	if name, _, hasValue := strings.Cut(word, "="); hasValue {
+		f := findOption(requestType, name[2:])
+		if f == nil || !isEnum(f.Type) {
+			return nil
+		}
+		var options []string
+		for _, varient := range f.Type.Fields {
+			options = append(options, name+"="+varient.Name)
+		}
+		return options
+	}
+	var options []string
+	addOptions(requestType, "--", &options)
+	sort.Strings(options)
+	return options
+}
+
+func addOptions(t *help_pb.Type, prefix string, options *[]string) {
+	for _, f := range t.Fields {
+		option := prefix + f.Name
+		switch {
+		case mapValue(f) != nil:
+			*options = append(*options, option+"=", option+".")
+		case isMessage(f.Type) && !f.Repeated:
+			*options = append(*options, option+"=")
+			addOptions(f.Type, option+".", options)
+		case f.Type.Name == "bool" && !f.Repeated:
+			*options = append(*options, option)
+		default:
+			*options = append(*options, option+"=")
+		}
+	}
+}
+
+func findOption(t *help_pb.Type, name string) *help_pb.Field {
+	var f *help_pb.Field
+	for _, part := range strings.Split(name, ".") {
+		if f != nil {
+			t = f.Type
+		}
+		if f = findField(t, part); f == nil {
+			return nil
+		}
+	}
+	return f
+}
+
+func matching(candidates []string, prefix string) []string {
+	var out []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) {
+			out = append(out, candidate)
+		}
+	}
+	return out
+}
+
+func commonPrefix(candidates []string) string {
+	prefix := candidates[0]
+	for _, candidate := range candidates[1:] {
+		for !strings.HasPrefix(candidate, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
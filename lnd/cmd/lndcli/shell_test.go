@@ -0,0 +1,124 @@
+////////////////////////////////////////////////////////////////////////////////
+//	lndcli/shell_test.go  -  Oct-17-2026
+//
+//	unit tests for the shell's completion and the table output
+////////////////////////////////////////////////////////////////////////////////
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/help_pb"
+)
+
+func TestSplitArguments(t *testing.T) {
+	for _, test := range []struct {
+		line     string
+		expected []string
+	}{
+		{"  meta/getinfo  ", []string{"meta/getinfo"}},
+		{`wallet/address/create --label="my label"`, []string{"wallet/address/create", "--label=my label"}},
+		{`x --outputs='[{"address":"pkt1a"}]'`, []string{"x", `--outputs=[{"address":"pkt1a"}]`}},
+		{`x --label=a\ b "" --c="\"q\""`, []string{"x", "--label=a b", "", `--c="q"`}},
+	} {
+		got, err := splitArguments(test.line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%s: got %q, want %q", test.line, got, test.expected)
+		}
+	}
+	if _, err := splitArguments(`x --label="open`); err == nil {
+		t.Errorf("expected an unterminated quote to fail")
+	}
+}
+
+//	the help is already cached so no pld is needed
+func testClient() *pldClient {
+	return &pldClient{
+		server: defaultPldServer,
+		output: outputJSON,
+		master: &help_pb.Category{
+			Categories: map[string]*help_pb.Category{
+				"test": {Endpoints: map[string]*help_pb.EndpointSimple{
+					"command": {HelpPath: "/api/v1/help/test/command"},
+					"other":   {HelpPath: "/api/v1/help/test/other"},
+				}},
+				"meta": {Endpoints: map[string]*help_pb.EndpointSimple{
+					"getinfo": {HelpPath: "/api/v1/help/meta/getinfo"},
+				}},
+			},
+		},
+		helpCache: map[string]*help_pb.EndpointHelp{"test/command": testEndpointHelp()},
+	}
+}
+
+func TestComplete(t *testing.T) {
+	c := testClient()
+	for _, test := range []struct {
+		line       string
+		expected   string
+		candidates int
+	}{
+		{"me", "meta/getinfo ", 1},
+		{"te", "test/", 2},
+		{"test/", "test/", 2},
+		{"help test/c", "help test/command ", 1},
+		{"test/command --sh", "test/command --show ", 1},
+		{"test/command --channel_point.f", "test/command --channel_point.funding_txid_str=", 1},
+		{"test/command --feature=T", "test/command --feature=TLV_ONION_OPT ", 1},
+		{"test/command --rec", "test/command --records", 2},
+		{"test/command --label=x", "test/command --label=x", 0},
+		{"nothing", "nothing", 0},
+	} {
+		got, pos, candidates := c.complete(test.line, len(test.line))
+		if got != test.expected || pos != len(got) || len(candidates) != test.candidates {
+			t.Errorf("%s: got [%s] at %d with %v, want [%s] with %d candidates",
+				test.line, got, pos, candidates, test.expected, test.candidates)
+		}
+	}
+
+	//	the text after the cursor is kept
+	if got, pos, _ := c.complete("me --x", 2); got != "meta/getinfo  --x" || pos != 13 {
+		t.Errorf("got [%s] at %d", got, pos)
+	}
+}
+
+func TestPrintTable(t *testing.T) {
+	var out bytes.Buffer
+	printResponse(&out, []byte(`{
+		"alias": "node",
+		"balance": {"confirmed": 10, "unconfirmed": 0},
+		"uris": ["a", "b"],
+		"peers": [
+			{"pubkey": "02aa", "bytes_in": 5},
+			{"pubkey": "03bb", "inbound": true}
+		]
+	}`), outputTable)
+	expected := strings.Join([]string{
+		"alias                node",
+		"balance.confirmed    10",
+		"balance.unconfirmed  0",
+		"uris                 a, b",
+		"",
+		"peers",
+		"bytes_in  pubkey  inbound",
+		"5         02aa    ",
+		"          03bb    true",
+		"",
+	}, "\n")
+	if out.String() != expected {
+		t.Errorf("got\n%s\nwant\n%s", out.String(), expected)
+	}
+
+	out.Reset()
+	printResponse(&out, []byte(`not json`), outputTable)
+	if out.String() != "not json\n" {
+		t.Errorf("expected a response which isn't JSON to be shown as it is, got %s", out.String())
+	}
+}
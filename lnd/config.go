@@ -49,6 +49,8 @@ import (
 
 const (
 	defaultDataDirname     = "data"
+	defaultTLSCertFilename = "tls.cert"
+	defaultTLSKeyFilename  = "tls.key"
 	defaultChainSubDirname = "chain"
 	defaultGraphSubDirname = "graph"
 	defaultTowerSubDirname = "watchtower"
@@ -137,6 +139,9 @@ var (
 	defaultDataDir  = filepath.Join(DefaultLndDir, defaultDataDirname)
 	defaultTowerDir = filepath.Join(defaultDataDir, defaultTowerSubDirname)
 
+	defaultTLSCertPath = filepath.Join(DefaultLndDir, defaultTLSCertFilename)
+	defaultTLSKeyPath  = filepath.Join(DefaultLndDir, defaultTLSKeyFilename)
+
 	defaultTorSOCKS   = net.JoinHostPort("localhost", strconv.Itoa(defaultTorSOCKSPort))
 	defaultTorDNS     = net.JoinHostPort(defaultTorDNSHost, strconv.Itoa(defaultTorDNSPort))
 	defaultTorControl = net.JoinHostPort("localhost", strconv.Itoa(defaultTorControlPort))
@@ -151,13 +156,14 @@ var (
 type Config struct {
 	ShowVersion bool `short:"V" long:"version" description:"Display version information and exit"`
 
-	LndDir       string `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
-	PktDir       string `long:"pktdir" description:"The base directory that contains pktwallet's data etc."`
-	ConfigFile   string `short:"C" long:"configfile" description:"Path to configuration file"`
-	DataDir      string `short:"b" long:"datadir" description:"The directory to store pld's data within"`
-	WalletFile   string `long:"wallet" description:"Wallet file name or path, if a simple word such as 'personal' then pktwallet will look for wallet_personal.db, if prefixed with a / then pktwallet will consider it an absolute path. (default: wallet.db)"`
-	SyncFreelist bool   `long:"sync-freelist" description:"Whether the databases used within pld should sync their freelist to disk. This is disabled by default resulting in improved memory performance during operation, but with an increase in startup time."`
-	Create       bool   `long:"create" description:"Create a new wallet, walking through the steps to do so"`
+	LndDir       string   `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
+	PktDir       string   `long:"pktdir" description:"The base directory that contains pktwallet's data etc."`
+	ConfigFile   string   `short:"C" long:"configfile" description:"Path to configuration file"`
+	DataDir      string   `short:"b" long:"datadir" description:"The directory to store pld's data within"`
+	WalletFile   string   `long:"wallet" description:"Wallet file name or path, if a simple word such as 'personal' then pktwallet will look for wallet_personal.db, if prefixed with a / then pktwallet will consider it an absolute path. (default: wallet.db)"`
+	LoadWallets  []string `long:"loadwallet" description:"The name of a wallet to load at startup in addition to the main wallet, it is stored as wallet_<name>.db and its endpoints are under /wallet/<name>/. May be specified multiple times."`
+	SyncFreelist bool     `long:"sync-freelist" description:"Whether the databases used within pld should sync their freelist to disk. This is disabled by default resulting in improved memory performance during operation, but with an increase in startup time."`
+	Create       bool     `long:"create" description:"Create a new wallet, walking through the steps to do so"`
 
 	// We'll parse these 'raw' string arguments into real net.Addrs in the
 	// loadConfig function. We need to expose the 'raw' strings so the
@@ -169,6 +175,8 @@ type Config struct {
 	ExternalHosts     []string `long:"externalhosts" description:"A set of hosts that should be periodically resolved to announce IPs for"`
 	RESTListeners     []net.Addr
 	RestCORS          []string `long:"restcors" description:"Add an ip:port/hostname to allow cross origin access from. To allow all origins, set as \"*\"."`
+	RestAuth          bool     `long:"restauth" description:"Require a token for access to the REST API, the first time this is used an admin token is written to admin.token in the data directory"`
+	RestTLS           bool     `long:"resttls" description:"Serve the REST API over TLS, if there is no certificate at tlscertpath then a self-signed one is generated and renewed before it expires"`
 	Listeners         []net.Addr
 	ExternalIPs       []net.Addr
 	DisableListen     bool          `long:"nolisten" description:"Disable listening for incoming peer connections"`
@@ -177,6 +185,14 @@ type Config struct {
 	MaxBackoff        time.Duration `long:"maxbackoff" description:"Longest backoff when reconnecting to persistent peers. Valid time units are {s, m, h}."`
 	ConnectionTimeout time.Duration `long:"connectiontimeout" description:"The timeout value for network connections. Valid time units are {ms, s, m, h}."`
 
+	TLSCertPath        string   `long:"tlscertpath" description:"Path to the TLS certificate for the REST API"`
+	TLSKeyPath         string   `long:"tlskeypath" description:"Path to the TLS private key for the REST API"`
+	TLSExtraIPs        []string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
+	TLSExtraDomains    []string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate"`
+	TLSAutoRefresh     bool     `long:"tlsautorefresh" description:"Re-generate the TLS certificate and key if the IPs or domains are changed"`
+	TLSDisableAutofill bool     `long:"tlsdisableautofill" description:"Do not include the interface IPs or the system hostname in the TLS certificate, use tlsextradomain and tlsextraip to include them"`
+	TLSClientCA        string   `long:"tlsclientca" description:"Path to a PEM file of CA certificates, if set then REST clients must present a TLS certificate which is signed by one of them"`
+
 	DebugLevel string `short:"d" long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <global-level>,<subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 
 	CPUProfile string `long:"cpuprofile" description:"Write CPU profile to the specified file"`
@@ -243,7 +259,7 @@ type Config struct {
 
 	EnableUpfrontShutdown bool `long:"enable-upfront-shutdown" description:"If true, option upfront shutdown script will be enabled. If peers that we open channels with support this feature, we will automatically set the script to which cooperative closes should be paid out to on channel open. This offers the partial protection of a channel peer disconnecting from us if cooperative close is attempted with a different script."`
 
-	AcceptKeySend bool `long:"accept-keysend" description:"If true, spontaneous payments through keysend will be accepted. [experimental]"`
+	AcceptKeySend lncfg.OptionalBool `long:"accept-keysend" optional:"yes" optional-value:"true" description:"If true, spontaneous payments through keysend will be accepted. By default they are accepted when cjdnssocket is set, --accept-keysend=false (or =0) refuses them. [experimental]"`
 
 	KeysendHoldTime time.Duration `long:"keysend-hold-time" description:"If non-zero, keysend payments are accepted but not immediately settled. If the payment isn't settled manually after the specified time, it is canceled automatically. [experimental]"`
 
@@ -270,6 +286,7 @@ type Config struct {
 	DB *lncfg.DB `group:"db" namespace:"db"`
 
 	CjdnsSocket string `long:"cjdnssocket" description:"The path of the CJDNS socket (cjdroute.sock)"`
+	CjdnsPeers  uint32 `long:"cjdnspeers" description:"Keep this many Lightning peers by connecting to the CJDNS neighbours, 0 to connect only when asked"`
 
 	// registeredChains keeps track of all chains that have been registered
 	// with the daemon.
@@ -322,6 +339,8 @@ func DefaultConfig() Config {
 			UserAgentName:    neutrino.UserAgentName,
 			UserAgentVersion: neutrino.UserAgentVersion,
 		},
+		TLSCertPath:        defaultTLSCertPath,
+		TLSKeyPath:         defaultTLSKeyPath,
 		UnsafeDisconnect:   true,
 		MaxPendingChannels: lncfg.DefaultMaxPendingChannels,
 		NoSeedBackup:       defaultNoSeedBackup,
@@ -508,6 +527,15 @@ func ValidateConfig(cfg Config, usageMessage string) (*Config, er.R) {
 	if lndDir != DefaultLndDir {
 		cfg.DataDir = filepath.Join(lndDir, defaultDataDirname)
 
+		// If the user has not specified the TLS paths, keep them in
+		// the lnd directory.
+		if cfg.TLSCertPath == defaultTLSCertPath {
+			cfg.TLSCertPath = filepath.Join(lndDir, defaultTLSCertFilename)
+		}
+		if cfg.TLSKeyPath == defaultTLSKeyPath {
+			cfg.TLSKeyPath = filepath.Join(lndDir, defaultTLSKeyFilename)
+		}
+
 		// If the watchtower's directory is set to the default, i.e. the
 		// user has not requested a different location, we'll move the
 		// location to be relative to the specified lnd directory.
@@ -549,6 +577,9 @@ func ValidateConfig(cfg Config, usageMessage string) (*Config, er.R) {
 	// to use them later on.
 	cfg.DataDir = CleanAndExpandPath(cfg.DataDir)
 	cfg.PktDir = CleanAndExpandPath(cfg.PktDir)
+	cfg.TLSCertPath = CleanAndExpandPath(cfg.TLSCertPath)
+	cfg.TLSKeyPath = CleanAndExpandPath(cfg.TLSKeyPath)
+	cfg.TLSClientCA = CleanAndExpandPath(cfg.TLSClientCA)
 	cfg.Tor.PrivateKeyPath = CleanAndExpandPath(cfg.Tor.PrivateKeyPath)
 	cfg.Tor.WatchtowerKeyPath = CleanAndExpandPath(cfg.Tor.WatchtowerKeyPath)
 	cfg.Watchtower.TowerDir = CleanAndExpandPath(cfg.Watchtower.TowerDir)
@@ -1105,6 +1136,12 @@ func (c *Config) networkName() string {
 	return lncfg.NormalizeNetwork(c.ActiveNetParams.Name)
 }
 
+// acceptKeySend tells whether keysend payments are accepted, when it is not
+// set they are accepted if cjdns is used.
+func (c *Config) acceptKeySend() bool {
+	return c.AcceptKeySend.Value(c.CjdnsSocket != "")
+}
+
 // CleanAndExpandPath expands environment variables and leading ~ in the
 // passed path, cleans the result, and returns it.
 // This function is taken from https://github.com/btcsuite/btcd
@@ -1200,10 +1237,11 @@ func fileExists(filePath string) (bool, er.R) {
 }
 
 type WalletSetupCfg struct {
-	Passphrase       *string `json:"passphrase"`
-	PublicPassphrase *string `json:"viewpassphrase"`
-	Seed             *string `json:"seed"`
-	SeedPassphrase   *string `json:"seedpassphrase"`
+	Passphrase       *string  `json:"passphrase"`
+	PublicPassphrase *string  `json:"viewpassphrase"`
+	Seed             *string  `json:"seed"`
+	SeedShares       []string `json:"seedshares"`
+	SeedPassphrase   *string  `json:"seedpassphrase"`
 }
 
 // createWallet prompts the user for information needed to generate a new wallet
@@ -1255,12 +1293,16 @@ func createWallet(cfg *Config) er.R {
 		if setupCfg.PublicPassphrase != nil {
 			pubPass = []byte(*setupCfg.PublicPassphrase)
 		}
-		if setupCfg.Seed != nil {
-			if decoded, err := hex.DecodeString(*setupCfg.Seed); err == nil {
+		if setupCfg.Seed != nil || len(setupCfg.SeedShares) > 0 {
+			seedWords := ""
+			if setupCfg.Seed != nil {
+				seedWords = *setupCfg.Seed
+			}
+			if decoded, err := hex.DecodeString(seedWords); err == nil && len(setupCfg.SeedShares) == 0 {
 				zero.Bytes(decoded)
-				seedInput = []byte(*setupCfg.Seed)
+				seedInput = []byte(seedWords)
 			} else {
-				seedEnc, err := seedwords.SeedFromWords(*setupCfg.Seed)
+				seedEnc, err := seedwords.SeedFromWordsOrShares(seedWords, setupCfg.SeedShares)
 				if err != nil {
 					return err
 				}
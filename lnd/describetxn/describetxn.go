@@ -222,20 +222,28 @@ func vote(voteOut **rpc_pb.Vote, script []byte, params *chaincfg.Params) {
 func createVoutList(mtx *wire.MsgTx, chainParams *chaincfg.Params) []*rpc_pb.Vout {
 	voutList := make([]*rpc_pb.Vout, 0, len(mtx.TxOut))
 	for i, v := range mtx.TxOut {
-		encodedAddr := txscript.PkScriptToAddress(v.PkScript, chainParams).EncodeAddress()
+		voutList = append(voutList, DescribeOutput(v, uint32(i), chainParams))
+	}
 
-		vout := &rpc_pb.Vout{
-			N:          uint32(i),
-			ValueCoins: btcutil.Amount(v.Value).ToBTC(),
-			Svalue:     strconv.FormatInt(v.Value, 10),
-			Address:    encodedAddr,
-		}
+	return voutList
+}
+
+// DescribeOutput creates a Vout from a single transaction output
+// @param v the output
+// @param n the index of the output within its transaction
+// @param chainParams the chain which we are using, for the address format
+func DescribeOutput(v *wire.TxOut, n uint32, chainParams *chaincfg.Params) *rpc_pb.Vout {
+	encodedAddr := txscript.PkScriptToAddress(v.PkScript, chainParams).EncodeAddress()
 
-		vote(&vout.Vote, v.PkScript, chainParams)
-		voutList = append(voutList, vout)
+	vout := &rpc_pb.Vout{
+		N:          n,
+		ValueCoins: btcutil.Amount(v.Value).ToBTC(),
+		Svalue:     strconv.FormatInt(v.Value, 10),
+		Address:    encodedAddr,
 	}
 
-	return voutList
+	vote(&vout.Vote, v.PkScript, chainParams)
+	return vout
 }
 
 // Simplify the VinDetail list into a list of Payers
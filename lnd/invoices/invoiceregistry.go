@@ -750,9 +750,16 @@ func (i *InvoiceRegistry) processKeySend(ctx invoiceUpdateCtx) er.R {
 	// sender.
 	payAddr := channeldb.BlankPayAddr
 
+	// Keep the message of the sender, if any, as the memo of the invoice.
+	memo := ctx.customRecords[record.KeySendMessageType]
+	if len(memo) > channeldb.MaxMemoSize {
+		memo = memo[:channeldb.MaxMemoSize]
+	}
+
 	// Create placeholder invoice.
 	invoice := &channeldb.Invoice{
 		CreationDate: i.cfg.Clock.Now(),
+		Memo:         memo,
 		Terms: channeldb.ContractTerm{
 			FinalCltvDelta:  finalCltvDelta,
 			Value:           amt,
@@ -806,7 +806,8 @@ func testKeySend(t *testing.T, keySendEnabled bool) {
 
 	keySendPayload2 := &mockPayload{
 		customRecords: map[uint64][]byte{
-			record.KeySendType: preimage2[:],
+			record.KeySendType:        preimage2[:],
+			record.KeySendMessageType: []byte("thanks"),
 		},
 	}
 
@@ -818,6 +819,11 @@ func testKeySend(t *testing.T, keySendEnabled bool) {
 
 	checkResolution(resolution, preimage2)
 	checkSubscription()
+
+	// The message which came with the payment is the memo of its invoice.
+	inv, err := ctx.registry.LookupInvoice(hash2)
+	assert.Nil(t, err)
+	assert.Equal(t, "thanks", string(inv.Memo))
 }
 
 // TestHoldKeysend tests receiving a spontaneous payment that is held.
@@ -0,0 +1,53 @@
+package lncfg
+
+import "strconv"
+
+// OptionalBool is a bool option which may be left unset, so that its default
+// can depend on other options. Unlike a bool option it takes a value, which is
+// parsed like a bool (true, false, 1, 0...), so it should be declared with
+// optional:"yes" optional-value:"true" for the option alone to mean true.
+type OptionalBool int8
+
+const (
+	// BoolUnset is the value of an OptionalBool which was not set.
+	BoolUnset OptionalBool = iota
+
+	// BoolFalse is the value of an OptionalBool which was set to false.
+	BoolFalse
+
+	// BoolTrue is the value of an OptionalBool which was set to true.
+	BoolTrue
+)
+
+// UnmarshalFlag parses the value of the option.
+func (b *OptionalBool) UnmarshalFlag(value string) error {
+	v, err := strconv.ParseBool(value)
+	if err != nil {
+		return err
+	}
+	if v {
+		*b = BoolTrue
+	} else {
+		*b = BoolFalse
+	}
+	return nil
+}
+
+// MarshalFlag formats the value of the option, an unset option is empty.
+func (b OptionalBool) MarshalFlag() (string, error) {
+	switch b {
+	case BoolTrue:
+		return "true", nil
+	case BoolFalse:
+		return "false", nil
+	}
+	return "", nil
+}
+
+// Value returns the value of the option, or def if it was not set.
+func (b OptionalBool) Value(def bool) bool {
+	if b == BoolUnset {
+		return def
+	}
+	return b == BoolTrue
+}
@@ -0,0 +1,54 @@
+package lncfg_test
+
+import (
+	"strings"
+	"testing"
+
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkt-cash/pktd/lnd/lncfg"
+)
+
+// TestOptionalBool parses an OptionalBool from the command line and from a
+// config file, alone and with the values which a bool takes.
+func TestOptionalBool(t *testing.T) {
+	type config struct {
+		Opt lncfg.OptionalBool `long:"opt" optional:"yes" optional-value:"true"`
+	}
+	tests := []struct {
+		args  []string
+		ini   string
+		value lncfg.OptionalBool
+	}{
+		{value: lncfg.BoolUnset},
+		{args: []string{"--opt"}, value: lncfg.BoolTrue},
+		{args: []string{"--opt=false"}, value: lncfg.BoolFalse},
+		{args: []string{"--opt=1"}, value: lncfg.BoolTrue},
+		{args: []string{"--opt=0"}, value: lncfg.BoolFalse},
+		{ini: "opt=false", value: lncfg.BoolFalse},
+		{ini: "opt=1", value: lncfg.BoolTrue},
+	}
+	for _, test := range tests {
+		var cfg config
+		parser := flags.NewParser(&cfg, flags.None)
+		if test.ini != "" {
+			err := flags.NewIniParser(parser).Parse(strings.NewReader(test.ini))
+			if err != nil {
+				t.Fatalf("%q: %v", test.ini, err)
+			}
+		}
+		if _, err := parser.ParseArgs(test.args); err != nil {
+			t.Fatalf("%v: %v", test.args, err)
+		}
+		if cfg.Opt != test.value {
+			t.Fatalf("%v %q: expected %d, got %d", test.args, test.ini, test.value, cfg.Opt)
+		}
+	}
+
+	var cfg config
+	if _, err := flags.NewParser(&cfg, flags.None).ParseArgs([]string{"--opt=maybe"}); err == nil {
+		t.Fatalf("expected a value which is not a bool to be refused")
+	}
+	if !lncfg.BoolUnset.Value(true) || lncfg.BoolFalse.Value(true) || !lncfg.BoolTrue.Value(false) {
+		t.Fatalf("expected only an unset option to take the default")
+	}
+}
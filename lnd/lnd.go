@@ -6,6 +6,7 @@ package lnd
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"fmt"
 	"net"
@@ -20,6 +21,7 @@ import (
 
 	apifunctions "github.com/pkt-cash/pktd/apiv1"
 	"github.com/pkt-cash/pktd/apiv1/lightning"
+	"github.com/pkt-cash/pktd/apiv1/wallets"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/btcutil/util/mailbox"
@@ -27,6 +29,7 @@ import (
 	"github.com/pkt-cash/pktd/cjdns"
 	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
 	"github.com/pkt-cash/pktd/lnd/autopilot"
+	"github.com/pkt-cash/pktd/lnd/cert"
 	"github.com/pkt-cash/pktd/lnd/chainreg"
 	"github.com/pkt-cash/pktd/lnd/chanacceptor"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
@@ -35,6 +38,7 @@ import (
 	"github.com/pkt-cash/pktd/lnd/lnrpc"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/autopilotrpc"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/invoicesrpc"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/routerrpc"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/wtclientrpc"
 	"github.com/pkt-cash/pktd/lnd/lnwallet"
@@ -80,9 +84,44 @@ func Main(cfg *Config, shutdownChan <-chan struct{}) er.R {
 	)
 
 	api, apiRouter := apiv1.New()
+	if cfg.RestAuth {
+		if err := api.EnableAuth(cfg.DataDir); err != nil {
+			log.Errorf("Unable to enable REST authentication: %v", err)
+			return err
+		}
+	}
+
+	var restTLS *tls.Config
+	if cfg.RestTLS {
+		autoCert, err := cert.NewAutoCert(cert.AutoCertConfig{
+			Org:             "pld autogenerated cert",
+			CertPath:        cfg.TLSCertPath,
+			KeyPath:         cfg.TLSKeyPath,
+			ExtraIPs:        cfg.TLSExtraIPs,
+			ExtraDomains:    cfg.TLSExtraDomains,
+			DisableAutofill: cfg.TLSDisableAutofill,
+			AutoRefresh:     cfg.TLSAutoRefresh,
+		})
+		if err != nil {
+			log.Errorf("Unable to load REST TLS certificate: %v", err)
+			return err
+		}
+		restTLS, err = autoCert.TLSConfig(cfg.TLSClientCA)
+		if err != nil {
+			log.Errorf("Unable to load REST TLS client CA: %v", err)
+			return err
+		}
+		go autoCert.Run(shutdownChan)
+	}
 
 	for _, restEndpoint := range cfg.RESTListeners {
-		lis, err := lncfg.ListenOnAddress(restEndpoint)
+		var lis net.Listener
+		var err er.R
+		if restTLS != nil {
+			lis, err = lncfg.TLSListenOnAddress(restEndpoint, restTLS)
+		} else {
+			lis, err = lncfg.ListenOnAddress(restEndpoint)
+		}
 		if err != nil {
 			log.Errorf("REST unable to listen on %s", restEndpoint)
 			return err
@@ -143,7 +182,8 @@ func Main(cfg *Config, shutdownChan <-chan struct{}) er.R {
 	//Initialize the metaservice with params needed for change password
 	metaService.Init(!cfg.SyncFreelist, cfg.ActiveNetParams.Params, walletFilename, walletPath)
 
-	wallet, err := openWallet(cfg, api)
+	walletCat := apifunctions.WalletCategory(api)
+	wallet, err := openWallet(cfg, walletCat)
 	if err != nil {
 		return err
 	}
@@ -153,9 +193,15 @@ func Main(cfg *Config, shutdownChan <-chan struct{}) er.R {
 
 	apifunctions.Register(
 		api,
+		walletCat,
 		wallet,
 		neutrinoCS,
 		&initLightning,
+		wallets.Config{
+			Dir:            cfg.PktDir,
+			MainWalletPath: filepath.Join(walletPath, walletFilename),
+			Load:           cfg.LoadWallets,
+		},
 	)
 
 	startLightning := initLightning.AwaitUpdate()
@@ -455,9 +501,16 @@ func startupLightning(
 		return err
 	}
 
+	invoicesRpc, err := invoicesrpc.New(cfg.SubRPCServers.InvoicesRPC)
+	if err != nil {
+		return err
+	}
+	defer invoicesRpc.Stop()
+
 	restContext := RpcContext{
 		MaybeMetaService:      metaService,
 		MaybeRouterServer:     routerRpc,
+		MaybeInvoicesServer:   invoicesRpc,
 		MaybeRpcServer:        rpcServer,
 		MaybeWatchTowerClient: maybeWtClient,
 	}
@@ -534,6 +587,13 @@ func startupLightning(
 				log.Errorf("Can not initialize CJDNS: %v", err)
 			} else {
 				//Cjdns initialized
+				cjdnsMgr.SetMaxChanSize(cfg.MaxChanSize)
+				if cfg.CjdnsPeers > 0 {
+					err := cjdnsMgr.SetPeering(&rpc_pb.CjdnsPeeringConfig{TargetPeers: cfg.CjdnsPeers})
+					if err != nil {
+						log.Errorf("Can not set CJDNS peering: %v", err)
+					}
+				}
 				cjdnsMgr.Start(rs)
 			}
 		}
@@ -566,7 +626,7 @@ func startupLightning(
 	return nil
 }
 
-func openWallet(cfg *Config, api *apiv1.Apiv1) (*wallet.Wallet, er.R) {
+func openWallet(cfg *Config, walletCat *apiv1.Apiv1) (*wallet.Wallet, er.R) {
 	walletPath, walletFilename := walletFilename(cfg)
 	loader := wallet.NewLoader(
 		cfg.ActiveNetParams.Params,
@@ -578,7 +638,7 @@ func openWallet(cfg *Config, api *apiv1.Apiv1) (*wallet.Wallet, er.R) {
 	wallet, err := loader.OpenExistingWallet(
 		[]byte(wallet.InsecurePubPassphrase),
 		false,
-		api,
+		walletCat,
 	)
 	if err != nil {
 		return nil, err
@@ -860,6 +920,41 @@ func (rs *LightningRPCServer) LndAddInvoice(ctx context.Context,
 	return rs.AddInvoice(ctx, in)
 }
 
+func (rs *LightningRPCServer) LndLookupInvoice(ctx context.Context,
+	in *rpc_pb.PaymentHash) (*rpc_pb.Invoice, er.R) {
+	return rs.LookupInvoice(ctx, in)
+}
+
+func (rs *LightningRPCServer) LndDecodePayReq(ctx context.Context,
+	in *rpc_pb.PayReqString) (*rpc_pb.PayReq, er.R) {
+	return rs.DecodePayReq(ctx, in)
+}
+
+func (rs *LightningRPCServer) LndSendPaymentSync(ctx context.Context,
+	in *rpc_pb.SendRequest) (*rpc_pb.SendResponse, er.R) {
+	return rs.SendPaymentSync(ctx, in)
+}
+
+func (rs *LightningRPCServer) LndListChannels(ctx context.Context,
+	in *rpc_pb.ListChannelsRequest) (*rpc_pb.ListChannelsResponse, er.R) {
+	return rs.ListChannels(ctx, in)
+}
+
+func (rs *LightningRPCServer) LndPendingChannels(ctx context.Context,
+	in *rpc_pb.Null) (*rpc_pb.PendingChannelsResponse, er.R) {
+	return rs.PendingChannels(ctx, in)
+}
+
+func (rs *LightningRPCServer) LndWalletBalance(ctx context.Context,
+	in *rpc_pb.Null) (*rpc_pb.WalletBalanceResponse, er.R) {
+	return rs.WalletBalance(ctx, in)
+}
+
+func (rs *LightningRPCServer) LndOpenChannelSync(ctx context.Context,
+	in *rpc_pb.OpenChannelRequest) (*rpc_pb.ChannelPoint, er.R) {
+	return rs.OpenChannelSync(ctx, in)
+}
+
 func (rs *LightningRPCServer) LndPeerPort() int {
 	addr := rs.cfg.Listeners[0]
 	tcpAddr, _ := addr.(*net.TCPAddr)
@@ -0,0 +1,485 @@
+package apiv1
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/lock"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/rest_pb"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktlog/log"
+)
+
+const (
+	// ScopeAdmin grants access to every endpoint
+	ScopeAdmin = "admin"
+	// ScopeReadOnly grants access to endpoints which do not change the state of the node
+	ScopeReadOnly = "readonly"
+	// ScopeInvoice grants access to creating and querying Lightning invoices
+	ScopeInvoice = "invoice"
+	// ScopeWalletSpend grants access to making on-chain payments from the wallet
+	ScopeWalletSpend = "walletspend"
+
+	tokensFile     = "apitokens.json"
+	adminTokenFile = "admin.token"
+	tokenIdLen     = 16
+)
+
+// Endpoints which do not require a token
+var publicPaths = []string{"help", "openapi"}
+
+// The endpoints which are accessible with each scope, a path which ends with a slash
+// grants access to everything in that category. A path in wallet/ also grants the same
+// endpoint of every named wallet, which is under wallet/<name>/.
+var scopePaths = map[string][]string{
+	ScopeAdmin: {"/"},
+	ScopeReadOnly: {
+		"meta/getinfo",
+		"meta/version",
+		"wallet/balance",
+		"wallet/transaction",
+		"wallet/transaction/query",
+		"wallet/transaction/export",
+		"wallet/transaction/decode",
+		"wallet/psbt/decode",
+		"wallet/psbt/analyze",
+		"wallet/psbt/combine",
+		"wallet/address/balances",
+		"wallet/unspent",
+		"wallet/unspent/lock",
+		"wallet/loosetxns",
+		"wallet/fold",
+		"wallet/webhook",
+		"wallet/webhook/deliveries",
+		"wallet/addressbook",
+		"wallet/addressbook/export",
+		"wallet/schedule",
+		"wallets",
+		"neutrino/sending",
+		"cjdns/bandwidth",
+		"cjdns/bandwidth/peers",
+		"cjdns/peering",
+		"cjdns/nodeinfo",
+		"cjdns/peers",
+		"cjdns/lookup",
+		"cjdns/switchping",
+		"cjdns/handlers",
+		"lightning/channel",
+		"lightning/channel/balance",
+		"lightning/channel/pending",
+		"lightning/channel/closed",
+		"lightning/channel/networkinfo",
+		"lightning/channel/feereport",
+		"lightning/graph",
+		"lightning/invoice",
+		"lightning/invoice/lookup",
+		"lightning/invoice/decodepayreq",
+		"lightning/invoice/subscribe",
+		"lightning/invoice/subscribeall",
+		"lightning/payment",
+		"lightning/payment/track",
+		"lightning/payment/htlcevents",
+		"lightning/payment/queryroutes",
+		"lightning/payment/fwdinghistory",
+		"lightning/payment/querymc",
+		"lightning/payment/queryprob",
+		"lightning/peer",
+		"lightning/watchtower",
+		"lightning/watchtower/stats",
+		"lightning/watchtower/towerinfo",
+		"lightning/watchtower/towerpolicy",
+		"lightning/autopilot",
+		"util/",
+	},
+	ScopeInvoice: {
+		"lightning/invoice/",
+	},
+	ScopeWalletSpend: {
+		"wallet/balance",
+		"wallet/transaction",
+		"wallet/transaction/create",
+		"wallet/transaction/sendfrom",
+		"wallet/transaction/sendmany",
+		"wallet/transaction/bumpfee",
+		"wallet/transaction/decode",
+		"wallet/transaction/publish",
+		"wallet/psbt/",
+		"wallet/unspent",
+		"wallet/address/balances",
+		"wallet/address/create",
+		"wallet/fold/",
+		"wallet/schedule/",
+		"neutrino/bcasttransaction",
+		"neutrino/sending",
+	},
+}
+
+type storedToken struct {
+	Id      string   `json:"id"`
+	Name    string   `json:"name"`
+	Scopes  []string `json:"scopes"`
+	Paths   []string `json:"paths"`
+	Created int64    `json:"created"`
+	Expires int64    `json:"expires"`
+}
+
+type tokenStore struct {
+	RootKey []byte         `json:"root_key"`
+	Tokens  []*storedToken `json:"tokens"`
+}
+
+type authenticator struct {
+	path  string
+	store lock.GenMutex[tokenStore]
+}
+
+// A path which was given explicitly when the token was created grants the endpoint
+// and the category with that name.
+func pathGranted(grant, path string) bool {
+	return path == grant || strings.HasPrefix(path, grant+"/")
+}
+
+func scopeGranted(grant, path string) bool {
+	if strings.HasSuffix(grant, "/") {
+		return strings.HasPrefix(path+"/", strings.TrimPrefix(grant, "/"))
+	}
+	return path == grant
+}
+
+// The path of an endpoint of a named wallet, as the same endpoint of the main wallet.
+func mainWalletPath(path string) (string, bool) {
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) < 3 || parts[0] != "wallet" {
+		return "", false
+	}
+	return "wallet/" + parts[2], true
+}
+
+func (t *storedToken) allows(path string) bool {
+	for _, p := range t.Paths {
+		if pathGranted(p, path) {
+			return true
+		}
+	}
+	walletPath, isNamedWallet := mainWalletPath(path)
+	for _, s := range t.Scopes {
+		for _, p := range scopePaths[s] {
+			if scopeGranted(p, path) {
+				return true
+			} else if isNamedWallet && strings.HasPrefix(p, "wallet/") && scopeGranted(p, walletPath) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (t *storedToken) info() *rest_pb.TokenInfo {
+	return &rest_pb.TokenInfo{
+		Id:             t.Id,
+		Name:           t.Name,
+		Scopes:         append([]string{}, t.Scopes...),
+		Paths:          append([]string{}, t.Paths...),
+		CreatedSeconds: t.Created,
+		ExpiresSeconds: t.Expires,
+	}
+}
+
+func (s *tokenStore) mac(id []byte) []byte {
+	h := hmac.New(sha256.New, s.RootKey)
+	h.Write(id)
+	return h.Sum(nil)
+}
+
+// Must be called with the store locked.
+func (a *authenticator) save(s *tokenStore) er.R {
+	b, err := json.MarshalIndent(s, "", "\t")
+	if err != nil {
+		return er.E(err)
+	}
+	tmp := a.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return er.E(err)
+	}
+	return er.E(os.Rename(tmp, a.path))
+}
+
+// Must be called with the store locked.
+func (a *authenticator) mint(s *tokenStore, req *rest_pb.TokenCreateRequest) (*rest_pb.TokenCreateResponse, er.R) {
+	for _, sc := range req.Scopes {
+		if _, ok := scopePaths[sc]; !ok {
+			return nil, er.Errorf("No such scope [%s]", sc)
+		}
+	}
+	paths := util.Map(req.Paths, func(p string) string { return strings.Trim(p, "/") })
+	if util.Contains(paths, "") {
+		return nil, er.New("Empty path, use the admin scope to grant access to everything")
+	}
+	if len(req.Scopes) == 0 && len(req.Paths) == 0 {
+		return nil, er.New("A token must have at least one scope or path")
+	}
+	id := make([]byte, tokenIdLen)
+	if _, err := rand.Read(id); err != nil {
+		return nil, er.E(err)
+	}
+	now := time.Now().Unix()
+	t := &storedToken{
+		Id:      hex.EncodeToString(id),
+		Name:    req.Name,
+		Scopes:  append([]string{}, req.Scopes...),
+		Paths:   paths,
+		Created: now,
+	}
+	if req.ExpireSeconds > 0 {
+		t.Expires = now + req.ExpireSeconds
+	}
+	s.Tokens = append(s.Tokens, t)
+	if err := a.save(s); err != nil {
+		s.Tokens = s.Tokens[:len(s.Tokens)-1]
+		return nil, err
+	}
+	return &rest_pb.TokenCreateResponse{
+		Token: base64.RawURLEncoding.EncodeToString(append(id, s.mac(id)...)),
+		Info:  t.info(),
+	}, nil
+}
+
+// Get the valid token which matches a token string, or nil.
+func (a *authenticator) lookup(token string) *storedToken {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != tokenIdLen+sha256.Size {
+		return nil
+	}
+	var out *storedToken
+	a.store.In(func(s *tokenStore) er.R {
+		if !hmac.Equal(b[tokenIdLen:], s.mac(b[:tokenIdLen])) {
+			return nil
+		}
+		id := hex.EncodeToString(b[:tokenIdLen])
+		now := time.Now().Unix()
+		for _, t := range s.Tokens {
+			if t.Id == id && (t.Expires == 0 || t.Expires > now) {
+				out = t
+				break
+			}
+		}
+		return nil
+	})
+	return out
+}
+
+func tokenFromRequest(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(h, "Bearer "))
+	}
+	// Browsers are unable to set headers for websockets and EventSource
+	return r.URL.Query().Get("token")
+}
+
+// Check that a request carries a valid token, if not then the reply is sent and false
+// is returned. If authentication is not enabled, the token is nil.
+func (a *authenticator) authenticate(w http.ResponseWriter, r *http.Request) (*storedToken, bool) {
+	if a == nil {
+		return nil, true
+	}
+	token := tokenFromRequest(r)
+	if token == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		respondError(w, http.StatusUnauthorized, "401 - A token is required to access this endpoint")
+		return nil, false
+	}
+	t := a.lookup(token)
+	if t == nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		respondError(w, http.StatusUnauthorized, "401 - Invalid, expired or revoked token")
+		return nil, false
+	}
+	return t, true
+}
+
+// Check that a request is allowed to access an endpoint, if not then the reply is sent
+// and false is returned.
+func (a *authenticator) authorize(w http.ResponseWriter, r *http.Request, path string) bool {
+	if a == nil || util.Contains(publicPaths, path) {
+		return true
+	}
+	t, ok := a.authenticate(w, r)
+	if !ok {
+		return false
+	}
+	if !t.allows(path) {
+		respondError(w, http.StatusForbidden, "403 - This token does not grant access to ["+path+"]")
+		return false
+	}
+	return true
+}
+
+// Whether the holder of a token may access an endpoint over an already authenticated
+// connection, t is nil if authentication is not enabled.
+func (a *authenticator) mayAccess(t *storedToken, path string) er.R {
+	if a == nil || util.Contains(publicPaths, path) {
+		return nil
+	}
+	valid := false
+	now := time.Now().Unix()
+	a.store.In(func(s *tokenStore) er.R {
+		valid = util.Contains(s.Tokens, t) && (t.Expires == 0 || t.Expires > now)
+		return nil
+	})
+	if !valid {
+		return er.New("401 - Token has expired or been revoked")
+	} else if !t.allows(path) {
+		return er.Errorf("403 - This token does not grant access to [%s]", path)
+	}
+	return nil
+}
+
+// EnableAuth requires that every request to a non-public endpoint carry a token.
+// Tokens are stored in dir, when the token store is first created an admin token is
+// also created and written to the file admin.token in dir.
+func (a *Apiv1) EnableAuth(dir string) er.R {
+	auth := &authenticator{
+		path:  filepath.Join(dir, tokensFile),
+		store: lock.NewGenMutex(tokenStore{}, "apiv1.auth"),
+	}
+	if err := auth.store.In(func(s *tokenStore) er.R {
+		if b, err := os.ReadFile(auth.path); err == nil {
+			if err := json.Unmarshal(b, s); err != nil {
+				return er.Errorf("Unable to parse [%s]: [%s]", auth.path, err)
+			}
+		} else if !os.IsNotExist(err) {
+			return er.E(err)
+		}
+		if len(s.RootKey) > 0 {
+			// If every token was revoked then that was deliberate, a new admin token is
+			// only made when the token store is created.
+			if len(s.Tokens) == 0 {
+				log.Warnf("No REST API tokens exist, to create a new admin token "+
+					"stop pld and delete [%s]", auth.path)
+			}
+			return nil
+		}
+		s.RootKey = make([]byte, 32)
+		if _, err := rand.Read(s.RootKey); err != nil {
+			return er.E(err)
+		}
+		res, err := auth.mint(s, &rest_pb.TokenCreateRequest{
+			Name:   "admin",
+			Scopes: []string{ScopeAdmin},
+		})
+		if err != nil {
+			return err
+		}
+		adminPath := filepath.Join(dir, adminTokenFile)
+		if err := os.WriteFile(adminPath, []byte(res.Token), 0600); err != nil {
+			return er.E(err)
+		}
+		log.Infof("Created an admin token for the REST API in [%s]", adminPath)
+		return nil
+	}); err != nil {
+		return err
+	}
+	a.internal.auth = auth
+	return nil
+}
+
+func (a *Apiv1) getAuth() (*authenticator, er.R) {
+	if a.internal.auth == nil {
+		return nil, er.New("REST authentication is not enabled, start pld with --restauth")
+	}
+	return a.internal.auth, nil
+}
+
+// RegisterTokenEndpoints registers the endpoints for managing REST API tokens.
+func RegisterTokenEndpoints(a *Apiv1) {
+	Endpoint(
+		a,
+		"",
+		`
+		List all REST API tokens
+
+		Lists every token which has not been revoked or expired, the tokens themselves
+		are not displayed, only their ids and permissions.
+		`,
+		func(_ *rpc_pb.Null) (*rest_pb.TokenList, er.R) {
+			auth, err := a.getAuth()
+			if err != nil {
+				return nil, err
+			}
+			out := &rest_pb.TokenList{}
+			now := time.Now().Unix()
+			auth.store.In(func(s *tokenStore) er.R {
+				for _, t := range s.Tokens {
+					if t.Expires == 0 || t.Expires > now {
+						out.Tokens = append(out.Tokens, t.info())
+					}
+				}
+				return nil
+			})
+			return out, nil
+		},
+	)
+	Endpoint(
+		a,
+		"create",
+		`
+		Create a new REST API token
+
+		The token grants access to the categories of endpoints in the requested scopes
+		as well as to any additional categories or endpoints which are listed in paths.
+		The scopes are: admin (everything), readonly (endpoints which do not change
+		the state of the node), invoice (Lightning invoices) and walletspend (making
+		on-chain payments). The token must be passed in each request using the
+		header "Authorization: Bearer <token>".
+		`,
+		func(req *rest_pb.TokenCreateRequest) (*rest_pb.TokenCreateResponse, er.R) {
+			auth, err := a.getAuth()
+			if err != nil {
+				return nil, err
+			}
+			var res *rest_pb.TokenCreateResponse
+			if err := auth.store.In(func(s *tokenStore) er.R {
+				res, err = auth.mint(s, req)
+				return err
+			}); err != nil {
+				return nil, err
+			}
+			return res, nil
+		},
+	)
+	Endpoint(
+		a,
+		"revoke",
+		`
+		Revoke a REST API token
+
+		The token will no longer be accepted for any request.
+		`,
+		func(req *rest_pb.TokenRevokeRequest) (*rpc_pb.Null, er.R) {
+			auth, err := a.getAuth()
+			if err != nil {
+				return nil, err
+			}
+			return nil, auth.store.In(func(s *tokenStore) er.R {
+				for i, t := range s.Tokens {
+					if t.Id == req.Id {
+						s.Tokens = append(s.Tokens[:i:i], s.Tokens[i+1:]...)
+						return auth.save(s)
+					}
+				}
+				return er.Errorf("No such token [%s]", req.Id)
+			})
+		},
+	)
+}
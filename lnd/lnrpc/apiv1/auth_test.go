@@ -0,0 +1,158 @@
+package apiv1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/rest_pb"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestScopes(t *testing.T) {
+	ro := storedToken{Scopes: []string{ScopeReadOnly}}
+	for path, ok := range map[string]bool{
+		"meta/getinfo":              true,
+		"util/seed/create":          true,
+		"utility":                   false,
+		"wallet/transaction":        true,
+		"wallet/transaction/query":  true,
+		"wallet/transaction/create": false,
+		"lightning/channel/open":    false,
+		"meta/stop":                 false,
+		"wallets":                   true,
+		"wallets/create":            false,
+		// Endpoints of a named wallet
+		"wallet/savings/balance":                  true,
+		"wallet/savings/transaction/query":        true,
+		"wallet/savings/transaction/create":       false,
+		"wallet/savings/address/dumpprivkey":      false,
+		"lightning/savings/channel":               false,
+		"wallet/savings/unspent/lock/create":      false,
+		"wallet/savings/addressbook/export":       true,
+		"wallet/savings/transaction/query/extras": false,
+	} {
+		if ro.allows(path) != ok {
+			t.Errorf("readonly token access to [%s] should be [%v]", path, ok)
+		}
+	}
+	spend := storedToken{Scopes: []string{ScopeWalletSpend}}
+	if !spend.allows("wallet/savings/psbt/fund") || !spend.allows("wallet/savings/transaction/sendmany") ||
+		spend.allows("wallet/savings/address/dumpprivkey") {
+		t.Errorf("walletspend token should spend from named wallets")
+	}
+	p := storedToken{Paths: []string{"lightning/invoice"}}
+	if !p.allows("lightning/invoice/create") || p.allows("lightning/invoices") {
+		t.Errorf("Path grant should cover exactly the category")
+	}
+	admin := storedToken{Scopes: []string{ScopeAdmin}}
+	if !admin.allows("meta/stop") {
+		t.Errorf("Admin token should allow everything")
+	}
+}
+
+func TestAuth(t *testing.T) {
+	dir := t.TempDir()
+	a, r := New()
+	if err := a.EnableAuth(dir); err != nil {
+		t.Fatal(err)
+	}
+	meta := DefineCategory(a, "meta", "Meta")
+	for _, name := range []string{"getinfo", "stop"} {
+		Endpoint(meta, name, "Test endpoint", func(_ *rpc_pb.Null) (*rpc_pb.Null, er.R) {
+			return &rpc_pb.Null{}, nil
+		})
+	}
+	RegisterTokenEndpoints(DefineCategory(meta, "token", "Tokens"))
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	adminToken, err := os.ReadFile(filepath.Join(dir, adminTokenFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	post := func(path, token, body string) *http.Response {
+		req, err := http.NewRequest("POST", srv.URL+"/api/v1/"+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	expect := func(path, token string, code int) {
+		res := post(path, token, "{}")
+		res.Body.Close()
+		if res.StatusCode != code {
+			t.Fatalf("Expected [%d] from [%s], got [%d]", code, path, res.StatusCode)
+		}
+	}
+
+	expect("meta/getinfo", "", http.StatusUnauthorized)
+	expect("meta/getinfo", "bogus", http.StatusUnauthorized)
+	expect("meta/stop", string(adminToken), http.StatusOK)
+
+	res := post("meta/token/create", string(adminToken), `{"name":"ro","scopes":["readonly"]}`)
+	var created rest_pb.TokenCreateResponse
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if err := protojson.Unmarshal(b, &created); err != nil {
+		t.Fatal(err)
+	}
+	expect("meta/getinfo", created.Token, http.StatusOK)
+	expect("meta/stop", created.Token, http.StatusForbidden)
+	expect("meta/token/create", created.Token, http.StatusForbidden)
+
+	// Tokens survive a restart
+	a2, _ := New()
+	if err := a2.EnableAuth(dir); err != nil {
+		t.Fatal(err)
+	}
+	if a2.internal.auth.lookup(created.Token) == nil {
+		t.Fatalf("Token was not persisted")
+	}
+
+	res = post("meta/token/revoke", string(adminToken), `{"id":"`+created.Info.Id+`"}`)
+	res.Body.Close()
+	expect("meta/getinfo", created.Token, http.StatusUnauthorized)
+
+	// Once every token is revoked, a restart doesn't create a new admin token
+	var list rest_pb.TokenList
+	res = post("meta/token", string(adminToken), "{}")
+	if b, err = io.ReadAll(res.Body); err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if err := protojson.Unmarshal(b, &list); err != nil {
+		t.Fatal(err)
+	}
+	for _, info := range list.Tokens {
+		res = post("meta/token/revoke", string(adminToken), `{"id":"`+info.Id+`"}`)
+		res.Body.Close()
+	}
+	if err := os.Remove(filepath.Join(dir, adminTokenFile)); err != nil {
+		t.Fatal(err)
+	}
+	a3, _ := New()
+	if err := a3.EnableAuth(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, adminTokenFile)); !os.IsNotExist(err) {
+		t.Fatalf("Expected no admin token to be created, got [%v]", err)
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -131,25 +133,69 @@ func marshal(w http.ResponseWriter, m proto.Message, isJson bool) er.R {
 	return nil
 }
 
+// Write a single message of a stream, JSON messages are written one per line
+// (or as Server-Sent Events) and protobuf messages are prefixed by their length as a varint.
+func marshalStreamMsg(w io.Writer, m proto.Message, isJson bool, isSse bool) er.R {
+	if !isJson && !isSse {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			return er.E(err)
+		}
+		_, err = w.Write(append(protowire.AppendVarint(nil, uint64(len(b))), b...))
+		return er.E(err)
+	}
+	marshaler := protojson.MarshalOptions{
+		EmitUnpopulated: true,
+		UseEnumNumbers:  false,
+	}
+	b, err := marshaler.Marshal(m)
+	if err != nil {
+		return er.E(err)
+	}
+	if isSse {
+		_, err = fmt.Fprintf(w, "data: %s\n\n", b)
+	} else {
+		_, err = w.Write(append(b, '\n'))
+	}
+	return er.E(err)
+}
+
 // Endpoint
 
+type stream struct {
+	mkReq func() proto.Message
+	// nil unless the stream is bidirectional, makes each message which the client
+	// sends into the stream
+	mkIn func() proto.Message
+	// Begin the stream, the returned channel must be closed when the stream ends
+	// and the stream must end once quit is closed. If the stream is bidirectional then
+	// the messages from the client arrive on in, otherwise in is nil.
+	f func(m proto.Message, in <-chan proto.Message, quit <-chan struct{}) (<-chan proto.Message, er.R)
+}
+
 type endpoint struct {
 	path     string
 	category string
 	mkReq    func() proto.Message
 	helpRes  help_pb.EndpointHelp
-	f        func(m proto.Message) (proto.Message, er.R)
+	// nil if the endpoint is streaming-only
+	f      func(m proto.Message) (proto.Message, er.R)
+	stream *stream
 }
 
-func (e *endpoint) serveHttpOrErr(w http.ResponseWriter, r *http.Request, isJson bool) er.R {
-
-	//	command URI handler
-	req := e.mkReq()
+// Whether a client has asked for the stream rather than the ordinary response.
+func wantsStream(r *http.Request) bool {
+	return strings.Contains(strings.ToLower(r.Header.Get("Accept")), "text/event-stream") ||
+		r.URL.Query().Has("stream")
+}
 
-	if r.Method != "POST" && r.Method != "GET" {
-		w.WriteHeader(http.StatusMethodNotAllowed)
-		return er.New("405 - Method not allowed: " + r.Method)
-	}
+func (e *endpoint) readRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	mkReq func() proto.Message,
+	isJson bool,
+) (proto.Message, er.R) {
+	req := mkReq()
 
 	// There is actually a req struct, it's not Null.
 	if _, ok := req.(*rpc_pb.Null); !ok {
@@ -157,13 +203,84 @@ func (e *endpoint) serveHttpOrErr(w http.ResponseWriter, r *http.Request, isJson
 		if r.Method == "POST" {
 			if err := unmarshal(r, req, isJson); err != nil {
 				w.WriteHeader(http.StatusBadRequest)
-				return err
+				return nil, err
 			}
 		} else if !util.Contains(e.helpRes.Features, help_pb.F_ALLOW_GET) {
 			w.WriteHeader(http.StatusMethodNotAllowed)
-			return er.New("405 - Request should be a POST because the endpoint requires input")
+			return nil, er.New("405 - Request should be a POST because the endpoint requires input")
+		}
+	}
+	return req, nil
+}
+
+func (e *endpoint) serveStream(w http.ResponseWriter, r *http.Request, isJson bool) er.R {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return er.New("500 - Streaming is not supported on this connection")
+	}
+	if e.stream.mkIn != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return er.New("400 - This stream is bidirectional and is only available over the websocket")
+	}
+	req, err := e.readRequest(w, r, e.stream.mkReq, isJson)
+	if err != nil {
+		return err
+	}
+	quit := make(chan struct{})
+	defer close(quit)
+	msgs, err := e.stream.f(req, nil, quit)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return err
+	}
+
+	isSse := strings.Contains(strings.ToLower(r.Header.Get("Accept")), "text/event-stream")
+	if isSse {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+	} else if isJson {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.Header().Set("Content-Type", "application/protobuf")
+	}
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// From here on the headers are sent so errors can only end the stream.
+	for {
+		select {
+		case <-r.Context().Done():
+			return nil
+		case m, ok := <-msgs:
+			if !ok {
+				return nil
+			}
+			if err := marshalStreamMsg(w, m, isJson, isSse); err != nil {
+				log.Debugf("Stream [%s] to [%s] ended: [%s]", e.path, r.RemoteAddr, err)
+				return nil
+			}
+			flusher.Flush()
 		}
 	}
+}
+
+func (e *endpoint) serveHttpOrErr(w http.ResponseWriter, r *http.Request, isJson bool) er.R {
+
+	if r.Method != "POST" && r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return er.New("405 - Method not allowed: " + r.Method)
+	}
+
+	if e.stream != nil && (e.f == nil || wantsStream(r)) {
+		return e.serveStream(w, r, isJson)
+	}
+
+	//	command URI handler
+	req, err := e.readRequest(w, r, e.mkReq, isJson)
+	if err != nil {
+		return err
+	}
 	if res, err := e.f(req); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return err
@@ -215,6 +332,8 @@ func (e *endpoint) respondHelp(res http.ResponseWriter, r *http.Request) er.R {
 type apiInt struct {
 	cats  lock.GenRwLock[map[string][]string]
 	funcs lock.GenRwLock[map[string]*endpoint]
+	// nil unless EnableAuth has been called
+	auth *authenticator
 }
 
 type Apiv1 struct {
@@ -266,24 +385,7 @@ func DefineCategory(
 	return a.cat(path, &description)
 }
 
-func Stream[Q proto.Message, R proto.Message](
-	a *Apiv1,
-	name string,
-	description string,
-	ev *event.Emitter[R],
-	filter func(req Q) (func(R) bool, er.R),
-	features ...help_pb.F,
-) {
-
-}
-
-func Endpoint[Q proto.Message, R proto.Message](
-	a *Apiv1,
-	name string,
-	description string,
-	f func(req Q) (R, er.R),
-	features ...help_pb.F,
-) {
+func (a *Apiv1) epPath(name string) string {
 	path := name
 	if a.category != "" {
 		if path != "" {
@@ -292,7 +394,10 @@ func Endpoint[Q proto.Message, R proto.Message](
 			path = a.category
 		}
 	}
+	return path
+}
 
+func withStability(path string, features []help_pb.F) []help_pb.F {
 	// If no stability, set stability to EXPERIMENTAL
 	hasStability := false
 	for _, f := range features {
@@ -315,6 +420,272 @@ func Endpoint[Q proto.Message, R proto.Message](
 		// No defined stability = experimental
 		features = append(features, help_pb.F_EXPERIMENTAL)
 	}
+	return features
+}
+
+// Subscribe to an event emitter for the life of a stream, events which are
+// accepted are sent to the returned channel, which is closed once quit is closed.
+func emitterStream[R proto.Message](
+	ev *event.Emitter[R],
+	accept func(R) bool,
+	quit <-chan struct{},
+) <-chan proto.Message {
+	out := make(chan proto.Message)
+	stop := event.NewEmitter[struct{}]("apiv1 stream stop")
+	ready := make(chan struct{})
+	var wg sync.WaitGroup
+	event.GoWg(&wg, func(loop *event.Loop) {
+		ev.On(loop, func(r R) {
+			if !accept(r) {
+				return
+			}
+			select {
+			case out <- r:
+			case <-quit:
+			}
+		})
+		stop.On(loop, func(_ struct{}) {
+			loop.Quit()
+		})
+		close(ready)
+	})
+	go func() {
+		<-ready
+		<-quit
+		if err := stop.TryEmit(struct{}{}); err != nil {
+			log.Warnf("Unable to stop stream: [%s]", err)
+		}
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+func registerStream(
+	a *Apiv1,
+	name string,
+	description string,
+	mkReq func() proto.Message,
+	mkIn func() proto.Message,
+	mkRes func() proto.Message,
+	f func(m proto.Message, in <-chan proto.Message, quit <-chan struct{}) (<-chan proto.Message, er.R),
+	features []help_pb.F,
+) {
+	path := a.epPath(name)
+	features = withStability(path, features)
+	if !util.Contains(features, help_pb.F_STREAMING) {
+		features = append(features, help_pb.F_STREAMING)
+	}
+
+	log.Infof("Registering stream [%s]", path)
+	reqHt, err := pkthelp.Help(mkReq())
+	if err != nil {
+		log.Warnf("Error registering stream [%s]: [%s]", path, err)
+		return
+	}
+	resHt, err := pkthelp.Help(mkRes())
+	if err != nil {
+		log.Warnf("Error registering stream [%s]: [%s]", path, err)
+		return
+	}
+	var inHt *help_pb.Type
+	if mkIn != nil {
+		if ht, err := pkthelp.Help(mkIn()); err != nil {
+			log.Warnf("Error registering stream [%s]: [%s]", path, err)
+			return
+		} else {
+			inHt = convertHelpType(ht)
+		}
+	}
+	st := &stream{
+		mkReq: mkReq,
+		mkIn:  mkIn,
+		f:     f,
+	}
+	a.internal.funcs.W().In(func(funcs *map[string]*endpoint) er.R {
+		if ep, ok := (*funcs)[path]; ok && ep.f != nil {
+			// There is already an ordinary endpoint at this path, add the stream to it
+			ep.stream = st
+			ep.helpRes.StreamRequest = convertHelpType(reqHt)
+			ep.helpRes.Stream = convertHelpType(resHt)
+			ep.helpRes.StreamInput = inHt
+			if !util.Contains(ep.helpRes.Features, help_pb.F_STREAMING) {
+				ep.helpRes.Features = append(ep.helpRes.Features, help_pb.F_STREAMING)
+			}
+			return nil
+		}
+		(*funcs)[path] = &endpoint{
+			path:     path,
+			mkReq:    mkReq,
+			category: a.category,
+			helpRes: help_pb.EndpointHelp{
+				Path:          _api_v1_ + path,
+				Description:   trimSplit(description),
+				Request:       convertHelpType(reqHt),
+				Response:      convertHelpType(resHt),
+				Features:      features,
+				StreamRequest: convertHelpType(reqHt),
+				Stream:        convertHelpType(resHt),
+				StreamInput:   inHt,
+			},
+			stream: st,
+		}
+		return nil
+	})
+}
+
+// Stream registers a streaming endpoint which sends each event from ev which passes
+// the filter. The filter function is called once for each subscriber with the
+// request which they sent, and it returns a function which selects the events which
+// will be sent to that subscriber.
+//
+// If there is already an ordinary Endpoint registered with the same name, the stream
+// is added to it and clients must explicitly request the stream, otherwise the endpoint
+// is streaming-only. Over HTTP, a stream is requested using the `Accept: text/event-stream`
+// header (Server-Sent Events) or with `?stream` in the URL, in which case JSON messages are
+// newline delimited and protobuf messages are each prefixed with their length as a varint.
+// Over the websocket, a stream is requested by setting has_more in the request.
+func Stream[Q proto.Message, R proto.Message](
+	a *Apiv1,
+	name string,
+	description string,
+	ev *event.Emitter[R],
+	filter func(req Q) (func(R) bool, er.R),
+	features ...help_pb.F,
+) {
+	registerStream(
+		a,
+		name,
+		description,
+		toPm[Q],
+		nil,
+		toPm[R],
+		func(m proto.Message, _ <-chan proto.Message, quit <-chan struct{}) (<-chan proto.Message, er.R) {
+			if query, ok := m.(Q); !ok {
+				panic("invalid type")
+			} else if accept, err := filter(query); err != nil {
+				return nil, err
+			} else {
+				return emitterStream(ev, accept, quit), nil
+			}
+		},
+		features,
+	)
+}
+
+// StreamFunc registers a streaming endpoint which is backed by a function that is
+// called once for each subscriber. The function returns a channel of messages which
+// it must close when the stream ends, it must also end the stream once quit is closed.
+// An error returned by the function is sent to the client in place of the stream.
+// See Stream for how clients request a stream.
+func StreamFunc[Q proto.Message, R proto.Message](
+	a *Apiv1,
+	name string,
+	description string,
+	f func(req Q, quit <-chan struct{}) (<-chan R, er.R),
+	features ...help_pb.F,
+) {
+	registerStream(
+		a,
+		name,
+		description,
+		toPm[Q],
+		nil,
+		toPm[R],
+		func(m proto.Message, _ <-chan proto.Message, quit <-chan struct{}) (<-chan proto.Message, er.R) {
+			query, ok := m.(Q)
+			if !ok {
+				panic("invalid type")
+			}
+			res, err := f(query, quit)
+			if err != nil {
+				return nil, err
+			}
+			return untypedStream(res, quit), nil
+		},
+		features,
+	)
+}
+
+// Forward the messages from a typed channel until it is closed.
+func untypedStream[R proto.Message](res <-chan R, quit <-chan struct{}) <-chan proto.Message {
+	out := make(chan proto.Message)
+	go func() {
+		defer close(out)
+		for r := range res {
+			select {
+			case out <- r:
+			case <-quit:
+			}
+		}
+	}()
+	return out
+}
+
+// BidiStream registers a bidirectional streaming endpoint, which is only available over
+// the websocket. The stream is started by a request with has_more set, in the same way
+// as any other stream, after which each message which the client sends with the same
+// request_id and has_more set is delivered to the function on the in channel. Sending
+// the request_id without has_more ends the stream, as does closing the websocket.
+// The function must close the returned channel when the stream ends and it must end
+// the stream once quit is closed.
+func BidiStream[Q proto.Message, I proto.Message, R proto.Message](
+	a *Apiv1,
+	name string,
+	description string,
+	f func(req Q, in <-chan I, quit <-chan struct{}) (<-chan R, er.R),
+	features ...help_pb.F,
+) {
+	registerStream(
+		a,
+		name,
+		description,
+		toPm[Q],
+		toPm[I],
+		toPm[R],
+		func(m proto.Message, in <-chan proto.Message, quit <-chan struct{}) (<-chan proto.Message, er.R) {
+			query, ok := m.(Q)
+			if !ok {
+				panic("invalid type")
+			}
+			typedIn := make(chan I)
+			go func() {
+				for {
+					select {
+					case m := <-in:
+						if i, ok := m.(I); !ok {
+							panic("invalid type")
+						} else {
+							select {
+							case typedIn <- i:
+							case <-quit:
+								return
+							}
+						}
+					case <-quit:
+						return
+					}
+				}
+			}()
+			res, err := f(query, typedIn, quit)
+			if err != nil {
+				return nil, err
+			}
+			return untypedStream(res, quit), nil
+		},
+		features,
+	)
+}
+
+func Endpoint[Q proto.Message, R proto.Message](
+	a *Apiv1,
+	name string,
+	description string,
+	f func(req Q) (R, er.R),
+	features ...help_pb.F,
+) {
+	path := a.epPath(name)
+	features = withStability(path, features)
 
 	// We're not going to return an error from here because
 	// nobody wants to handle runtime errors while setting up
@@ -334,7 +705,7 @@ func Endpoint[Q proto.Message, R proto.Message](
 		return
 	}
 	a.internal.funcs.W().In(func(funcs *map[string]*endpoint) er.R {
-		(*funcs)[path] = &endpoint{
+		ep := &endpoint{
 			path:     path,
 			mkReq:    toPm[Q],
 			category: a.category,
@@ -353,6 +724,17 @@ func Endpoint[Q proto.Message, R proto.Message](
 				}
 			},
 		}
+		if old, ok := (*funcs)[path]; ok && old.stream != nil {
+			// Keep the stream which was registered at this path
+			ep.stream = old.stream
+			ep.helpRes.StreamRequest = old.helpRes.StreamRequest
+			ep.helpRes.Stream = old.helpRes.Stream
+			ep.helpRes.StreamInput = old.helpRes.StreamInput
+			if !util.Contains(ep.helpRes.Features, help_pb.F_STREAMING) {
+				ep.helpRes.Features = append(ep.helpRes.Features, help_pb.F_STREAMING)
+			}
+		}
+		(*funcs)[path] = ep
 		return nil
 	})
 }
@@ -368,6 +750,63 @@ func Deregister(a *Apiv1, path string) er.R {
 	})
 }
 
+func underPath(p, path string) bool {
+	return p == path || strings.HasPrefix(p, path+"/")
+}
+
+// DeregisterCategory removes the category at path along with every endpoint
+// and sub-category within it.
+func DeregisterCategory(a *Apiv1, path string) er.R {
+	found := false
+	a.internal.funcs.W().In(func(eps *map[string]*endpoint) er.R {
+		for p := range *eps {
+			if underPath(p, path) {
+				delete(*eps, p)
+				found = true
+			}
+		}
+		return nil
+	})
+	a.internal.cats.W().In(func(cats *map[string][]string) er.R {
+		for p := range *cats {
+			if underPath(p, path) {
+				delete(*cats, p)
+				found = true
+			}
+		}
+		return nil
+	})
+	if !found {
+		return er.New("not found")
+	}
+	return nil
+}
+
+// IsRegistered returns true if there is an endpoint or a category at path,
+// or anything registered within it.
+func IsRegistered(a *Apiv1, path string) bool {
+	found := false
+	a.internal.funcs.R().In(func(eps *map[string]*endpoint) er.R {
+		for p := range *eps {
+			if underPath(p, path) {
+				found = true
+				break
+			}
+		}
+		return nil
+	})
+	a.internal.cats.R().In(func(cats *map[string][]string) er.R {
+		for p := range *cats {
+			if underPath(p, path) {
+				found = true
+				break
+			}
+		}
+		return nil
+	})
+	return found
+}
+
 type epInfo struct {
 	shortDesc string
 	category  string
@@ -418,7 +857,25 @@ func (a *Apiv1) openApiHelp() (*help_pb.OpenAPI, er.R) {
 							for _, line := range ep.helpRes.Description {
 								txt(line)
 							}
+							if ep.stream != nil && ep.stream.mkIn != nil {
+								txt("")
+								txt("Bidirectional stream of [%s] messages, only available over the "+
+									"websocket. Start the stream with `has_more` set, then send [%s] "+
+									"messages with the same `request_id` and `has_more` set.",
+									ep.helpRes.Stream.Name, ep.helpRes.StreamInput.Name)
+							} else if ep.stream != nil {
+								txt("")
+								txt("Streams [%s] messages, request the stream using the "+
+									"`Accept: text/event-stream` header, with `?stream` in the URL, "+
+									"or over the websocket with `has_more` set.", ep.helpRes.Stream.Name)
+							}
 						})
+						if ep.stream != nil {
+							txt("x-stream: %s", ep.helpRes.Stream.Name)
+						}
+						if ep.helpRes.StreamInput != nil {
+							txt("x-stream-input: %s", ep.helpRes.StreamInput.Name)
+						}
 					})
 				})
 			}
@@ -523,6 +980,8 @@ func New() (*Apiv1, *mux.Router) {
 		if strings.Index(path, "help/") == 0 {
 			isHelp = true
 			path = strings.Replace(path, "help/", "", 1)
+		} else if !out.internal.auth.authorize(res, r, path) {
+			return
 		}
 		var ep *endpoint
 		out.internal.funcs.R().In(func(funcs *map[string]*endpoint) er.R {
@@ -546,7 +1005,9 @@ func New() (*Apiv1, *mux.Router) {
 
 	//	add a handler for websocket endpoint
 	r.Handle(_api_v1_+"websocket", http.HandlerFunc(func(httpResponse http.ResponseWriter, httpRequest *http.Request) {
-		webSocketHandler(&out, httpResponse, httpRequest)
+		if token, ok := out.internal.auth.authenticate(httpResponse, httpRequest); ok {
+			webSocketHandler(&out, token, httpResponse, httpRequest)
+		}
 	}))
 
 	Endpoint(
@@ -0,0 +1,460 @@
+package apiv1
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/event"
+	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/rest_pb"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func mkStreamApi(t *testing.T) (*event.Emitter[*rpc_pb.SendTxUpdate], *httptest.Server) {
+	a, r := New()
+	ee := event.NewEmitter[*rpc_pb.SendTxUpdate]("test emitter")
+	Endpoint(
+		a,
+		"sending",
+		"Snapshot of transactions being sent",
+		func(_ *rpc_pb.Null) (*rpc_pb.TransactionsInFlight, er.R) {
+			return &rpc_pb.TransactionsInFlight{}, nil
+		},
+	)
+	Stream(
+		a,
+		"sending",
+		"Transactions being sent",
+		&ee,
+		func(_ *rpc_pb.Null) (func(*rpc_pb.SendTxUpdate) bool, er.R) {
+			return func(u *rpc_pb.SendTxUpdate) bool {
+				return u.Event != rpc_pb.SendTxEvent_INIT
+			}, nil
+		},
+	)
+	return &ee, httptest.NewServer(r)
+}
+
+func awaitListener(t *testing.T, ee *event.Emitter[*rpc_pb.SendTxUpdate]) {
+	for i := 0; ee.Listeners() == 0; i++ {
+		if i > 100 {
+			t.Fatalf("Stream never subscribed to the emitter")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestHttpStream(t *testing.T) {
+	ee, srv := mkStreamApi(t)
+	defer srv.Close()
+
+	// Without asking for the stream, we get the ordinary endpoint
+	res, err := http.Get(srv.URL + "/api/v1/sending")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); strings.Contains(ct, "ndjson") {
+		t.Fatalf("Unexpected content type [%s]", ct)
+	}
+
+	res, err = http.Get(srv.URL + "/api/v1/sending?stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Fatalf("Unexpected content type [%s]", ct)
+	}
+	awaitListener(t, ee)
+	if err := ee.TryEmit(&rpc_pb.SendTxUpdate{Txid: "filtered", Event: rpc_pb.SendTxEvent_INIT}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ee.TryEmit(&rpc_pb.SendTxUpdate{Txid: "abcd", Event: rpc_pb.SendTxEvent_SENT}); err != nil {
+		t.Fatal(err)
+	}
+	line, errr := bufio.NewReader(res.Body).ReadBytes('\n')
+	if errr != nil {
+		t.Fatal(errr)
+	}
+	var upd rpc_pb.SendTxUpdate
+	if err := protojson.Unmarshal(line, &upd); err != nil {
+		t.Fatal(err)
+	}
+	if upd.Txid != "abcd" || upd.Event != rpc_pb.SendTxEvent_SENT {
+		t.Fatalf("Unexpected update [%v]", &upd)
+	}
+}
+
+func TestWebsocketStream(t *testing.T) {
+	ee, srv := mkStreamApi(t)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/v1/websocket"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	req := func(r WebSocketJSonRequest) {
+		if b, err := jsoniter.Marshal(&r); err != nil {
+			t.Fatal(err)
+		} else if err := conn.WriteMessage(websocket.TextMessage, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp := func() *WebSocketJSonResponse {
+		var r WebSocketJSonResponse
+		if _, b, err := conn.ReadMessage(); err != nil {
+			t.Fatal(err)
+		} else if err := jsoniter.Unmarshal(b, &r); err != nil {
+			t.Fatal(err)
+		}
+		return &r
+	}
+
+	req(WebSocketJSonRequest{Endpoint: "sending", RequestId: "1", HasMore: true, Payload: []byte("{}")})
+	awaitListener(t, ee)
+	if err := ee.TryEmit(&rpc_pb.SendTxUpdate{Txid: "abcd", Event: rpc_pb.SendTxEvent_SENT}); err != nil {
+		t.Fatal(err)
+	}
+	if r := resp(); r.RequestId != "1" || !r.HasMore || !strings.Contains(string(r.Payload), "abcd") {
+		t.Fatalf("Unexpected response [%s] [%v] [%s]", r.RequestId, r.HasMore, r.Payload)
+	}
+
+	// Cancel the stream, the final message has no more
+	req(WebSocketJSonRequest{RequestId: "1"})
+	if r := resp(); r.RequestId != "1" || r.HasMore {
+		t.Fatalf("Unexpected response [%s] [%v] [%s]", r.RequestId, r.HasMore, r.Payload)
+	}
+}
+
+// Protobuf replies are sent in text frames, as they always have been, so existing clients
+// keep working.
+func TestWebsocketProtobufFrames(t *testing.T) {
+	ee, srv := mkStreamApi(t)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/v1/websocket"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	payload, err := anypb.New(&rpc_pb.Null{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := func(r *rest_pb.WebSocketProtobufRequest) {
+		if b, err := proto.Marshal(r); err != nil {
+			t.Fatal(err)
+		} else if err := conn.WriteMessage(websocket.BinaryMessage, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp := func() *rest_pb.WebSocketProtobufResponse {
+		var r rest_pb.WebSocketProtobufResponse
+		if msgType, b, err := conn.ReadMessage(); err != nil {
+			t.Fatal(err)
+		} else if msgType != websocket.TextMessage {
+			t.Fatalf("Expected a text frame, got [%d]", msgType)
+		} else if err := proto.Unmarshal(b, &r); err != nil {
+			t.Fatal(err)
+		}
+		return &r
+	}
+
+	req(&rest_pb.WebSocketProtobufRequest{Endpoint: "sending", RequestId: "1", Payload: payload})
+	if r := resp(); r.RequestId != "1" || r.GetOk() == nil {
+		t.Fatalf("Unexpected response [%v]", r)
+	}
+
+	req(&rest_pb.WebSocketProtobufRequest{Endpoint: "sending", RequestId: "2", HasMore: true, Payload: payload})
+	awaitListener(t, ee)
+	if err := ee.TryEmit(&rpc_pb.SendTxUpdate{Txid: "abcd", Event: rpc_pb.SendTxEvent_SENT}); err != nil {
+		t.Fatal(err)
+	}
+	if r := resp(); r.RequestId != "2" || !r.HasMore || r.GetOk() == nil {
+		t.Fatalf("Unexpected response [%v]", r)
+	}
+}
+
+func TestStreamFunc(t *testing.T) {
+	a, r := New()
+	StreamFunc(
+		a,
+		"count",
+		"Count to the requested number",
+		func(req *rpc_pb.SendTxUpdate, quit <-chan struct{}) (<-chan *rpc_pb.SendTxUpdate, er.R) {
+			if req.Txid == "" {
+				return nil, er.New("txid is required")
+			}
+			out := make(chan *rpc_pb.SendTxUpdate)
+			go func() {
+				defer close(out)
+				for _, ev := range []rpc_pb.SendTxEvent{rpc_pb.SendTxEvent_INIT, rpc_pb.SendTxEvent_SENT} {
+					select {
+					case out <- &rpc_pb.SendTxUpdate{Txid: req.Txid, Event: ev}:
+					case <-quit:
+						return
+					}
+				}
+			}()
+			return out, nil
+		},
+	)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	res, err := http.Post(srv.URL+"/api/v1/count", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Expected an error, got [%d]", res.StatusCode)
+	}
+
+	res, err = http.Post(srv.URL+"/api/v1/count", "application/json", strings.NewReader(`{"txid":"abcd"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	rd := bufio.NewReader(res.Body)
+	for _, ev := range []rpc_pb.SendTxEvent{rpc_pb.SendTxEvent_INIT, rpc_pb.SendTxEvent_SENT} {
+		line, errr := rd.ReadBytes('\n')
+		if errr != nil {
+			t.Fatal(errr)
+		}
+		var upd rpc_pb.SendTxUpdate
+		if err := protojson.Unmarshal(line, &upd); err != nil {
+			t.Fatal(err)
+		}
+		if upd.Txid != "abcd" || upd.Event != ev {
+			t.Fatalf("Unexpected update [%v]", &upd)
+		}
+	}
+	// The stream ends when the channel is closed
+	if _, errr := rd.ReadBytes('\n'); errr == nil {
+		t.Fatalf("Expected the stream to end")
+	}
+}
+
+func TestBidiStream(t *testing.T) {
+	a, r := New()
+	BidiStream(
+		a,
+		"echo",
+		"Echo each input back",
+		func(_ *rpc_pb.Null, in <-chan *rpc_pb.SendTxUpdate, quit <-chan struct{}) (<-chan *rpc_pb.SendTxUpdate, er.R) {
+			out := make(chan *rpc_pb.SendTxUpdate)
+			go func() {
+				defer close(out)
+				for {
+					select {
+					case m := <-in:
+						select {
+						case out <- m:
+						case <-quit:
+							return
+						}
+					case <-quit:
+						return
+					}
+				}
+			}()
+			return out, nil
+		},
+	)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	// Bidirectional streams are not available over plain HTTP
+	res, err := http.Post(srv.URL+"/api/v1/echo", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Expected bad request, got [%d]", res.StatusCode)
+	}
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/v1/websocket"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	req := func(r WebSocketJSonRequest) {
+		if b, err := jsoniter.Marshal(&r); err != nil {
+			t.Fatal(err)
+		} else if err := conn.WriteMessage(websocket.TextMessage, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req(WebSocketJSonRequest{Endpoint: "echo", RequestId: "1", HasMore: true, Payload: []byte("{}")})
+	// Input is sent on the same request_id with has_more
+	req(WebSocketJSonRequest{RequestId: "1", HasMore: true, Payload: []byte(`{"txid":"abcd"}`)})
+	var r1 WebSocketJSonResponse
+	if _, b, err := conn.ReadMessage(); err != nil {
+		t.Fatal(err)
+	} else if err := jsoniter.Unmarshal(b, &r1); err != nil {
+		t.Fatal(err)
+	}
+	if r1.RequestId != "1" || !r1.HasMore || !strings.Contains(string(r1.Payload), "abcd") {
+		t.Fatalf("Unexpected response [%s] [%v] [%s]", r1.RequestId, r1.HasMore, r1.Payload)
+	}
+
+	// Closing the stream sends a final message without has_more
+	req(WebSocketJSonRequest{RequestId: "1"})
+	var r2 WebSocketJSonResponse
+	if _, b, err := conn.ReadMessage(); err != nil {
+		t.Fatal(err)
+	} else if err := jsoniter.Unmarshal(b, &r2); err != nil {
+		t.Fatal(err)
+	}
+	if r2.RequestId != "1" || r2.HasMore {
+		t.Fatalf("Unexpected response [%s] [%v] [%s]", r2.RequestId, r2.HasMore, r2.Payload)
+	}
+}
+
+// A handler which doesn't read its input must not stop the websocket from handling other
+// requests, and an ordinary endpoint at the same path keeps the stream's input type.
+func TestBidiStreamSlowHandler(t *testing.T) {
+	a, r := New()
+	BidiStream(
+		a,
+		"stuck",
+		"Never reads its input",
+		func(_ *rpc_pb.Null, _ <-chan *rpc_pb.SendTxUpdate, quit <-chan struct{}) (<-chan *rpc_pb.SendTxUpdate, er.R) {
+			out := make(chan *rpc_pb.SendTxUpdate)
+			go func() {
+				<-quit
+				close(out)
+			}()
+			return out, nil
+		},
+	)
+	Endpoint(
+		a,
+		"stuck",
+		"Snapshot",
+		func(_ *rpc_pb.Null) (*rpc_pb.Null, er.R) {
+			return &rpc_pb.Null{}, nil
+		},
+	)
+	a.internal.funcs.R().In(func(funcs *map[string]*endpoint) er.R {
+		if ep := (*funcs)["stuck"]; ep.stream == nil || ep.helpRes.StreamInput == nil {
+			t.Errorf("The stream and its input type should be kept")
+		}
+		return nil
+	})
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/api/v1/websocket"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	req := func(r WebSocketJSonRequest) {
+		if b, err := jsoniter.Marshal(&r); err != nil {
+			t.Fatal(err)
+		} else if err := conn.WriteMessage(websocket.TextMessage, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req(WebSocketJSonRequest{Endpoint: "stuck", RequestId: "1", HasMore: true, Payload: []byte("{}")})
+	// One message is held by the stream, the rest wait in the buffer
+	for i := 0; i < wsStreamInputBuffer+2; i++ {
+		req(WebSocketJSonRequest{RequestId: "1", HasMore: true, Payload: []byte(`{"txid":"abcd"}`)})
+	}
+	req(WebSocketJSonRequest{Endpoint: "stuck", RequestId: "2", Payload: []byte("{}")})
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var dropped, answered bool
+	for !answered {
+		var r WebSocketJSonResponse
+		if _, b, err := conn.ReadMessage(); err != nil {
+			t.Fatal(err)
+		} else if err := jsoniter.Unmarshal(b, &r); err != nil {
+			t.Fatal(err)
+		}
+		switch r.RequestId {
+		case "1":
+			dropped = dropped || (r.HasMore && strings.Contains(r.Error.Message, "not keeping up"))
+		case "2":
+			answered = true
+		}
+	}
+	if !dropped {
+		t.Fatalf("Expected the input which did not fit to be refused")
+	}
+}
+
+func TestDeregisterCategory(t *testing.T) {
+	a, r := New()
+	wallet := DefineCategory(a, "wallet", "Wallet")
+	Endpoint(wallet, "balance", "Balance", func(_ *rpc_pb.Null) (*rpc_pb.Null, er.R) {
+		return nil, nil
+	})
+	named := DefineCategory(wallet, "alice", "Named wallet")
+	Endpoint(named, "balance", "Balance", func(_ *rpc_pb.Null) (*rpc_pb.Null, er.R) {
+		return nil, nil
+	})
+	Endpoint(DefineCategory(named, "address", "Addresses"), "create", "Create",
+		func(_ *rpc_pb.Null) (*rpc_pb.Null, er.R) {
+			return nil, nil
+		})
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	if !IsRegistered(a, "wallet/alice") || !IsRegistered(a, "wallet/balance") {
+		t.Fatalf("Expected endpoints to be registered")
+	}
+	if IsRegistered(a, "wallet/ali") {
+		t.Fatalf("A prefix of a name is not registered")
+	}
+	get := func(path string) int {
+		res, err := http.Get(srv.URL + "/api/v1/" + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+	if code := get("wallet/alice/address/create"); code != http.StatusOK {
+		t.Fatalf("Unexpected status [%d]", code)
+	}
+
+	if err := DeregisterCategory(a, "wallet/alice"); err != nil {
+		t.Fatal(err)
+	}
+	if IsRegistered(a, "wallet/alice") {
+		t.Fatalf("Expected wallet/alice to be removed")
+	}
+	if code := get("wallet/alice/address/create"); code != http.StatusNotFound {
+		t.Fatalf("Unexpected status [%d]", code)
+	}
+	if code := get("wallet/balance"); code != http.StatusOK {
+		t.Fatalf("Unexpected status [%d]", code)
+	}
+	if err := DeregisterCategory(a, "wallet/alice"); err == nil {
+		t.Fatalf("Expected an error deregistering a missing category")
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"reflect"
+	"sync"
 
 	"github.com/gorilla/websocket"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/lock"
 	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/rest_pb"
 	"github.com/pkt-cash/pktd/pktlog/log"
 	jsonpb "google.golang.org/protobuf/encoding/protojson"
@@ -15,7 +17,25 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
-type websocketConn websocket.Conn
+type websocketConn struct {
+	conn *websocket.Conn
+	// gorilla websocket allows only one concurrent writer
+	writeMut sync.Mutex
+	// the streams which are active on this connection, by request id
+	streams lock.GenMutex[map[string]*wsStream]
+	// the token which was used to open the connection, nil if there is no authentication
+	token *storedToken
+}
+
+// The number of messages from the client which can wait for a bidirectional stream
+const wsStreamInputBuffer = 64
+
+type wsStream struct {
+	ep   *endpoint
+	quit chan struct{}
+	// messages from the client, nil unless the stream is bidirectional
+	in chan proto.Message
+}
 
 type WebSocketJSonRequest struct {
 	Endpoint  string          `json:"endpoint,omitempty"`
@@ -33,7 +53,12 @@ type WebSocketJSonResponse struct {
 
 var upgrader = websocket.Upgrader{}
 
-func webSocketHandler(ctx *Apiv1, httpResponse http.ResponseWriter, httpRequest *http.Request) {
+func webSocketHandler(
+	ctx *Apiv1,
+	token *storedToken,
+	httpResponse http.ResponseWriter,
+	httpRequest *http.Request,
+) {
 	//	upgrade raw HTTP connection to a websocket
 	conn, err := upgrader.Upgrade(httpResponse, httpRequest, nil)
 	if err != nil {
@@ -44,12 +69,17 @@ func webSocketHandler(ctx *Apiv1, httpResponse http.ResponseWriter, httpRequest
 	defer conn.Close()
 
 	//	webSocket communication loop
-	var wsConn websocketConn = websocketConn(*conn)
+	wsConn := websocketConn{
+		conn:    conn,
+		streams: lock.NewGenMutex(make(map[string]*wsStream), "websocketConn.streams"),
+		token:   token,
+	}
+	defer wsConn.stopStreams()
 
 	for {
 		msgType, message, err := conn.ReadMessage()
 		if err != nil {
-			log.Errorf("Fail during message reading:", err)
+			log.Errorf("Fail during message reading: [%s]", err)
 			return
 		}
 
@@ -88,6 +118,25 @@ func pWsError(err er.R) *rest_pb.WebSocketProtobufResponse_Error {
 	}
 }
 
+func pWsOk(res proto.Message) (*rest_pb.WebSocketProtobufResponse_Ok, er.R) {
+	resBytes, err := er.E1(jsoniter.Marshal(res))
+	if err != nil {
+		return nil, err
+	}
+	return &rest_pb.WebSocketProtobufResponse_Ok{
+		Ok: &anypb.Any{
+			TypeUrl: "github.com/pkt-cash/pktd/lnd/" + reflect.TypeOf(res).String()[1:],
+			Value:   resBytes,
+		},
+	}, nil
+}
+
+func (conn *websocketConn) write(msgType int, payload []byte) er.R {
+	conn.writeMut.Lock()
+	defer conn.writeMut.Unlock()
+	return er.E(conn.conn.WriteMessage(msgType, payload))
+}
+
 func (conn *websocketConn) errorClose(err er.R) {
 	resp := WebSocketJSonResponse{
 		RequestId: "FATAL ERROR",
@@ -96,14 +145,135 @@ func (conn *websocketConn) errorClose(err er.R) {
 	}
 	if respPayload, err := jsoniter.Marshal(&resp); err != nil {
 		log.Errorf("Unable to marshal error message: [%s]", err)
-	} else if err := (*websocket.Conn)(conn).WriteMessage(websocket.TextMessage, respPayload); err != nil {
+	} else if err := conn.write(websocket.TextMessage, respPayload); err != nil {
 		log.Errorf("Unable to send error message: [%s]", err)
 	}
-	if err := (*websocket.Conn)(conn).Close(); err != nil {
+	if err := conn.conn.Close(); err != nil {
 		log.Errorf("Unable to close websocket: [%s]", err)
 	}
 }
 
+func (conn *websocketConn) stopStreams() {
+	conn.streams.In(func(streams *map[string]*wsStream) er.R {
+		for id, st := range *streams {
+			close(st.quit)
+			delete(*streams, id)
+		}
+		return nil
+	})
+}
+
+// If requestId is an active stream, stop it and return true.
+func (conn *websocketConn) stopStream(requestId string) bool {
+	stopped := false
+	conn.streams.In(func(streams *map[string]*wsStream) er.R {
+		if st, ok := (*streams)[requestId]; ok {
+			close(st.quit)
+			delete(*streams, requestId)
+			stopped = true
+		}
+		return nil
+	})
+	return stopped
+}
+
+func (conn *websocketConn) getStream(requestId string) *wsStream {
+	var out *wsStream
+	conn.streams.In(func(streams *map[string]*wsStream) er.R {
+		out = (*streams)[requestId]
+		return nil
+	})
+	return out
+}
+
+// Deliver a message from the client to a bidirectional stream, unmarshal fills in
+// the message from the payload of the request.
+func (st *wsStream) input(unmarshal func(m proto.Message) er.R) er.R {
+	if st.in == nil {
+		return er.New("This stream does not accept input")
+	}
+	m := st.ep.stream.mkIn()
+	if err := unmarshal(m); err != nil {
+		return err
+	}
+	// Never block, the read loop must keep handling pings, close frames and other
+	// requests while a handler is busy.
+	select {
+	case st.in <- m:
+		return nil
+	case <-st.quit:
+		return er.New("The stream has ended")
+	default:
+		return er.Errorf("The stream is not keeping up, more than [%d] messages are "+
+			"waiting, this one was dropped", wsStreamInputBuffer)
+	}
+}
+
+// Begin a stream for a request and call send for each message, a final call to send
+// with a nil message is made when the stream ends.
+func (conn *websocketConn) beginStream(
+	ep *endpoint,
+	requestId string,
+	req proto.Message,
+	send func(m proto.Message) er.R,
+) er.R {
+	st := &wsStream{
+		ep:   ep,
+		quit: make(chan struct{}),
+	}
+	if ep.stream.mkIn != nil {
+		st.in = make(chan proto.Message, wsStreamInputBuffer)
+	}
+	if err := conn.streams.In(func(streams *map[string]*wsStream) er.R {
+		if _, ok := (*streams)[requestId]; ok {
+			return er.Errorf("There is already an active stream with request_id [%s]", requestId)
+		}
+		(*streams)[requestId] = st
+		return nil
+	}); err != nil {
+		return err
+	}
+	msgs, err := ep.stream.f(req, st.in, st.quit)
+	if err != nil {
+		conn.stopStream(requestId)
+		return err
+	}
+	go func() {
+		for m := range msgs {
+			if err := send(m); err != nil {
+				log.Debugf("Websocket stream [%s] ended: [%s]", requestId, err)
+				conn.stopStream(requestId)
+				// Drain so that the stream can end
+				for range msgs {
+				}
+				return
+			}
+		}
+		conn.stopStream(requestId)
+		if err := send(nil); err != nil {
+			log.Debugf("Unable to send end of websocket stream [%s]: [%s]", requestId, err)
+		}
+	}()
+	return nil
+}
+
+func (conn *websocketConn) getEndpoint(ctx *Apiv1, path string) (*endpoint, er.R) {
+	if err := ctx.internal.auth.mayAccess(conn.token, path); err != nil {
+		return nil, err
+	}
+	var endpt *endpoint
+	ctx.internal.funcs.R().In(func(funcs *map[string]*endpoint) er.R {
+		if ep, ok := (*funcs)[path]; ok {
+			endpt = ep
+		}
+		return nil
+	})
+	if endpt == nil {
+		return nil, er.Errorf("No such endpoint: [%s]", path)
+	}
+	return endpt, nil
+}
+
 func (conn *websocketConn) handleJsonMessage(ctx *Apiv1, req []byte) {
 
 	//	unmarshal the request message
@@ -120,15 +290,51 @@ func (conn *websocketConn) handleJsonMessage(ctx *Apiv1, req []byte) {
 		HasMore:   false,
 		Payload:   nil,
 	}
-	var endpt *endpoint
-	ctx.internal.funcs.R().In(func(funcs *map[string]*endpoint) er.R {
-		if ep, ok := (*funcs)[webSocketReq.Endpoint]; ok {
-			endpt = ep
+	if !webSocketReq.HasMore && conn.stopStream(webSocketReq.RequestId) {
+		// The client cancelled a stream, the end of the stream is signaled by the stream itself
+		return
+	}
+	if st := conn.getStream(webSocketReq.RequestId); st != nil && webSocketReq.HasMore {
+		// Input to a bidirectional stream, errors are reported with has_more because the
+		// stream continues.
+		if err := st.input(func(m proto.Message) er.R {
+			return er.E(jsonpb.Unmarshal(webSocketReq.Payload, m))
+		}); err != nil {
+			resp.HasMore = true
+			resp.Error = wsError(err)
+		} else {
+			return
 		}
-		return nil
-	})
-	if endpt == nil {
-		resp.Error = wsError(er.Errorf("No such endpoint: [%s]", webSocketReq.Endpoint))
+	} else if endpt, err := conn.getEndpoint(ctx, webSocketReq.Endpoint); err != nil {
+		resp.Error = wsError(err)
+	} else if endpt.stream != nil && (endpt.f == nil || webSocketReq.HasMore) {
+		req := endpt.stream.mkReq()
+		if err := er.E(jsonpb.Unmarshal(webSocketReq.Payload, req)); err != nil {
+			resp.Error = wsError(err)
+		} else if err := conn.beginStream(endpt, webSocketReq.RequestId, req, func(m proto.Message) er.R {
+			resp := WebSocketJSonResponse{
+				RequestId: webSocketReq.RequestId,
+				HasMore:   m != nil,
+			}
+			if m != nil {
+				if resBytes, err := er.E1(jsoniter.Marshal(m)); err != nil {
+					return err
+				} else {
+					resp.Payload = resBytes
+				}
+			}
+			if respPayload, err := jsoniter.Marshal(&resp); err != nil {
+				return er.E(err)
+			} else {
+				return conn.write(websocket.TextMessage, respPayload)
+			}
+		}); err != nil {
+			resp.Error = wsError(err)
+		} else {
+			return
+		}
+	} else if webSocketReq.HasMore {
+		resp.Error = wsError(er.Errorf("Endpoint [%s] does not support streaming", webSocketReq.Endpoint))
 	} else {
 		req := endpt.mkReq()
 		if err := er.E(jsonpb.Unmarshal(webSocketReq.Payload, req)); err != nil {
@@ -148,8 +354,7 @@ func (conn *websocketConn) handleJsonMessage(ctx *Apiv1, req []byte) {
 		return
 	}
 	//	write the result message to the webSocket client
-	err = (*websocket.Conn)(conn).WriteMessage(websocket.TextMessage, respPayload)
-	if err != nil {
+	if err := conn.write(websocket.TextMessage, respPayload); err != nil {
 		log.Errorf("Cannot write error message to webSocket client: [%s]", err)
 	}
 }
@@ -168,36 +373,64 @@ func (conn *websocketConn) handleProtobufMessage(ctx *Apiv1, req []byte) {
 		HasMore:   false,
 		Payload:   nil,
 	}
-	var endpt *endpoint
-	ctx.internal.funcs.R().In(func(funcs *map[string]*endpoint) er.R {
-		if ep, ok := (*funcs)[webSocketReq.Endpoint]; ok {
-			endpt = ep
+	if !webSocketReq.HasMore && conn.stopStream(webSocketReq.RequestId) {
+		return
+	}
+	if st := conn.getStream(webSocketReq.RequestId); st != nil && webSocketReq.HasMore {
+		if err := st.input(func(m proto.Message) er.R {
+			return er.E(webSocketReq.Payload.UnmarshalTo(m))
+		}); err != nil {
+			resp.HasMore = true
+			resp.Payload = pWsError(err)
+		} else {
+			return
 		}
-		return nil
-	})
-	if endpt == nil {
-		resp.Payload = pWsError(er.Errorf("No such endpoint: [%s]", webSocketReq.Endpoint))
+	} else if endpt, err := conn.getEndpoint(ctx, webSocketReq.Endpoint); err != nil {
+		resp.Payload = pWsError(err)
+	} else if endpt.stream != nil && (endpt.f == nil || webSocketReq.HasMore) {
+		req := endpt.stream.mkReq()
+		if err := er.E(webSocketReq.Payload.UnmarshalTo(req)); err != nil {
+			resp.Payload = pWsError(err)
+		} else if err := conn.beginStream(endpt, webSocketReq.RequestId, req, func(m proto.Message) er.R {
+			resp := rest_pb.WebSocketProtobufResponse{
+				RequestId: webSocketReq.RequestId,
+				HasMore:   m != nil,
+			}
+			if m != nil {
+				if ok, err := pWsOk(m); err != nil {
+					return err
+				} else {
+					resp.Payload = ok
+				}
+			}
+			if respPayload, err := proto.Marshal(&resp); err != nil {
+				return er.E(err)
+			} else {
+				return conn.write(websocket.TextMessage, respPayload)
+			}
+		}); err != nil {
+			resp.Payload = pWsError(err)
+		} else {
+			return
+		}
+	} else if webSocketReq.HasMore {
+		resp.Payload = pWsError(er.Errorf("Endpoint [%s] does not support streaming", webSocketReq.Endpoint))
 	} else {
 		req := endpt.mkReq()
 		if err := er.E(webSocketReq.Payload.UnmarshalTo(req)); err != nil {
 			resp.Payload = pWsError(err)
 		} else if res, err := endpt.f(req); err != nil {
 			resp.Payload = pWsError(err)
-		} else if resBytes, err := er.E1(jsoniter.Marshal(res)); err != nil {
+		} else if ok, err := pWsOk(res); err != nil {
 			resp.Payload = pWsError(err)
 		} else {
-			resp.Payload = &rest_pb.WebSocketProtobufResponse_Ok{
-				Ok: &anypb.Any{
-					TypeUrl: "github.com/pkt-cash/pktd/lnd/" + reflect.TypeOf(res).String()[1:],
-					Value:   resBytes,
-				},
-			}
+			resp.Payload = ok
 		}
 	}
 
 	if respPayload, err := proto.Marshal(&resp); err != nil {
 		log.Errorf("Unable to marshal response to req: [%s]: [%s]", webSocketReq.RequestId, err)
-	} else if err := (*websocket.Conn)(conn).WriteMessage(websocket.TextMessage, respPayload); err != nil {
+	} else if err := conn.write(websocket.TextMessage, respPayload); err != nil {
 		log.Errorf("Cannot write error message to webSocket client: [%s]", err)
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/generated/proto/invoicesrpc_pb"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
 	"github.com/pkt-cash/pktd/lnd/channeldb"
 	"github.com/pkt-cash/pktd/lnd/lnrpc"
 	"github.com/pkt-cash/pktd/lnd/lntypes"
@@ -128,37 +129,84 @@ func (s *Server) SubscribeSingleInvoice(
 func (s *Server) SubscribeSingleInvoice0(req *invoicesrpc_pb.SubscribeSingleInvoiceRequest,
 	updateStream invoicesrpc_pb.Invoices_SubscribeSingleInvoiceServer) er.R {
 
-	hash, err := lntypes.MakeHash(req.RHash)
-	if err != nil {
-		return err
-	}
+	quit := make(chan struct{})
+	defer close(quit)
 
-	invoiceClient, err := s.cfg.InvoiceRegistry.SubscribeSingleInvoice(hash)
+	updates, err := s.SubscribeSingleInvoiceStream(req, quit)
 	if err != nil {
 		return err
 	}
-	defer invoiceClient.Cancel()
 
 	for {
 		select {
-		case newInvoice := <-invoiceClient.Updates:
-			rpcInvoice, err := CreateRPCInvoice(
-				newInvoice, s.cfg.ChainParams,
-			)
-			if err != nil {
-				return err
+		case rpcInvoice, ok := <-updates:
+			if !ok {
+				return nil
 			}
-
 			if err := updateStream.Send(rpcInvoice); err != nil {
 				return er.E(err)
 			}
 
-		case <-s.quit:
+		case <-updateStream.Context().Done():
 			return nil
 		}
 	}
 }
 
+// SubscribeSingleInvoiceStream returns a channel which carries each state
+// change of the specified invoice, beginning with its current state. The
+// channel is closed when quit is closed or when the server shuts down.
+func (s *Server) SubscribeSingleInvoiceStream(
+	req *invoicesrpc_pb.SubscribeSingleInvoiceRequest,
+	quit <-chan struct{}) (<-chan *rpc_pb.Invoice, er.R) {
+
+	hash, err := lntypes.MakeHash(req.RHash)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceClient, err := s.cfg.InvoiceRegistry.SubscribeSingleInvoice(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *rpc_pb.Invoice)
+	go func() {
+		defer close(out)
+		defer invoiceClient.Cancel()
+
+		for {
+			select {
+			case newInvoice := <-invoiceClient.Updates:
+				rpcInvoice, err := CreateRPCInvoice(
+					newInvoice, s.cfg.ChainParams,
+				)
+				if err != nil {
+					log.Errorf("Unable to create rpc invoice "+
+						"%v: %v", hash, err)
+					return
+				}
+
+				select {
+				case out <- rpcInvoice:
+				case <-quit:
+					return
+				case <-s.quit:
+					return
+				}
+
+			case <-quit:
+				return
+
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
 // SettleInvoice settles an accepted invoice. If the invoice is already settled,
 // this call will succeed.
 func (s *Server) SettleInvoice(
@@ -1,6 +1,7 @@
 package routerrpc
 
 import (
+	"io"
 	"sync"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -23,6 +24,46 @@ var (
 	ErrMissingPreimage = Err.CodeWithDetail("ErrMissingPreimage", "missing preimage")
 )
 
+// interceptorStream is the client side of an interceptor session, it is
+// satisfied by the gRPC stream and by chanInterceptorStream.
+type interceptorStream interface {
+	Send(*routerrpc_pb.ForwardHtlcInterceptRequest) error
+	Recv() (*routerrpc_pb.ForwardHtlcInterceptResponse, error)
+}
+
+// chanInterceptorStream is an interceptorStream which is backed by channels,
+// for clients which are not using gRPC.
+type chanInterceptorStream struct {
+	in   <-chan *routerrpc_pb.ForwardHtlcInterceptResponse
+	out  chan<- *routerrpc_pb.ForwardHtlcInterceptRequest
+	quit <-chan struct{}
+
+	// serverQuit is closed when the server is shutting down.
+	serverQuit <-chan struct{}
+}
+
+func (c *chanInterceptorStream) Send(req *routerrpc_pb.ForwardHtlcInterceptRequest) error {
+	select {
+	case c.out <- req:
+		return nil
+	case <-c.quit:
+		return io.EOF
+	case <-c.serverQuit:
+		return io.EOF
+	}
+}
+
+func (c *chanInterceptorStream) Recv() (*routerrpc_pb.ForwardHtlcInterceptResponse, error) {
+	select {
+	case resp := <-c.in:
+		return resp, nil
+	case <-c.quit:
+		return nil, io.EOF
+	case <-c.serverQuit:
+		return nil, io.EOF
+	}
+}
+
 // forwardInterceptor is a helper struct that handles the lifecycle of an rpc
 // interceptor streaming session.
 // It is created when the stream opens and disconnects when the stream closes.
@@ -35,7 +76,7 @@ type forwardInterceptor struct {
 	holdForwards map[channeldb.CircuitKey]htlcswitch.InterceptedForward
 
 	// stream is the bidirectional RPC stream
-	stream routerrpc_pb.Router_HtlcInterceptorServer
+	stream interceptorStream
 
 	// quit is a channel that is closed when this forwardInterceptor is shutting
 	// down.
@@ -49,7 +90,7 @@ type forwardInterceptor struct {
 }
 
 // newForwardInterceptor creates a new forwardInterceptor.
-func newForwardInterceptor(server *Server, stream routerrpc_pb.Router_HtlcInterceptorServer) *forwardInterceptor {
+func newForwardInterceptor(server *Server, stream interceptorStream) *forwardInterceptor {
 	return &forwardInterceptor{
 		server: server,
 		stream: stream,
@@ -129,7 +170,10 @@ func (r *forwardInterceptor) readClientResponses(
 	for {
 		resp, err := r.stream.Recv()
 		if err != nil {
-			errChan <- er.E(err)
+			select {
+			case errChan <- er.E(err):
+			case <-r.quit:
+			}
 			return
 		}
 
@@ -176,6 +220,9 @@ func (r *forwardInterceptor) holdAndForwardToClient(
 func (r *forwardInterceptor) resolveFromClient(
 	in *routerrpc_pb.ForwardHtlcInterceptResponse) er.R {
 
+	if in.IncomingCircuitKey == nil {
+		return er.New("missing incoming_circuit_key")
+	}
 	circuitKey := channeldb.CircuitKey{
 		ChanID: lnwire.NewShortChanIDFromInt(in.IncomingCircuitKey.ChanId),
 		HtlcID: in.IncomingCircuitKey.HtlcId,
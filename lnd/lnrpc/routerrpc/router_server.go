@@ -286,42 +286,24 @@ func (s *Server) TrackPaymentV2(request *routerrpc_pb.TrackPaymentRequest,
 func (s *Server) trackPayment(paymentHash lntypes.Hash,
 	stream routerrpc_pb.Router_TrackPaymentV2Server, noInflightUpdates bool) error {
 
-	router := s.cfg.RouterBackend
+	quit := make(chan struct{})
+	defer close(quit)
 
-	// Subscribe to the outcome of this payment.
-	subscription, err := router.Tower.SubscribePayment(
-		paymentHash,
-	)
+	updates, err := s.streamPayment(paymentHash, noInflightUpdates, quit)
 	switch {
 	case channeldb.ErrPaymentNotInitiated.Is(err):
 		return status.Error(codes.NotFound, err.String())
 	case err != nil:
 		return er.Native(err)
 	}
-	defer subscription.Close()
 
-	// Stream updates back to the client. The first update is always the
-	// current state of the payment.
 	for {
 		select {
-		case item, ok := <-subscription.Updates:
+		case rpcPayment, ok := <-updates:
 			if !ok {
 				// No more payment updates.
 				return nil
 			}
-			result := item.(*channeldb.MPPayment)
-
-			// Skip in-flight updates unless requested.
-			if noInflightUpdates &&
-				result.Status == channeldb.StatusInFlight {
-
-				continue
-			}
-
-			rpcPayment, err := router.MarshallPayment(result)
-			if err != nil {
-				return er.Native(err)
-			}
 
 			// Send event to the client.
 			errr := stream.Send(rpcPayment)
@@ -339,6 +321,110 @@ func (s *Server) trackPayment(paymentHash lntypes.Hash,
 	}
 }
 
+// streamPayment subscribes to the outcome of a payment and returns a channel
+// of payment updates. The first update is always the current state of the
+// payment and the channel is closed once the payment completes, when quit is
+// closed or when the server shuts down.
+func (s *Server) streamPayment(paymentHash lntypes.Hash,
+	noInflightUpdates bool, quit <-chan struct{}) (<-chan *rpc_pb.Payment, er.R) {
+
+	router := s.cfg.RouterBackend
+
+	// Subscribe to the outcome of this payment.
+	subscription, err := router.Tower.SubscribePayment(
+		paymentHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *rpc_pb.Payment)
+	go func() {
+		defer close(out)
+		defer subscription.Close()
+
+		for {
+			select {
+			case item, ok := <-subscription.Updates:
+				if !ok {
+					// No more payment updates.
+					return
+				}
+				result := item.(*channeldb.MPPayment)
+
+				// Skip in-flight updates unless requested.
+				if noInflightUpdates &&
+					result.Status == channeldb.StatusInFlight {
+
+					continue
+				}
+
+				rpcPayment, err := router.MarshallPayment(result)
+				if err != nil {
+					log.Errorf("Unable to marshal payment %v: %v",
+						paymentHash, err)
+					return
+				}
+
+				select {
+				case out <- rpcPayment:
+				case <-quit:
+					return
+				case <-s.quit:
+					return
+				}
+
+			case <-quit:
+				log.Debugf("Payment status stream %v canceled",
+					paymentHash)
+				return
+
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// SendPaymentStream is SendPaymentV2 for clients which are not using gRPC, it
+// begins the payment and returns a channel of updates which is closed once
+// the payment completes or quit is closed. Closing quit does not cancel the
+// payment, it can be tracked again using TrackPaymentStream.
+func (s *Server) SendPaymentStream(req *routerrpc_pb.SendPaymentRequest,
+	quit <-chan struct{}) (<-chan *rpc_pb.Payment, er.R) {
+
+	payment, err := s.cfg.RouterBackend.extractIntentFromSendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cfg.Router.SendPaymentAsync(payment); err != nil {
+		log.Debugf("SendPayment async error for hash %x: %v",
+			payment.PaymentHash, err)
+		return nil, err
+	}
+
+	return s.streamPayment(payment.PaymentHash, req.NoInflightUpdates, quit)
+}
+
+// TrackPaymentStream is TrackPaymentV2 for clients which are not using gRPC,
+// it returns a channel of updates for the payment, the first update is the
+// current state of the payment.
+func (s *Server) TrackPaymentStream(req *routerrpc_pb.TrackPaymentRequest,
+	quit <-chan struct{}) (<-chan *rpc_pb.Payment, er.R) {
+
+	paymentHash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("TrackPayment called for payment %v", paymentHash)
+
+	return s.streamPayment(paymentHash, req.NoInflightUpdates, quit)
+}
+
 // BuildRoute builds a route from a list of hop addresses.
 func (s *Server) BuildRoute(ctx context.Context,
 	req *routerrpc_pb.BuildRouteRequest) (*routerrpc_pb.BuildRouteResponse, er.R) {
@@ -390,18 +476,19 @@ func (s *Server) BuildRoute(ctx context.Context,
 func (s *Server) SubscribeHtlcEvents(req *routerrpc_pb.SubscribeHtlcEventsRequest,
 	stream routerrpc_pb.Router_SubscribeHtlcEventsServer) error {
 
-	htlcClient, err := s.cfg.RouterBackend.SubscribeHtlcEvents()
+	quit := make(chan struct{})
+	defer close(quit)
+
+	events, err := s.SubscribeHtlcEventsStream(req, quit)
 	if err != nil {
 		return er.Native(err)
 	}
-	defer htlcClient.Cancel()
 
 	for {
 		select {
-		case event := <-htlcClient.Updates():
-			rpcEvent, err := rpcHtlcEvent(event)
-			if err != nil {
-				return er.Native(err)
+		case rpcEvent, ok := <-events:
+			if !ok {
+				return er.Native(er.New("htlc event subscription terminated"))
 			}
 
 			if err := stream.Send(rpcEvent); err != nil {
@@ -413,10 +500,6 @@ func (s *Server) SubscribeHtlcEvents(req *routerrpc_pb.SubscribeHtlcEventsReques
 			log.Debugf("htlc event stream cancelled")
 			return stream.Context().Err()
 
-		// If the subscribe client terminates, exit with an error.
-		case <-htlcClient.Quit():
-			return er.Native(er.New("htlc event subscription terminated"))
-
 		// If the server has been signalled to shut down, exit.
 		case <-s.quit:
 			return er.Native(errServerShuttingDown.Default())
@@ -424,6 +507,55 @@ func (s *Server) SubscribeHtlcEvents(req *routerrpc_pb.SubscribeHtlcEventsReques
 	}
 }
 
+// SubscribeHtlcEventsStream returns a channel of HTLC events (forwards,
+// failures, settles and link failures). The channel is closed when quit is
+// closed, when the subscription terminates or when the server shuts down.
+func (s *Server) SubscribeHtlcEventsStream(_ *routerrpc_pb.SubscribeHtlcEventsRequest,
+	quit <-chan struct{}) (<-chan *routerrpc_pb.HtlcEvent, er.R) {
+
+	htlcClient, err := s.cfg.RouterBackend.SubscribeHtlcEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *routerrpc_pb.HtlcEvent)
+	go func() {
+		defer close(out)
+		defer htlcClient.Cancel()
+
+		for {
+			select {
+			case event := <-htlcClient.Updates():
+				rpcEvent, err := rpcHtlcEvent(event)
+				if err != nil {
+					log.Errorf("Unable to convert htlc event: %v", err)
+					return
+				}
+
+				select {
+				case out <- rpcEvent:
+				case <-quit:
+					return
+				case <-s.quit:
+					return
+				}
+
+			case <-quit:
+				return
+
+			// If the subscribe client terminates, end the stream.
+			case <-htlcClient.Quit():
+				return
+
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
 // HtlcInterceptor is a bidirectional stream for streaming interception
 // requests to the caller.
 // Upon connection it does the following:
@@ -442,3 +574,35 @@ func (s *Server) HtlcInterceptor(stream routerrpc_pb.Router_HtlcInterceptorServe
 	// run the forward interceptor.
 	return er.Native(newForwardInterceptor(s, stream).run())
 }
+
+// HtlcInterceptorStream is HtlcInterceptor for clients which are not using
+// gRPC. Each forwarded HTLC is held and sent on the returned channel until it
+// is resolved by a message on in. When quit is closed, the interceptor is
+// removed and all HTLCs which are still held are resumed.
+func (s *Server) HtlcInterceptorStream(
+	in <-chan *routerrpc_pb.ForwardHtlcInterceptResponse,
+	quit <-chan struct{}) (<-chan *routerrpc_pb.ForwardHtlcInterceptRequest, er.R) {
+
+	// We ensure there is only one interceptor at a time.
+	if !atomic.CompareAndSwapInt32(&s.forwardInterceptorActive, 0, 1) {
+		return nil, ErrInterceptorAlreadyExists.Default()
+	}
+
+	out := make(chan *routerrpc_pb.ForwardHtlcInterceptRequest)
+	go func() {
+		defer close(out)
+		defer atomic.CompareAndSwapInt32(&s.forwardInterceptorActive, 1, 0)
+
+		err := newForwardInterceptor(s, &chanInterceptorStream{
+			in:         in,
+			out:        out,
+			quit:       quit,
+			serverQuit: s.quit,
+		}).run()
+		if err != nil && !er.EOF.Is(err) {
+			log.Warnf("HTLC interceptor ended: %v", err)
+		}
+	}()
+
+	return out, nil
+}
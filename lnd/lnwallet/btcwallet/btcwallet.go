@@ -17,6 +17,7 @@ import (
 	"github.com/pkt-cash/pktd/lnd/lnwallet"
 	"github.com/pkt-cash/pktd/lnd/lnwallet/chainfee"
 	"github.com/pkt-cash/pktd/neutrino"
+	"github.com/pkt-cash/pktd/pktlog/log"
 	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
 	"github.com/pkt-cash/pktd/pktwallet/wallet"
 	base "github.com/pkt-cash/pktd/pktwallet/wallet"
@@ -34,6 +35,10 @@ const (
 	// UnconfirmedHeight is the special case end height that is used to
 	// obtain unconfirmed transactions from ListTransactionDetails.
 	UnconfirmedHeight int32 = -1
+
+	// lndLockName is the name of the locks which lnd places on outpoints
+	// while funding.
+	lndLockName = "locked-by-lnd"
 )
 
 var (
@@ -140,6 +145,14 @@ func (b *BtcWallet) Start() er.R {
 		return err
 	}
 
+	// The wallet keeps locked outpoints across restarts but lnd locks
+	// outpoints only while it is funding, so the locks which it left
+	// before the restart are released.
+	lockName := lndLockName
+	if err := b.wallet.ResetLockedOutpoints(&lockName); err != nil {
+		return err
+	}
+
 	// Start the underlying btcwallet core.
 	b.wallet.Start()
 
@@ -339,7 +352,9 @@ func (b *BtcWallet) CreateSimpleTx(outputs []*wire.TxOut,
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) LockOutpoint(o wire.OutPoint) {
-	b.wallet.LockOutpoint(o, "locked-by-lnd")
+	if err := b.wallet.LockOutpoint(o, lndLockName); err != nil {
+		log.Warnf("Unable to lock outpoint [%s]: [%s]", o, err)
+	}
 }
 
 // UnlockOutpoint unlocks a previously locked output, marking it eligible for
@@ -349,7 +364,9 @@ func (b *BtcWallet) LockOutpoint(o wire.OutPoint) {
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) UnlockOutpoint(o wire.OutPoint) {
-	b.wallet.UnlockOutpoint(o)
+	if err := b.wallet.UnlockOutpoint(o); err != nil {
+		log.Warnf("Unable to unlock outpoint [%s]: [%s]", o, err)
+	}
 }
 
 // LeaseOutput locks an output to the given ID, preventing it from being
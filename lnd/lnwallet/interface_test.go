@@ -36,7 +36,6 @@ import (
 	"github.com/pkt-cash/pktd/lnd/lnwallet/chainfee"
 	"github.com/pkt-cash/pktd/lnd/lnwallet/chanfunding"
 	"github.com/pkt-cash/pktd/lnd/lnwire"
-	"github.com/pkt-cash/pktd/mempool"
 	"github.com/pkt-cash/pktd/neutrino"
 	"github.com/pkt-cash/pktd/pktlog/log"
 	"github.com/pkt-cash/pktd/pktwallet/chain"
@@ -1672,7 +1671,7 @@ func txFromOutput(tx *wire.MsgTx, signer input.Signer, fromPubKey,
 	// Otherwise we just use the standard max sequence.
 	sequence := constants.MaxTxInSequenceNum
 	if rbf {
-		sequence = mempool.MaxRBFSequence
+		sequence = constants.MaxRBFSequence
 	}
 
 	tx1.AddTxIn(&wire.TxIn{
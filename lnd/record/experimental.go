@@ -3,4 +3,8 @@ package record
 const (
 	// KeySendType is the custom record identifier for keysend preimages.
 	KeySendType uint64 = 5482373484
+
+	// KeySendMessageType is the custom record identifier for a text message
+	// which is sent along with a keysend payment.
+	KeySendMessageType uint64 = 34349334
 )
@@ -10,12 +10,14 @@ import (
 	"context"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/invoicesrpc_pb"
 	"github.com/pkt-cash/pktd/generated/proto/restrpc_pb/help_pb"
 	"github.com/pkt-cash/pktd/generated/proto/routerrpc_pb"
 	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
 	"github.com/pkt-cash/pktd/generated/proto/wtclientrpc_pb"
 	"github.com/pkt-cash/pktd/lnd/lnrpc"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/apiv1"
+	"github.com/pkt-cash/pktd/lnd/lnrpc/invoicesrpc"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/routerrpc"
 	"github.com/pkt-cash/pktd/lnd/lnrpc/wtclientrpc"
 	"google.golang.org/protobuf/proto"
@@ -372,6 +374,79 @@ func (c *RpcContext) RegisterFunctions(a *apiv1.Apiv1) {
 			return cc.DecodePayReq(context.TODO(), req)
 		}),
 	)
+	apiv1.Endpoint(
+		lightningInvoice,
+		"addhold",
+		`
+		Add a new hold invoice
+
+		AddHoldInvoice creates a hold invoice. It ties the invoice to the hash
+		supplied in the request. When the invoice is paid, the payment is held
+		(the invoice is in the ACCEPTED state) until it is either settled with
+		lightning/invoice/settle, using the preimage of the hash, or canceled with
+		lightning/invoice/cancel.
+		`,
+		withInvoices(c, func(is *invoicesrpc.Server, req *invoicesrpc_pb.AddHoldInvoiceRequest) (*invoicesrpc_pb.AddHoldInvoiceResp, er.R) {
+			return is.AddHoldInvoice0(context.TODO(), req)
+		}),
+	)
+	apiv1.Endpoint(
+		lightningInvoice,
+		"settle",
+		`
+		Settle an accepted hold invoice
+
+		SettleInvoice settles an accepted invoice using the preimage of its hash.
+		If the invoice is already settled, this call will succeed.
+		`,
+		withInvoices(c, func(is *invoicesrpc.Server, req *invoicesrpc_pb.SettleInvoiceMsg) (*invoicesrpc_pb.SettleInvoiceResp, er.R) {
+			return is.SettleInvoice0(context.TODO(), req)
+		}),
+	)
+	apiv1.Endpoint(
+		lightningInvoice,
+		"cancel",
+		`
+		Cancel an invoice
+
+		CancelInvoice cancels a currently open invoice. If the invoice is already
+		canceled, this call will succeed. If the invoice is already settled, it will
+		fail. If a hold invoice has been accepted, canceling it returns the payment
+		to the payer.
+		`,
+		withInvoices(c, func(is *invoicesrpc.Server, req *invoicesrpc_pb.CancelInvoiceMsg) (*invoicesrpc_pb.CancelInvoiceResp, er.R) {
+			return is.CancelInvoice0(context.TODO(), req)
+		}),
+	)
+	apiv1.StreamFunc(
+		lightningInvoice,
+		"subscribe",
+		`
+		Follow the state of an invoice
+
+		SubscribeSingleInvoice streams the state of the invoice identified by its
+		payment hash, the first message is the current state of the invoice and
+		each change of state (for example OPEN, ACCEPTED, SETTLED) is sent after.
+		`,
+		withInvoicesStream(c, func(is *invoicesrpc.Server, req *invoicesrpc_pb.SubscribeSingleInvoiceRequest, quit <-chan struct{}) (<-chan *rpc_pb.Invoice, er.R) {
+			return is.SubscribeSingleInvoiceStream(req, quit)
+		}),
+	)
+	apiv1.StreamFunc(
+		lightningInvoice,
+		"subscribeall",
+		`
+		Follow all invoices as they are added and settled
+
+		SubscribeInvoices streams each invoice as it is added or settled. If
+		add_index or settle_index are specified, invoices which were added or
+		settled after those indexes are sent first, so a client which remembers the
+		last indexes it has seen can reconnect without missing any updates.
+		`,
+		withRpcStream(c, func(rs *LightningRPCServer, req *rpc_pb.InvoiceSubscription, quit <-chan struct{}) (<-chan *rpc_pb.Invoice, er.R) {
+			return rs.SubscribeInvoicesStream(req, quit)
+		}),
+	)
 
 	//	>>> lightning/payment subCategory command
 	lightningPayment := apiv1.DefineCategory(lightning, "payment",
@@ -386,22 +461,23 @@ func (c *RpcContext) RegisterFunctions(a *apiv1.Apiv1) {
 			return cc.SendPaymentSync(context.TODO(), req)
 		}),
 	)
-	// TODO(cjd): Streaming
-	// apiv1.Register(
-	// 	a,
-	// 	"/lightning/payment/payinvoice",
-	// 	`
-	// 	Send a payment over lightning
+	apiv1.StreamFunc(
+		lightningPayment,
+		"payinvoice",
+		`
+		Send a payment over lightning
 
-	// 	SendPaymentV2 attempts to route a payment described by the passed
-	// 	PaymentRequest to the final destination. The call returns a stream of
-	// 	payment updates.
-	// 	`,
-	// 	false,
-	// 	withRouter(c, func(rs *routerrpc.Server, req *routerrpc_pb.SendPaymentRequest) (*rpc_pb.Null, er.R) {
-	// 		return rs.SendPaymentV2(context.TODO(), req)
-	// 	}),
-	// )
+		SendPaymentV2 attempts to route a payment described by the passed
+		PaymentRequest to the final destination. The call returns a stream of
+		payment updates, each one contains the state of the payment and all of
+		the HTLC attempts which have been made, including each shard of a
+		multi-path payment. The payment continues if the stream is closed, use
+		lightning/payment/track to resume following it.
+		`,
+		withRouterStream(c, func(rs *routerrpc.Server, req *routerrpc_pb.SendPaymentRequest, quit <-chan struct{}) (<-chan *rpc_pb.Payment, er.R) {
+			return rs.SendPaymentStream(req, quit)
+		}),
+	)
 	apiv1.Endpoint(
 		lightningPayment,
 		"sendtoroute",
@@ -429,21 +505,20 @@ func (c *RpcContext) RegisterFunctions(a *apiv1.Apiv1) {
 			return cc.ListPayments(context.TODO(), req)
 		}),
 	)
-	// TODO(cjd): Streaming only
-	// apiv1.Register(
-	// 	a,
-	// 	"/lightning/payment/track",
-	// 	`
-	// 	Track payment
+	apiv1.StreamFunc(
+		lightningPayment,
+		"track",
+		`
+		Track payment
 
-	// 	TrackPaymentV2 returns an update stream for the payment identified by the
-	// 	payment hash.
-	// 	`,
-	// 	false,
-	// 	withRouter(c, func(rs *routerrpc.Server, req *routerrpc_pb.TrackPaymentRequest) (*rpc_pb.HTLCAttempt, er.R) {
-	// 		return rs.TrackPaymentV2(context.TODO(), req)
-	// 	}),
-	// )
+		TrackPaymentV2 returns an update stream for the payment identified by the
+		payment hash. The first update is the current state of the payment, so
+		this can be used to resume following a payment after reconnecting.
+		`,
+		withRouterStream(c, func(rs *routerrpc.Server, req *routerrpc_pb.TrackPaymentRequest, quit <-chan struct{}) (<-chan *rpc_pb.Payment, er.R) {
+			return rs.TrackPaymentStream(req, quit)
+		}),
+	)
 	apiv1.Endpoint(
 		lightningPayment,
 		"queryroutes",
@@ -532,6 +607,36 @@ func (c *RpcContext) RegisterFunctions(a *apiv1.Apiv1) {
 		}),
 	)
 
+	apiv1.StreamFunc(
+		lightningPayment,
+		"htlcevents",
+		`
+		Subscribe to HTLC events
+
+		SubscribeHtlcEvents streams the forwards, settles, failures and link
+		failures of HTLCs as they are processed by the switch.
+		`,
+		withRouterStream(c, func(rs *routerrpc.Server, req *routerrpc_pb.SubscribeHtlcEventsRequest, quit <-chan struct{}) (<-chan *routerrpc_pb.HtlcEvent, er.R) {
+			return rs.SubscribeHtlcEventsStream(req, quit)
+		}),
+	)
+	apiv1.BidiStream(
+		lightningPayment,
+		"htlcinterceptor",
+		`
+		Intercept forwarded HTLCs
+
+		HtlcInterceptor sends an intercept request for every HTLC which is
+		forwarded through this node, the client must answer each one with a
+		resolution (settle, fail or resume) sent on the same request_id. Only
+		one interceptor may be active at a time, when it disconnects all held
+		HTLCs are resumed.
+		`,
+		withRouterBidi(c, func(rs *routerrpc.Server, _ *rpc_pb.Null, in <-chan *routerrpc_pb.ForwardHtlcInterceptResponse, quit <-chan struct{}) (<-chan *routerrpc_pb.ForwardHtlcInterceptRequest, er.R) {
+			return rs.HtlcInterceptorStream(in, quit)
+		}),
+	)
+
 	//	>>> lightning/peer subCategory command
 
 	lightningPeer := apiv1.DefineCategory(lightning, "peer", "Lightning nodes to which we are directly connected")
@@ -673,6 +778,7 @@ type RpcContext struct {
 	MaybeRpcServer        *LightningRPCServer
 	MaybeMetaService      *lnrpc.MetaService
 	MaybeRouterServer     *routerrpc.Server
+	MaybeInvoicesServer   *invoicesrpc.Server
 	MaybeWatchTowerClient *wtclientrpc.WatchtowerClient
 }
 
@@ -712,6 +818,62 @@ func withRouter[Q, R proto.Message](
 		return f(c.MaybeRouterServer, q)
 	}
 }
+func withRouterStream[Q, R proto.Message](
+	c *RpcContext,
+	f func(*routerrpc.Server, Q, <-chan struct{}) (<-chan R, er.R),
+) func(Q, <-chan struct{}) (<-chan R, er.R) {
+	return func(q Q, quit <-chan struct{}) (<-chan R, er.R) {
+		if c.MaybeRouterServer == nil {
+			return nil, er.Errorf("Could not call function because RouterServer is not yet ready")
+		}
+		return f(c.MaybeRouterServer, q, quit)
+	}
+}
+func withRouterBidi[Q, I, R proto.Message](
+	c *RpcContext,
+	f func(*routerrpc.Server, Q, <-chan I, <-chan struct{}) (<-chan R, er.R),
+) func(Q, <-chan I, <-chan struct{}) (<-chan R, er.R) {
+	return func(q Q, in <-chan I, quit <-chan struct{}) (<-chan R, er.R) {
+		if c.MaybeRouterServer == nil {
+			return nil, er.Errorf("Could not call function because RouterServer is not yet ready")
+		}
+		return f(c.MaybeRouterServer, q, in, quit)
+	}
+}
+func withRpcStream[Q, R proto.Message](
+	c *RpcContext,
+	f func(*LightningRPCServer, Q, <-chan struct{}) (<-chan R, er.R),
+) func(Q, <-chan struct{}) (<-chan R, er.R) {
+	return func(q Q, quit <-chan struct{}) (<-chan R, er.R) {
+		if c.MaybeRpcServer == nil {
+			return nil, er.Errorf("Could not call function because LightningRPCServer is not yet ready")
+		}
+		return f(c.MaybeRpcServer, q, quit)
+	}
+}
+func withInvoices[Q, R proto.Message](
+	c *RpcContext,
+	f func(*invoicesrpc.Server, Q) (R, er.R),
+) func(Q) (R, er.R) {
+	return func(q Q) (R, er.R) {
+		if c.MaybeInvoicesServer == nil {
+			var none R
+			return none, er.Errorf("Could not call function because InvoicesServer is not yet ready")
+		}
+		return f(c.MaybeInvoicesServer, q)
+	}
+}
+func withInvoicesStream[Q, R proto.Message](
+	c *RpcContext,
+	f func(*invoicesrpc.Server, Q, <-chan struct{}) (<-chan R, er.R),
+) func(Q, <-chan struct{}) (<-chan R, er.R) {
+	return func(q Q, quit <-chan struct{}) (<-chan R, er.R) {
+		if c.MaybeInvoicesServer == nil {
+			return nil, er.Errorf("Could not call function because InvoicesServer is not yet ready")
+		}
+		return f(c.MaybeInvoicesServer, q, quit)
+	}
+}
 func withWtclient[Q, R proto.Message](
 	c *RpcContext,
 	f func(*wtclientrpc.WatchtowerClient, Q) (R, er.R),
@@ -3938,44 +3938,80 @@ func (r *LightningRPCServer) ListInvoices(ctx context.Context,
 func (r *LightningRPCServer) SubscribeInvoices(req *rpc_pb.InvoiceSubscription,
 	updateStream rpc_pb.Lightning_SubscribeInvoicesServer) error {
 
-	invoiceClient, err := r.server.invoices.SubscribeNotifications(
-		req.AddIndex, req.SettleIndex,
-	)
+	quit := make(chan struct{})
+	defer close(quit)
+
+	updates, err := r.SubscribeInvoicesStream(req, quit)
 	if err != nil {
 		return er.Native(err)
 	}
-	defer invoiceClient.Cancel()
 
 	for {
 		select {
-		case newInvoice := <-invoiceClient.NewInvoices:
-			rpcInvoice, err := invoicesrpc.CreateRPCInvoice(
-				newInvoice, r.cfg.ActiveNetParams.Params,
-			)
-			if err != nil {
-				return er.Native(err)
+		case rpcInvoice, ok := <-updates:
+			if !ok {
+				return nil
 			}
-
 			if err := updateStream.Send(rpcInvoice); err != nil {
 				return er.Native(er.E(err))
 			}
 
-		case settledInvoice := <-invoiceClient.SettledInvoices:
+		case <-updateStream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// SubscribeInvoicesStream returns a channel which carries each invoice as it
+// is added or settled. Invoices which were added or settled after the
+// add_index or settle_index in the request, but before the call, are sent
+// first. The channel is closed when quit is closed or when the server shuts
+// down.
+func (r *LightningRPCServer) SubscribeInvoicesStream(req *rpc_pb.InvoiceSubscription,
+	quit <-chan struct{}) (<-chan *rpc_pb.Invoice, er.R) {
+
+	invoiceClient, err := r.server.invoices.SubscribeNotifications(
+		req.AddIndex, req.SettleIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *rpc_pb.Invoice)
+	go func() {
+		defer close(out)
+		defer invoiceClient.Cancel()
+
+		for {
+			var invoice *channeldb.Invoice
+			select {
+			case invoice = <-invoiceClient.NewInvoices:
+			case invoice = <-invoiceClient.SettledInvoices:
+			case <-quit:
+				return
+			case <-r.quit:
+				return
+			}
+
 			rpcInvoice, err := invoicesrpc.CreateRPCInvoice(
-				settledInvoice, r.cfg.ActiveNetParams.Params,
+				invoice, r.cfg.ActiveNetParams.Params,
 			)
 			if err != nil {
-				return er.Native(err)
+				log.Errorf("Unable to create rpc invoice: %v", err)
+				return
 			}
 
-			if err := updateStream.Send(rpcInvoice); err != nil {
-				return er.Native(er.E(err))
+			select {
+			case out <- rpcInvoice:
+			case <-quit:
+				return
+			case <-r.quit:
+				return
 			}
-
-		case <-r.quit:
-			return nil
 		}
-	}
+	}()
+
+	return out, nil
 }
 
 /* TODO(cjd): This should be implemented where the data actually exists rather than
@@ -419,7 +419,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FinalCltvRejectDelta:        lncfg.DefaultFinalCltvRejectDelta,
 		HtlcHoldDuration:            invoices.DefaultHtlcHoldDuration,
 		Clock:                       clock.NewDefaultClock(),
-		AcceptKeySend:               cfg.AcceptKeySend,
+		AcceptKeySend:               cfg.acceptKeySend(),
 		GcCanceledInvoicesOnStartup: cfg.GcCanceledInvoicesOnStartup,
 		GcCanceledInvoicesOnTheFly:  cfg.GcCanceledInvoicesOnTheFly,
 		KeysendHoldTime:             cfg.KeysendHoldTime,
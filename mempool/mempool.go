@@ -25,6 +25,7 @@ import (
 	"github.com/pkt-cash/pktd/mining"
 	"github.com/pkt-cash/pktd/txscript"
 	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
 )
 
 const (
@@ -43,11 +44,6 @@ const (
 	// scans of the orphan pool to evict expired transactions.
 	orphanExpireScanInterval = time.Minute * 5
 
-	// MaxRBFSequence is the maximum sequence number an input can use to
-	// signal that the transaction spending it can be replaced using the
-	// Replace-By-Fee (RBF) policy.
-	MaxRBFSequence = 0xfffffffd
-
 	// MaxReplacementEvictions is the maximum number of transactions that
 	// can be evicted from the mempool when accepting a transaction
 	// replacement.
@@ -628,7 +624,7 @@ func (mp *TxPool) signalsReplacement(tx *btcutil.Tx,
 	}
 
 	for _, txIn := range tx.MsgTx().TxIn {
-		if txIn.Sequence <= MaxRBFSequence {
+		if txIn.Sequence <= constants.MaxRBFSequence {
 			return true
 		}
 
@@ -209,7 +209,7 @@ func (p *poolHarness) CreateSignedTx(inputs []spendableOutput,
 	tx := wire.NewMsgTx(constants.TxVersion)
 	sequence := constants.MaxTxInSequenceNum
 	if signalsReplacement {
-		sequence = MaxRBFSequence
+		sequence = constants.MaxRBFSequence
 	}
 	for _, input := range inputs {
 		tx.AddTxIn(&wire.TxIn{
@@ -835,7 +835,7 @@ func help(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 // listLockUnspent handles a listlockunspent request by returning an slice of
 // all locked outpoints.
 func listLockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
-	return w.LockedOutpoints(), nil
+	return w.LockedOutpoints(nil), nil
 }
 
 // listReceivedByAddress handles a listreceivedbyaddress request by returning
@@ -1054,7 +1054,9 @@ func lockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 
 	switch {
 	case cmd.Unlock && len(cmd.Transactions) == 0:
-		w.ResetLockedOutpoints(cmd.LockName)
+		if err := w.ResetLockedOutpoints(cmd.LockName); err != nil {
+			return nil, err
+		}
 	default:
 		for _, input := range cmd.Transactions {
 			txHash, err := chainhash.NewHashFromStr(input.Txid)
@@ -1063,9 +1065,12 @@ func lockUnspent(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 			}
 			op := wire.OutPoint{Hash: *txHash, Index: input.Vout}
 			if cmd.Unlock {
-				w.UnlockOutpoint(op)
+				err = w.UnlockOutpoint(op)
 			} else {
-				w.LockOutpoint(op, lockName)
+				err = w.LockOutpoint(op, lockName)
+			}
+			if err != nil {
+				return nil, err
 			}
 		}
 	}
@@ -1289,7 +1294,9 @@ func createTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	if cmd.AutoLock != nil {
 		for _, in := range tx.Tx.TxIn {
 			op := in.PreviousOutPoint
-			w.LockOutpoint(op, *cmd.AutoLock)
+			if err := w.LockOutpoint(op, *cmd.AutoLock); err != nil {
+				return nil, err
+			}
 		}
 	}
 
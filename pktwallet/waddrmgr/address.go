@@ -296,6 +296,11 @@ func (a *managedAddress) PrivKey() (*btcec.PrivateKey, er.R) {
 		return nil, ErrLocked.Default()
 	}
 
+	// Addresses which belong to watch-only accounts have no private key.
+	if len(a.privKeyEncrypted) == 0 && len(a.privKeyCT) == 0 {
+		return nil, ErrWatchingOnly.Default()
+	}
+
 	// Decrypt the key as needed.  Also, make sure it's a copy since the
 	// private key stored in memory can be cleared at any time.  Otherwise
 	// the returned private key could be invalidated from under the caller.
@@ -279,6 +279,9 @@ var (
 
 	// bucket containing dbNetworkStewardVote
 	networkStewardVoteName = []byte("nsvote")
+
+	// bucket containing the gap limit of each account which has one
+	accountGapLimitName = []byte("gaplimit")
 )
 
 // uint32ToBytes converts a 32 bit unsigned integer into a 4-byte slice in
@@ -1050,6 +1053,48 @@ func deleteAccountNetworkStewardVote(ns walletdb.ReadWriteBucket, scope *KeyScop
 	return bucket.Delete(uint32ToBytes(account))
 }
 
+// fetchAccountGapLimit returns the number of unused addresses which are kept
+// after the last used address of the account, zero if the account has none.
+func fetchAccountGapLimit(ns walletdb.ReadBucket, scope *KeyScope,
+	account uint32) (uint32, er.R) {
+
+	scopedBucket, err := fetchReadScopeBucket(ns, scope)
+	if err != nil {
+		return 0, err
+	}
+	bucket := scopedBucket.NestedReadBucket(accountGapLimitName)
+	if bucket == nil {
+		return 0, nil
+	}
+	v := bucket.Get(uint32ToBytes(account))
+	if v == nil {
+		return 0, nil
+	}
+	if len(v) != 4 {
+		str := fmt.Sprintf("malformed gap limit for account %d", account)
+		return 0, managerError(ErrDatabase, str, nil)
+	}
+	return binary.LittleEndian.Uint32(v), nil
+}
+
+func putAccountGapLimit(ns walletdb.ReadWriteBucket, scope *KeyScope,
+	account uint32, gapLimit uint32) er.R {
+
+	scopedBucket, err := fetchWriteScopeBucket(ns, scope)
+	if err != nil {
+		return err
+	}
+	bucket := scopedBucket.NestedReadWriteBucket(accountGapLimitName)
+	if bucket == nil {
+		bucket, err = scopedBucket.CreateBucket(accountGapLimitName)
+		if err != nil {
+			str := "failed to create the gap limit bucket"
+			return managerError(ErrDatabase, str, err)
+		}
+	}
+	return bucket.Put(uint32ToBytes(account), uint32ToBytes(gapLimit))
+}
+
 // deleteAccountNameIndex deletes the given key from the account name index of the database.
 func deleteAccountNameIndex(ns walletdb.ReadWriteBucket, scope *KeyScope,
 	name string) er.R {
@@ -0,0 +1,55 @@
+package waddrmgr
+
+import (
+	"fmt"
+
+	"github.com/pkt-cash/pktd/btcutil/base58"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
+	"github.com/pkt-cash/pktd/chaincfg"
+)
+
+// extPubKeyPurposes maps the SLIP-0132 version bytes of extended public keys
+// to the purpose of the key scope which they are used with.  The xpub and tpub
+// versions are used by many wallets for every address type so they do not
+// imply any particular purpose.
+var extPubKeyPurposes = map[[4]byte]uint32{
+	{0x04, 0x88, 0xb2, 0x1e}: 0,  // xpub
+	{0x04, 0x9d, 0x7c, 0xb2}: 49, // ypub
+	{0x04, 0xb2, 0x47, 0x46}: 84, // zpub
+	{0x04, 0x35, 0x87, 0xcf}: 0,  // tpub
+	{0x04, 0x4a, 0x52, 0x62}: 49, // upub
+	{0x04, 0x5f, 0x1c, 0xf6}: 84, // vpub
+}
+
+// ParseExtendedPubKey parses a base58 extended public key which may use either
+// the version bytes of the network or those of an xpub, ypub or zpub (or the
+// testnet equivalents).  It returns the key with the version bytes of the
+// network along with the key scope purpose implied by the version bytes, or
+// zero if the version bytes do not imply any purpose.
+func ParseExtendedPubKey(key string,
+	net *chaincfg.Params) (*hdkeychain.ExtendedKey, uint32, er.R) {
+
+	extKey, err := hdkeychain.NewKeyFromString(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if extKey.IsPrivate() {
+		str := "expected an extended public key, not a private key"
+		return nil, 0, managerError(ErrInvalidAccount, str, nil)
+	}
+
+	// NewKeyFromString has already validated the length and checksum.
+	var version [4]byte
+	copy(version[:], base58.Decode(key)[:4])
+
+	purpose, ok := extPubKeyPurposes[version]
+	if !ok && version != net.HDPublicKeyID {
+		str := fmt.Sprintf("unknown extended public key version %x",
+			version)
+		return nil, 0, managerError(ErrWrongNet, str, nil)
+	}
+	extKey.SetNet(net)
+
+	return extKey, purpose, nil
+}
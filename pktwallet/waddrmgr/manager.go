@@ -165,6 +165,13 @@ type accountInfo struct {
 	lastInternalAddr  ManagedAddress
 }
 
+// watchOnly returns true if there is no private key for the account, either
+// because it was created from an extended public key or because the address
+// manager has been converted to watching-only.
+func (a *accountInfo) watchOnly() bool {
+	return len(a.acctKeyEncrypted) == 0
+}
+
 // AccountProperties contains properties associated with each account, such as
 // the account name, number, and the nubmer of derived and imported keys.
 type AccountProperties struct {
@@ -173,6 +180,8 @@ type AccountProperties struct {
 	ExternalKeyCount uint32
 	InternalKeyCount uint32
 	ImportedKeyCount uint32
+	KeyScope         KeyScope
+	WatchOnly        bool
 }
 
 // unlockDeriveInfo houses the information needed to derive a private key for a
@@ -706,6 +715,57 @@ func (m *Manager) ForEachAccountAddress(ns walletdb.ReadBucket, account uint32,
 	return nil
 }
 
+// ExtendGap derives addresses after a used address of an account which has a
+// gap limit, see ScopedKeyManager.ExtendGap.  The addresses which have been
+// derived are returned.
+func (m *Manager) ExtendGap(ns walletdb.ReadWriteBucket,
+	address btcutil.Address) ([]ManagedAddress, er.R) {
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, scopedMgr := range m.scopedManagers {
+		if _, err := scopedMgr.Address(ns, address); err != nil {
+			continue
+		}
+		return scopedMgr.ExtendGap(ns, address)
+	}
+	return nil, nil
+}
+
+// ForEachWatchOnlyAddress calls the given function with each address which
+// belongs to a watch-only account, breaking early on error.
+func (m *Manager) ForEachWatchOnlyAddress(ns walletdb.ReadBucket,
+	fn func(maddr ManagedAddress) er.R) er.R {
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, scopedMgr := range m.scopedManagers {
+		var accounts []uint32
+		err := scopedMgr.ForEachAccount(ns, func(account uint32) er.R {
+			watchOnly, err := scopedMgr.IsWatchOnlyAccount(ns, account)
+			if err != nil {
+				return err
+			}
+			if watchOnly {
+				accounts = append(accounts, account)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			if err := scopedMgr.ForEachAccountAddress(ns, account, fn); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (m *Manager) Seed() *seedwords.SeedEnc {
 	return m.xseed
 }
@@ -1081,6 +1141,9 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) er.R {
 	// extended keys.
 	for _, manager := range m.scopedManagers {
 		for account, acctInfo := range manager.acctInfo {
+			if acctInfo.watchOnly() {
+				continue
+			}
 			decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
 			if err != nil {
 				m.lock()
@@ -1103,6 +1166,15 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) er.R {
 		// We'll also derive any private keys that are pending due to
 		// them being created while the address manager was locked.
 		for _, info := range manager.deriveOnUnlock {
+			// Addresses of watch-only accounts have no private key
+			// to derive.
+			acct := info.managedAddr.Account()
+			if ai, ok := manager.acctInfo[acct]; ok && ai.watchOnly() {
+				manager.deriveOnUnlock[0] = nil
+				manager.deriveOnUnlock = manager.deriveOnUnlock[1:]
+				continue
+			}
+
 			addressKey, err := manager.deriveKeyFromPath(
 				ns, info.managedAddr.Account(), info.branch,
 				info.index, true,
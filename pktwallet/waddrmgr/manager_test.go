@@ -15,7 +15,9 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/base58"
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
 	"github.com/pkt-cash/pktd/btcutil/util"
 	"github.com/pkt-cash/pktd/chaincfg"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
@@ -2454,3 +2456,147 @@ func TestNewRawAccount(t *testing.T) {
 			accountTargetAddr.AddrHash())
 	}
 }
+
+// TestNewAccountWatchingOnly tests that an account can be created from an
+// extended public key, that it derives the same addresses as the wallet which
+// holds the private key and that no private keys are available for it.
+func TestNewAccountWatchingOnly(t *testing.T) {
+	teardown, db, mgr := setupManager(t)
+	defer teardown()
+
+	// The account key of some other wallet, encoded as a zpub.
+	master, err := hdkeychain.NewMaster(
+		bytes.Repeat([]byte{0x07}, 32), &chaincfg.MainNetParams,
+	)
+	util.RequireNoErr(t, err)
+	acctKey := master
+	for _, i := range []uint32{84, 0, 0} {
+		acctKey, err = acctKey.Derive(hdkeychain.HardenedKeyStart + i)
+		util.RequireNoErr(t, err)
+	}
+	acctPub, err := acctKey.Neuter()
+	util.RequireNoErr(t, err)
+	payload := base58.Decode(acctPub.String())[:78]
+	copy(payload, []byte{0x04, 0xb2, 0x47, 0x46})
+	zpub := base58.Encode(append(payload, chainhash.DoubleHashB(payload)[:4]...))
+
+	if _, _, err := ParseExtendedPubKey(acctKey.String(), &chaincfg.MainNetParams); err == nil {
+		t.Fatalf("expected an error parsing a private key")
+	}
+	pubKey, purpose, err := ParseExtendedPubKey(zpub, &chaincfg.MainNetParams)
+	util.RequireNoErr(t, err)
+	if purpose != KeyScopeBIP0084.Purpose {
+		t.Fatalf("expected purpose %d, got %d", KeyScopeBIP0084.Purpose, purpose)
+	}
+
+	scopedMgr, err := mgr.FetchScopedKeyManager(KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+
+	// The manager is locked, this must not prevent creating the account.
+	var account uint32
+	var addr ManagedAddress
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err er.R
+		account, err = scopedMgr.NewAccountWatchingOnly(ns, "treasury", pubKey, 2)
+		if err != nil {
+			return err
+		}
+		addrs, err := scopedMgr.NextExternalAddresses(ns, account, 1)
+		if err != nil {
+			return err
+		}
+		addr = addrs[0]
+		return nil
+	})
+	util.RequireNoErr(t, err)
+
+	extKey, err := acctPub.Derive(ExternalBranch)
+	util.RequireNoErr(t, err)
+	extKey, err = extKey.Derive(0)
+	util.RequireNoErr(t, err)
+	ecPub, err := extKey.ECPubKey()
+	util.RequireNoErr(t, err)
+	expected, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(ecPub.SerializeCompressed()), &chaincfg.MainNetParams,
+	)
+	util.RequireNoErr(t, err)
+	if addr.Address().EncodeAddress() != expected.EncodeAddress() {
+		t.Fatalf("expected address %s, got %s", expected, addr.Address())
+	}
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		if watchOnly, err := scopedMgr.IsWatchOnlyAccount(ns, account); err != nil {
+			return err
+		} else if !watchOnly {
+			return er.Errorf("account %d should be watch-only", account)
+		}
+		if watchOnly, err := scopedMgr.IsWatchOnlyAccount(ns, DefaultAccountNum); err != nil {
+			return err
+		} else if watchOnly {
+			return er.New("the default account should not be watch-only")
+		}
+
+		// Unlocking must skip the account and addresses can still be
+		// derived from it while unlocked, but never any private key.
+		if err := mgr.Unlock(ns, privPassphrase); err != nil {
+			return err
+		}
+		if _, err := scopedMgr.NextInternalAddresses(ns, account, 1); err != nil {
+			return err
+		}
+		ma, err := mgr.Address(ns, expected)
+		if err != nil {
+			return err
+		}
+		if _, err := ma.(ManagedPubKeyAddress).PrivKey(); !ErrWatchingOnly.Is(err) {
+			return er.Errorf("expected ErrWatchingOnly, got %v", err)
+		}
+
+		return nil
+	})
+	util.RequireNoErr(t, err)
+
+	// Using the first address keeps a gap of 2 addresses after it, the
+	// addresses are only counted once they have been committed so using it
+	// again derives nothing.
+	for _, want := range []int{2, 0} {
+		err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			extended, err := mgr.ExtendGap(ns, expected)
+			if err != nil {
+				return err
+			}
+			if len(extended) != want {
+				return er.Errorf("expected %d addresses, got %d", want, len(extended))
+			}
+			return nil
+		})
+		util.RequireNoErr(t, err)
+	}
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		last, err := scopedMgr.LastExternalAddress(ns, account)
+		if err != nil {
+			return err
+		}
+		if _, path, _ := last.(ManagedPubKeyAddress).DerivationInfo(); path.Index != 2 {
+			return er.Errorf("expected the last address to be index 2, got %d", path.Index)
+		}
+
+		// Accounts without a gap limit are not extended.
+		defaultAddrs, err := scopedMgr.NextExternalAddresses(ns, DefaultAccountNum, 1)
+		if err != nil {
+			return err
+		}
+		if extended, err := mgr.ExtendGap(ns, defaultAddrs[0].Address()); err != nil {
+			return err
+		} else if len(extended) != 0 {
+			return er.Errorf("expected no addresses, got %d", len(extended))
+		}
+		return nil
+	})
+	util.RequireNoErr(t, err)
+	util.RequireNoErr(t, mgr.Lock())
+}
@@ -276,9 +276,10 @@ func (s *ScopedKeyManager) deriveKey(acctInfo *accountInfo, branch,
 
 	// Choose the public or private extended key based on whether or not
 	// the private flag was specified.  This, in turn, allows for public or
-	// private child derivation.
+	// private child derivation.  Watch-only accounts have no private key so
+	// public derivation is always used for them.
 	acctKey := acctInfo.acctKeyPub
-	if private {
+	if private && acctInfo.acctKeyPriv != nil {
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -354,7 +355,7 @@ func (s *ScopedKeyManager) loadAccountInfo(ns walletdb.ReadBucket,
 		nextInternalIndex: row.nextInternalIndex,
 	}
 
-	if !s.rootManager.isLocked() {
+	if !s.rootManager.isLocked() && !acctInfo.watchOnly() {
 		// Use the crypto private key to decrypt the account private
 		// extended keys.
 		decrypted, err := s.rootManager.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
@@ -523,7 +524,10 @@ func (s *ScopedKeyManager) AccountProperties(ns walletdb.ReadBucket,
 	defer s.mtx.RUnlock()
 	s.mtx.RLock()
 
-	props := &AccountProperties{AccountNumber: account}
+	props := &AccountProperties{
+		AccountNumber: account,
+		KeyScope:      s.scope,
+	}
 
 	// Until keys can be imported into any account, special handling is
 	// required for the imported account.
@@ -544,6 +548,7 @@ func (s *ScopedKeyManager) AccountProperties(ns walletdb.ReadBucket,
 		props.AccountName = acctInfo.acctName
 		props.ExternalKeyCount = acctInfo.nextExternalIndex
 		props.InternalKeyCount = acctInfo.nextInternalIndex
+		props.WatchOnly = acctInfo.watchOnly()
 	} else {
 		props.AccountName = ImportedAddrAccountName // reserved, nonchangable
 
@@ -804,7 +809,7 @@ func (s *ScopedKeyManager) nextAddresses(ns walletdb.ReadWriteBucket,
 	// Choose the account key to used based on whether the address manager
 	// is locked.
 	acctKey := acctInfo.acctKeyPub
-	if !s.rootManager.IsLocked() {
+	if !s.rootManager.IsLocked() && !acctInfo.watchOnly() {
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -999,7 +1004,7 @@ func (s *ScopedKeyManager) extendAddresses(ns walletdb.ReadWriteBucket,
 	// Choose the account key to used based on whether the address manager
 	// is locked.
 	acctKey := acctInfo.acctKeyPub
-	if !s.rootManager.IsLocked() {
+	if !s.rootManager.IsLocked() && !acctInfo.watchOnly() {
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -1309,6 +1314,9 @@ func (s *ScopedKeyManager) GetSecret(ns walletdb.ReadBucket, account uint32, nam
 	if s.rootManager.IsLocked() {
 		return nil, er.New("You need to enter your wallet passphrase before getting a secret")
 	}
+	if acctInfo.watchOnly() {
+		return nil, ErrWatchingOnly.Default()
+	}
 	return acctInfo.acctKeyPriv.GetSecret(name)
 }
 
@@ -1370,6 +1378,144 @@ func (s *ScopedKeyManager) NewAccount(ns walletdb.ReadWriteBucket, name string)
 	return account, nil
 }
 
+// NewAccountWatchingOnly creates a new account from an account level extended
+// public key, such as one exported from a hardware wallet.  Addresses of the
+// account can be derived and watched, but since the account has no private key
+// nothing can be signed for it.  Unlike NewAccount, this does not require the
+// manager to be unlocked.  If an account with the same name already exists,
+// ErrDuplicateAccount will be returned.
+//
+// If gapLimit is not zero, ExtendGap keeps that many addresses derived after
+// the last used address of each branch.
+func (s *ScopedKeyManager) NewAccountWatchingOnly(ns walletdb.ReadWriteBucket,
+	name string, pubKey *hdkeychain.ExtendedKey, gapLimit uint32) (uint32, er.R) {
+
+	if pubKey.IsPrivate() {
+		str := "watch-only accounts must be created from an extended " +
+			"public key"
+		return 0, managerError(ErrInvalidAccount, str, nil)
+	}
+	if pubKey.Depth() != 3 {
+		str := fmt.Sprintf("expected an account level extended public "+
+			"key (m/purpose'/cointype'/account'), got a key with "+
+			"depth %d", pubKey.Depth())
+		return 0, managerError(ErrInvalidAccount, str, nil)
+	}
+	if err := checkBranchKeys(pubKey); err != nil {
+		str := "the provided extended public key is unusable"
+		return 0, managerError(ErrKeyChain, str, err)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := ValidateAccountName(name); err != nil {
+		return 0, err
+	}
+	if _, err := s.lookupAccount(ns, name); err == nil {
+		str := "account with the same name already exists"
+		return 0, managerError(ErrDuplicateAccount, str, err)
+	}
+
+	account, err := fetchLastAccount(ns, &s.scope)
+	if err != nil {
+		return 0, err
+	}
+	account++
+	if account > MaxAccountNum {
+		return 0, ErrAccountNumTooHigh.Default()
+	}
+
+	acctPubEnc, err := s.rootManager.cryptoKeyPub.Encrypt(
+		[]byte(pubKey.String()),
+	)
+	if err != nil {
+		str := "failed to encrypt public key for account"
+		return 0, managerError(ErrCrypto, str, err)
+	}
+
+	// The account is stored without a private key, this is what makes it
+	// watch-only.
+	err = putAccountInfo(ns, &s.scope, account, acctPubEnc, nil, 0, 0, name)
+	if err != nil {
+		return 0, err
+	}
+	if err := putLastAccount(ns, &s.scope, account); err != nil {
+		return 0, err
+	}
+	if gapLimit > 0 {
+		if err := putAccountGapLimit(ns, &s.scope, account, gapLimit); err != nil {
+			return 0, err
+		}
+	}
+
+	return account, nil
+}
+
+// ExtendGap derives addresses after the given address of an account which has
+// a gap limit, so that there are always gap limit addresses after the last one
+// which has been used.  The addresses which have been derived are returned so
+// they can be watched, nothing is derived for accounts without a gap limit.
+func (s *ScopedKeyManager) ExtendGap(ns walletdb.ReadWriteBucket,
+	address btcutil.Address) ([]ManagedAddress, er.R) {
+
+	ma, err := s.Address(ns, address)
+	if err != nil {
+		return nil, err
+	}
+	pka, ok := ma.(ManagedPubKeyAddress)
+	if !ok {
+		return nil, nil
+	}
+	_, path, ok := pka.DerivationInfo()
+	if !ok || path.Account == ImportedAddrAccount {
+		return nil, nil
+	}
+	gapLimit, err := fetchAccountGapLimit(ns, &s.scope, path.Account)
+	if err != nil || gapLimit == 0 {
+		return nil, err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	acctInfo, err := s.loadAccountInfo(ns, path.Account)
+	if err != nil {
+		return nil, err
+	}
+	internal := path.Branch == InternalBranch
+	nextIndex := acctInfo.nextExternalIndex
+	if internal {
+		nextIndex = acctInfo.nextInternalIndex
+	}
+	want := path.Index + gapLimit + 1
+	if want <= nextIndex {
+		return nil, nil
+	}
+	return s.nextAddresses(ns, path.Account, want-nextIndex, internal)
+}
+
+// IsWatchOnlyAccount returns true if the account has no private key, for
+// example because it was created with NewAccountWatchingOnly.
+func (s *ScopedKeyManager) IsWatchOnlyAccount(ns walletdb.ReadBucket,
+	account uint32) (bool, er.R) {
+
+	// The imported account has no account key at all, whether or not the
+	// individual keys are spendable.
+	if account == ImportedAddrAccount {
+		return false, nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	acctInfo, err := s.loadAccountInfo(ns, account)
+	if err != nil {
+		return false, err
+	}
+	return acctInfo.watchOnly(), nil
+}
+
 // newAccount is a helper function that derives a new precise account number,
 // and creates a mapping from the passed name to the account number in the
 // database.
@@ -0,0 +1,590 @@
+package wallet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/utilfun"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"google.golang.org/protobuf/proto"
+)
+
+// The address book names the counterparties of the wallet (contacts) and
+// labels addresses and outputs, the labels of transactions are kept by the
+// wtxmgr. All of the labels can be exported and imported as BIP-329 records.
+//
+// The contacts bucket is keyed by the name of the contact, the addrs bucket by
+// the encoded address and the outputs bucket by the outpoint:
+//
+//   [0:32]  Transaction hash
+//   [32:36] Output index, big endian
+
+var (
+	wabookNamespaceKey = []byte("wabook")
+	wabookContactsKey  = []byte("contacts")
+	wabookAddrsKey     = []byte("addrs")
+	wabookOutputsKey   = []byte("outputs")
+)
+
+// Types of BIP-329 records which the address book stores.
+const (
+	LabelTypeTx     = "tx"
+	LabelTypeAddr   = "addr"
+	LabelTypeOutput = "output"
+)
+
+func addressBookBuckets(dbtx walletdb.ReadWriteTx) (walletdb.ReadWriteBucket, er.R) {
+	b, err := dbtx.CreateTopLevelBucket(wabookNamespaceKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range [][]byte{wabookContactsKey, wabookAddrsKey, wabookOutputsKey} {
+		if _, err := b.CreateBucketIfNotExists(k); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func parseOutPointRef(ref string) (*wire.OutPoint, er.R) {
+	i := strings.LastIndex(ref, ":")
+	if i < 0 {
+		return nil, er.Errorf("outpoint [%s] is not in the form txid:vout", ref)
+	}
+	hash, err := chainhash.NewHashFromStr(ref[:i])
+	if err != nil {
+		return nil, err
+	}
+	vout, errr := strconv.ParseUint(ref[i+1:], 10, 32)
+	if errr != nil {
+		return nil, er.Errorf("outpoint [%s] is not in the form txid:vout", ref)
+	}
+	return wire.NewOutPoint(hash, uint32(vout)), nil
+}
+
+func checkLabel(label string) er.R {
+	if len(label) > wtxmgr.TxLabelLimit {
+		return er.Errorf("label is [%d] bytes long, the limit is [%d]",
+			len(label), wtxmgr.TxLabelLimit)
+	}
+	return nil
+}
+
+// addressBook is the content of the address book, loaded for a query.
+type addressBook struct {
+	contacts    []*rpc_pb.AddressBookContact
+	addrContact map[string]string
+	addrLabels  map[string]string
+	outLabels   map[wire.OutPoint]string
+}
+
+func loadAddressBook(dbtx walletdb.ReadTx) (*addressBook, er.R) {
+	ab := &addressBook{
+		addrContact: make(map[string]string),
+		addrLabels:  make(map[string]string),
+		outLabels:   make(map[wire.OutPoint]string),
+	}
+	b := dbtx.ReadBucket(wabookNamespaceKey)
+	if b == nil {
+		return ab, nil
+	}
+	if err := b.NestedReadBucket(wabookContactsKey).ForEach(func(k, v []byte) er.R {
+		c := &rpc_pb.AddressBookContact{}
+		if err := proto.Unmarshal(v, c); err != nil {
+			return er.E(err)
+		}
+		ab.contacts = append(ab.contacts, c)
+		for _, a := range c.Addresses {
+			ab.addrContact[a] = c.Name
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := b.NestedReadBucket(wabookAddrsKey).ForEach(func(k, v []byte) er.R {
+		ab.addrLabels[string(k)] = string(v)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	err := b.NestedReadBucket(wabookOutputsKey).ForEach(func(k, v []byte) er.R {
+		var op wire.OutPoint
+		if err := utilfun.ReadCanonicalOutPoint(k, &op); err != nil {
+			return err
+		}
+		ab.outLabels[op] = string(v)
+		return nil
+	})
+	return ab, err
+}
+
+func (w *Wallet) loadAddressBook() (*addressBook, er.R) {
+	var ab *addressBook
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		var err er.R
+		ab, err = loadAddressBook(dbtx)
+		return err
+	})
+	return ab, err
+}
+
+// txAddresses returns the addresses which are paid by the outputs of a
+// transaction and those which can be deduced from its inputs.
+func txAddresses(mtx *wire.MsgTx, params *chaincfg.Params) []string {
+	var out []string
+	for _, in := range mtx.TxIn {
+		var addr btcutil.Address
+		if len(in.Witness) > 0 {
+			addr = txscript.WitnessToAddress(in.Witness, params)
+		} else if len(in.SignatureScript) > 0 {
+			addr = txscript.SigScriptToAddress(in.SignatureScript, params)
+		}
+		if addr != nil {
+			out = append(out, addr.EncodeAddress())
+		}
+	}
+	for _, o := range mtx.TxOut {
+		out = append(out, txscript.PkScriptToAddress(o.PkScript, params).EncodeAddress())
+	}
+	return out
+}
+
+// contactsOf returns the names of the contacts which a transaction involves.
+func (ab *addressBook) contactsOf(mtx *wire.MsgTx, params *chaincfg.Params) []string {
+	var out []string
+	seen := make(map[string]struct{})
+	for _, a := range txAddresses(mtx, params) {
+		c, ok := ab.addrContact[a]
+		if _, dup := seen[c]; ok && !dup {
+			seen[c] = struct{}{}
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// outputLabel returns the label of an output, or else of the address it pays.
+func (ab *addressBook) outputLabel(op *wire.OutPoint, addr string) string {
+	if l, ok := ab.outLabels[*op]; ok {
+		return l
+	}
+	return ab.addrLabels[addr]
+}
+
+// matcher returns a function which tells whether a transaction matches the
+// label and contact filters of a query, or nil if there are no filters.
+func (ab *addressBook) matcher(label, contact string, params *chaincfg.Params) func(*wtxmgr.TxDetails) bool {
+	if label == "" && contact == "" {
+		return nil
+	}
+	label = strings.ToLower(label)
+	return func(d *wtxmgr.TxDetails) bool {
+		addrs := txAddresses(&d.MsgTx, params)
+		if contact != "" {
+			found := false
+			for _, a := range addrs {
+				found = found || ab.addrContact[a] == contact
+			}
+			if !found {
+				return false
+			}
+		}
+		if label == "" || strings.Contains(strings.ToLower(d.Label), label) {
+			return true
+		}
+		for _, a := range addrs {
+			if strings.Contains(strings.ToLower(ab.addrLabels[a]), label) {
+				return true
+			}
+		}
+		for i := range d.MsgTx.TxOut {
+			op := wire.OutPoint{Hash: d.Hash, Index: uint32(i)}
+			if strings.Contains(strings.ToLower(ab.outLabels[op]), label) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func putContact(b walletdb.ReadWriteBucket, c *rpc_pb.AddressBookContact) er.R {
+	v, errr := proto.Marshal(c)
+	if errr != nil {
+		return er.E(errr)
+	}
+	return b.NestedReadWriteBucket(wabookContactsKey).Put([]byte(c.Name), v)
+}
+
+// SetContact creates a contact, or replaces the contact with the same name.
+func (w *Wallet) SetContact(req *rpc_pb.AddressBookContact) (*rpc_pb.AddressBookContact, er.R) {
+	if req.Name == "" {
+		return nil, er.New("a contact needs a name")
+	} else if err := checkLabel(req.Name); err != nil {
+		return nil, err
+	} else if err := checkLabel(req.Note); err != nil {
+		return nil, err
+	}
+	c := &rpc_pb.AddressBookContact{
+		Name:       req.Name,
+		Note:       req.Note,
+		CreatedSec: time.Now().Unix(),
+	}
+	for _, a := range req.Addresses {
+		addr, err := btcutil.DecodeAddress(a, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		c.Addresses = append(c.Addresses, addr.EncodeAddress())
+	}
+	return c, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := addressBookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		ab, err := loadAddressBook(dbtx)
+		if err != nil {
+			return err
+		}
+		for _, a := range c.Addresses {
+			if other, ok := ab.addrContact[a]; ok && other != c.Name {
+				return er.Errorf("address [%s] belongs to contact [%s]", a, other)
+			}
+		}
+		for _, old := range ab.contacts {
+			if old.Name == c.Name {
+				c.CreatedSec = old.CreatedSec
+			}
+		}
+		return putContact(b, c)
+	})
+}
+
+// DeleteContact removes a contact from the address book.
+func (w *Wallet) DeleteContact(name string) er.R {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := addressBookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		contacts := b.NestedReadWriteBucket(wabookContactsKey)
+		if contacts.Get([]byte(name)) == nil {
+			return er.Errorf("no such contact [%s]", name)
+		}
+		return contacts.Delete([]byte(name))
+	})
+}
+
+func (w *Wallet) setLabel(dbtx walletdb.ReadWriteTx, l *rpc_pb.AddressBookLabel, overwrite bool) (bool, er.R) {
+	if err := checkLabel(l.Label); err != nil {
+		return false, err
+	}
+	b, err := addressBookBuckets(dbtx)
+	if err != nil {
+		return false, err
+	}
+	var bucket walletdb.ReadWriteBucket
+	var key []byte
+	switch l.Type {
+	case LabelTypeTx:
+		hash, err := chainhash.NewHashFromStr(l.Ref)
+		if err != nil {
+			return false, err
+		}
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if d, err := w.TxStore.TxDetails(txmgrNs, hash); err != nil {
+			return false, err
+		} else if d == nil {
+			return false, ErrUnknownTransaction.New(l.Ref, nil)
+		} else if d.Label != "" && !overwrite {
+			return false, nil
+		} else if l.Label == "" {
+			return true, wtxmgr.DeleteTxLabel(txmgrNs, *hash)
+		}
+		return true, w.TxStore.PutTxLabel(txmgrNs, *hash, l.Label)
+	case LabelTypeAddr:
+		addr, err := btcutil.DecodeAddress(l.Ref, w.chainParams)
+		if err != nil {
+			return false, err
+		}
+		bucket = b.NestedReadWriteBucket(wabookAddrsKey)
+		key = []byte(addr.EncodeAddress())
+	case LabelTypeOutput:
+		op, err := parseOutPointRef(l.Ref)
+		if err != nil {
+			return false, err
+		}
+		bucket = b.NestedReadWriteBucket(wabookOutputsKey)
+		key = utilfun.CanonicalOutPoint(&op.Hash, op.Index)
+	default:
+		return false, er.Errorf("unsupported label type [%s], use tx, addr or output", l.Type)
+	}
+	if bucket.Get(key) != nil && !overwrite {
+		return false, nil
+	} else if l.Label == "" {
+		return true, bucket.Delete(key)
+	}
+	return true, bucket.Put(key, []byte(l.Label))
+}
+
+// SetLabel labels a transaction, an address or an output, an empty label
+// removes the label.
+func (w *Wallet) SetLabel(l *rpc_pb.AddressBookLabel) er.R {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		_, err := w.setLabel(dbtx, l, true)
+		return err
+	})
+}
+
+// SetOutputLabels labels outputs of a transaction which was made by the
+// wallet, the map is keyed by the output index.
+func (w *Wallet) SetOutputLabels(txid *chainhash.Hash, labels map[uint32]string) er.R {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		for vout, label := range labels {
+			if _, err := w.setLabel(dbtx, &rpc_pb.AddressBookLabel{
+				Type:  LabelTypeOutput,
+				Ref:   wire.NewOutPoint(txid, vout).String(),
+				Label: label,
+			}, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AddressBook returns all contacts and all labels, including the labels of
+// transactions.
+func (w *Wallet) AddressBook() (*rpc_pb.AddressBook, er.R) {
+	out := &rpc_pb.AddressBook{}
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		ab, err := loadAddressBook(dbtx)
+		if err != nil {
+			return err
+		}
+		txLabels, err := fetchAllLabels(dbtx)
+		if err != nil {
+			return err
+		}
+		out.Contacts = ab.contacts
+		out.Labels = ab.labels(txLabels)
+		return nil
+	})
+	return out, err
+}
+
+// labels returns all of the labels, sorted by type and reference.
+func (ab *addressBook) labels(txLabels map[chainhash.Hash]string) []*rpc_pb.AddressBookLabel {
+	var out []*rpc_pb.AddressBookLabel
+	for h, l := range txLabels {
+		out = append(out, &rpc_pb.AddressBookLabel{Type: LabelTypeTx, Ref: h.String(), Label: l})
+	}
+	for a, l := range ab.addrLabels {
+		out = append(out, &rpc_pb.AddressBookLabel{Type: LabelTypeAddr, Ref: a, Label: l})
+	}
+	for op, l := range ab.outLabels {
+		out = append(out, &rpc_pb.AddressBookLabel{Type: LabelTypeOutput, Ref: op.String(), Label: l})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Type != out[j].Type {
+			return out[i].Type > out[j].Type
+		}
+		return out[i].Ref < out[j].Ref
+	})
+	return out
+}
+
+// bip329Record is one line of a BIP-329 export. Contact is not part of
+// BIP-329, it carries the contact of an address and other wallets ignore it.
+type bip329Record struct {
+	Type    string `json:"type"`
+	Ref     string `json:"ref"`
+	Label   string `json:"label"`
+	Contact string `json:"contact,omitempty"`
+}
+
+// ExportLabels writes all labels as BIP-329 JSON lines. An address of a
+// contact which has no label of its own is labelled with the name of the
+// contact.
+func (w *Wallet) ExportLabels() (*rpc_pb.AddressBookExport, er.R) {
+	var buf bytes.Buffer
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		ab, err := loadAddressBook(dbtx)
+		if err != nil {
+			return err
+		}
+		txLabels, err := fetchAllLabels(dbtx)
+		if err != nil {
+			return err
+		}
+		for a, c := range ab.addrContact {
+			if _, ok := ab.addrLabels[a]; !ok {
+				ab.addrLabels[a] = c
+			}
+		}
+		enc := json.NewEncoder(&buf)
+		for _, l := range ab.labels(txLabels) {
+			r := bip329Record{Type: l.Type, Ref: l.Ref, Label: l.Label}
+			if l.Type == LabelTypeAddr {
+				r.Contact = ab.addrContact[l.Ref]
+			}
+			if errr := enc.Encode(&r); errr != nil {
+				return er.E(errr)
+			}
+		}
+		return nil
+	})
+	return &rpc_pb.AddressBookExport{Jsonl: buf.String()}, err
+}
+
+// checkLabelRecord checks the reference, the label and the contact of a
+// BIP-329 record of one of the types which the address book stores.
+func (w *Wallet) checkLabelRecord(r *bip329Record) er.R {
+	if err := checkLabel(r.Label); err != nil {
+		return err
+	} else if err := checkLabel(r.Contact); err != nil {
+		return err
+	}
+	var err er.R
+	switch r.Type {
+	case LabelTypeTx:
+		_, err = chainhash.NewHashFromStr(r.Ref)
+	case LabelTypeAddr:
+		_, err = btcutil.DecodeAddress(r.Ref, w.chainParams)
+	case LabelTypeOutput:
+		_, err = parseOutPointRef(r.Ref)
+	}
+	return err
+}
+
+// ImportLabels stores BIP-329 records. Records of types other than tx, addr and
+// output, and the labels of transactions which are not in the wallet, are
+// skipped. Records which are not valid, such as an address of another chain,
+// are counted as invalid and the rest are still imported. An addr record with
+// a contact adds the address to the contact.
+func (w *Wallet) ImportLabels(req *rpc_pb.AddressBookImportRequest) (*rpc_pb.AddressBookImportResponse, er.R) {
+	out := &rpc_pb.AddressBookImportResponse{}
+	var records []bip329Record
+	sc := bufio.NewScanner(strings.NewReader(req.Jsonl))
+	sc.Buffer(nil, 1<<20)
+	for line := 1; sc.Scan(); line++ {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		var r bip329Record
+		if errr := json.Unmarshal(sc.Bytes(), &r); errr != nil {
+			log.Warnf("Line [%d] is not a valid BIP-329 record: %v", line, errr)
+			out.Invalid++
+			continue
+		}
+		switch r.Type {
+		case LabelTypeTx, LabelTypeAddr, LabelTypeOutput:
+			if err := w.checkLabelRecord(&r); err != nil {
+				log.Warnf("Line [%d] is not a valid %s record: %s", line, r.Type, err.Message())
+				out.Invalid++
+				continue
+			}
+		}
+		records = append(records, r)
+	}
+	if errr := sc.Err(); errr != nil {
+		return nil, er.E(errr)
+	}
+
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := addressBookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		ab, err := loadAddressBook(dbtx)
+		if err != nil {
+			return err
+		}
+		contacts := make(map[string]*rpc_pb.AddressBookContact)
+		for _, c := range ab.contacts {
+			contacts[c.Name] = c
+		}
+		changed := make(map[string]*rpc_pb.AddressBookContact)
+		for _, r := range records {
+			switch r.Type {
+			case LabelTypeTx, LabelTypeAddr, LabelTypeOutput:
+			default:
+				out.Skipped++
+				continue
+			}
+			// A record which carries only the contact of an address, the
+			// label was filled in with the name of the contact on export.
+			contactOnly := r.Type == LabelTypeAddr && r.Contact != "" && r.Contact == r.Label
+			labelled := false
+			if !contactOnly && r.Label != "" {
+				ok, err := w.setLabel(dbtx, &rpc_pb.AddressBookLabel{
+					Type: r.Type, Ref: r.Ref, Label: r.Label,
+				}, req.Overwrite)
+				if err != nil && !(r.Type == LabelTypeTx && ErrUnknownTransaction.Is(err)) {
+					return err
+				}
+				labelled = ok
+			}
+			contacted, err := w.importContact(&r, ab, contacts, changed)
+			if err != nil {
+				return err
+			} else if labelled || contacted {
+				out.Imported++
+			} else {
+				out.Skipped++
+			}
+		}
+		for _, c := range changed {
+			if err := putContact(b, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return out, err
+}
+
+// importContact adds the address of an addr record to the contact of the
+// record, unless the address already belongs to a contact.
+func (w *Wallet) importContact(
+	r *bip329Record,
+	ab *addressBook,
+	contacts, changed map[string]*rpc_pb.AddressBookContact,
+) (bool, er.R) {
+	if r.Type != LabelTypeAddr || r.Contact == "" {
+		return false, nil
+	} else if err := checkLabel(r.Contact); err != nil {
+		return false, err
+	}
+	addr, err := btcutil.DecodeAddress(r.Ref, w.chainParams)
+	if err != nil {
+		return false, err
+	}
+	a := addr.EncodeAddress()
+	if _, ok := ab.addrContact[a]; ok {
+		return false, nil
+	}
+	c := contacts[r.Contact]
+	if c == nil {
+		c = &rpc_pb.AddressBookContact{Name: r.Contact, CreatedSec: time.Now().Unix()}
+		contacts[r.Contact] = c
+	}
+	c.Addresses = append(c.Addresses, a)
+	ab.addrContact[a] = c.Name
+	changed[c.Name] = c
+	return true, nil
+}
@@ -0,0 +1,126 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestAddressBook labels two payments, queries them by label and by contact,
+// and checks that the labels survive an export and import.
+func TestAddressBook(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	var addrs []string
+	var recs []*wtxmgr.TxRecord
+	for i := 0; i < 2; i++ {
+		addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+		util.RequireNoErr(t, err)
+		pkScript, err := txscript.PayToAddrScript(addr)
+		util.RequireNoErr(t, err)
+		rec, err := wtxmgr.NewTxRecordFromMsgTx(&wire.MsgTx{
+			TxIn:  []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Index: uint32(i)}}},
+			TxOut: []*wire.TxOut{wire.NewTxOut(1000000, pkScript)},
+		}, time.Now())
+		util.RequireNoErr(t, err)
+		util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			return w.addRelevantTx(dbtx, rec, nil)
+		}))
+		addrs = append(addrs, addr.EncodeAddress())
+		recs = append(recs, rec)
+	}
+
+	_, err := w.SetContact(&rpc_pb.AddressBookContact{Name: "alice", Addresses: addrs[:1]})
+	util.RequireNoErr(t, err)
+	if _, err := w.SetContact(&rpc_pb.AddressBookContact{Name: "bob", Addresses: addrs[:1]}); err == nil {
+		t.Fatalf("expected an address to belong to only one contact")
+	}
+	util.RequireNoErr(t, w.SetLabel(&rpc_pb.AddressBookLabel{
+		Type: LabelTypeOutput, Ref: recs[0].Hash.String() + ":0", Label: "Invoice 42"}))
+	util.RequireNoErr(t, w.SetLabel(&rpc_pb.AddressBookLabel{
+		Type: LabelTypeAddr, Ref: addrs[1], Label: "rent"}))
+	util.RequireNoErr(t, w.SetLabel(&rpc_pb.AddressBookLabel{
+		Type: LabelTypeTx, Ref: recs[1].Hash.String(), Label: "march"}))
+	if err := w.SetLabel(&rpc_pb.AddressBookLabel{
+		Type: LabelTypeTx, Ref: (&wire.MsgTx{}).TxHash().String(), Label: "x"}); err == nil {
+		t.Fatalf("expected an unknown transaction not to be labelled")
+	}
+
+	query := func(label, contact string, expect int) *rpc_pb.ContextualTransaction {
+		res, err := w.GetTransactions1(&rpc_pb.GetTransactionsRequest{
+			EndHeight: -1,
+			Label:     label,
+			Contact:   contact,
+		})
+		util.RequireNoErr(t, err)
+		if len(res.Transactions) != 1 || res.Transactions[0].Tx.Txid != recs[expect].Hash.String() {
+			t.Fatalf("expected query [%s] [%s] to find tx %d, got %v", label, contact, expect, res)
+		}
+		return res.Transactions[0]
+	}
+	if tx := query("INVOICE", "", 0); tx.Tx.Vout[0].Label != "Invoice 42" ||
+		len(tx.Contacts) != 1 || tx.Contacts[0] != "alice" {
+		t.Fatalf("expected the output label and the contact, got %v", tx)
+	}
+	query("", "alice", 0)
+	if tx := query("rent", "", 1); tx.Tx.Vout[0].Label != "rent" || tx.Label != "march" {
+		t.Fatalf("expected the address label and the tx label, got %v", tx)
+	}
+	query("MARCH", "", 1)
+
+	before, err := w.AddressBook()
+	util.RequireNoErr(t, err)
+	if len(before.Contacts) != 1 || len(before.Labels) != 3 {
+		t.Fatalf("expected one contact and three labels, got %v", before)
+	}
+	exp, err := w.ExportLabels()
+	util.RequireNoErr(t, err)
+	if n := strings.Count(exp.Jsonl, "\n"); n != 4 {
+		t.Fatalf("expected 4 records, got %s", exp.Jsonl)
+	}
+
+	// Without overwrite, the existing labels are kept.
+	res, err := w.ImportLabels(&rpc_pb.AddressBookImportRequest{Jsonl: exp.Jsonl})
+	util.RequireNoErr(t, err)
+	if res.Imported != 0 || res.Skipped != 4 {
+		t.Fatalf("expected nothing to be imported, got %v", res)
+	}
+
+	util.RequireNoErr(t, w.DeleteContact("alice"))
+	for _, l := range before.Labels {
+		util.RequireNoErr(t, w.SetLabel(&rpc_pb.AddressBookLabel{Type: l.Type, Ref: l.Ref}))
+	}
+	if ab, err := w.AddressBook(); err != nil || len(ab.Contacts)+len(ab.Labels) != 0 {
+		t.Fatalf("expected an empty address book, got %v %v", ab, err)
+	}
+	// A record which is not valid doesn't stop the import.
+	res, err = w.ImportLabels(&rpc_pb.AddressBookImportRequest{
+		Jsonl: `{"type":"addr","ref":"bc1qnotanaddress","label":"bad"}` + "\n" + "not json\n" +
+			exp.Jsonl + `{"type":"xpub","ref":"xpub0","label":"cold"}` + "\n",
+	})
+	util.RequireNoErr(t, err)
+	if res.Imported != 4 || res.Skipped != 1 || res.Invalid != 2 {
+		t.Fatalf("expected 4 records imported, 1 skipped and 2 invalid, got %v", res)
+	}
+	after, err := w.AddressBook()
+	util.RequireNoErr(t, err)
+	if len(after.Contacts) != 1 || after.Contacts[0].Name != "alice" ||
+		len(after.Contacts[0].Addresses) != 1 || after.Contacts[0].Addresses[0] != addrs[0] {
+		t.Fatalf("expected the contact to be imported, got %v", after.Contacts)
+	}
+	for i, l := range before.Labels {
+		if a := after.Labels[i]; a.Type != l.Type || a.Ref != l.Ref || a.Label != l.Label {
+			t.Fatalf("expected label %v, got %v", l, a)
+		}
+	}
+}
@@ -0,0 +1,406 @@
+package wallet
+
+import (
+	"bytes"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// BumpFeeMode selects how the fee of a transaction is increased.
+type BumpFeeMode uint8
+
+const (
+	// BumpFeeAuto replaces the transaction if it signals replaceability
+	// and has change to take the fee from, otherwise, or if the change is
+	// not enough to pay the fee, it spends one of its outputs with a child
+	// transaction.
+	BumpFeeAuto BumpFeeMode = 0
+
+	// BumpFeeRBF replaces the transaction with one spending the same inputs
+	// and taking the additional fee from the change (BIP-125).
+	BumpFeeRBF BumpFeeMode = 1
+
+	// BumpFeeCPFP spends an output of the transaction back to the same
+	// address, with a fee high enough to pay for both transactions.
+	BumpFeeCPFP BumpFeeMode = 2
+)
+
+func (m BumpFeeMode) String() string {
+	switch m {
+	case BumpFeeRBF:
+		return "rbf"
+	case BumpFeeCPFP:
+		return "cpfp"
+	default:
+		return "auto"
+	}
+}
+
+var NotBumpableError = er.GenericErrorType.CodeWithDetail("NotBumpableError",
+	"unable to bump the fee of this transaction")
+
+// BumpFeeResult describes a transaction which was published to increase the
+// fee of an unconfirmed transaction.
+type BumpFeeResult struct {
+	// The replacement transaction or the child transaction
+	Tx *wire.MsgTx
+
+	// Either BumpFeeRBF or BumpFeeCPFP
+	Mode BumpFeeMode
+
+	// The fee paid by Tx
+	Fee btcutil.Amount
+
+	// The effective fee rate, for CPFP this is the rate of the parent and
+	// child together
+	FeePerKb btcutil.Amount
+}
+
+func vsize(tx *wire.MsgTx) int {
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	return int((weight + blockchain.WitnessScaleFactor - 1) / blockchain.WitnessScaleFactor)
+}
+
+func feeRate(fee btcutil.Amount, vsize int) btcutil.Amount {
+	if vsize <= 0 {
+		return 0
+	}
+	return fee * 1000 / btcutil.Amount(vsize)
+}
+
+func signalsReplacement(tx *wire.MsgTx) bool {
+	for _, in := range tx.TxIn {
+		if in.Sequence <= constants.MaxRBFSequence {
+			return true
+		}
+	}
+	return false
+}
+
+// signBumpTx (re)signs every input of a transaction created for bumping a fee,
+// the previous outputs must be set in tx.Additional.
+func (w *Wallet) signBumpTx(tx *wire.MsgTx) er.R {
+	for _, in := range tx.TxIn {
+		in.SignatureScript = nil
+		in.Witness = nil
+	}
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return txauthor.AddAllInputScripts(tx, secretSource{w.Manager, addrmgrNs})
+	})
+	if err != nil {
+		return err
+	}
+	return validateMsgTx1(tx)
+}
+
+type bumpInfo struct {
+	details *wtxmgr.TxDetails
+
+	// The previous output of each input, nil if unknown
+	prevOuts []*wire.TxOut
+
+	// Fee of the transaction, zero if some of the inputs are not ours
+	fee btcutil.Amount
+
+	// Index of the change output, or -1
+	changeIndex int
+}
+
+func (w *Wallet) getBumpInfo(txid *chainhash.Hash) (*bumpInfo, er.R) {
+	var out bumpInfo
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		details, err := w.TxStore.TxDetails(txmgrNs, txid)
+		if err != nil {
+			return err
+		}
+		if details == nil {
+			if r, err := w.TxStore.ReplacedTx(txmgrNs, txid); err != nil {
+				return err
+			} else if r != nil {
+				return NotBumpableError.New("transaction has already been replaced by ["+
+					r.ReplacedBy.String()+"]", nil)
+			}
+			return NotBumpableError.New("transaction not found in the wallet", nil)
+		}
+		if details.Block.Height != -1 {
+			return NotBumpableError.New("transaction is already confirmed", nil)
+		}
+		out.details = details
+		out.prevOuts = make([]*wire.TxOut, len(details.MsgTx.TxIn))
+		for i, in := range details.MsgTx.TxIn {
+			prev, err := w.TxStore.TxDetails(txmgrNs, &in.PreviousOutPoint.Hash)
+			if err != nil {
+				return err
+			}
+			if prev != nil && int(in.PreviousOutPoint.Index) < len(prev.MsgTx.TxOut) {
+				out.prevOuts[i] = prev.MsgTx.TxOut[in.PreviousOutPoint.Index]
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &out.details.MsgTx
+	if len(out.details.Debits) == len(tx.TxIn) {
+		for _, d := range out.details.Debits {
+			out.fee += d.Amount
+		}
+		for _, o := range tx.TxOut {
+			out.fee -= btcutil.Amount(o.Value)
+		}
+	}
+
+	// Change is either flagged as such or, as is usual in PKT, paid back to
+	// the address which one of the inputs was spending from.
+	out.changeIndex = -1
+	for _, c := range out.details.Credits {
+		if c.Change {
+			out.changeIndex = int(c.Index)
+			break
+		}
+		pkScript := tx.TxOut[c.Index].PkScript
+		for _, prev := range out.prevOuts {
+			if prev != nil && bytes.Equal(prev.PkScript, pkScript) {
+				out.changeIndex = int(c.Index)
+				break
+			}
+		}
+		if out.changeIndex > -1 {
+			break
+		}
+	}
+	return &out, nil
+}
+
+// rbfCheck returns nil if the transaction can be replaced by fee.
+func (bi *bumpInfo) rbfCheck() er.R {
+	tx := &bi.details.MsgTx
+	if !signalsReplacement(tx) {
+		return NotBumpableError.New("transaction does not signal replaceability", nil)
+	} else if len(bi.details.Debits) != len(tx.TxIn) {
+		return NotBumpableError.New("not all inputs of the transaction belong to the wallet", nil)
+	} else if bi.changeIndex < 0 {
+		return NotBumpableError.New("transaction has no change output to take the fee from", nil)
+	}
+	for _, c := range bi.details.Credits {
+		if c.Spent {
+			return NotBumpableError.New("an output of the transaction has been spent by "+
+				"another unconfirmed transaction, which would be replaced as well", nil)
+		}
+	}
+	return nil
+}
+
+func (w *Wallet) bumpRBF(bi *bumpInfo, feePerKb btcutil.Amount) (*BumpFeeResult, er.R) {
+	orig := &bi.details.MsgTx
+	origVsize := vsize(orig)
+	if feePerKb == 0 {
+		feePerKb = feeRate(bi.fee, origVsize) + txrules.DefaultRelayFeePerKb
+	} else if feePerKb <= feeRate(bi.fee, origVsize) {
+		return nil, er.Errorf("fee rate must be higher than the current rate of [%d] per kb",
+			feeRate(bi.fee, origVsize))
+	}
+
+	tx := orig.Copy()
+	tx.Additional = make([]wire.TxInAdditional, len(tx.TxIn))
+	for i, prev := range bi.prevOuts {
+		if prev == nil {
+			return nil, er.Errorf("previous output of input [%d] is unknown", i)
+		}
+		v := prev.Value
+		tx.Additional[i] = wire.TxInAdditional{PkScript: prev.PkScript, Value: &v}
+	}
+	if err := w.signBumpTx(tx); err != nil {
+		return nil, err
+	}
+
+	// The replacement must pay for its own relay on top of the original fee.
+	change := tx.TxOut[bi.changeIndex]
+	size := vsize(tx)
+	fee := txrules.FeeForSerializeSize(feePerKb, size)
+	if minFee := bi.fee + txrules.FeeForSerializeSize(txrules.DefaultRelayFeePerKb, size); fee < minFee {
+		fee = minFee
+	}
+	change.Value -= int64(fee - bi.fee)
+	if change.Value < 0 || txrules.IsDustOutput(change, txrules.DefaultRelayFeePerKb) {
+		if len(tx.TxOut) == 1 {
+			return nil, InsufficientFundsError.New("the change is not enough to pay the fee", nil)
+		}
+		// Give all of the change to the fee.
+		tx.TxOut = append(tx.TxOut[:bi.changeIndex], tx.TxOut[bi.changeIndex+1:]...)
+		fee = bi.fee + btcutil.Amount(orig.TxOut[bi.changeIndex].Value)
+		size = vsize(tx)
+		if fee < txrules.FeeForSerializeSize(feePerKb, size) ||
+			fee < bi.fee+txrules.FeeForSerializeSize(txrules.DefaultRelayFeePerKb, size) {
+			return nil, InsufficientFundsError.New("the change is not enough to pay the fee", nil)
+		}
+	}
+	if err := w.signBumpTx(tx); err != nil {
+		return nil, err
+	}
+
+	txid, err := w.ReliablyPublishTransaction(tx, bi.details.Label)
+	if err != nil {
+		return nil, err
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ReplaceUnminedTx(txmgrNs, &bi.details.TxRecord, txid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BumpFeeResult{
+		Tx:       tx,
+		Mode:     BumpFeeRBF,
+		Fee:      fee,
+		FeePerKb: feeRate(fee, vsize(tx)),
+	}, nil
+}
+
+func (w *Wallet) bumpCPFP(bi *bumpInfo, feePerKb btcutil.Amount) (*BumpFeeResult, er.R) {
+	parent := &bi.details.MsgTx
+	parentVsize := vsize(parent)
+	if feePerKb == 0 {
+		feePerKb = feeRate(bi.fee, parentVsize) + txrules.DefaultRelayFeePerKb
+	} else if feePerKb <= feeRate(bi.fee, parentVsize) {
+		return nil, er.Errorf("fee rate must be higher than the current rate of [%d] per kb",
+			feeRate(bi.fee, parentVsize))
+	}
+
+	// Spend the largest unspent output which we own.
+	spend := -1
+	for _, c := range bi.details.Credits {
+		op := wire.OutPoint{Hash: bi.details.Hash, Index: c.Index}
+		if c.Spent || w.LockedOutpoint(op) {
+			continue
+		}
+		if spend < 0 || parent.TxOut[c.Index].Value > parent.TxOut[spend].Value {
+			spend = int(c.Index)
+		}
+	}
+	if spend < 0 {
+		return nil, NotBumpableError.New("transaction has no unspent output "+
+			"belonging to the wallet", nil)
+	}
+	prev := parent.TxOut[spend]
+
+	tx := wire.NewMsgTx(constants.TxVersion)
+	in := wire.NewTxIn(&wire.OutPoint{Hash: bi.details.Hash, Index: uint32(spend)}, nil, nil)
+	in.Sequence = constants.MaxRBFSequence
+	tx.AddTxIn(in)
+	tx.AddTxOut(wire.NewTxOut(prev.Value, prev.PkScript))
+	v := prev.Value
+	tx.Additional = []wire.TxInAdditional{{PkScript: prev.PkScript, Value: &v}}
+	if err := w.signBumpTx(tx); err != nil {
+		return nil, err
+	}
+
+	// The child pays for the whole package at the requested rate, less what
+	// the parent already pays.
+	size := vsize(tx)
+	fee := txrules.FeeForSerializeSize(feePerKb, parentVsize+size) - bi.fee
+	if minFee := txrules.FeeForSerializeSize(feePerKb, size); fee < minFee {
+		fee = minFee
+	}
+	tx.TxOut[0].Value -= int64(fee)
+	if tx.TxOut[0].Value < 0 || txrules.IsDustOutput(tx.TxOut[0], txrules.DefaultRelayFeePerKb) {
+		return nil, InsufficientFundsError.New("the output is not enough to pay the fee", nil)
+	}
+	if err := w.signBumpTx(tx); err != nil {
+		return nil, err
+	}
+
+	if _, err := w.ReliablyPublishTransaction(tx, ""); err != nil {
+		return nil, err
+	}
+	return &BumpFeeResult{
+		Tx:       tx,
+		Mode:     BumpFeeCPFP,
+		Fee:      fee,
+		FeePerKb: feeRate(bi.fee+fee, parentVsize+vsize(tx)),
+	}, nil
+}
+
+// BumpFee increases the fee of an unconfirmed wallet transaction, either by
+// replacing it (RBF) or by spending one of its outputs with a child transaction
+// which pays for both (CPFP). If feePerKb is zero then the fee rate is raised
+// by the minimum relay fee rate. The wallet must be unlocked.
+func (w *Wallet) BumpFee(txid *chainhash.Hash, feePerKb btcutil.Amount,
+	mode BumpFeeMode) (*BumpFeeResult, er.R) {
+
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return nil, err
+	}
+	defer heldUnlock.release()
+
+	bi, err := w.getBumpInfo(txid)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *BumpFeeResult
+	switch mode {
+	case BumpFeeRBF:
+		if err := bi.rbfCheck(); err != nil {
+			return nil, err
+		}
+		res, err = w.bumpRBF(bi, feePerKb)
+	case BumpFeeCPFP:
+		res, err = w.bumpCPFP(bi, feePerKb)
+	default:
+		if bi.rbfCheck() == nil {
+			res, err = w.bumpRBF(bi, feePerKb)
+		}
+		if res == nil && (err == nil || InsufficientFundsError.Is(err)) {
+			res, err = w.bumpCPFP(bi, feePerKb)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Bumped fee of [%s] by %s with [%s], fee rate is now [%d] per kb",
+		log.Txid(txid.String()), res.Mode, log.Txid(res.Tx.TxHash().String()), res.FeePerKb)
+	return res, nil
+}
+
+// ReplacedTx returns the record of a wallet transaction which was replaced by
+// fee, or nil if the transaction was not replaced.
+func (w *Wallet) ReplacedTx(txid *chainhash.Hash) (*wtxmgr.ReplacedTx, er.R) {
+	var out *wtxmgr.ReplacedTx
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err er.R
+		out, err = w.TxStore.ReplacedTx(txmgrNs, txid)
+		return err
+	})
+	return out, err
+}
+
+// Replaces returns the txids of the wallet transactions which were replaced by
+// fee with the transaction txid.
+func (w *Wallet) Replaces(txid *chainhash.Hash) ([]chainhash.Hash, er.R) {
+	var out []chainhash.Hash
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err er.R
+		out, err = w.TxStore.Replaces(txmgrNs, txid)
+		return err
+	})
+	return out, err
+}
@@ -0,0 +1,170 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// TestBumpFee replaces a wallet transaction by fee, then spends the change of
+// the replacement with a CPFP child.
+func TestBumpFee(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	util.RequireNoErr(t, err)
+	payee, err := btcutil.NewAddressWitnessPubKeyHash(make([]byte, 20), &chaincfg.TestNet3Params)
+	util.RequireNoErr(t, err)
+	payeeScript, err := txscript.PayToAddrScript(payee)
+	util.RequireNoErr(t, err)
+
+	incoming := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(100000000, pkScript)},
+	}
+	addUtxo(t, w, incoming)
+
+	// Pay 5e7 with a low fee and the change back to the same address.
+	orig := wire.NewMsgTx(constants.TxVersion)
+	in := wire.NewTxIn(&wire.OutPoint{Hash: incoming.TxHash()}, nil, nil)
+	in.Sequence = constants.MaxRBFSequence
+	orig.AddTxIn(in)
+	orig.AddTxOut(wire.NewTxOut(50000000, payeeScript))
+	orig.AddTxOut(wire.NewTxOut(50000000-200, pkScript))
+	v := incoming.TxOut[0].Value
+	orig.Additional = []wire.TxInAdditional{{PkScript: pkScript, Value: &v}}
+	util.RequireNoErr(t, w.signBumpTx(orig))
+	_, err = w.ReliablyPublishTransaction(orig, "pay bob")
+	util.RequireNoErr(t, err)
+	origHash := orig.TxHash()
+
+	_, err = w.BumpFee(&origHash, 100, BumpFeeRBF)
+	util.RequireErr(t, err)
+
+	res, err := w.BumpFee(&origHash, 5000, BumpFeeAuto)
+	util.RequireNoErr(t, err)
+	if res.Mode != BumpFeeRBF {
+		t.Fatalf("expected rbf, got %s", res.Mode)
+	}
+	if res.FeePerKb < 5000 {
+		t.Fatalf("expected a fee rate of at least 5000, got %d", res.FeePerKb)
+	}
+	if res.Tx.TxOut[0].Value != 50000000 {
+		t.Fatalf("the payment was changed to %d", res.Tx.TxOut[0].Value)
+	}
+	if res.Tx.TxOut[1].Value != 50000000-int64(res.Fee) {
+		t.Fatalf("expected change of %d, got %d",
+			50000000-int64(res.Fee), res.Tx.TxOut[1].Value)
+	}
+	replHash := res.Tx.TxHash()
+
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		if details, err := w.TxStore.TxDetails(ns, &origHash); err != nil {
+			return err
+		} else if details != nil {
+			t.Fatal("the original transaction was not removed")
+		}
+		replaced, err := w.TxStore.ReplacedTx(ns, &origHash)
+		if err != nil {
+			return err
+		} else if replaced == nil || replaced.ReplacedBy != replHash {
+			t.Fatalf("the replacement was not recorded: %v", replaced)
+		}
+		details, err := w.TxStore.TxDetails(ns, &replHash)
+		if err != nil {
+			return err
+		} else if details == nil || details.Label != "pay bob" {
+			t.Fatalf("the replacement was not stored with its label: %v", details)
+		}
+		return nil
+	})
+	util.RequireNoErr(t, err)
+
+	_, err = w.BumpFee(&origHash, 10000, BumpFeeAuto)
+	if !NotBumpableError.Is(err) {
+		t.Fatalf("expected NotBumpableError, got %v", err)
+	}
+
+	res, err = w.BumpFee(&replHash, 20000, BumpFeeCPFP)
+	util.RequireNoErr(t, err)
+	if res.Mode != BumpFeeCPFP {
+		t.Fatalf("expected cpfp, got %s", res.Mode)
+	}
+	if res.FeePerKb < 20000 {
+		t.Fatalf("expected a package fee rate of at least 20000, got %d", res.FeePerKb)
+	}
+	if res.Tx.TxIn[0].PreviousOutPoint != (wire.OutPoint{Hash: replHash, Index: 1}) {
+		t.Fatalf("the child does not spend the change, it spends %v",
+			res.Tx.TxIn[0].PreviousOutPoint)
+	}
+
+	// Now that the change is spent, the parent cannot be replaced without
+	// also replacing the child.
+	_, err = w.BumpFee(&replHash, 30000, BumpFeeRBF)
+	if !NotBumpableError.Is(err) {
+		t.Fatalf("expected NotBumpableError, got %v", err)
+	}
+}
+
+// TestBumpFeeAutoCPFP tests that when the change of a transaction is too small
+// to pay a higher fee, BumpFeeAuto spends another output of it instead.
+func TestBumpFeeAutoCPFP(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	util.RequireNoErr(t, err)
+	other, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	otherScript, err := txscript.PayToAddrScript(other)
+	util.RequireNoErr(t, err)
+
+	incoming := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(100000000, pkScript)},
+	}
+	addUtxo(t, w, incoming)
+
+	// Pay almost everything to another address of the wallet, with a
+	// change of 1000 back to the same address.
+	orig := wire.NewMsgTx(constants.TxVersion)
+	in := wire.NewTxIn(&wire.OutPoint{Hash: incoming.TxHash()}, nil, nil)
+	in.Sequence = constants.MaxRBFSequence
+	orig.AddTxIn(in)
+	orig.AddTxOut(wire.NewTxOut(100000000-2000, otherScript))
+	orig.AddTxOut(wire.NewTxOut(1000, pkScript))
+	v := incoming.TxOut[0].Value
+	orig.Additional = []wire.TxInAdditional{{PkScript: pkScript, Value: &v}}
+	util.RequireNoErr(t, w.signBumpTx(orig))
+	_, err = w.ReliablyPublishTransaction(orig, "")
+	util.RequireNoErr(t, err)
+	origHash := orig.TxHash()
+
+	_, err = w.BumpFee(&origHash, 50000, BumpFeeRBF)
+	if !InsufficientFundsError.Is(err) {
+		t.Fatalf("expected InsufficientFundsError, got %v", err)
+	}
+	res, err := w.BumpFee(&origHash, 50000, BumpFeeAuto)
+	util.RequireNoErr(t, err)
+	if res.Mode != BumpFeeCPFP {
+		t.Fatalf("expected cpfp, got %s", res.Mode)
+	}
+	if res.Tx.TxIn[0].PreviousOutPoint != (wire.OutPoint{Hash: origHash, Index: 0}) {
+		t.Fatalf("the child does not spend the largest output, it spends %v",
+			res.Tx.TxIn[0].PreviousOutPoint)
+	}
+}
@@ -127,6 +127,15 @@ func (w *Wallet) addRelevantTx(dbtx walletdb.ReadWriteTx, rec *wtxmgr.TxRecord,
 				if err != nil {
 					return err
 				}
+				// Accounts with a gap limit derive more addresses as
+				// they are used, they must be watched to see payments.
+				extended, err := w.Manager.ExtendGap(addrmgrNs, addr)
+				if err != nil {
+					return err
+				}
+				for _, ema := range extended {
+					w.watch.WatchAddr(ema.Address())
+				}
 				txOutAmt := btcutil.Amount(rec.MsgTx.TxOut[i].Value)
 				if !isNew {
 					// don't log when we see the same money again
@@ -162,6 +171,10 @@ func (w *Wallet) addRelevantTx(dbtx walletdb.ReadWriteTx, rec *wtxmgr.TxRecord,
 		}
 	}
 
+	if err := w.trackWebhookTx(dbtx, &rec.Hash, block); err != nil {
+		return err
+	}
+
 	// Send notification of mined or unmined transaction to any interested
 	// clients.
 	//
@@ -0,0 +1,406 @@
+package wallet
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/internal/txsizes"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/dbstructs"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// CoinSelection is a strategy for choosing which outputs are spent by a new
+// transaction.
+type CoinSelection uint8
+
+const (
+	// CoinSelectDefault groups the outputs by address and spends from the
+	// addresses in the order given by the InputComparator.
+	CoinSelectDefault CoinSelection = iota
+
+	// CoinSelectAuto tries bnb, largestfirst and minfuturefees and uses the
+	// selection which results in the least waste.
+	CoinSelectAuto
+
+	// CoinSelectBnB searches (branch and bound) for a set of outputs which
+	// pays for the transaction without needing a change output.
+	CoinSelectBnB
+
+	// CoinSelectPrivacy only spends outputs of one address, so that the
+	// transaction does not link addresses of the wallet together.
+	CoinSelectPrivacy
+
+	// CoinSelectMinFutureFees consolidates small outputs when fees are low
+	// and spends as few outputs as possible when they are high.
+	CoinSelectMinFutureFees
+
+	// CoinSelectLargestFirst spends the largest outputs first.
+	CoinSelectLargestFirst
+)
+
+var coinSelectionNames = []string{
+	CoinSelectDefault:       "default",
+	CoinSelectAuto:          "auto",
+	CoinSelectBnB:           "bnb",
+	CoinSelectPrivacy:       "privacy",
+	CoinSelectMinFutureFees: "minfuturefees",
+	CoinSelectLargestFirst:  "largestfirst",
+}
+
+func (c CoinSelection) String() string {
+	if int(c) < len(coinSelectionNames) {
+		return coinSelectionNames[c]
+	}
+	return "unknown"
+}
+
+// ParseCoinSelection returns the coin selection strategy with the given name,
+// an empty name is the default strategy.
+func ParseCoinSelection(name string) (CoinSelection, er.R) {
+	if name == "" {
+		return CoinSelectDefault, nil
+	}
+	for i, n := range coinSelectionNames {
+		if strings.EqualFold(n, name) {
+			return CoinSelection(i), nil
+		}
+	}
+	return CoinSelectDefault, er.Errorf("unknown coin selection strategy [%s], "+
+		"expecting one of [%s]", name, strings.Join(coinSelectionNames, ", "))
+}
+
+// CoinSelectionError is returned when the requested coin selection strategy is
+// unable to find a set of outputs, even though the wallet might have enough
+// coins to make the transaction with another strategy.
+var CoinSelectionError = er.GenericErrorType.CodeWithDetail("CoinSelectionError",
+	"the coin selection strategy was unable to select outputs for the transaction")
+
+// Maximum number of branches which are visited by the branch and bound search
+// before it settles for the best solution found so far.
+const bnbMaxTries = 100000
+
+type inputCounts struct {
+	p2pkh  int
+	p2wpkh int
+	nested int
+}
+
+func (c *inputCounts) add(pkScript []byte, n int) {
+	switch {
+	case txscript.IsPayToScriptHash(pkScript):
+		c.nested += n
+	case txscript.IsPayToWitnessPubKeyHash(pkScript):
+		c.p2wpkh += n
+	default:
+		c.p2pkh += n
+	}
+}
+
+// Virtual size of the witness of a P2WPKH input, rounded down so that the
+// effective values of the candidates are never underestimated and the search
+// doesn't skip over a selection which fits.
+const p2wpkhWitnessVSize = txsizes.RedeemP2WPKHInputWitnessWeight / 4
+
+// inputVSize is the virtual size which an input spending pkScript adds to a
+// transaction.
+func inputVSize(pkScript []byte) int {
+	switch {
+	case txscript.IsPayToScriptHash(pkScript):
+		return txsizes.RedeemNestedP2WPKHInputSize + p2wpkhWitnessVSize
+	case txscript.IsPayToWitnessPubKeyHash(pkScript):
+		return txsizes.RedeemP2WPKHInputSize + p2wpkhWitnessVSize
+	default:
+		return txsizes.RedeemP2PKHInputSize
+	}
+}
+
+// feeForVSize is the fee for vsize at the rate, without the minimum fee which
+// is applied by txrules.FeeForSerializeSize.
+func feeForVSize(feePerKb btcutil.Amount, vsize int) btcutil.Amount {
+	return feePerKb * btcutil.Amount(vsize) / 1000
+}
+
+type coinCandidate struct {
+	uns   *dbstructs.Unspent
+	vsize int
+
+	// The value of the output less the fee which it costs to spend it.
+	effValue btcutil.Amount
+}
+
+type coinSelection struct {
+	strategy CoinSelection
+	inputs   []*dbstructs.Unspent
+	waste    btcutil.Amount
+}
+
+type coinSelector struct {
+	outputs  []*wire.TxOut
+	target   btcutil.Amount
+	feePerKb btcutil.Amount
+	longTerm btcutil.Amount
+	limit    int
+}
+
+// evaluate checks whether the inputs pay for the transaction, the same way as
+// txauthor.NewUnsignedTransaction does, and computes the waste of the selection.
+func (s *coinSelector) evaluate(inputs []*coinCandidate) (btcutil.Amount, bool) {
+	if len(inputs) == 0 || len(inputs) > s.limit {
+		return 0, false
+	}
+	var counts inputCounts
+	sum := btcutil.Amount(0)
+	var wasteInputs btcutil.Amount
+	for _, c := range inputs {
+		counts.add(c.uns.PkScript, 1)
+		sum += btcutil.Amount(c.uns.Value)
+		wasteInputs += feeForVSize(s.feePerKb, c.vsize) - feeForVSize(s.longTerm, c.vsize)
+	}
+	if counts.p2pkh > 0 && len(inputs) > MaxInputsPerTxLegacy {
+		return 0, false
+	}
+	fee := txrules.FeeForSerializeSize(s.feePerKb, txsizes.EstimateVirtualSize(
+		counts.p2pkh, counts.p2wpkh, counts.nested, s.outputs, true))
+	if sum < s.target+fee {
+		return 0, false
+	}
+	return wasteInputs + s.excessWaste(sum-s.target-fee), true
+}
+
+// makesChange is whether txauthor.NewUnsignedTransaction adds a change output
+// for what remains after the outputs and the fee are paid.
+func makesChange(excess btcutil.Amount) bool {
+	return excess != 0 && !txrules.IsDustAmount(excess,
+		txsizes.P2WPKHPkScriptSize, txrules.DefaultRelayFeePerKb)
+}
+
+// excessWaste is the part of the waste which comes from what remains after the
+// outputs and the fee are paid. If it makes a change output, the waste is the
+// cost of spending that output later, otherwise the excess is paid as fee.
+func (s *coinSelector) excessWaste(excess btcutil.Amount) btcutil.Amount {
+	if makesChange(excess) {
+		return feeForVSize(s.longTerm, txsizes.RedeemP2WPKHInputSize+p2wpkhWitnessVSize)
+	}
+	return excess
+}
+
+// accumulate adds candidates in order until the transaction is paid for.
+func (s *coinSelector) accumulate(strategy CoinSelection, cands []*coinCandidate) *coinSelection {
+	for i := range cands {
+		if i >= s.limit {
+			break
+		}
+		if waste, ok := s.evaluate(cands[:i+1]); ok {
+			return s.result(strategy, cands[:i+1], waste)
+		}
+	}
+	return nil
+}
+
+func (s *coinSelector) result(
+	strategy CoinSelection,
+	cands []*coinCandidate,
+	waste btcutil.Amount,
+) *coinSelection {
+	out := &coinSelection{strategy: strategy, waste: waste}
+	for _, c := range cands {
+		out.inputs = append(out.inputs, c.uns)
+	}
+	return out
+}
+
+// bnb does a depth first search for a set of candidates whose effective value
+// lands between the amount needed and the amount which would make a change
+// output, so the transaction needs no change. The selection with the least
+// waste wins.
+func (s *coinSelector) bnb(cands []*coinCandidate) *coinSelection {
+	// Each selection in the window is checked with evaluate() because the
+	// effective values only approximate the fee.
+	baseSize := txsizes.EstimateVirtualSize(0, 0, 0, s.outputs, true)
+	need := s.target + feeForVSize(s.feePerKb, baseSize)
+	upper := need + txrules.GetDustThreshold(txsizes.P2WPKHPkScriptSize, txrules.DefaultRelayFeePerKb)
+
+	remaining := btcutil.Amount(0)
+	for _, c := range cands {
+		remaining += c.effValue
+	}
+
+	var best *coinSelection
+	var selected []*coinCandidate
+	tries := 0
+	var search func(i int, sum, remaining btcutil.Amount)
+	search = func(i int, sum, remaining btcutil.Amount) {
+		if tries >= bnbMaxTries {
+			return
+		}
+		tries++
+		if sum >= upper {
+			return
+		} else if sum >= need {
+			if waste, ok := s.evaluate(selected); ok &&
+				(best == nil || waste < best.waste) &&
+				!makesChange(s.excess(selected)) {
+				best = s.result(CoinSelectBnB, selected, waste)
+			}
+			return
+		} else if i >= len(cands) || sum+remaining < need || len(selected) >= s.limit {
+			return
+		}
+
+		// Include the candidate
+		selected = append(selected, cands[i])
+		search(i+1, sum+cands[i].effValue, remaining-cands[i].effValue)
+		selected = selected[:len(selected)-1]
+
+		// Exclude it, along with the candidates of equal value because
+		// including them would give the same result.
+		j := i
+		for ; j < len(cands) && cands[j].effValue == cands[i].effValue; j++ {
+			remaining -= cands[j].effValue
+		}
+		search(j, sum, remaining)
+	}
+	search(0, 0, remaining)
+	return best
+}
+
+// excess is what remains of the inputs after paying the outputs and the fee.
+func (s *coinSelector) excess(inputs []*coinCandidate) btcutil.Amount {
+	var counts inputCounts
+	sum := btcutil.Amount(0)
+	for _, c := range inputs {
+		counts.add(c.uns.PkScript, 1)
+		sum += btcutil.Amount(c.uns.Value)
+	}
+	return sum - s.target - txrules.FeeForSerializeSize(s.feePerKb, txsizes.EstimateVirtualSize(
+		counts.p2pkh, counts.p2wpkh, counts.nested, s.outputs, true))
+}
+
+// privacy selects the outputs of whichever single address can pay for the
+// transaction with the least waste.
+func (s *coinSelector) privacy(cands []*coinCandidate) *coinSelection {
+	byAddr := make(map[string][]*coinCandidate)
+	var addrs []string
+	for _, c := range cands {
+		if _, ok := byAddr[c.uns.Address]; !ok {
+			addrs = append(addrs, c.uns.Address)
+		}
+		byAddr[c.uns.Address] = append(byAddr[c.uns.Address], c)
+	}
+	var best *coinSelection
+	for _, a := range addrs {
+		res := s.accumulate(CoinSelectPrivacy, byAddr[a])
+		if res != nil && (best == nil || res.waste < best.waste) {
+			best = res
+		}
+	}
+	return best
+}
+
+// minFutureFees spends the smallest outputs first while the fee rate is no
+// higher than the long term rate, so they need not be spent later at a higher
+// rate, otherwise it spends the largest outputs first.
+func (s *coinSelector) minFutureFees(cands []*coinCandidate) *coinSelection {
+	if s.feePerKb > s.longTerm {
+		return s.accumulate(CoinSelectMinFutureFees, cands)
+	}
+	smallest := make([]*coinCandidate, len(cands))
+	for i, c := range cands {
+		smallest[len(cands)-1-i] = c
+	}
+	return s.accumulate(CoinSelectMinFutureFees, smallest)
+}
+
+// selectCoins chooses the outputs to spend using the given strategy, it returns
+// nil if the strategy could not find a selection.
+func selectCoins(
+	strategy CoinSelection,
+	unspents []*dbstructs.Unspent,
+	outputs []*wire.TxOut,
+	feePerKb btcutil.Amount,
+	longTerm btcutil.Amount,
+	maxInputs int,
+) *coinSelection {
+	s := coinSelector{
+		outputs:  outputs,
+		feePerKb: feePerKb,
+		longTerm: longTerm,
+		limit:    MaxInputsPerTx,
+	}
+	if maxInputs > 0 {
+		s.limit = maxInputs
+	}
+	for _, o := range outputs {
+		s.target += btcutil.Amount(o.Value)
+	}
+
+	// Outputs which cost more to spend than they are worth are never used.
+	cands := make([]*coinCandidate, 0, len(unspents))
+	for _, uns := range unspents {
+		c := &coinCandidate{uns: uns, vsize: inputVSize(uns.PkScript)}
+		c.effValue = btcutil.Amount(uns.Value) - feeForVSize(feePerKb, c.vsize)
+		if c.effValue > 0 {
+			cands = append(cands, c)
+		}
+	}
+	sort.SliceStable(cands, func(i, j int) bool {
+		return cands[i].effValue > cands[j].effValue
+	})
+
+	switch strategy {
+	case CoinSelectBnB:
+		return s.bnb(cands)
+	case CoinSelectPrivacy:
+		return s.privacy(cands)
+	case CoinSelectMinFutureFees:
+		return s.minFutureFees(cands)
+	case CoinSelectLargestFirst:
+		return s.accumulate(CoinSelectLargestFirst, cands)
+	case CoinSelectAuto:
+		var best *coinSelection
+		for _, res := range []*coinSelection{
+			s.bnb(cands),
+			s.accumulate(CoinSelectLargestFirst, cands),
+			s.minFutureFees(cands),
+		} {
+			if res != nil && (best == nil || res.waste < best.waste) {
+				best = res
+			}
+		}
+		return best
+	}
+	return nil
+}
+
+// transactionWaste computes the waste of a transaction which has been
+// authored: the difference between the fee paid for the inputs now and what
+// they would cost at the long term fee rate, plus either the cost of spending
+// the change later or the excess which was paid as fee.
+func transactionWaste(tx *txauthor.AuthoredTx, feePerKb, longTerm btcutil.Amount) btcutil.Amount {
+	var counts inputCounts
+	waste := btcutil.Amount(0)
+	for _, add := range tx.Tx.Additional {
+		counts.add(add.PkScript, 1)
+		vsize := inputVSize(add.PkScript)
+		waste += feeForVSize(feePerKb, vsize) - feeForVSize(longTerm, vsize)
+	}
+	outputs := make([]*wire.TxOut, 0, len(tx.Tx.TxOut))
+	target := btcutil.Amount(0)
+	for i, o := range tx.Tx.TxOut {
+		if i != tx.ChangeIndex {
+			outputs = append(outputs, o)
+			target += btcutil.Amount(o.Value)
+		}
+	}
+	if tx.ChangeIndex >= 0 {
+		return waste + feeForVSize(longTerm, inputVSize(tx.Tx.TxOut[tx.ChangeIndex].PkScript))
+	}
+	fee := txrules.FeeForSerializeSize(feePerKb, txsizes.EstimateVirtualSize(
+		counts.p2pkh, counts.p2wpkh, counts.nested, outputs, true))
+	return waste + tx.TotalInput - target - fee
+}
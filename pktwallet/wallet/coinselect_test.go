@@ -0,0 +1,181 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/internal/txsizes"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/dbstructs"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+func testUnspent(addr string, value int64, index uint32) *dbstructs.Unspent {
+	pkScript := append([]byte{0x00, 0x14}, make([]byte, 20)...)
+	pkScript[2] = byte(len(addr))
+	return &dbstructs.Unspent{
+		OutPoint: wire.OutPoint{Index: index},
+		Address:  addr,
+		Value:    value,
+		PkScript: pkScript,
+	}
+}
+
+// TestSelectCoins checks that each of the strategies finds a selection which
+// pays for the transaction with the properties it promises.
+func TestSelectCoins(t *testing.T) {
+	rate := txrules.DefaultRelayFeePerKb
+	pkt := int64(btcutil.UnitsPerCoin())
+	out := []*wire.TxOut{wire.NewTxOut(0, make([]byte, txsizes.P2WPKHPkScriptSize))}
+	fee2 := txrules.FeeForSerializeSize(rate, txsizes.EstimateVirtualSize(0, 2, 0, out, true))
+
+	// Two of the coins pay for the output exactly.
+	unspents := []*dbstructs.Unspent{
+		testUnspent("a", 10*pkt, 0),
+		testUnspent("a", 3*pkt, 1),
+		testUnspent("b", 2*pkt, 2),
+		testUnspent("b", pkt/2, 3),
+	}
+	out[0].Value = 5*pkt - int64(fee2)
+
+	sel := selectCoins(CoinSelectBnB, unspents, out, rate, rate, 0)
+	if sel == nil || len(sel.inputs) != 2 ||
+		sel.inputs[0] != unspents[1] || sel.inputs[1] != unspents[2] {
+		t.Fatalf("expected bnb to select the coins of 3 and 2 PKT, got %v", sel)
+	}
+	if sel.waste != 0 {
+		t.Fatalf("expected no waste from an exact match, got %d", sel.waste)
+	}
+
+	lf := selectCoins(CoinSelectLargestFirst, unspents, out, rate, rate, 0)
+	if lf == nil || len(lf.inputs) != 1 || lf.inputs[0] != unspents[0] {
+		t.Fatalf("expected largestfirst to select the coin of 10 PKT, got %v", lf)
+	}
+	if lf.waste <= sel.waste {
+		t.Fatalf("expected making change to waste more than an exact match")
+	}
+
+	auto := selectCoins(CoinSelectAuto, unspents, out, rate, rate, 0)
+	if auto == nil || auto.strategy != CoinSelectBnB || auto.waste != sel.waste {
+		t.Fatalf("expected auto to choose bnb, got %v", auto)
+	}
+
+	// Only the coins of a can pay 11 PKT.
+	out[0].Value = 11 * pkt
+	priv := selectCoins(CoinSelectPrivacy, unspents, out, rate, rate, 0)
+	if priv == nil || len(priv.inputs) != 2 {
+		t.Fatalf("expected privacy to select both coins of a, got %v", priv)
+	}
+	for _, in := range priv.inputs {
+		if in.Address != "a" {
+			t.Fatalf("privacy selected a coin of address %s", in.Address)
+		}
+	}
+	out[0].Value = 14 * pkt
+	if priv := selectCoins(CoinSelectPrivacy, unspents, out, rate, rate, 0); priv != nil {
+		t.Fatalf("expected no single address to pay 14 PKT, got %v", priv)
+	}
+
+	// While fees are low, the small coins are consolidated.
+	out[0].Value = pkt
+	mff := selectCoins(CoinSelectMinFutureFees, unspents, out, rate, rate, 0)
+	if mff == nil || mff.inputs[0] != unspents[3] || len(mff.inputs) != 2 {
+		t.Fatalf("expected minfuturefees to spend the smallest coins, got %v", mff)
+	}
+	mff = selectCoins(CoinSelectMinFutureFees, unspents, out, rate*10, rate, 0)
+	if mff == nil || len(mff.inputs) != 1 || mff.inputs[0] != unspents[0] {
+		t.Fatalf("expected minfuturefees to spend the largest coin, got %v", mff)
+	}
+}
+
+// TestSelectCoinsWaste checks the waste when the fee rate is not the long term
+// fee rate: spending an input costs more now than later while fees are high,
+// and less while fees are low, so auto picks fewer or more inputs.
+func TestSelectCoinsWaste(t *testing.T) {
+	pkt := int64(btcutil.UnitsPerCoin())
+	unspents := []*dbstructs.Unspent{
+		testUnspent("a", 10*pkt, 0),
+		testUnspent("a", 3*pkt, 1),
+		testUnspent("b", 2*pkt, 2),
+		testUnspent("b", pkt/2, 3),
+	}
+	out := []*wire.TxOut{wire.NewTxOut(pkt, make([]byte, txsizes.P2WPKHPkScriptSize))}
+	vsize := inputVSize(unspents[0].PkScript)
+	low := txrules.DefaultRelayFeePerKb
+	high := 10 * low
+
+	// While fees are high the fewest inputs win, the waste is what the input
+	// costs above the long term rate plus spending the change later.
+	auto := selectCoins(CoinSelectAuto, unspents, out, high, low, 0)
+	if auto == nil || auto.strategy != CoinSelectLargestFirst || len(auto.inputs) != 1 {
+		t.Fatalf("expected auto to spend the largest coin, got %v", auto)
+	}
+	if expected := feeForVSize(high, vsize); auto.waste != expected {
+		t.Fatalf("expected waste of %d, got %d", expected, auto.waste)
+	}
+
+	// Spending the two smallest coins while fees are low saves more than
+	// the change will cost.
+	auto = selectCoins(CoinSelectAuto, unspents, out, low, high, 0)
+	if auto == nil || auto.strategy != CoinSelectMinFutureFees || len(auto.inputs) != 2 {
+		t.Fatalf("expected auto to consolidate the smallest coins, got %v", auto)
+	}
+	if expected := 2*feeForVSize(low, vsize) - feeForVSize(high, vsize); auto.waste != expected {
+		t.Fatalf("expected waste of %d, got %d", expected, auto.waste)
+	}
+}
+
+// TestTxToOutputsCoinSelection checks that the strategy and the waste are
+// reported on the authored transaction.
+func TestTxToOutputsCoinSelection(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.Start()
+	defer w.Stop()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	util.RequireNoErr(t, err)
+
+	pkt := int64(btcutil.UnitsPerCoin())
+	for _, v := range []int64{10 * pkt, 3 * pkt, 2 * pkt} {
+		addUtxo(t, w, &wire.MsgTx{
+			TxIn:  []*wire.TxIn{{}},
+			TxOut: []*wire.TxOut{wire.NewTxOut(v, pkScript)},
+		})
+	}
+
+	rate := txrules.DefaultRelayFeePerKb
+	out := []*wire.TxOut{wire.NewTxOut(0, pkScript)}
+	fee2 := txrules.FeeForSerializeSize(rate, txsizes.EstimateVirtualSize(0, 2, 0, out, true))
+	out[0].Value = 5*pkt - int64(fee2)
+
+	txr := CreateTxReq{
+		Outputs:       out,
+		Minconf:       1,
+		FeeSatPerKB:   rate,
+		SendMode:      SendModeUnsigned,
+		CoinSelection: CoinSelectAuto,
+	}
+	tx, err := w.txToOutputs(txr)
+	util.RequireNoErr(t, err)
+	if tx.CoinSelection != "bnb" || tx.ChangeIndex != -1 || len(tx.Tx.TxIn) != 2 {
+		t.Fatalf("expected a changeless bnb transaction, got [%s] with %d inputs",
+			tx.CoinSelection, len(tx.Tx.TxIn))
+	}
+	if tx.Waste != 0 {
+		t.Fatalf("expected no waste, got %d", tx.Waste)
+	}
+
+	txr.CoinSelection = CoinSelectDefault
+	tx, err = w.txToOutputs(txr)
+	util.RequireNoErr(t, err)
+	if tx.CoinSelection != "default" || tx.Waste <= 0 {
+		t.Fatalf("expected the default selection to make change, got [%s] waste %d",
+			tx.CoinSelection, tx.Waste)
+	}
+}
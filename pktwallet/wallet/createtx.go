@@ -8,6 +8,7 @@ package wallet
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/unspent"
 	"github.com/pkt-cash/pktd/txscript"
 	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
 )
 
 // Maximum number of inputs which will be included in a transaction
@@ -139,13 +141,46 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 		return nil, err
 	}
 
+	// Unless the inputs are explicitly chosen, coins which belong to
+	// watch-only accounts can only be selected for unsigned transactions,
+	// and only if the caller doesn't exclude them.
+	var excludeAddrs map[string]struct{}
+	if len(txr.InputAddresses) == 0 &&
+		(txr.SendMode != SendModeUnsigned || txr.ExcludeWatchOnly) {
+		if excludeAddrs, err = w.watchOnlyAddresses(addrmgrNs); err != nil {
+			return nil, err
+		}
+	}
+
+	longTermFee := txr.LongTermFeeSatPerKB
+	if longTermFee == 0 {
+		longTermFee = txrules.DefaultRelayFeePerKb
+	}
+
 	isEnough := enough.MkIsEnough(txr.Outputs, txr.FeeSatPerKB)
 	t0 := time.Now()
-	eligibleOuts, visits, err := w.findEligibleOutputs(
-		dbtx, isEnough, txr.InputAddresses, txr.Minconf, bs,
-		txr.InputMinHeight, txr.InputComparator, txr.MaxInputs)
-	if err != nil {
-		return nil, err
+	var eligibleOuts eligibleOutputs
+	var visits int
+	var selection *coinSelection
+	// The strategies assume that the inputs pay the fee.
+	if txr.CoinSelection != CoinSelectDefault && !isEnough.IsSweeping() &&
+		len(txr.SubtractFeeFrom) == 0 {
+		eligibleOuts, visits, selection, err = w.selectEligibleOutputs(
+			dbtx, &txr, excludeAddrs, bs, longTermFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if selection == nil {
+		// Either the default strategy was requested or the strategy did
+		// not find enough coins, in which case the default strategy will
+		// explain what is wrong or make a partial transaction.
+		eligibleOuts, visits, err = w.findEligibleOutputs(
+			dbtx, isEnough, txr.InputAddresses, excludeAddrs, txr.Minconf, bs,
+			txr.InputMinHeight, txr.InputComparator, txr.MaxInputs)
+		if err != nil {
+			return nil, err
+		}
 	}
 	log.Infof("findEligibleOutputs() completed in [%s], visited [%d] utxos",
 		time.Since(t0).String(), visits)
@@ -166,6 +201,13 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 	}
 
 	inputSource := makeInputSource(eligibleOuts.credits)
+	if selection != nil {
+		// All of the selected inputs are spent, even if fewer would do.
+		allInputs := inputSource
+		inputSource = func(btcutil.Amount) (btcutil.Amount, []*wire.TxIn, []wire.TxInAdditional, er.R) {
+			return allInputs(btcutil.Amount(math.MaxInt64))
+		}
+	}
 	changeSource := func() ([]byte, er.R) {
 		// Derive the change output script.  As a hack to allow
 		// spending from the imported account, change addresses are
@@ -190,8 +232,8 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 		}
 		return txscript.PayToAddrScript(changeAddr)
 	}
-	tx, err = txauthor.NewUnsignedTransaction(
-		txr.Outputs, txr.FeeSatPerKB, inputSource, changeSource, txr.MaxInputs > -1)
+	tx, err = txauthor.NewUnsignedTransactionSubtractFee(txr.Outputs, txr.FeeSatPerKB,
+		inputSource, changeSource, txr.MaxInputs > -1, txr.SubtractFeeFrom)
 	if err != nil {
 		if !txauthor.ImpossibleTxError.Is(err) {
 			return nil, err
@@ -214,6 +256,12 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 		}
 	}
 
+	tx.CoinSelection = CoinSelectDefault.String()
+	if selection != nil {
+		tx.CoinSelection = selection.strategy.String()
+	}
+	tx.Waste = transactionWaste(tx, txr.FeeSatPerKB, longTermFee)
+
 	// Randomize change position, if change exists, before signing.  This
 	// doesn't affect the serialize size, so the change amount will still
 	// be valid.
@@ -221,6 +269,35 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 		tx.RandomizeChangePosition()
 	}
 
+	// Signal that the transaction can be replaced by fee (BIP-125), this
+	// must be done before signing because the sequence numbers are signed.
+	if txr.Replaceable {
+		for _, in := range tx.Tx.TxIn {
+			in.Sequence = constants.MaxRBFSequence
+		}
+	}
+
+	// Time locks are signed too. The lock time is only enforced if an input
+	// has a sequence number below the maximum, and a relative lock time
+	// needs version 2 (BIP-68).
+	if txr.Sequence != 0 {
+		if txr.Sequence&constants.SequenceLockTimeDisabled != 0 {
+			return nil, er.Errorf("sequence [%08x] disables the relative lock time", txr.Sequence)
+		}
+		tx.Tx.Version = 2
+		for _, in := range tx.Tx.TxIn {
+			in.Sequence = txr.Sequence
+		}
+	}
+	if txr.LockTime != 0 {
+		tx.Tx.LockTime = txr.LockTime
+		for _, in := range tx.Tx.TxIn {
+			if in.Sequence == constants.MaxTxInSequenceNum {
+				in.Sequence = constants.MaxTxInSequenceNum - 1
+			}
+		}
+	}
+
 	// If a dry run was requested, we return now before adding the input
 	// scripts, and don't commit the database transaction. The DB will be
 	// rolled back when this method returns to ensure the dry run didn't
@@ -351,9 +428,9 @@ func PreferBiggest(a, b interface{}) int {
 }
 
 // PreferSmallest prefers smallest (coin value) outputs first (spend the dust)
-// func PreferSmallest(a, b interface{}) int {
-// 	return -PreferBiggest(a, b)
-// }
+func PreferSmallest(a, b interface{}) int {
+	return -PreferBiggest(a, b)
+}
 
 func convertResult(ac *amountCount) []*dbstructs.Unspent {
 	ifaces := ac.credits.Keys()
@@ -375,37 +452,37 @@ type eligibleOutputs struct {
 	unusedAmt        btcutil.Amount
 }
 
-func (w *Wallet) findEligibleOutputs(
+// forEachSpendableOutput calls f with each unspent output which may be used as
+// an input of a new transaction. Outputs which are locked, immature, burned or
+// lacking confirmations are skipped, and burned outputs are deleted afterwards.
+func (w *Wallet) forEachSpendableOutput(
 	dbtx walletdb.ReadWriteTx,
-	isEnough enough.IsEnough,
 	fromAddresses []btcutil.Address,
+	excludeAddrs map[string]struct{},
 	minconf int32,
 	bs *waddrmgr.BlockStamp,
 	inputMinHeight int,
-	inputComparator utils.Comparator,
-	maxInputs int,
-) (eligibleOutputs, int, er.R) {
-	out := eligibleOutputs{}
+	out *eligibleOutputs,
+	f func(uns *dbstructs.Unspent) er.R,
+) (int, er.R) {
 	chainClient, err := w.requireChainClient()
 	if err != nil {
-		return out, 0, err
+		return 0, err
 	}
 	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 
-	haveAmounts := make(map[string]*amountCount)
-	var winner *amountCount
-
 	var burnedOutputs []wire.OutPoint
 
-	log.Debugf("Looking for unspents to build transaction")
-
 	addrStrs := make(map[string]struct{})
 	for _, a := range fromAddresses {
 		addrStrs[a.String()] = struct{}{}
 	}
 
-	var visits int
-	if visits, err = w.TxStore.ForEachUnspentOutput(txmgrNs, nil, addrStrs, func(key []byte, uns *dbstructs.Unspent) er.R {
+	visits, err := w.TxStore.ForEachUnspentOutput(txmgrNs, nil, addrStrs, func(key []byte, uns *dbstructs.Unspent) er.R {
+
+		if _, ok := excludeAddrs[uns.Address]; ok {
+			return nil
+		}
 
 		if uns.Block.Height >= 0 && uns.Block.Height < int32(inputMinHeight) {
 			log.Debugf("Skipping output %s at height %d because it is below minimum %d",
@@ -455,92 +532,169 @@ func (w *Wallet) findEligibleOutputs(
 			return nil
 		}
 
-		ha := haveAmounts[uns.Address]
-		if ha == nil {
-			haa := amountCount{}
-			if inputComparator == nil {
-				// If the user does not specify a comparator, we use the preferBiggest
-				// comparator to prefer high value outputs over less valuable outputs.
-				//
-				// Without this, there would be a risk that the wallet collected a bunch
-				// of dust and then - using arbitrary ordering - could not remove the dust
-				// inputs to ever make the transaction small enough, despite having large
-				// spendable outputs.
-				//
-				// This does NOT cause the default behavior of the wallet to prefer large
-				// outputs over small, because with no explicit comparator, we short circuit
-				// as soon as we have enough money to make the transaction.
-				haa.credits = redblacktree.NewWith(PreferBiggest)
-			} else {
-				haa.credits = redblacktree.NewWith(inputComparator)
-			}
-			if addr, err := btcutil.DecodeAddress(uns.Address, w.chainParams); err != nil {
-				log.Warnf("Unable to decode address [%s] from utxo [%s]", uns.Address, uns.OutPoint.String())
-			} else {
-				haa.isSegwit = addr.IsSegwit()
+		return f(uns)
+	})
+	if err != nil && !er.IsLoopBreak(err) {
+		return visits, err
+	}
+
+	if len(burnedOutputs) > 0 {
+		wtxmgrBucket := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		log.Infof("Deleting [%s] burned coins", log.Int(len(burnedOutputs)))
+		for _, op := range burnedOutputs {
+			if err := unspent.Delete(wtxmgrBucket, &op); err != nil {
+				return visits, err
 			}
-			ha = &haa
-			haveAmounts[uns.Address] = ha
 		}
-		ha.credits.Put(uns, nil)
-		ha.amount += btcutil.Amount(uns.Value)
-		if isEnough.WellIsIt(ha.credits.Size(), ha.isSegwit, ha.amount) {
-			worst := ha.credits.Right().Key.(*dbstructs.Unspent)
-			if worst == nil {
-				panic("findEligibleOutputs: worst == nil")
+	}
+	return visits, nil
+}
+
+// selectEligibleOutputs gathers all of the outputs which may be spent and
+// chooses among them using the coin selection strategy of the request. If the
+// strategy finds no selection because there are not enough coins, the returned
+// selection is nil.
+func (w *Wallet) selectEligibleOutputs(
+	dbtx walletdb.ReadWriteTx,
+	txr *CreateTxReq,
+	excludeAddrs map[string]struct{},
+	bs *waddrmgr.BlockStamp,
+	longTermFee btcutil.Amount,
+) (eligibleOutputs, int, *coinSelection, er.R) {
+	out := eligibleOutputs{}
+	var unspents []*dbstructs.Unspent
+	visits, err := w.forEachSpendableOutput(dbtx, txr.InputAddresses, excludeAddrs,
+		txr.Minconf, bs, txr.InputMinHeight, &out, func(uns *dbstructs.Unspent) er.R {
+			unspents = append(unspents, uns)
+			return nil
+		})
+	if err != nil {
+		return out, visits, nil, err
+	}
+
+	selection := selectCoins(txr.CoinSelection, unspents, txr.Outputs,
+		txr.FeeSatPerKB, longTermFee, txr.MaxInputs)
+	if selection != nil {
+		out.credits = selection.inputs
+		return out, visits, selection, nil
+	}
+
+	// If there are enough coins but the strategy can't use them, we must
+	// not fall back to some other strategy.
+	if selectCoins(CoinSelectLargestFirst, unspents, txr.Outputs,
+		txr.FeeSatPerKB, longTermFee, txr.MaxInputs) == nil {
+
+		return out, visits, nil, nil
+	}
+	switch txr.CoinSelection {
+	case CoinSelectBnB:
+		return out, visits, nil, CoinSelectionError.New(
+			"no combination of coins pays for the transaction without making change", nil)
+	case CoinSelectPrivacy:
+		return out, visits, nil, CoinSelectionError.New(
+			"no single address has enough coins to pay for the transaction", nil)
+	}
+	return out, visits, nil, nil
+}
+
+func (w *Wallet) findEligibleOutputs(
+	dbtx walletdb.ReadWriteTx,
+	isEnough enough.IsEnough,
+	fromAddresses []btcutil.Address,
+	excludeAddrs map[string]struct{},
+	minconf int32,
+	bs *waddrmgr.BlockStamp,
+	inputMinHeight int,
+	inputComparator utils.Comparator,
+	maxInputs int,
+) (eligibleOutputs, int, er.R) {
+	out := eligibleOutputs{}
+
+	haveAmounts := make(map[string]*amountCount)
+	var winner *amountCount
+
+	log.Debugf("Looking for unspents to build transaction")
+
+	visits, err := w.forEachSpendableOutput(dbtx, fromAddresses, excludeAddrs, minconf, bs,
+		inputMinHeight, &out, func(uns *dbstructs.Unspent) er.R {
+
+			ha := haveAmounts[uns.Address]
+			if ha == nil {
+				haa := amountCount{}
+				if inputComparator == nil {
+					// If the user does not specify a comparator, we use the preferBiggest
+					// comparator to prefer high value outputs over less valuable outputs.
+					//
+					// Without this, there would be a risk that the wallet collected a bunch
+					// of dust and then - using arbitrary ordering - could not remove the dust
+					// inputs to ever make the transaction small enough, despite having large
+					// spendable outputs.
+					//
+					// This does NOT cause the default behavior of the wallet to prefer large
+					// outputs over small, because with no explicit comparator, we short circuit
+					// as soon as we have enough money to make the transaction.
+					haa.credits = redblacktree.NewWith(PreferBiggest)
+				} else {
+					haa.credits = redblacktree.NewWith(inputComparator)
+				}
+				if addr, err := btcutil.DecodeAddress(uns.Address, w.chainParams); err != nil {
+					log.Warnf("Unable to decode address [%s] from utxo [%s]", uns.Address, uns.OutPoint.String())
+				} else {
+					haa.isSegwit = addr.IsSegwit()
+				}
+				ha = &haa
+				haveAmounts[uns.Address] = ha
 			}
-			if isEnough.WellIsIt(ha.credits.Size()-1, ha.isSegwit, ha.amount-btcutil.Amount(worst.Value)) {
-				// Our amount is still fine even if we drop the worst credit
-				// so we'll drop it and continue traversing to find the best outputs
-				ha.credits.Remove(worst)
-				ha.amount -= btcutil.Amount(worst.Value)
-				out.unusedAmt += btcutil.Amount(worst.Value)
-				out.unusedCount++
+			ha.credits.Put(uns, nil)
+			ha.amount += btcutil.Amount(uns.Value)
+			if isEnough.WellIsIt(ha.credits.Size(), ha.isSegwit, ha.amount) {
+				worst := ha.credits.Right().Key.(*dbstructs.Unspent)
+				if worst == nil {
+					panic("findEligibleOutputs: worst == nil")
+				}
+				if isEnough.WellIsIt(ha.credits.Size()-1, ha.isSegwit, ha.amount-btcutil.Amount(worst.Value)) {
+					// Our amount is still fine even if we drop the worst credit
+					// so we'll drop it and continue traversing to find the best outputs
+					ha.credits.Remove(worst)
+					ha.amount -= btcutil.Amount(worst.Value)
+					out.unusedAmt += btcutil.Amount(worst.Value)
+					out.unusedCount++
+				}
+
+				// If we have no explicit sorting specified then we can short-circuit
+				// and avoid table-scanning the whole db
+				if inputComparator == nil {
+					winner = ha
+					return er.LoopBreak
+				}
 			}
 
-			// If we have no explicit sorting specified then we can short-circuit
-			// and avoid table-scanning the whole db
-			if inputComparator == nil {
+			if !ha.overLimit(maxInputs) {
+				// We don't have too many inputs
+			} else if isEnough.IsSweeping() && inputComparator == nil {
+				// We're sweeping the wallet with no ordering specified
+				// This means we should just short-circuit with a winner
 				winner = ha
 				return er.LoopBreak
+			} else {
+				// Too many inputs, we will remove the worst
+				worst := ha.credits.Right().Key.(*dbstructs.Unspent)
+				if worst == nil {
+					panic("findEligibleOutputs: worst == nil")
+				}
+				ha.credits.Remove(worst)
+				ha.amount -= btcutil.Amount(worst.Value)
+				out.unusedAmt += btcutil.Amount(worst.Value)
+				out.unusedCount++
 			}
-		}
-
-		if !ha.overLimit(maxInputs) {
-			// We don't have too many inputs
-		} else if isEnough.IsSweeping() && inputComparator == nil {
-			// We're sweeping the wallet with no ordering specified
-			// This means we should just short-circuit with a winner
-			winner = ha
-			return er.LoopBreak
-		} else {
-			// Too many inputs, we will remove the worst
-			worst := ha.credits.Right().Key.(*dbstructs.Unspent)
-			if worst == nil {
-				panic("findEligibleOutputs: worst == nil")
-			}
-			ha.credits.Remove(worst)
-			ha.amount -= btcutil.Amount(worst.Value)
-			out.unusedAmt += btcutil.Amount(worst.Value)
-			out.unusedCount++
-		}
-		return nil
-	}); err != nil && !er.IsLoopBreak(err) {
+			return nil
+		})
+	if err != nil {
 		return out, visits, err
 	}
 
 	log.Debugf("Got unspents")
 
-	if len(burnedOutputs) > 0 {
-		wtxmgrBucket := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
-		log.Infof("Deleting [%s] burned coins", log.Int(len(burnedOutputs)))
-		for _, op := range burnedOutputs {
-			if err := unspent.Delete(wtxmgrBucket, &op); err != nil {
-				return out, visits, err
-			}
-		}
-	}
-
 	if inputComparator != nil {
 		// This is a special consideration because when there is a custom comparator,
 		// we don't short circuit early so we might have a winner on our hands but not
@@ -0,0 +1,368 @@
+package wallet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/enough"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/dbstructs"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"google.golang.org/protobuf/proto"
+)
+
+// Mining payouts leave a wallet with a great many small coinbase outputs which
+// make normal sends fail or produce huge transactions. The fold job spends the
+// small outputs of one address back to the same address, one size-bounded
+// transaction at a time, until there is nothing left to fold or the fee budget
+// is spent. The state of the job is stored in the wallet db so that it
+// continues after a restart.
+
+var (
+	wfoldNamespaceKey = []byte("wfold")
+	foldStatusKey     = []byte("status")
+)
+
+const (
+	defaultFoldMinInputs = 20
+	defaultFoldMaxInputs = 400
+	defaultFoldInterval  = 60
+)
+
+var FoldBudgetError = er.GenericErrorType.CodeWithDetail("FoldBudgetError",
+	"the fold transaction would exceed the fee budget")
+
+type foldJob struct {
+	loaded      bool
+	status      *rpc_pb.WalletFoldStatus
+	lastAttempt time.Time
+}
+
+// FoldResult describes one transaction made by the fold job.
+type FoldResult struct {
+	Tx      *wire.MsgTx
+	Address string
+	Inputs  int
+	Fee     btcutil.Amount
+}
+
+// foldJobIn runs f with the state of the fold job, which is loaded from the
+// database the first time.
+func (w *Wallet) foldJobIn(f func(j *foldJob) er.R) er.R {
+	return w.fold.In(func(j *foldJob) er.R {
+		if !j.loaded {
+			st, err := w.loadFoldStatus()
+			if err != nil {
+				return err
+			}
+			j.status = st
+			j.loaded = true
+		}
+		return f(j)
+	})
+}
+
+func (w *Wallet) loadFoldStatus() (*rpc_pb.WalletFoldStatus, er.R) {
+	st := &rpc_pb.WalletFoldStatus{}
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		b := tx.ReadBucket(wfoldNamespaceKey)
+		if b == nil {
+			return nil
+		}
+		if v := b.Get(foldStatusKey); v != nil {
+			if err := proto.Unmarshal(v, st); err != nil {
+				return er.E(err)
+			}
+		}
+		return nil
+	})
+	return st, err
+}
+
+func (w *Wallet) storeFoldStatus(st *rpc_pb.WalletFoldStatus) er.R {
+	v, errr := proto.Marshal(st)
+	if errr != nil {
+		return er.E(errr)
+	}
+	return walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		b, err := tx.CreateTopLevelBucket(wfoldNamespaceKey)
+		if err != nil {
+			return err
+		}
+		return b.Put(foldStatusKey, v)
+	})
+}
+
+// foldConfigWithDefaults checks the fold configuration and returns a copy with
+// the zero values replaced by the defaults.
+func (w *Wallet) foldConfigWithDefaults(conf *rpc_pb.WalletFoldConfig) (*rpc_pb.WalletFoldConfig, er.R) {
+	out := &rpc_pb.WalletFoldConfig{}
+	if conf != nil {
+		out = proto.Clone(conf).(*rpc_pb.WalletFoldConfig)
+	}
+	if out.MaxValue == 0 {
+		out.MaxValue = uint64(btcutil.UnitsPerCoin())
+	}
+	if out.MinInputs == 0 {
+		out.MinInputs = defaultFoldMinInputs
+	}
+	if out.MaxInputs == 0 {
+		out.MaxInputs = defaultFoldMaxInputs
+	}
+	if out.MinInputs < 2 {
+		return nil, er.New("min_inputs must be at least 2")
+	} else if out.MaxInputs < out.MinInputs {
+		return nil, er.Errorf("max_inputs [%d] must not be less than min_inputs [%d]",
+			out.MaxInputs, out.MinInputs)
+	}
+	if out.FeePerKb == 0 {
+		out.FeePerKb = uint64(txrules.DefaultRelayFeePerKb)
+	}
+	if out.FeeBudget == 0 {
+		out.FeeBudget = uint64(btcutil.UnitsPerCoin())
+	}
+	if out.IntervalSec == 0 {
+		out.IntervalSec = defaultFoldInterval
+	}
+	for _, a := range out.Addresses {
+		if _, err := btcutil.DecodeAddress(a, w.chainParams); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// StartFold starts the fold job with the given configuration, if the job is
+// already running then it is restarted with the new configuration.
+func (w *Wallet) StartFold(conf *rpc_pb.WalletFoldConfig) (*rpc_pb.WalletFoldStatus, er.R) {
+	conf, err := w.foldConfigWithDefaults(conf)
+	if err != nil {
+		return nil, err
+	}
+	var out *rpc_pb.WalletFoldStatus
+	err = w.foldJobIn(func(j *foldJob) er.R {
+		st := &rpc_pb.WalletFoldStatus{
+			Running: true,
+			Config:  conf,
+		}
+		if err := w.storeFoldStatus(st); err != nil {
+			return err
+		}
+		j.status = st
+		j.lastAttempt = time.Time{}
+		out = proto.Clone(st).(*rpc_pb.WalletFoldStatus)
+		return nil
+	})
+	return out, err
+}
+
+// StopFold stops the fold job, transactions which it has already made are not
+// affected.
+func (w *Wallet) StopFold() (*rpc_pb.WalletFoldStatus, er.R) {
+	var out *rpc_pb.WalletFoldStatus
+	err := w.foldJobIn(func(j *foldJob) er.R {
+		if j.status.Running {
+			j.status.Running = false
+			j.status.StoppedReason = "stopped by request"
+			if err := w.storeFoldStatus(j.status); err != nil {
+				return err
+			}
+		}
+		out = proto.Clone(j.status).(*rpc_pb.WalletFoldStatus)
+		return nil
+	})
+	return out, err
+}
+
+// FoldStatus returns the state of the fold job.
+func (w *Wallet) FoldStatus() (*rpc_pb.WalletFoldStatus, er.R) {
+	var out *rpc_pb.WalletFoldStatus
+	err := w.foldJobIn(func(j *foldJob) er.R {
+		out = proto.Clone(j.status).(*rpc_pb.WalletFoldStatus)
+		return nil
+	})
+	return out, err
+}
+
+// foldCoins is called from the main loop, if the fold job is running and its
+// interval has passed, it makes one fold transaction.
+func (w *Wallet) foldCoins() {
+	if !w.ChainSynced() {
+		return
+	}
+	var conf *rpc_pb.WalletFoldConfig
+	var remaining btcutil.Amount
+	if err := w.foldJobIn(func(j *foldJob) er.R {
+		st := j.status
+		if !st.Running || st.Config == nil {
+			return nil
+		}
+		interval := time.Duration(st.Config.IntervalSec) * time.Second
+		if time.Since(j.lastAttempt) < interval {
+			return nil
+		}
+		j.lastAttempt = time.Now()
+		conf = proto.Clone(st.Config).(*rpc_pb.WalletFoldConfig)
+		if st.FeesSpent < st.Config.FeeBudget {
+			remaining = btcutil.Amount(st.Config.FeeBudget - st.FeesSpent)
+		}
+		return nil
+	}); err != nil {
+		log.Warnf("Unable to load the state of the fold job: [%s]", err)
+		return
+	} else if conf == nil {
+		return
+	}
+
+	res, foldErr := w.FoldOnce(conf, remaining)
+
+	if err := w.foldJobIn(func(j *foldJob) er.R {
+		st := j.status
+		if FoldBudgetError.Is(foldErr) {
+			st.Running = false
+			st.StoppedReason = "fee budget exhausted"
+		}
+		if foldErr != nil {
+			st.LastError = foldErr.Message()
+		} else {
+			st.LastError = ""
+		}
+		if res != nil {
+			st.FeesSpent += uint64(res.Fee)
+			st.Transactions++
+			st.InputsFolded += uint64(res.Inputs)
+			st.LastTxid = res.Tx.TxHash().String()
+			st.LastFoldTime = time.Now().Unix()
+		}
+		return w.storeFoldStatus(st)
+	}); err != nil {
+		log.Warnf("Unable to store the state of the fold job: [%s]", err)
+	}
+}
+
+// FoldOnce makes one fold transaction, spending the smallest outputs of the
+// address which has the most outputs worth less than conf.MaxValue back to the
+// same address. If the fee of the transaction would be more than budget then it
+// is not published and FoldBudgetError is returned. If no address has at least
+// conf.MinInputs small outputs, nil is returned.
+func (w *Wallet) FoldOnce(conf *rpc_pb.WalletFoldConfig, budget btcutil.Amount) (*FoldResult, er.R) {
+	conf, err := w.foldConfigWithDefaults(conf)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make(map[string]struct{})
+	for _, a := range conf.Addresses {
+		addrs[a] = struct{}{}
+	}
+
+	// Count the foldable outputs of each address, this uses the same rules
+	// as findEligibleOutputs so that the outputs which are counted are the
+	// ones which will be selected.
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+	bs, err := chainClient.BestBlock()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, err := w.TxStore.ForEachUnspentOutput(txmgrNs, nil, addrs, func(_ []byte, uns *dbstructs.Unspent) er.R {
+			if uns.Value < 0 || uint64(uns.Value) >= conf.MaxValue {
+				return nil
+			}
+			if !confirmed(1, uns.Block.Height, bs.Height) {
+				return nil
+			}
+			if uns.FromCoinBase {
+				if !confirmed(int32(w.chainParams.CoinbaseMaturity), uns.Block.Height, bs.Height) {
+					return nil
+				} else if txrules.IsBurned(uns, w.chainParams, bs.Height+1440) {
+					return nil
+				}
+			}
+			if w.LockedOutpoint(uns.OutPoint) {
+				return nil
+			}
+			counts[uns.Address]++
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	best := ""
+	for a, c := range counts {
+		if c > counts[best] || (c == counts[best] && a < best) {
+			best = a
+		}
+	}
+	if counts[best] < int(conf.MinInputs) {
+		return nil, nil
+	}
+
+	addr, err := btcutil.DecodeAddress(best, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	inputs := counts[best]
+	if inputs > int(conf.MaxInputs) {
+		inputs = int(conf.MaxInputs)
+	}
+	if addr.IsSegwit() && inputs >= MaxInputsPerTx {
+		inputs = MaxInputsPerTx - 1
+	} else if !addr.IsSegwit() && inputs >= MaxInputsPerTxLegacy {
+		inputs = MaxInputsPerTxLegacy - 1
+	}
+
+	// Sweeping the address with PreferSmallest and MaxInputs keeps only the
+	// smallest outputs, which are exactly the ones which were counted.
+	tx, err := w.CreateSimpleTx(CreateTxReq{
+		InputAddresses:  []btcutil.Address{addr},
+		Outputs:         []*wire.TxOut{wire.NewTxOut(enough.SweepOutputAmount, pkScript)},
+		Minconf:         1,
+		FeeSatPerKB:     btcutil.Amount(conf.FeePerKb),
+		SendMode:        SendModeSigned,
+		InputComparator: PreferSmallest,
+		MaxInputs:       inputs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var outputs btcutil.Amount
+	for _, out := range tx.Tx.TxOut {
+		outputs += btcutil.Amount(out.Value)
+	}
+	fee := tx.TotalInput - outputs
+	if fee > budget {
+		return nil, FoldBudgetError.New(
+			fmt.Sprintf("fee of [%s] with [%s] remaining in the budget", fee, budget), nil)
+	}
+
+	label := fmt.Sprintf("fold %d outputs", len(tx.Tx.TxIn))
+	if _, err := w.ReliablyPublishTransaction(tx.Tx, label); err != nil {
+		return nil, err
+	}
+	log.Infof("Folded [%d] outputs of [%s] in [%s], fee [%s]",
+		len(tx.Tx.TxIn), log.Address(best), log.Txid(tx.Tx.TxHash().String()), fee)
+	return &FoldResult{
+		Tx:      tx.Tx,
+		Address: best,
+		Inputs:  len(tx.Tx.TxIn),
+		Fee:     fee,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/lock"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestFoldOnce folds the small outputs of an address, leaving large and locked
+// outputs alone, and checks that the state of the fold job is persisted.
+func TestFoldOnce(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.Start()
+	defer w.Stop()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	util.RequireNoErr(t, err)
+
+	var small []wire.OutPoint
+	for i := 0; i < 30; i++ {
+		incoming := &wire.MsgTx{
+			TxIn:  []*wire.TxIn{{}},
+			TxOut: []*wire.TxOut{wire.NewTxOut(int64(1000000+i), pkScript)},
+		}
+		addUtxo(t, w, incoming)
+		small = append(small, wire.OutPoint{Hash: incoming.TxHash()})
+	}
+	big := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(int64(btcutil.UnitsPerCoin())*2, pkScript)},
+	}
+	addUtxo(t, w, big)
+	if err := w.LockOutpoint(small[0], "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only 29 small outputs are unlocked.
+	res, err := w.FoldOnce(&rpc_pb.WalletFoldConfig{MinInputs: 30}, btcutil.UnitsPerCoin())
+	util.RequireNoErr(t, err)
+	if res != nil {
+		t.Fatalf("expected nothing to fold, folded %d outputs", res.Inputs)
+	}
+
+	conf := &rpc_pb.WalletFoldConfig{MinInputs: 10, MaxInputs: 20}
+	_, err = w.FoldOnce(conf, 1)
+	if !FoldBudgetError.Is(err) {
+		t.Fatalf("expected FoldBudgetError, got %v", err)
+	}
+
+	res, err = w.FoldOnce(conf, btcutil.UnitsPerCoin())
+	util.RequireNoErr(t, err)
+	if res == nil || res.Inputs != 20 {
+		t.Fatalf("expected 20 outputs to be folded, got %v", res)
+	}
+	if res.Address != addr.String() {
+		t.Fatalf("expected outputs of %s to be folded, got %s", addr, res.Address)
+	}
+	if len(res.Tx.TxOut) != 1 || !bytes.Equal(res.Tx.TxOut[0].PkScript, pkScript) {
+		t.Fatal("the fold transaction does not pay back to the same address")
+	}
+	for _, in := range res.Tx.TxIn {
+		if in.PreviousOutPoint == small[0] {
+			t.Fatal("a locked output was folded")
+		} else if in.PreviousOutPoint.Hash == big.TxHash() {
+			t.Fatal("a large output was folded")
+		}
+	}
+
+	// Starting the job fills in the defaults and the state survives a
+	// restart of the wallet.
+	_, err = w.StartFold(&rpc_pb.WalletFoldConfig{FeeBudget: 5000})
+	util.RequireNoErr(t, err)
+	w.fold = lock.NewGenMutex(foldJob{}, "fold")
+	st, err := w.FoldStatus()
+	util.RequireNoErr(t, err)
+	if !st.Running || st.Config.FeeBudget != 5000 ||
+		st.Config.MinInputs != defaultFoldMinInputs {
+		t.Fatalf("unexpected status after restart: %v", st)
+	}
+	st, err = w.StopFold()
+	util.RequireNoErr(t, err)
+	if st.Running {
+		t.Fatal("the fold job is still running")
+	}
+}
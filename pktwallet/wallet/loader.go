@@ -250,6 +250,16 @@ func (l *Loader) LoadedWallet() (*Wallet, bool) {
 // CreateNewWallet or LoadExistingWallet.  The Loader may be reused if this
 // function returns without error.
 func (l *Loader) UnloadWallet() er.R {
+	return l.unload((*Wallet).Stop)
+}
+
+// DetachWallet is the same as UnloadWallet except that the chain client of the
+// wallet is left running so that it can continue to be used by other wallets.
+func (l *Loader) DetachWallet() er.R {
+	return l.unload((*Wallet).Detach)
+}
+
+func (l *Loader) unload(stop func(*Wallet)) er.R {
 	defer l.mu.Unlock()
 	l.mu.Lock()
 
@@ -257,7 +267,7 @@ func (l *Loader) UnloadWallet() er.R {
 		return ErrNotLoaded.Default()
 	}
 
-	l.wallet.Stop()
+	stop(l.wallet)
 	l.wallet.WaitForShutdown()
 	err := l.db.Close()
 	if err != nil {
@@ -6,10 +6,15 @@ package wallet
 
 import (
 	"bytes"
+	"strconv"
 
+	"github.com/pkt-cash/pktd/blockchain"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/btcutil/psbt"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/internal/txsizes"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
 	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
@@ -37,6 +42,19 @@ import (
 func (w *Wallet) FundPsbt(packet *psbt.Packet, account uint32,
 	feeSatPerKB btcutil.Amount) (int32, er.R) {
 
+	return w.FundPsbtFrom(packet, account, nil, feeSatPerKB, "")
+}
+
+// FundPsbtFrom is the same as FundPsbt except that if coin selection is
+// performed, only coins paid to fromAddresses are selected.  This makes it
+// possible to fund a PSBT from a watch-only account, coins of watch-only
+// accounts are never selected otherwise. If autolock is not empty,
+// the inputs are locked under that name, selected coins are locked before any
+// other transaction can select coins so concurrent funding can't pick them.
+func (w *Wallet) FundPsbtFrom(packet *psbt.Packet, account uint32,
+	fromAddresses []btcutil.Address, feeSatPerKB btcutil.Amount,
+	autolock string) (int32, er.R) {
+
 	// Make sure the packet is well formed. We only require there to be at
 	// least one output but not necessarily any inputs.
 	err := psbt.VerifyInputOutputLen(packet, false, true)
@@ -112,10 +130,16 @@ func (w *Wallet) FundPsbt(packet *psbt.Packet, account uint32,
 		// includes everything we need, specifically fee estimation and
 		// change address creation.
 		tx, err = w.CreateSimpleTx(CreateTxReq{
-			FeeSatPerKB: feeSatPerKB,
-			Minconf:     1,
-			Outputs:     packet.UnsignedTx.TxOut,
-			SendMode:    SendModeBcasted,
+			InputAddresses: fromAddresses,
+			FeeSatPerKB:    feeSatPerKB,
+			Minconf:        1,
+			Outputs:        packet.UnsignedTx.TxOut,
+			SendMode:       SendModeUnsigned,
+			Autolock:       autolock,
+
+			// Coins of watch-only accounts can't be signed for, so they
+			// are only selected if the caller asks for their addresses.
+			ExcludeWatchOnly: true,
 		})
 		if err != nil {
 			return 0, er.Errorf("error creating funding TX: %v",
@@ -188,6 +212,12 @@ func (w *Wallet) FundPsbt(packet *psbt.Packet, account uint32,
 			return 0, er.Errorf("could not add change address to "+
 				"database: %v", err)
 		}
+
+		if autolock != "" {
+			if err := w.lockInputs(txIn, autolock); err != nil {
+				return 0, err
+			}
+		}
 	}
 
 	// If there is a change output, we need to copy it over to the PSBT now.
@@ -335,6 +365,287 @@ func (w *Wallet) FinalizePsbt(packet *psbt.Packet) er.R {
 	return nil
 }
 
+// psbtInputInfo looks up the output spent by input idx of the packet in the
+// wallet, checks it against the UTXO information of the PSBT input and fills
+// in the full previous transaction if it is missing. If the output does not
+// belong to the wallet, ErrNotMine is returned.
+func (w *Wallet) psbtInputInfo(packet *psbt.Packet, idx int) (*wire.TxOut, er.R) {
+	txIn := packet.UnsignedTx.TxIn[idx]
+	in := &packet.Inputs[idx]
+
+	fullTx, txOut, _, err := w.FetchInputInfo(&txIn.PreviousOutPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.NonWitnessUtxo == nil {
+		in.NonWitnessUtxo = fullTx
+	} else if in.NonWitnessUtxo.TxHash() != txIn.PreviousOutPoint.Hash {
+		return nil, er.Errorf("found UTXO tx %v but it doesn't match "+
+			"PSBT's input %v", in.NonWitnessUtxo.TxHash(),
+			txIn.PreviousOutPoint.Hash)
+	}
+
+	if in.WitnessUtxo != nil && !psbt.TxOutsEqual(txOut, in.WitnessUtxo) {
+		return nil, er.Errorf("found UTXO %#v but it doesn't match "+
+			"PSBT's input %v", txOut, in.WitnessUtxo)
+	}
+
+	return txOut, nil
+}
+
+// SignPsbt adds a partial signature to every input of the PSBT which spends an
+// output of this wallet for which the private key is available, filling in
+// the UTXO information of those inputs if it is missing. Inputs which are
+// already finalized, which belong to someone else, or which are watch-only are
+// left untouched. The indexes of the inputs which were signed are returned.
+//
+// Unlike FinalizePsbt, the wallet does not need to be the last signer, so the
+// resulting PSBT can be passed on to other signers and then combined and
+// finalized.
+func (w *Wallet) SignPsbt(packet *psbt.Packet) ([]uint32, er.R) {
+	err := psbt.VerifyInputOutputLen(packet, true, true)
+	if err != nil {
+		return nil, err
+	}
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := packet.UnsignedTx
+	sigHashes := txscript.NewTxSigHashes(tx)
+	signed := []uint32{}
+	for idx := range tx.TxIn {
+		in := &packet.Inputs[idx]
+		if len(in.FinalScriptSig) > 0 || len(in.FinalScriptWitness) > 0 {
+			continue
+		}
+
+		txOut, err := w.psbtInputInfo(packet, idx)
+		if ErrNotMine.Is(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		walletAddr, err := w.fetchOutputAddr(txOut.PkScript)
+		if err != nil {
+			return nil, err
+		}
+		pka, ok := walletAddr.(waddrmgr.ManagedPubKeyAddress)
+		if !ok {
+			continue
+		}
+		privKey, err := pka.PrivKey()
+		if waddrmgr.ErrWatchingOnly.Is(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		pubKey := privKey.PubKey().SerializeUncompressed()
+		if pka.Compressed() {
+			pubKey = privKey.PubKey().SerializeCompressed()
+		}
+		alreadySigned := false
+		for _, ps := range in.PartialSigs {
+			if bytes.Equal(ps.PubKey, pubKey) {
+				alreadySigned = true
+			}
+		}
+		if alreadySigned {
+			continue
+		}
+
+		if in.SighashType == 0 {
+			in.SighashType = params.SigHashAll
+		}
+
+		var sig, redeemScript []byte
+		switch pka.AddrType() {
+		case waddrmgr.NestedWitnessPubKey:
+			// The redeem script is the p2wkh witness program, which
+			// is also the subscript for the sighash digest.
+			p2wkhAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+				btcutil.Hash160(pubKey), w.chainParams,
+			)
+			if err != nil {
+				return nil, err
+			}
+			redeemScript, err = txscript.PayToAddrScript(p2wkhAddr)
+			if err != nil {
+				return nil, err
+			}
+			sig, err = txscript.RawTxInWitnessSignature(
+				tx, sigHashes, idx, txOut.Value, redeemScript,
+				in.SighashType, privKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+		case waddrmgr.WitnessPubKey:
+			sig, err = txscript.RawTxInWitnessSignature(
+				tx, sigHashes, idx, txOut.Value, txOut.PkScript,
+				in.SighashType, privKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+		case waddrmgr.PubKeyHash:
+			// This is not a witness input so it must only carry the
+			// full previous transaction, otherwise it would be
+			// checked and finalized as p2wkh.
+			in.WitnessUtxo = nil
+			sig, err = txscript.RawTxInSignature(
+				tx, idx, txOut.PkScript, in.SighashType, privKey,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			continue
+		}
+
+		if _, err := updater.Sign(idx, sig, pubKey, redeemScript, nil); err != nil {
+			return nil, er.Errorf("error signing input %d: %v", idx, err)
+		}
+		signed = append(signed, uint32(idx))
+	}
+
+	return signed, nil
+}
+
+// The roles of BIP174 in the order in which they act on a PSBT.
+var psbtRoles = []string{"updater", "signer", "finalizer", "extractor"}
+
+// AnalyzePsbt examines a PSBT without modifying it. For each input it reports
+// whether the previous output is known, whether it belongs to the wallet and
+// whether it is finalized, and which role must act on it next. It also
+// estimates the size and fee rate of the final transaction, assuming that any
+// unsigned input is a single-key p2pkh, p2wkh or nested p2wkh spend.
+func (w *Wallet) AnalyzePsbt(packet *psbt.Packet) (*rpc_pb.PsbtAnalyzeResponse, er.R) {
+	err := psbt.VerifyInputOutputLen(packet, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finalization is attempted on a copy of the packet, so that the
+	// caller's packet is not changed.
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	cp, err := psbt.NewFromRawBytes(&buf, false)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &rpc_pb.PsbtAnalyzeResponse{Sfee: "unknown"}
+	next := len(psbtRoles) - 1
+	canEstimate := true
+	for idx, txIn := range cp.UnsignedTx.TxIn {
+		in := &cp.Inputs[idx]
+		utxo := in.WitnessUtxo
+		if utxo == nil && in.NonWitnessUtxo != nil &&
+			int(txIn.PreviousOutPoint.Index) < len(in.NonWitnessUtxo.TxOut) {
+
+			utxo = in.NonWitnessUtxo.TxOut[txIn.PreviousOutPoint.Index]
+		}
+
+		a := &rpc_pb.PsbtInputAnalysis{HasUtxo: utxo != nil}
+		var walletAddr waddrmgr.ManagedAddress
+		if utxo != nil {
+			walletAddr, _ = w.fetchOutputAddr(utxo.PkScript)
+			a.IsMine = walletAddr != nil
+		}
+
+		role := 0
+		switch {
+		case len(in.FinalScriptSig) > 0 || len(in.FinalScriptWitness) > 0:
+			role = 3
+		case utxo == nil:
+			role = 0
+		default:
+			if ok, err := psbt.MaybeFinalize(cp, idx); err == nil && ok {
+				role = 2
+			} else {
+				role = 1
+			}
+		}
+		a.IsFinal = role == 3
+		a.Next = psbtRoles[role]
+		if role < next {
+			next = role
+		}
+		out.Inputs = append(out.Inputs, a)
+
+		if len(in.FinalScriptSig) > 0 || len(in.FinalScriptWitness) > 0 {
+			continue
+		} else if utxo == nil {
+			canEstimate = false
+			continue
+		}
+
+		// Fill in worst-case placeholder scripts so that the size of
+		// the final transaction can be computed.
+		nested := false
+		switch txscript.GetScriptClass(utxo.PkScript) {
+		case txscript.WitnessV0PubKeyHashTy:
+		case txscript.PubKeyHashTy:
+			in.FinalScriptSig = make([]byte, txsizes.RedeemP2PKHSigScriptSize)
+			continue
+		case txscript.ScriptHashTy:
+			if txscript.IsPayToWitnessPubKeyHash(in.RedeemScript) {
+				nested = true
+			} else if pka, ok := walletAddr.(waddrmgr.ManagedPubKeyAddress); ok &&
+				pka.AddrType() == waddrmgr.NestedWitnessPubKey {
+				nested = true
+			}
+			if !nested {
+				canEstimate = false
+				continue
+			}
+			in.FinalScriptSig = make([]byte, txsizes.RedeemNestedP2WPKHScriptSize)
+		default:
+			canEstimate = false
+			continue
+		}
+		var wit bytes.Buffer
+		if err := psbt.WriteTxWitness(&wit, [][]byte{make([]byte, 73), make([]byte, 33)}); err != nil {
+			return nil, err
+		}
+		in.FinalScriptWitness = wit.Bytes()
+	}
+	out.Next = psbtRoles[next]
+
+	if inputSum, err := psbt.SumUtxoInputValues(packet); err == nil {
+		fee := inputSum
+		for _, txOut := range packet.UnsignedTx.TxOut {
+			fee -= txOut.Value
+		}
+		out.Sfee = strconv.FormatInt(fee, 10)
+		if canEstimate {
+			finalTx, err := psbt.Extract(cp)
+			if err != nil {
+				return nil, err
+			}
+			weight := blockchain.GetTransactionWeight(btcutil.NewTx(finalTx))
+			vsize := (weight + blockchain.WitnessScaleFactor - 1) /
+				blockchain.WitnessScaleFactor
+			out.EstimatedVsize = int32(vsize)
+			if fee > 0 && vsize > 0 {
+				out.EstimatedFeePerKb = uint64(fee * 1000 / vsize)
+			}
+		}
+	}
+
+	return out, nil
+}
+
 // constantInputSource creates an input source function that always returns the
 // static set of user-selected UTXOs.
 func constantInputSource(eligible []wtxmgr.Credit) txauthor.InputSource {
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/pkt-cash/pktd/blockchain"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/psbt"
 	"github.com/pkt-cash/pktd/pktlog/log"
@@ -281,7 +282,9 @@ func TestFundPsbt(t *testing.T) {
 			// In any case, unlock the UTXO before continuing, we
 			// don't want to pollute other test iterations.
 			for _, in := range tc.packet.UnsignedTx.TxIn {
-				w.UnlockOutpoint(in.PreviousOutPoint)
+				if err := w.UnlockOutpoint(in.PreviousOutPoint); err != nil {
+					t.Fatal(err)
+				}
 			}
 
 			// Make sure the error is what we expected.
@@ -335,6 +338,29 @@ func TestFundPsbt(t *testing.T) {
 			}
 		})
 	}
+
+	// Funding doesn't sign, so it works while the wallet is locked.
+	w.Lock()
+	if !w.Locked() {
+		t.Fatalf("expected the wallet to be locked")
+	}
+	packet := &psbt.Packet{
+		UnsignedTx: &wire.MsgTx{
+			TxOut: []*wire.TxOut{wire.NewTxOut(100000, p2wkhAddr)},
+		},
+		Outputs: []psbt.POutput{{}},
+	}
+	if _, err := w.FundPsbt(packet, 0, 1000); err != nil {
+		t.Fatalf("unable to fund a PSBT with the wallet locked: %v", err)
+	}
+	for _, in := range packet.UnsignedTx.TxIn {
+		if len(in.Witness) > 0 || len(in.SignatureScript) > 0 {
+			t.Fatalf("expected the funded PSBT not to be signed")
+		}
+		if err := w.UnlockOutpoint(in.PreviousOutPoint); err != nil {
+			t.Fatal(err)
+		}
+	}
 }
 
 func assertTxInputs(t *testing.T, packet *psbt.Packet,
@@ -465,3 +491,114 @@ func TestFinalizePsbt(t *testing.T) {
 		t.Fatalf("error validating tx: %v", err)
 	}
 }
+
+// TestSignPsbt tests that the wallet adds partial signatures to its inputs
+// which can then be finalized by anyone, and that the analysis of the PSBT
+// follows its progress.
+func TestSignPsbt(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", addr)
+	}
+	p2wkhAddr, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to convert wallet address to p2wkh: %v", err)
+	}
+	addr, err = w.CurrentAddress(0, waddrmgr.KeyScopeBIP0049Plus)
+	if err != nil {
+		t.Fatalf("unable to get current address: %v", addr)
+	}
+	np2wkhAddr, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to convert wallet address to np2wkh: %v", err)
+	}
+
+	utxOutP2WKH := wire.NewTxOut(1000000, p2wkhAddr)
+	utxOutNP2WKH := wire.NewTxOut(1000000, np2wkhAddr)
+	incomingTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{utxOutP2WKH, utxOutNP2WKH},
+	}
+	addUtxo(t, w, incomingTx)
+
+	// The UTXO information is left out, the wallet fills it in.
+	packet, err := psbt.New(
+		[]*wire.OutPoint{
+			{Hash: incomingTx.TxHash(), Index: 0},
+			{Hash: incomingTx.TxHash(), Index: 1},
+		},
+		[]*wire.TxOut{{
+			PkScript: testScriptP2WKH,
+			Value:    1999000,
+		}},
+		2, 0, []uint32{0, 0},
+	)
+	if err != nil {
+		t.Fatalf("unable to create PSBT: %v", err)
+	}
+
+	analysis, err := w.AnalyzePsbt(packet)
+	if err != nil {
+		t.Fatalf("unable to analyze PSBT: %v", err)
+	}
+	if analysis.Next != "updater" || analysis.Sfee != "unknown" {
+		t.Fatalf("unexpected analysis of empty PSBT: %v", analysis)
+	}
+
+	signed, err := w.SignPsbt(packet)
+	if err != nil {
+		t.Fatalf("unable to sign PSBT: %v", err)
+	}
+	if len(signed) != 2 {
+		t.Fatalf("expected 2 signed inputs, got %v", signed)
+	}
+
+	// Signing again does not add duplicate signatures.
+	signed, err = w.SignPsbt(packet)
+	if err != nil {
+		t.Fatalf("unable to sign PSBT: %v", err)
+	}
+	if len(signed) != 0 {
+		t.Fatalf("expected no newly signed inputs, got %v", signed)
+	}
+
+	analysis, err = w.AnalyzePsbt(packet)
+	if err != nil {
+		t.Fatalf("unable to analyze PSBT: %v", err)
+	}
+	if analysis.Next != "finalizer" || analysis.Sfee != "1000" ||
+		analysis.EstimatedVsize == 0 {
+
+		t.Fatalf("unexpected analysis of signed PSBT: %v", analysis)
+	}
+	for _, in := range analysis.Inputs {
+		if !in.IsMine || !in.HasUtxo || in.IsFinal {
+			t.Fatalf("unexpected input analysis: %v", in)
+		}
+	}
+
+	if err := psbt.MaybeFinalizeAll(packet); err != nil {
+		t.Fatalf("unable to finalize PSBT: %v", err)
+	}
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		t.Fatalf("error extracting final TX from PSBT: %v", err)
+	}
+	err = validateMsgTx(
+		finalTx, [][]byte{utxOutP2WKH.PkScript, utxOutNP2WKH.PkScript},
+		[]btcutil.Amount{1000000, 1000000},
+	)
+	if err != nil {
+		t.Fatalf("error validating tx: %v", err)
+	}
+
+	// The estimate must not be smaller than the real transaction.
+	vsize := (blockchain.GetTransactionWeight(btcutil.NewTx(finalTx)) + 3) / 4
+	if int64(analysis.EstimatedVsize) < vsize {
+		t.Fatalf("estimated vsize %d is less than actual %d",
+			analysis.EstimatedVsize, vsize)
+	}
+}
@@ -0,0 +1,438 @@
+package wallet
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+	"google.golang.org/protobuf/proto"
+)
+
+// Scheduled transactions are fully signed transactions which are not final
+// yet, because of an nLockTime or a relative (CSV) lock time, so they can not
+// be relayed. They are kept in the wallet db, keyed by txid, and the schedule
+// loop broadcasts each one once the next block could contain it. The inputs
+// are locked in the meantime so that coin selection doesn't spend them, if
+// they are spent anyway the scheduled transaction fails.
+
+var wschedNamespaceKey = []byte("wsched")
+
+const (
+	ScheduleStatusPending   = "pending"
+	ScheduleStatusPublished = "published"
+	ScheduleStatusFailed    = "failed"
+	ScheduleStatusCancelled = "cancelled"
+)
+
+const (
+	scheduleLockName = "scheduled"
+	scheduleInterval = 30 * time.Second
+)
+
+func scheduleBucket(dbtx walletdb.ReadWriteTx) (walletdb.ReadWriteBucket, er.R) {
+	return dbtx.CreateTopLevelBucket(wschedNamespaceKey)
+}
+
+func getScheduled(b walletdb.ReadBucket, txid *chainhash.Hash) (*rpc_pb.ScheduledTransaction, er.R) {
+	v := b.Get(txid[:])
+	if v == nil {
+		return nil, nil
+	}
+	st := &rpc_pb.ScheduledTransaction{}
+	if err := proto.Unmarshal(v, st); err != nil {
+		return nil, er.E(err)
+	}
+	return st, nil
+}
+
+func putScheduled(b walletdb.ReadWriteBucket, st *rpc_pb.ScheduledTransaction) er.R {
+	txid, err := chainhash.NewHashFromStr(st.Txid)
+	if err != nil {
+		return err
+	}
+	v, errr := proto.Marshal(st)
+	if errr != nil {
+		return er.E(errr)
+	}
+	return b.Put(txid[:], v)
+}
+
+func loadScheduled(dbtx walletdb.ReadTx) ([]*rpc_pb.ScheduledTransaction, er.R) {
+	b := dbtx.ReadBucket(wschedNamespaceKey)
+	if b == nil {
+		return nil, nil
+	}
+	var out []*rpc_pb.ScheduledTransaction
+	err := b.ForEach(func(k, v []byte) er.R {
+		st := &rpc_pb.ScheduledTransaction{}
+		if err := proto.Unmarshal(v, st); err != nil {
+			return er.E(err)
+		}
+		out = append(out, st)
+		return nil
+	})
+	return out, err
+}
+
+func decodeScheduled(st *rpc_pb.ScheduledTransaction) (*wire.MsgTx, er.R) {
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(st.Tx)); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// scheduledInputs returns the wallet's credits which tx spends, it fails if an
+// input is not an unspent output of the wallet.
+func (w *Wallet) scheduledInputs(dbtx walletdb.ReadTx, tx *wire.MsgTx) ([]*wtxmgr.TxDetails, er.R) {
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	prevs := make([]*wtxmgr.TxDetails, 0, len(tx.TxIn))
+	for _, in := range tx.TxIn {
+		op := in.PreviousOutPoint
+		d, err := w.TxStore.TxDetails(txmgrNs, &op.Hash)
+		if err != nil {
+			return nil, err
+		}
+		var cred *wtxmgr.CreditRecord
+		if d != nil {
+			for i := range d.Credits {
+				if d.Credits[i].Index == op.Index {
+					cred = &d.Credits[i]
+				}
+			}
+		}
+		if cred == nil {
+			return nil, er.Errorf("input [%s] is not an output of the wallet", op)
+		} else if cred.Spent {
+			return nil, er.Errorf("input [%s] is already spent", op)
+		}
+		prevs = append(prevs, d)
+	}
+	return prevs, nil
+}
+
+// spentByScheduled tells whether tx itself spends one of its inputs, which is
+// the case if it was published but the wallet stopped before the schedule was
+// updated.
+func (w *Wallet) spentByScheduled(tx *wire.MsgTx) (bool, er.R) {
+	txid := tx.TxHash()
+	spent := false
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, in := range tx.TxIn {
+			for _, spender := range w.TxStore.OutputSpenders(txmgrNs, &in.PreviousOutPoint) {
+				if spender == txid {
+					spent = true
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	return spent, err
+}
+
+// txFinal tells whether tx could be in the next block, considering both its
+// nLockTime and the relative lock times of its inputs. The time of the tip is
+// used rather than the median time past, so a time locked transaction may be
+// tried a little early, in that case it is rejected and retried later.
+func (w *Wallet) txFinal(tx *wire.MsgTx) (bool, er.R) {
+	tip := w.Manager.SyncedTo()
+	nextHeight := tip.Height + 1
+	if !blockchain.IsFinalizedTransaction(btcutil.NewTx(tx), nextHeight, tip.Timestamp) {
+		return false, nil
+	}
+	if tx.Version < 2 {
+		return true, nil
+	}
+	final := true
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, in := range tx.TxIn {
+			seq := in.Sequence
+			if seq&constants.SequenceLockTimeDisabled != 0 {
+				continue
+			}
+			prev, err := w.TxStore.TxDetails(txmgrNs, &in.PreviousOutPoint.Hash)
+			if err != nil {
+				return err
+			} else if prev == nil || prev.Block.Height == -1 {
+				// The age of the input starts when it is mined.
+				final = false
+				return nil
+			}
+			lock := seq & constants.SequenceLockTimeMask
+			if seq&constants.SequenceLockTimeIsSeconds != 0 {
+				age := tip.Timestamp.Sub(prev.Block.Time)
+				if age < time.Duration(lock<<constants.SequenceLockTimeGranularity)*time.Second {
+					final = false
+				}
+			} else if nextHeight-prev.Block.Height < int32(lock) {
+				final = false
+			}
+		}
+		return nil
+	})
+	return final, err
+}
+
+// ScheduleTransaction stores a signed transaction which spends outputs of the
+// wallet, to be broadcast once it is final. Its inputs are locked until then.
+func (w *Wallet) ScheduleTransaction(tx *wire.MsgTx, label string) (*rpc_pb.ScheduledTransaction, er.R) {
+	if err := checkLabel(label); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	txid := tx.TxHash()
+	st := &rpc_pb.ScheduledTransaction{
+		Txid:       txid.String(),
+		Tx:         buf.Bytes(),
+		Label:      label,
+		Status:     ScheduleStatusPending,
+		LockTime:   tx.LockTime,
+		CreatedSec: time.Now().Unix(),
+	}
+	// The inputs are locked before the db is written, so that coin selection
+	// can't pick them while the transaction is being scheduled, and the locks
+	// are stored in the same db transaction as the schedule.
+	w.lockedOutpointsWriteMtx.Lock()
+	defer w.lockedOutpointsWriteMtx.Unlock()
+	locks := make([]wtxmgr.NamedLock, 0, len(tx.TxIn))
+	prevLocks := make(map[wire.OutPoint]wtxmgr.NamedLock)
+	w.lockedOutpointsMtx.Lock()
+	for _, in := range tx.TxIn {
+		l := wtxmgr.NamedLock{OutPoint: in.PreviousOutPoint, Name: scheduleLockName}
+		if old, ok := w.lockedOutpoints[l.OutPoint]; ok {
+			prevLocks[l.OutPoint] = old
+		}
+		w.lockedOutpoints[l.OutPoint] = l
+		locks = append(locks, l)
+	}
+	w.lockedOutpointsMtx.Unlock()
+
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		prevs, err := w.scheduledInputs(dbtx, tx)
+		if err != nil {
+			return err
+		}
+		prevScripts := make([][]byte, 0, len(prevs))
+		values := make([]btcutil.Amount, 0, len(prevs))
+		for i, d := range prevs {
+			out := d.MsgTx.TxOut[tx.TxIn[i].PreviousOutPoint.Index]
+			prevScripts = append(prevScripts, out.PkScript)
+			values = append(values, btcutil.Amount(out.Value))
+		}
+		if err := validateMsgTx(tx, prevScripts, values); err != nil {
+			return er.Errorf("transaction is not fully signed: %v", err)
+		}
+		b, err := scheduleBucket(dbtx)
+		if err != nil {
+			return err
+		}
+		if old, err := getScheduled(b, &txid); err != nil {
+			return err
+		} else if old != nil && old.Status == ScheduleStatusPending {
+			return er.Errorf("transaction [%s] is already scheduled", st.Txid)
+		}
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for i := range locks {
+			if err := w.TxStore.PutNamedLock(txmgrNs, &locks[i]); err != nil {
+				return err
+			}
+		}
+		return putScheduled(b, st)
+	}); err != nil {
+		w.lockedOutpointsMtx.Lock()
+		for _, l := range locks {
+			if old, ok := prevLocks[l.OutPoint]; ok {
+				w.lockedOutpoints[l.OutPoint] = old
+			} else {
+				delete(w.lockedOutpoints, l.OutPoint)
+			}
+		}
+		w.lockedOutpointsMtx.Unlock()
+		return nil, err
+	}
+	return st, nil
+}
+
+// ScheduledTransactions lists the scheduled transactions, optionally only
+// those with a given status.
+func (w *Wallet) ScheduledTransactions(req *rpc_pb.ScheduledTransactionsRequest) (*rpc_pb.ScheduledTransactions, er.R) {
+	var all []*rpc_pb.ScheduledTransaction
+	if err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		var err er.R
+		all, err = loadScheduled(dbtx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	out := &rpc_pb.ScheduledTransactions{}
+	for _, st := range all {
+		if req.Status == "" || req.Status == st.Status {
+			out.Transactions = append(out.Transactions, st)
+		}
+	}
+	return out, nil
+}
+
+// finishScheduled sets the status of a scheduled transaction which is no longer
+// pending and unlocks its inputs.
+func (w *Wallet) finishScheduled(st *rpc_pb.ScheduledTransaction, tx *wire.MsgTx) er.R {
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := scheduleBucket(dbtx)
+		if err != nil {
+			return err
+		}
+		return putScheduled(b, st)
+	}); err != nil {
+		return err
+	}
+	for _, in := range tx.TxIn {
+		if err := w.UnlockOutpoint(in.PreviousOutPoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CancelScheduledTransaction drops a pending scheduled transaction and unlocks
+// its inputs. The transaction is signed, so whoever has a copy of it could
+// still broadcast it, spend one of the inputs to be sure it never confirms.
+func (w *Wallet) CancelScheduledTransaction(txid *chainhash.Hash) (*rpc_pb.ScheduledTransaction, er.R) {
+	var st *rpc_pb.ScheduledTransaction
+	if err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		b := dbtx.ReadBucket(wschedNamespaceKey)
+		if b == nil {
+			return nil
+		}
+		var err er.R
+		st, err = getScheduled(b, txid)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, er.Errorf("no scheduled transaction [%s]", txid)
+	} else if st.Status != ScheduleStatusPending {
+		return nil, er.Errorf("scheduled transaction [%s] is %s", txid, st.Status)
+	}
+	tx, err := decodeScheduled(st)
+	if err != nil {
+		return nil, err
+	}
+	st.Status = ScheduleStatusCancelled
+	if err := w.finishScheduled(st, tx); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// runSchedule broadcasts the pending transactions which became final, and
+// fails those whose inputs were spent by something else. A transaction whose
+// inputs it spends itself was already published.
+func (w *Wallet) runSchedule() er.R {
+	var pending []*rpc_pb.ScheduledTransaction
+	if err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		all, err := loadScheduled(dbtx)
+		for _, st := range all {
+			if st.Status == ScheduleStatusPending {
+				pending = append(pending, st)
+			}
+		}
+		return err
+	}); err != nil {
+		return err
+	}
+	for _, st := range pending {
+		tx, err := decodeScheduled(st)
+		if err != nil {
+			return err
+		}
+		if err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+			_, err := w.scheduledInputs(dbtx, tx)
+			return err
+		}); err != nil {
+			if published, errr := w.spentByScheduled(tx); errr != nil {
+				return errr
+			} else if published {
+				log.Infof("Scheduled transaction [%s] was already published", st.Txid)
+				st.Status = ScheduleStatusPublished
+				if st.PublishedSec == 0 {
+					st.PublishedSec = time.Now().Unix()
+				}
+				st.LastError = ""
+				if err := w.finishScheduled(st, tx); err != nil {
+					return err
+				}
+				continue
+			}
+			st.Status = ScheduleStatusFailed
+			st.LastError = err.Message()
+			log.Warnf("Scheduled transaction [%s] failed: %s", st.Txid, st.LastError)
+			if err := w.finishScheduled(st, tx); err != nil {
+				return err
+			}
+			continue
+		}
+		if final, err := w.txFinal(tx); err != nil {
+			return err
+		} else if !final {
+			continue
+		}
+		if _, err := w.ReliablyPublishTransaction(tx, st.Label); err != nil {
+			// Leave it pending, it may be too early by the median time.
+			log.Infof("Unable to publish scheduled transaction [%s]: %v", st.Txid, err)
+			st.LastError = err.Message()
+			if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+				b, err := scheduleBucket(dbtx)
+				if err != nil {
+					return err
+				}
+				return putScheduled(b, st)
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+		log.Infof("Published scheduled transaction [%s]", st.Txid)
+		st.Status = ScheduleStatusPublished
+		st.PublishedSec = time.Now().Unix()
+		st.LastError = ""
+		if err := w.finishScheduled(st, tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scheduleLoop runs for as long as the wallet is started, broadcasting the
+// scheduled transactions when they become final.
+func (w *Wallet) scheduleLoop() {
+	defer w.wg.Done()
+	quit := w.quitChan()
+	t := time.NewTicker(scheduleInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-t.C:
+		}
+		if err := w.runSchedule(); err != nil {
+			log.Warnf("Unable to run scheduled transactions: %v", err)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// TestScheduleTransaction sends a transaction which is time locked a few
+// blocks ahead, checks that it is held with its input locked until the lock
+// time is reached, and that it can be cancelled and scheduled again.
+func TestScheduleTransaction(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.Start()
+	defer w.Stop()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	util.RequireNoErr(t, err)
+	incoming := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(int64(btcutil.UnitsPerCoin())*2, pkScript)},
+	}
+	addUtxo(t, w, incoming)
+	input := wire.OutPoint{Hash: incoming.TxHash()}
+
+	syncTo := func(height int32) {
+		util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			return w.Manager.SetSyncedTo(dbtx.ReadWriteBucket(waddrmgrNamespaceKey),
+				&waddrmgr.BlockStamp{Height: height, Hash: *testBlockHash, Timestamp: time.Now()})
+		}))
+	}
+	status := func(expect string) {
+		list, err := w.ScheduledTransactions(&rpc_pb.ScheduledTransactionsRequest{})
+		util.RequireNoErr(t, err)
+		if len(list.Transactions) != 1 || list.Transactions[0].Status != expect {
+			t.Fatalf("expected one %s transaction, got %v", expect, list)
+		}
+	}
+	syncTo(testBlockHeight + 10)
+
+	// Locked until the block after the tip.
+	lockTime := uint32(testBlockHeight + 12)
+	tx, err := w.SendOutputs(CreateTxReq{
+		Outputs:     []*wire.TxOut{wire.NewTxOut(100000000, pkScript)},
+		Minconf:     1,
+		FeeSatPerKB: 1000,
+		SendMode:    SendModeBcasted,
+		LockTime:    lockTime,
+		Label:       "rent",
+	})
+	util.RequireNoErr(t, err)
+	if !tx.Scheduled || tx.Tx.LockTime != lockTime || tx.Tx.TxIn[0].Sequence == constants.MaxTxInSequenceNum {
+		t.Fatalf("expected a scheduled time locked transaction, got %v", tx)
+	}
+	status(ScheduleStatusPending)
+	if !w.LockedOutpoint(input) {
+		t.Fatalf("expected the input of the scheduled transaction to be locked")
+	}
+	if _, err := w.ScheduleTransaction(tx.Tx, ""); err == nil {
+		t.Fatalf("expected a transaction not to be scheduled twice")
+	}
+
+	util.RequireNoErr(t, w.runSchedule())
+	status(ScheduleStatusPending)
+
+	txid := tx.Tx.TxHash()
+	_, err = w.CancelScheduledTransaction(&txid)
+	util.RequireNoErr(t, err)
+	status(ScheduleStatusCancelled)
+	if w.LockedOutpoint(input) {
+		t.Fatalf("expected the input of a cancelled transaction to be unlocked")
+	}
+	if _, err := w.CancelScheduledTransaction(&txid); err == nil {
+		t.Fatalf("expected a cancelled transaction not to be cancelled again")
+	}
+
+	_, err = w.ScheduleTransaction(tx.Tx, "rent")
+	util.RequireNoErr(t, err)
+	status(ScheduleStatusPending)
+
+	syncTo(int32(lockTime))
+	util.RequireNoErr(t, w.runSchedule())
+	status(ScheduleStatusPublished)
+	if w.LockedOutpoint(input) {
+		t.Fatalf("expected the input of a published transaction to be unlocked")
+	}
+	details, err := UnstableAPI(w).TxDetails(&txid)
+	util.RequireNoErr(t, err)
+	if details == nil || details.Label != "rent" {
+		t.Fatalf("expected the published transaction in the wallet, got %v", details)
+	}
+}
+
+// TestScheduledAlreadyPublished checks that a scheduled transaction which was
+// published before the wallet could record it, is found published rather than
+// failed because its inputs are spent.
+func TestScheduledAlreadyPublished(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.Start()
+	defer w.Stop()
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	util.RequireNoErr(t, err)
+	incoming := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(int64(btcutil.UnitsPerCoin())*2, pkScript)},
+	}
+	addUtxo(t, w, incoming)
+	input := wire.OutPoint{Hash: incoming.TxHash()}
+
+	tx, err := w.SendOutputs(CreateTxReq{
+		Outputs:     []*wire.TxOut{wire.NewTxOut(100000000, pkScript)},
+		Minconf:     1,
+		FeeSatPerKB: 1000,
+		SendMode:    SendModeSigned,
+	})
+	util.RequireNoErr(t, err)
+	_, err = w.ScheduleTransaction(tx.Tx, "")
+	util.RequireNoErr(t, err)
+	if !w.LockedOutpoint(input) {
+		t.Fatalf("expected the input of the scheduled transaction to be locked")
+	}
+
+	// The wallet stops after publishing, before the schedule is updated.
+	_, err = w.ReliablyPublishTransaction(tx.Tx, "")
+	util.RequireNoErr(t, err)
+	util.RequireNoErr(t, w.runSchedule())
+
+	list, err := w.ScheduledTransactions(&rpc_pb.ScheduledTransactionsRequest{})
+	util.RequireNoErr(t, err)
+	if len(list.Transactions) != 1 || list.Transactions[0].Status != ScheduleStatusPublished {
+		t.Fatalf("expected one published transaction, got %v", list)
+	}
+	if w.LockedOutpoint(input) {
+		t.Fatalf("expected the input of a published transaction to be unlocked")
+	}
+}
+
+// TestTxFinalRelative checks the relative lock time of an input, counted from
+// the block which mined the output it spends.
+func TestTxFinalRelative(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	incoming := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(100000000, nil)},
+	}
+	addUtxo(t, w, incoming)
+	tx := &wire.MsgTx{
+		Version: 2,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Hash: incoming.TxHash()},
+			Sequence:         10,
+		}},
+	}
+	for _, tc := range []struct {
+		height int32
+		final  bool
+	}{{testBlockHeight + 8, false}, {testBlockHeight + 9, true}} {
+		util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			return w.Manager.SetSyncedTo(dbtx.ReadWriteBucket(waddrmgrNamespaceKey),
+				&waddrmgr.BlockStamp{Height: tc.height, Hash: *testBlockHash})
+		}))
+		final, err := w.txFinal(tx)
+		util.RequireNoErr(t, err)
+		if final != tc.final {
+			t.Fatalf("at height %d expected final %v, got %v", tc.height, tc.final, final)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/pkt-cash/pktd/pktconfig/version"
+	"golang.org/x/text/unicode/norm"
 )
 
 func dieIfError(err error) {
@@ -69,12 +70,13 @@ func main() {
 			fmt.Fprintf(fi, "    \"%s\",\n", str)
 		}
 		fmt.Fprintln(fi, "}")
+		// Words are looked up in NFKD form, as is required by BIP-39
 		fmt.Fprintf(fi, "var rwords_%s = map[string]int16{\n", l)
 		for i, str := range strs {
 			if str == "" {
 				continue
 			}
-			fmt.Fprintf(fi, "    \"%s\": %d,\n", str, i)
+			fmt.Fprintf(fi, "    \"%s\": %d,\n", norm.NFKD.String(str), i)
 		}
 		fmt.Fprintln(fi, "}")
 	}
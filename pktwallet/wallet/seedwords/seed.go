@@ -8,6 +8,7 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/pktwallet/zero"
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/text/unicode/norm"
 )
 
 type wordsDesc struct {
@@ -26,6 +28,20 @@ type wordsDesc struct {
 //go:generate go run -tags words genwords.go
 var allWords = make(map[string]*wordsDesc)
 
+// DefaultLanguage is the language of seed words when none is specified.
+const DefaultLanguage = "english"
+
+// Languages returns the names of the languages which seed words can be
+// written in, sorted.
+func Languages() []string {
+	out := make([]string, 0, len(allWords))
+	for l := range allWords {
+		out = append(out, l)
+	}
+	sort.Strings(out)
+	return out
+}
+
 /**
  * Seed layout:
  *     0               1               2               3
@@ -238,7 +254,8 @@ func (s *Seed) Encrypt(passphrase []byte) *SeedEnc {
 func (s *SeedEnc) Words(lang string) (string, er.R) {
 	wd, ok := allWords[lang]
 	if !ok {
-		return "", er.Errorf("Language [%s] is not supported", lang)
+		return "", er.Errorf("Language [%s] is not supported, use one of %v",
+			lang, Languages())
 	}
 	words := make([]string, 0, wordCount)
 	defer zeroStr(words)
@@ -260,7 +277,15 @@ func (s *SeedEnc) Words(lang string) (string, er.R) {
 	if !b.IsUint64() || b.Uint64() != 1 {
 		panic("Internal error: bignum should have resulted in 1")
 	}
-	return strings.Join(words, " "), nil
+	return joinWords(words, lang), nil
+}
+
+func joinWords(words []string, lang string) string {
+	if lang == "japanese" {
+		// BIP-39 separates japanese words with an ideographic space
+		return strings.Join(words, "\u3000")
+	}
+	return strings.Join(words, " ")
 }
 
 func fromNums(nums [wordCount]int16) (*SeedEnc, er.R) {
@@ -280,43 +305,90 @@ func fromNums(nums [wordCount]int16) (*SeedEnc, er.R) {
 	}
 	s := SeedEnc{}
 	copy(s.Bytes[:], bytes)
-	var err er.R
+	if err := s.check(); err != nil {
+		s.Zero()
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (s *SeedEnc) check() er.R {
 	if s.getUnused() != expectUnused {
-		err = er.New("Invalid seed: Wrong bit pattern")
+		return er.New("Invalid seed: Wrong bit pattern")
 	} else if s.getVer() != 0 {
-		err = er.Errorf("Invalid seed: Unknown version [%d]", s.getVer())
+		return er.Errorf("Invalid seed: Unknown version [%d]", s.getVer())
 	} else if s.getCsum() != s.computeCsum() {
-		err = er.New("Invalid seed: Checksum mismatch")
-	} else {
-		return &s, nil
+		return er.New("Invalid seed: Checksum mismatch")
 	}
-	s.Zero()
-	return nil, err
+	return nil
 }
 
 // SeedFromWords creates an encrypted seed from a set of words, the language
 // is auto-detected.
 func SeedFromWords(words string) (*SeedEnc, er.R) {
-	splitWords := strings.Split(words, " ")
+	s, _, err := SeedFromWordsLang(words, "")
+	return s, err
+}
+
+// SeedFromWordsLang creates an encrypted seed from a set of words in the given
+// language and returns the language. If lang is the empty string then the
+// language is auto-detected. Words are compared in NFKD form, so accents and
+// japanese kana are matched however they were typed.
+func SeedFromWordsLang(words, lang string) (*SeedEnc, string, er.R) {
+	normWords := norm.NFKD.String(words)
+	splitWords := strings.Fields(normWords)
 	defer zeroStr(splitWords)
 	if len(splitWords) != wordCount {
-		return nil, er.Errorf("Expected a %d word seed", wordCount)
+		return nil, "", er.Errorf("Expected a %d word seed", wordCount)
+	}
+	langs := Languages()
+	if lang != "" {
+		if _, ok := allWords[lang]; !ok {
+			return nil, "", er.Errorf("Language [%s] is not supported, use one of %v",
+				lang, langs)
+		}
+		langs = []string{lang}
 	}
 	nums := [wordCount]int16{}
 	defer zeroNums(nums[:])
+	var out *SeedEnc
+	var outLang string
+	var err er.R
 LANGUAGE:
-	for _, wd := range allWords {
+	for _, l := range langs {
+		wd := allWords[l]
 		for i, word := range splitWords {
-			if num, ok := wd.rwords[word]; ok {
-				if !ok {
-					continue LANGUAGE
-				}
-				nums[i] = num
+			num, ok := wd.rwords[word]
+			if !ok {
+				continue LANGUAGE
 			}
+			nums[i] = num
 		}
-		return fromNums(nums)
+		s, e := fromNums(nums)
+		if e != nil {
+			// Remember the error in case no other language matches
+			err = e
+			continue
+		} else if out == nil {
+			out = s
+			outLang = l
+		} else if out.Bytes != s.Bytes {
+			// Some words are in more than one list (e.g. chinese simplified
+			// and traditional), rarely both decode with a valid checksum.
+			out.Zero()
+			s.Zero()
+			return nil, "", er.Errorf("The words are a valid seed in both [%s] and [%s], "+
+				"please specify the language", outLang, l)
+		} else {
+			s.Zero()
+		}
+	}
+	if out != nil {
+		return out, outLang, nil
+	} else if err != nil {
+		return nil, "", err
 	}
-	return nil, er.New("Could not decode the words provided, check for typos")
+	return nil, "", er.New("Could not decode the words provided, check for typos")
 }
 
 // Zero wipes the data from this seed from memory.
@@ -7,12 +7,14 @@ package seedwords_test
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pkt-cash/pktd/btcutil/util"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestEncrypt(t *testing.T) {
@@ -61,3 +63,39 @@ func TestReversibleEncrypt(t *testing.T) {
 		require.Equal(t, seed.Birthday(), decipheredSeed.Birthday())
 	})
 }
+
+// Seed words in every language decode to the same seed, with the language
+// detected, whether the input is composed or decomposed unicode.
+func TestWordsLanguages(t *testing.T) {
+	seed, err := seedwords.RandomSeed()
+	util.RequireNoErr(t, err)
+	se := seed.Encrypt(nil)
+
+	for _, lang := range seedwords.Languages() {
+		mnemonic, err := se.Words(lang)
+		util.RequireNoErr(t, err)
+		for _, words := range []string{
+			mnemonic,
+			norm.NFC.String(mnemonic),
+			norm.NFKD.String(mnemonic),
+		} {
+			se1, lang1, err := seedwords.SeedFromWordsLang(words, "")
+			util.RequireNoErr(t, err)
+
+			// Chinese simplified and traditional share some words, so
+			// compare the words rather than the name of the language.
+			words1, err := se1.Words(lang1)
+			util.RequireNoErr(t, err)
+			require.Equal(t, mnemonic, words1)
+		}
+	}
+
+	mnemonic, err := se.Words("japanese")
+	util.RequireNoErr(t, err)
+	require.Equal(t, 14, strings.Count(mnemonic, "　"))
+
+	_, err = se.Words("klingon")
+	require.NotNil(t, err)
+	_, _, err = seedwords.SeedFromWordsLang(mnemonic, "english")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,323 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package seedwords
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"strings"
+
+	"github.com/dchest/blake2b"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/zero"
+	"golang.org/x/text/unicode/norm"
+)
+
+/**
+ * Share layout:
+ *     0               1               2               3
+ *     0 1 2 3 4 5 6 7 0 1 2 3 4 5 6 7 0 1 2 3 4 5 6 7 0 1 2 3 4 5 6 7
+ *    +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+ *  0 |   U   |  Ver  |          Identifier           |   T   |   X   |
+ *    +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+ *  4 |                                                               |
+ *    +                                                               +
+ *  8 |                                                               |
+ *    +                                                               +
+ * 12 |                                                               |
+ *    +                             Value                             +
+ * 16 |                                                               |
+ *    +                                                               +
+ * 20 |                                                               |
+ *    +               +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+ * 24 |               |                   Checksum                    |
+ *    +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+ *
+ * U: unused, must be 0
+ * Ver: 0
+ * Identifier: random, the same in all shares of one split
+ * T: the number of shares needed to recover the seed, minus 1
+ * X: the index of the share, minus 1
+ * Value: the share of the SeedEnc, with the unused bits cleared
+ * Checksum: first 3 bytes of 32-byte blake2b digest without key of the preceeding bytes
+ *
+ * The SeedEnc is split using Shamir's secret sharing over GF(256), byte by byte,
+ * the share with index X is the value of the polynomial at X+1. Because the
+ * encrypted seed is split, the shares carry the birthday and the flag saying
+ * whether a passphrase is needed, and the passphrase is still needed after the
+ * shares are combined.
+ * 224 bits with the 4 unused bits removed are encoded as 20 words.
+ */
+const shareVersion = 0
+const shareWordCount = 20
+const shareByteLen = 28
+const shareValueOff = 4
+const shareCsumOff = shareValueOff + encByteLen
+
+// MaxShares is the maximum number of shares which a seed can be split into.
+const MaxShares = 16
+
+type seedShare struct {
+	Bytes [shareByteLen]byte
+}
+
+func (s *seedShare) getVer() byte {
+	return s.Bytes[0] & 0x0f
+}
+func (s *seedShare) getThreshold() int {
+	return int(s.Bytes[3]>>4) + 1
+}
+func (s *seedShare) getX() byte {
+	return (s.Bytes[3] & 0x0f) + 1
+}
+func (s *seedShare) computeCsum() []byte {
+	csum := blake2b.Sum256(s.Bytes[:shareCsumOff])
+	return csum[:shareByteLen-shareCsumOff]
+}
+
+func (s *seedShare) words(lang string) string {
+	wd := allWords[lang]
+	words := make([]string, 0, shareWordCount)
+	defer zeroStr(words)
+	b := new(big.Int).SetBytes(s.Bytes[:])
+	defer zero.BigInt(b)
+	b_ := big.NewInt(0)
+	defer zero.BigInt(b_)
+	b2047 := big.NewInt(2047)
+	for i := 0; i < shareWordCount; i++ {
+		b_.And(b, b2047)
+		words = append(words, wd.words[b_.Uint64()])
+		b.Rsh(b, 11)
+	}
+	if b.Sign() != 0 {
+		panic("Internal error: bignum should have resulted in 0")
+	}
+	return joinWords(words, lang)
+}
+
+func shareFromNums(nums []int16) (*seedShare, er.R) {
+	b := big.NewInt(0)
+	defer zero.BigInt(b)
+	b_ := big.NewInt(0)
+	defer zero.BigInt(b_)
+	for i := len(nums) - 1; i >= 0; i-- {
+		b_.SetInt64(int64(nums[i]))
+		b.Lsh(b, 11)
+		b.Add(b, b_)
+	}
+	s := seedShare{}
+	b.FillBytes(s.Bytes[:])
+	var err er.R
+	if s.Bytes[0]>>4 != 0 {
+		err = er.New("Wrong bit pattern")
+	} else if !bytes.Equal(s.computeCsum(), s.Bytes[shareCsumOff:]) {
+		err = er.New("Checksum mismatch")
+	} else if s.getVer() != shareVersion {
+		err = er.Errorf("Unknown version [%d]", s.getVer())
+	} else {
+		return &s, nil
+	}
+	s.zero()
+	return nil, err
+}
+
+func (s *seedShare) zero() {
+	zero.Bytes(s.Bytes[:])
+}
+
+// Arithmetic in GF(256) with the AES polynomial, using tables of powers of 3.
+var gfExp [255]byte
+var gfLog [256]byte
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gfExp[i] = x
+		gfLog[x] = byte(i)
+		x2 := x << 1
+		if x&0x80 != 0 {
+			x2 ^= 0x1b
+		}
+		x ^= x2
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])+int(gfLog[b]))%255]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[(int(gfLog[a])-int(gfLog[b])+255)%255]
+}
+
+// Split divides the encrypted seed into shares, any threshold of which can be
+// combined with SeedFromShares to recover it. Each share is a string of 20
+// words in the given language.
+func (s *SeedEnc) Split(threshold, shares int, lang string) ([]string, er.R) {
+	if _, ok := allWords[lang]; !ok {
+		return nil, er.Errorf("Language [%s] is not supported, use one of %v",
+			lang, Languages())
+	} else if shares < 1 || shares > MaxShares {
+		return nil, er.Errorf("The number of shares must be between 1 and %d", MaxShares)
+	} else if threshold < 1 || threshold > shares {
+		return nil, er.Errorf("The threshold must be between 1 and the number of shares [%d]",
+			shares)
+	}
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, er.E(err)
+	}
+	// coeffs[0] is the secret, the others are random
+	coeffs := make([][encByteLen]byte, threshold)
+	defer func() {
+		for i := range coeffs {
+			zero.Bytes(coeffs[i][:])
+		}
+	}()
+	coeffs[0] = s.Bytes
+	coeffs[0][0] &= 0x1f
+	for i := 1; i < threshold; i++ {
+		if _, err := rand.Read(coeffs[i][:]); err != nil {
+			return nil, er.E(err)
+		}
+	}
+
+	out := make([]string, 0, shares)
+	for x := 1; x <= shares; x++ {
+		sh := seedShare{}
+		sh.Bytes[0] = shareVersion
+		copy(sh.Bytes[1:3], id[:])
+		sh.Bytes[3] = byte(threshold-1)<<4 | byte(x-1)
+		for j := 0; j < encByteLen; j++ {
+			// Horner's method, from the highest coefficient down
+			y := byte(0)
+			for i := threshold - 1; i >= 0; i-- {
+				y = gfMul(y, byte(x)) ^ coeffs[i][j]
+			}
+			sh.Bytes[shareValueOff+j] = y
+		}
+		copy(sh.Bytes[shareCsumOff:], sh.computeCsum())
+		out = append(out, sh.words(lang))
+		sh.zero()
+	}
+	return out, nil
+}
+
+func shareFromWords(words string) (*seedShare, string, er.R) {
+	splitWords := strings.Fields(norm.NFKD.String(words))
+	defer zeroStr(splitWords)
+	if len(splitWords) != shareWordCount {
+		return nil, "", er.Errorf("Expected a %d word share", shareWordCount)
+	}
+	nums := make([]int16, shareWordCount)
+	defer zeroNums(nums)
+	var err er.R
+LANGUAGE:
+	for _, l := range Languages() {
+		wd := allWords[l]
+		for i, word := range splitWords {
+			num, ok := wd.rwords[word]
+			if !ok {
+				continue LANGUAGE
+			}
+			nums[i] = num
+		}
+		s, e := shareFromNums(nums)
+		if e != nil {
+			err = e
+			continue
+		}
+		return s, l, nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return nil, "", er.New("Could not decode the words provided, check for typos")
+}
+
+// SeedFromShares combines shares which were made by Split, returning the
+// encrypted seed and the language of the shares, which is auto-detected. All of
+// the shares must be in the same language.
+func SeedFromShares(shares []string) (*SeedEnc, string, er.R) {
+	if len(shares) == 0 {
+		return nil, "", er.New("No shares were provided")
+	}
+	parsed := make([]*seedShare, 0, len(shares))
+	defer func() {
+		for _, s := range parsed {
+			s.zero()
+		}
+	}()
+	lang := ""
+	for i, words := range shares {
+		s, l, err := shareFromWords(words)
+		if err != nil {
+			return nil, "", er.Errorf("Invalid share [%d]: %s", i+1, err.Message())
+		}
+		parsed = append(parsed, s)
+		if i == 0 {
+			lang = l
+			continue
+		} else if l != lang {
+			return nil, "", er.Errorf("Share [%d] is in [%s] but share [1] is in [%s]", i+1, l, lang)
+		}
+		first := parsed[0]
+		if !bytes.Equal(s.Bytes[1:3], first.Bytes[1:3]) ||
+			s.getThreshold() != first.getThreshold() {
+			return nil, "", er.Errorf("Share [%d] is not from the same seed as share [1]", i+1)
+		}
+		for j, s1 := range parsed[:i] {
+			if s1.getX() == s.getX() {
+				return nil, "", er.Errorf("Share [%d] is the same as share [%d]", i+1, j+1)
+			}
+		}
+	}
+	threshold := parsed[0].getThreshold()
+	if len(parsed) < threshold {
+		return nil, "", er.Errorf("This seed needs [%d] shares to recover, only [%d] were provided",
+			threshold, len(parsed))
+	}
+
+	// Lagrange interpolation of the polynomial at 0
+	use := parsed[:threshold]
+	out := SeedEnc{}
+	for i, si := range use {
+		basis := byte(1)
+		for j, sj := range use {
+			if i != j {
+				basis = gfMul(basis, gfDiv(sj.getX(), sj.getX()^si.getX()))
+			}
+		}
+		for k := 0; k < encByteLen; k++ {
+			out.Bytes[k] ^= gfMul(si.Bytes[shareValueOff+k], basis)
+		}
+	}
+	out.Bytes[0] |= expectUnused << 5
+	if err := out.check(); err != nil {
+		out.Zero()
+		return nil, "", er.Errorf("The shares do not combine into a valid seed: %s",
+			err.Message())
+	}
+	return &out, lang, nil
+}
+
+// SeedFromWordsOrShares decodes a seed which is given either as seed words or
+// as the shares of a split seed, but not both.
+func SeedFromWordsOrShares(words string, shares []string) (*SeedEnc, er.R) {
+	if words != "" && len(shares) > 0 {
+		return nil, er.New("Only one of the seed words and the seed shares may be provided")
+	} else if len(shares) > 0 {
+		seedEnc, _, err := SeedFromShares(shares)
+		return seedEnc, err
+	}
+	return SeedFromWords(words)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package seedwords
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/stretchr/testify/require"
+)
+
+// The same share in another language is still a valid share, but shares in
+// different languages are not combined.
+func TestSharesMixedLanguages(t *testing.T) {
+	se, err := SeedFromWords("major expire wise author arctic devote supply come sniff " +
+		"wide depend diary volcano behind assault")
+	util.RequireNoErr(t, err)
+	shares, err := se.Split(2, 3, "english")
+	util.RequireNoErr(t, err)
+
+	s, lang, err := shareFromWords(shares[1])
+	util.RequireNoErr(t, err)
+	require.Equal(t, "english", lang)
+	french := s.words("french")
+	s.zero()
+
+	_, _, err = SeedFromShares([]string{shares[0], french})
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Message(), "is in [french]"), err.Message())
+	_, _, err = SeedFromShares([]string{shares[0], shares[1]})
+	util.RequireNoErr(t, err)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package seedwords_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
+	"github.com/stretchr/testify/require"
+)
+
+// Encrypted with passphrase "pass"
+const testSeedWords = "major expire wise author arctic devote supply come sniff " +
+	"wide depend diary volcano behind assault"
+
+func TestSplitCombine(t *testing.T) {
+	se, err := seedwords.SeedFromWords(testSeedWords)
+	util.RequireNoErr(t, err)
+
+	shares, err := se.Split(3, 5, "english")
+	util.RequireNoErr(t, err)
+	require.Len(t, shares, 5)
+
+	// Every choice of 3 shares, in any order, recovers the seed.
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			for k := 0; k < 5; k++ {
+				if i == j || j == k || i == k {
+					continue
+				}
+				se1, lang, err := seedwords.SeedFromShares(
+					[]string{shares[i], shares[j], shares[k]})
+				util.RequireNoErr(t, err)
+				require.Equal(t, "english", lang)
+				require.True(t, se1.NeedsPassphrase())
+				words, err := se1.Words(lang)
+				util.RequireNoErr(t, err)
+				require.Equal(t, testSeedWords, words)
+			}
+		}
+	}
+
+	_, _, err = seedwords.SeedFromShares(shares[:2])
+	require.NotNil(t, err)
+	_, _, err = seedwords.SeedFromShares([]string{shares[0], shares[1], shares[1]})
+	require.NotNil(t, err)
+
+	// Shares of another split of the same seed don't mix.
+	other, err := se.Split(3, 5, "english")
+	util.RequireNoErr(t, err)
+	_, _, err = seedwords.SeedFromShares([]string{shares[0], shares[1], other[2]})
+	require.NotNil(t, err)
+
+	// A typo is caught by the checksum.
+	words := strings.Fields(shares[0])
+	if words[4] == "zoo" {
+		words[4] = "abandon"
+	} else {
+		words[4] = "zoo"
+	}
+	_, _, err = seedwords.SeedFromShares([]string{strings.Join(words, " "), shares[1], shares[2]})
+	require.NotNil(t, err)
+
+	// 1 of 1 and other languages
+	jp, err := se.Split(1, 1, "japanese")
+	util.RequireNoErr(t, err)
+	se1, lang, err := seedwords.SeedFromShares(jp)
+	util.RequireNoErr(t, err)
+	require.Equal(t, "japanese", lang)
+	words1, err := se1.Words("english")
+	util.RequireNoErr(t, err)
+	require.Equal(t, testSeedWords, words1)
+
+	_, err = se.Split(4, 3, "english")
+	require.NotNil(t, err)
+	_, err = se.Split(2, seedwords.MaxShares+1, "english")
+	require.NotNil(t, err)
+}
@@ -7,6 +7,12306 @@ package seedwords
 // Auto-generated file (see genwords.go)
 // DO NOT EDIT
 
+var words_chinese_simplified = [2048]string{
+    "的",
+    "一",
+    "是",
+    "在",
+    "不",
+    "了",
+    "有",
+    "和",
+    "人",
+    "这",
+    "中",
+    "大",
+    "为",
+    "上",
+    "个",
+    "国",
+    "我",
+    "以",
+    "要",
+    "他",
+    "时",
+    "来",
+    "用",
+    "们",
+    "生",
+    "到",
+    "作",
+    "地",
+    "于",
+    "出",
+    "就",
+    "分",
+    "对",
+    "成",
+    "会",
+    "可",
+    "主",
+    "发",
+    "年",
+    "动",
+    "同",
+    "工",
+    "也",
+    "能",
+    "下",
+    "过",
+    "子",
+    "说",
+    "产",
+    "种",
+    "面",
+    "而",
+    "方",
+    "后",
+    "多",
+    "定",
+    "行",
+    "学",
+    "法",
+    "所",
+    "民",
+    "得",
+    "经",
+    "十",
+    "三",
+    "之",
+    "进",
+    "着",
+    "等",
+    "部",
+    "度",
+    "家",
+    "电",
+    "力",
+    "里",
+    "如",
+    "水",
+    "化",
+    "高",
+    "自",
+    "二",
+    "理",
+    "起",
+    "小",
+    "物",
+    "现",
+    "实",
+    "加",
+    "量",
+    "都",
+    "两",
+    "体",
+    "制",
+    "机",
+    "当",
+    "使",
+    "点",
+    "从",
+    "业",
+    "本",
+    "去",
+    "把",
+    "性",
+    "好",
+    "应",
+    "开",
+    "它",
+    "合",
+    "还",
+    "因",
+    "由",
+    "其",
+    "些",
+    "然",
+    "前",
+    "外",
+    "天",
+    "政",
+    "四",
+    "日",
+    "那",
+    "社",
+    "义",
+    "事",
+    "平",
+    "形",
+    "相",
+    "全",
+    "表",
+    "间",
+    "样",
+    "与",
+    "关",
+    "各",
+    "重",
+    "新",
+    "线",
+    "内",
+    "数",
+    "正",
+    "心",
+    "反",
+    "你",
+    "明",
+    "看",
+    "原",
+    "又",
+    "么",
+    "利",
+    "比",
+    "或",
+    "但",
+    "质",
+    "气",
+    "第",
+    "向",
+    "道",
+    "命",
+    "此",
+    "变",
+    "条",
+    "只",
+    "没",
+    "结",
+    "解",
+    "问",
+    "意",
+    "建",
+    "月",
+    "公",
+    "无",
+    "系",
+    "军",
+    "很",
+    "情",
+    "者",
+    "最",
+    "立",
+    "代",
+    "想",
+    "已",
+    "通",
+    "并",
+    "提",
+    "直",
+    "题",
+    "党",
+    "程",
+    "展",
+    "五",
+    "果",
+    "料",
+    "象",
+    "员",
+    "革",
+    "位",
+    "入",
+    "常",
+    "文",
+    "总",
+    "次",
+    "品",
+    "式",
+    "活",
+    "设",
+    "及",
+    "管",
+    "特",
+    "件",
+    "长",
+    "求",
+    "老",
+    "头",
+    "基",
+    "资",
+    "边",
+    "流",
+    "路",
+    "级",
+    "少",
+    "图",
+    "山",
+    "统",
+    "接",
+    "知",
+    "较",
+    "将",
+    "组",
+    "见",
+    "计",
+    "别",
+    "她",
+    "手",
+    "角",
+    "期",
+    "根",
+    "论",
+    "运",
+    "农",
+    "指",
+    "几",
+    "九",
+    "区",
+    "强",
+    "放",
+    "决",
+    "西",
+    "被",
+    "干",
+    "做",
+    "必",
+    "战",
+    "先",
+    "回",
+    "则",
+    "任",
+    "取",
+    "据",
+    "处",
+    "队",
+    "南",
+    "给",
+    "色",
+    "光",
+    "门",
+    "即",
+    "保",
+    "治",
+    "北",
+    "造",
+    "百",
+    "规",
+    "热",
+    "领",
+    "七",
+    "海",
+    "口",
+    "东",
+    "导",
+    "器",
+    "压",
+    "志",
+    "世",
+    "金",
+    "增",
+    "争",
+    "济",
+    "阶",
+    "油",
+    "思",
+    "术",
+    "极",
+    "交",
+    "受",
+    "联",
+    "什",
+    "认",
+    "六",
+    "共",
+    "权",
+    "收",
+    "证",
+    "改",
+    "清",
+    "美",
+    "再",
+    "采",
+    "转",
+    "更",
+    "单",
+    "风",
+    "切",
+    "打",
+    "白",
+    "教",
+    "速",
+    "花",
+    "带",
+    "安",
+    "场",
+    "身",
+    "车",
+    "例",
+    "真",
+    "务",
+    "具",
+    "万",
+    "每",
+    "目",
+    "至",
+    "达",
+    "走",
+    "积",
+    "示",
+    "议",
+    "声",
+    "报",
+    "斗",
+    "完",
+    "类",
+    "八",
+    "离",
+    "华",
+    "名",
+    "确",
+    "才",
+    "科",
+    "张",
+    "信",
+    "马",
+    "节",
+    "话",
+    "米",
+    "整",
+    "空",
+    "元",
+    "况",
+    "今",
+    "集",
+    "温",
+    "传",
+    "土",
+    "许",
+    "步",
+    "群",
+    "广",
+    "石",
+    "记",
+    "需",
+    "段",
+    "研",
+    "界",
+    "拉",
+    "林",
+    "律",
+    "叫",
+    "且",
+    "究",
+    "观",
+    "越",
+    "织",
+    "装",
+    "影",
+    "算",
+    "低",
+    "持",
+    "音",
+    "众",
+    "书",
+    "布",
+    "复",
+    "容",
+    "儿",
+    "须",
+    "际",
+    "商",
+    "非",
+    "验",
+    "连",
+    "断",
+    "深",
+    "难",
+    "近",
+    "矿",
+    "千",
+    "周",
+    "委",
+    "素",
+    "技",
+    "备",
+    "半",
+    "办",
+    "青",
+    "省",
+    "列",
+    "习",
+    "响",
+    "约",
+    "支",
+    "般",
+    "史",
+    "感",
+    "劳",
+    "便",
+    "团",
+    "往",
+    "酸",
+    "历",
+    "市",
+    "克",
+    "何",
+    "除",
+    "消",
+    "构",
+    "府",
+    "称",
+    "太",
+    "准",
+    "精",
+    "值",
+    "号",
+    "率",
+    "族",
+    "维",
+    "划",
+    "选",
+    "标",
+    "写",
+    "存",
+    "候",
+    "毛",
+    "亲",
+    "快",
+    "效",
+    "斯",
+    "院",
+    "查",
+    "江",
+    "型",
+    "眼",
+    "王",
+    "按",
+    "格",
+    "养",
+    "易",
+    "置",
+    "派",
+    "层",
+    "片",
+    "始",
+    "却",
+    "专",
+    "状",
+    "育",
+    "厂",
+    "京",
+    "识",
+    "适",
+    "属",
+    "圆",
+    "包",
+    "火",
+    "住",
+    "调",
+    "满",
+    "县",
+    "局",
+    "照",
+    "参",
+    "红",
+    "细",
+    "引",
+    "听",
+    "该",
+    "铁",
+    "价",
+    "严",
+    "首",
+    "底",
+    "液",
+    "官",
+    "德",
+    "随",
+    "病",
+    "苏",
+    "失",
+    "尔",
+    "死",
+    "讲",
+    "配",
+    "女",
+    "黄",
+    "推",
+    "显",
+    "谈",
+    "罪",
+    "神",
+    "艺",
+    "呢",
+    "席",
+    "含",
+    "企",
+    "望",
+    "密",
+    "批",
+    "营",
+    "项",
+    "防",
+    "举",
+    "球",
+    "英",
+    "氧",
+    "势",
+    "告",
+    "李",
+    "台",
+    "落",
+    "木",
+    "帮",
+    "轮",
+    "破",
+    "亚",
+    "师",
+    "围",
+    "注",
+    "远",
+    "字",
+    "材",
+    "排",
+    "供",
+    "河",
+    "态",
+    "封",
+    "另",
+    "施",
+    "减",
+    "树",
+    "溶",
+    "怎",
+    "止",
+    "案",
+    "言",
+    "士",
+    "均",
+    "武",
+    "固",
+    "叶",
+    "鱼",
+    "波",
+    "视",
+    "仅",
+    "费",
+    "紧",
+    "爱",
+    "左",
+    "章",
+    "早",
+    "朝",
+    "害",
+    "续",
+    "轻",
+    "服",
+    "试",
+    "食",
+    "充",
+    "兵",
+    "源",
+    "判",
+    "护",
+    "司",
+    "足",
+    "某",
+    "练",
+    "差",
+    "致",
+    "板",
+    "田",
+    "降",
+    "黑",
+    "犯",
+    "负",
+    "击",
+    "范",
+    "继",
+    "兴",
+    "似",
+    "余",
+    "坚",
+    "曲",
+    "输",
+    "修",
+    "故",
+    "城",
+    "夫",
+    "够",
+    "送",
+    "笔",
+    "船",
+    "占",
+    "右",
+    "财",
+    "吃",
+    "富",
+    "春",
+    "职",
+    "觉",
+    "汉",
+    "画",
+    "功",
+    "巴",
+    "跟",
+    "虽",
+    "杂",
+    "飞",
+    "检",
+    "吸",
+    "助",
+    "升",
+    "阳",
+    "互",
+    "初",
+    "创",
+    "抗",
+    "考",
+    "投",
+    "坏",
+    "策",
+    "古",
+    "径",
+    "换",
+    "未",
+    "跑",
+    "留",
+    "钢",
+    "曾",
+    "端",
+    "责",
+    "站",
+    "简",
+    "述",
+    "钱",
+    "副",
+    "尽",
+    "帝",
+    "射",
+    "草",
+    "冲",
+    "承",
+    "独",
+    "令",
+    "限",
+    "阿",
+    "宣",
+    "环",
+    "双",
+    "请",
+    "超",
+    "微",
+    "让",
+    "控",
+    "州",
+    "良",
+    "轴",
+    "找",
+    "否",
+    "纪",
+    "益",
+    "依",
+    "优",
+    "顶",
+    "础",
+    "载",
+    "倒",
+    "房",
+    "突",
+    "坐",
+    "粉",
+    "敌",
+    "略",
+    "客",
+    "袁",
+    "冷",
+    "胜",
+    "绝",
+    "析",
+    "块",
+    "剂",
+    "测",
+    "丝",
+    "协",
+    "诉",
+    "念",
+    "陈",
+    "仍",
+    "罗",
+    "盐",
+    "友",
+    "洋",
+    "错",
+    "苦",
+    "夜",
+    "刑",
+    "移",
+    "频",
+    "逐",
+    "靠",
+    "混",
+    "母",
+    "短",
+    "皮",
+    "终",
+    "聚",
+    "汽",
+    "村",
+    "云",
+    "哪",
+    "既",
+    "距",
+    "卫",
+    "停",
+    "烈",
+    "央",
+    "察",
+    "烧",
+    "迅",
+    "境",
+    "若",
+    "印",
+    "洲",
+    "刻",
+    "括",
+    "激",
+    "孔",
+    "搞",
+    "甚",
+    "室",
+    "待",
+    "核",
+    "校",
+    "散",
+    "侵",
+    "吧",
+    "甲",
+    "游",
+    "久",
+    "菜",
+    "味",
+    "旧",
+    "模",
+    "湖",
+    "货",
+    "损",
+    "预",
+    "阻",
+    "毫",
+    "普",
+    "稳",
+    "乙",
+    "妈",
+    "植",
+    "息",
+    "扩",
+    "银",
+    "语",
+    "挥",
+    "酒",
+    "守",
+    "拿",
+    "序",
+    "纸",
+    "医",
+    "缺",
+    "雨",
+    "吗",
+    "针",
+    "刘",
+    "啊",
+    "急",
+    "唱",
+    "误",
+    "训",
+    "愿",
+    "审",
+    "附",
+    "获",
+    "茶",
+    "鲜",
+    "粮",
+    "斤",
+    "孩",
+    "脱",
+    "硫",
+    "肥",
+    "善",
+    "龙",
+    "演",
+    "父",
+    "渐",
+    "血",
+    "欢",
+    "械",
+    "掌",
+    "歌",
+    "沙",
+    "刚",
+    "攻",
+    "谓",
+    "盾",
+    "讨",
+    "晚",
+    "粒",
+    "乱",
+    "燃",
+    "矛",
+    "乎",
+    "杀",
+    "药",
+    "宁",
+    "鲁",
+    "贵",
+    "钟",
+    "煤",
+    "读",
+    "班",
+    "伯",
+    "香",
+    "介",
+    "迫",
+    "句",
+    "丰",
+    "培",
+    "握",
+    "兰",
+    "担",
+    "弦",
+    "蛋",
+    "沉",
+    "假",
+    "穿",
+    "执",
+    "答",
+    "乐",
+    "谁",
+    "顺",
+    "烟",
+    "缩",
+    "征",
+    "脸",
+    "喜",
+    "松",
+    "脚",
+    "困",
+    "异",
+    "免",
+    "背",
+    "星",
+    "福",
+    "买",
+    "染",
+    "井",
+    "概",
+    "慢",
+    "怕",
+    "磁",
+    "倍",
+    "祖",
+    "皇",
+    "促",
+    "静",
+    "补",
+    "评",
+    "翻",
+    "肉",
+    "践",
+    "尼",
+    "衣",
+    "宽",
+    "扬",
+    "棉",
+    "希",
+    "伤",
+    "操",
+    "垂",
+    "秋",
+    "宜",
+    "氢",
+    "套",
+    "督",
+    "振",
+    "架",
+    "亮",
+    "末",
+    "宪",
+    "庆",
+    "编",
+    "牛",
+    "触",
+    "映",
+    "雷",
+    "销",
+    "诗",
+    "座",
+    "居",
+    "抓",
+    "裂",
+    "胞",
+    "呼",
+    "娘",
+    "景",
+    "威",
+    "绿",
+    "晶",
+    "厚",
+    "盟",
+    "衡",
+    "鸡",
+    "孙",
+    "延",
+    "危",
+    "胶",
+    "屋",
+    "乡",
+    "临",
+    "陆",
+    "顾",
+    "掉",
+    "呀",
+    "灯",
+    "岁",
+    "措",
+    "束",
+    "耐",
+    "剧",
+    "玉",
+    "赵",
+    "跳",
+    "哥",
+    "季",
+    "课",
+    "凯",
+    "胡",
+    "额",
+    "款",
+    "绍",
+    "卷",
+    "齐",
+    "伟",
+    "蒸",
+    "殖",
+    "永",
+    "宗",
+    "苗",
+    "川",
+    "炉",
+    "岩",
+    "弱",
+    "零",
+    "杨",
+    "奏",
+    "沿",
+    "露",
+    "杆",
+    "探",
+    "滑",
+    "镇",
+    "饭",
+    "浓",
+    "航",
+    "怀",
+    "赶",
+    "库",
+    "夺",
+    "伊",
+    "灵",
+    "税",
+    "途",
+    "灭",
+    "赛",
+    "归",
+    "召",
+    "鼓",
+    "播",
+    "盘",
+    "裁",
+    "险",
+    "康",
+    "唯",
+    "录",
+    "菌",
+    "纯",
+    "借",
+    "糖",
+    "盖",
+    "横",
+    "符",
+    "私",
+    "努",
+    "堂",
+    "域",
+    "枪",
+    "润",
+    "幅",
+    "哈",
+    "竟",
+    "熟",
+    "虫",
+    "泽",
+    "脑",
+    "壤",
+    "碳",
+    "欧",
+    "遍",
+    "侧",
+    "寨",
+    "敢",
+    "彻",
+    "虑",
+    "斜",
+    "薄",
+    "庭",
+    "纳",
+    "弹",
+    "饲",
+    "伸",
+    "折",
+    "麦",
+    "湿",
+    "暗",
+    "荷",
+    "瓦",
+    "塞",
+    "床",
+    "筑",
+    "恶",
+    "户",
+    "访",
+    "塔",
+    "奇",
+    "透",
+    "梁",
+    "刀",
+    "旋",
+    "迹",
+    "卡",
+    "氯",
+    "遇",
+    "份",
+    "毒",
+    "泥",
+    "退",
+    "洗",
+    "摆",
+    "灰",
+    "彩",
+    "卖",
+    "耗",
+    "夏",
+    "择",
+    "忙",
+    "铜",
+    "献",
+    "硬",
+    "予",
+    "繁",
+    "圈",
+    "雪",
+    "函",
+    "亦",
+    "抽",
+    "篇",
+    "阵",
+    "阴",
+    "丁",
+    "尺",
+    "追",
+    "堆",
+    "雄",
+    "迎",
+    "泛",
+    "爸",
+    "楼",
+    "避",
+    "谋",
+    "吨",
+    "野",
+    "猪",
+    "旗",
+    "累",
+    "偏",
+    "典",
+    "馆",
+    "索",
+    "秦",
+    "脂",
+    "潮",
+    "爷",
+    "豆",
+    "忽",
+    "托",
+    "惊",
+    "塑",
+    "遗",
+    "愈",
+    "朱",
+    "替",
+    "纤",
+    "粗",
+    "倾",
+    "尚",
+    "痛",
+    "楚",
+    "谢",
+    "奋",
+    "购",
+    "磨",
+    "君",
+    "池",
+    "旁",
+    "碎",
+    "骨",
+    "监",
+    "捕",
+    "弟",
+    "暴",
+    "割",
+    "贯",
+    "殊",
+    "释",
+    "词",
+    "亡",
+    "壁",
+    "顿",
+    "宝",
+    "午",
+    "尘",
+    "闻",
+    "揭",
+    "炮",
+    "残",
+    "冬",
+    "桥",
+    "妇",
+    "警",
+    "综",
+    "招",
+    "吴",
+    "付",
+    "浮",
+    "遭",
+    "徐",
+    "您",
+    "摇",
+    "谷",
+    "赞",
+    "箱",
+    "隔",
+    "订",
+    "男",
+    "吹",
+    "园",
+    "纷",
+    "唐",
+    "败",
+    "宋",
+    "玻",
+    "巨",
+    "耕",
+    "坦",
+    "荣",
+    "闭",
+    "湾",
+    "键",
+    "凡",
+    "驻",
+    "锅",
+    "救",
+    "恩",
+    "剥",
+    "凝",
+    "碱",
+    "齿",
+    "截",
+    "炼",
+    "麻",
+    "纺",
+    "禁",
+    "废",
+    "盛",
+    "版",
+    "缓",
+    "净",
+    "睛",
+    "昌",
+    "婚",
+    "涉",
+    "筒",
+    "嘴",
+    "插",
+    "岸",
+    "朗",
+    "庄",
+    "街",
+    "藏",
+    "姑",
+    "贸",
+    "腐",
+    "奴",
+    "啦",
+    "惯",
+    "乘",
+    "伙",
+    "恢",
+    "匀",
+    "纱",
+    "扎",
+    "辩",
+    "耳",
+    "彪",
+    "臣",
+    "亿",
+    "璃",
+    "抵",
+    "脉",
+    "秀",
+    "萨",
+    "俄",
+    "网",
+    "舞",
+    "店",
+    "喷",
+    "纵",
+    "寸",
+    "汗",
+    "挂",
+    "洪",
+    "贺",
+    "闪",
+    "柬",
+    "爆",
+    "烯",
+    "津",
+    "稻",
+    "墙",
+    "软",
+    "勇",
+    "像",
+    "滚",
+    "厘",
+    "蒙",
+    "芳",
+    "肯",
+    "坡",
+    "柱",
+    "荡",
+    "腿",
+    "仪",
+    "旅",
+    "尾",
+    "轧",
+    "冰",
+    "贡",
+    "登",
+    "黎",
+    "削",
+    "钻",
+    "勒",
+    "逃",
+    "障",
+    "氨",
+    "郭",
+    "峰",
+    "币",
+    "港",
+    "伏",
+    "轨",
+    "亩",
+    "毕",
+    "擦",
+    "莫",
+    "刺",
+    "浪",
+    "秘",
+    "援",
+    "株",
+    "健",
+    "售",
+    "股",
+    "岛",
+    "甘",
+    "泡",
+    "睡",
+    "童",
+    "铸",
+    "汤",
+    "阀",
+    "休",
+    "汇",
+    "舍",
+    "牧",
+    "绕",
+    "炸",
+    "哲",
+    "磷",
+    "绩",
+    "朋",
+    "淡",
+    "尖",
+    "启",
+    "陷",
+    "柴",
+    "呈",
+    "徒",
+    "颜",
+    "泪",
+    "稍",
+    "忘",
+    "泵",
+    "蓝",
+    "拖",
+    "洞",
+    "授",
+    "镜",
+    "辛",
+    "壮",
+    "锋",
+    "贫",
+    "虚",
+    "弯",
+    "摩",
+    "泰",
+    "幼",
+    "廷",
+    "尊",
+    "窗",
+    "纲",
+    "弄",
+    "隶",
+    "疑",
+    "氏",
+    "宫",
+    "姐",
+    "震",
+    "瑞",
+    "怪",
+    "尤",
+    "琴",
+    "循",
+    "描",
+    "膜",
+    "违",
+    "夹",
+    "腰",
+    "缘",
+    "珠",
+    "穷",
+    "森",
+    "枝",
+    "竹",
+    "沟",
+    "催",
+    "绳",
+    "忆",
+    "邦",
+    "剩",
+    "幸",
+    "浆",
+    "栏",
+    "拥",
+    "牙",
+    "贮",
+    "礼",
+    "滤",
+    "钠",
+    "纹",
+    "罢",
+    "拍",
+    "咱",
+    "喊",
+    "袖",
+    "埃",
+    "勤",
+    "罚",
+    "焦",
+    "潜",
+    "伍",
+    "墨",
+    "欲",
+    "缝",
+    "姓",
+    "刊",
+    "饱",
+    "仿",
+    "奖",
+    "铝",
+    "鬼",
+    "丽",
+    "跨",
+    "默",
+    "挖",
+    "链",
+    "扫",
+    "喝",
+    "袋",
+    "炭",
+    "污",
+    "幕",
+    "诸",
+    "弧",
+    "励",
+    "梅",
+    "奶",
+    "洁",
+    "灾",
+    "舟",
+    "鉴",
+    "苯",
+    "讼",
+    "抱",
+    "毁",
+    "懂",
+    "寒",
+    "智",
+    "埔",
+    "寄",
+    "届",
+    "跃",
+    "渡",
+    "挑",
+    "丹",
+    "艰",
+    "贝",
+    "碰",
+    "拔",
+    "爹",
+    "戴",
+    "码",
+    "梦",
+    "芽",
+    "熔",
+    "赤",
+    "渔",
+    "哭",
+    "敬",
+    "颗",
+    "奔",
+    "铅",
+    "仲",
+    "虎",
+    "稀",
+    "妹",
+    "乏",
+    "珍",
+    "申",
+    "桌",
+    "遵",
+    "允",
+    "隆",
+    "螺",
+    "仓",
+    "魏",
+    "锐",
+    "晓",
+    "氮",
+    "兼",
+    "隐",
+    "碍",
+    "赫",
+    "拨",
+    "忠",
+    "肃",
+    "缸",
+    "牵",
+    "抢",
+    "博",
+    "巧",
+    "壳",
+    "兄",
+    "杜",
+    "讯",
+    "诚",
+    "碧",
+    "祥",
+    "柯",
+    "页",
+    "巡",
+    "矩",
+    "悲",
+    "灌",
+    "龄",
+    "伦",
+    "票",
+    "寻",
+    "桂",
+    "铺",
+    "圣",
+    "恐",
+    "恰",
+    "郑",
+    "趣",
+    "抬",
+    "荒",
+    "腾",
+    "贴",
+    "柔",
+    "滴",
+    "猛",
+    "阔",
+    "辆",
+    "妻",
+    "填",
+    "撤",
+    "储",
+    "签",
+    "闹",
+    "扰",
+    "紫",
+    "砂",
+    "递",
+    "戏",
+    "吊",
+    "陶",
+    "伐",
+    "喂",
+    "疗",
+    "瓶",
+    "婆",
+    "抚",
+    "臂",
+    "摸",
+    "忍",
+    "虾",
+    "蜡",
+    "邻",
+    "胸",
+    "巩",
+    "挤",
+    "偶",
+    "弃",
+    "槽",
+    "劲",
+    "乳",
+    "邓",
+    "吉",
+    "仁",
+    "烂",
+    "砖",
+    "租",
+    "乌",
+    "舰",
+    "伴",
+    "瓜",
+    "浅",
+    "丙",
+    "暂",
+    "燥",
+    "橡",
+    "柳",
+    "迷",
+    "暖",
+    "牌",
+    "秧",
+    "胆",
+    "详",
+    "簧",
+    "踏",
+    "瓷",
+    "谱",
+    "呆",
+    "宾",
+    "糊",
+    "洛",
+    "辉",
+    "愤",
+    "竞",
+    "隙",
+    "怒",
+    "粘",
+    "乃",
+    "绪",
+    "肩",
+    "籍",
+    "敏",
+    "涂",
+    "熙",
+    "皆",
+    "侦",
+    "悬",
+    "掘",
+    "享",
+    "纠",
+    "醒",
+    "狂",
+    "锁",
+    "淀",
+    "恨",
+    "牲",
+    "霸",
+    "爬",
+    "赏",
+    "逆",
+    "玩",
+    "陵",
+    "祝",
+    "秒",
+    "浙",
+    "貌",
+    "役",
+    "彼",
+    "悉",
+    "鸭",
+    "趋",
+    "凤",
+    "晨",
+    "畜",
+    "辈",
+    "秩",
+    "卵",
+    "署",
+    "梯",
+    "炎",
+    "滩",
+    "棋",
+    "驱",
+    "筛",
+    "峡",
+    "冒",
+    "啥",
+    "寿",
+    "译",
+    "浸",
+    "泉",
+    "帽",
+    "迟",
+    "硅",
+    "疆",
+    "贷",
+    "漏",
+    "稿",
+    "冠",
+    "嫩",
+    "胁",
+    "芯",
+    "牢",
+    "叛",
+    "蚀",
+    "奥",
+    "鸣",
+    "岭",
+    "羊",
+    "凭",
+    "串",
+    "塘",
+    "绘",
+    "酵",
+    "融",
+    "盆",
+    "锡",
+    "庙",
+    "筹",
+    "冻",
+    "辅",
+    "摄",
+    "袭",
+    "筋",
+    "拒",
+    "僚",
+    "旱",
+    "钾",
+    "鸟",
+    "漆",
+    "沈",
+    "眉",
+    "疏",
+    "添",
+    "棒",
+    "穗",
+    "硝",
+    "韩",
+    "逼",
+    "扭",
+    "侨",
+    "凉",
+    "挺",
+    "碗",
+    "栽",
+    "炒",
+    "杯",
+    "患",
+    "馏",
+    "劝",
+    "豪",
+    "辽",
+    "勃",
+    "鸿",
+    "旦",
+    "吏",
+    "拜",
+    "狗",
+    "埋",
+    "辊",
+    "掩",
+    "饮",
+    "搬",
+    "骂",
+    "辞",
+    "勾",
+    "扣",
+    "估",
+    "蒋",
+    "绒",
+    "雾",
+    "丈",
+    "朵",
+    "姆",
+    "拟",
+    "宇",
+    "辑",
+    "陕",
+    "雕",
+    "偿",
+    "蓄",
+    "崇",
+    "剪",
+    "倡",
+    "厅",
+    "咬",
+    "驶",
+    "薯",
+    "刷",
+    "斥",
+    "番",
+    "赋",
+    "奉",
+    "佛",
+    "浇",
+    "漫",
+    "曼",
+    "扇",
+    "钙",
+    "桃",
+    "扶",
+    "仔",
+    "返",
+    "俗",
+    "亏",
+    "腔",
+    "鞋",
+    "棱",
+    "覆",
+    "框",
+    "悄",
+    "叔",
+    "撞",
+    "骗",
+    "勘",
+    "旺",
+    "沸",
+    "孤",
+    "吐",
+    "孟",
+    "渠",
+    "屈",
+    "疾",
+    "妙",
+    "惜",
+    "仰",
+    "狠",
+    "胀",
+    "谐",
+    "抛",
+    "霉",
+    "桑",
+    "岗",
+    "嘛",
+    "衰",
+    "盗",
+    "渗",
+    "脏",
+    "赖",
+    "涌",
+    "甜",
+    "曹",
+    "阅",
+    "肌",
+    "哩",
+    "厉",
+    "烃",
+    "纬",
+    "毅",
+    "昨",
+    "伪",
+    "症",
+    "煮",
+    "叹",
+    "钉",
+    "搭",
+    "茎",
+    "笼",
+    "酷",
+    "偷",
+    "弓",
+    "锥",
+    "恒",
+    "杰",
+    "坑",
+    "鼻",
+    "翼",
+    "纶",
+    "叙",
+    "狱",
+    "逮",
+    "罐",
+    "络",
+    "棚",
+    "抑",
+    "膨",
+    "蔬",
+    "寺",
+    "骤",
+    "穆",
+    "冶",
+    "枯",
+    "册",
+    "尸",
+    "凸",
+    "绅",
+    "坯",
+    "牺",
+    "焰",
+    "轰",
+    "欣",
+    "晋",
+    "瘦",
+    "御",
+    "锭",
+    "锦",
+    "丧",
+    "旬",
+    "锻",
+    "垄",
+    "搜",
+    "扑",
+    "邀",
+    "亭",
+    "酯",
+    "迈",
+    "舒",
+    "脆",
+    "酶",
+    "闲",
+    "忧",
+    "酚",
+    "顽",
+    "羽",
+    "涨",
+    "卸",
+    "仗",
+    "陪",
+    "辟",
+    "惩",
+    "杭",
+    "姚",
+    "肚",
+    "捉",
+    "飘",
+    "漂",
+    "昆",
+    "欺",
+    "吾",
+    "郎",
+    "烷",
+    "汁",
+    "呵",
+    "饰",
+    "萧",
+    "雅",
+    "邮",
+    "迁",
+    "燕",
+    "撒",
+    "姻",
+    "赴",
+    "宴",
+    "烦",
+    "债",
+    "帐",
+    "斑",
+    "铃",
+    "旨",
+    "醇",
+    "董",
+    "饼",
+    "雏",
+    "姿",
+    "拌",
+    "傅",
+    "腹",
+    "妥",
+    "揉",
+    "贤",
+    "拆",
+    "歪",
+    "葡",
+    "胺",
+    "丢",
+    "浩",
+    "徽",
+    "昂",
+    "垫",
+    "挡",
+    "览",
+    "贪",
+    "慰",
+    "缴",
+    "汪",
+    "慌",
+    "冯",
+    "诺",
+    "姜",
+    "谊",
+    "凶",
+    "劣",
+    "诬",
+    "耀",
+    "昏",
+    "躺",
+    "盈",
+    "骑",
+    "乔",
+    "溪",
+    "丛",
+    "卢",
+    "抹",
+    "闷",
+    "咨",
+    "刮",
+    "驾",
+    "缆",
+    "悟",
+    "摘",
+    "铒",
+    "掷",
+    "颇",
+    "幻",
+    "柄",
+    "惠",
+    "惨",
+    "佳",
+    "仇",
+    "腊",
+    "窝",
+    "涤",
+    "剑",
+    "瞧",
+    "堡",
+    "泼",
+    "葱",
+    "罩",
+    "霍",
+    "捞",
+    "胎",
+    "苍",
+    "滨",
+    "俩",
+    "捅",
+    "湘",
+    "砍",
+    "霞",
+    "邵",
+    "萄",
+    "疯",
+    "淮",
+    "遂",
+    "熊",
+    "粪",
+    "烘",
+    "宿",
+    "档",
+    "戈",
+    "驳",
+    "嫂",
+    "裕",
+    "徙",
+    "箭",
+    "捐",
+    "肠",
+    "撑",
+    "晒",
+    "辨",
+    "殿",
+    "莲",
+    "摊",
+    "搅",
+    "酱",
+    "屏",
+    "疫",
+    "哀",
+    "蔡",
+    "堵",
+    "沫",
+    "皱",
+    "畅",
+    "叠",
+    "阁",
+    "莱",
+    "敲",
+    "辖",
+    "钩",
+    "痕",
+    "坝",
+    "巷",
+    "饿",
+    "祸",
+    "丘",
+    "玄",
+    "溜",
+    "曰",
+    "逻",
+    "彭",
+    "尝",
+    "卿",
+    "妨",
+    "艇",
+    "吞",
+    "韦",
+    "怨",
+    "矮",
+    "歇",
+}
+var rwords_chinese_simplified = map[string]int16{
+    "的": 0,
+    "一": 1,
+    "是": 2,
+    "在": 3,
+    "不": 4,
+    "了": 5,
+    "有": 6,
+    "和": 7,
+    "人": 8,
+    "这": 9,
+    "中": 10,
+    "大": 11,
+    "为": 12,
+    "上": 13,
+    "个": 14,
+    "国": 15,
+    "我": 16,
+    "以": 17,
+    "要": 18,
+    "他": 19,
+    "时": 20,
+    "来": 21,
+    "用": 22,
+    "们": 23,
+    "生": 24,
+    "到": 25,
+    "作": 26,
+    "地": 27,
+    "于": 28,
+    "出": 29,
+    "就": 30,
+    "分": 31,
+    "对": 32,
+    "成": 33,
+    "会": 34,
+    "可": 35,
+    "主": 36,
+    "发": 37,
+    "年": 38,
+    "动": 39,
+    "同": 40,
+    "工": 41,
+    "也": 42,
+    "能": 43,
+    "下": 44,
+    "过": 45,
+    "子": 46,
+    "说": 47,
+    "产": 48,
+    "种": 49,
+    "面": 50,
+    "而": 51,
+    "方": 52,
+    "后": 53,
+    "多": 54,
+    "定": 55,
+    "行": 56,
+    "学": 57,
+    "法": 58,
+    "所": 59,
+    "民": 60,
+    "得": 61,
+    "经": 62,
+    "十": 63,
+    "三": 64,
+    "之": 65,
+    "进": 66,
+    "着": 67,
+    "等": 68,
+    "部": 69,
+    "度": 70,
+    "家": 71,
+    "电": 72,
+    "力": 73,
+    "里": 74,
+    "如": 75,
+    "水": 76,
+    "化": 77,
+    "高": 78,
+    "自": 79,
+    "二": 80,
+    "理": 81,
+    "起": 82,
+    "小": 83,
+    "物": 84,
+    "现": 85,
+    "实": 86,
+    "加": 87,
+    "量": 88,
+    "都": 89,
+    "两": 90,
+    "体": 91,
+    "制": 92,
+    "机": 93,
+    "当": 94,
+    "使": 95,
+    "点": 96,
+    "从": 97,
+    "业": 98,
+    "本": 99,
+    "去": 100,
+    "把": 101,
+    "性": 102,
+    "好": 103,
+    "应": 104,
+    "开": 105,
+    "它": 106,
+    "合": 107,
+    "还": 108,
+    "因": 109,
+    "由": 110,
+    "其": 111,
+    "些": 112,
+    "然": 113,
+    "前": 114,
+    "外": 115,
+    "天": 116,
+    "政": 117,
+    "四": 118,
+    "日": 119,
+    "那": 120,
+    "社": 121,
+    "义": 122,
+    "事": 123,
+    "平": 124,
+    "形": 125,
+    "相": 126,
+    "全": 127,
+    "表": 128,
+    "间": 129,
+    "样": 130,
+    "与": 131,
+    "关": 132,
+    "各": 133,
+    "重": 134,
+    "新": 135,
+    "线": 136,
+    "内": 137,
+    "数": 138,
+    "正": 139,
+    "心": 140,
+    "反": 141,
+    "你": 142,
+    "明": 143,
+    "看": 144,
+    "原": 145,
+    "又": 146,
+    "么": 147,
+    "利": 148,
+    "比": 149,
+    "或": 150,
+    "但": 151,
+    "质": 152,
+    "气": 153,
+    "第": 154,
+    "向": 155,
+    "道": 156,
+    "命": 157,
+    "此": 158,
+    "变": 159,
+    "条": 160,
+    "只": 161,
+    "没": 162,
+    "结": 163,
+    "解": 164,
+    "问": 165,
+    "意": 166,
+    "建": 167,
+    "月": 168,
+    "公": 169,
+    "无": 170,
+    "系": 171,
+    "军": 172,
+    "很": 173,
+    "情": 174,
+    "者": 175,
+    "最": 176,
+    "立": 177,
+    "代": 178,
+    "想": 179,
+    "已": 180,
+    "通": 181,
+    "并": 182,
+    "提": 183,
+    "直": 184,
+    "题": 185,
+    "党": 186,
+    "程": 187,
+    "展": 188,
+    "五": 189,
+    "果": 190,
+    "料": 191,
+    "象": 192,
+    "员": 193,
+    "革": 194,
+    "位": 195,
+    "入": 196,
+    "常": 197,
+    "文": 198,
+    "总": 199,
+    "次": 200,
+    "品": 201,
+    "式": 202,
+    "活": 203,
+    "设": 204,
+    "及": 205,
+    "管": 206,
+    "特": 207,
+    "件": 208,
+    "长": 209,
+    "求": 210,
+    "老": 211,
+    "头": 212,
+    "基": 213,
+    "资": 214,
+    "边": 215,
+    "流": 216,
+    "路": 217,
+    "级": 218,
+    "少": 219,
+    "图": 220,
+    "山": 221,
+    "统": 222,
+    "接": 223,
+    "知": 224,
+    "较": 225,
+    "将": 226,
+    "组": 227,
+    "见": 228,
+    "计": 229,
+    "别": 230,
+    "她": 231,
+    "手": 232,
+    "角": 233,
+    "期": 234,
+    "根": 235,
+    "论": 236,
+    "运": 237,
+    "农": 238,
+    "指": 239,
+    "几": 240,
+    "九": 241,
+    "区": 242,
+    "强": 243,
+    "放": 244,
+    "决": 245,
+    "西": 246,
+    "被": 247,
+    "干": 248,
+    "做": 249,
+    "必": 250,
+    "战": 251,
+    "先": 252,
+    "回": 253,
+    "则": 254,
+    "任": 255,
+    "取": 256,
+    "据": 257,
+    "处": 258,
+    "队": 259,
+    "南": 260,
+    "给": 261,
+    "色": 262,
+    "光": 263,
+    "门": 264,
+    "即": 265,
+    "保": 266,
+    "治": 267,
+    "北": 268,
+    "造": 269,
+    "百": 270,
+    "规": 271,
+    "热": 272,
+    "领": 273,
+    "七": 274,
+    "海": 275,
+    "口": 276,
+    "东": 277,
+    "导": 278,
+    "器": 279,
+    "压": 280,
+    "志": 281,
+    "世": 282,
+    "金": 283,
+    "增": 284,
+    "争": 285,
+    "济": 286,
+    "阶": 287,
+    "油": 288,
+    "思": 289,
+    "术": 290,
+    "极": 291,
+    "交": 292,
+    "受": 293,
+    "联": 294,
+    "什": 295,
+    "认": 296,
+    "六": 297,
+    "共": 298,
+    "权": 299,
+    "收": 300,
+    "证": 301,
+    "改": 302,
+    "清": 303,
+    "美": 304,
+    "再": 305,
+    "采": 306,
+    "转": 307,
+    "更": 308,
+    "单": 309,
+    "风": 310,
+    "切": 311,
+    "打": 312,
+    "白": 313,
+    "教": 314,
+    "速": 315,
+    "花": 316,
+    "带": 317,
+    "安": 318,
+    "场": 319,
+    "身": 320,
+    "车": 321,
+    "例": 322,
+    "真": 323,
+    "务": 324,
+    "具": 325,
+    "万": 326,
+    "每": 327,
+    "目": 328,
+    "至": 329,
+    "达": 330,
+    "走": 331,
+    "积": 332,
+    "示": 333,
+    "议": 334,
+    "声": 335,
+    "报": 336,
+    "斗": 337,
+    "完": 338,
+    "类": 339,
+    "八": 340,
+    "离": 341,
+    "华": 342,
+    "名": 343,
+    "确": 344,
+    "才": 345,
+    "科": 346,
+    "张": 347,
+    "信": 348,
+    "马": 349,
+    "节": 350,
+    "话": 351,
+    "米": 352,
+    "整": 353,
+    "空": 354,
+    "元": 355,
+    "况": 356,
+    "今": 357,
+    "集": 358,
+    "温": 359,
+    "传": 360,
+    "土": 361,
+    "许": 362,
+    "步": 363,
+    "群": 364,
+    "广": 365,
+    "石": 366,
+    "记": 367,
+    "需": 368,
+    "段": 369,
+    "研": 370,
+    "界": 371,
+    "拉": 372,
+    "林": 373,
+    "律": 374,
+    "叫": 375,
+    "且": 376,
+    "究": 377,
+    "观": 378,
+    "越": 379,
+    "织": 380,
+    "装": 381,
+    "影": 382,
+    "算": 383,
+    "低": 384,
+    "持": 385,
+    "音": 386,
+    "众": 387,
+    "书": 388,
+    "布": 389,
+    "复": 390,
+    "容": 391,
+    "儿": 392,
+    "须": 393,
+    "际": 394,
+    "商": 395,
+    "非": 396,
+    "验": 397,
+    "连": 398,
+    "断": 399,
+    "深": 400,
+    "难": 401,
+    "近": 402,
+    "矿": 403,
+    "千": 404,
+    "周": 405,
+    "委": 406,
+    "素": 407,
+    "技": 408,
+    "备": 409,
+    "半": 410,
+    "办": 411,
+    "青": 412,
+    "省": 413,
+    "列": 414,
+    "习": 415,
+    "响": 416,
+    "约": 417,
+    "支": 418,
+    "般": 419,
+    "史": 420,
+    "感": 421,
+    "劳": 422,
+    "便": 423,
+    "团": 424,
+    "往": 425,
+    "酸": 426,
+    "历": 427,
+    "市": 428,
+    "克": 429,
+    "何": 430,
+    "除": 431,
+    "消": 432,
+    "构": 433,
+    "府": 434,
+    "称": 435,
+    "太": 436,
+    "准": 437,
+    "精": 438,
+    "值": 439,
+    "号": 440,
+    "率": 441,
+    "族": 442,
+    "维": 443,
+    "划": 444,
+    "选": 445,
+    "标": 446,
+    "写": 447,
+    "存": 448,
+    "候": 449,
+    "毛": 450,
+    "亲": 451,
+    "快": 452,
+    "效": 453,
+    "斯": 454,
+    "院": 455,
+    "查": 456,
+    "江": 457,
+    "型": 458,
+    "眼": 459,
+    "王": 460,
+    "按": 461,
+    "格": 462,
+    "养": 463,
+    "易": 464,
+    "置": 465,
+    "派": 466,
+    "层": 467,
+    "片": 468,
+    "始": 469,
+    "却": 470,
+    "专": 471,
+    "状": 472,
+    "育": 473,
+    "厂": 474,
+    "京": 475,
+    "识": 476,
+    "适": 477,
+    "属": 478,
+    "圆": 479,
+    "包": 480,
+    "火": 481,
+    "住": 482,
+    "调": 483,
+    "满": 484,
+    "县": 485,
+    "局": 486,
+    "照": 487,
+    "参": 488,
+    "红": 489,
+    "细": 490,
+    "引": 491,
+    "听": 492,
+    "该": 493,
+    "铁": 494,
+    "价": 495,
+    "严": 496,
+    "首": 497,
+    "底": 498,
+    "液": 499,
+    "官": 500,
+    "德": 501,
+    "随": 502,
+    "病": 503,
+    "苏": 504,
+    "失": 505,
+    "尔": 506,
+    "死": 507,
+    "讲": 508,
+    "配": 509,
+    "女": 510,
+    "黄": 511,
+    "推": 512,
+    "显": 513,
+    "谈": 514,
+    "罪": 515,
+    "神": 516,
+    "艺": 517,
+    "呢": 518,
+    "席": 519,
+    "含": 520,
+    "企": 521,
+    "望": 522,
+    "密": 523,
+    "批": 524,
+    "营": 525,
+    "项": 526,
+    "防": 527,
+    "举": 528,
+    "球": 529,
+    "英": 530,
+    "氧": 531,
+    "势": 532,
+    "告": 533,
+    "李": 534,
+    "台": 535,
+    "落": 536,
+    "木": 537,
+    "帮": 538,
+    "轮": 539,
+    "破": 540,
+    "亚": 541,
+    "师": 542,
+    "围": 543,
+    "注": 544,
+    "远": 545,
+    "字": 546,
+    "材": 547,
+    "排": 548,
+    "供": 549,
+    "河": 550,
+    "态": 551,
+    "封": 552,
+    "另": 553,
+    "施": 554,
+    "减": 555,
+    "树": 556,
+    "溶": 557,
+    "怎": 558,
+    "止": 559,
+    "案": 560,
+    "言": 561,
+    "士": 562,
+    "均": 563,
+    "武": 564,
+    "固": 565,
+    "叶": 566,
+    "鱼": 567,
+    "波": 568,
+    "视": 569,
+    "仅": 570,
+    "费": 571,
+    "紧": 572,
+    "爱": 573,
+    "左": 574,
+    "章": 575,
+    "早": 576,
+    "朝": 577,
+    "害": 578,
+    "续": 579,
+    "轻": 580,
+    "服": 581,
+    "试": 582,
+    "食": 583,
+    "充": 584,
+    "兵": 585,
+    "源": 586,
+    "判": 587,
+    "护": 588,
+    "司": 589,
+    "足": 590,
+    "某": 591,
+    "练": 592,
+    "差": 593,
+    "致": 594,
+    "板": 595,
+    "田": 596,
+    "降": 597,
+    "黑": 598,
+    "犯": 599,
+    "负": 600,
+    "击": 601,
+    "范": 602,
+    "继": 603,
+    "兴": 604,
+    "似": 605,
+    "余": 606,
+    "坚": 607,
+    "曲": 608,
+    "输": 609,
+    "修": 610,
+    "故": 611,
+    "城": 612,
+    "夫": 613,
+    "够": 614,
+    "送": 615,
+    "笔": 616,
+    "船": 617,
+    "占": 618,
+    "右": 619,
+    "财": 620,
+    "吃": 621,
+    "富": 622,
+    "春": 623,
+    "职": 624,
+    "觉": 625,
+    "汉": 626,
+    "画": 627,
+    "功": 628,
+    "巴": 629,
+    "跟": 630,
+    "虽": 631,
+    "杂": 632,
+    "飞": 633,
+    "检": 634,
+    "吸": 635,
+    "助": 636,
+    "升": 637,
+    "阳": 638,
+    "互": 639,
+    "初": 640,
+    "创": 641,
+    "抗": 642,
+    "考": 643,
+    "投": 644,
+    "坏": 645,
+    "策": 646,
+    "古": 647,
+    "径": 648,
+    "换": 649,
+    "未": 650,
+    "跑": 651,
+    "留": 652,
+    "钢": 653,
+    "曾": 654,
+    "端": 655,
+    "责": 656,
+    "站": 657,
+    "简": 658,
+    "述": 659,
+    "钱": 660,
+    "副": 661,
+    "尽": 662,
+    "帝": 663,
+    "射": 664,
+    "草": 665,
+    "冲": 666,
+    "承": 667,
+    "独": 668,
+    "令": 669,
+    "限": 670,
+    "阿": 671,
+    "宣": 672,
+    "环": 673,
+    "双": 674,
+    "请": 675,
+    "超": 676,
+    "微": 677,
+    "让": 678,
+    "控": 679,
+    "州": 680,
+    "良": 681,
+    "轴": 682,
+    "找": 683,
+    "否": 684,
+    "纪": 685,
+    "益": 686,
+    "依": 687,
+    "优": 688,
+    "顶": 689,
+    "础": 690,
+    "载": 691,
+    "倒": 692,
+    "房": 693,
+    "突": 694,
+    "坐": 695,
+    "粉": 696,
+    "敌": 697,
+    "略": 698,
+    "客": 699,
+    "袁": 700,
+    "冷": 701,
+    "胜": 702,
+    "绝": 703,
+    "析": 704,
+    "块": 705,
+    "剂": 706,
+    "测": 707,
+    "丝": 708,
+    "协": 709,
+    "诉": 710,
+    "念": 711,
+    "陈": 712,
+    "仍": 713,
+    "罗": 714,
+    "盐": 715,
+    "友": 716,
+    "洋": 717,
+    "错": 718,
+    "苦": 719,
+    "夜": 720,
+    "刑": 721,
+    "移": 722,
+    "频": 723,
+    "逐": 724,
+    "靠": 725,
+    "混": 726,
+    "母": 727,
+    "短": 728,
+    "皮": 729,
+    "终": 730,
+    "聚": 731,
+    "汽": 732,
+    "村": 733,
+    "云": 734,
+    "哪": 735,
+    "既": 736,
+    "距": 737,
+    "卫": 738,
+    "停": 739,
+    "烈": 740,
+    "央": 741,
+    "察": 742,
+    "烧": 743,
+    "迅": 744,
+    "境": 745,
+    "若": 746,
+    "印": 747,
+    "洲": 748,
+    "刻": 749,
+    "括": 750,
+    "激": 751,
+    "孔": 752,
+    "搞": 753,
+    "甚": 754,
+    "室": 755,
+    "待": 756,
+    "核": 757,
+    "校": 758,
+    "散": 759,
+    "侵": 760,
+    "吧": 761,
+    "甲": 762,
+    "游": 763,
+    "久": 764,
+    "菜": 765,
+    "味": 766,
+    "旧": 767,
+    "模": 768,
+    "湖": 769,
+    "货": 770,
+    "损": 771,
+    "预": 772,
+    "阻": 773,
+    "毫": 774,
+    "普": 775,
+    "稳": 776,
+    "乙": 777,
+    "妈": 778,
+    "植": 779,
+    "息": 780,
+    "扩": 781,
+    "银": 782,
+    "语": 783,
+    "挥": 784,
+    "酒": 785,
+    "守": 786,
+    "拿": 787,
+    "序": 788,
+    "纸": 789,
+    "医": 790,
+    "缺": 791,
+    "雨": 792,
+    "吗": 793,
+    "针": 794,
+    "刘": 795,
+    "啊": 796,
+    "急": 797,
+    "唱": 798,
+    "误": 799,
+    "训": 800,
+    "愿": 801,
+    "审": 802,
+    "附": 803,
+    "获": 804,
+    "茶": 805,
+    "鲜": 806,
+    "粮": 807,
+    "斤": 808,
+    "孩": 809,
+    "脱": 810,
+    "硫": 811,
+    "肥": 812,
+    "善": 813,
+    "龙": 814,
+    "演": 815,
+    "父": 816,
+    "渐": 817,
+    "血": 818,
+    "欢": 819,
+    "械": 820,
+    "掌": 821,
+    "歌": 822,
+    "沙": 823,
+    "刚": 824,
+    "攻": 825,
+    "谓": 826,
+    "盾": 827,
+    "讨": 828,
+    "晚": 829,
+    "粒": 830,
+    "乱": 831,
+    "燃": 832,
+    "矛": 833,
+    "乎": 834,
+    "杀": 835,
+    "药": 836,
+    "宁": 837,
+    "鲁": 838,
+    "贵": 839,
+    "钟": 840,
+    "煤": 841,
+    "读": 842,
+    "班": 843,
+    "伯": 844,
+    "香": 845,
+    "介": 846,
+    "迫": 847,
+    "句": 848,
+    "丰": 849,
+    "培": 850,
+    "握": 851,
+    "兰": 852,
+    "担": 853,
+    "弦": 854,
+    "蛋": 855,
+    "沉": 856,
+    "假": 857,
+    "穿": 858,
+    "执": 859,
+    "答": 860,
+    "乐": 861,
+    "谁": 862,
+    "顺": 863,
+    "烟": 864,
+    "缩": 865,
+    "征": 866,
+    "脸": 867,
+    "喜": 868,
+    "松": 869,
+    "脚": 870,
+    "困": 871,
+    "异": 872,
+    "免": 873,
+    "背": 874,
+    "星": 875,
+    "福": 876,
+    "买": 877,
+    "染": 878,
+    "井": 879,
+    "概": 880,
+    "慢": 881,
+    "怕": 882,
+    "磁": 883,
+    "倍": 884,
+    "祖": 885,
+    "皇": 886,
+    "促": 887,
+    "静": 888,
+    "补": 889,
+    "评": 890,
+    "翻": 891,
+    "肉": 892,
+    "践": 893,
+    "尼": 894,
+    "衣": 895,
+    "宽": 896,
+    "扬": 897,
+    "棉": 898,
+    "希": 899,
+    "伤": 900,
+    "操": 901,
+    "垂": 902,
+    "秋": 903,
+    "宜": 904,
+    "氢": 905,
+    "套": 906,
+    "督": 907,
+    "振": 908,
+    "架": 909,
+    "亮": 910,
+    "末": 911,
+    "宪": 912,
+    "庆": 913,
+    "编": 914,
+    "牛": 915,
+    "触": 916,
+    "映": 917,
+    "雷": 918,
+    "销": 919,
+    "诗": 920,
+    "座": 921,
+    "居": 922,
+    "抓": 923,
+    "裂": 924,
+    "胞": 925,
+    "呼": 926,
+    "娘": 927,
+    "景": 928,
+    "威": 929,
+    "绿": 930,
+    "晶": 931,
+    "厚": 932,
+    "盟": 933,
+    "衡": 934,
+    "鸡": 935,
+    "孙": 936,
+    "延": 937,
+    "危": 938,
+    "胶": 939,
+    "屋": 940,
+    "乡": 941,
+    "临": 942,
+    "陆": 943,
+    "顾": 944,
+    "掉": 945,
+    "呀": 946,
+    "灯": 947,
+    "岁": 948,
+    "措": 949,
+    "束": 950,
+    "耐": 951,
+    "剧": 952,
+    "玉": 953,
+    "赵": 954,
+    "跳": 955,
+    "哥": 956,
+    "季": 957,
+    "课": 958,
+    "凯": 959,
+    "胡": 960,
+    "额": 961,
+    "款": 962,
+    "绍": 963,
+    "卷": 964,
+    "齐": 965,
+    "伟": 966,
+    "蒸": 967,
+    "殖": 968,
+    "永": 969,
+    "宗": 970,
+    "苗": 971,
+    "川": 972,
+    "炉": 973,
+    "岩": 974,
+    "弱": 975,
+    "零": 976,
+    "杨": 977,
+    "奏": 978,
+    "沿": 979,
+    "露": 980,
+    "杆": 981,
+    "探": 982,
+    "滑": 983,
+    "镇": 984,
+    "饭": 985,
+    "浓": 986,
+    "航": 987,
+    "怀": 988,
+    "赶": 989,
+    "库": 990,
+    "夺": 991,
+    "伊": 992,
+    "灵": 993,
+    "税": 994,
+    "途": 995,
+    "灭": 996,
+    "赛": 997,
+    "归": 998,
+    "召": 999,
+    "鼓": 1000,
+    "播": 1001,
+    "盘": 1002,
+    "裁": 1003,
+    "险": 1004,
+    "康": 1005,
+    "唯": 1006,
+    "录": 1007,
+    "菌": 1008,
+    "纯": 1009,
+    "借": 1010,
+    "糖": 1011,
+    "盖": 1012,
+    "横": 1013,
+    "符": 1014,
+    "私": 1015,
+    "努": 1016,
+    "堂": 1017,
+    "域": 1018,
+    "枪": 1019,
+    "润": 1020,
+    "幅": 1021,
+    "哈": 1022,
+    "竟": 1023,
+    "熟": 1024,
+    "虫": 1025,
+    "泽": 1026,
+    "脑": 1027,
+    "壤": 1028,
+    "碳": 1029,
+    "欧": 1030,
+    "遍": 1031,
+    "侧": 1032,
+    "寨": 1033,
+    "敢": 1034,
+    "彻": 1035,
+    "虑": 1036,
+    "斜": 1037,
+    "薄": 1038,
+    "庭": 1039,
+    "纳": 1040,
+    "弹": 1041,
+    "饲": 1042,
+    "伸": 1043,
+    "折": 1044,
+    "麦": 1045,
+    "湿": 1046,
+    "暗": 1047,
+    "荷": 1048,
+    "瓦": 1049,
+    "塞": 1050,
+    "床": 1051,
+    "筑": 1052,
+    "恶": 1053,
+    "户": 1054,
+    "访": 1055,
+    "塔": 1056,
+    "奇": 1057,
+    "透": 1058,
+    "梁": 1059,
+    "刀": 1060,
+    "旋": 1061,
+    "迹": 1062,
+    "卡": 1063,
+    "氯": 1064,
+    "遇": 1065,
+    "份": 1066,
+    "毒": 1067,
+    "泥": 1068,
+    "退": 1069,
+    "洗": 1070,
+    "摆": 1071,
+    "灰": 1072,
+    "彩": 1073,
+    "卖": 1074,
+    "耗": 1075,
+    "夏": 1076,
+    "择": 1077,
+    "忙": 1078,
+    "铜": 1079,
+    "献": 1080,
+    "硬": 1081,
+    "予": 1082,
+    "繁": 1083,
+    "圈": 1084,
+    "雪": 1085,
+    "函": 1086,
+    "亦": 1087,
+    "抽": 1088,
+    "篇": 1089,
+    "阵": 1090,
+    "阴": 1091,
+    "丁": 1092,
+    "尺": 1093,
+    "追": 1094,
+    "堆": 1095,
+    "雄": 1096,
+    "迎": 1097,
+    "泛": 1098,
+    "爸": 1099,
+    "楼": 1100,
+    "避": 1101,
+    "谋": 1102,
+    "吨": 1103,
+    "野": 1104,
+    "猪": 1105,
+    "旗": 1106,
+    "累": 1107,
+    "偏": 1108,
+    "典": 1109,
+    "馆": 1110,
+    "索": 1111,
+    "秦": 1112,
+    "脂": 1113,
+    "潮": 1114,
+    "爷": 1115,
+    "豆": 1116,
+    "忽": 1117,
+    "托": 1118,
+    "惊": 1119,
+    "塑": 1120,
+    "遗": 1121,
+    "愈": 1122,
+    "朱": 1123,
+    "替": 1124,
+    "纤": 1125,
+    "粗": 1126,
+    "倾": 1127,
+    "尚": 1128,
+    "痛": 1129,
+    "楚": 1130,
+    "谢": 1131,
+    "奋": 1132,
+    "购": 1133,
+    "磨": 1134,
+    "君": 1135,
+    "池": 1136,
+    "旁": 1137,
+    "碎": 1138,
+    "骨": 1139,
+    "监": 1140,
+    "捕": 1141,
+    "弟": 1142,
+    "暴": 1143,
+    "割": 1144,
+    "贯": 1145,
+    "殊": 1146,
+    "释": 1147,
+    "词": 1148,
+    "亡": 1149,
+    "壁": 1150,
+    "顿": 1151,
+    "宝": 1152,
+    "午": 1153,
+    "尘": 1154,
+    "闻": 1155,
+    "揭": 1156,
+    "炮": 1157,
+    "残": 1158,
+    "冬": 1159,
+    "桥": 1160,
+    "妇": 1161,
+    "警": 1162,
+    "综": 1163,
+    "招": 1164,
+    "吴": 1165,
+    "付": 1166,
+    "浮": 1167,
+    "遭": 1168,
+    "徐": 1169,
+    "您": 1170,
+    "摇": 1171,
+    "谷": 1172,
+    "赞": 1173,
+    "箱": 1174,
+    "隔": 1175,
+    "订": 1176,
+    "男": 1177,
+    "吹": 1178,
+    "园": 1179,
+    "纷": 1180,
+    "唐": 1181,
+    "败": 1182,
+    "宋": 1183,
+    "玻": 1184,
+    "巨": 1185,
+    "耕": 1186,
+    "坦": 1187,
+    "荣": 1188,
+    "闭": 1189,
+    "湾": 1190,
+    "键": 1191,
+    "凡": 1192,
+    "驻": 1193,
+    "锅": 1194,
+    "救": 1195,
+    "恩": 1196,
+    "剥": 1197,
+    "凝": 1198,
+    "碱": 1199,
+    "齿": 1200,
+    "截": 1201,
+    "炼": 1202,
+    "麻": 1203,
+    "纺": 1204,
+    "禁": 1205,
+    "废": 1206,
+    "盛": 1207,
+    "版": 1208,
+    "缓": 1209,
+    "净": 1210,
+    "睛": 1211,
+    "昌": 1212,
+    "婚": 1213,
+    "涉": 1214,
+    "筒": 1215,
+    "嘴": 1216,
+    "插": 1217,
+    "岸": 1218,
+    "朗": 1219,
+    "庄": 1220,
+    "街": 1221,
+    "藏": 1222,
+    "姑": 1223,
+    "贸": 1224,
+    "腐": 1225,
+    "奴": 1226,
+    "啦": 1227,
+    "惯": 1228,
+    "乘": 1229,
+    "伙": 1230,
+    "恢": 1231,
+    "匀": 1232,
+    "纱": 1233,
+    "扎": 1234,
+    "辩": 1235,
+    "耳": 1236,
+    "彪": 1237,
+    "臣": 1238,
+    "亿": 1239,
+    "璃": 1240,
+    "抵": 1241,
+    "脉": 1242,
+    "秀": 1243,
+    "萨": 1244,
+    "俄": 1245,
+    "网": 1246,
+    "舞": 1247,
+    "店": 1248,
+    "喷": 1249,
+    "纵": 1250,
+    "寸": 1251,
+    "汗": 1252,
+    "挂": 1253,
+    "洪": 1254,
+    "贺": 1255,
+    "闪": 1256,
+    "柬": 1257,
+    "爆": 1258,
+    "烯": 1259,
+    "津": 1260,
+    "稻": 1261,
+    "墙": 1262,
+    "软": 1263,
+    "勇": 1264,
+    "像": 1265,
+    "滚": 1266,
+    "厘": 1267,
+    "蒙": 1268,
+    "芳": 1269,
+    "肯": 1270,
+    "坡": 1271,
+    "柱": 1272,
+    "荡": 1273,
+    "腿": 1274,
+    "仪": 1275,
+    "旅": 1276,
+    "尾": 1277,
+    "轧": 1278,
+    "冰": 1279,
+    "贡": 1280,
+    "登": 1281,
+    "黎": 1282,
+    "削": 1283,
+    "钻": 1284,
+    "勒": 1285,
+    "逃": 1286,
+    "障": 1287,
+    "氨": 1288,
+    "郭": 1289,
+    "峰": 1290,
+    "币": 1291,
+    "港": 1292,
+    "伏": 1293,
+    "轨": 1294,
+    "亩": 1295,
+    "毕": 1296,
+    "擦": 1297,
+    "莫": 1298,
+    "刺": 1299,
+    "浪": 1300,
+    "秘": 1301,
+    "援": 1302,
+    "株": 1303,
+    "健": 1304,
+    "售": 1305,
+    "股": 1306,
+    "岛": 1307,
+    "甘": 1308,
+    "泡": 1309,
+    "睡": 1310,
+    "童": 1311,
+    "铸": 1312,
+    "汤": 1313,
+    "阀": 1314,
+    "休": 1315,
+    "汇": 1316,
+    "舍": 1317,
+    "牧": 1318,
+    "绕": 1319,
+    "炸": 1320,
+    "哲": 1321,
+    "磷": 1322,
+    "绩": 1323,
+    "朋": 1324,
+    "淡": 1325,
+    "尖": 1326,
+    "启": 1327,
+    "陷": 1328,
+    "柴": 1329,
+    "呈": 1330,
+    "徒": 1331,
+    "颜": 1332,
+    "泪": 1333,
+    "稍": 1334,
+    "忘": 1335,
+    "泵": 1336,
+    "蓝": 1337,
+    "拖": 1338,
+    "洞": 1339,
+    "授": 1340,
+    "镜": 1341,
+    "辛": 1342,
+    "壮": 1343,
+    "锋": 1344,
+    "贫": 1345,
+    "虚": 1346,
+    "弯": 1347,
+    "摩": 1348,
+    "泰": 1349,
+    "幼": 1350,
+    "廷": 1351,
+    "尊": 1352,
+    "窗": 1353,
+    "纲": 1354,
+    "弄": 1355,
+    "隶": 1356,
+    "疑": 1357,
+    "氏": 1358,
+    "宫": 1359,
+    "姐": 1360,
+    "震": 1361,
+    "瑞": 1362,
+    "怪": 1363,
+    "尤": 1364,
+    "琴": 1365,
+    "循": 1366,
+    "描": 1367,
+    "膜": 1368,
+    "违": 1369,
+    "夹": 1370,
+    "腰": 1371,
+    "缘": 1372,
+    "珠": 1373,
+    "穷": 1374,
+    "森": 1375,
+    "枝": 1376,
+    "竹": 1377,
+    "沟": 1378,
+    "催": 1379,
+    "绳": 1380,
+    "忆": 1381,
+    "邦": 1382,
+    "剩": 1383,
+    "幸": 1384,
+    "浆": 1385,
+    "栏": 1386,
+    "拥": 1387,
+    "牙": 1388,
+    "贮": 1389,
+    "礼": 1390,
+    "滤": 1391,
+    "钠": 1392,
+    "纹": 1393,
+    "罢": 1394,
+    "拍": 1395,
+    "咱": 1396,
+    "喊": 1397,
+    "袖": 1398,
+    "埃": 1399,
+    "勤": 1400,
+    "罚": 1401,
+    "焦": 1402,
+    "潜": 1403,
+    "伍": 1404,
+    "墨": 1405,
+    "欲": 1406,
+    "缝": 1407,
+    "姓": 1408,
+    "刊": 1409,
+    "饱": 1410,
+    "仿": 1411,
+    "奖": 1412,
+    "铝": 1413,
+    "鬼": 1414,
+    "丽": 1415,
+    "跨": 1416,
+    "默": 1417,
+    "挖": 1418,
+    "链": 1419,
+    "扫": 1420,
+    "喝": 1421,
+    "袋": 1422,
+    "炭": 1423,
+    "污": 1424,
+    "幕": 1425,
+    "诸": 1426,
+    "弧": 1427,
+    "励": 1428,
+    "梅": 1429,
+    "奶": 1430,
+    "洁": 1431,
+    "灾": 1432,
+    "舟": 1433,
+    "鉴": 1434,
+    "苯": 1435,
+    "讼": 1436,
+    "抱": 1437,
+    "毁": 1438,
+    "懂": 1439,
+    "寒": 1440,
+    "智": 1441,
+    "埔": 1442,
+    "寄": 1443,
+    "届": 1444,
+    "跃": 1445,
+    "渡": 1446,
+    "挑": 1447,
+    "丹": 1448,
+    "艰": 1449,
+    "贝": 1450,
+    "碰": 1451,
+    "拔": 1452,
+    "爹": 1453,
+    "戴": 1454,
+    "码": 1455,
+    "梦": 1456,
+    "芽": 1457,
+    "熔": 1458,
+    "赤": 1459,
+    "渔": 1460,
+    "哭": 1461,
+    "敬": 1462,
+    "颗": 1463,
+    "奔": 1464,
+    "铅": 1465,
+    "仲": 1466,
+    "虎": 1467,
+    "稀": 1468,
+    "妹": 1469,
+    "乏": 1470,
+    "珍": 1471,
+    "申": 1472,
+    "桌": 1473,
+    "遵": 1474,
+    "允": 1475,
+    "隆": 1476,
+    "螺": 1477,
+    "仓": 1478,
+    "魏": 1479,
+    "锐": 1480,
+    "晓": 1481,
+    "氮": 1482,
+    "兼": 1483,
+    "隐": 1484,
+    "碍": 1485,
+    "赫": 1486,
+    "拨": 1487,
+    "忠": 1488,
+    "肃": 1489,
+    "缸": 1490,
+    "牵": 1491,
+    "抢": 1492,
+    "博": 1493,
+    "巧": 1494,
+    "壳": 1495,
+    "兄": 1496,
+    "杜": 1497,
+    "讯": 1498,
+    "诚": 1499,
+    "碧": 1500,
+    "祥": 1501,
+    "柯": 1502,
+    "页": 1503,
+    "巡": 1504,
+    "矩": 1505,
+    "悲": 1506,
+    "灌": 1507,
+    "龄": 1508,
+    "伦": 1509,
+    "票": 1510,
+    "寻": 1511,
+    "桂": 1512,
+    "铺": 1513,
+    "圣": 1514,
+    "恐": 1515,
+    "恰": 1516,
+    "郑": 1517,
+    "趣": 1518,
+    "抬": 1519,
+    "荒": 1520,
+    "腾": 1521,
+    "贴": 1522,
+    "柔": 1523,
+    "滴": 1524,
+    "猛": 1525,
+    "阔": 1526,
+    "辆": 1527,
+    "妻": 1528,
+    "填": 1529,
+    "撤": 1530,
+    "储": 1531,
+    "签": 1532,
+    "闹": 1533,
+    "扰": 1534,
+    "紫": 1535,
+    "砂": 1536,
+    "递": 1537,
+    "戏": 1538,
+    "吊": 1539,
+    "陶": 1540,
+    "伐": 1541,
+    "喂": 1542,
+    "疗": 1543,
+    "瓶": 1544,
+    "婆": 1545,
+    "抚": 1546,
+    "臂": 1547,
+    "摸": 1548,
+    "忍": 1549,
+    "虾": 1550,
+    "蜡": 1551,
+    "邻": 1552,
+    "胸": 1553,
+    "巩": 1554,
+    "挤": 1555,
+    "偶": 1556,
+    "弃": 1557,
+    "槽": 1558,
+    "劲": 1559,
+    "乳": 1560,
+    "邓": 1561,
+    "吉": 1562,
+    "仁": 1563,
+    "烂": 1564,
+    "砖": 1565,
+    "租": 1566,
+    "乌": 1567,
+    "舰": 1568,
+    "伴": 1569,
+    "瓜": 1570,
+    "浅": 1571,
+    "丙": 1572,
+    "暂": 1573,
+    "燥": 1574,
+    "橡": 1575,
+    "柳": 1576,
+    "迷": 1577,
+    "暖": 1578,
+    "牌": 1579,
+    "秧": 1580,
+    "胆": 1581,
+    "详": 1582,
+    "簧": 1583,
+    "踏": 1584,
+    "瓷": 1585,
+    "谱": 1586,
+    "呆": 1587,
+    "宾": 1588,
+    "糊": 1589,
+    "洛": 1590,
+    "辉": 1591,
+    "愤": 1592,
+    "竞": 1593,
+    "隙": 1594,
+    "怒": 1595,
+    "粘": 1596,
+    "乃": 1597,
+    "绪": 1598,
+    "肩": 1599,
+    "籍": 1600,
+    "敏": 1601,
+    "涂": 1602,
+    "熙": 1603,
+    "皆": 1604,
+    "侦": 1605,
+    "悬": 1606,
+    "掘": 1607,
+    "享": 1608,
+    "纠": 1609,
+    "醒": 1610,
+    "狂": 1611,
+    "锁": 1612,
+    "淀": 1613,
+    "恨": 1614,
+    "牲": 1615,
+    "霸": 1616,
+    "爬": 1617,
+    "赏": 1618,
+    "逆": 1619,
+    "玩": 1620,
+    "陵": 1621,
+    "祝": 1622,
+    "秒": 1623,
+    "浙": 1624,
+    "貌": 1625,
+    "役": 1626,
+    "彼": 1627,
+    "悉": 1628,
+    "鸭": 1629,
+    "趋": 1630,
+    "凤": 1631,
+    "晨": 1632,
+    "畜": 1633,
+    "辈": 1634,
+    "秩": 1635,
+    "卵": 1636,
+    "署": 1637,
+    "梯": 1638,
+    "炎": 1639,
+    "滩": 1640,
+    "棋": 1641,
+    "驱": 1642,
+    "筛": 1643,
+    "峡": 1644,
+    "冒": 1645,
+    "啥": 1646,
+    "寿": 1647,
+    "译": 1648,
+    "浸": 1649,
+    "泉": 1650,
+    "帽": 1651,
+    "迟": 1652,
+    "硅": 1653,
+    "疆": 1654,
+    "贷": 1655,
+    "漏": 1656,
+    "稿": 1657,
+    "冠": 1658,
+    "嫩": 1659,
+    "胁": 1660,
+    "芯": 1661,
+    "牢": 1662,
+    "叛": 1663,
+    "蚀": 1664,
+    "奥": 1665,
+    "鸣": 1666,
+    "岭": 1667,
+    "羊": 1668,
+    "凭": 1669,
+    "串": 1670,
+    "塘": 1671,
+    "绘": 1672,
+    "酵": 1673,
+    "融": 1674,
+    "盆": 1675,
+    "锡": 1676,
+    "庙": 1677,
+    "筹": 1678,
+    "冻": 1679,
+    "辅": 1680,
+    "摄": 1681,
+    "袭": 1682,
+    "筋": 1683,
+    "拒": 1684,
+    "僚": 1685,
+    "旱": 1686,
+    "钾": 1687,
+    "鸟": 1688,
+    "漆": 1689,
+    "沈": 1690,
+    "眉": 1691,
+    "疏": 1692,
+    "添": 1693,
+    "棒": 1694,
+    "穗": 1695,
+    "硝": 1696,
+    "韩": 1697,
+    "逼": 1698,
+    "扭": 1699,
+    "侨": 1700,
+    "凉": 1701,
+    "挺": 1702,
+    "碗": 1703,
+    "栽": 1704,
+    "炒": 1705,
+    "杯": 1706,
+    "患": 1707,
+    "馏": 1708,
+    "劝": 1709,
+    "豪": 1710,
+    "辽": 1711,
+    "勃": 1712,
+    "鸿": 1713,
+    "旦": 1714,
+    "吏": 1715,
+    "拜": 1716,
+    "狗": 1717,
+    "埋": 1718,
+    "辊": 1719,
+    "掩": 1720,
+    "饮": 1721,
+    "搬": 1722,
+    "骂": 1723,
+    "辞": 1724,
+    "勾": 1725,
+    "扣": 1726,
+    "估": 1727,
+    "蒋": 1728,
+    "绒": 1729,
+    "雾": 1730,
+    "丈": 1731,
+    "朵": 1732,
+    "姆": 1733,
+    "拟": 1734,
+    "宇": 1735,
+    "辑": 1736,
+    "陕": 1737,
+    "雕": 1738,
+    "偿": 1739,
+    "蓄": 1740,
+    "崇": 1741,
+    "剪": 1742,
+    "倡": 1743,
+    "厅": 1744,
+    "咬": 1745,
+    "驶": 1746,
+    "薯": 1747,
+    "刷": 1748,
+    "斥": 1749,
+    "番": 1750,
+    "赋": 1751,
+    "奉": 1752,
+    "佛": 1753,
+    "浇": 1754,
+    "漫": 1755,
+    "曼": 1756,
+    "扇": 1757,
+    "钙": 1758,
+    "桃": 1759,
+    "扶": 1760,
+    "仔": 1761,
+    "返": 1762,
+    "俗": 1763,
+    "亏": 1764,
+    "腔": 1765,
+    "鞋": 1766,
+    "棱": 1767,
+    "覆": 1768,
+    "框": 1769,
+    "悄": 1770,
+    "叔": 1771,
+    "撞": 1772,
+    "骗": 1773,
+    "勘": 1774,
+    "旺": 1775,
+    "沸": 1776,
+    "孤": 1777,
+    "吐": 1778,
+    "孟": 1779,
+    "渠": 1780,
+    "屈": 1781,
+    "疾": 1782,
+    "妙": 1783,
+    "惜": 1784,
+    "仰": 1785,
+    "狠": 1786,
+    "胀": 1787,
+    "谐": 1788,
+    "抛": 1789,
+    "霉": 1790,
+    "桑": 1791,
+    "岗": 1792,
+    "嘛": 1793,
+    "衰": 1794,
+    "盗": 1795,
+    "渗": 1796,
+    "脏": 1797,
+    "赖": 1798,
+    "涌": 1799,
+    "甜": 1800,
+    "曹": 1801,
+    "阅": 1802,
+    "肌": 1803,
+    "哩": 1804,
+    "厉": 1805,
+    "烃": 1806,
+    "纬": 1807,
+    "毅": 1808,
+    "昨": 1809,
+    "伪": 1810,
+    "症": 1811,
+    "煮": 1812,
+    "叹": 1813,
+    "钉": 1814,
+    "搭": 1815,
+    "茎": 1816,
+    "笼": 1817,
+    "酷": 1818,
+    "偷": 1819,
+    "弓": 1820,
+    "锥": 1821,
+    "恒": 1822,
+    "杰": 1823,
+    "坑": 1824,
+    "鼻": 1825,
+    "翼": 1826,
+    "纶": 1827,
+    "叙": 1828,
+    "狱": 1829,
+    "逮": 1830,
+    "罐": 1831,
+    "络": 1832,
+    "棚": 1833,
+    "抑": 1834,
+    "膨": 1835,
+    "蔬": 1836,
+    "寺": 1837,
+    "骤": 1838,
+    "穆": 1839,
+    "冶": 1840,
+    "枯": 1841,
+    "册": 1842,
+    "尸": 1843,
+    "凸": 1844,
+    "绅": 1845,
+    "坯": 1846,
+    "牺": 1847,
+    "焰": 1848,
+    "轰": 1849,
+    "欣": 1850,
+    "晋": 1851,
+    "瘦": 1852,
+    "御": 1853,
+    "锭": 1854,
+    "锦": 1855,
+    "丧": 1856,
+    "旬": 1857,
+    "锻": 1858,
+    "垄": 1859,
+    "搜": 1860,
+    "扑": 1861,
+    "邀": 1862,
+    "亭": 1863,
+    "酯": 1864,
+    "迈": 1865,
+    "舒": 1866,
+    "脆": 1867,
+    "酶": 1868,
+    "闲": 1869,
+    "忧": 1870,
+    "酚": 1871,
+    "顽": 1872,
+    "羽": 1873,
+    "涨": 1874,
+    "卸": 1875,
+    "仗": 1876,
+    "陪": 1877,
+    "辟": 1878,
+    "惩": 1879,
+    "杭": 1880,
+    "姚": 1881,
+    "肚": 1882,
+    "捉": 1883,
+    "飘": 1884,
+    "漂": 1885,
+    "昆": 1886,
+    "欺": 1887,
+    "吾": 1888,
+    "郎": 1889,
+    "烷": 1890,
+    "汁": 1891,
+    "呵": 1892,
+    "饰": 1893,
+    "萧": 1894,
+    "雅": 1895,
+    "邮": 1896,
+    "迁": 1897,
+    "燕": 1898,
+    "撒": 1899,
+    "姻": 1900,
+    "赴": 1901,
+    "宴": 1902,
+    "烦": 1903,
+    "债": 1904,
+    "帐": 1905,
+    "斑": 1906,
+    "铃": 1907,
+    "旨": 1908,
+    "醇": 1909,
+    "董": 1910,
+    "饼": 1911,
+    "雏": 1912,
+    "姿": 1913,
+    "拌": 1914,
+    "傅": 1915,
+    "腹": 1916,
+    "妥": 1917,
+    "揉": 1918,
+    "贤": 1919,
+    "拆": 1920,
+    "歪": 1921,
+    "葡": 1922,
+    "胺": 1923,
+    "丢": 1924,
+    "浩": 1925,
+    "徽": 1926,
+    "昂": 1927,
+    "垫": 1928,
+    "挡": 1929,
+    "览": 1930,
+    "贪": 1931,
+    "慰": 1932,
+    "缴": 1933,
+    "汪": 1934,
+    "慌": 1935,
+    "冯": 1936,
+    "诺": 1937,
+    "姜": 1938,
+    "谊": 1939,
+    "凶": 1940,
+    "劣": 1941,
+    "诬": 1942,
+    "耀": 1943,
+    "昏": 1944,
+    "躺": 1945,
+    "盈": 1946,
+    "骑": 1947,
+    "乔": 1948,
+    "溪": 1949,
+    "丛": 1950,
+    "卢": 1951,
+    "抹": 1952,
+    "闷": 1953,
+    "咨": 1954,
+    "刮": 1955,
+    "驾": 1956,
+    "缆": 1957,
+    "悟": 1958,
+    "摘": 1959,
+    "铒": 1960,
+    "掷": 1961,
+    "颇": 1962,
+    "幻": 1963,
+    "柄": 1964,
+    "惠": 1965,
+    "惨": 1966,
+    "佳": 1967,
+    "仇": 1968,
+    "腊": 1969,
+    "窝": 1970,
+    "涤": 1971,
+    "剑": 1972,
+    "瞧": 1973,
+    "堡": 1974,
+    "泼": 1975,
+    "葱": 1976,
+    "罩": 1977,
+    "霍": 1978,
+    "捞": 1979,
+    "胎": 1980,
+    "苍": 1981,
+    "滨": 1982,
+    "俩": 1983,
+    "捅": 1984,
+    "湘": 1985,
+    "砍": 1986,
+    "霞": 1987,
+    "邵": 1988,
+    "萄": 1989,
+    "疯": 1990,
+    "淮": 1991,
+    "遂": 1992,
+    "熊": 1993,
+    "粪": 1994,
+    "烘": 1995,
+    "宿": 1996,
+    "档": 1997,
+    "戈": 1998,
+    "驳": 1999,
+    "嫂": 2000,
+    "裕": 2001,
+    "徙": 2002,
+    "箭": 2003,
+    "捐": 2004,
+    "肠": 2005,
+    "撑": 2006,
+    "晒": 2007,
+    "辨": 2008,
+    "殿": 2009,
+    "莲": 2010,
+    "摊": 2011,
+    "搅": 2012,
+    "酱": 2013,
+    "屏": 2014,
+    "疫": 2015,
+    "哀": 2016,
+    "蔡": 2017,
+    "堵": 2018,
+    "沫": 2019,
+    "皱": 2020,
+    "畅": 2021,
+    "叠": 2022,
+    "阁": 2023,
+    "莱": 2024,
+    "敲": 2025,
+    "辖": 2026,
+    "钩": 2027,
+    "痕": 2028,
+    "坝": 2029,
+    "巷": 2030,
+    "饿": 2031,
+    "祸": 2032,
+    "丘": 2033,
+    "玄": 2034,
+    "溜": 2035,
+    "曰": 2036,
+    "逻": 2037,
+    "彭": 2038,
+    "尝": 2039,
+    "卿": 2040,
+    "妨": 2041,
+    "艇": 2042,
+    "吞": 2043,
+    "韦": 2044,
+    "怨": 2045,
+    "矮": 2046,
+    "歇": 2047,
+}
+var words_chinese_traditional = [2048]string{
+    "的",
+    "一",
+    "是",
+    "在",
+    "不",
+    "了",
+    "有",
+    "和",
+    "人",
+    "這",
+    "中",
+    "大",
+    "為",
+    "上",
+    "個",
+    "國",
+    "我",
+    "以",
+    "要",
+    "他",
+    "時",
+    "來",
+    "用",
+    "們",
+    "生",
+    "到",
+    "作",
+    "地",
+    "於",
+    "出",
+    "就",
+    "分",
+    "對",
+    "成",
+    "會",
+    "可",
+    "主",
+    "發",
+    "年",
+    "動",
+    "同",
+    "工",
+    "也",
+    "能",
+    "下",
+    "過",
+    "子",
+    "說",
+    "產",
+    "種",
+    "面",
+    "而",
+    "方",
+    "後",
+    "多",
+    "定",
+    "行",
+    "學",
+    "法",
+    "所",
+    "民",
+    "得",
+    "經",
+    "十",
+    "三",
+    "之",
+    "進",
+    "著",
+    "等",
+    "部",
+    "度",
+    "家",
+    "電",
+    "力",
+    "裡",
+    "如",
+    "水",
+    "化",
+    "高",
+    "自",
+    "二",
+    "理",
+    "起",
+    "小",
+    "物",
+    "現",
+    "實",
+    "加",
+    "量",
+    "都",
+    "兩",
+    "體",
+    "制",
+    "機",
+    "當",
+    "使",
+    "點",
+    "從",
+    "業",
+    "本",
+    "去",
+    "把",
+    "性",
+    "好",
+    "應",
+    "開",
+    "它",
+    "合",
+    "還",
+    "因",
+    "由",
+    "其",
+    "些",
+    "然",
+    "前",
+    "外",
+    "天",
+    "政",
+    "四",
+    "日",
+    "那",
+    "社",
+    "義",
+    "事",
+    "平",
+    "形",
+    "相",
+    "全",
+    "表",
+    "間",
+    "樣",
+    "與",
+    "關",
+    "各",
+    "重",
+    "新",
+    "線",
+    "內",
+    "數",
+    "正",
+    "心",
+    "反",
+    "你",
+    "明",
+    "看",
+    "原",
+    "又",
+    "麼",
+    "利",
+    "比",
+    "或",
+    "但",
+    "質",
+    "氣",
+    "第",
+    "向",
+    "道",
+    "命",
+    "此",
+    "變",
+    "條",
+    "只",
+    "沒",
+    "結",
+    "解",
+    "問",
+    "意",
+    "建",
+    "月",
+    "公",
+    "無",
+    "系",
+    "軍",
+    "很",
+    "情",
+    "者",
+    "最",
+    "立",
+    "代",
+    "想",
+    "已",
+    "通",
+    "並",
+    "提",
+    "直",
+    "題",
+    "黨",
+    "程",
+    "展",
+    "五",
+    "果",
+    "料",
+    "象",
+    "員",
+    "革",
+    "位",
+    "入",
+    "常",
+    "文",
+    "總",
+    "次",
+    "品",
+    "式",
+    "活",
+    "設",
+    "及",
+    "管",
+    "特",
+    "件",
+    "長",
+    "求",
+    "老",
+    "頭",
+    "基",
+    "資",
+    "邊",
+    "流",
+    "路",
+    "級",
+    "少",
+    "圖",
+    "山",
+    "統",
+    "接",
+    "知",
+    "較",
+    "將",
+    "組",
+    "見",
+    "計",
+    "別",
+    "她",
+    "手",
+    "角",
+    "期",
+    "根",
+    "論",
+    "運",
+    "農",
+    "指",
+    "幾",
+    "九",
+    "區",
+    "強",
+    "放",
+    "決",
+    "西",
+    "被",
+    "幹",
+    "做",
+    "必",
+    "戰",
+    "先",
+    "回",
+    "則",
+    "任",
+    "取",
+    "據",
+    "處",
+    "隊",
+    "南",
+    "給",
+    "色",
+    "光",
+    "門",
+    "即",
+    "保",
+    "治",
+    "北",
+    "造",
+    "百",
+    "規",
+    "熱",
+    "領",
+    "七",
+    "海",
+    "口",
+    "東",
+    "導",
+    "器",
+    "壓",
+    "志",
+    "世",
+    "金",
+    "增",
+    "爭",
+    "濟",
+    "階",
+    "油",
+    "思",
+    "術",
+    "極",
+    "交",
+    "受",
+    "聯",
+    "什",
+    "認",
+    "六",
+    "共",
+    "權",
+    "收",
+    "證",
+    "改",
+    "清",
+    "美",
+    "再",
+    "採",
+    "轉",
+    "更",
+    "單",
+    "風",
+    "切",
+    "打",
+    "白",
+    "教",
+    "速",
+    "花",
+    "帶",
+    "安",
+    "場",
+    "身",
+    "車",
+    "例",
+    "真",
+    "務",
+    "具",
+    "萬",
+    "每",
+    "目",
+    "至",
+    "達",
+    "走",
+    "積",
+    "示",
+    "議",
+    "聲",
+    "報",
+    "鬥",
+    "完",
+    "類",
+    "八",
+    "離",
+    "華",
+    "名",
+    "確",
+    "才",
+    "科",
+    "張",
+    "信",
+    "馬",
+    "節",
+    "話",
+    "米",
+    "整",
+    "空",
+    "元",
+    "況",
+    "今",
+    "集",
+    "溫",
+    "傳",
+    "土",
+    "許",
+    "步",
+    "群",
+    "廣",
+    "石",
+    "記",
+    "需",
+    "段",
+    "研",
+    "界",
+    "拉",
+    "林",
+    "律",
+    "叫",
+    "且",
+    "究",
+    "觀",
+    "越",
+    "織",
+    "裝",
+    "影",
+    "算",
+    "低",
+    "持",
+    "音",
+    "眾",
+    "書",
+    "布",
+    "复",
+    "容",
+    "兒",
+    "須",
+    "際",
+    "商",
+    "非",
+    "驗",
+    "連",
+    "斷",
+    "深",
+    "難",
+    "近",
+    "礦",
+    "千",
+    "週",
+    "委",
+    "素",
+    "技",
+    "備",
+    "半",
+    "辦",
+    "青",
+    "省",
+    "列",
+    "習",
+    "響",
+    "約",
+    "支",
+    "般",
+    "史",
+    "感",
+    "勞",
+    "便",
+    "團",
+    "往",
+    "酸",
+    "歷",
+    "市",
+    "克",
+    "何",
+    "除",
+    "消",
+    "構",
+    "府",
+    "稱",
+    "太",
+    "準",
+    "精",
+    "值",
+    "號",
+    "率",
+    "族",
+    "維",
+    "劃",
+    "選",
+    "標",
+    "寫",
+    "存",
+    "候",
+    "毛",
+    "親",
+    "快",
+    "效",
+    "斯",
+    "院",
+    "查",
+    "江",
+    "型",
+    "眼",
+    "王",
+    "按",
+    "格",
+    "養",
+    "易",
+    "置",
+    "派",
+    "層",
+    "片",
+    "始",
+    "卻",
+    "專",
+    "狀",
+    "育",
+    "廠",
+    "京",
+    "識",
+    "適",
+    "屬",
+    "圓",
+    "包",
+    "火",
+    "住",
+    "調",
+    "滿",
+    "縣",
+    "局",
+    "照",
+    "參",
+    "紅",
+    "細",
+    "引",
+    "聽",
+    "該",
+    "鐵",
+    "價",
+    "嚴",
+    "首",
+    "底",
+    "液",
+    "官",
+    "德",
+    "隨",
+    "病",
+    "蘇",
+    "失",
+    "爾",
+    "死",
+    "講",
+    "配",
+    "女",
+    "黃",
+    "推",
+    "顯",
+    "談",
+    "罪",
+    "神",
+    "藝",
+    "呢",
+    "席",
+    "含",
+    "企",
+    "望",
+    "密",
+    "批",
+    "營",
+    "項",
+    "防",
+    "舉",
+    "球",
+    "英",
+    "氧",
+    "勢",
+    "告",
+    "李",
+    "台",
+    "落",
+    "木",
+    "幫",
+    "輪",
+    "破",
+    "亞",
+    "師",
+    "圍",
+    "注",
+    "遠",
+    "字",
+    "材",
+    "排",
+    "供",
+    "河",
+    "態",
+    "封",
+    "另",
+    "施",
+    "減",
+    "樹",
+    "溶",
+    "怎",
+    "止",
+    "案",
+    "言",
+    "士",
+    "均",
+    "武",
+    "固",
+    "葉",
+    "魚",
+    "波",
+    "視",
+    "僅",
+    "費",
+    "緊",
+    "愛",
+    "左",
+    "章",
+    "早",
+    "朝",
+    "害",
+    "續",
+    "輕",
+    "服",
+    "試",
+    "食",
+    "充",
+    "兵",
+    "源",
+    "判",
+    "護",
+    "司",
+    "足",
+    "某",
+    "練",
+    "差",
+    "致",
+    "板",
+    "田",
+    "降",
+    "黑",
+    "犯",
+    "負",
+    "擊",
+    "范",
+    "繼",
+    "興",
+    "似",
+    "餘",
+    "堅",
+    "曲",
+    "輸",
+    "修",
+    "故",
+    "城",
+    "夫",
+    "夠",
+    "送",
+    "筆",
+    "船",
+    "佔",
+    "右",
+    "財",
+    "吃",
+    "富",
+    "春",
+    "職",
+    "覺",
+    "漢",
+    "畫",
+    "功",
+    "巴",
+    "跟",
+    "雖",
+    "雜",
+    "飛",
+    "檢",
+    "吸",
+    "助",
+    "昇",
+    "陽",
+    "互",
+    "初",
+    "創",
+    "抗",
+    "考",
+    "投",
+    "壞",
+    "策",
+    "古",
+    "徑",
+    "換",
+    "未",
+    "跑",
+    "留",
+    "鋼",
+    "曾",
+    "端",
+    "責",
+    "站",
+    "簡",
+    "述",
+    "錢",
+    "副",
+    "盡",
+    "帝",
+    "射",
+    "草",
+    "衝",
+    "承",
+    "獨",
+    "令",
+    "限",
+    "阿",
+    "宣",
+    "環",
+    "雙",
+    "請",
+    "超",
+    "微",
+    "讓",
+    "控",
+    "州",
+    "良",
+    "軸",
+    "找",
+    "否",
+    "紀",
+    "益",
+    "依",
+    "優",
+    "頂",
+    "礎",
+    "載",
+    "倒",
+    "房",
+    "突",
+    "坐",
+    "粉",
+    "敵",
+    "略",
+    "客",
+    "袁",
+    "冷",
+    "勝",
+    "絕",
+    "析",
+    "塊",
+    "劑",
+    "測",
+    "絲",
+    "協",
+    "訴",
+    "念",
+    "陳",
+    "仍",
+    "羅",
+    "鹽",
+    "友",
+    "洋",
+    "錯",
+    "苦",
+    "夜",
+    "刑",
+    "移",
+    "頻",
+    "逐",
+    "靠",
+    "混",
+    "母",
+    "短",
+    "皮",
+    "終",
+    "聚",
+    "汽",
+    "村",
+    "雲",
+    "哪",
+    "既",
+    "距",
+    "衛",
+    "停",
+    "烈",
+    "央",
+    "察",
+    "燒",
+    "迅",
+    "境",
+    "若",
+    "印",
+    "洲",
+    "刻",
+    "括",
+    "激",
+    "孔",
+    "搞",
+    "甚",
+    "室",
+    "待",
+    "核",
+    "校",
+    "散",
+    "侵",
+    "吧",
+    "甲",
+    "遊",
+    "久",
+    "菜",
+    "味",
+    "舊",
+    "模",
+    "湖",
+    "貨",
+    "損",
+    "預",
+    "阻",
+    "毫",
+    "普",
+    "穩",
+    "乙",
+    "媽",
+    "植",
+    "息",
+    "擴",
+    "銀",
+    "語",
+    "揮",
+    "酒",
+    "守",
+    "拿",
+    "序",
+    "紙",
+    "醫",
+    "缺",
+    "雨",
+    "嗎",
+    "針",
+    "劉",
+    "啊",
+    "急",
+    "唱",
+    "誤",
+    "訓",
+    "願",
+    "審",
+    "附",
+    "獲",
+    "茶",
+    "鮮",
+    "糧",
+    "斤",
+    "孩",
+    "脫",
+    "硫",
+    "肥",
+    "善",
+    "龍",
+    "演",
+    "父",
+    "漸",
+    "血",
+    "歡",
+    "械",
+    "掌",
+    "歌",
+    "沙",
+    "剛",
+    "攻",
+    "謂",
+    "盾",
+    "討",
+    "晚",
+    "粒",
+    "亂",
+    "燃",
+    "矛",
+    "乎",
+    "殺",
+    "藥",
+    "寧",
+    "魯",
+    "貴",
+    "鐘",
+    "煤",
+    "讀",
+    "班",
+    "伯",
+    "香",
+    "介",
+    "迫",
+    "句",
+    "豐",
+    "培",
+    "握",
+    "蘭",
+    "擔",
+    "弦",
+    "蛋",
+    "沉",
+    "假",
+    "穿",
+    "執",
+    "答",
+    "樂",
+    "誰",
+    "順",
+    "煙",
+    "縮",
+    "徵",
+    "臉",
+    "喜",
+    "松",
+    "腳",
+    "困",
+    "異",
+    "免",
+    "背",
+    "星",
+    "福",
+    "買",
+    "染",
+    "井",
+    "概",
+    "慢",
+    "怕",
+    "磁",
+    "倍",
+    "祖",
+    "皇",
+    "促",
+    "靜",
+    "補",
+    "評",
+    "翻",
+    "肉",
+    "踐",
+    "尼",
+    "衣",
+    "寬",
+    "揚",
+    "棉",
+    "希",
+    "傷",
+    "操",
+    "垂",
+    "秋",
+    "宜",
+    "氫",
+    "套",
+    "督",
+    "振",
+    "架",
+    "亮",
+    "末",
+    "憲",
+    "慶",
+    "編",
+    "牛",
+    "觸",
+    "映",
+    "雷",
+    "銷",
+    "詩",
+    "座",
+    "居",
+    "抓",
+    "裂",
+    "胞",
+    "呼",
+    "娘",
+    "景",
+    "威",
+    "綠",
+    "晶",
+    "厚",
+    "盟",
+    "衡",
+    "雞",
+    "孫",
+    "延",
+    "危",
+    "膠",
+    "屋",
+    "鄉",
+    "臨",
+    "陸",
+    "顧",
+    "掉",
+    "呀",
+    "燈",
+    "歲",
+    "措",
+    "束",
+    "耐",
+    "劇",
+    "玉",
+    "趙",
+    "跳",
+    "哥",
+    "季",
+    "課",
+    "凱",
+    "胡",
+    "額",
+    "款",
+    "紹",
+    "卷",
+    "齊",
+    "偉",
+    "蒸",
+    "殖",
+    "永",
+    "宗",
+    "苗",
+    "川",
+    "爐",
+    "岩",
+    "弱",
+    "零",
+    "楊",
+    "奏",
+    "沿",
+    "露",
+    "桿",
+    "探",
+    "滑",
+    "鎮",
+    "飯",
+    "濃",
+    "航",
+    "懷",
+    "趕",
+    "庫",
+    "奪",
+    "伊",
+    "靈",
+    "稅",
+    "途",
+    "滅",
+    "賽",
+    "歸",
+    "召",
+    "鼓",
+    "播",
+    "盤",
+    "裁",
+    "險",
+    "康",
+    "唯",
+    "錄",
+    "菌",
+    "純",
+    "借",
+    "糖",
+    "蓋",
+    "橫",
+    "符",
+    "私",
+    "努",
+    "堂",
+    "域",
+    "槍",
+    "潤",
+    "幅",
+    "哈",
+    "竟",
+    "熟",
+    "蟲",
+    "澤",
+    "腦",
+    "壤",
+    "碳",
+    "歐",
+    "遍",
+    "側",
+    "寨",
+    "敢",
+    "徹",
+    "慮",
+    "斜",
+    "薄",
+    "庭",
+    "納",
+    "彈",
+    "飼",
+    "伸",
+    "折",
+    "麥",
+    "濕",
+    "暗",
+    "荷",
+    "瓦",
+    "塞",
+    "床",
+    "築",
+    "惡",
+    "戶",
+    "訪",
+    "塔",
+    "奇",
+    "透",
+    "梁",
+    "刀",
+    "旋",
+    "跡",
+    "卡",
+    "氯",
+    "遇",
+    "份",
+    "毒",
+    "泥",
+    "退",
+    "洗",
+    "擺",
+    "灰",
+    "彩",
+    "賣",
+    "耗",
+    "夏",
+    "擇",
+    "忙",
+    "銅",
+    "獻",
+    "硬",
+    "予",
+    "繁",
+    "圈",
+    "雪",
+    "函",
+    "亦",
+    "抽",
+    "篇",
+    "陣",
+    "陰",
+    "丁",
+    "尺",
+    "追",
+    "堆",
+    "雄",
+    "迎",
+    "泛",
+    "爸",
+    "樓",
+    "避",
+    "謀",
+    "噸",
+    "野",
+    "豬",
+    "旗",
+    "累",
+    "偏",
+    "典",
+    "館",
+    "索",
+    "秦",
+    "脂",
+    "潮",
+    "爺",
+    "豆",
+    "忽",
+    "托",
+    "驚",
+    "塑",
+    "遺",
+    "愈",
+    "朱",
+    "替",
+    "纖",
+    "粗",
+    "傾",
+    "尚",
+    "痛",
+    "楚",
+    "謝",
+    "奮",
+    "購",
+    "磨",
+    "君",
+    "池",
+    "旁",
+    "碎",
+    "骨",
+    "監",
+    "捕",
+    "弟",
+    "暴",
+    "割",
+    "貫",
+    "殊",
+    "釋",
+    "詞",
+    "亡",
+    "壁",
+    "頓",
+    "寶",
+    "午",
+    "塵",
+    "聞",
+    "揭",
+    "炮",
+    "殘",
+    "冬",
+    "橋",
+    "婦",
+    "警",
+    "綜",
+    "招",
+    "吳",
+    "付",
+    "浮",
+    "遭",
+    "徐",
+    "您",
+    "搖",
+    "谷",
+    "贊",
+    "箱",
+    "隔",
+    "訂",
+    "男",
+    "吹",
+    "園",
+    "紛",
+    "唐",
+    "敗",
+    "宋",
+    "玻",
+    "巨",
+    "耕",
+    "坦",
+    "榮",
+    "閉",
+    "灣",
+    "鍵",
+    "凡",
+    "駐",
+    "鍋",
+    "救",
+    "恩",
+    "剝",
+    "凝",
+    "鹼",
+    "齒",
+    "截",
+    "煉",
+    "麻",
+    "紡",
+    "禁",
+    "廢",
+    "盛",
+    "版",
+    "緩",
+    "淨",
+    "睛",
+    "昌",
+    "婚",
+    "涉",
+    "筒",
+    "嘴",
+    "插",
+    "岸",
+    "朗",
+    "莊",
+    "街",
+    "藏",
+    "姑",
+    "貿",
+    "腐",
+    "奴",
+    "啦",
+    "慣",
+    "乘",
+    "夥",
+    "恢",
+    "勻",
+    "紗",
+    "扎",
+    "辯",
+    "耳",
+    "彪",
+    "臣",
+    "億",
+    "璃",
+    "抵",
+    "脈",
+    "秀",
+    "薩",
+    "俄",
+    "網",
+    "舞",
+    "店",
+    "噴",
+    "縱",
+    "寸",
+    "汗",
+    "掛",
+    "洪",
+    "賀",
+    "閃",
+    "柬",
+    "爆",
+    "烯",
+    "津",
+    "稻",
+    "牆",
+    "軟",
+    "勇",
+    "像",
+    "滾",
+    "厘",
+    "蒙",
+    "芳",
+    "肯",
+    "坡",
+    "柱",
+    "盪",
+    "腿",
+    "儀",
+    "旅",
+    "尾",
+    "軋",
+    "冰",
+    "貢",
+    "登",
+    "黎",
+    "削",
+    "鑽",
+    "勒",
+    "逃",
+    "障",
+    "氨",
+    "郭",
+    "峰",
+    "幣",
+    "港",
+    "伏",
+    "軌",
+    "畝",
+    "畢",
+    "擦",
+    "莫",
+    "刺",
+    "浪",
+    "秘",
+    "援",
+    "株",
+    "健",
+    "售",
+    "股",
+    "島",
+    "甘",
+    "泡",
+    "睡",
+    "童",
+    "鑄",
+    "湯",
+    "閥",
+    "休",
+    "匯",
+    "舍",
+    "牧",
+    "繞",
+    "炸",
+    "哲",
+    "磷",
+    "績",
+    "朋",
+    "淡",
+    "尖",
+    "啟",
+    "陷",
+    "柴",
+    "呈",
+    "徒",
+    "顏",
+    "淚",
+    "稍",
+    "忘",
+    "泵",
+    "藍",
+    "拖",
+    "洞",
+    "授",
+    "鏡",
+    "辛",
+    "壯",
+    "鋒",
+    "貧",
+    "虛",
+    "彎",
+    "摩",
+    "泰",
+    "幼",
+    "廷",
+    "尊",
+    "窗",
+    "綱",
+    "弄",
+    "隸",
+    "疑",
+    "氏",
+    "宮",
+    "姐",
+    "震",
+    "瑞",
+    "怪",
+    "尤",
+    "琴",
+    "循",
+    "描",
+    "膜",
+    "違",
+    "夾",
+    "腰",
+    "緣",
+    "珠",
+    "窮",
+    "森",
+    "枝",
+    "竹",
+    "溝",
+    "催",
+    "繩",
+    "憶",
+    "邦",
+    "剩",
+    "幸",
+    "漿",
+    "欄",
+    "擁",
+    "牙",
+    "貯",
+    "禮",
+    "濾",
+    "鈉",
+    "紋",
+    "罷",
+    "拍",
+    "咱",
+    "喊",
+    "袖",
+    "埃",
+    "勤",
+    "罰",
+    "焦",
+    "潛",
+    "伍",
+    "墨",
+    "欲",
+    "縫",
+    "姓",
+    "刊",
+    "飽",
+    "仿",
+    "獎",
+    "鋁",
+    "鬼",
+    "麗",
+    "跨",
+    "默",
+    "挖",
+    "鏈",
+    "掃",
+    "喝",
+    "袋",
+    "炭",
+    "污",
+    "幕",
+    "諸",
+    "弧",
+    "勵",
+    "梅",
+    "奶",
+    "潔",
+    "災",
+    "舟",
+    "鑑",
+    "苯",
+    "訟",
+    "抱",
+    "毀",
+    "懂",
+    "寒",
+    "智",
+    "埔",
+    "寄",
+    "屆",
+    "躍",
+    "渡",
+    "挑",
+    "丹",
+    "艱",
+    "貝",
+    "碰",
+    "拔",
+    "爹",
+    "戴",
+    "碼",
+    "夢",
+    "芽",
+    "熔",
+    "赤",
+    "漁",
+    "哭",
+    "敬",
+    "顆",
+    "奔",
+    "鉛",
+    "仲",
+    "虎",
+    "稀",
+    "妹",
+    "乏",
+    "珍",
+    "申",
+    "桌",
+    "遵",
+    "允",
+    "隆",
+    "螺",
+    "倉",
+    "魏",
+    "銳",
+    "曉",
+    "氮",
+    "兼",
+    "隱",
+    "礙",
+    "赫",
+    "撥",
+    "忠",
+    "肅",
+    "缸",
+    "牽",
+    "搶",
+    "博",
+    "巧",
+    "殼",
+    "兄",
+    "杜",
+    "訊",
+    "誠",
+    "碧",
+    "祥",
+    "柯",
+    "頁",
+    "巡",
+    "矩",
+    "悲",
+    "灌",
+    "齡",
+    "倫",
+    "票",
+    "尋",
+    "桂",
+    "鋪",
+    "聖",
+    "恐",
+    "恰",
+    "鄭",
+    "趣",
+    "抬",
+    "荒",
+    "騰",
+    "貼",
+    "柔",
+    "滴",
+    "猛",
+    "闊",
+    "輛",
+    "妻",
+    "填",
+    "撤",
+    "儲",
+    "簽",
+    "鬧",
+    "擾",
+    "紫",
+    "砂",
+    "遞",
+    "戲",
+    "吊",
+    "陶",
+    "伐",
+    "餵",
+    "療",
+    "瓶",
+    "婆",
+    "撫",
+    "臂",
+    "摸",
+    "忍",
+    "蝦",
+    "蠟",
+    "鄰",
+    "胸",
+    "鞏",
+    "擠",
+    "偶",
+    "棄",
+    "槽",
+    "勁",
+    "乳",
+    "鄧",
+    "吉",
+    "仁",
+    "爛",
+    "磚",
+    "租",
+    "烏",
+    "艦",
+    "伴",
+    "瓜",
+    "淺",
+    "丙",
+    "暫",
+    "燥",
+    "橡",
+    "柳",
+    "迷",
+    "暖",
+    "牌",
+    "秧",
+    "膽",
+    "詳",
+    "簧",
+    "踏",
+    "瓷",
+    "譜",
+    "呆",
+    "賓",
+    "糊",
+    "洛",
+    "輝",
+    "憤",
+    "競",
+    "隙",
+    "怒",
+    "粘",
+    "乃",
+    "緒",
+    "肩",
+    "籍",
+    "敏",
+    "塗",
+    "熙",
+    "皆",
+    "偵",
+    "懸",
+    "掘",
+    "享",
+    "糾",
+    "醒",
+    "狂",
+    "鎖",
+    "淀",
+    "恨",
+    "牲",
+    "霸",
+    "爬",
+    "賞",
+    "逆",
+    "玩",
+    "陵",
+    "祝",
+    "秒",
+    "浙",
+    "貌",
+    "役",
+    "彼",
+    "悉",
+    "鴨",
+    "趨",
+    "鳳",
+    "晨",
+    "畜",
+    "輩",
+    "秩",
+    "卵",
+    "署",
+    "梯",
+    "炎",
+    "灘",
+    "棋",
+    "驅",
+    "篩",
+    "峽",
+    "冒",
+    "啥",
+    "壽",
+    "譯",
+    "浸",
+    "泉",
+    "帽",
+    "遲",
+    "矽",
+    "疆",
+    "貸",
+    "漏",
+    "稿",
+    "冠",
+    "嫩",
+    "脅",
+    "芯",
+    "牢",
+    "叛",
+    "蝕",
+    "奧",
+    "鳴",
+    "嶺",
+    "羊",
+    "憑",
+    "串",
+    "塘",
+    "繪",
+    "酵",
+    "融",
+    "盆",
+    "錫",
+    "廟",
+    "籌",
+    "凍",
+    "輔",
+    "攝",
+    "襲",
+    "筋",
+    "拒",
+    "僚",
+    "旱",
+    "鉀",
+    "鳥",
+    "漆",
+    "沈",
+    "眉",
+    "疏",
+    "添",
+    "棒",
+    "穗",
+    "硝",
+    "韓",
+    "逼",
+    "扭",
+    "僑",
+    "涼",
+    "挺",
+    "碗",
+    "栽",
+    "炒",
+    "杯",
+    "患",
+    "餾",
+    "勸",
+    "豪",
+    "遼",
+    "勃",
+    "鴻",
+    "旦",
+    "吏",
+    "拜",
+    "狗",
+    "埋",
+    "輥",
+    "掩",
+    "飲",
+    "搬",
+    "罵",
+    "辭",
+    "勾",
+    "扣",
+    "估",
+    "蔣",
+    "絨",
+    "霧",
+    "丈",
+    "朵",
+    "姆",
+    "擬",
+    "宇",
+    "輯",
+    "陝",
+    "雕",
+    "償",
+    "蓄",
+    "崇",
+    "剪",
+    "倡",
+    "廳",
+    "咬",
+    "駛",
+    "薯",
+    "刷",
+    "斥",
+    "番",
+    "賦",
+    "奉",
+    "佛",
+    "澆",
+    "漫",
+    "曼",
+    "扇",
+    "鈣",
+    "桃",
+    "扶",
+    "仔",
+    "返",
+    "俗",
+    "虧",
+    "腔",
+    "鞋",
+    "棱",
+    "覆",
+    "框",
+    "悄",
+    "叔",
+    "撞",
+    "騙",
+    "勘",
+    "旺",
+    "沸",
+    "孤",
+    "吐",
+    "孟",
+    "渠",
+    "屈",
+    "疾",
+    "妙",
+    "惜",
+    "仰",
+    "狠",
+    "脹",
+    "諧",
+    "拋",
+    "黴",
+    "桑",
+    "崗",
+    "嘛",
+    "衰",
+    "盜",
+    "滲",
+    "臟",
+    "賴",
+    "湧",
+    "甜",
+    "曹",
+    "閱",
+    "肌",
+    "哩",
+    "厲",
+    "烴",
+    "緯",
+    "毅",
+    "昨",
+    "偽",
+    "症",
+    "煮",
+    "嘆",
+    "釘",
+    "搭",
+    "莖",
+    "籠",
+    "酷",
+    "偷",
+    "弓",
+    "錐",
+    "恆",
+    "傑",
+    "坑",
+    "鼻",
+    "翼",
+    "綸",
+    "敘",
+    "獄",
+    "逮",
+    "罐",
+    "絡",
+    "棚",
+    "抑",
+    "膨",
+    "蔬",
+    "寺",
+    "驟",
+    "穆",
+    "冶",
+    "枯",
+    "冊",
+    "屍",
+    "凸",
+    "紳",
+    "坯",
+    "犧",
+    "焰",
+    "轟",
+    "欣",
+    "晉",
+    "瘦",
+    "禦",
+    "錠",
+    "錦",
+    "喪",
+    "旬",
+    "鍛",
+    "壟",
+    "搜",
+    "撲",
+    "邀",
+    "亭",
+    "酯",
+    "邁",
+    "舒",
+    "脆",
+    "酶",
+    "閒",
+    "憂",
+    "酚",
+    "頑",
+    "羽",
+    "漲",
+    "卸",
+    "仗",
+    "陪",
+    "闢",
+    "懲",
+    "杭",
+    "姚",
+    "肚",
+    "捉",
+    "飄",
+    "漂",
+    "昆",
+    "欺",
+    "吾",
+    "郎",
+    "烷",
+    "汁",
+    "呵",
+    "飾",
+    "蕭",
+    "雅",
+    "郵",
+    "遷",
+    "燕",
+    "撒",
+    "姻",
+    "赴",
+    "宴",
+    "煩",
+    "債",
+    "帳",
+    "斑",
+    "鈴",
+    "旨",
+    "醇",
+    "董",
+    "餅",
+    "雛",
+    "姿",
+    "拌",
+    "傅",
+    "腹",
+    "妥",
+    "揉",
+    "賢",
+    "拆",
+    "歪",
+    "葡",
+    "胺",
+    "丟",
+    "浩",
+    "徽",
+    "昂",
+    "墊",
+    "擋",
+    "覽",
+    "貪",
+    "慰",
+    "繳",
+    "汪",
+    "慌",
+    "馮",
+    "諾",
+    "姜",
+    "誼",
+    "兇",
+    "劣",
+    "誣",
+    "耀",
+    "昏",
+    "躺",
+    "盈",
+    "騎",
+    "喬",
+    "溪",
+    "叢",
+    "盧",
+    "抹",
+    "悶",
+    "諮",
+    "刮",
+    "駕",
+    "纜",
+    "悟",
+    "摘",
+    "鉺",
+    "擲",
+    "頗",
+    "幻",
+    "柄",
+    "惠",
+    "慘",
+    "佳",
+    "仇",
+    "臘",
+    "窩",
+    "滌",
+    "劍",
+    "瞧",
+    "堡",
+    "潑",
+    "蔥",
+    "罩",
+    "霍",
+    "撈",
+    "胎",
+    "蒼",
+    "濱",
+    "倆",
+    "捅",
+    "湘",
+    "砍",
+    "霞",
+    "邵",
+    "萄",
+    "瘋",
+    "淮",
+    "遂",
+    "熊",
+    "糞",
+    "烘",
+    "宿",
+    "檔",
+    "戈",
+    "駁",
+    "嫂",
+    "裕",
+    "徙",
+    "箭",
+    "捐",
+    "腸",
+    "撐",
+    "曬",
+    "辨",
+    "殿",
+    "蓮",
+    "攤",
+    "攪",
+    "醬",
+    "屏",
+    "疫",
+    "哀",
+    "蔡",
+    "堵",
+    "沫",
+    "皺",
+    "暢",
+    "疊",
+    "閣",
+    "萊",
+    "敲",
+    "轄",
+    "鉤",
+    "痕",
+    "壩",
+    "巷",
+    "餓",
+    "禍",
+    "丘",
+    "玄",
+    "溜",
+    "曰",
+    "邏",
+    "彭",
+    "嘗",
+    "卿",
+    "妨",
+    "艇",
+    "吞",
+    "韋",
+    "怨",
+    "矮",
+    "歇",
+}
+var rwords_chinese_traditional = map[string]int16{
+    "的": 0,
+    "一": 1,
+    "是": 2,
+    "在": 3,
+    "不": 4,
+    "了": 5,
+    "有": 6,
+    "和": 7,
+    "人": 8,
+    "這": 9,
+    "中": 10,
+    "大": 11,
+    "為": 12,
+    "上": 13,
+    "個": 14,
+    "國": 15,
+    "我": 16,
+    "以": 17,
+    "要": 18,
+    "他": 19,
+    "時": 20,
+    "來": 21,
+    "用": 22,
+    "們": 23,
+    "生": 24,
+    "到": 25,
+    "作": 26,
+    "地": 27,
+    "於": 28,
+    "出": 29,
+    "就": 30,
+    "分": 31,
+    "對": 32,
+    "成": 33,
+    "會": 34,
+    "可": 35,
+    "主": 36,
+    "發": 37,
+    "年": 38,
+    "動": 39,
+    "同": 40,
+    "工": 41,
+    "也": 42,
+    "能": 43,
+    "下": 44,
+    "過": 45,
+    "子": 46,
+    "說": 47,
+    "產": 48,
+    "種": 49,
+    "面": 50,
+    "而": 51,
+    "方": 52,
+    "後": 53,
+    "多": 54,
+    "定": 55,
+    "行": 56,
+    "學": 57,
+    "法": 58,
+    "所": 59,
+    "民": 60,
+    "得": 61,
+    "經": 62,
+    "十": 63,
+    "三": 64,
+    "之": 65,
+    "進": 66,
+    "著": 67,
+    "等": 68,
+    "部": 69,
+    "度": 70,
+    "家": 71,
+    "電": 72,
+    "力": 73,
+    "裡": 74,
+    "如": 75,
+    "水": 76,
+    "化": 77,
+    "高": 78,
+    "自": 79,
+    "二": 80,
+    "理": 81,
+    "起": 82,
+    "小": 83,
+    "物": 84,
+    "現": 85,
+    "實": 86,
+    "加": 87,
+    "量": 88,
+    "都": 89,
+    "兩": 90,
+    "體": 91,
+    "制": 92,
+    "機": 93,
+    "當": 94,
+    "使": 95,
+    "點": 96,
+    "從": 97,
+    "業": 98,
+    "本": 99,
+    "去": 100,
+    "把": 101,
+    "性": 102,
+    "好": 103,
+    "應": 104,
+    "開": 105,
+    "它": 106,
+    "合": 107,
+    "還": 108,
+    "因": 109,
+    "由": 110,
+    "其": 111,
+    "些": 112,
+    "然": 113,
+    "前": 114,
+    "外": 115,
+    "天": 116,
+    "政": 117,
+    "四": 118,
+    "日": 119,
+    "那": 120,
+    "社": 121,
+    "義": 122,
+    "事": 123,
+    "平": 124,
+    "形": 125,
+    "相": 126,
+    "全": 127,
+    "表": 128,
+    "間": 129,
+    "樣": 130,
+    "與": 131,
+    "關": 132,
+    "各": 133,
+    "重": 134,
+    "新": 135,
+    "線": 136,
+    "內": 137,
+    "數": 138,
+    "正": 139,
+    "心": 140,
+    "反": 141,
+    "你": 142,
+    "明": 143,
+    "看": 144,
+    "原": 145,
+    "又": 146,
+    "麼": 147,
+    "利": 148,
+    "比": 149,
+    "或": 150,
+    "但": 151,
+    "質": 152,
+    "氣": 153,
+    "第": 154,
+    "向": 155,
+    "道": 156,
+    "命": 157,
+    "此": 158,
+    "變": 159,
+    "條": 160,
+    "只": 161,
+    "沒": 162,
+    "結": 163,
+    "解": 164,
+    "問": 165,
+    "意": 166,
+    "建": 167,
+    "月": 168,
+    "公": 169,
+    "無": 170,
+    "系": 171,
+    "軍": 172,
+    "很": 173,
+    "情": 174,
+    "者": 175,
+    "最": 176,
+    "立": 177,
+    "代": 178,
+    "想": 179,
+    "已": 180,
+    "通": 181,
+    "並": 182,
+    "提": 183,
+    "直": 184,
+    "題": 185,
+    "黨": 186,
+    "程": 187,
+    "展": 188,
+    "五": 189,
+    "果": 190,
+    "料": 191,
+    "象": 192,
+    "員": 193,
+    "革": 194,
+    "位": 195,
+    "入": 196,
+    "常": 197,
+    "文": 198,
+    "總": 199,
+    "次": 200,
+    "品": 201,
+    "式": 202,
+    "活": 203,
+    "設": 204,
+    "及": 205,
+    "管": 206,
+    "特": 207,
+    "件": 208,
+    "長": 209,
+    "求": 210,
+    "老": 211,
+    "頭": 212,
+    "基": 213,
+    "資": 214,
+    "邊": 215,
+    "流": 216,
+    "路": 217,
+    "級": 218,
+    "少": 219,
+    "圖": 220,
+    "山": 221,
+    "統": 222,
+    "接": 223,
+    "知": 224,
+    "較": 225,
+    "將": 226,
+    "組": 227,
+    "見": 228,
+    "計": 229,
+    "別": 230,
+    "她": 231,
+    "手": 232,
+    "角": 233,
+    "期": 234,
+    "根": 235,
+    "論": 236,
+    "運": 237,
+    "農": 238,
+    "指": 239,
+    "幾": 240,
+    "九": 241,
+    "區": 242,
+    "強": 243,
+    "放": 244,
+    "決": 245,
+    "西": 246,
+    "被": 247,
+    "幹": 248,
+    "做": 249,
+    "必": 250,
+    "戰": 251,
+    "先": 252,
+    "回": 253,
+    "則": 254,
+    "任": 255,
+    "取": 256,
+    "據": 257,
+    "處": 258,
+    "隊": 259,
+    "南": 260,
+    "給": 261,
+    "色": 262,
+    "光": 263,
+    "門": 264,
+    "即": 265,
+    "保": 266,
+    "治": 267,
+    "北": 268,
+    "造": 269,
+    "百": 270,
+    "規": 271,
+    "熱": 272,
+    "領": 273,
+    "七": 274,
+    "海": 275,
+    "口": 276,
+    "東": 277,
+    "導": 278,
+    "器": 279,
+    "壓": 280,
+    "志": 281,
+    "世": 282,
+    "金": 283,
+    "增": 284,
+    "爭": 285,
+    "濟": 286,
+    "階": 287,
+    "油": 288,
+    "思": 289,
+    "術": 290,
+    "極": 291,
+    "交": 292,
+    "受": 293,
+    "聯": 294,
+    "什": 295,
+    "認": 296,
+    "六": 297,
+    "共": 298,
+    "權": 299,
+    "收": 300,
+    "證": 301,
+    "改": 302,
+    "清": 303,
+    "美": 304,
+    "再": 305,
+    "採": 306,
+    "轉": 307,
+    "更": 308,
+    "單": 309,
+    "風": 310,
+    "切": 311,
+    "打": 312,
+    "白": 313,
+    "教": 314,
+    "速": 315,
+    "花": 316,
+    "帶": 317,
+    "安": 318,
+    "場": 319,
+    "身": 320,
+    "車": 321,
+    "例": 322,
+    "真": 323,
+    "務": 324,
+    "具": 325,
+    "萬": 326,
+    "每": 327,
+    "目": 328,
+    "至": 329,
+    "達": 330,
+    "走": 331,
+    "積": 332,
+    "示": 333,
+    "議": 334,
+    "聲": 335,
+    "報": 336,
+    "鬥": 337,
+    "完": 338,
+    "類": 339,
+    "八": 340,
+    "離": 341,
+    "華": 342,
+    "名": 343,
+    "確": 344,
+    "才": 345,
+    "科": 346,
+    "張": 347,
+    "信": 348,
+    "馬": 349,
+    "節": 350,
+    "話": 351,
+    "米": 352,
+    "整": 353,
+    "空": 354,
+    "元": 355,
+    "況": 356,
+    "今": 357,
+    "集": 358,
+    "溫": 359,
+    "傳": 360,
+    "土": 361,
+    "許": 362,
+    "步": 363,
+    "群": 364,
+    "廣": 365,
+    "石": 366,
+    "記": 367,
+    "需": 368,
+    "段": 369,
+    "研": 370,
+    "界": 371,
+    "拉": 372,
+    "林": 373,
+    "律": 374,
+    "叫": 375,
+    "且": 376,
+    "究": 377,
+    "觀": 378,
+    "越": 379,
+    "織": 380,
+    "裝": 381,
+    "影": 382,
+    "算": 383,
+    "低": 384,
+    "持": 385,
+    "音": 386,
+    "眾": 387,
+    "書": 388,
+    "布": 389,
+    "复": 390,
+    "容": 391,
+    "兒": 392,
+    "須": 393,
+    "際": 394,
+    "商": 395,
+    "非": 396,
+    "驗": 397,
+    "連": 398,
+    "斷": 399,
+    "深": 400,
+    "難": 401,
+    "近": 402,
+    "礦": 403,
+    "千": 404,
+    "週": 405,
+    "委": 406,
+    "素": 407,
+    "技": 408,
+    "備": 409,
+    "半": 410,
+    "辦": 411,
+    "青": 412,
+    "省": 413,
+    "列": 414,
+    "習": 415,
+    "響": 416,
+    "約": 417,
+    "支": 418,
+    "般": 419,
+    "史": 420,
+    "感": 421,
+    "勞": 422,
+    "便": 423,
+    "團": 424,
+    "往": 425,
+    "酸": 426,
+    "歷": 427,
+    "市": 428,
+    "克": 429,
+    "何": 430,
+    "除": 431,
+    "消": 432,
+    "構": 433,
+    "府": 434,
+    "稱": 435,
+    "太": 436,
+    "準": 437,
+    "精": 438,
+    "值": 439,
+    "號": 440,
+    "率": 441,
+    "族": 442,
+    "維": 443,
+    "劃": 444,
+    "選": 445,
+    "標": 446,
+    "寫": 447,
+    "存": 448,
+    "候": 449,
+    "毛": 450,
+    "親": 451,
+    "快": 452,
+    "效": 453,
+    "斯": 454,
+    "院": 455,
+    "查": 456,
+    "江": 457,
+    "型": 458,
+    "眼": 459,
+    "王": 460,
+    "按": 461,
+    "格": 462,
+    "養": 463,
+    "易": 464,
+    "置": 465,
+    "派": 466,
+    "層": 467,
+    "片": 468,
+    "始": 469,
+    "卻": 470,
+    "專": 471,
+    "狀": 472,
+    "育": 473,
+    "廠": 474,
+    "京": 475,
+    "識": 476,
+    "適": 477,
+    "屬": 478,
+    "圓": 479,
+    "包": 480,
+    "火": 481,
+    "住": 482,
+    "調": 483,
+    "滿": 484,
+    "縣": 485,
+    "局": 486,
+    "照": 487,
+    "參": 488,
+    "紅": 489,
+    "細": 490,
+    "引": 491,
+    "聽": 492,
+    "該": 493,
+    "鐵": 494,
+    "價": 495,
+    "嚴": 496,
+    "首": 497,
+    "底": 498,
+    "液": 499,
+    "官": 500,
+    "德": 501,
+    "隨": 502,
+    "病": 503,
+    "蘇": 504,
+    "失": 505,
+    "爾": 506,
+    "死": 507,
+    "講": 508,
+    "配": 509,
+    "女": 510,
+    "黃": 511,
+    "推": 512,
+    "顯": 513,
+    "談": 514,
+    "罪": 515,
+    "神": 516,
+    "藝": 517,
+    "呢": 518,
+    "席": 519,
+    "含": 520,
+    "企": 521,
+    "望": 522,
+    "密": 523,
+    "批": 524,
+    "營": 525,
+    "項": 526,
+    "防": 527,
+    "舉": 528,
+    "球": 529,
+    "英": 530,
+    "氧": 531,
+    "勢": 532,
+    "告": 533,
+    "李": 534,
+    "台": 535,
+    "落": 536,
+    "木": 537,
+    "幫": 538,
+    "輪": 539,
+    "破": 540,
+    "亞": 541,
+    "師": 542,
+    "圍": 543,
+    "注": 544,
+    "遠": 545,
+    "字": 546,
+    "材": 547,
+    "排": 548,
+    "供": 549,
+    "河": 550,
+    "態": 551,
+    "封": 552,
+    "另": 553,
+    "施": 554,
+    "減": 555,
+    "樹": 556,
+    "溶": 557,
+    "怎": 558,
+    "止": 559,
+    "案": 560,
+    "言": 561,
+    "士": 562,
+    "均": 563,
+    "武": 564,
+    "固": 565,
+    "葉": 566,
+    "魚": 567,
+    "波": 568,
+    "視": 569,
+    "僅": 570,
+    "費": 571,
+    "緊": 572,
+    "愛": 573,
+    "左": 574,
+    "章": 575,
+    "早": 576,
+    "朝": 577,
+    "害": 578,
+    "續": 579,
+    "輕": 580,
+    "服": 581,
+    "試": 582,
+    "食": 583,
+    "充": 584,
+    "兵": 585,
+    "源": 586,
+    "判": 587,
+    "護": 588,
+    "司": 589,
+    "足": 590,
+    "某": 591,
+    "練": 592,
+    "差": 593,
+    "致": 594,
+    "板": 595,
+    "田": 596,
+    "降": 597,
+    "黑": 598,
+    "犯": 599,
+    "負": 600,
+    "擊": 601,
+    "范": 602,
+    "繼": 603,
+    "興": 604,
+    "似": 605,
+    "餘": 606,
+    "堅": 607,
+    "曲": 608,
+    "輸": 609,
+    "修": 610,
+    "故": 611,
+    "城": 612,
+    "夫": 613,
+    "夠": 614,
+    "送": 615,
+    "筆": 616,
+    "船": 617,
+    "佔": 618,
+    "右": 619,
+    "財": 620,
+    "吃": 621,
+    "富": 622,
+    "春": 623,
+    "職": 624,
+    "覺": 625,
+    "漢": 626,
+    "畫": 627,
+    "功": 628,
+    "巴": 629,
+    "跟": 630,
+    "雖": 631,
+    "雜": 632,
+    "飛": 633,
+    "檢": 634,
+    "吸": 635,
+    "助": 636,
+    "昇": 637,
+    "陽": 638,
+    "互": 639,
+    "初": 640,
+    "創": 641,
+    "抗": 642,
+    "考": 643,
+    "投": 644,
+    "壞": 645,
+    "策": 646,
+    "古": 647,
+    "徑": 648,
+    "換": 649,
+    "未": 650,
+    "跑": 651,
+    "留": 652,
+    "鋼": 653,
+    "曾": 654,
+    "端": 655,
+    "責": 656,
+    "站": 657,
+    "簡": 658,
+    "述": 659,
+    "錢": 660,
+    "副": 661,
+    "盡": 662,
+    "帝": 663,
+    "射": 664,
+    "草": 665,
+    "衝": 666,
+    "承": 667,
+    "獨": 668,
+    "令": 669,
+    "限": 670,
+    "阿": 671,
+    "宣": 672,
+    "環": 673,
+    "雙": 674,
+    "請": 675,
+    "超": 676,
+    "微": 677,
+    "讓": 678,
+    "控": 679,
+    "州": 680,
+    "良": 681,
+    "軸": 682,
+    "找": 683,
+    "否": 684,
+    "紀": 685,
+    "益": 686,
+    "依": 687,
+    "優": 688,
+    "頂": 689,
+    "礎": 690,
+    "載": 691,
+    "倒": 692,
+    "房": 693,
+    "突": 694,
+    "坐": 695,
+    "粉": 696,
+    "敵": 697,
+    "略": 698,
+    "客": 699,
+    "袁": 700,
+    "冷": 701,
+    "勝": 702,
+    "絕": 703,
+    "析": 704,
+    "塊": 705,
+    "劑": 706,
+    "測": 707,
+    "絲": 708,
+    "協": 709,
+    "訴": 710,
+    "念": 711,
+    "陳": 712,
+    "仍": 713,
+    "羅": 714,
+    "鹽": 715,
+    "友": 716,
+    "洋": 717,
+    "錯": 718,
+    "苦": 719,
+    "夜": 720,
+    "刑": 721,
+    "移": 722,
+    "頻": 723,
+    "逐": 724,
+    "靠": 725,
+    "混": 726,
+    "母": 727,
+    "短": 728,
+    "皮": 729,
+    "終": 730,
+    "聚": 731,
+    "汽": 732,
+    "村": 733,
+    "雲": 734,
+    "哪": 735,
+    "既": 736,
+    "距": 737,
+    "衛": 738,
+    "停": 739,
+    "烈": 740,
+    "央": 741,
+    "察": 742,
+    "燒": 743,
+    "迅": 744,
+    "境": 745,
+    "若": 746,
+    "印": 747,
+    "洲": 748,
+    "刻": 749,
+    "括": 750,
+    "激": 751,
+    "孔": 752,
+    "搞": 753,
+    "甚": 754,
+    "室": 755,
+    "待": 756,
+    "核": 757,
+    "校": 758,
+    "散": 759,
+    "侵": 760,
+    "吧": 761,
+    "甲": 762,
+    "遊": 763,
+    "久": 764,
+    "菜": 765,
+    "味": 766,
+    "舊": 767,
+    "模": 768,
+    "湖": 769,
+    "貨": 770,
+    "損": 771,
+    "預": 772,
+    "阻": 773,
+    "毫": 774,
+    "普": 775,
+    "穩": 776,
+    "乙": 777,
+    "媽": 778,
+    "植": 779,
+    "息": 780,
+    "擴": 781,
+    "銀": 782,
+    "語": 783,
+    "揮": 784,
+    "酒": 785,
+    "守": 786,
+    "拿": 787,
+    "序": 788,
+    "紙": 789,
+    "醫": 790,
+    "缺": 791,
+    "雨": 792,
+    "嗎": 793,
+    "針": 794,
+    "劉": 795,
+    "啊": 796,
+    "急": 797,
+    "唱": 798,
+    "誤": 799,
+    "訓": 800,
+    "願": 801,
+    "審": 802,
+    "附": 803,
+    "獲": 804,
+    "茶": 805,
+    "鮮": 806,
+    "糧": 807,
+    "斤": 808,
+    "孩": 809,
+    "脫": 810,
+    "硫": 811,
+    "肥": 812,
+    "善": 813,
+    "龍": 814,
+    "演": 815,
+    "父": 816,
+    "漸": 817,
+    "血": 818,
+    "歡": 819,
+    "械": 820,
+    "掌": 821,
+    "歌": 822,
+    "沙": 823,
+    "剛": 824,
+    "攻": 825,
+    "謂": 826,
+    "盾": 827,
+    "討": 828,
+    "晚": 829,
+    "粒": 830,
+    "亂": 831,
+    "燃": 832,
+    "矛": 833,
+    "乎": 834,
+    "殺": 835,
+    "藥": 836,
+    "寧": 837,
+    "魯": 838,
+    "貴": 839,
+    "鐘": 840,
+    "煤": 841,
+    "讀": 842,
+    "班": 843,
+    "伯": 844,
+    "香": 845,
+    "介": 846,
+    "迫": 847,
+    "句": 848,
+    "豐": 849,
+    "培": 850,
+    "握": 851,
+    "蘭": 852,
+    "擔": 853,
+    "弦": 854,
+    "蛋": 855,
+    "沉": 856,
+    "假": 857,
+    "穿": 858,
+    "執": 859,
+    "答": 860,
+    "樂": 861,
+    "誰": 862,
+    "順": 863,
+    "煙": 864,
+    "縮": 865,
+    "徵": 866,
+    "臉": 867,
+    "喜": 868,
+    "松": 869,
+    "腳": 870,
+    "困": 871,
+    "異": 872,
+    "免": 873,
+    "背": 874,
+    "星": 875,
+    "福": 876,
+    "買": 877,
+    "染": 878,
+    "井": 879,
+    "概": 880,
+    "慢": 881,
+    "怕": 882,
+    "磁": 883,
+    "倍": 884,
+    "祖": 885,
+    "皇": 886,
+    "促": 887,
+    "靜": 888,
+    "補": 889,
+    "評": 890,
+    "翻": 891,
+    "肉": 892,
+    "踐": 893,
+    "尼": 894,
+    "衣": 895,
+    "寬": 896,
+    "揚": 897,
+    "棉": 898,
+    "希": 899,
+    "傷": 900,
+    "操": 901,
+    "垂": 902,
+    "秋": 903,
+    "宜": 904,
+    "氫": 905,
+    "套": 906,
+    "督": 907,
+    "振": 908,
+    "架": 909,
+    "亮": 910,
+    "末": 911,
+    "憲": 912,
+    "慶": 913,
+    "編": 914,
+    "牛": 915,
+    "觸": 916,
+    "映": 917,
+    "雷": 918,
+    "銷": 919,
+    "詩": 920,
+    "座": 921,
+    "居": 922,
+    "抓": 923,
+    "裂": 924,
+    "胞": 925,
+    "呼": 926,
+    "娘": 927,
+    "景": 928,
+    "威": 929,
+    "綠": 930,
+    "晶": 931,
+    "厚": 932,
+    "盟": 933,
+    "衡": 934,
+    "雞": 935,
+    "孫": 936,
+    "延": 937,
+    "危": 938,
+    "膠": 939,
+    "屋": 940,
+    "鄉": 941,
+    "臨": 942,
+    "陸": 943,
+    "顧": 944,
+    "掉": 945,
+    "呀": 946,
+    "燈": 947,
+    "歲": 948,
+    "措": 949,
+    "束": 950,
+    "耐": 951,
+    "劇": 952,
+    "玉": 953,
+    "趙": 954,
+    "跳": 955,
+    "哥": 956,
+    "季": 957,
+    "課": 958,
+    "凱": 959,
+    "胡": 960,
+    "額": 961,
+    "款": 962,
+    "紹": 963,
+    "卷": 964,
+    "齊": 965,
+    "偉": 966,
+    "蒸": 967,
+    "殖": 968,
+    "永": 969,
+    "宗": 970,
+    "苗": 971,
+    "川": 972,
+    "爐": 973,
+    "岩": 974,
+    "弱": 975,
+    "零": 976,
+    "楊": 977,
+    "奏": 978,
+    "沿": 979,
+    "露": 980,
+    "桿": 981,
+    "探": 982,
+    "滑": 983,
+    "鎮": 984,
+    "飯": 985,
+    "濃": 986,
+    "航": 987,
+    "懷": 988,
+    "趕": 989,
+    "庫": 990,
+    "奪": 991,
+    "伊": 992,
+    "靈": 993,
+    "稅": 994,
+    "途": 995,
+    "滅": 996,
+    "賽": 997,
+    "歸": 998,
+    "召": 999,
+    "鼓": 1000,
+    "播": 1001,
+    "盤": 1002,
+    "裁": 1003,
+    "險": 1004,
+    "康": 1005,
+    "唯": 1006,
+    "錄": 1007,
+    "菌": 1008,
+    "純": 1009,
+    "借": 1010,
+    "糖": 1011,
+    "蓋": 1012,
+    "橫": 1013,
+    "符": 1014,
+    "私": 1015,
+    "努": 1016,
+    "堂": 1017,
+    "域": 1018,
+    "槍": 1019,
+    "潤": 1020,
+    "幅": 1021,
+    "哈": 1022,
+    "竟": 1023,
+    "熟": 1024,
+    "蟲": 1025,
+    "澤": 1026,
+    "腦": 1027,
+    "壤": 1028,
+    "碳": 1029,
+    "歐": 1030,
+    "遍": 1031,
+    "側": 1032,
+    "寨": 1033,
+    "敢": 1034,
+    "徹": 1035,
+    "慮": 1036,
+    "斜": 1037,
+    "薄": 1038,
+    "庭": 1039,
+    "納": 1040,
+    "彈": 1041,
+    "飼": 1042,
+    "伸": 1043,
+    "折": 1044,
+    "麥": 1045,
+    "濕": 1046,
+    "暗": 1047,
+    "荷": 1048,
+    "瓦": 1049,
+    "塞": 1050,
+    "床": 1051,
+    "築": 1052,
+    "惡": 1053,
+    "戶": 1054,
+    "訪": 1055,
+    "塔": 1056,
+    "奇": 1057,
+    "透": 1058,
+    "梁": 1059,
+    "刀": 1060,
+    "旋": 1061,
+    "跡": 1062,
+    "卡": 1063,
+    "氯": 1064,
+    "遇": 1065,
+    "份": 1066,
+    "毒": 1067,
+    "泥": 1068,
+    "退": 1069,
+    "洗": 1070,
+    "擺": 1071,
+    "灰": 1072,
+    "彩": 1073,
+    "賣": 1074,
+    "耗": 1075,
+    "夏": 1076,
+    "擇": 1077,
+    "忙": 1078,
+    "銅": 1079,
+    "獻": 1080,
+    "硬": 1081,
+    "予": 1082,
+    "繁": 1083,
+    "圈": 1084,
+    "雪": 1085,
+    "函": 1086,
+    "亦": 1087,
+    "抽": 1088,
+    "篇": 1089,
+    "陣": 1090,
+    "陰": 1091,
+    "丁": 1092,
+    "尺": 1093,
+    "追": 1094,
+    "堆": 1095,
+    "雄": 1096,
+    "迎": 1097,
+    "泛": 1098,
+    "爸": 1099,
+    "樓": 1100,
+    "避": 1101,
+    "謀": 1102,
+    "噸": 1103,
+    "野": 1104,
+    "豬": 1105,
+    "旗": 1106,
+    "累": 1107,
+    "偏": 1108,
+    "典": 1109,
+    "館": 1110,
+    "索": 1111,
+    "秦": 1112,
+    "脂": 1113,
+    "潮": 1114,
+    "爺": 1115,
+    "豆": 1116,
+    "忽": 1117,
+    "托": 1118,
+    "驚": 1119,
+    "塑": 1120,
+    "遺": 1121,
+    "愈": 1122,
+    "朱": 1123,
+    "替": 1124,
+    "纖": 1125,
+    "粗": 1126,
+    "傾": 1127,
+    "尚": 1128,
+    "痛": 1129,
+    "楚": 1130,
+    "謝": 1131,
+    "奮": 1132,
+    "購": 1133,
+    "磨": 1134,
+    "君": 1135,
+    "池": 1136,
+    "旁": 1137,
+    "碎": 1138,
+    "骨": 1139,
+    "監": 1140,
+    "捕": 1141,
+    "弟": 1142,
+    "暴": 1143,
+    "割": 1144,
+    "貫": 1145,
+    "殊": 1146,
+    "釋": 1147,
+    "詞": 1148,
+    "亡": 1149,
+    "壁": 1150,
+    "頓": 1151,
+    "寶": 1152,
+    "午": 1153,
+    "塵": 1154,
+    "聞": 1155,
+    "揭": 1156,
+    "炮": 1157,
+    "殘": 1158,
+    "冬": 1159,
+    "橋": 1160,
+    "婦": 1161,
+    "警": 1162,
+    "綜": 1163,
+    "招": 1164,
+    "吳": 1165,
+    "付": 1166,
+    "浮": 1167,
+    "遭": 1168,
+    "徐": 1169,
+    "您": 1170,
+    "搖": 1171,
+    "谷": 1172,
+    "贊": 1173,
+    "箱": 1174,
+    "隔": 1175,
+    "訂": 1176,
+    "男": 1177,
+    "吹": 1178,
+    "園": 1179,
+    "紛": 1180,
+    "唐": 1181,
+    "敗": 1182,
+    "宋": 1183,
+    "玻": 1184,
+    "巨": 1185,
+    "耕": 1186,
+    "坦": 1187,
+    "榮": 1188,
+    "閉": 1189,
+    "灣": 1190,
+    "鍵": 1191,
+    "凡": 1192,
+    "駐": 1193,
+    "鍋": 1194,
+    "救": 1195,
+    "恩": 1196,
+    "剝": 1197,
+    "凝": 1198,
+    "鹼": 1199,
+    "齒": 1200,
+    "截": 1201,
+    "煉": 1202,
+    "麻": 1203,
+    "紡": 1204,
+    "禁": 1205,
+    "廢": 1206,
+    "盛": 1207,
+    "版": 1208,
+    "緩": 1209,
+    "淨": 1210,
+    "睛": 1211,
+    "昌": 1212,
+    "婚": 1213,
+    "涉": 1214,
+    "筒": 1215,
+    "嘴": 1216,
+    "插": 1217,
+    "岸": 1218,
+    "朗": 1219,
+    "莊": 1220,
+    "街": 1221,
+    "藏": 1222,
+    "姑": 1223,
+    "貿": 1224,
+    "腐": 1225,
+    "奴": 1226,
+    "啦": 1227,
+    "慣": 1228,
+    "乘": 1229,
+    "夥": 1230,
+    "恢": 1231,
+    "勻": 1232,
+    "紗": 1233,
+    "扎": 1234,
+    "辯": 1235,
+    "耳": 1236,
+    "彪": 1237,
+    "臣": 1238,
+    "億": 1239,
+    "璃": 1240,
+    "抵": 1241,
+    "脈": 1242,
+    "秀": 1243,
+    "薩": 1244,
+    "俄": 1245,
+    "網": 1246,
+    "舞": 1247,
+    "店": 1248,
+    "噴": 1249,
+    "縱": 1250,
+    "寸": 1251,
+    "汗": 1252,
+    "掛": 1253,
+    "洪": 1254,
+    "賀": 1255,
+    "閃": 1256,
+    "柬": 1257,
+    "爆": 1258,
+    "烯": 1259,
+    "津": 1260,
+    "稻": 1261,
+    "牆": 1262,
+    "軟": 1263,
+    "勇": 1264,
+    "像": 1265,
+    "滾": 1266,
+    "厘": 1267,
+    "蒙": 1268,
+    "芳": 1269,
+    "肯": 1270,
+    "坡": 1271,
+    "柱": 1272,
+    "盪": 1273,
+    "腿": 1274,
+    "儀": 1275,
+    "旅": 1276,
+    "尾": 1277,
+    "軋": 1278,
+    "冰": 1279,
+    "貢": 1280,
+    "登": 1281,
+    "黎": 1282,
+    "削": 1283,
+    "鑽": 1284,
+    "勒": 1285,
+    "逃": 1286,
+    "障": 1287,
+    "氨": 1288,
+    "郭": 1289,
+    "峰": 1290,
+    "幣": 1291,
+    "港": 1292,
+    "伏": 1293,
+    "軌": 1294,
+    "畝": 1295,
+    "畢": 1296,
+    "擦": 1297,
+    "莫": 1298,
+    "刺": 1299,
+    "浪": 1300,
+    "秘": 1301,
+    "援": 1302,
+    "株": 1303,
+    "健": 1304,
+    "售": 1305,
+    "股": 1306,
+    "島": 1307,
+    "甘": 1308,
+    "泡": 1309,
+    "睡": 1310,
+    "童": 1311,
+    "鑄": 1312,
+    "湯": 1313,
+    "閥": 1314,
+    "休": 1315,
+    "匯": 1316,
+    "舍": 1317,
+    "牧": 1318,
+    "繞": 1319,
+    "炸": 1320,
+    "哲": 1321,
+    "磷": 1322,
+    "績": 1323,
+    "朋": 1324,
+    "淡": 1325,
+    "尖": 1326,
+    "啟": 1327,
+    "陷": 1328,
+    "柴": 1329,
+    "呈": 1330,
+    "徒": 1331,
+    "顏": 1332,
+    "淚": 1333,
+    "稍": 1334,
+    "忘": 1335,
+    "泵": 1336,
+    "藍": 1337,
+    "拖": 1338,
+    "洞": 1339,
+    "授": 1340,
+    "鏡": 1341,
+    "辛": 1342,
+    "壯": 1343,
+    "鋒": 1344,
+    "貧": 1345,
+    "虛": 1346,
+    "彎": 1347,
+    "摩": 1348,
+    "泰": 1349,
+    "幼": 1350,
+    "廷": 1351,
+    "尊": 1352,
+    "窗": 1353,
+    "綱": 1354,
+    "弄": 1355,
+    "隸": 1356,
+    "疑": 1357,
+    "氏": 1358,
+    "宮": 1359,
+    "姐": 1360,
+    "震": 1361,
+    "瑞": 1362,
+    "怪": 1363,
+    "尤": 1364,
+    "琴": 1365,
+    "循": 1366,
+    "描": 1367,
+    "膜": 1368,
+    "違": 1369,
+    "夾": 1370,
+    "腰": 1371,
+    "緣": 1372,
+    "珠": 1373,
+    "窮": 1374,
+    "森": 1375,
+    "枝": 1376,
+    "竹": 1377,
+    "溝": 1378,
+    "催": 1379,
+    "繩": 1380,
+    "憶": 1381,
+    "邦": 1382,
+    "剩": 1383,
+    "幸": 1384,
+    "漿": 1385,
+    "欄": 1386,
+    "擁": 1387,
+    "牙": 1388,
+    "貯": 1389,
+    "禮": 1390,
+    "濾": 1391,
+    "鈉": 1392,
+    "紋": 1393,
+    "罷": 1394,
+    "拍": 1395,
+    "咱": 1396,
+    "喊": 1397,
+    "袖": 1398,
+    "埃": 1399,
+    "勤": 1400,
+    "罰": 1401,
+    "焦": 1402,
+    "潛": 1403,
+    "伍": 1404,
+    "墨": 1405,
+    "欲": 1406,
+    "縫": 1407,
+    "姓": 1408,
+    "刊": 1409,
+    "飽": 1410,
+    "仿": 1411,
+    "獎": 1412,
+    "鋁": 1413,
+    "鬼": 1414,
+    "麗": 1415,
+    "跨": 1416,
+    "默": 1417,
+    "挖": 1418,
+    "鏈": 1419,
+    "掃": 1420,
+    "喝": 1421,
+    "袋": 1422,
+    "炭": 1423,
+    "污": 1424,
+    "幕": 1425,
+    "諸": 1426,
+    "弧": 1427,
+    "勵": 1428,
+    "梅": 1429,
+    "奶": 1430,
+    "潔": 1431,
+    "災": 1432,
+    "舟": 1433,
+    "鑑": 1434,
+    "苯": 1435,
+    "訟": 1436,
+    "抱": 1437,
+    "毀": 1438,
+    "懂": 1439,
+    "寒": 1440,
+    "智": 1441,
+    "埔": 1442,
+    "寄": 1443,
+    "屆": 1444,
+    "躍": 1445,
+    "渡": 1446,
+    "挑": 1447,
+    "丹": 1448,
+    "艱": 1449,
+    "貝": 1450,
+    "碰": 1451,
+    "拔": 1452,
+    "爹": 1453,
+    "戴": 1454,
+    "碼": 1455,
+    "夢": 1456,
+    "芽": 1457,
+    "熔": 1458,
+    "赤": 1459,
+    "漁": 1460,
+    "哭": 1461,
+    "敬": 1462,
+    "顆": 1463,
+    "奔": 1464,
+    "鉛": 1465,
+    "仲": 1466,
+    "虎": 1467,
+    "稀": 1468,
+    "妹": 1469,
+    "乏": 1470,
+    "珍": 1471,
+    "申": 1472,
+    "桌": 1473,
+    "遵": 1474,
+    "允": 1475,
+    "隆": 1476,
+    "螺": 1477,
+    "倉": 1478,
+    "魏": 1479,
+    "銳": 1480,
+    "曉": 1481,
+    "氮": 1482,
+    "兼": 1483,
+    "隱": 1484,
+    "礙": 1485,
+    "赫": 1486,
+    "撥": 1487,
+    "忠": 1488,
+    "肅": 1489,
+    "缸": 1490,
+    "牽": 1491,
+    "搶": 1492,
+    "博": 1493,
+    "巧": 1494,
+    "殼": 1495,
+    "兄": 1496,
+    "杜": 1497,
+    "訊": 1498,
+    "誠": 1499,
+    "碧": 1500,
+    "祥": 1501,
+    "柯": 1502,
+    "頁": 1503,
+    "巡": 1504,
+    "矩": 1505,
+    "悲": 1506,
+    "灌": 1507,
+    "齡": 1508,
+    "倫": 1509,
+    "票": 1510,
+    "尋": 1511,
+    "桂": 1512,
+    "鋪": 1513,
+    "聖": 1514,
+    "恐": 1515,
+    "恰": 1516,
+    "鄭": 1517,
+    "趣": 1518,
+    "抬": 1519,
+    "荒": 1520,
+    "騰": 1521,
+    "貼": 1522,
+    "柔": 1523,
+    "滴": 1524,
+    "猛": 1525,
+    "闊": 1526,
+    "輛": 1527,
+    "妻": 1528,
+    "填": 1529,
+    "撤": 1530,
+    "儲": 1531,
+    "簽": 1532,
+    "鬧": 1533,
+    "擾": 1534,
+    "紫": 1535,
+    "砂": 1536,
+    "遞": 1537,
+    "戲": 1538,
+    "吊": 1539,
+    "陶": 1540,
+    "伐": 1541,
+    "餵": 1542,
+    "療": 1543,
+    "瓶": 1544,
+    "婆": 1545,
+    "撫": 1546,
+    "臂": 1547,
+    "摸": 1548,
+    "忍": 1549,
+    "蝦": 1550,
+    "蠟": 1551,
+    "鄰": 1552,
+    "胸": 1553,
+    "鞏": 1554,
+    "擠": 1555,
+    "偶": 1556,
+    "棄": 1557,
+    "槽": 1558,
+    "勁": 1559,
+    "乳": 1560,
+    "鄧": 1561,
+    "吉": 1562,
+    "仁": 1563,
+    "爛": 1564,
+    "磚": 1565,
+    "租": 1566,
+    "烏": 1567,
+    "艦": 1568,
+    "伴": 1569,
+    "瓜": 1570,
+    "淺": 1571,
+    "丙": 1572,
+    "暫": 1573,
+    "燥": 1574,
+    "橡": 1575,
+    "柳": 1576,
+    "迷": 1577,
+    "暖": 1578,
+    "牌": 1579,
+    "秧": 1580,
+    "膽": 1581,
+    "詳": 1582,
+    "簧": 1583,
+    "踏": 1584,
+    "瓷": 1585,
+    "譜": 1586,
+    "呆": 1587,
+    "賓": 1588,
+    "糊": 1589,
+    "洛": 1590,
+    "輝": 1591,
+    "憤": 1592,
+    "競": 1593,
+    "隙": 1594,
+    "怒": 1595,
+    "粘": 1596,
+    "乃": 1597,
+    "緒": 1598,
+    "肩": 1599,
+    "籍": 1600,
+    "敏": 1601,
+    "塗": 1602,
+    "熙": 1603,
+    "皆": 1604,
+    "偵": 1605,
+    "懸": 1606,
+    "掘": 1607,
+    "享": 1608,
+    "糾": 1609,
+    "醒": 1610,
+    "狂": 1611,
+    "鎖": 1612,
+    "淀": 1613,
+    "恨": 1614,
+    "牲": 1615,
+    "霸": 1616,
+    "爬": 1617,
+    "賞": 1618,
+    "逆": 1619,
+    "玩": 1620,
+    "陵": 1621,
+    "祝": 1622,
+    "秒": 1623,
+    "浙": 1624,
+    "貌": 1625,
+    "役": 1626,
+    "彼": 1627,
+    "悉": 1628,
+    "鴨": 1629,
+    "趨": 1630,
+    "鳳": 1631,
+    "晨": 1632,
+    "畜": 1633,
+    "輩": 1634,
+    "秩": 1635,
+    "卵": 1636,
+    "署": 1637,
+    "梯": 1638,
+    "炎": 1639,
+    "灘": 1640,
+    "棋": 1641,
+    "驅": 1642,
+    "篩": 1643,
+    "峽": 1644,
+    "冒": 1645,
+    "啥": 1646,
+    "壽": 1647,
+    "譯": 1648,
+    "浸": 1649,
+    "泉": 1650,
+    "帽": 1651,
+    "遲": 1652,
+    "矽": 1653,
+    "疆": 1654,
+    "貸": 1655,
+    "漏": 1656,
+    "稿": 1657,
+    "冠": 1658,
+    "嫩": 1659,
+    "脅": 1660,
+    "芯": 1661,
+    "牢": 1662,
+    "叛": 1663,
+    "蝕": 1664,
+    "奧": 1665,
+    "鳴": 1666,
+    "嶺": 1667,
+    "羊": 1668,
+    "憑": 1669,
+    "串": 1670,
+    "塘": 1671,
+    "繪": 1672,
+    "酵": 1673,
+    "融": 1674,
+    "盆": 1675,
+    "錫": 1676,
+    "廟": 1677,
+    "籌": 1678,
+    "凍": 1679,
+    "輔": 1680,
+    "攝": 1681,
+    "襲": 1682,
+    "筋": 1683,
+    "拒": 1684,
+    "僚": 1685,
+    "旱": 1686,
+    "鉀": 1687,
+    "鳥": 1688,
+    "漆": 1689,
+    "沈": 1690,
+    "眉": 1691,
+    "疏": 1692,
+    "添": 1693,
+    "棒": 1694,
+    "穗": 1695,
+    "硝": 1696,
+    "韓": 1697,
+    "逼": 1698,
+    "扭": 1699,
+    "僑": 1700,
+    "涼": 1701,
+    "挺": 1702,
+    "碗": 1703,
+    "栽": 1704,
+    "炒": 1705,
+    "杯": 1706,
+    "患": 1707,
+    "餾": 1708,
+    "勸": 1709,
+    "豪": 1710,
+    "遼": 1711,
+    "勃": 1712,
+    "鴻": 1713,
+    "旦": 1714,
+    "吏": 1715,
+    "拜": 1716,
+    "狗": 1717,
+    "埋": 1718,
+    "輥": 1719,
+    "掩": 1720,
+    "飲": 1721,
+    "搬": 1722,
+    "罵": 1723,
+    "辭": 1724,
+    "勾": 1725,
+    "扣": 1726,
+    "估": 1727,
+    "蔣": 1728,
+    "絨": 1729,
+    "霧": 1730,
+    "丈": 1731,
+    "朵": 1732,
+    "姆": 1733,
+    "擬": 1734,
+    "宇": 1735,
+    "輯": 1736,
+    "陝": 1737,
+    "雕": 1738,
+    "償": 1739,
+    "蓄": 1740,
+    "崇": 1741,
+    "剪": 1742,
+    "倡": 1743,
+    "廳": 1744,
+    "咬": 1745,
+    "駛": 1746,
+    "薯": 1747,
+    "刷": 1748,
+    "斥": 1749,
+    "番": 1750,
+    "賦": 1751,
+    "奉": 1752,
+    "佛": 1753,
+    "澆": 1754,
+    "漫": 1755,
+    "曼": 1756,
+    "扇": 1757,
+    "鈣": 1758,
+    "桃": 1759,
+    "扶": 1760,
+    "仔": 1761,
+    "返": 1762,
+    "俗": 1763,
+    "虧": 1764,
+    "腔": 1765,
+    "鞋": 1766,
+    "棱": 1767,
+    "覆": 1768,
+    "框": 1769,
+    "悄": 1770,
+    "叔": 1771,
+    "撞": 1772,
+    "騙": 1773,
+    "勘": 1774,
+    "旺": 1775,
+    "沸": 1776,
+    "孤": 1777,
+    "吐": 1778,
+    "孟": 1779,
+    "渠": 1780,
+    "屈": 1781,
+    "疾": 1782,
+    "妙": 1783,
+    "惜": 1784,
+    "仰": 1785,
+    "狠": 1786,
+    "脹": 1787,
+    "諧": 1788,
+    "拋": 1789,
+    "黴": 1790,
+    "桑": 1791,
+    "崗": 1792,
+    "嘛": 1793,
+    "衰": 1794,
+    "盜": 1795,
+    "滲": 1796,
+    "臟": 1797,
+    "賴": 1798,
+    "湧": 1799,
+    "甜": 1800,
+    "曹": 1801,
+    "閱": 1802,
+    "肌": 1803,
+    "哩": 1804,
+    "厲": 1805,
+    "烴": 1806,
+    "緯": 1807,
+    "毅": 1808,
+    "昨": 1809,
+    "偽": 1810,
+    "症": 1811,
+    "煮": 1812,
+    "嘆": 1813,
+    "釘": 1814,
+    "搭": 1815,
+    "莖": 1816,
+    "籠": 1817,
+    "酷": 1818,
+    "偷": 1819,
+    "弓": 1820,
+    "錐": 1821,
+    "恆": 1822,
+    "傑": 1823,
+    "坑": 1824,
+    "鼻": 1825,
+    "翼": 1826,
+    "綸": 1827,
+    "敘": 1828,
+    "獄": 1829,
+    "逮": 1830,
+    "罐": 1831,
+    "絡": 1832,
+    "棚": 1833,
+    "抑": 1834,
+    "膨": 1835,
+    "蔬": 1836,
+    "寺": 1837,
+    "驟": 1838,
+    "穆": 1839,
+    "冶": 1840,
+    "枯": 1841,
+    "冊": 1842,
+    "屍": 1843,
+    "凸": 1844,
+    "紳": 1845,
+    "坯": 1846,
+    "犧": 1847,
+    "焰": 1848,
+    "轟": 1849,
+    "欣": 1850,
+    "晉": 1851,
+    "瘦": 1852,
+    "禦": 1853,
+    "錠": 1854,
+    "錦": 1855,
+    "喪": 1856,
+    "旬": 1857,
+    "鍛": 1858,
+    "壟": 1859,
+    "搜": 1860,
+    "撲": 1861,
+    "邀": 1862,
+    "亭": 1863,
+    "酯": 1864,
+    "邁": 1865,
+    "舒": 1866,
+    "脆": 1867,
+    "酶": 1868,
+    "閒": 1869,
+    "憂": 1870,
+    "酚": 1871,
+    "頑": 1872,
+    "羽": 1873,
+    "漲": 1874,
+    "卸": 1875,
+    "仗": 1876,
+    "陪": 1877,
+    "闢": 1878,
+    "懲": 1879,
+    "杭": 1880,
+    "姚": 1881,
+    "肚": 1882,
+    "捉": 1883,
+    "飄": 1884,
+    "漂": 1885,
+    "昆": 1886,
+    "欺": 1887,
+    "吾": 1888,
+    "郎": 1889,
+    "烷": 1890,
+    "汁": 1891,
+    "呵": 1892,
+    "飾": 1893,
+    "蕭": 1894,
+    "雅": 1895,
+    "郵": 1896,
+    "遷": 1897,
+    "燕": 1898,
+    "撒": 1899,
+    "姻": 1900,
+    "赴": 1901,
+    "宴": 1902,
+    "煩": 1903,
+    "債": 1904,
+    "帳": 1905,
+    "斑": 1906,
+    "鈴": 1907,
+    "旨": 1908,
+    "醇": 1909,
+    "董": 1910,
+    "餅": 1911,
+    "雛": 1912,
+    "姿": 1913,
+    "拌": 1914,
+    "傅": 1915,
+    "腹": 1916,
+    "妥": 1917,
+    "揉": 1918,
+    "賢": 1919,
+    "拆": 1920,
+    "歪": 1921,
+    "葡": 1922,
+    "胺": 1923,
+    "丟": 1924,
+    "浩": 1925,
+    "徽": 1926,
+    "昂": 1927,
+    "墊": 1928,
+    "擋": 1929,
+    "覽": 1930,
+    "貪": 1931,
+    "慰": 1932,
+    "繳": 1933,
+    "汪": 1934,
+    "慌": 1935,
+    "馮": 1936,
+    "諾": 1937,
+    "姜": 1938,
+    "誼": 1939,
+    "兇": 1940,
+    "劣": 1941,
+    "誣": 1942,
+    "耀": 1943,
+    "昏": 1944,
+    "躺": 1945,
+    "盈": 1946,
+    "騎": 1947,
+    "喬": 1948,
+    "溪": 1949,
+    "叢": 1950,
+    "盧": 1951,
+    "抹": 1952,
+    "悶": 1953,
+    "諮": 1954,
+    "刮": 1955,
+    "駕": 1956,
+    "纜": 1957,
+    "悟": 1958,
+    "摘": 1959,
+    "鉺": 1960,
+    "擲": 1961,
+    "頗": 1962,
+    "幻": 1963,
+    "柄": 1964,
+    "惠": 1965,
+    "慘": 1966,
+    "佳": 1967,
+    "仇": 1968,
+    "臘": 1969,
+    "窩": 1970,
+    "滌": 1971,
+    "劍": 1972,
+    "瞧": 1973,
+    "堡": 1974,
+    "潑": 1975,
+    "蔥": 1976,
+    "罩": 1977,
+    "霍": 1978,
+    "撈": 1979,
+    "胎": 1980,
+    "蒼": 1981,
+    "濱": 1982,
+    "倆": 1983,
+    "捅": 1984,
+    "湘": 1985,
+    "砍": 1986,
+    "霞": 1987,
+    "邵": 1988,
+    "萄": 1989,
+    "瘋": 1990,
+    "淮": 1991,
+    "遂": 1992,
+    "熊": 1993,
+    "糞": 1994,
+    "烘": 1995,
+    "宿": 1996,
+    "檔": 1997,
+    "戈": 1998,
+    "駁": 1999,
+    "嫂": 2000,
+    "裕": 2001,
+    "徙": 2002,
+    "箭": 2003,
+    "捐": 2004,
+    "腸": 2005,
+    "撐": 2006,
+    "曬": 2007,
+    "辨": 2008,
+    "殿": 2009,
+    "蓮": 2010,
+    "攤": 2011,
+    "攪": 2012,
+    "醬": 2013,
+    "屏": 2014,
+    "疫": 2015,
+    "哀": 2016,
+    "蔡": 2017,
+    "堵": 2018,
+    "沫": 2019,
+    "皺": 2020,
+    "暢": 2021,
+    "疊": 2022,
+    "閣": 2023,
+    "萊": 2024,
+    "敲": 2025,
+    "轄": 2026,
+    "鉤": 2027,
+    "痕": 2028,
+    "壩": 2029,
+    "巷": 2030,
+    "餓": 2031,
+    "禍": 2032,
+    "丘": 2033,
+    "玄": 2034,
+    "溜": 2035,
+    "曰": 2036,
+    "邏": 2037,
+    "彭": 2038,
+    "嘗": 2039,
+    "卿": 2040,
+    "妨": 2041,
+    "艇": 2042,
+    "吞": 2043,
+    "韋": 2044,
+    "怨": 2045,
+    "矮": 2046,
+    "歇": 2047,
+}
+var words_czech = [2048]string{
+    "abdikace",
+    "abeceda",
+    "adresa",
+    "agrese",
+    "akce",
+    "aktovka",
+    "alej",
+    "alkohol",
+    "amputace",
+    "ananas",
+    "andulka",
+    "anekdota",
+    "anketa",
+    "antika",
+    "anulovat",
+    "archa",
+    "arogance",
+    "asfalt",
+    "asistent",
+    "aspirace",
+    "astma",
+    "astronom",
+    "atlas",
+    "atletika",
+    "atol",
+    "autobus",
+    "azyl",
+    "babka",
+    "bachor",
+    "bacil",
+    "baculka",
+    "badatel",
+    "bageta",
+    "bagr",
+    "bahno",
+    "bakterie",
+    "balada",
+    "baletka",
+    "balkon",
+    "balonek",
+    "balvan",
+    "balza",
+    "bambus",
+    "bankomat",
+    "barbar",
+    "baret",
+    "barman",
+    "baroko",
+    "barva",
+    "baterka",
+    "batoh",
+    "bavlna",
+    "bazalka",
+    "bazilika",
+    "bazuka",
+    "bedna",
+    "beran",
+    "beseda",
+    "bestie",
+    "beton",
+    "bezinka",
+    "bezmoc",
+    "beztak",
+    "bicykl",
+    "bidlo",
+    "biftek",
+    "bikiny",
+    "bilance",
+    "biograf",
+    "biolog",
+    "bitva",
+    "bizon",
+    "blahobyt",
+    "blatouch",
+    "blecha",
+    "bledule",
+    "blesk",
+    "blikat",
+    "blizna",
+    "blokovat",
+    "bloudit",
+    "blud",
+    "bobek",
+    "bobr",
+    "bodlina",
+    "bodnout",
+    "bohatost",
+    "bojkot",
+    "bojovat",
+    "bokorys",
+    "bolest",
+    "borec",
+    "borovice",
+    "bota",
+    "boubel",
+    "bouchat",
+    "bouda",
+    "boule",
+    "bourat",
+    "boxer",
+    "bradavka",
+    "brambora",
+    "branka",
+    "bratr",
+    "brepta",
+    "briketa",
+    "brko",
+    "brloh",
+    "bronz",
+    "broskev",
+    "brunetka",
+    "brusinka",
+    "brzda",
+    "brzy",
+    "bublina",
+    "bubnovat",
+    "buchta",
+    "buditel",
+    "budka",
+    "budova",
+    "bufet",
+    "bujarost",
+    "bukvice",
+    "buldok",
+    "bulva",
+    "bunda",
+    "bunkr",
+    "burza",
+    "butik",
+    "buvol",
+    "buzola",
+    "bydlet",
+    "bylina",
+    "bytovka",
+    "bzukot",
+    "capart",
+    "carevna",
+    "cedr",
+    "cedule",
+    "cejch",
+    "cejn",
+    "cela",
+    "celer",
+    "celkem",
+    "celnice",
+    "cenina",
+    "cennost",
+    "cenovka",
+    "centrum",
+    "cenzor",
+    "cestopis",
+    "cetka",
+    "chalupa",
+    "chapadlo",
+    "charita",
+    "chata",
+    "chechtat",
+    "chemie",
+    "chichot",
+    "chirurg",
+    "chlad",
+    "chleba",
+    "chlubit",
+    "chmel",
+    "chmura",
+    "chobot",
+    "chochol",
+    "chodba",
+    "cholera",
+    "chomout",
+    "chopit",
+    "choroba",
+    "chov",
+    "chrapot",
+    "chrlit",
+    "chrt",
+    "chrup",
+    "chtivost",
+    "chudina",
+    "chutnat",
+    "chvat",
+    "chvilka",
+    "chvost",
+    "chyba",
+    "chystat",
+    "chytit",
+    "cibule",
+    "cigareta",
+    "cihelna",
+    "cihla",
+    "cinkot",
+    "cirkus",
+    "cisterna",
+    "citace",
+    "citrus",
+    "cizinec",
+    "cizost",
+    "clona",
+    "cokoliv",
+    "couvat",
+    "ctitel",
+    "ctnost",
+    "cudnost",
+    "cuketa",
+    "cukr",
+    "cupot",
+    "cvaknout",
+    "cval",
+    "cvik",
+    "cvrkot",
+    "cyklista",
+    "daleko",
+    "dareba",
+    "datel",
+    "datum",
+    "dcera",
+    "debata",
+    "dechovka",
+    "decibel",
+    "deficit",
+    "deflace",
+    "dekl",
+    "dekret",
+    "demokrat",
+    "deprese",
+    "derby",
+    "deska",
+    "detektiv",
+    "dikobraz",
+    "diktovat",
+    "dioda",
+    "diplom",
+    "disk",
+    "displej",
+    "divadlo",
+    "divoch",
+    "dlaha",
+    "dlouho",
+    "dluhopis",
+    "dnes",
+    "dobro",
+    "dobytek",
+    "docent",
+    "dochutit",
+    "dodnes",
+    "dohled",
+    "dohoda",
+    "dohra",
+    "dojem",
+    "dojnice",
+    "doklad",
+    "dokola",
+    "doktor",
+    "dokument",
+    "dolar",
+    "doleva",
+    "dolina",
+    "doma",
+    "dominant",
+    "domluvit",
+    "domov",
+    "donutit",
+    "dopad",
+    "dopis",
+    "doplnit",
+    "doposud",
+    "doprovod",
+    "dopustit",
+    "dorazit",
+    "dorost",
+    "dort",
+    "dosah",
+    "doslov",
+    "dostatek",
+    "dosud",
+    "dosyta",
+    "dotaz",
+    "dotek",
+    "dotknout",
+    "doufat",
+    "doutnat",
+    "dovozce",
+    "dozadu",
+    "doznat",
+    "dozorce",
+    "drahota",
+    "drak",
+    "dramatik",
+    "dravec",
+    "draze",
+    "drdol",
+    "drobnost",
+    "drogerie",
+    "drozd",
+    "drsnost",
+    "drtit",
+    "drzost",
+    "duben",
+    "duchovno",
+    "dudek",
+    "duha",
+    "duhovka",
+    "dusit",
+    "dusno",
+    "dutost",
+    "dvojice",
+    "dvorec",
+    "dynamit",
+    "ekolog",
+    "ekonomie",
+    "elektron",
+    "elipsa",
+    "email",
+    "emise",
+    "emoce",
+    "empatie",
+    "epizoda",
+    "epocha",
+    "epopej",
+    "epos",
+    "esej",
+    "esence",
+    "eskorta",
+    "eskymo",
+    "etiketa",
+    "euforie",
+    "evoluce",
+    "exekuce",
+    "exkurze",
+    "expedice",
+    "exploze",
+    "export",
+    "extrakt",
+    "facka",
+    "fajfka",
+    "fakulta",
+    "fanatik",
+    "fantazie",
+    "farmacie",
+    "favorit",
+    "fazole",
+    "federace",
+    "fejeton",
+    "fenka",
+    "fialka",
+    "figurant",
+    "filozof",
+    "filtr",
+    "finance",
+    "finta",
+    "fixace",
+    "fjord",
+    "flanel",
+    "flirt",
+    "flotila",
+    "fond",
+    "fosfor",
+    "fotbal",
+    "fotka",
+    "foton",
+    "frakce",
+    "freska",
+    "fronta",
+    "fukar",
+    "funkce",
+    "fyzika",
+    "galeje",
+    "garant",
+    "genetika",
+    "geolog",
+    "gilotina",
+    "glazura",
+    "glejt",
+    "golem",
+    "golfista",
+    "gotika",
+    "graf",
+    "gramofon",
+    "granule",
+    "grep",
+    "gril",
+    "grog",
+    "groteska",
+    "guma",
+    "hadice",
+    "hadr",
+    "hala",
+    "halenka",
+    "hanba",
+    "hanopis",
+    "harfa",
+    "harpuna",
+    "havran",
+    "hebkost",
+    "hejkal",
+    "hejno",
+    "hejtman",
+    "hektar",
+    "helma",
+    "hematom",
+    "herec",
+    "herna",
+    "heslo",
+    "hezky",
+    "historik",
+    "hladovka",
+    "hlasivky",
+    "hlava",
+    "hledat",
+    "hlen",
+    "hlodavec",
+    "hloh",
+    "hloupost",
+    "hltat",
+    "hlubina",
+    "hluchota",
+    "hmat",
+    "hmota",
+    "hmyz",
+    "hnis",
+    "hnojivo",
+    "hnout",
+    "hoblina",
+    "hoboj",
+    "hoch",
+    "hodiny",
+    "hodlat",
+    "hodnota",
+    "hodovat",
+    "hojnost",
+    "hokej",
+    "holinka",
+    "holka",
+    "holub",
+    "homole",
+    "honitba",
+    "honorace",
+    "horal",
+    "horda",
+    "horizont",
+    "horko",
+    "horlivec",
+    "hormon",
+    "hornina",
+    "horoskop",
+    "horstvo",
+    "hospoda",
+    "hostina",
+    "hotovost",
+    "houba",
+    "houf",
+    "houpat",
+    "houska",
+    "hovor",
+    "hradba",
+    "hranice",
+    "hravost",
+    "hrazda",
+    "hrbolek",
+    "hrdina",
+    "hrdlo",
+    "hrdost",
+    "hrnek",
+    "hrobka",
+    "hromada",
+    "hrot",
+    "hrouda",
+    "hrozen",
+    "hrstka",
+    "hrubost",
+    "hryzat",
+    "hubenost",
+    "hubnout",
+    "hudba",
+    "hukot",
+    "humr",
+    "husita",
+    "hustota",
+    "hvozd",
+    "hybnost",
+    "hydrant",
+    "hygiena",
+    "hymna",
+    "hysterik",
+    "idylka",
+    "ihned",
+    "ikona",
+    "iluze",
+    "imunita",
+    "infekce",
+    "inflace",
+    "inkaso",
+    "inovace",
+    "inspekce",
+    "internet",
+    "invalida",
+    "investor",
+    "inzerce",
+    "ironie",
+    "jablko",
+    "jachta",
+    "jahoda",
+    "jakmile",
+    "jakost",
+    "jalovec",
+    "jantar",
+    "jarmark",
+    "jaro",
+    "jasan",
+    "jasno",
+    "jatka",
+    "javor",
+    "jazyk",
+    "jedinec",
+    "jedle",
+    "jednatel",
+    "jehlan",
+    "jekot",
+    "jelen",
+    "jelito",
+    "jemnost",
+    "jenom",
+    "jepice",
+    "jeseter",
+    "jevit",
+    "jezdec",
+    "jezero",
+    "jinak",
+    "jindy",
+    "jinoch",
+    "jiskra",
+    "jistota",
+    "jitrnice",
+    "jizva",
+    "jmenovat",
+    "jogurt",
+    "jurta",
+    "kabaret",
+    "kabel",
+    "kabinet",
+    "kachna",
+    "kadet",
+    "kadidlo",
+    "kahan",
+    "kajak",
+    "kajuta",
+    "kakao",
+    "kaktus",
+    "kalamita",
+    "kalhoty",
+    "kalibr",
+    "kalnost",
+    "kamera",
+    "kamkoliv",
+    "kamna",
+    "kanibal",
+    "kanoe",
+    "kantor",
+    "kapalina",
+    "kapela",
+    "kapitola",
+    "kapka",
+    "kaple",
+    "kapota",
+    "kapr",
+    "kapusta",
+    "kapybara",
+    "karamel",
+    "karotka",
+    "karton",
+    "kasa",
+    "katalog",
+    "katedra",
+    "kauce",
+    "kauza",
+    "kavalec",
+    "kazajka",
+    "kazeta",
+    "kazivost",
+    "kdekoliv",
+    "kdesi",
+    "kedluben",
+    "kemp",
+    "keramika",
+    "kino",
+    "klacek",
+    "kladivo",
+    "klam",
+    "klapot",
+    "klasika",
+    "klaun",
+    "klec",
+    "klenba",
+    "klepat",
+    "klesnout",
+    "klid",
+    "klima",
+    "klisna",
+    "klobouk",
+    "klokan",
+    "klopa",
+    "kloub",
+    "klubovna",
+    "klusat",
+    "kluzkost",
+    "kmen",
+    "kmitat",
+    "kmotr",
+    "kniha",
+    "knot",
+    "koalice",
+    "koberec",
+    "kobka",
+    "kobliha",
+    "kobyla",
+    "kocour",
+    "kohout",
+    "kojenec",
+    "kokos",
+    "koktejl",
+    "kolaps",
+    "koleda",
+    "kolize",
+    "kolo",
+    "komando",
+    "kometa",
+    "komik",
+    "komnata",
+    "komora",
+    "kompas",
+    "komunita",
+    "konat",
+    "koncept",
+    "kondice",
+    "konec",
+    "konfese",
+    "kongres",
+    "konina",
+    "konkurs",
+    "kontakt",
+    "konzerva",
+    "kopanec",
+    "kopie",
+    "kopnout",
+    "koprovka",
+    "korbel",
+    "korektor",
+    "kormidlo",
+    "koroptev",
+    "korpus",
+    "koruna",
+    "koryto",
+    "korzet",
+    "kosatec",
+    "kostka",
+    "kotel",
+    "kotleta",
+    "kotoul",
+    "koukat",
+    "koupelna",
+    "kousek",
+    "kouzlo",
+    "kovboj",
+    "koza",
+    "kozoroh",
+    "krabice",
+    "krach",
+    "krajina",
+    "kralovat",
+    "krasopis",
+    "kravata",
+    "kredit",
+    "krejcar",
+    "kresba",
+    "kreveta",
+    "kriket",
+    "kritik",
+    "krize",
+    "krkavec",
+    "krmelec",
+    "krmivo",
+    "krocan",
+    "krok",
+    "kronika",
+    "kropit",
+    "kroupa",
+    "krovka",
+    "krtek",
+    "kruhadlo",
+    "krupice",
+    "krutost",
+    "krvinka",
+    "krychle",
+    "krypta",
+    "krystal",
+    "kryt",
+    "kudlanka",
+    "kufr",
+    "kujnost",
+    "kukla",
+    "kulajda",
+    "kulich",
+    "kulka",
+    "kulomet",
+    "kultura",
+    "kuna",
+    "kupodivu",
+    "kurt",
+    "kurzor",
+    "kutil",
+    "kvalita",
+    "kvasinka",
+    "kvestor",
+    "kynolog",
+    "kyselina",
+    "kytara",
+    "kytice",
+    "kytka",
+    "kytovec",
+    "kyvadlo",
+    "labrador",
+    "lachtan",
+    "ladnost",
+    "laik",
+    "lakomec",
+    "lamela",
+    "lampa",
+    "lanovka",
+    "lasice",
+    "laso",
+    "lastura",
+    "latinka",
+    "lavina",
+    "lebka",
+    "leckdy",
+    "leden",
+    "lednice",
+    "ledovka",
+    "ledvina",
+    "legenda",
+    "legie",
+    "legrace",
+    "lehce",
+    "lehkost",
+    "lehnout",
+    "lektvar",
+    "lenochod",
+    "lentilka",
+    "lepenka",
+    "lepidlo",
+    "letadlo",
+    "letec",
+    "letmo",
+    "letokruh",
+    "levhart",
+    "levitace",
+    "levobok",
+    "libra",
+    "lichotka",
+    "lidojed",
+    "lidskost",
+    "lihovina",
+    "lijavec",
+    "lilek",
+    "limetka",
+    "linie",
+    "linka",
+    "linoleum",
+    "listopad",
+    "litina",
+    "litovat",
+    "lobista",
+    "lodivod",
+    "logika",
+    "logoped",
+    "lokalita",
+    "loket",
+    "lomcovat",
+    "lopata",
+    "lopuch",
+    "lord",
+    "losos",
+    "lotr",
+    "loudal",
+    "louh",
+    "louka",
+    "louskat",
+    "lovec",
+    "lstivost",
+    "lucerna",
+    "lucifer",
+    "lump",
+    "lusk",
+    "lustrace",
+    "lvice",
+    "lyra",
+    "lyrika",
+    "lysina",
+    "madam",
+    "madlo",
+    "magistr",
+    "mahagon",
+    "majetek",
+    "majitel",
+    "majorita",
+    "makak",
+    "makovice",
+    "makrela",
+    "malba",
+    "malina",
+    "malovat",
+    "malvice",
+    "maminka",
+    "mandle",
+    "manko",
+    "marnost",
+    "masakr",
+    "maskot",
+    "masopust",
+    "matice",
+    "matrika",
+    "maturita",
+    "mazanec",
+    "mazivo",
+    "mazlit",
+    "mazurka",
+    "mdloba",
+    "mechanik",
+    "meditace",
+    "medovina",
+    "melasa",
+    "meloun",
+    "mentolka",
+    "metla",
+    "metoda",
+    "metr",
+    "mezera",
+    "migrace",
+    "mihnout",
+    "mihule",
+    "mikina",
+    "mikrofon",
+    "milenec",
+    "milimetr",
+    "milost",
+    "mimika",
+    "mincovna",
+    "minibar",
+    "minomet",
+    "minulost",
+    "miska",
+    "mistr",
+    "mixovat",
+    "mladost",
+    "mlha",
+    "mlhovina",
+    "mlok",
+    "mlsat",
+    "mluvit",
+    "mnich",
+    "mnohem",
+    "mobil",
+    "mocnost",
+    "modelka",
+    "modlitba",
+    "mohyla",
+    "mokro",
+    "molekula",
+    "momentka",
+    "monarcha",
+    "monokl",
+    "monstrum",
+    "montovat",
+    "monzun",
+    "mosaz",
+    "moskyt",
+    "most",
+    "motivace",
+    "motorka",
+    "motyka",
+    "moucha",
+    "moudrost",
+    "mozaika",
+    "mozek",
+    "mozol",
+    "mramor",
+    "mravenec",
+    "mrkev",
+    "mrtvola",
+    "mrzet",
+    "mrzutost",
+    "mstitel",
+    "mudrc",
+    "muflon",
+    "mulat",
+    "mumie",
+    "munice",
+    "muset",
+    "mutace",
+    "muzeum",
+    "muzikant",
+    "myslivec",
+    "mzda",
+    "nabourat",
+    "nachytat",
+    "nadace",
+    "nadbytek",
+    "nadhoz",
+    "nadobro",
+    "nadpis",
+    "nahlas",
+    "nahnat",
+    "nahodile",
+    "nahradit",
+    "naivita",
+    "najednou",
+    "najisto",
+    "najmout",
+    "naklonit",
+    "nakonec",
+    "nakrmit",
+    "nalevo",
+    "namazat",
+    "namluvit",
+    "nanometr",
+    "naoko",
+    "naopak",
+    "naostro",
+    "napadat",
+    "napevno",
+    "naplnit",
+    "napnout",
+    "naposled",
+    "naprosto",
+    "narodit",
+    "naruby",
+    "narychlo",
+    "nasadit",
+    "nasekat",
+    "naslepo",
+    "nastat",
+    "natolik",
+    "navenek",
+    "navrch",
+    "navzdory",
+    "nazvat",
+    "nebe",
+    "nechat",
+    "necky",
+    "nedaleko",
+    "nedbat",
+    "neduh",
+    "negace",
+    "nehet",
+    "nehoda",
+    "nejen",
+    "nejprve",
+    "neklid",
+    "nelibost",
+    "nemilost",
+    "nemoc",
+    "neochota",
+    "neonka",
+    "nepokoj",
+    "nerost",
+    "nerv",
+    "nesmysl",
+    "nesoulad",
+    "netvor",
+    "neuron",
+    "nevina",
+    "nezvykle",
+    "nicota",
+    "nijak",
+    "nikam",
+    "nikdy",
+    "nikl",
+    "nikterak",
+    "nitro",
+    "nocleh",
+    "nohavice",
+    "nominace",
+    "nora",
+    "norek",
+    "nositel",
+    "nosnost",
+    "nouze",
+    "noviny",
+    "novota",
+    "nozdra",
+    "nuda",
+    "nudle",
+    "nuget",
+    "nutit",
+    "nutnost",
+    "nutrie",
+    "nymfa",
+    "obal",
+    "obarvit",
+    "obava",
+    "obdiv",
+    "obec",
+    "obehnat",
+    "obejmout",
+    "obezita",
+    "obhajoba",
+    "obilnice",
+    "objasnit",
+    "objekt",
+    "obklopit",
+    "oblast",
+    "oblek",
+    "obliba",
+    "obloha",
+    "obluda",
+    "obnos",
+    "obohatit",
+    "obojek",
+    "obout",
+    "obrazec",
+    "obrna",
+    "obruba",
+    "obrys",
+    "obsah",
+    "obsluha",
+    "obstarat",
+    "obuv",
+    "obvaz",
+    "obvinit",
+    "obvod",
+    "obvykle",
+    "obyvatel",
+    "obzor",
+    "ocas",
+    "ocel",
+    "ocenit",
+    "ochladit",
+    "ochota",
+    "ochrana",
+    "ocitnout",
+    "odboj",
+    "odbyt",
+    "odchod",
+    "odcizit",
+    "odebrat",
+    "odeslat",
+    "odevzdat",
+    "odezva",
+    "odhadce",
+    "odhodit",
+    "odjet",
+    "odjinud",
+    "odkaz",
+    "odkoupit",
+    "odliv",
+    "odluka",
+    "odmlka",
+    "odolnost",
+    "odpad",
+    "odpis",
+    "odplout",
+    "odpor",
+    "odpustit",
+    "odpykat",
+    "odrazka",
+    "odsoudit",
+    "odstup",
+    "odsun",
+    "odtok",
+    "odtud",
+    "odvaha",
+    "odveta",
+    "odvolat",
+    "odvracet",
+    "odznak",
+    "ofina",
+    "ofsajd",
+    "ohlas",
+    "ohnisko",
+    "ohrada",
+    "ohrozit",
+    "ohryzek",
+    "okap",
+    "okenice",
+    "oklika",
+    "okno",
+    "okouzlit",
+    "okovy",
+    "okrasa",
+    "okres",
+    "okrsek",
+    "okruh",
+    "okupant",
+    "okurka",
+    "okusit",
+    "olejnina",
+    "olizovat",
+    "omak",
+    "omeleta",
+    "omezit",
+    "omladina",
+    "omlouvat",
+    "omluva",
+    "omyl",
+    "onehdy",
+    "opakovat",
+    "opasek",
+    "operace",
+    "opice",
+    "opilost",
+    "opisovat",
+    "opora",
+    "opozice",
+    "opravdu",
+    "oproti",
+    "orbital",
+    "orchestr",
+    "orgie",
+    "orlice",
+    "orloj",
+    "ortel",
+    "osada",
+    "oschnout",
+    "osika",
+    "osivo",
+    "oslava",
+    "oslepit",
+    "oslnit",
+    "oslovit",
+    "osnova",
+    "osoba",
+    "osolit",
+    "ospalec",
+    "osten",
+    "ostraha",
+    "ostuda",
+    "ostych",
+    "osvojit",
+    "oteplit",
+    "otisk",
+    "otop",
+    "otrhat",
+    "otrlost",
+    "otrok",
+    "otruby",
+    "otvor",
+    "ovanout",
+    "ovar",
+    "oves",
+    "ovlivnit",
+    "ovoce",
+    "oxid",
+    "ozdoba",
+    "pachatel",
+    "pacient",
+    "padouch",
+    "pahorek",
+    "pakt",
+    "palanda",
+    "palec",
+    "palivo",
+    "paluba",
+    "pamflet",
+    "pamlsek",
+    "panenka",
+    "panika",
+    "panna",
+    "panovat",
+    "panstvo",
+    "pantofle",
+    "paprika",
+    "parketa",
+    "parodie",
+    "parta",
+    "paruka",
+    "paryba",
+    "paseka",
+    "pasivita",
+    "pastelka",
+    "patent",
+    "patrona",
+    "pavouk",
+    "pazneht",
+    "pazourek",
+    "pecka",
+    "pedagog",
+    "pejsek",
+    "peklo",
+    "peloton",
+    "penalta",
+    "pendrek",
+    "penze",
+    "periskop",
+    "pero",
+    "pestrost",
+    "petarda",
+    "petice",
+    "petrolej",
+    "pevnina",
+    "pexeso",
+    "pianista",
+    "piha",
+    "pijavice",
+    "pikle",
+    "piknik",
+    "pilina",
+    "pilnost",
+    "pilulka",
+    "pinzeta",
+    "pipeta",
+    "pisatel",
+    "pistole",
+    "pitevna",
+    "pivnice",
+    "pivovar",
+    "placenta",
+    "plakat",
+    "plamen",
+    "planeta",
+    "plastika",
+    "platit",
+    "plavidlo",
+    "plaz",
+    "plech",
+    "plemeno",
+    "plenta",
+    "ples",
+    "pletivo",
+    "plevel",
+    "plivat",
+    "plnit",
+    "plno",
+    "plocha",
+    "plodina",
+    "plomba",
+    "plout",
+    "pluk",
+    "plyn",
+    "pobavit",
+    "pobyt",
+    "pochod",
+    "pocit",
+    "poctivec",
+    "podat",
+    "podcenit",
+    "podepsat",
+    "podhled",
+    "podivit",
+    "podklad",
+    "podmanit",
+    "podnik",
+    "podoba",
+    "podpora",
+    "podraz",
+    "podstata",
+    "podvod",
+    "podzim",
+    "poezie",
+    "pohanka",
+    "pohnutka",
+    "pohovor",
+    "pohroma",
+    "pohyb",
+    "pointa",
+    "pojistka",
+    "pojmout",
+    "pokazit",
+    "pokles",
+    "pokoj",
+    "pokrok",
+    "pokuta",
+    "pokyn",
+    "poledne",
+    "polibek",
+    "polknout",
+    "poloha",
+    "polynom",
+    "pomalu",
+    "pominout",
+    "pomlka",
+    "pomoc",
+    "pomsta",
+    "pomyslet",
+    "ponechat",
+    "ponorka",
+    "ponurost",
+    "popadat",
+    "popel",
+    "popisek",
+    "poplach",
+    "poprosit",
+    "popsat",
+    "popud",
+    "poradce",
+    "porce",
+    "porod",
+    "porucha",
+    "poryv",
+    "posadit",
+    "posed",
+    "posila",
+    "poskok",
+    "poslanec",
+    "posoudit",
+    "pospolu",
+    "postava",
+    "posudek",
+    "posyp",
+    "potah",
+    "potkan",
+    "potlesk",
+    "potomek",
+    "potrava",
+    "potupa",
+    "potvora",
+    "poukaz",
+    "pouto",
+    "pouzdro",
+    "povaha",
+    "povidla",
+    "povlak",
+    "povoz",
+    "povrch",
+    "povstat",
+    "povyk",
+    "povzdech",
+    "pozdrav",
+    "pozemek",
+    "poznatek",
+    "pozor",
+    "pozvat",
+    "pracovat",
+    "prahory",
+    "praktika",
+    "prales",
+    "praotec",
+    "praporek",
+    "prase",
+    "pravda",
+    "princip",
+    "prkno",
+    "probudit",
+    "procento",
+    "prodej",
+    "profese",
+    "prohra",
+    "projekt",
+    "prolomit",
+    "promile",
+    "pronikat",
+    "propad",
+    "prorok",
+    "prosba",
+    "proton",
+    "proutek",
+    "provaz",
+    "prskavka",
+    "prsten",
+    "prudkost",
+    "prut",
+    "prvek",
+    "prvohory",
+    "psanec",
+    "psovod",
+    "pstruh",
+    "ptactvo",
+    "puberta",
+    "puch",
+    "pudl",
+    "pukavec",
+    "puklina",
+    "pukrle",
+    "pult",
+    "pumpa",
+    "punc",
+    "pupen",
+    "pusa",
+    "pusinka",
+    "pustina",
+    "putovat",
+    "putyka",
+    "pyramida",
+    "pysk",
+    "pytel",
+    "racek",
+    "rachot",
+    "radiace",
+    "radnice",
+    "radon",
+    "raft",
+    "ragby",
+    "raketa",
+    "rakovina",
+    "rameno",
+    "rampouch",
+    "rande",
+    "rarach",
+    "rarita",
+    "rasovna",
+    "rastr",
+    "ratolest",
+    "razance",
+    "razidlo",
+    "reagovat",
+    "reakce",
+    "recept",
+    "redaktor",
+    "referent",
+    "reflex",
+    "rejnok",
+    "reklama",
+    "rekord",
+    "rekrut",
+    "rektor",
+    "reputace",
+    "revize",
+    "revma",
+    "revolver",
+    "rezerva",
+    "riskovat",
+    "riziko",
+    "robotika",
+    "rodokmen",
+    "rohovka",
+    "rokle",
+    "rokoko",
+    "romaneto",
+    "ropovod",
+    "ropucha",
+    "rorejs",
+    "rosol",
+    "rostlina",
+    "rotmistr",
+    "rotoped",
+    "rotunda",
+    "roubenka",
+    "roucho",
+    "roup",
+    "roura",
+    "rovina",
+    "rovnice",
+    "rozbor",
+    "rozchod",
+    "rozdat",
+    "rozeznat",
+    "rozhodce",
+    "rozinka",
+    "rozjezd",
+    "rozkaz",
+    "rozloha",
+    "rozmar",
+    "rozpad",
+    "rozruch",
+    "rozsah",
+    "roztok",
+    "rozum",
+    "rozvod",
+    "rubrika",
+    "ruchadlo",
+    "rukavice",
+    "rukopis",
+    "ryba",
+    "rybolov",
+    "rychlost",
+    "rydlo",
+    "rypadlo",
+    "rytina",
+    "ryzost",
+    "sadista",
+    "sahat",
+    "sako",
+    "samec",
+    "samizdat",
+    "samota",
+    "sanitka",
+    "sardinka",
+    "sasanka",
+    "satelit",
+    "sazba",
+    "sazenice",
+    "sbor",
+    "schovat",
+    "sebranka",
+    "secese",
+    "sedadlo",
+    "sediment",
+    "sedlo",
+    "sehnat",
+    "sejmout",
+    "sekera",
+    "sekta",
+    "sekunda",
+    "sekvoje",
+    "semeno",
+    "seno",
+    "servis",
+    "sesadit",
+    "seshora",
+    "seskok",
+    "seslat",
+    "sestra",
+    "sesuv",
+    "sesypat",
+    "setba",
+    "setina",
+    "setkat",
+    "setnout",
+    "setrvat",
+    "sever",
+    "seznam",
+    "shoda",
+    "shrnout",
+    "sifon",
+    "silnice",
+    "sirka",
+    "sirotek",
+    "sirup",
+    "situace",
+    "skafandr",
+    "skalisko",
+    "skanzen",
+    "skaut",
+    "skeptik",
+    "skica",
+    "skladba",
+    "sklenice",
+    "sklo",
+    "skluz",
+    "skoba",
+    "skokan",
+    "skoro",
+    "skripta",
+    "skrz",
+    "skupina",
+    "skvost",
+    "skvrna",
+    "slabika",
+    "sladidlo",
+    "slanina",
+    "slast",
+    "slavnost",
+    "sledovat",
+    "slepec",
+    "sleva",
+    "slezina",
+    "slib",
+    "slina",
+    "sliznice",
+    "slon",
+    "sloupek",
+    "slovo",
+    "sluch",
+    "sluha",
+    "slunce",
+    "slupka",
+    "slza",
+    "smaragd",
+    "smetana",
+    "smilstvo",
+    "smlouva",
+    "smog",
+    "smrad",
+    "smrk",
+    "smrtka",
+    "smutek",
+    "smysl",
+    "snad",
+    "snaha",
+    "snob",
+    "sobota",
+    "socha",
+    "sodovka",
+    "sokol",
+    "sopka",
+    "sotva",
+    "souboj",
+    "soucit",
+    "soudce",
+    "souhlas",
+    "soulad",
+    "soumrak",
+    "souprava",
+    "soused",
+    "soutok",
+    "souviset",
+    "spalovna",
+    "spasitel",
+    "spis",
+    "splav",
+    "spodek",
+    "spojenec",
+    "spolu",
+    "sponzor",
+    "spornost",
+    "spousta",
+    "sprcha",
+    "spustit",
+    "sranda",
+    "sraz",
+    "srdce",
+    "srna",
+    "srnec",
+    "srovnat",
+    "srpen",
+    "srst",
+    "srub",
+    "stanice",
+    "starosta",
+    "statika",
+    "stavba",
+    "stehno",
+    "stezka",
+    "stodola",
+    "stolek",
+    "stopa",
+    "storno",
+    "stoupat",
+    "strach",
+    "stres",
+    "strhnout",
+    "strom",
+    "struna",
+    "studna",
+    "stupnice",
+    "stvol",
+    "styk",
+    "subjekt",
+    "subtropy",
+    "suchar",
+    "sudost",
+    "sukno",
+    "sundat",
+    "sunout",
+    "surikata",
+    "surovina",
+    "svah",
+    "svalstvo",
+    "svetr",
+    "svatba",
+    "svazek",
+    "svisle",
+    "svitek",
+    "svoboda",
+    "svodidlo",
+    "svorka",
+    "svrab",
+    "sykavka",
+    "sykot",
+    "synek",
+    "synovec",
+    "sypat",
+    "sypkost",
+    "syrovost",
+    "sysel",
+    "sytost",
+    "tabletka",
+    "tabule",
+    "tahoun",
+    "tajemno",
+    "tajfun",
+    "tajga",
+    "tajit",
+    "tajnost",
+    "taktika",
+    "tamhle",
+    "tampon",
+    "tancovat",
+    "tanec",
+    "tanker",
+    "tapeta",
+    "tavenina",
+    "tazatel",
+    "technika",
+    "tehdy",
+    "tekutina",
+    "telefon",
+    "temnota",
+    "tendence",
+    "tenista",
+    "tenor",
+    "teplota",
+    "tepna",
+    "teprve",
+    "terapie",
+    "termoska",
+    "textil",
+    "ticho",
+    "tiskopis",
+    "titulek",
+    "tkadlec",
+    "tkanina",
+    "tlapka",
+    "tleskat",
+    "tlukot",
+    "tlupa",
+    "tmel",
+    "toaleta",
+    "topinka",
+    "topol",
+    "torzo",
+    "touha",
+    "toulec",
+    "tradice",
+    "traktor",
+    "tramp",
+    "trasa",
+    "traverza",
+    "trefit",
+    "trest",
+    "trezor",
+    "trhavina",
+    "trhlina",
+    "trochu",
+    "trojice",
+    "troska",
+    "trouba",
+    "trpce",
+    "trpitel",
+    "trpkost",
+    "trubec",
+    "truchlit",
+    "truhlice",
+    "trus",
+    "trvat",
+    "tudy",
+    "tuhnout",
+    "tuhost",
+    "tundra",
+    "turista",
+    "turnaj",
+    "tuzemsko",
+    "tvaroh",
+    "tvorba",
+    "tvrdost",
+    "tvrz",
+    "tygr",
+    "tykev",
+    "ubohost",
+    "uboze",
+    "ubrat",
+    "ubrousek",
+    "ubrus",
+    "ubytovna",
+    "ucho",
+    "uctivost",
+    "udivit",
+    "uhradit",
+    "ujednat",
+    "ujistit",
+    "ujmout",
+    "ukazatel",
+    "uklidnit",
+    "uklonit",
+    "ukotvit",
+    "ukrojit",
+    "ulice",
+    "ulita",
+    "ulovit",
+    "umyvadlo",
+    "unavit",
+    "uniforma",
+    "uniknout",
+    "upadnout",
+    "uplatnit",
+    "uplynout",
+    "upoutat",
+    "upravit",
+    "uran",
+    "urazit",
+    "usednout",
+    "usilovat",
+    "usmrtit",
+    "usnadnit",
+    "usnout",
+    "usoudit",
+    "ustlat",
+    "ustrnout",
+    "utahovat",
+    "utkat",
+    "utlumit",
+    "utonout",
+    "utopenec",
+    "utrousit",
+    "uvalit",
+    "uvolnit",
+    "uvozovka",
+    "uzdravit",
+    "uzel",
+    "uzenina",
+    "uzlina",
+    "uznat",
+    "vagon",
+    "valcha",
+    "valoun",
+    "vana",
+    "vandal",
+    "vanilka",
+    "varan",
+    "varhany",
+    "varovat",
+    "vcelku",
+    "vchod",
+    "vdova",
+    "vedro",
+    "vegetace",
+    "vejce",
+    "velbloud",
+    "veletrh",
+    "velitel",
+    "velmoc",
+    "velryba",
+    "venkov",
+    "veranda",
+    "verze",
+    "veselka",
+    "veskrze",
+    "vesnice",
+    "vespodu",
+    "vesta",
+    "veterina",
+    "veverka",
+    "vibrace",
+    "vichr",
+    "videohra",
+    "vidina",
+    "vidle",
+    "vila",
+    "vinice",
+    "viset",
+    "vitalita",
+    "vize",
+    "vizitka",
+    "vjezd",
+    "vklad",
+    "vkus",
+    "vlajka",
+    "vlak",
+    "vlasec",
+    "vlevo",
+    "vlhkost",
+    "vliv",
+    "vlnovka",
+    "vloupat",
+    "vnucovat",
+    "vnuk",
+    "voda",
+    "vodivost",
+    "vodoznak",
+    "vodstvo",
+    "vojensky",
+    "vojna",
+    "vojsko",
+    "volant",
+    "volba",
+    "volit",
+    "volno",
+    "voskovka",
+    "vozidlo",
+    "vozovna",
+    "vpravo",
+    "vrabec",
+    "vracet",
+    "vrah",
+    "vrata",
+    "vrba",
+    "vrcholek",
+    "vrhat",
+    "vrstva",
+    "vrtule",
+    "vsadit",
+    "vstoupit",
+    "vstup",
+    "vtip",
+    "vybavit",
+    "vybrat",
+    "vychovat",
+    "vydat",
+    "vydra",
+    "vyfotit",
+    "vyhledat",
+    "vyhnout",
+    "vyhodit",
+    "vyhradit",
+    "vyhubit",
+    "vyjasnit",
+    "vyjet",
+    "vyjmout",
+    "vyklopit",
+    "vykonat",
+    "vylekat",
+    "vymazat",
+    "vymezit",
+    "vymizet",
+    "vymyslet",
+    "vynechat",
+    "vynikat",
+    "vynutit",
+    "vypadat",
+    "vyplatit",
+    "vypravit",
+    "vypustit",
+    "vyrazit",
+    "vyrovnat",
+    "vyrvat",
+    "vyslovit",
+    "vysoko",
+    "vystavit",
+    "vysunout",
+    "vysypat",
+    "vytasit",
+    "vytesat",
+    "vytratit",
+    "vyvinout",
+    "vyvolat",
+    "vyvrhel",
+    "vyzdobit",
+    "vyznat",
+    "vzadu",
+    "vzbudit",
+    "vzchopit",
+    "vzdor",
+    "vzduch",
+    "vzdychat",
+    "vzestup",
+    "vzhledem",
+    "vzkaz",
+    "vzlykat",
+    "vznik",
+    "vzorek",
+    "vzpoura",
+    "vztah",
+    "vztek",
+    "xylofon",
+    "zabrat",
+    "zabydlet",
+    "zachovat",
+    "zadarmo",
+    "zadusit",
+    "zafoukat",
+    "zahltit",
+    "zahodit",
+    "zahrada",
+    "zahynout",
+    "zajatec",
+    "zajet",
+    "zajistit",
+    "zaklepat",
+    "zakoupit",
+    "zalepit",
+    "zamezit",
+    "zamotat",
+    "zamyslet",
+    "zanechat",
+    "zanikat",
+    "zaplatit",
+    "zapojit",
+    "zapsat",
+    "zarazit",
+    "zastavit",
+    "zasunout",
+    "zatajit",
+    "zatemnit",
+    "zatknout",
+    "zaujmout",
+    "zavalit",
+    "zavelet",
+    "zavinit",
+    "zavolat",
+    "zavrtat",
+    "zazvonit",
+    "zbavit",
+    "zbrusu",
+    "zbudovat",
+    "zbytek",
+    "zdaleka",
+    "zdarma",
+    "zdatnost",
+    "zdivo",
+    "zdobit",
+    "zdroj",
+    "zdvih",
+    "zdymadlo",
+    "zelenina",
+    "zeman",
+    "zemina",
+    "zeptat",
+    "zezadu",
+    "zezdola",
+    "zhatit",
+    "zhltnout",
+    "zhluboka",
+    "zhotovit",
+    "zhruba",
+    "zima",
+    "zimnice",
+    "zjemnit",
+    "zklamat",
+    "zkoumat",
+    "zkratka",
+    "zkumavka",
+    "zlato",
+    "zlehka",
+    "zloba",
+    "zlom",
+    "zlost",
+    "zlozvyk",
+    "zmapovat",
+    "zmar",
+    "zmatek",
+    "zmije",
+    "zmizet",
+    "zmocnit",
+    "zmodrat",
+    "zmrzlina",
+    "zmutovat",
+    "znak",
+    "znalost",
+    "znamenat",
+    "znovu",
+    "zobrazit",
+    "zotavit",
+    "zoubek",
+    "zoufale",
+    "zplodit",
+    "zpomalit",
+    "zprava",
+    "zprostit",
+    "zprudka",
+    "zprvu",
+    "zrada",
+    "zranit",
+    "zrcadlo",
+    "zrnitost",
+    "zrno",
+    "zrovna",
+    "zrychlit",
+    "zrzavost",
+    "zticha",
+    "ztratit",
+    "zubovina",
+    "zubr",
+    "zvednout",
+    "zvenku",
+    "zvesela",
+    "zvon",
+    "zvrat",
+    "zvukovod",
+    "zvyk",
+}
+var rwords_czech = map[string]int16{
+    "abdikace": 0,
+    "abeceda": 1,
+    "adresa": 2,
+    "agrese": 3,
+    "akce": 4,
+    "aktovka": 5,
+    "alej": 6,
+    "alkohol": 7,
+    "amputace": 8,
+    "ananas": 9,
+    "andulka": 10,
+    "anekdota": 11,
+    "anketa": 12,
+    "antika": 13,
+    "anulovat": 14,
+    "archa": 15,
+    "arogance": 16,
+    "asfalt": 17,
+    "asistent": 18,
+    "aspirace": 19,
+    "astma": 20,
+    "astronom": 21,
+    "atlas": 22,
+    "atletika": 23,
+    "atol": 24,
+    "autobus": 25,
+    "azyl": 26,
+    "babka": 27,
+    "bachor": 28,
+    "bacil": 29,
+    "baculka": 30,
+    "badatel": 31,
+    "bageta": 32,
+    "bagr": 33,
+    "bahno": 34,
+    "bakterie": 35,
+    "balada": 36,
+    "baletka": 37,
+    "balkon": 38,
+    "balonek": 39,
+    "balvan": 40,
+    "balza": 41,
+    "bambus": 42,
+    "bankomat": 43,
+    "barbar": 44,
+    "baret": 45,
+    "barman": 46,
+    "baroko": 47,
+    "barva": 48,
+    "baterka": 49,
+    "batoh": 50,
+    "bavlna": 51,
+    "bazalka": 52,
+    "bazilika": 53,
+    "bazuka": 54,
+    "bedna": 55,
+    "beran": 56,
+    "beseda": 57,
+    "bestie": 58,
+    "beton": 59,
+    "bezinka": 60,
+    "bezmoc": 61,
+    "beztak": 62,
+    "bicykl": 63,
+    "bidlo": 64,
+    "biftek": 65,
+    "bikiny": 66,
+    "bilance": 67,
+    "biograf": 68,
+    "biolog": 69,
+    "bitva": 70,
+    "bizon": 71,
+    "blahobyt": 72,
+    "blatouch": 73,
+    "blecha": 74,
+    "bledule": 75,
+    "blesk": 76,
+    "blikat": 77,
+    "blizna": 78,
+    "blokovat": 79,
+    "bloudit": 80,
+    "blud": 81,
+    "bobek": 82,
+    "bobr": 83,
+    "bodlina": 84,
+    "bodnout": 85,
+    "bohatost": 86,
+    "bojkot": 87,
+    "bojovat": 88,
+    "bokorys": 89,
+    "bolest": 90,
+    "borec": 91,
+    "borovice": 92,
+    "bota": 93,
+    "boubel": 94,
+    "bouchat": 95,
+    "bouda": 96,
+    "boule": 97,
+    "bourat": 98,
+    "boxer": 99,
+    "bradavka": 100,
+    "brambora": 101,
+    "branka": 102,
+    "bratr": 103,
+    "brepta": 104,
+    "briketa": 105,
+    "brko": 106,
+    "brloh": 107,
+    "bronz": 108,
+    "broskev": 109,
+    "brunetka": 110,
+    "brusinka": 111,
+    "brzda": 112,
+    "brzy": 113,
+    "bublina": 114,
+    "bubnovat": 115,
+    "buchta": 116,
+    "buditel": 117,
+    "budka": 118,
+    "budova": 119,
+    "bufet": 120,
+    "bujarost": 121,
+    "bukvice": 122,
+    "buldok": 123,
+    "bulva": 124,
+    "bunda": 125,
+    "bunkr": 126,
+    "burza": 127,
+    "butik": 128,
+    "buvol": 129,
+    "buzola": 130,
+    "bydlet": 131,
+    "bylina": 132,
+    "bytovka": 133,
+    "bzukot": 134,
+    "capart": 135,
+    "carevna": 136,
+    "cedr": 137,
+    "cedule": 138,
+    "cejch": 139,
+    "cejn": 140,
+    "cela": 141,
+    "celer": 142,
+    "celkem": 143,
+    "celnice": 144,
+    "cenina": 145,
+    "cennost": 146,
+    "cenovka": 147,
+    "centrum": 148,
+    "cenzor": 149,
+    "cestopis": 150,
+    "cetka": 151,
+    "chalupa": 152,
+    "chapadlo": 153,
+    "charita": 154,
+    "chata": 155,
+    "chechtat": 156,
+    "chemie": 157,
+    "chichot": 158,
+    "chirurg": 159,
+    "chlad": 160,
+    "chleba": 161,
+    "chlubit": 162,
+    "chmel": 163,
+    "chmura": 164,
+    "chobot": 165,
+    "chochol": 166,
+    "chodba": 167,
+    "cholera": 168,
+    "chomout": 169,
+    "chopit": 170,
+    "choroba": 171,
+    "chov": 172,
+    "chrapot": 173,
+    "chrlit": 174,
+    "chrt": 175,
+    "chrup": 176,
+    "chtivost": 177,
+    "chudina": 178,
+    "chutnat": 179,
+    "chvat": 180,
+    "chvilka": 181,
+    "chvost": 182,
+    "chyba": 183,
+    "chystat": 184,
+    "chytit": 185,
+    "cibule": 186,
+    "cigareta": 187,
+    "cihelna": 188,
+    "cihla": 189,
+    "cinkot": 190,
+    "cirkus": 191,
+    "cisterna": 192,
+    "citace": 193,
+    "citrus": 194,
+    "cizinec": 195,
+    "cizost": 196,
+    "clona": 197,
+    "cokoliv": 198,
+    "couvat": 199,
+    "ctitel": 200,
+    "ctnost": 201,
+    "cudnost": 202,
+    "cuketa": 203,
+    "cukr": 204,
+    "cupot": 205,
+    "cvaknout": 206,
+    "cval": 207,
+    "cvik": 208,
+    "cvrkot": 209,
+    "cyklista": 210,
+    "daleko": 211,
+    "dareba": 212,
+    "datel": 213,
+    "datum": 214,
+    "dcera": 215,
+    "debata": 216,
+    "dechovka": 217,
+    "decibel": 218,
+    "deficit": 219,
+    "deflace": 220,
+    "dekl": 221,
+    "dekret": 222,
+    "demokrat": 223,
+    "deprese": 224,
+    "derby": 225,
+    "deska": 226,
+    "detektiv": 227,
+    "dikobraz": 228,
+    "diktovat": 229,
+    "dioda": 230,
+    "diplom": 231,
+    "disk": 232,
+    "displej": 233,
+    "divadlo": 234,
+    "divoch": 235,
+    "dlaha": 236,
+    "dlouho": 237,
+    "dluhopis": 238,
+    "dnes": 239,
+    "dobro": 240,
+    "dobytek": 241,
+    "docent": 242,
+    "dochutit": 243,
+    "dodnes": 244,
+    "dohled": 245,
+    "dohoda": 246,
+    "dohra": 247,
+    "dojem": 248,
+    "dojnice": 249,
+    "doklad": 250,
+    "dokola": 251,
+    "doktor": 252,
+    "dokument": 253,
+    "dolar": 254,
+    "doleva": 255,
+    "dolina": 256,
+    "doma": 257,
+    "dominant": 258,
+    "domluvit": 259,
+    "domov": 260,
+    "donutit": 261,
+    "dopad": 262,
+    "dopis": 263,
+    "doplnit": 264,
+    "doposud": 265,
+    "doprovod": 266,
+    "dopustit": 267,
+    "dorazit": 268,
+    "dorost": 269,
+    "dort": 270,
+    "dosah": 271,
+    "doslov": 272,
+    "dostatek": 273,
+    "dosud": 274,
+    "dosyta": 275,
+    "dotaz": 276,
+    "dotek": 277,
+    "dotknout": 278,
+    "doufat": 279,
+    "doutnat": 280,
+    "dovozce": 281,
+    "dozadu": 282,
+    "doznat": 283,
+    "dozorce": 284,
+    "drahota": 285,
+    "drak": 286,
+    "dramatik": 287,
+    "dravec": 288,
+    "draze": 289,
+    "drdol": 290,
+    "drobnost": 291,
+    "drogerie": 292,
+    "drozd": 293,
+    "drsnost": 294,
+    "drtit": 295,
+    "drzost": 296,
+    "duben": 297,
+    "duchovno": 298,
+    "dudek": 299,
+    "duha": 300,
+    "duhovka": 301,
+    "dusit": 302,
+    "dusno": 303,
+    "dutost": 304,
+    "dvojice": 305,
+    "dvorec": 306,
+    "dynamit": 307,
+    "ekolog": 308,
+    "ekonomie": 309,
+    "elektron": 310,
+    "elipsa": 311,
+    "email": 312,
+    "emise": 313,
+    "emoce": 314,
+    "empatie": 315,
+    "epizoda": 316,
+    "epocha": 317,
+    "epopej": 318,
+    "epos": 319,
+    "esej": 320,
+    "esence": 321,
+    "eskorta": 322,
+    "eskymo": 323,
+    "etiketa": 324,
+    "euforie": 325,
+    "evoluce": 326,
+    "exekuce": 327,
+    "exkurze": 328,
+    "expedice": 329,
+    "exploze": 330,
+    "export": 331,
+    "extrakt": 332,
+    "facka": 333,
+    "fajfka": 334,
+    "fakulta": 335,
+    "fanatik": 336,
+    "fantazie": 337,
+    "farmacie": 338,
+    "favorit": 339,
+    "fazole": 340,
+    "federace": 341,
+    "fejeton": 342,
+    "fenka": 343,
+    "fialka": 344,
+    "figurant": 345,
+    "filozof": 346,
+    "filtr": 347,
+    "finance": 348,
+    "finta": 349,
+    "fixace": 350,
+    "fjord": 351,
+    "flanel": 352,
+    "flirt": 353,
+    "flotila": 354,
+    "fond": 355,
+    "fosfor": 356,
+    "fotbal": 357,
+    "fotka": 358,
+    "foton": 359,
+    "frakce": 360,
+    "freska": 361,
+    "fronta": 362,
+    "fukar": 363,
+    "funkce": 364,
+    "fyzika": 365,
+    "galeje": 366,
+    "garant": 367,
+    "genetika": 368,
+    "geolog": 369,
+    "gilotina": 370,
+    "glazura": 371,
+    "glejt": 372,
+    "golem": 373,
+    "golfista": 374,
+    "gotika": 375,
+    "graf": 376,
+    "gramofon": 377,
+    "granule": 378,
+    "grep": 379,
+    "gril": 380,
+    "grog": 381,
+    "groteska": 382,
+    "guma": 383,
+    "hadice": 384,
+    "hadr": 385,
+    "hala": 386,
+    "halenka": 387,
+    "hanba": 388,
+    "hanopis": 389,
+    "harfa": 390,
+    "harpuna": 391,
+    "havran": 392,
+    "hebkost": 393,
+    "hejkal": 394,
+    "hejno": 395,
+    "hejtman": 396,
+    "hektar": 397,
+    "helma": 398,
+    "hematom": 399,
+    "herec": 400,
+    "herna": 401,
+    "heslo": 402,
+    "hezky": 403,
+    "historik": 404,
+    "hladovka": 405,
+    "hlasivky": 406,
+    "hlava": 407,
+    "hledat": 408,
+    "hlen": 409,
+    "hlodavec": 410,
+    "hloh": 411,
+    "hloupost": 412,
+    "hltat": 413,
+    "hlubina": 414,
+    "hluchota": 415,
+    "hmat": 416,
+    "hmota": 417,
+    "hmyz": 418,
+    "hnis": 419,
+    "hnojivo": 420,
+    "hnout": 421,
+    "hoblina": 422,
+    "hoboj": 423,
+    "hoch": 424,
+    "hodiny": 425,
+    "hodlat": 426,
+    "hodnota": 427,
+    "hodovat": 428,
+    "hojnost": 429,
+    "hokej": 430,
+    "holinka": 431,
+    "holka": 432,
+    "holub": 433,
+    "homole": 434,
+    "honitba": 435,
+    "honorace": 436,
+    "horal": 437,
+    "horda": 438,
+    "horizont": 439,
+    "horko": 440,
+    "horlivec": 441,
+    "hormon": 442,
+    "hornina": 443,
+    "horoskop": 444,
+    "horstvo": 445,
+    "hospoda": 446,
+    "hostina": 447,
+    "hotovost": 448,
+    "houba": 449,
+    "houf": 450,
+    "houpat": 451,
+    "houska": 452,
+    "hovor": 453,
+    "hradba": 454,
+    "hranice": 455,
+    "hravost": 456,
+    "hrazda": 457,
+    "hrbolek": 458,
+    "hrdina": 459,
+    "hrdlo": 460,
+    "hrdost": 461,
+    "hrnek": 462,
+    "hrobka": 463,
+    "hromada": 464,
+    "hrot": 465,
+    "hrouda": 466,
+    "hrozen": 467,
+    "hrstka": 468,
+    "hrubost": 469,
+    "hryzat": 470,
+    "hubenost": 471,
+    "hubnout": 472,
+    "hudba": 473,
+    "hukot": 474,
+    "humr": 475,
+    "husita": 476,
+    "hustota": 477,
+    "hvozd": 478,
+    "hybnost": 479,
+    "hydrant": 480,
+    "hygiena": 481,
+    "hymna": 482,
+    "hysterik": 483,
+    "idylka": 484,
+    "ihned": 485,
+    "ikona": 486,
+    "iluze": 487,
+    "imunita": 488,
+    "infekce": 489,
+    "inflace": 490,
+    "inkaso": 491,
+    "inovace": 492,
+    "inspekce": 493,
+    "internet": 494,
+    "invalida": 495,
+    "investor": 496,
+    "inzerce": 497,
+    "ironie": 498,
+    "jablko": 499,
+    "jachta": 500,
+    "jahoda": 501,
+    "jakmile": 502,
+    "jakost": 503,
+    "jalovec": 504,
+    "jantar": 505,
+    "jarmark": 506,
+    "jaro": 507,
+    "jasan": 508,
+    "jasno": 509,
+    "jatka": 510,
+    "javor": 511,
+    "jazyk": 512,
+    "jedinec": 513,
+    "jedle": 514,
+    "jednatel": 515,
+    "jehlan": 516,
+    "jekot": 517,
+    "jelen": 518,
+    "jelito": 519,
+    "jemnost": 520,
+    "jenom": 521,
+    "jepice": 522,
+    "jeseter": 523,
+    "jevit": 524,
+    "jezdec": 525,
+    "jezero": 526,
+    "jinak": 527,
+    "jindy": 528,
+    "jinoch": 529,
+    "jiskra": 530,
+    "jistota": 531,
+    "jitrnice": 532,
+    "jizva": 533,
+    "jmenovat": 534,
+    "jogurt": 535,
+    "jurta": 536,
+    "kabaret": 537,
+    "kabel": 538,
+    "kabinet": 539,
+    "kachna": 540,
+    "kadet": 541,
+    "kadidlo": 542,
+    "kahan": 543,
+    "kajak": 544,
+    "kajuta": 545,
+    "kakao": 546,
+    "kaktus": 547,
+    "kalamita": 548,
+    "kalhoty": 549,
+    "kalibr": 550,
+    "kalnost": 551,
+    "kamera": 552,
+    "kamkoliv": 553,
+    "kamna": 554,
+    "kanibal": 555,
+    "kanoe": 556,
+    "kantor": 557,
+    "kapalina": 558,
+    "kapela": 559,
+    "kapitola": 560,
+    "kapka": 561,
+    "kaple": 562,
+    "kapota": 563,
+    "kapr": 564,
+    "kapusta": 565,
+    "kapybara": 566,
+    "karamel": 567,
+    "karotka": 568,
+    "karton": 569,
+    "kasa": 570,
+    "katalog": 571,
+    "katedra": 572,
+    "kauce": 573,
+    "kauza": 574,
+    "kavalec": 575,
+    "kazajka": 576,
+    "kazeta": 577,
+    "kazivost": 578,
+    "kdekoliv": 579,
+    "kdesi": 580,
+    "kedluben": 581,
+    "kemp": 582,
+    "keramika": 583,
+    "kino": 584,
+    "klacek": 585,
+    "kladivo": 586,
+    "klam": 587,
+    "klapot": 588,
+    "klasika": 589,
+    "klaun": 590,
+    "klec": 591,
+    "klenba": 592,
+    "klepat": 593,
+    "klesnout": 594,
+    "klid": 595,
+    "klima": 596,
+    "klisna": 597,
+    "klobouk": 598,
+    "klokan": 599,
+    "klopa": 600,
+    "kloub": 601,
+    "klubovna": 602,
+    "klusat": 603,
+    "kluzkost": 604,
+    "kmen": 605,
+    "kmitat": 606,
+    "kmotr": 607,
+    "kniha": 608,
+    "knot": 609,
+    "koalice": 610,
+    "koberec": 611,
+    "kobka": 612,
+    "kobliha": 613,
+    "kobyla": 614,
+    "kocour": 615,
+    "kohout": 616,
+    "kojenec": 617,
+    "kokos": 618,
+    "koktejl": 619,
+    "kolaps": 620,
+    "koleda": 621,
+    "kolize": 622,
+    "kolo": 623,
+    "komando": 624,
+    "kometa": 625,
+    "komik": 626,
+    "komnata": 627,
+    "komora": 628,
+    "kompas": 629,
+    "komunita": 630,
+    "konat": 631,
+    "koncept": 632,
+    "kondice": 633,
+    "konec": 634,
+    "konfese": 635,
+    "kongres": 636,
+    "konina": 637,
+    "konkurs": 638,
+    "kontakt": 639,
+    "konzerva": 640,
+    "kopanec": 641,
+    "kopie": 642,
+    "kopnout": 643,
+    "koprovka": 644,
+    "korbel": 645,
+    "korektor": 646,
+    "kormidlo": 647,
+    "koroptev": 648,
+    "korpus": 649,
+    "koruna": 650,
+    "koryto": 651,
+    "korzet": 652,
+    "kosatec": 653,
+    "kostka": 654,
+    "kotel": 655,
+    "kotleta": 656,
+    "kotoul": 657,
+    "koukat": 658,
+    "koupelna": 659,
+    "kousek": 660,
+    "kouzlo": 661,
+    "kovboj": 662,
+    "koza": 663,
+    "kozoroh": 664,
+    "krabice": 665,
+    "krach": 666,
+    "krajina": 667,
+    "kralovat": 668,
+    "krasopis": 669,
+    "kravata": 670,
+    "kredit": 671,
+    "krejcar": 672,
+    "kresba": 673,
+    "kreveta": 674,
+    "kriket": 675,
+    "kritik": 676,
+    "krize": 677,
+    "krkavec": 678,
+    "krmelec": 679,
+    "krmivo": 680,
+    "krocan": 681,
+    "krok": 682,
+    "kronika": 683,
+    "kropit": 684,
+    "kroupa": 685,
+    "krovka": 686,
+    "krtek": 687,
+    "kruhadlo": 688,
+    "krupice": 689,
+    "krutost": 690,
+    "krvinka": 691,
+    "krychle": 692,
+    "krypta": 693,
+    "krystal": 694,
+    "kryt": 695,
+    "kudlanka": 696,
+    "kufr": 697,
+    "kujnost": 698,
+    "kukla": 699,
+    "kulajda": 700,
+    "kulich": 701,
+    "kulka": 702,
+    "kulomet": 703,
+    "kultura": 704,
+    "kuna": 705,
+    "kupodivu": 706,
+    "kurt": 707,
+    "kurzor": 708,
+    "kutil": 709,
+    "kvalita": 710,
+    "kvasinka": 711,
+    "kvestor": 712,
+    "kynolog": 713,
+    "kyselina": 714,
+    "kytara": 715,
+    "kytice": 716,
+    "kytka": 717,
+    "kytovec": 718,
+    "kyvadlo": 719,
+    "labrador": 720,
+    "lachtan": 721,
+    "ladnost": 722,
+    "laik": 723,
+    "lakomec": 724,
+    "lamela": 725,
+    "lampa": 726,
+    "lanovka": 727,
+    "lasice": 728,
+    "laso": 729,
+    "lastura": 730,
+    "latinka": 731,
+    "lavina": 732,
+    "lebka": 733,
+    "leckdy": 734,
+    "leden": 735,
+    "lednice": 736,
+    "ledovka": 737,
+    "ledvina": 738,
+    "legenda": 739,
+    "legie": 740,
+    "legrace": 741,
+    "lehce": 742,
+    "lehkost": 743,
+    "lehnout": 744,
+    "lektvar": 745,
+    "lenochod": 746,
+    "lentilka": 747,
+    "lepenka": 748,
+    "lepidlo": 749,
+    "letadlo": 750,
+    "letec": 751,
+    "letmo": 752,
+    "letokruh": 753,
+    "levhart": 754,
+    "levitace": 755,
+    "levobok": 756,
+    "libra": 757,
+    "lichotka": 758,
+    "lidojed": 759,
+    "lidskost": 760,
+    "lihovina": 761,
+    "lijavec": 762,
+    "lilek": 763,
+    "limetka": 764,
+    "linie": 765,
+    "linka": 766,
+    "linoleum": 767,
+    "listopad": 768,
+    "litina": 769,
+    "litovat": 770,
+    "lobista": 771,
+    "lodivod": 772,
+    "logika": 773,
+    "logoped": 774,
+    "lokalita": 775,
+    "loket": 776,
+    "lomcovat": 777,
+    "lopata": 778,
+    "lopuch": 779,
+    "lord": 780,
+    "losos": 781,
+    "lotr": 782,
+    "loudal": 783,
+    "louh": 784,
+    "louka": 785,
+    "louskat": 786,
+    "lovec": 787,
+    "lstivost": 788,
+    "lucerna": 789,
+    "lucifer": 790,
+    "lump": 791,
+    "lusk": 792,
+    "lustrace": 793,
+    "lvice": 794,
+    "lyra": 795,
+    "lyrika": 796,
+    "lysina": 797,
+    "madam": 798,
+    "madlo": 799,
+    "magistr": 800,
+    "mahagon": 801,
+    "majetek": 802,
+    "majitel": 803,
+    "majorita": 804,
+    "makak": 805,
+    "makovice": 806,
+    "makrela": 807,
+    "malba": 808,
+    "malina": 809,
+    "malovat": 810,
+    "malvice": 811,
+    "maminka": 812,
+    "mandle": 813,
+    "manko": 814,
+    "marnost": 815,
+    "masakr": 816,
+    "maskot": 817,
+    "masopust": 818,
+    "matice": 819,
+    "matrika": 820,
+    "maturita": 821,
+    "mazanec": 822,
+    "mazivo": 823,
+    "mazlit": 824,
+    "mazurka": 825,
+    "mdloba": 826,
+    "mechanik": 827,
+    "meditace": 828,
+    "medovina": 829,
+    "melasa": 830,
+    "meloun": 831,
+    "mentolka": 832,
+    "metla": 833,
+    "metoda": 834,
+    "metr": 835,
+    "mezera": 836,
+    "migrace": 837,
+    "mihnout": 838,
+    "mihule": 839,
+    "mikina": 840,
+    "mikrofon": 841,
+    "milenec": 842,
+    "milimetr": 843,
+    "milost": 844,
+    "mimika": 845,
+    "mincovna": 846,
+    "minibar": 847,
+    "minomet": 848,
+    "minulost": 849,
+    "miska": 850,
+    "mistr": 851,
+    "mixovat": 852,
+    "mladost": 853,
+    "mlha": 854,
+    "mlhovina": 855,
+    "mlok": 856,
+    "mlsat": 857,
+    "mluvit": 858,
+    "mnich": 859,
+    "mnohem": 860,
+    "mobil": 861,
+    "mocnost": 862,
+    "modelka": 863,
+    "modlitba": 864,
+    "mohyla": 865,
+    "mokro": 866,
+    "molekula": 867,
+    "momentka": 868,
+    "monarcha": 869,
+    "monokl": 870,
+    "monstrum": 871,
+    "montovat": 872,
+    "monzun": 873,
+    "mosaz": 874,
+    "moskyt": 875,
+    "most": 876,
+    "motivace": 877,
+    "motorka": 878,
+    "motyka": 879,
+    "moucha": 880,
+    "moudrost": 881,
+    "mozaika": 882,
+    "mozek": 883,
+    "mozol": 884,
+    "mramor": 885,
+    "mravenec": 886,
+    "mrkev": 887,
+    "mrtvola": 888,
+    "mrzet": 889,
+    "mrzutost": 890,
+    "mstitel": 891,
+    "mudrc": 892,
+    "muflon": 893,
+    "mulat": 894,
+    "mumie": 895,
+    "munice": 896,
+    "muset": 897,
+    "mutace": 898,
+    "muzeum": 899,
+    "muzikant": 900,
+    "myslivec": 901,
+    "mzda": 902,
+    "nabourat": 903,
+    "nachytat": 904,
+    "nadace": 905,
+    "nadbytek": 906,
+    "nadhoz": 907,
+    "nadobro": 908,
+    "nadpis": 909,
+    "nahlas": 910,
+    "nahnat": 911,
+    "nahodile": 912,
+    "nahradit": 913,
+    "naivita": 914,
+    "najednou": 915,
+    "najisto": 916,
+    "najmout": 917,
+    "naklonit": 918,
+    "nakonec": 919,
+    "nakrmit": 920,
+    "nalevo": 921,
+    "namazat": 922,
+    "namluvit": 923,
+    "nanometr": 924,
+    "naoko": 925,
+    "naopak": 926,
+    "naostro": 927,
+    "napadat": 928,
+    "napevno": 929,
+    "naplnit": 930,
+    "napnout": 931,
+    "naposled": 932,
+    "naprosto": 933,
+    "narodit": 934,
+    "naruby": 935,
+    "narychlo": 936,
+    "nasadit": 937,
+    "nasekat": 938,
+    "naslepo": 939,
+    "nastat": 940,
+    "natolik": 941,
+    "navenek": 942,
+    "navrch": 943,
+    "navzdory": 944,
+    "nazvat": 945,
+    "nebe": 946,
+    "nechat": 947,
+    "necky": 948,
+    "nedaleko": 949,
+    "nedbat": 950,
+    "neduh": 951,
+    "negace": 952,
+    "nehet": 953,
+    "nehoda": 954,
+    "nejen": 955,
+    "nejprve": 956,
+    "neklid": 957,
+    "nelibost": 958,
+    "nemilost": 959,
+    "nemoc": 960,
+    "neochota": 961,
+    "neonka": 962,
+    "nepokoj": 963,
+    "nerost": 964,
+    "nerv": 965,
+    "nesmysl": 966,
+    "nesoulad": 967,
+    "netvor": 968,
+    "neuron": 969,
+    "nevina": 970,
+    "nezvykle": 971,
+    "nicota": 972,
+    "nijak": 973,
+    "nikam": 974,
+    "nikdy": 975,
+    "nikl": 976,
+    "nikterak": 977,
+    "nitro": 978,
+    "nocleh": 979,
+    "nohavice": 980,
+    "nominace": 981,
+    "nora": 982,
+    "norek": 983,
+    "nositel": 984,
+    "nosnost": 985,
+    "nouze": 986,
+    "noviny": 987,
+    "novota": 988,
+    "nozdra": 989,
+    "nuda": 990,
+    "nudle": 991,
+    "nuget": 992,
+    "nutit": 993,
+    "nutnost": 994,
+    "nutrie": 995,
+    "nymfa": 996,
+    "obal": 997,
+    "obarvit": 998,
+    "obava": 999,
+    "obdiv": 1000,
+    "obec": 1001,
+    "obehnat": 1002,
+    "obejmout": 1003,
+    "obezita": 1004,
+    "obhajoba": 1005,
+    "obilnice": 1006,
+    "objasnit": 1007,
+    "objekt": 1008,
+    "obklopit": 1009,
+    "oblast": 1010,
+    "oblek": 1011,
+    "obliba": 1012,
+    "obloha": 1013,
+    "obluda": 1014,
+    "obnos": 1015,
+    "obohatit": 1016,
+    "obojek": 1017,
+    "obout": 1018,
+    "obrazec": 1019,
+    "obrna": 1020,
+    "obruba": 1021,
+    "obrys": 1022,
+    "obsah": 1023,
+    "obsluha": 1024,
+    "obstarat": 1025,
+    "obuv": 1026,
+    "obvaz": 1027,
+    "obvinit": 1028,
+    "obvod": 1029,
+    "obvykle": 1030,
+    "obyvatel": 1031,
+    "obzor": 1032,
+    "ocas": 1033,
+    "ocel": 1034,
+    "ocenit": 1035,
+    "ochladit": 1036,
+    "ochota": 1037,
+    "ochrana": 1038,
+    "ocitnout": 1039,
+    "odboj": 1040,
+    "odbyt": 1041,
+    "odchod": 1042,
+    "odcizit": 1043,
+    "odebrat": 1044,
+    "odeslat": 1045,
+    "odevzdat": 1046,
+    "odezva": 1047,
+    "odhadce": 1048,
+    "odhodit": 1049,
+    "odjet": 1050,
+    "odjinud": 1051,
+    "odkaz": 1052,
+    "odkoupit": 1053,
+    "odliv": 1054,
+    "odluka": 1055,
+    "odmlka": 1056,
+    "odolnost": 1057,
+    "odpad": 1058,
+    "odpis": 1059,
+    "odplout": 1060,
+    "odpor": 1061,
+    "odpustit": 1062,
+    "odpykat": 1063,
+    "odrazka": 1064,
+    "odsoudit": 1065,
+    "odstup": 1066,
+    "odsun": 1067,
+    "odtok": 1068,
+    "odtud": 1069,
+    "odvaha": 1070,
+    "odveta": 1071,
+    "odvolat": 1072,
+    "odvracet": 1073,
+    "odznak": 1074,
+    "ofina": 1075,
+    "ofsajd": 1076,
+    "ohlas": 1077,
+    "ohnisko": 1078,
+    "ohrada": 1079,
+    "ohrozit": 1080,
+    "ohryzek": 1081,
+    "okap": 1082,
+    "okenice": 1083,
+    "oklika": 1084,
+    "okno": 1085,
+    "okouzlit": 1086,
+    "okovy": 1087,
+    "okrasa": 1088,
+    "okres": 1089,
+    "okrsek": 1090,
+    "okruh": 1091,
+    "okupant": 1092,
+    "okurka": 1093,
+    "okusit": 1094,
+    "olejnina": 1095,
+    "olizovat": 1096,
+    "omak": 1097,
+    "omeleta": 1098,
+    "omezit": 1099,
+    "omladina": 1100,
+    "omlouvat": 1101,
+    "omluva": 1102,
+    "omyl": 1103,
+    "onehdy": 1104,
+    "opakovat": 1105,
+    "opasek": 1106,
+    "operace": 1107,
+    "opice": 1108,
+    "opilost": 1109,
+    "opisovat": 1110,
+    "opora": 1111,
+    "opozice": 1112,
+    "opravdu": 1113,
+    "oproti": 1114,
+    "orbital": 1115,
+    "orchestr": 1116,
+    "orgie": 1117,
+    "orlice": 1118,
+    "orloj": 1119,
+    "ortel": 1120,
+    "osada": 1121,
+    "oschnout": 1122,
+    "osika": 1123,
+    "osivo": 1124,
+    "oslava": 1125,
+    "oslepit": 1126,
+    "oslnit": 1127,
+    "oslovit": 1128,
+    "osnova": 1129,
+    "osoba": 1130,
+    "osolit": 1131,
+    "ospalec": 1132,
+    "osten": 1133,
+    "ostraha": 1134,
+    "ostuda": 1135,
+    "ostych": 1136,
+    "osvojit": 1137,
+    "oteplit": 1138,
+    "otisk": 1139,
+    "otop": 1140,
+    "otrhat": 1141,
+    "otrlost": 1142,
+    "otrok": 1143,
+    "otruby": 1144,
+    "otvor": 1145,
+    "ovanout": 1146,
+    "ovar": 1147,
+    "oves": 1148,
+    "ovlivnit": 1149,
+    "ovoce": 1150,
+    "oxid": 1151,
+    "ozdoba": 1152,
+    "pachatel": 1153,
+    "pacient": 1154,
+    "padouch": 1155,
+    "pahorek": 1156,
+    "pakt": 1157,
+    "palanda": 1158,
+    "palec": 1159,
+    "palivo": 1160,
+    "paluba": 1161,
+    "pamflet": 1162,
+    "pamlsek": 1163,
+    "panenka": 1164,
+    "panika": 1165,
+    "panna": 1166,
+    "panovat": 1167,
+    "panstvo": 1168,
+    "pantofle": 1169,
+    "paprika": 1170,
+    "parketa": 1171,
+    "parodie": 1172,
+    "parta": 1173,
+    "paruka": 1174,
+    "paryba": 1175,
+    "paseka": 1176,
+    "pasivita": 1177,
+    "pastelka": 1178,
+    "patent": 1179,
+    "patrona": 1180,
+    "pavouk": 1181,
+    "pazneht": 1182,
+    "pazourek": 1183,
+    "pecka": 1184,
+    "pedagog": 1185,
+    "pejsek": 1186,
+    "peklo": 1187,
+    "peloton": 1188,
+    "penalta": 1189,
+    "pendrek": 1190,
+    "penze": 1191,
+    "periskop": 1192,
+    "pero": 1193,
+    "pestrost": 1194,
+    "petarda": 1195,
+    "petice": 1196,
+    "petrolej": 1197,
+    "pevnina": 1198,
+    "pexeso": 1199,
+    "pianista": 1200,
+    "piha": 1201,
+    "pijavice": 1202,
+    "pikle": 1203,
+    "piknik": 1204,
+    "pilina": 1205,
+    "pilnost": 1206,
+    "pilulka": 1207,
+    "pinzeta": 1208,
+    "pipeta": 1209,
+    "pisatel": 1210,
+    "pistole": 1211,
+    "pitevna": 1212,
+    "pivnice": 1213,
+    "pivovar": 1214,
+    "placenta": 1215,
+    "plakat": 1216,
+    "plamen": 1217,
+    "planeta": 1218,
+    "plastika": 1219,
+    "platit": 1220,
+    "plavidlo": 1221,
+    "plaz": 1222,
+    "plech": 1223,
+    "plemeno": 1224,
+    "plenta": 1225,
+    "ples": 1226,
+    "pletivo": 1227,
+    "plevel": 1228,
+    "plivat": 1229,
+    "plnit": 1230,
+    "plno": 1231,
+    "plocha": 1232,
+    "plodina": 1233,
+    "plomba": 1234,
+    "plout": 1235,
+    "pluk": 1236,
+    "plyn": 1237,
+    "pobavit": 1238,
+    "pobyt": 1239,
+    "pochod": 1240,
+    "pocit": 1241,
+    "poctivec": 1242,
+    "podat": 1243,
+    "podcenit": 1244,
+    "podepsat": 1245,
+    "podhled": 1246,
+    "podivit": 1247,
+    "podklad": 1248,
+    "podmanit": 1249,
+    "podnik": 1250,
+    "podoba": 1251,
+    "podpora": 1252,
+    "podraz": 1253,
+    "podstata": 1254,
+    "podvod": 1255,
+    "podzim": 1256,
+    "poezie": 1257,
+    "pohanka": 1258,
+    "pohnutka": 1259,
+    "pohovor": 1260,
+    "pohroma": 1261,
+    "pohyb": 1262,
+    "pointa": 1263,
+    "pojistka": 1264,
+    "pojmout": 1265,
+    "pokazit": 1266,
+    "pokles": 1267,
+    "pokoj": 1268,
+    "pokrok": 1269,
+    "pokuta": 1270,
+    "pokyn": 1271,
+    "poledne": 1272,
+    "polibek": 1273,
+    "polknout": 1274,
+    "poloha": 1275,
+    "polynom": 1276,
+    "pomalu": 1277,
+    "pominout": 1278,
+    "pomlka": 1279,
+    "pomoc": 1280,
+    "pomsta": 1281,
+    "pomyslet": 1282,
+    "ponechat": 1283,
+    "ponorka": 1284,
+    "ponurost": 1285,
+    "popadat": 1286,
+    "popel": 1287,
+    "popisek": 1288,
+    "poplach": 1289,
+    "poprosit": 1290,
+    "popsat": 1291,
+    "popud": 1292,
+    "poradce": 1293,
+    "porce": 1294,
+    "porod": 1295,
+    "porucha": 1296,
+    "poryv": 1297,
+    "posadit": 1298,
+    "posed": 1299,
+    "posila": 1300,
+    "poskok": 1301,
+    "poslanec": 1302,
+    "posoudit": 1303,
+    "pospolu": 1304,
+    "postava": 1305,
+    "posudek": 1306,
+    "posyp": 1307,
+    "potah": 1308,
+    "potkan": 1309,
+    "potlesk": 1310,
+    "potomek": 1311,
+    "potrava": 1312,
+    "potupa": 1313,
+    "potvora": 1314,
+    "poukaz": 1315,
+    "pouto": 1316,
+    "pouzdro": 1317,
+    "povaha": 1318,
+    "povidla": 1319,
+    "povlak": 1320,
+    "povoz": 1321,
+    "povrch": 1322,
+    "povstat": 1323,
+    "povyk": 1324,
+    "povzdech": 1325,
+    "pozdrav": 1326,
+    "pozemek": 1327,
+    "poznatek": 1328,
+    "pozor": 1329,
+    "pozvat": 1330,
+    "pracovat": 1331,
+    "prahory": 1332,
+    "praktika": 1333,
+    "prales": 1334,
+    "praotec": 1335,
+    "praporek": 1336,
+    "prase": 1337,
+    "pravda": 1338,
+    "princip": 1339,
+    "prkno": 1340,
+    "probudit": 1341,
+    "procento": 1342,
+    "prodej": 1343,
+    "profese": 1344,
+    "prohra": 1345,
+    "projekt": 1346,
+    "prolomit": 1347,
+    "promile": 1348,
+    "pronikat": 1349,
+    "propad": 1350,
+    "prorok": 1351,
+    "prosba": 1352,
+    "proton": 1353,
+    "proutek": 1354,
+    "provaz": 1355,
+    "prskavka": 1356,
+    "prsten": 1357,
+    "prudkost": 1358,
+    "prut": 1359,
+    "prvek": 1360,
+    "prvohory": 1361,
+    "psanec": 1362,
+    "psovod": 1363,
+    "pstruh": 1364,
+    "ptactvo": 1365,
+    "puberta": 1366,
+    "puch": 1367,
+    "pudl": 1368,
+    "pukavec": 1369,
+    "puklina": 1370,
+    "pukrle": 1371,
+    "pult": 1372,
+    "pumpa": 1373,
+    "punc": 1374,
+    "pupen": 1375,
+    "pusa": 1376,
+    "pusinka": 1377,
+    "pustina": 1378,
+    "putovat": 1379,
+    "putyka": 1380,
+    "pyramida": 1381,
+    "pysk": 1382,
+    "pytel": 1383,
+    "racek": 1384,
+    "rachot": 1385,
+    "radiace": 1386,
+    "radnice": 1387,
+    "radon": 1388,
+    "raft": 1389,
+    "ragby": 1390,
+    "raketa": 1391,
+    "rakovina": 1392,
+    "rameno": 1393,
+    "rampouch": 1394,
+    "rande": 1395,
+    "rarach": 1396,
+    "rarita": 1397,
+    "rasovna": 1398,
+    "rastr": 1399,
+    "ratolest": 1400,
+    "razance": 1401,
+    "razidlo": 1402,
+    "reagovat": 1403,
+    "reakce": 1404,
+    "recept": 1405,
+    "redaktor": 1406,
+    "referent": 1407,
+    "reflex": 1408,
+    "rejnok": 1409,
+    "reklama": 1410,
+    "rekord": 1411,
+    "rekrut": 1412,
+    "rektor": 1413,
+    "reputace": 1414,
+    "revize": 1415,
+    "revma": 1416,
+    "revolver": 1417,
+    "rezerva": 1418,
+    "riskovat": 1419,
+    "riziko": 1420,
+    "robotika": 1421,
+    "rodokmen": 1422,
+    "rohovka": 1423,
+    "rokle": 1424,
+    "rokoko": 1425,
+    "romaneto": 1426,
+    "ropovod": 1427,
+    "ropucha": 1428,
+    "rorejs": 1429,
+    "rosol": 1430,
+    "rostlina": 1431,
+    "rotmistr": 1432,
+    "rotoped": 1433,
+    "rotunda": 1434,
+    "roubenka": 1435,
+    "roucho": 1436,
+    "roup": 1437,
+    "roura": 1438,
+    "rovina": 1439,
+    "rovnice": 1440,
+    "rozbor": 1441,
+    "rozchod": 1442,
+    "rozdat": 1443,
+    "rozeznat": 1444,
+    "rozhodce": 1445,
+    "rozinka": 1446,
+    "rozjezd": 1447,
+    "rozkaz": 1448,
+    "rozloha": 1449,
+    "rozmar": 1450,
+    "rozpad": 1451,
+    "rozruch": 1452,
+    "rozsah": 1453,
+    "roztok": 1454,
+    "rozum": 1455,
+    "rozvod": 1456,
+    "rubrika": 1457,
+    "ruchadlo": 1458,
+    "rukavice": 1459,
+    "rukopis": 1460,
+    "ryba": 1461,
+    "rybolov": 1462,
+    "rychlost": 1463,
+    "rydlo": 1464,
+    "rypadlo": 1465,
+    "rytina": 1466,
+    "ryzost": 1467,
+    "sadista": 1468,
+    "sahat": 1469,
+    "sako": 1470,
+    "samec": 1471,
+    "samizdat": 1472,
+    "samota": 1473,
+    "sanitka": 1474,
+    "sardinka": 1475,
+    "sasanka": 1476,
+    "satelit": 1477,
+    "sazba": 1478,
+    "sazenice": 1479,
+    "sbor": 1480,
+    "schovat": 1481,
+    "sebranka": 1482,
+    "secese": 1483,
+    "sedadlo": 1484,
+    "sediment": 1485,
+    "sedlo": 1486,
+    "sehnat": 1487,
+    "sejmout": 1488,
+    "sekera": 1489,
+    "sekta": 1490,
+    "sekunda": 1491,
+    "sekvoje": 1492,
+    "semeno": 1493,
+    "seno": 1494,
+    "servis": 1495,
+    "sesadit": 1496,
+    "seshora": 1497,
+    "seskok": 1498,
+    "seslat": 1499,
+    "sestra": 1500,
+    "sesuv": 1501,
+    "sesypat": 1502,
+    "setba": 1503,
+    "setina": 1504,
+    "setkat": 1505,
+    "setnout": 1506,
+    "setrvat": 1507,
+    "sever": 1508,
+    "seznam": 1509,
+    "shoda": 1510,
+    "shrnout": 1511,
+    "sifon": 1512,
+    "silnice": 1513,
+    "sirka": 1514,
+    "sirotek": 1515,
+    "sirup": 1516,
+    "situace": 1517,
+    "skafandr": 1518,
+    "skalisko": 1519,
+    "skanzen": 1520,
+    "skaut": 1521,
+    "skeptik": 1522,
+    "skica": 1523,
+    "skladba": 1524,
+    "sklenice": 1525,
+    "sklo": 1526,
+    "skluz": 1527,
+    "skoba": 1528,
+    "skokan": 1529,
+    "skoro": 1530,
+    "skripta": 1531,
+    "skrz": 1532,
+    "skupina": 1533,
+    "skvost": 1534,
+    "skvrna": 1535,
+    "slabika": 1536,
+    "sladidlo": 1537,
+    "slanina": 1538,
+    "slast": 1539,
+    "slavnost": 1540,
+    "sledovat": 1541,
+    "slepec": 1542,
+    "sleva": 1543,
+    "slezina": 1544,
+    "slib": 1545,
+    "slina": 1546,
+    "sliznice": 1547,
+    "slon": 1548,
+    "sloupek": 1549,
+    "slovo": 1550,
+    "sluch": 1551,
+    "sluha": 1552,
+    "slunce": 1553,
+    "slupka": 1554,
+    "slza": 1555,
+    "smaragd": 1556,
+    "smetana": 1557,
+    "smilstvo": 1558,
+    "smlouva": 1559,
+    "smog": 1560,
+    "smrad": 1561,
+    "smrk": 1562,
+    "smrtka": 1563,
+    "smutek": 1564,
+    "smysl": 1565,
+    "snad": 1566,
+    "snaha": 1567,
+    "snob": 1568,
+    "sobota": 1569,
+    "socha": 1570,
+    "sodovka": 1571,
+    "sokol": 1572,
+    "sopka": 1573,
+    "sotva": 1574,
+    "souboj": 1575,
+    "soucit": 1576,
+    "soudce": 1577,
+    "souhlas": 1578,
+    "soulad": 1579,
+    "soumrak": 1580,
+    "souprava": 1581,
+    "soused": 1582,
+    "soutok": 1583,
+    "souviset": 1584,
+    "spalovna": 1585,
+    "spasitel": 1586,
+    "spis": 1587,
+    "splav": 1588,
+    "spodek": 1589,
+    "spojenec": 1590,
+    "spolu": 1591,
+    "sponzor": 1592,
+    "spornost": 1593,
+    "spousta": 1594,
+    "sprcha": 1595,
+    "spustit": 1596,
+    "sranda": 1597,
+    "sraz": 1598,
+    "srdce": 1599,
+    "srna": 1600,
+    "srnec": 1601,
+    "srovnat": 1602,
+    "srpen": 1603,
+    "srst": 1604,
+    "srub": 1605,
+    "stanice": 1606,
+    "starosta": 1607,
+    "statika": 1608,
+    "stavba": 1609,
+    "stehno": 1610,
+    "stezka": 1611,
+    "stodola": 1612,
+    "stolek": 1613,
+    "stopa": 1614,
+    "storno": 1615,
+    "stoupat": 1616,
+    "strach": 1617,
+    "stres": 1618,
+    "strhnout": 1619,
+    "strom": 1620,
+    "struna": 1621,
+    "studna": 1622,
+    "stupnice": 1623,
+    "stvol": 1624,
+    "styk": 1625,
+    "subjekt": 1626,
+    "subtropy": 1627,
+    "suchar": 1628,
+    "sudost": 1629,
+    "sukno": 1630,
+    "sundat": 1631,
+    "sunout": 1632,
+    "surikata": 1633,
+    "surovina": 1634,
+    "svah": 1635,
+    "svalstvo": 1636,
+    "svetr": 1637,
+    "svatba": 1638,
+    "svazek": 1639,
+    "svisle": 1640,
+    "svitek": 1641,
+    "svoboda": 1642,
+    "svodidlo": 1643,
+    "svorka": 1644,
+    "svrab": 1645,
+    "sykavka": 1646,
+    "sykot": 1647,
+    "synek": 1648,
+    "synovec": 1649,
+    "sypat": 1650,
+    "sypkost": 1651,
+    "syrovost": 1652,
+    "sysel": 1653,
+    "sytost": 1654,
+    "tabletka": 1655,
+    "tabule": 1656,
+    "tahoun": 1657,
+    "tajemno": 1658,
+    "tajfun": 1659,
+    "tajga": 1660,
+    "tajit": 1661,
+    "tajnost": 1662,
+    "taktika": 1663,
+    "tamhle": 1664,
+    "tampon": 1665,
+    "tancovat": 1666,
+    "tanec": 1667,
+    "tanker": 1668,
+    "tapeta": 1669,
+    "tavenina": 1670,
+    "tazatel": 1671,
+    "technika": 1672,
+    "tehdy": 1673,
+    "tekutina": 1674,
+    "telefon": 1675,
+    "temnota": 1676,
+    "tendence": 1677,
+    "tenista": 1678,
+    "tenor": 1679,
+    "teplota": 1680,
+    "tepna": 1681,
+    "teprve": 1682,
+    "terapie": 1683,
+    "termoska": 1684,
+    "textil": 1685,
+    "ticho": 1686,
+    "tiskopis": 1687,
+    "titulek": 1688,
+    "tkadlec": 1689,
+    "tkanina": 1690,
+    "tlapka": 1691,
+    "tleskat": 1692,
+    "tlukot": 1693,
+    "tlupa": 1694,
+    "tmel": 1695,
+    "toaleta": 1696,
+    "topinka": 1697,
+    "topol": 1698,
+    "torzo": 1699,
+    "touha": 1700,
+    "toulec": 1701,
+    "tradice": 1702,
+    "traktor": 1703,
+    "tramp": 1704,
+    "trasa": 1705,
+    "traverza": 1706,
+    "trefit": 1707,
+    "trest": 1708,
+    "trezor": 1709,
+    "trhavina": 1710,
+    "trhlina": 1711,
+    "trochu": 1712,
+    "trojice": 1713,
+    "troska": 1714,
+    "trouba": 1715,
+    "trpce": 1716,
+    "trpitel": 1717,
+    "trpkost": 1718,
+    "trubec": 1719,
+    "truchlit": 1720,
+    "truhlice": 1721,
+    "trus": 1722,
+    "trvat": 1723,
+    "tudy": 1724,
+    "tuhnout": 1725,
+    "tuhost": 1726,
+    "tundra": 1727,
+    "turista": 1728,
+    "turnaj": 1729,
+    "tuzemsko": 1730,
+    "tvaroh": 1731,
+    "tvorba": 1732,
+    "tvrdost": 1733,
+    "tvrz": 1734,
+    "tygr": 1735,
+    "tykev": 1736,
+    "ubohost": 1737,
+    "uboze": 1738,
+    "ubrat": 1739,
+    "ubrousek": 1740,
+    "ubrus": 1741,
+    "ubytovna": 1742,
+    "ucho": 1743,
+    "uctivost": 1744,
+    "udivit": 1745,
+    "uhradit": 1746,
+    "ujednat": 1747,
+    "ujistit": 1748,
+    "ujmout": 1749,
+    "ukazatel": 1750,
+    "uklidnit": 1751,
+    "uklonit": 1752,
+    "ukotvit": 1753,
+    "ukrojit": 1754,
+    "ulice": 1755,
+    "ulita": 1756,
+    "ulovit": 1757,
+    "umyvadlo": 1758,
+    "unavit": 1759,
+    "uniforma": 1760,
+    "uniknout": 1761,
+    "upadnout": 1762,
+    "uplatnit": 1763,
+    "uplynout": 1764,
+    "upoutat": 1765,
+    "upravit": 1766,
+    "uran": 1767,
+    "urazit": 1768,
+    "usednout": 1769,
+    "usilovat": 1770,
+    "usmrtit": 1771,
+    "usnadnit": 1772,
+    "usnout": 1773,
+    "usoudit": 1774,
+    "ustlat": 1775,
+    "ustrnout": 1776,
+    "utahovat": 1777,
+    "utkat": 1778,
+    "utlumit": 1779,
+    "utonout": 1780,
+    "utopenec": 1781,
+    "utrousit": 1782,
+    "uvalit": 1783,
+    "uvolnit": 1784,
+    "uvozovka": 1785,
+    "uzdravit": 1786,
+    "uzel": 1787,
+    "uzenina": 1788,
+    "uzlina": 1789,
+    "uznat": 1790,
+    "vagon": 1791,
+    "valcha": 1792,
+    "valoun": 1793,
+    "vana": 1794,
+    "vandal": 1795,
+    "vanilka": 1796,
+    "varan": 1797,
+    "varhany": 1798,
+    "varovat": 1799,
+    "vcelku": 1800,
+    "vchod": 1801,
+    "vdova": 1802,
+    "vedro": 1803,
+    "vegetace": 1804,
+    "vejce": 1805,
+    "velbloud": 1806,
+    "veletrh": 1807,
+    "velitel": 1808,
+    "velmoc": 1809,
+    "velryba": 1810,
+    "venkov": 1811,
+    "veranda": 1812,
+    "verze": 1813,
+    "veselka": 1814,
+    "veskrze": 1815,
+    "vesnice": 1816,
+    "vespodu": 1817,
+    "vesta": 1818,
+    "veterina": 1819,
+    "veverka": 1820,
+    "vibrace": 1821,
+    "vichr": 1822,
+    "videohra": 1823,
+    "vidina": 1824,
+    "vidle": 1825,
+    "vila": 1826,
+    "vinice": 1827,
+    "viset": 1828,
+    "vitalita": 1829,
+    "vize": 1830,
+    "vizitka": 1831,
+    "vjezd": 1832,
+    "vklad": 1833,
+    "vkus": 1834,
+    "vlajka": 1835,
+    "vlak": 1836,
+    "vlasec": 1837,
+    "vlevo": 1838,
+    "vlhkost": 1839,
+    "vliv": 1840,
+    "vlnovka": 1841,
+    "vloupat": 1842,
+    "vnucovat": 1843,
+    "vnuk": 1844,
+    "voda": 1845,
+    "vodivost": 1846,
+    "vodoznak": 1847,
+    "vodstvo": 1848,
+    "vojensky": 1849,
+    "vojna": 1850,
+    "vojsko": 1851,
+    "volant": 1852,
+    "volba": 1853,
+    "volit": 1854,
+    "volno": 1855,
+    "voskovka": 1856,
+    "vozidlo": 1857,
+    "vozovna": 1858,
+    "vpravo": 1859,
+    "vrabec": 1860,
+    "vracet": 1861,
+    "vrah": 1862,
+    "vrata": 1863,
+    "vrba": 1864,
+    "vrcholek": 1865,
+    "vrhat": 1866,
+    "vrstva": 1867,
+    "vrtule": 1868,
+    "vsadit": 1869,
+    "vstoupit": 1870,
+    "vstup": 1871,
+    "vtip": 1872,
+    "vybavit": 1873,
+    "vybrat": 1874,
+    "vychovat": 1875,
+    "vydat": 1876,
+    "vydra": 1877,
+    "vyfotit": 1878,
+    "vyhledat": 1879,
+    "vyhnout": 1880,
+    "vyhodit": 1881,
+    "vyhradit": 1882,
+    "vyhubit": 1883,
+    "vyjasnit": 1884,
+    "vyjet": 1885,
+    "vyjmout": 1886,
+    "vyklopit": 1887,
+    "vykonat": 1888,
+    "vylekat": 1889,
+    "vymazat": 1890,
+    "vymezit": 1891,
+    "vymizet": 1892,
+    "vymyslet": 1893,
+    "vynechat": 1894,
+    "vynikat": 1895,
+    "vynutit": 1896,
+    "vypadat": 1897,
+    "vyplatit": 1898,
+    "vypravit": 1899,
+    "vypustit": 1900,
+    "vyrazit": 1901,
+    "vyrovnat": 1902,
+    "vyrvat": 1903,
+    "vyslovit": 1904,
+    "vysoko": 1905,
+    "vystavit": 1906,
+    "vysunout": 1907,
+    "vysypat": 1908,
+    "vytasit": 1909,
+    "vytesat": 1910,
+    "vytratit": 1911,
+    "vyvinout": 1912,
+    "vyvolat": 1913,
+    "vyvrhel": 1914,
+    "vyzdobit": 1915,
+    "vyznat": 1916,
+    "vzadu": 1917,
+    "vzbudit": 1918,
+    "vzchopit": 1919,
+    "vzdor": 1920,
+    "vzduch": 1921,
+    "vzdychat": 1922,
+    "vzestup": 1923,
+    "vzhledem": 1924,
+    "vzkaz": 1925,
+    "vzlykat": 1926,
+    "vznik": 1927,
+    "vzorek": 1928,
+    "vzpoura": 1929,
+    "vztah": 1930,
+    "vztek": 1931,
+    "xylofon": 1932,
+    "zabrat": 1933,
+    "zabydlet": 1934,
+    "zachovat": 1935,
+    "zadarmo": 1936,
+    "zadusit": 1937,
+    "zafoukat": 1938,
+    "zahltit": 1939,
+    "zahodit": 1940,
+    "zahrada": 1941,
+    "zahynout": 1942,
+    "zajatec": 1943,
+    "zajet": 1944,
+    "zajistit": 1945,
+    "zaklepat": 1946,
+    "zakoupit": 1947,
+    "zalepit": 1948,
+    "zamezit": 1949,
+    "zamotat": 1950,
+    "zamyslet": 1951,
+    "zanechat": 1952,
+    "zanikat": 1953,
+    "zaplatit": 1954,
+    "zapojit": 1955,
+    "zapsat": 1956,
+    "zarazit": 1957,
+    "zastavit": 1958,
+    "zasunout": 1959,
+    "zatajit": 1960,
+    "zatemnit": 1961,
+    "zatknout": 1962,
+    "zaujmout": 1963,
+    "zavalit": 1964,
+    "zavelet": 1965,
+    "zavinit": 1966,
+    "zavolat": 1967,
+    "zavrtat": 1968,
+    "zazvonit": 1969,
+    "zbavit": 1970,
+    "zbrusu": 1971,
+    "zbudovat": 1972,
+    "zbytek": 1973,
+    "zdaleka": 1974,
+    "zdarma": 1975,
+    "zdatnost": 1976,
+    "zdivo": 1977,
+    "zdobit": 1978,
+    "zdroj": 1979,
+    "zdvih": 1980,
+    "zdymadlo": 1981,
+    "zelenina": 1982,
+    "zeman": 1983,
+    "zemina": 1984,
+    "zeptat": 1985,
+    "zezadu": 1986,
+    "zezdola": 1987,
+    "zhatit": 1988,
+    "zhltnout": 1989,
+    "zhluboka": 1990,
+    "zhotovit": 1991,
+    "zhruba": 1992,
+    "zima": 1993,
+    "zimnice": 1994,
+    "zjemnit": 1995,
+    "zklamat": 1996,
+    "zkoumat": 1997,
+    "zkratka": 1998,
+    "zkumavka": 1999,
+    "zlato": 2000,
+    "zlehka": 2001,
+    "zloba": 2002,
+    "zlom": 2003,
+    "zlost": 2004,
+    "zlozvyk": 2005,
+    "zmapovat": 2006,
+    "zmar": 2007,
+    "zmatek": 2008,
+    "zmije": 2009,
+    "zmizet": 2010,
+    "zmocnit": 2011,
+    "zmodrat": 2012,
+    "zmrzlina": 2013,
+    "zmutovat": 2014,
+    "znak": 2015,
+    "znalost": 2016,
+    "znamenat": 2017,
+    "znovu": 2018,
+    "zobrazit": 2019,
+    "zotavit": 2020,
+    "zoubek": 2021,
+    "zoufale": 2022,
+    "zplodit": 2023,
+    "zpomalit": 2024,
+    "zprava": 2025,
+    "zprostit": 2026,
+    "zprudka": 2027,
+    "zprvu": 2028,
+    "zrada": 2029,
+    "zranit": 2030,
+    "zrcadlo": 2031,
+    "zrnitost": 2032,
+    "zrno": 2033,
+    "zrovna": 2034,
+    "zrychlit": 2035,
+    "zrzavost": 2036,
+    "zticha": 2037,
+    "ztratit": 2038,
+    "zubovina": 2039,
+    "zubr": 2040,
+    "zvednout": 2041,
+    "zvenku": 2042,
+    "zvesela": 2043,
+    "zvon": 2044,
+    "zvrat": 2045,
+    "zvukovod": 2046,
+    "zvyk": 2047,
+}
 var words_english = [2048]string{
     "abandon",
     "ability",
@@ -4107,6 +16407,20514 @@ var rwords_english = map[string]int16{
     "zone": 2046,
     "zoo": 2047,
 }
+var words_french = [2048]string{
+    "abaisser",
+    "abandon",
+    "abdiquer",
+    "abeille",
+    "abolir",
+    "aborder",
+    "aboutir",
+    "aboyer",
+    "abrasif",
+    "abreuver",
+    "abriter",
+    "abroger",
+    "abrupt",
+    "absence",
+    "absolu",
+    "absurde",
+    "abusif",
+    "abyssal",
+    "académie",
+    "acajou",
+    "acarien",
+    "accabler",
+    "accepter",
+    "acclamer",
+    "accolade",
+    "accroche",
+    "accuser",
+    "acerbe",
+    "achat",
+    "acheter",
+    "aciduler",
+    "acier",
+    "acompte",
+    "acquérir",
+    "acronyme",
+    "acteur",
+    "actif",
+    "actuel",
+    "adepte",
+    "adéquat",
+    "adhésif",
+    "adjectif",
+    "adjuger",
+    "admettre",
+    "admirer",
+    "adopter",
+    "adorer",
+    "adoucir",
+    "adresse",
+    "adroit",
+    "adulte",
+    "adverbe",
+    "aérer",
+    "aéronef",
+    "affaire",
+    "affecter",
+    "affiche",
+    "affreux",
+    "affubler",
+    "agacer",
+    "agencer",
+    "agile",
+    "agiter",
+    "agrafer",
+    "agréable",
+    "agrume",
+    "aider",
+    "aiguille",
+    "ailier",
+    "aimable",
+    "aisance",
+    "ajouter",
+    "ajuster",
+    "alarmer",
+    "alchimie",
+    "alerte",
+    "algèbre",
+    "algue",
+    "aliéner",
+    "aliment",
+    "alléger",
+    "alliage",
+    "allouer",
+    "allumer",
+    "alourdir",
+    "alpaga",
+    "altesse",
+    "alvéole",
+    "amateur",
+    "ambigu",
+    "ambre",
+    "aménager",
+    "amertume",
+    "amidon",
+    "amiral",
+    "amorcer",
+    "amour",
+    "amovible",
+    "amphibie",
+    "ampleur",
+    "amusant",
+    "analyse",
+    "anaphore",
+    "anarchie",
+    "anatomie",
+    "ancien",
+    "anéantir",
+    "angle",
+    "angoisse",
+    "anguleux",
+    "animal",
+    "annexer",
+    "annonce",
+    "annuel",
+    "anodin",
+    "anomalie",
+    "anonyme",
+    "anormal",
+    "antenne",
+    "antidote",
+    "anxieux",
+    "apaiser",
+    "apéritif",
+    "aplanir",
+    "apologie",
+    "appareil",
+    "appeler",
+    "apporter",
+    "appuyer",
+    "aquarium",
+    "aqueduc",
+    "arbitre",
+    "arbuste",
+    "ardeur",
+    "ardoise",
+    "argent",
+    "arlequin",
+    "armature",
+    "armement",
+    "armoire",
+    "armure",
+    "arpenter",
+    "arracher",
+    "arriver",
+    "arroser",
+    "arsenic",
+    "artériel",
+    "article",
+    "aspect",
+    "asphalte",
+    "aspirer",
+    "assaut",
+    "asservir",
+    "assiette",
+    "associer",
+    "assurer",
+    "asticot",
+    "astre",
+    "astuce",
+    "atelier",
+    "atome",
+    "atrium",
+    "atroce",
+    "attaque",
+    "attentif",
+    "attirer",
+    "attraper",
+    "aubaine",
+    "auberge",
+    "audace",
+    "audible",
+    "augurer",
+    "aurore",
+    "automne",
+    "autruche",
+    "avaler",
+    "avancer",
+    "avarice",
+    "avenir",
+    "averse",
+    "aveugle",
+    "aviateur",
+    "avide",
+    "avion",
+    "aviser",
+    "avoine",
+    "avouer",
+    "avril",
+    "axial",
+    "axiome",
+    "badge",
+    "bafouer",
+    "bagage",
+    "baguette",
+    "baignade",
+    "balancer",
+    "balcon",
+    "baleine",
+    "balisage",
+    "bambin",
+    "bancaire",
+    "bandage",
+    "banlieue",
+    "bannière",
+    "banquier",
+    "barbier",
+    "baril",
+    "baron",
+    "barque",
+    "barrage",
+    "bassin",
+    "bastion",
+    "bataille",
+    "bateau",
+    "batterie",
+    "baudrier",
+    "bavarder",
+    "belette",
+    "bélier",
+    "belote",
+    "bénéfice",
+    "berceau",
+    "berger",
+    "berline",
+    "bermuda",
+    "besace",
+    "besogne",
+    "bétail",
+    "beurre",
+    "biberon",
+    "bicycle",
+    "bidule",
+    "bijou",
+    "bilan",
+    "bilingue",
+    "billard",
+    "binaire",
+    "biologie",
+    "biopsie",
+    "biotype",
+    "biscuit",
+    "bison",
+    "bistouri",
+    "bitume",
+    "bizarre",
+    "blafard",
+    "blague",
+    "blanchir",
+    "blessant",
+    "blinder",
+    "blond",
+    "bloquer",
+    "blouson",
+    "bobard",
+    "bobine",
+    "boire",
+    "boiser",
+    "bolide",
+    "bonbon",
+    "bondir",
+    "bonheur",
+    "bonifier",
+    "bonus",
+    "bordure",
+    "borne",
+    "botte",
+    "boucle",
+    "boueux",
+    "bougie",
+    "boulon",
+    "bouquin",
+    "bourse",
+    "boussole",
+    "boutique",
+    "boxeur",
+    "branche",
+    "brasier",
+    "brave",
+    "brebis",
+    "brèche",
+    "breuvage",
+    "bricoler",
+    "brigade",
+    "brillant",
+    "brioche",
+    "brique",
+    "brochure",
+    "broder",
+    "bronzer",
+    "brousse",
+    "broyeur",
+    "brume",
+    "brusque",
+    "brutal",
+    "bruyant",
+    "buffle",
+    "buisson",
+    "bulletin",
+    "bureau",
+    "burin",
+    "bustier",
+    "butiner",
+    "butoir",
+    "buvable",
+    "buvette",
+    "cabanon",
+    "cabine",
+    "cachette",
+    "cadeau",
+    "cadre",
+    "caféine",
+    "caillou",
+    "caisson",
+    "calculer",
+    "calepin",
+    "calibre",
+    "calmer",
+    "calomnie",
+    "calvaire",
+    "camarade",
+    "caméra",
+    "camion",
+    "campagne",
+    "canal",
+    "caneton",
+    "canon",
+    "cantine",
+    "canular",
+    "capable",
+    "caporal",
+    "caprice",
+    "capsule",
+    "capter",
+    "capuche",
+    "carabine",
+    "carbone",
+    "caresser",
+    "caribou",
+    "carnage",
+    "carotte",
+    "carreau",
+    "carton",
+    "cascade",
+    "casier",
+    "casque",
+    "cassure",
+    "causer",
+    "caution",
+    "cavalier",
+    "caverne",
+    "caviar",
+    "cédille",
+    "ceinture",
+    "céleste",
+    "cellule",
+    "cendrier",
+    "censurer",
+    "central",
+    "cercle",
+    "cérébral",
+    "cerise",
+    "cerner",
+    "cerveau",
+    "cesser",
+    "chagrin",
+    "chaise",
+    "chaleur",
+    "chambre",
+    "chance",
+    "chapitre",
+    "charbon",
+    "chasseur",
+    "chaton",
+    "chausson",
+    "chavirer",
+    "chemise",
+    "chenille",
+    "chéquier",
+    "chercher",
+    "cheval",
+    "chien",
+    "chiffre",
+    "chignon",
+    "chimère",
+    "chiot",
+    "chlorure",
+    "chocolat",
+    "choisir",
+    "chose",
+    "chouette",
+    "chrome",
+    "chute",
+    "cigare",
+    "cigogne",
+    "cimenter",
+    "cinéma",
+    "cintrer",
+    "circuler",
+    "cirer",
+    "cirque",
+    "citerne",
+    "citoyen",
+    "citron",
+    "civil",
+    "clairon",
+    "clameur",
+    "claquer",
+    "classe",
+    "clavier",
+    "client",
+    "cligner",
+    "climat",
+    "clivage",
+    "cloche",
+    "clonage",
+    "cloporte",
+    "cobalt",
+    "cobra",
+    "cocasse",
+    "cocotier",
+    "coder",
+    "codifier",
+    "coffre",
+    "cogner",
+    "cohésion",
+    "coiffer",
+    "coincer",
+    "colère",
+    "colibri",
+    "colline",
+    "colmater",
+    "colonel",
+    "combat",
+    "comédie",
+    "commande",
+    "compact",
+    "concert",
+    "conduire",
+    "confier",
+    "congeler",
+    "connoter",
+    "consonne",
+    "contact",
+    "convexe",
+    "copain",
+    "copie",
+    "corail",
+    "corbeau",
+    "cordage",
+    "corniche",
+    "corpus",
+    "correct",
+    "cortège",
+    "cosmique",
+    "costume",
+    "coton",
+    "coude",
+    "coupure",
+    "courage",
+    "couteau",
+    "couvrir",
+    "coyote",
+    "crabe",
+    "crainte",
+    "cravate",
+    "crayon",
+    "créature",
+    "créditer",
+    "crémeux",
+    "creuser",
+    "crevette",
+    "cribler",
+    "crier",
+    "cristal",
+    "critère",
+    "croire",
+    "croquer",
+    "crotale",
+    "crucial",
+    "cruel",
+    "crypter",
+    "cubique",
+    "cueillir",
+    "cuillère",
+    "cuisine",
+    "cuivre",
+    "culminer",
+    "cultiver",
+    "cumuler",
+    "cupide",
+    "curatif",
+    "curseur",
+    "cyanure",
+    "cycle",
+    "cylindre",
+    "cynique",
+    "daigner",
+    "damier",
+    "danger",
+    "danseur",
+    "dauphin",
+    "débattre",
+    "débiter",
+    "déborder",
+    "débrider",
+    "débutant",
+    "décaler",
+    "décembre",
+    "déchirer",
+    "décider",
+    "déclarer",
+    "décorer",
+    "décrire",
+    "décupler",
+    "dédale",
+    "déductif",
+    "déesse",
+    "défensif",
+    "défiler",
+    "défrayer",
+    "dégager",
+    "dégivrer",
+    "déglutir",
+    "dégrafer",
+    "déjeuner",
+    "délice",
+    "déloger",
+    "demander",
+    "demeurer",
+    "démolir",
+    "dénicher",
+    "dénouer",
+    "dentelle",
+    "dénuder",
+    "départ",
+    "dépenser",
+    "déphaser",
+    "déplacer",
+    "déposer",
+    "déranger",
+    "dérober",
+    "désastre",
+    "descente",
+    "désert",
+    "désigner",
+    "désobéir",
+    "dessiner",
+    "destrier",
+    "détacher",
+    "détester",
+    "détourer",
+    "détresse",
+    "devancer",
+    "devenir",
+    "deviner",
+    "devoir",
+    "diable",
+    "dialogue",
+    "diamant",
+    "dicter",
+    "différer",
+    "digérer",
+    "digital",
+    "digne",
+    "diluer",
+    "dimanche",
+    "diminuer",
+    "dioxyde",
+    "directif",
+    "diriger",
+    "discuter",
+    "disposer",
+    "dissiper",
+    "distance",
+    "divertir",
+    "diviser",
+    "docile",
+    "docteur",
+    "dogme",
+    "doigt",
+    "domaine",
+    "domicile",
+    "dompter",
+    "donateur",
+    "donjon",
+    "donner",
+    "dopamine",
+    "dortoir",
+    "dorure",
+    "dosage",
+    "doseur",
+    "dossier",
+    "dotation",
+    "douanier",
+    "double",
+    "douceur",
+    "douter",
+    "doyen",
+    "dragon",
+    "draper",
+    "dresser",
+    "dribbler",
+    "droiture",
+    "duperie",
+    "duplexe",
+    "durable",
+    "durcir",
+    "dynastie",
+    "éblouir",
+    "écarter",
+    "écharpe",
+    "échelle",
+    "éclairer",
+    "éclipse",
+    "éclore",
+    "écluse",
+    "école",
+    "économie",
+    "écorce",
+    "écouter",
+    "écraser",
+    "écrémer",
+    "écrivain",
+    "écrou",
+    "écume",
+    "écureuil",
+    "édifier",
+    "éduquer",
+    "effacer",
+    "effectif",
+    "effigie",
+    "effort",
+    "effrayer",
+    "effusion",
+    "égaliser",
+    "égarer",
+    "éjecter",
+    "élaborer",
+    "élargir",
+    "électron",
+    "élégant",
+    "éléphant",
+    "élève",
+    "éligible",
+    "élitisme",
+    "éloge",
+    "élucider",
+    "éluder",
+    "emballer",
+    "embellir",
+    "embryon",
+    "émeraude",
+    "émission",
+    "emmener",
+    "émotion",
+    "émouvoir",
+    "empereur",
+    "employer",
+    "emporter",
+    "emprise",
+    "émulsion",
+    "encadrer",
+    "enchère",
+    "enclave",
+    "encoche",
+    "endiguer",
+    "endosser",
+    "endroit",
+    "enduire",
+    "énergie",
+    "enfance",
+    "enfermer",
+    "enfouir",
+    "engager",
+    "engin",
+    "englober",
+    "énigme",
+    "enjamber",
+    "enjeu",
+    "enlever",
+    "ennemi",
+    "ennuyeux",
+    "enrichir",
+    "enrobage",
+    "enseigne",
+    "entasser",
+    "entendre",
+    "entier",
+    "entourer",
+    "entraver",
+    "énumérer",
+    "envahir",
+    "enviable",
+    "envoyer",
+    "enzyme",
+    "éolien",
+    "épaissir",
+    "épargne",
+    "épatant",
+    "épaule",
+    "épicerie",
+    "épidémie",
+    "épier",
+    "épilogue",
+    "épine",
+    "épisode",
+    "épitaphe",
+    "époque",
+    "épreuve",
+    "éprouver",
+    "épuisant",
+    "équerre",
+    "équipe",
+    "ériger",
+    "érosion",
+    "erreur",
+    "éruption",
+    "escalier",
+    "espadon",
+    "espèce",
+    "espiègle",
+    "espoir",
+    "esprit",
+    "esquiver",
+    "essayer",
+    "essence",
+    "essieu",
+    "essorer",
+    "estime",
+    "estomac",
+    "estrade",
+    "étagère",
+    "étaler",
+    "étanche",
+    "étatique",
+    "éteindre",
+    "étendoir",
+    "éternel",
+    "éthanol",
+    "éthique",
+    "ethnie",
+    "étirer",
+    "étoffer",
+    "étoile",
+    "étonnant",
+    "étourdir",
+    "étrange",
+    "étroit",
+    "étude",
+    "euphorie",
+    "évaluer",
+    "évasion",
+    "éventail",
+    "évidence",
+    "éviter",
+    "évolutif",
+    "évoquer",
+    "exact",
+    "exagérer",
+    "exaucer",
+    "exceller",
+    "excitant",
+    "exclusif",
+    "excuse",
+    "exécuter",
+    "exemple",
+    "exercer",
+    "exhaler",
+    "exhorter",
+    "exigence",
+    "exiler",
+    "exister",
+    "exotique",
+    "expédier",
+    "explorer",
+    "exposer",
+    "exprimer",
+    "exquis",
+    "extensif",
+    "extraire",
+    "exulter",
+    "fable",
+    "fabuleux",
+    "facette",
+    "facile",
+    "facture",
+    "faiblir",
+    "falaise",
+    "fameux",
+    "famille",
+    "farceur",
+    "farfelu",
+    "farine",
+    "farouche",
+    "fasciner",
+    "fatal",
+    "fatigue",
+    "faucon",
+    "fautif",
+    "faveur",
+    "favori",
+    "fébrile",
+    "féconder",
+    "fédérer",
+    "félin",
+    "femme",
+    "fémur",
+    "fendoir",
+    "féodal",
+    "fermer",
+    "féroce",
+    "ferveur",
+    "festival",
+    "feuille",
+    "feutre",
+    "février",
+    "fiasco",
+    "ficeler",
+    "fictif",
+    "fidèle",
+    "figure",
+    "filature",
+    "filetage",
+    "filière",
+    "filleul",
+    "filmer",
+    "filou",
+    "filtrer",
+    "financer",
+    "finir",
+    "fiole",
+    "firme",
+    "fissure",
+    "fixer",
+    "flairer",
+    "flamme",
+    "flasque",
+    "flatteur",
+    "fléau",
+    "flèche",
+    "fleur",
+    "flexion",
+    "flocon",
+    "flore",
+    "fluctuer",
+    "fluide",
+    "fluvial",
+    "folie",
+    "fonderie",
+    "fongible",
+    "fontaine",
+    "forcer",
+    "forgeron",
+    "formuler",
+    "fortune",
+    "fossile",
+    "foudre",
+    "fougère",
+    "fouiller",
+    "foulure",
+    "fourmi",
+    "fragile",
+    "fraise",
+    "franchir",
+    "frapper",
+    "frayeur",
+    "frégate",
+    "freiner",
+    "frelon",
+    "frémir",
+    "frénésie",
+    "frère",
+    "friable",
+    "friction",
+    "frisson",
+    "frivole",
+    "froid",
+    "fromage",
+    "frontal",
+    "frotter",
+    "fruit",
+    "fugitif",
+    "fuite",
+    "fureur",
+    "furieux",
+    "furtif",
+    "fusion",
+    "futur",
+    "gagner",
+    "galaxie",
+    "galerie",
+    "gambader",
+    "garantir",
+    "gardien",
+    "garnir",
+    "garrigue",
+    "gazelle",
+    "gazon",
+    "géant",
+    "gélatine",
+    "gélule",
+    "gendarme",
+    "général",
+    "génie",
+    "genou",
+    "gentil",
+    "géologie",
+    "géomètre",
+    "géranium",
+    "germe",
+    "gestuel",
+    "geyser",
+    "gibier",
+    "gicler",
+    "girafe",
+    "givre",
+    "glace",
+    "glaive",
+    "glisser",
+    "globe",
+    "gloire",
+    "glorieux",
+    "golfeur",
+    "gomme",
+    "gonfler",
+    "gorge",
+    "gorille",
+    "goudron",
+    "gouffre",
+    "goulot",
+    "goupille",
+    "gourmand",
+    "goutte",
+    "graduel",
+    "graffiti",
+    "graine",
+    "grand",
+    "grappin",
+    "gratuit",
+    "gravir",
+    "grenat",
+    "griffure",
+    "griller",
+    "grimper",
+    "grogner",
+    "gronder",
+    "grotte",
+    "groupe",
+    "gruger",
+    "grutier",
+    "gruyère",
+    "guépard",
+    "guerrier",
+    "guide",
+    "guimauve",
+    "guitare",
+    "gustatif",
+    "gymnaste",
+    "gyrostat",
+    "habitude",
+    "hachoir",
+    "halte",
+    "hameau",
+    "hangar",
+    "hanneton",
+    "haricot",
+    "harmonie",
+    "harpon",
+    "hasard",
+    "hélium",
+    "hématome",
+    "herbe",
+    "hérisson",
+    "hermine",
+    "héron",
+    "hésiter",
+    "heureux",
+    "hiberner",
+    "hibou",
+    "hilarant",
+    "histoire",
+    "hiver",
+    "homard",
+    "hommage",
+    "homogène",
+    "honneur",
+    "honorer",
+    "honteux",
+    "horde",
+    "horizon",
+    "horloge",
+    "hormone",
+    "horrible",
+    "houleux",
+    "housse",
+    "hublot",
+    "huileux",
+    "humain",
+    "humble",
+    "humide",
+    "humour",
+    "hurler",
+    "hydromel",
+    "hygiène",
+    "hymne",
+    "hypnose",
+    "idylle",
+    "ignorer",
+    "iguane",
+    "illicite",
+    "illusion",
+    "image",
+    "imbiber",
+    "imiter",
+    "immense",
+    "immobile",
+    "immuable",
+    "impact",
+    "impérial",
+    "implorer",
+    "imposer",
+    "imprimer",
+    "imputer",
+    "incarner",
+    "incendie",
+    "incident",
+    "incliner",
+    "incolore",
+    "indexer",
+    "indice",
+    "inductif",
+    "inédit",
+    "ineptie",
+    "inexact",
+    "infini",
+    "infliger",
+    "informer",
+    "infusion",
+    "ingérer",
+    "inhaler",
+    "inhiber",
+    "injecter",
+    "injure",
+    "innocent",
+    "inoculer",
+    "inonder",
+    "inscrire",
+    "insecte",
+    "insigne",
+    "insolite",
+    "inspirer",
+    "instinct",
+    "insulter",
+    "intact",
+    "intense",
+    "intime",
+    "intrigue",
+    "intuitif",
+    "inutile",
+    "invasion",
+    "inventer",
+    "inviter",
+    "invoquer",
+    "ironique",
+    "irradier",
+    "irréel",
+    "irriter",
+    "isoler",
+    "ivoire",
+    "ivresse",
+    "jaguar",
+    "jaillir",
+    "jambe",
+    "janvier",
+    "jardin",
+    "jauger",
+    "jaune",
+    "javelot",
+    "jetable",
+    "jeton",
+    "jeudi",
+    "jeunesse",
+    "joindre",
+    "joncher",
+    "jongler",
+    "joueur",
+    "jouissif",
+    "journal",
+    "jovial",
+    "joyau",
+    "joyeux",
+    "jubiler",
+    "jugement",
+    "junior",
+    "jupon",
+    "juriste",
+    "justice",
+    "juteux",
+    "juvénile",
+    "kayak",
+    "kimono",
+    "kiosque",
+    "label",
+    "labial",
+    "labourer",
+    "lacérer",
+    "lactose",
+    "lagune",
+    "laine",
+    "laisser",
+    "laitier",
+    "lambeau",
+    "lamelle",
+    "lampe",
+    "lanceur",
+    "langage",
+    "lanterne",
+    "lapin",
+    "largeur",
+    "larme",
+    "laurier",
+    "lavabo",
+    "lavoir",
+    "lecture",
+    "légal",
+    "léger",
+    "légume",
+    "lessive",
+    "lettre",
+    "levier",
+    "lexique",
+    "lézard",
+    "liasse",
+    "libérer",
+    "libre",
+    "licence",
+    "licorne",
+    "liège",
+    "lièvre",
+    "ligature",
+    "ligoter",
+    "ligue",
+    "limer",
+    "limite",
+    "limonade",
+    "limpide",
+    "linéaire",
+    "lingot",
+    "lionceau",
+    "liquide",
+    "lisière",
+    "lister",
+    "lithium",
+    "litige",
+    "littoral",
+    "livreur",
+    "logique",
+    "lointain",
+    "loisir",
+    "lombric",
+    "loterie",
+    "louer",
+    "lourd",
+    "loutre",
+    "louve",
+    "loyal",
+    "lubie",
+    "lucide",
+    "lucratif",
+    "lueur",
+    "lugubre",
+    "luisant",
+    "lumière",
+    "lunaire",
+    "lundi",
+    "luron",
+    "lutter",
+    "luxueux",
+    "machine",
+    "magasin",
+    "magenta",
+    "magique",
+    "maigre",
+    "maillon",
+    "maintien",
+    "mairie",
+    "maison",
+    "majorer",
+    "malaxer",
+    "maléfice",
+    "malheur",
+    "malice",
+    "mallette",
+    "mammouth",
+    "mandater",
+    "maniable",
+    "manquant",
+    "manteau",
+    "manuel",
+    "marathon",
+    "marbre",
+    "marchand",
+    "mardi",
+    "maritime",
+    "marqueur",
+    "marron",
+    "marteler",
+    "mascotte",
+    "massif",
+    "matériel",
+    "matière",
+    "matraque",
+    "maudire",
+    "maussade",
+    "mauve",
+    "maximal",
+    "méchant",
+    "méconnu",
+    "médaille",
+    "médecin",
+    "méditer",
+    "méduse",
+    "meilleur",
+    "mélange",
+    "mélodie",
+    "membre",
+    "mémoire",
+    "menacer",
+    "mener",
+    "menhir",
+    "mensonge",
+    "mentor",
+    "mercredi",
+    "mérite",
+    "merle",
+    "messager",
+    "mesure",
+    "métal",
+    "météore",
+    "méthode",
+    "métier",
+    "meuble",
+    "miauler",
+    "microbe",
+    "miette",
+    "mignon",
+    "migrer",
+    "milieu",
+    "million",
+    "mimique",
+    "mince",
+    "minéral",
+    "minimal",
+    "minorer",
+    "minute",
+    "miracle",
+    "miroiter",
+    "missile",
+    "mixte",
+    "mobile",
+    "moderne",
+    "moelleux",
+    "mondial",
+    "moniteur",
+    "monnaie",
+    "monotone",
+    "monstre",
+    "montagne",
+    "monument",
+    "moqueur",
+    "morceau",
+    "morsure",
+    "mortier",
+    "moteur",
+    "motif",
+    "mouche",
+    "moufle",
+    "moulin",
+    "mousson",
+    "mouton",
+    "mouvant",
+    "multiple",
+    "munition",
+    "muraille",
+    "murène",
+    "murmure",
+    "muscle",
+    "muséum",
+    "musicien",
+    "mutation",
+    "muter",
+    "mutuel",
+    "myriade",
+    "myrtille",
+    "mystère",
+    "mythique",
+    "nageur",
+    "nappe",
+    "narquois",
+    "narrer",
+    "natation",
+    "nation",
+    "nature",
+    "naufrage",
+    "nautique",
+    "navire",
+    "nébuleux",
+    "nectar",
+    "néfaste",
+    "négation",
+    "négliger",
+    "négocier",
+    "neige",
+    "nerveux",
+    "nettoyer",
+    "neurone",
+    "neutron",
+    "neveu",
+    "niche",
+    "nickel",
+    "nitrate",
+    "niveau",
+    "noble",
+    "nocif",
+    "nocturne",
+    "noirceur",
+    "noisette",
+    "nomade",
+    "nombreux",
+    "nommer",
+    "normatif",
+    "notable",
+    "notifier",
+    "notoire",
+    "nourrir",
+    "nouveau",
+    "novateur",
+    "novembre",
+    "novice",
+    "nuage",
+    "nuancer",
+    "nuire",
+    "nuisible",
+    "numéro",
+    "nuptial",
+    "nuque",
+    "nutritif",
+    "obéir",
+    "objectif",
+    "obliger",
+    "obscur",
+    "observer",
+    "obstacle",
+    "obtenir",
+    "obturer",
+    "occasion",
+    "occuper",
+    "océan",
+    "octobre",
+    "octroyer",
+    "octupler",
+    "oculaire",
+    "odeur",
+    "odorant",
+    "offenser",
+    "officier",
+    "offrir",
+    "ogive",
+    "oiseau",
+    "oisillon",
+    "olfactif",
+    "olivier",
+    "ombrage",
+    "omettre",
+    "onctueux",
+    "onduler",
+    "onéreux",
+    "onirique",
+    "opale",
+    "opaque",
+    "opérer",
+    "opinion",
+    "opportun",
+    "opprimer",
+    "opter",
+    "optique",
+    "orageux",
+    "orange",
+    "orbite",
+    "ordonner",
+    "oreille",
+    "organe",
+    "orgueil",
+    "orifice",
+    "ornement",
+    "orque",
+    "ortie",
+    "osciller",
+    "osmose",
+    "ossature",
+    "otarie",
+    "ouragan",
+    "ourson",
+    "outil",
+    "outrager",
+    "ouvrage",
+    "ovation",
+    "oxyde",
+    "oxygène",
+    "ozone",
+    "paisible",
+    "palace",
+    "palmarès",
+    "palourde",
+    "palper",
+    "panache",
+    "panda",
+    "pangolin",
+    "paniquer",
+    "panneau",
+    "panorama",
+    "pantalon",
+    "papaye",
+    "papier",
+    "papoter",
+    "papyrus",
+    "paradoxe",
+    "parcelle",
+    "paresse",
+    "parfumer",
+    "parler",
+    "parole",
+    "parrain",
+    "parsemer",
+    "partager",
+    "parure",
+    "parvenir",
+    "passion",
+    "pastèque",
+    "paternel",
+    "patience",
+    "patron",
+    "pavillon",
+    "pavoiser",
+    "payer",
+    "paysage",
+    "peigne",
+    "peintre",
+    "pelage",
+    "pélican",
+    "pelle",
+    "pelouse",
+    "peluche",
+    "pendule",
+    "pénétrer",
+    "pénible",
+    "pensif",
+    "pénurie",
+    "pépite",
+    "péplum",
+    "perdrix",
+    "perforer",
+    "période",
+    "permuter",
+    "perplexe",
+    "persil",
+    "perte",
+    "peser",
+    "pétale",
+    "petit",
+    "pétrir",
+    "peuple",
+    "pharaon",
+    "phobie",
+    "phoque",
+    "photon",
+    "phrase",
+    "physique",
+    "piano",
+    "pictural",
+    "pièce",
+    "pierre",
+    "pieuvre",
+    "pilote",
+    "pinceau",
+    "pipette",
+    "piquer",
+    "pirogue",
+    "piscine",
+    "piston",
+    "pivoter",
+    "pixel",
+    "pizza",
+    "placard",
+    "plafond",
+    "plaisir",
+    "planer",
+    "plaque",
+    "plastron",
+    "plateau",
+    "pleurer",
+    "plexus",
+    "pliage",
+    "plomb",
+    "plonger",
+    "pluie",
+    "plumage",
+    "pochette",
+    "poésie",
+    "poète",
+    "pointe",
+    "poirier",
+    "poisson",
+    "poivre",
+    "polaire",
+    "policier",
+    "pollen",
+    "polygone",
+    "pommade",
+    "pompier",
+    "ponctuel",
+    "pondérer",
+    "poney",
+    "portique",
+    "position",
+    "posséder",
+    "posture",
+    "potager",
+    "poteau",
+    "potion",
+    "pouce",
+    "poulain",
+    "poumon",
+    "pourpre",
+    "poussin",
+    "pouvoir",
+    "prairie",
+    "pratique",
+    "précieux",
+    "prédire",
+    "préfixe",
+    "prélude",
+    "prénom",
+    "présence",
+    "prétexte",
+    "prévoir",
+    "primitif",
+    "prince",
+    "prison",
+    "priver",
+    "problème",
+    "procéder",
+    "prodige",
+    "profond",
+    "progrès",
+    "proie",
+    "projeter",
+    "prologue",
+    "promener",
+    "propre",
+    "prospère",
+    "protéger",
+    "prouesse",
+    "proverbe",
+    "prudence",
+    "pruneau",
+    "psychose",
+    "public",
+    "puceron",
+    "puiser",
+    "pulpe",
+    "pulsar",
+    "punaise",
+    "punitif",
+    "pupitre",
+    "purifier",
+    "puzzle",
+    "pyramide",
+    "quasar",
+    "querelle",
+    "question",
+    "quiétude",
+    "quitter",
+    "quotient",
+    "racine",
+    "raconter",
+    "radieux",
+    "ragondin",
+    "raideur",
+    "raisin",
+    "ralentir",
+    "rallonge",
+    "ramasser",
+    "rapide",
+    "rasage",
+    "ratisser",
+    "ravager",
+    "ravin",
+    "rayonner",
+    "réactif",
+    "réagir",
+    "réaliser",
+    "réanimer",
+    "recevoir",
+    "réciter",
+    "réclamer",
+    "récolter",
+    "recruter",
+    "reculer",
+    "recycler",
+    "rédiger",
+    "redouter",
+    "refaire",
+    "réflexe",
+    "réformer",
+    "refrain",
+    "refuge",
+    "régalien",
+    "région",
+    "réglage",
+    "régulier",
+    "réitérer",
+    "rejeter",
+    "rejouer",
+    "relatif",
+    "relever",
+    "relief",
+    "remarque",
+    "remède",
+    "remise",
+    "remonter",
+    "remplir",
+    "remuer",
+    "renard",
+    "renfort",
+    "renifler",
+    "renoncer",
+    "rentrer",
+    "renvoi",
+    "replier",
+    "reporter",
+    "reprise",
+    "reptile",
+    "requin",
+    "réserve",
+    "résineux",
+    "résoudre",
+    "respect",
+    "rester",
+    "résultat",
+    "rétablir",
+    "retenir",
+    "réticule",
+    "retomber",
+    "retracer",
+    "réunion",
+    "réussir",
+    "revanche",
+    "revivre",
+    "révolte",
+    "révulsif",
+    "richesse",
+    "rideau",
+    "rieur",
+    "rigide",
+    "rigoler",
+    "rincer",
+    "riposter",
+    "risible",
+    "risque",
+    "rituel",
+    "rival",
+    "rivière",
+    "rocheux",
+    "romance",
+    "rompre",
+    "ronce",
+    "rondin",
+    "roseau",
+    "rosier",
+    "rotatif",
+    "rotor",
+    "rotule",
+    "rouge",
+    "rouille",
+    "rouleau",
+    "routine",
+    "royaume",
+    "ruban",
+    "rubis",
+    "ruche",
+    "ruelle",
+    "rugueux",
+    "ruiner",
+    "ruisseau",
+    "ruser",
+    "rustique",
+    "rythme",
+    "sabler",
+    "saboter",
+    "sabre",
+    "sacoche",
+    "safari",
+    "sagesse",
+    "saisir",
+    "salade",
+    "salive",
+    "salon",
+    "saluer",
+    "samedi",
+    "sanction",
+    "sanglier",
+    "sarcasme",
+    "sardine",
+    "saturer",
+    "saugrenu",
+    "saumon",
+    "sauter",
+    "sauvage",
+    "savant",
+    "savonner",
+    "scalpel",
+    "scandale",
+    "scélérat",
+    "scénario",
+    "sceptre",
+    "schéma",
+    "science",
+    "scinder",
+    "score",
+    "scrutin",
+    "sculpter",
+    "séance",
+    "sécable",
+    "sécher",
+    "secouer",
+    "sécréter",
+    "sédatif",
+    "séduire",
+    "seigneur",
+    "séjour",
+    "sélectif",
+    "semaine",
+    "sembler",
+    "semence",
+    "séminal",
+    "sénateur",
+    "sensible",
+    "sentence",
+    "séparer",
+    "séquence",
+    "serein",
+    "sergent",
+    "sérieux",
+    "serrure",
+    "sérum",
+    "service",
+    "sésame",
+    "sévir",
+    "sevrage",
+    "sextuple",
+    "sidéral",
+    "siècle",
+    "siéger",
+    "siffler",
+    "sigle",
+    "signal",
+    "silence",
+    "silicium",
+    "simple",
+    "sincère",
+    "sinistre",
+    "siphon",
+    "sirop",
+    "sismique",
+    "situer",
+    "skier",
+    "social",
+    "socle",
+    "sodium",
+    "soigneux",
+    "soldat",
+    "soleil",
+    "solitude",
+    "soluble",
+    "sombre",
+    "sommeil",
+    "somnoler",
+    "sonde",
+    "songeur",
+    "sonnette",
+    "sonore",
+    "sorcier",
+    "sortir",
+    "sosie",
+    "sottise",
+    "soucieux",
+    "soudure",
+    "souffle",
+    "soulever",
+    "soupape",
+    "source",
+    "soutirer",
+    "souvenir",
+    "spacieux",
+    "spatial",
+    "spécial",
+    "sphère",
+    "spiral",
+    "stable",
+    "station",
+    "sternum",
+    "stimulus",
+    "stipuler",
+    "strict",
+    "studieux",
+    "stupeur",
+    "styliste",
+    "sublime",
+    "substrat",
+    "subtil",
+    "subvenir",
+    "succès",
+    "sucre",
+    "suffixe",
+    "suggérer",
+    "suiveur",
+    "sulfate",
+    "superbe",
+    "supplier",
+    "surface",
+    "suricate",
+    "surmener",
+    "surprise",
+    "sursaut",
+    "survie",
+    "suspect",
+    "syllabe",
+    "symbole",
+    "symétrie",
+    "synapse",
+    "syntaxe",
+    "système",
+    "tabac",
+    "tablier",
+    "tactile",
+    "tailler",
+    "talent",
+    "talisman",
+    "talonner",
+    "tambour",
+    "tamiser",
+    "tangible",
+    "tapis",
+    "taquiner",
+    "tarder",
+    "tarif",
+    "tartine",
+    "tasse",
+    "tatami",
+    "tatouage",
+    "taupe",
+    "taureau",
+    "taxer",
+    "témoin",
+    "temporel",
+    "tenaille",
+    "tendre",
+    "teneur",
+    "tenir",
+    "tension",
+    "terminer",
+    "terne",
+    "terrible",
+    "tétine",
+    "texte",
+    "thème",
+    "théorie",
+    "thérapie",
+    "thorax",
+    "tibia",
+    "tiède",
+    "timide",
+    "tirelire",
+    "tiroir",
+    "tissu",
+    "titane",
+    "titre",
+    "tituber",
+    "toboggan",
+    "tolérant",
+    "tomate",
+    "tonique",
+    "tonneau",
+    "toponyme",
+    "torche",
+    "tordre",
+    "tornade",
+    "torpille",
+    "torrent",
+    "torse",
+    "tortue",
+    "totem",
+    "toucher",
+    "tournage",
+    "tousser",
+    "toxine",
+    "traction",
+    "trafic",
+    "tragique",
+    "trahir",
+    "train",
+    "trancher",
+    "travail",
+    "trèfle",
+    "tremper",
+    "trésor",
+    "treuil",
+    "triage",
+    "tribunal",
+    "tricoter",
+    "trilogie",
+    "triomphe",
+    "tripler",
+    "triturer",
+    "trivial",
+    "trombone",
+    "tronc",
+    "tropical",
+    "troupeau",
+    "tuile",
+    "tulipe",
+    "tumulte",
+    "tunnel",
+    "turbine",
+    "tuteur",
+    "tutoyer",
+    "tuyau",
+    "tympan",
+    "typhon",
+    "typique",
+    "tyran",
+    "ubuesque",
+    "ultime",
+    "ultrason",
+    "unanime",
+    "unifier",
+    "union",
+    "unique",
+    "unitaire",
+    "univers",
+    "uranium",
+    "urbain",
+    "urticant",
+    "usage",
+    "usine",
+    "usuel",
+    "usure",
+    "utile",
+    "utopie",
+    "vacarme",
+    "vaccin",
+    "vagabond",
+    "vague",
+    "vaillant",
+    "vaincre",
+    "vaisseau",
+    "valable",
+    "valise",
+    "vallon",
+    "valve",
+    "vampire",
+    "vanille",
+    "vapeur",
+    "varier",
+    "vaseux",
+    "vassal",
+    "vaste",
+    "vecteur",
+    "vedette",
+    "végétal",
+    "véhicule",
+    "veinard",
+    "véloce",
+    "vendredi",
+    "vénérer",
+    "venger",
+    "venimeux",
+    "ventouse",
+    "verdure",
+    "vérin",
+    "vernir",
+    "verrou",
+    "verser",
+    "vertu",
+    "veston",
+    "vétéran",
+    "vétuste",
+    "vexant",
+    "vexer",
+    "viaduc",
+    "viande",
+    "victoire",
+    "vidange",
+    "vidéo",
+    "vignette",
+    "vigueur",
+    "vilain",
+    "village",
+    "vinaigre",
+    "violon",
+    "vipère",
+    "virement",
+    "virtuose",
+    "virus",
+    "visage",
+    "viseur",
+    "vision",
+    "visqueux",
+    "visuel",
+    "vital",
+    "vitesse",
+    "viticole",
+    "vitrine",
+    "vivace",
+    "vivipare",
+    "vocation",
+    "voguer",
+    "voile",
+    "voisin",
+    "voiture",
+    "volaille",
+    "volcan",
+    "voltiger",
+    "volume",
+    "vorace",
+    "vortex",
+    "voter",
+    "vouloir",
+    "voyage",
+    "voyelle",
+    "wagon",
+    "xénon",
+    "yacht",
+    "zèbre",
+    "zénith",
+    "zeste",
+    "zoologie",
+}
+var rwords_french = map[string]int16{
+    "abaisser": 0,
+    "abandon": 1,
+    "abdiquer": 2,
+    "abeille": 3,
+    "abolir": 4,
+    "aborder": 5,
+    "aboutir": 6,
+    "aboyer": 7,
+    "abrasif": 8,
+    "abreuver": 9,
+    "abriter": 10,
+    "abroger": 11,
+    "abrupt": 12,
+    "absence": 13,
+    "absolu": 14,
+    "absurde": 15,
+    "abusif": 16,
+    "abyssal": 17,
+    "académie": 18,
+    "acajou": 19,
+    "acarien": 20,
+    "accabler": 21,
+    "accepter": 22,
+    "acclamer": 23,
+    "accolade": 24,
+    "accroche": 25,
+    "accuser": 26,
+    "acerbe": 27,
+    "achat": 28,
+    "acheter": 29,
+    "aciduler": 30,
+    "acier": 31,
+    "acompte": 32,
+    "acquérir": 33,
+    "acronyme": 34,
+    "acteur": 35,
+    "actif": 36,
+    "actuel": 37,
+    "adepte": 38,
+    "adéquat": 39,
+    "adhésif": 40,
+    "adjectif": 41,
+    "adjuger": 42,
+    "admettre": 43,
+    "admirer": 44,
+    "adopter": 45,
+    "adorer": 46,
+    "adoucir": 47,
+    "adresse": 48,
+    "adroit": 49,
+    "adulte": 50,
+    "adverbe": 51,
+    "aérer": 52,
+    "aéronef": 53,
+    "affaire": 54,
+    "affecter": 55,
+    "affiche": 56,
+    "affreux": 57,
+    "affubler": 58,
+    "agacer": 59,
+    "agencer": 60,
+    "agile": 61,
+    "agiter": 62,
+    "agrafer": 63,
+    "agréable": 64,
+    "agrume": 65,
+    "aider": 66,
+    "aiguille": 67,
+    "ailier": 68,
+    "aimable": 69,
+    "aisance": 70,
+    "ajouter": 71,
+    "ajuster": 72,
+    "alarmer": 73,
+    "alchimie": 74,
+    "alerte": 75,
+    "algèbre": 76,
+    "algue": 77,
+    "aliéner": 78,
+    "aliment": 79,
+    "alléger": 80,
+    "alliage": 81,
+    "allouer": 82,
+    "allumer": 83,
+    "alourdir": 84,
+    "alpaga": 85,
+    "altesse": 86,
+    "alvéole": 87,
+    "amateur": 88,
+    "ambigu": 89,
+    "ambre": 90,
+    "aménager": 91,
+    "amertume": 92,
+    "amidon": 93,
+    "amiral": 94,
+    "amorcer": 95,
+    "amour": 96,
+    "amovible": 97,
+    "amphibie": 98,
+    "ampleur": 99,
+    "amusant": 100,
+    "analyse": 101,
+    "anaphore": 102,
+    "anarchie": 103,
+    "anatomie": 104,
+    "ancien": 105,
+    "anéantir": 106,
+    "angle": 107,
+    "angoisse": 108,
+    "anguleux": 109,
+    "animal": 110,
+    "annexer": 111,
+    "annonce": 112,
+    "annuel": 113,
+    "anodin": 114,
+    "anomalie": 115,
+    "anonyme": 116,
+    "anormal": 117,
+    "antenne": 118,
+    "antidote": 119,
+    "anxieux": 120,
+    "apaiser": 121,
+    "apéritif": 122,
+    "aplanir": 123,
+    "apologie": 124,
+    "appareil": 125,
+    "appeler": 126,
+    "apporter": 127,
+    "appuyer": 128,
+    "aquarium": 129,
+    "aqueduc": 130,
+    "arbitre": 131,
+    "arbuste": 132,
+    "ardeur": 133,
+    "ardoise": 134,
+    "argent": 135,
+    "arlequin": 136,
+    "armature": 137,
+    "armement": 138,
+    "armoire": 139,
+    "armure": 140,
+    "arpenter": 141,
+    "arracher": 142,
+    "arriver": 143,
+    "arroser": 144,
+    "arsenic": 145,
+    "artériel": 146,
+    "article": 147,
+    "aspect": 148,
+    "asphalte": 149,
+    "aspirer": 150,
+    "assaut": 151,
+    "asservir": 152,
+    "assiette": 153,
+    "associer": 154,
+    "assurer": 155,
+    "asticot": 156,
+    "astre": 157,
+    "astuce": 158,
+    "atelier": 159,
+    "atome": 160,
+    "atrium": 161,
+    "atroce": 162,
+    "attaque": 163,
+    "attentif": 164,
+    "attirer": 165,
+    "attraper": 166,
+    "aubaine": 167,
+    "auberge": 168,
+    "audace": 169,
+    "audible": 170,
+    "augurer": 171,
+    "aurore": 172,
+    "automne": 173,
+    "autruche": 174,
+    "avaler": 175,
+    "avancer": 176,
+    "avarice": 177,
+    "avenir": 178,
+    "averse": 179,
+    "aveugle": 180,
+    "aviateur": 181,
+    "avide": 182,
+    "avion": 183,
+    "aviser": 184,
+    "avoine": 185,
+    "avouer": 186,
+    "avril": 187,
+    "axial": 188,
+    "axiome": 189,
+    "badge": 190,
+    "bafouer": 191,
+    "bagage": 192,
+    "baguette": 193,
+    "baignade": 194,
+    "balancer": 195,
+    "balcon": 196,
+    "baleine": 197,
+    "balisage": 198,
+    "bambin": 199,
+    "bancaire": 200,
+    "bandage": 201,
+    "banlieue": 202,
+    "bannière": 203,
+    "banquier": 204,
+    "barbier": 205,
+    "baril": 206,
+    "baron": 207,
+    "barque": 208,
+    "barrage": 209,
+    "bassin": 210,
+    "bastion": 211,
+    "bataille": 212,
+    "bateau": 213,
+    "batterie": 214,
+    "baudrier": 215,
+    "bavarder": 216,
+    "belette": 217,
+    "bélier": 218,
+    "belote": 219,
+    "bénéfice": 220,
+    "berceau": 221,
+    "berger": 222,
+    "berline": 223,
+    "bermuda": 224,
+    "besace": 225,
+    "besogne": 226,
+    "bétail": 227,
+    "beurre": 228,
+    "biberon": 229,
+    "bicycle": 230,
+    "bidule": 231,
+    "bijou": 232,
+    "bilan": 233,
+    "bilingue": 234,
+    "billard": 235,
+    "binaire": 236,
+    "biologie": 237,
+    "biopsie": 238,
+    "biotype": 239,
+    "biscuit": 240,
+    "bison": 241,
+    "bistouri": 242,
+    "bitume": 243,
+    "bizarre": 244,
+    "blafard": 245,
+    "blague": 246,
+    "blanchir": 247,
+    "blessant": 248,
+    "blinder": 249,
+    "blond": 250,
+    "bloquer": 251,
+    "blouson": 252,
+    "bobard": 253,
+    "bobine": 254,
+    "boire": 255,
+    "boiser": 256,
+    "bolide": 257,
+    "bonbon": 258,
+    "bondir": 259,
+    "bonheur": 260,
+    "bonifier": 261,
+    "bonus": 262,
+    "bordure": 263,
+    "borne": 264,
+    "botte": 265,
+    "boucle": 266,
+    "boueux": 267,
+    "bougie": 268,
+    "boulon": 269,
+    "bouquin": 270,
+    "bourse": 271,
+    "boussole": 272,
+    "boutique": 273,
+    "boxeur": 274,
+    "branche": 275,
+    "brasier": 276,
+    "brave": 277,
+    "brebis": 278,
+    "brèche": 279,
+    "breuvage": 280,
+    "bricoler": 281,
+    "brigade": 282,
+    "brillant": 283,
+    "brioche": 284,
+    "brique": 285,
+    "brochure": 286,
+    "broder": 287,
+    "bronzer": 288,
+    "brousse": 289,
+    "broyeur": 290,
+    "brume": 291,
+    "brusque": 292,
+    "brutal": 293,
+    "bruyant": 294,
+    "buffle": 295,
+    "buisson": 296,
+    "bulletin": 297,
+    "bureau": 298,
+    "burin": 299,
+    "bustier": 300,
+    "butiner": 301,
+    "butoir": 302,
+    "buvable": 303,
+    "buvette": 304,
+    "cabanon": 305,
+    "cabine": 306,
+    "cachette": 307,
+    "cadeau": 308,
+    "cadre": 309,
+    "caféine": 310,
+    "caillou": 311,
+    "caisson": 312,
+    "calculer": 313,
+    "calepin": 314,
+    "calibre": 315,
+    "calmer": 316,
+    "calomnie": 317,
+    "calvaire": 318,
+    "camarade": 319,
+    "caméra": 320,
+    "camion": 321,
+    "campagne": 322,
+    "canal": 323,
+    "caneton": 324,
+    "canon": 325,
+    "cantine": 326,
+    "canular": 327,
+    "capable": 328,
+    "caporal": 329,
+    "caprice": 330,
+    "capsule": 331,
+    "capter": 332,
+    "capuche": 333,
+    "carabine": 334,
+    "carbone": 335,
+    "caresser": 336,
+    "caribou": 337,
+    "carnage": 338,
+    "carotte": 339,
+    "carreau": 340,
+    "carton": 341,
+    "cascade": 342,
+    "casier": 343,
+    "casque": 344,
+    "cassure": 345,
+    "causer": 346,
+    "caution": 347,
+    "cavalier": 348,
+    "caverne": 349,
+    "caviar": 350,
+    "cédille": 351,
+    "ceinture": 352,
+    "céleste": 353,
+    "cellule": 354,
+    "cendrier": 355,
+    "censurer": 356,
+    "central": 357,
+    "cercle": 358,
+    "cérébral": 359,
+    "cerise": 360,
+    "cerner": 361,
+    "cerveau": 362,
+    "cesser": 363,
+    "chagrin": 364,
+    "chaise": 365,
+    "chaleur": 366,
+    "chambre": 367,
+    "chance": 368,
+    "chapitre": 369,
+    "charbon": 370,
+    "chasseur": 371,
+    "chaton": 372,
+    "chausson": 373,
+    "chavirer": 374,
+    "chemise": 375,
+    "chenille": 376,
+    "chéquier": 377,
+    "chercher": 378,
+    "cheval": 379,
+    "chien": 380,
+    "chiffre": 381,
+    "chignon": 382,
+    "chimère": 383,
+    "chiot": 384,
+    "chlorure": 385,
+    "chocolat": 386,
+    "choisir": 387,
+    "chose": 388,
+    "chouette": 389,
+    "chrome": 390,
+    "chute": 391,
+    "cigare": 392,
+    "cigogne": 393,
+    "cimenter": 394,
+    "cinéma": 395,
+    "cintrer": 396,
+    "circuler": 397,
+    "cirer": 398,
+    "cirque": 399,
+    "citerne": 400,
+    "citoyen": 401,
+    "citron": 402,
+    "civil": 403,
+    "clairon": 404,
+    "clameur": 405,
+    "claquer": 406,
+    "classe": 407,
+    "clavier": 408,
+    "client": 409,
+    "cligner": 410,
+    "climat": 411,
+    "clivage": 412,
+    "cloche": 413,
+    "clonage": 414,
+    "cloporte": 415,
+    "cobalt": 416,
+    "cobra": 417,
+    "cocasse": 418,
+    "cocotier": 419,
+    "coder": 420,
+    "codifier": 421,
+    "coffre": 422,
+    "cogner": 423,
+    "cohésion": 424,
+    "coiffer": 425,
+    "coincer": 426,
+    "colère": 427,
+    "colibri": 428,
+    "colline": 429,
+    "colmater": 430,
+    "colonel": 431,
+    "combat": 432,
+    "comédie": 433,
+    "commande": 434,
+    "compact": 435,
+    "concert": 436,
+    "conduire": 437,
+    "confier": 438,
+    "congeler": 439,
+    "connoter": 440,
+    "consonne": 441,
+    "contact": 442,
+    "convexe": 443,
+    "copain": 444,
+    "copie": 445,
+    "corail": 446,
+    "corbeau": 447,
+    "cordage": 448,
+    "corniche": 449,
+    "corpus": 450,
+    "correct": 451,
+    "cortège": 452,
+    "cosmique": 453,
+    "costume": 454,
+    "coton": 455,
+    "coude": 456,
+    "coupure": 457,
+    "courage": 458,
+    "couteau": 459,
+    "couvrir": 460,
+    "coyote": 461,
+    "crabe": 462,
+    "crainte": 463,
+    "cravate": 464,
+    "crayon": 465,
+    "créature": 466,
+    "créditer": 467,
+    "crémeux": 468,
+    "creuser": 469,
+    "crevette": 470,
+    "cribler": 471,
+    "crier": 472,
+    "cristal": 473,
+    "critère": 474,
+    "croire": 475,
+    "croquer": 476,
+    "crotale": 477,
+    "crucial": 478,
+    "cruel": 479,
+    "crypter": 480,
+    "cubique": 481,
+    "cueillir": 482,
+    "cuillère": 483,
+    "cuisine": 484,
+    "cuivre": 485,
+    "culminer": 486,
+    "cultiver": 487,
+    "cumuler": 488,
+    "cupide": 489,
+    "curatif": 490,
+    "curseur": 491,
+    "cyanure": 492,
+    "cycle": 493,
+    "cylindre": 494,
+    "cynique": 495,
+    "daigner": 496,
+    "damier": 497,
+    "danger": 498,
+    "danseur": 499,
+    "dauphin": 500,
+    "débattre": 501,
+    "débiter": 502,
+    "déborder": 503,
+    "débrider": 504,
+    "débutant": 505,
+    "décaler": 506,
+    "décembre": 507,
+    "déchirer": 508,
+    "décider": 509,
+    "déclarer": 510,
+    "décorer": 511,
+    "décrire": 512,
+    "décupler": 513,
+    "dédale": 514,
+    "déductif": 515,
+    "déesse": 516,
+    "défensif": 517,
+    "défiler": 518,
+    "défrayer": 519,
+    "dégager": 520,
+    "dégivrer": 521,
+    "déglutir": 522,
+    "dégrafer": 523,
+    "déjeuner": 524,
+    "délice": 525,
+    "déloger": 526,
+    "demander": 527,
+    "demeurer": 528,
+    "démolir": 529,
+    "dénicher": 530,
+    "dénouer": 531,
+    "dentelle": 532,
+    "dénuder": 533,
+    "départ": 534,
+    "dépenser": 535,
+    "déphaser": 536,
+    "déplacer": 537,
+    "déposer": 538,
+    "déranger": 539,
+    "dérober": 540,
+    "désastre": 541,
+    "descente": 542,
+    "désert": 543,
+    "désigner": 544,
+    "désobéir": 545,
+    "dessiner": 546,
+    "destrier": 547,
+    "détacher": 548,
+    "détester": 549,
+    "détourer": 550,
+    "détresse": 551,
+    "devancer": 552,
+    "devenir": 553,
+    "deviner": 554,
+    "devoir": 555,
+    "diable": 556,
+    "dialogue": 557,
+    "diamant": 558,
+    "dicter": 559,
+    "différer": 560,
+    "digérer": 561,
+    "digital": 562,
+    "digne": 563,
+    "diluer": 564,
+    "dimanche": 565,
+    "diminuer": 566,
+    "dioxyde": 567,
+    "directif": 568,
+    "diriger": 569,
+    "discuter": 570,
+    "disposer": 571,
+    "dissiper": 572,
+    "distance": 573,
+    "divertir": 574,
+    "diviser": 575,
+    "docile": 576,
+    "docteur": 577,
+    "dogme": 578,
+    "doigt": 579,
+    "domaine": 580,
+    "domicile": 581,
+    "dompter": 582,
+    "donateur": 583,
+    "donjon": 584,
+    "donner": 585,
+    "dopamine": 586,
+    "dortoir": 587,
+    "dorure": 588,
+    "dosage": 589,
+    "doseur": 590,
+    "dossier": 591,
+    "dotation": 592,
+    "douanier": 593,
+    "double": 594,
+    "douceur": 595,
+    "douter": 596,
+    "doyen": 597,
+    "dragon": 598,
+    "draper": 599,
+    "dresser": 600,
+    "dribbler": 601,
+    "droiture": 602,
+    "duperie": 603,
+    "duplexe": 604,
+    "durable": 605,
+    "durcir": 606,
+    "dynastie": 607,
+    "éblouir": 608,
+    "écarter": 609,
+    "écharpe": 610,
+    "échelle": 611,
+    "éclairer": 612,
+    "éclipse": 613,
+    "éclore": 614,
+    "écluse": 615,
+    "école": 616,
+    "économie": 617,
+    "écorce": 618,
+    "écouter": 619,
+    "écraser": 620,
+    "écrémer": 621,
+    "écrivain": 622,
+    "écrou": 623,
+    "écume": 624,
+    "écureuil": 625,
+    "édifier": 626,
+    "éduquer": 627,
+    "effacer": 628,
+    "effectif": 629,
+    "effigie": 630,
+    "effort": 631,
+    "effrayer": 632,
+    "effusion": 633,
+    "égaliser": 634,
+    "égarer": 635,
+    "éjecter": 636,
+    "élaborer": 637,
+    "élargir": 638,
+    "électron": 639,
+    "élégant": 640,
+    "éléphant": 641,
+    "élève": 642,
+    "éligible": 643,
+    "élitisme": 644,
+    "éloge": 645,
+    "élucider": 646,
+    "éluder": 647,
+    "emballer": 648,
+    "embellir": 649,
+    "embryon": 650,
+    "émeraude": 651,
+    "émission": 652,
+    "emmener": 653,
+    "émotion": 654,
+    "émouvoir": 655,
+    "empereur": 656,
+    "employer": 657,
+    "emporter": 658,
+    "emprise": 659,
+    "émulsion": 660,
+    "encadrer": 661,
+    "enchère": 662,
+    "enclave": 663,
+    "encoche": 664,
+    "endiguer": 665,
+    "endosser": 666,
+    "endroit": 667,
+    "enduire": 668,
+    "énergie": 669,
+    "enfance": 670,
+    "enfermer": 671,
+    "enfouir": 672,
+    "engager": 673,
+    "engin": 674,
+    "englober": 675,
+    "énigme": 676,
+    "enjamber": 677,
+    "enjeu": 678,
+    "enlever": 679,
+    "ennemi": 680,
+    "ennuyeux": 681,
+    "enrichir": 682,
+    "enrobage": 683,
+    "enseigne": 684,
+    "entasser": 685,
+    "entendre": 686,
+    "entier": 687,
+    "entourer": 688,
+    "entraver": 689,
+    "énumérer": 690,
+    "envahir": 691,
+    "enviable": 692,
+    "envoyer": 693,
+    "enzyme": 694,
+    "éolien": 695,
+    "épaissir": 696,
+    "épargne": 697,
+    "épatant": 698,
+    "épaule": 699,
+    "épicerie": 700,
+    "épidémie": 701,
+    "épier": 702,
+    "épilogue": 703,
+    "épine": 704,
+    "épisode": 705,
+    "épitaphe": 706,
+    "époque": 707,
+    "épreuve": 708,
+    "éprouver": 709,
+    "épuisant": 710,
+    "équerre": 711,
+    "équipe": 712,
+    "ériger": 713,
+    "érosion": 714,
+    "erreur": 715,
+    "éruption": 716,
+    "escalier": 717,
+    "espadon": 718,
+    "espèce": 719,
+    "espiègle": 720,
+    "espoir": 721,
+    "esprit": 722,
+    "esquiver": 723,
+    "essayer": 724,
+    "essence": 725,
+    "essieu": 726,
+    "essorer": 727,
+    "estime": 728,
+    "estomac": 729,
+    "estrade": 730,
+    "étagère": 731,
+    "étaler": 732,
+    "étanche": 733,
+    "étatique": 734,
+    "éteindre": 735,
+    "étendoir": 736,
+    "éternel": 737,
+    "éthanol": 738,
+    "éthique": 739,
+    "ethnie": 740,
+    "étirer": 741,
+    "étoffer": 742,
+    "étoile": 743,
+    "étonnant": 744,
+    "étourdir": 745,
+    "étrange": 746,
+    "étroit": 747,
+    "étude": 748,
+    "euphorie": 749,
+    "évaluer": 750,
+    "évasion": 751,
+    "éventail": 752,
+    "évidence": 753,
+    "éviter": 754,
+    "évolutif": 755,
+    "évoquer": 756,
+    "exact": 757,
+    "exagérer": 758,
+    "exaucer": 759,
+    "exceller": 760,
+    "excitant": 761,
+    "exclusif": 762,
+    "excuse": 763,
+    "exécuter": 764,
+    "exemple": 765,
+    "exercer": 766,
+    "exhaler": 767,
+    "exhorter": 768,
+    "exigence": 769,
+    "exiler": 770,
+    "exister": 771,
+    "exotique": 772,
+    "expédier": 773,
+    "explorer": 774,
+    "exposer": 775,
+    "exprimer": 776,
+    "exquis": 777,
+    "extensif": 778,
+    "extraire": 779,
+    "exulter": 780,
+    "fable": 781,
+    "fabuleux": 782,
+    "facette": 783,
+    "facile": 784,
+    "facture": 785,
+    "faiblir": 786,
+    "falaise": 787,
+    "fameux": 788,
+    "famille": 789,
+    "farceur": 790,
+    "farfelu": 791,
+    "farine": 792,
+    "farouche": 793,
+    "fasciner": 794,
+    "fatal": 795,
+    "fatigue": 796,
+    "faucon": 797,
+    "fautif": 798,
+    "faveur": 799,
+    "favori": 800,
+    "fébrile": 801,
+    "féconder": 802,
+    "fédérer": 803,
+    "félin": 804,
+    "femme": 805,
+    "fémur": 806,
+    "fendoir": 807,
+    "féodal": 808,
+    "fermer": 809,
+    "féroce": 810,
+    "ferveur": 811,
+    "festival": 812,
+    "feuille": 813,
+    "feutre": 814,
+    "février": 815,
+    "fiasco": 816,
+    "ficeler": 817,
+    "fictif": 818,
+    "fidèle": 819,
+    "figure": 820,
+    "filature": 821,
+    "filetage": 822,
+    "filière": 823,
+    "filleul": 824,
+    "filmer": 825,
+    "filou": 826,
+    "filtrer": 827,
+    "financer": 828,
+    "finir": 829,
+    "fiole": 830,
+    "firme": 831,
+    "fissure": 832,
+    "fixer": 833,
+    "flairer": 834,
+    "flamme": 835,
+    "flasque": 836,
+    "flatteur": 837,
+    "fléau": 838,
+    "flèche": 839,
+    "fleur": 840,
+    "flexion": 841,
+    "flocon": 842,
+    "flore": 843,
+    "fluctuer": 844,
+    "fluide": 845,
+    "fluvial": 846,
+    "folie": 847,
+    "fonderie": 848,
+    "fongible": 849,
+    "fontaine": 850,
+    "forcer": 851,
+    "forgeron": 852,
+    "formuler": 853,
+    "fortune": 854,
+    "fossile": 855,
+    "foudre": 856,
+    "fougère": 857,
+    "fouiller": 858,
+    "foulure": 859,
+    "fourmi": 860,
+    "fragile": 861,
+    "fraise": 862,
+    "franchir": 863,
+    "frapper": 864,
+    "frayeur": 865,
+    "frégate": 866,
+    "freiner": 867,
+    "frelon": 868,
+    "frémir": 869,
+    "frénésie": 870,
+    "frère": 871,
+    "friable": 872,
+    "friction": 873,
+    "frisson": 874,
+    "frivole": 875,
+    "froid": 876,
+    "fromage": 877,
+    "frontal": 878,
+    "frotter": 879,
+    "fruit": 880,
+    "fugitif": 881,
+    "fuite": 882,
+    "fureur": 883,
+    "furieux": 884,
+    "furtif": 885,
+    "fusion": 886,
+    "futur": 887,
+    "gagner": 888,
+    "galaxie": 889,
+    "galerie": 890,
+    "gambader": 891,
+    "garantir": 892,
+    "gardien": 893,
+    "garnir": 894,
+    "garrigue": 895,
+    "gazelle": 896,
+    "gazon": 897,
+    "géant": 898,
+    "gélatine": 899,
+    "gélule": 900,
+    "gendarme": 901,
+    "général": 902,
+    "génie": 903,
+    "genou": 904,
+    "gentil": 905,
+    "géologie": 906,
+    "géomètre": 907,
+    "géranium": 908,
+    "germe": 909,
+    "gestuel": 910,
+    "geyser": 911,
+    "gibier": 912,
+    "gicler": 913,
+    "girafe": 914,
+    "givre": 915,
+    "glace": 916,
+    "glaive": 917,
+    "glisser": 918,
+    "globe": 919,
+    "gloire": 920,
+    "glorieux": 921,
+    "golfeur": 922,
+    "gomme": 923,
+    "gonfler": 924,
+    "gorge": 925,
+    "gorille": 926,
+    "goudron": 927,
+    "gouffre": 928,
+    "goulot": 929,
+    "goupille": 930,
+    "gourmand": 931,
+    "goutte": 932,
+    "graduel": 933,
+    "graffiti": 934,
+    "graine": 935,
+    "grand": 936,
+    "grappin": 937,
+    "gratuit": 938,
+    "gravir": 939,
+    "grenat": 940,
+    "griffure": 941,
+    "griller": 942,
+    "grimper": 943,
+    "grogner": 944,
+    "gronder": 945,
+    "grotte": 946,
+    "groupe": 947,
+    "gruger": 948,
+    "grutier": 949,
+    "gruyère": 950,
+    "guépard": 951,
+    "guerrier": 952,
+    "guide": 953,
+    "guimauve": 954,
+    "guitare": 955,
+    "gustatif": 956,
+    "gymnaste": 957,
+    "gyrostat": 958,
+    "habitude": 959,
+    "hachoir": 960,
+    "halte": 961,
+    "hameau": 962,
+    "hangar": 963,
+    "hanneton": 964,
+    "haricot": 965,
+    "harmonie": 966,
+    "harpon": 967,
+    "hasard": 968,
+    "hélium": 969,
+    "hématome": 970,
+    "herbe": 971,
+    "hérisson": 972,
+    "hermine": 973,
+    "héron": 974,
+    "hésiter": 975,
+    "heureux": 976,
+    "hiberner": 977,
+    "hibou": 978,
+    "hilarant": 979,
+    "histoire": 980,
+    "hiver": 981,
+    "homard": 982,
+    "hommage": 983,
+    "homogène": 984,
+    "honneur": 985,
+    "honorer": 986,
+    "honteux": 987,
+    "horde": 988,
+    "horizon": 989,
+    "horloge": 990,
+    "hormone": 991,
+    "horrible": 992,
+    "houleux": 993,
+    "housse": 994,
+    "hublot": 995,
+    "huileux": 996,
+    "humain": 997,
+    "humble": 998,
+    "humide": 999,
+    "humour": 1000,
+    "hurler": 1001,
+    "hydromel": 1002,
+    "hygiène": 1003,
+    "hymne": 1004,
+    "hypnose": 1005,
+    "idylle": 1006,
+    "ignorer": 1007,
+    "iguane": 1008,
+    "illicite": 1009,
+    "illusion": 1010,
+    "image": 1011,
+    "imbiber": 1012,
+    "imiter": 1013,
+    "immense": 1014,
+    "immobile": 1015,
+    "immuable": 1016,
+    "impact": 1017,
+    "impérial": 1018,
+    "implorer": 1019,
+    "imposer": 1020,
+    "imprimer": 1021,
+    "imputer": 1022,
+    "incarner": 1023,
+    "incendie": 1024,
+    "incident": 1025,
+    "incliner": 1026,
+    "incolore": 1027,
+    "indexer": 1028,
+    "indice": 1029,
+    "inductif": 1030,
+    "inédit": 1031,
+    "ineptie": 1032,
+    "inexact": 1033,
+    "infini": 1034,
+    "infliger": 1035,
+    "informer": 1036,
+    "infusion": 1037,
+    "ingérer": 1038,
+    "inhaler": 1039,
+    "inhiber": 1040,
+    "injecter": 1041,
+    "injure": 1042,
+    "innocent": 1043,
+    "inoculer": 1044,
+    "inonder": 1045,
+    "inscrire": 1046,
+    "insecte": 1047,
+    "insigne": 1048,
+    "insolite": 1049,
+    "inspirer": 1050,
+    "instinct": 1051,
+    "insulter": 1052,
+    "intact": 1053,
+    "intense": 1054,
+    "intime": 1055,
+    "intrigue": 1056,
+    "intuitif": 1057,
+    "inutile": 1058,
+    "invasion": 1059,
+    "inventer": 1060,
+    "inviter": 1061,
+    "invoquer": 1062,
+    "ironique": 1063,
+    "irradier": 1064,
+    "irréel": 1065,
+    "irriter": 1066,
+    "isoler": 1067,
+    "ivoire": 1068,
+    "ivresse": 1069,
+    "jaguar": 1070,
+    "jaillir": 1071,
+    "jambe": 1072,
+    "janvier": 1073,
+    "jardin": 1074,
+    "jauger": 1075,
+    "jaune": 1076,
+    "javelot": 1077,
+    "jetable": 1078,
+    "jeton": 1079,
+    "jeudi": 1080,
+    "jeunesse": 1081,
+    "joindre": 1082,
+    "joncher": 1083,
+    "jongler": 1084,
+    "joueur": 1085,
+    "jouissif": 1086,
+    "journal": 1087,
+    "jovial": 1088,
+    "joyau": 1089,
+    "joyeux": 1090,
+    "jubiler": 1091,
+    "jugement": 1092,
+    "junior": 1093,
+    "jupon": 1094,
+    "juriste": 1095,
+    "justice": 1096,
+    "juteux": 1097,
+    "juvénile": 1098,
+    "kayak": 1099,
+    "kimono": 1100,
+    "kiosque": 1101,
+    "label": 1102,
+    "labial": 1103,
+    "labourer": 1104,
+    "lacérer": 1105,
+    "lactose": 1106,
+    "lagune": 1107,
+    "laine": 1108,
+    "laisser": 1109,
+    "laitier": 1110,
+    "lambeau": 1111,
+    "lamelle": 1112,
+    "lampe": 1113,
+    "lanceur": 1114,
+    "langage": 1115,
+    "lanterne": 1116,
+    "lapin": 1117,
+    "largeur": 1118,
+    "larme": 1119,
+    "laurier": 1120,
+    "lavabo": 1121,
+    "lavoir": 1122,
+    "lecture": 1123,
+    "légal": 1124,
+    "léger": 1125,
+    "légume": 1126,
+    "lessive": 1127,
+    "lettre": 1128,
+    "levier": 1129,
+    "lexique": 1130,
+    "lézard": 1131,
+    "liasse": 1132,
+    "libérer": 1133,
+    "libre": 1134,
+    "licence": 1135,
+    "licorne": 1136,
+    "liège": 1137,
+    "lièvre": 1138,
+    "ligature": 1139,
+    "ligoter": 1140,
+    "ligue": 1141,
+    "limer": 1142,
+    "limite": 1143,
+    "limonade": 1144,
+    "limpide": 1145,
+    "linéaire": 1146,
+    "lingot": 1147,
+    "lionceau": 1148,
+    "liquide": 1149,
+    "lisière": 1150,
+    "lister": 1151,
+    "lithium": 1152,
+    "litige": 1153,
+    "littoral": 1154,
+    "livreur": 1155,
+    "logique": 1156,
+    "lointain": 1157,
+    "loisir": 1158,
+    "lombric": 1159,
+    "loterie": 1160,
+    "louer": 1161,
+    "lourd": 1162,
+    "loutre": 1163,
+    "louve": 1164,
+    "loyal": 1165,
+    "lubie": 1166,
+    "lucide": 1167,
+    "lucratif": 1168,
+    "lueur": 1169,
+    "lugubre": 1170,
+    "luisant": 1171,
+    "lumière": 1172,
+    "lunaire": 1173,
+    "lundi": 1174,
+    "luron": 1175,
+    "lutter": 1176,
+    "luxueux": 1177,
+    "machine": 1178,
+    "magasin": 1179,
+    "magenta": 1180,
+    "magique": 1181,
+    "maigre": 1182,
+    "maillon": 1183,
+    "maintien": 1184,
+    "mairie": 1185,
+    "maison": 1186,
+    "majorer": 1187,
+    "malaxer": 1188,
+    "maléfice": 1189,
+    "malheur": 1190,
+    "malice": 1191,
+    "mallette": 1192,
+    "mammouth": 1193,
+    "mandater": 1194,
+    "maniable": 1195,
+    "manquant": 1196,
+    "manteau": 1197,
+    "manuel": 1198,
+    "marathon": 1199,
+    "marbre": 1200,
+    "marchand": 1201,
+    "mardi": 1202,
+    "maritime": 1203,
+    "marqueur": 1204,
+    "marron": 1205,
+    "marteler": 1206,
+    "mascotte": 1207,
+    "massif": 1208,
+    "matériel": 1209,
+    "matière": 1210,
+    "matraque": 1211,
+    "maudire": 1212,
+    "maussade": 1213,
+    "mauve": 1214,
+    "maximal": 1215,
+    "méchant": 1216,
+    "méconnu": 1217,
+    "médaille": 1218,
+    "médecin": 1219,
+    "méditer": 1220,
+    "méduse": 1221,
+    "meilleur": 1222,
+    "mélange": 1223,
+    "mélodie": 1224,
+    "membre": 1225,
+    "mémoire": 1226,
+    "menacer": 1227,
+    "mener": 1228,
+    "menhir": 1229,
+    "mensonge": 1230,
+    "mentor": 1231,
+    "mercredi": 1232,
+    "mérite": 1233,
+    "merle": 1234,
+    "messager": 1235,
+    "mesure": 1236,
+    "métal": 1237,
+    "météore": 1238,
+    "méthode": 1239,
+    "métier": 1240,
+    "meuble": 1241,
+    "miauler": 1242,
+    "microbe": 1243,
+    "miette": 1244,
+    "mignon": 1245,
+    "migrer": 1246,
+    "milieu": 1247,
+    "million": 1248,
+    "mimique": 1249,
+    "mince": 1250,
+    "minéral": 1251,
+    "minimal": 1252,
+    "minorer": 1253,
+    "minute": 1254,
+    "miracle": 1255,
+    "miroiter": 1256,
+    "missile": 1257,
+    "mixte": 1258,
+    "mobile": 1259,
+    "moderne": 1260,
+    "moelleux": 1261,
+    "mondial": 1262,
+    "moniteur": 1263,
+    "monnaie": 1264,
+    "monotone": 1265,
+    "monstre": 1266,
+    "montagne": 1267,
+    "monument": 1268,
+    "moqueur": 1269,
+    "morceau": 1270,
+    "morsure": 1271,
+    "mortier": 1272,
+    "moteur": 1273,
+    "motif": 1274,
+    "mouche": 1275,
+    "moufle": 1276,
+    "moulin": 1277,
+    "mousson": 1278,
+    "mouton": 1279,
+    "mouvant": 1280,
+    "multiple": 1281,
+    "munition": 1282,
+    "muraille": 1283,
+    "murène": 1284,
+    "murmure": 1285,
+    "muscle": 1286,
+    "muséum": 1287,
+    "musicien": 1288,
+    "mutation": 1289,
+    "muter": 1290,
+    "mutuel": 1291,
+    "myriade": 1292,
+    "myrtille": 1293,
+    "mystère": 1294,
+    "mythique": 1295,
+    "nageur": 1296,
+    "nappe": 1297,
+    "narquois": 1298,
+    "narrer": 1299,
+    "natation": 1300,
+    "nation": 1301,
+    "nature": 1302,
+    "naufrage": 1303,
+    "nautique": 1304,
+    "navire": 1305,
+    "nébuleux": 1306,
+    "nectar": 1307,
+    "néfaste": 1308,
+    "négation": 1309,
+    "négliger": 1310,
+    "négocier": 1311,
+    "neige": 1312,
+    "nerveux": 1313,
+    "nettoyer": 1314,
+    "neurone": 1315,
+    "neutron": 1316,
+    "neveu": 1317,
+    "niche": 1318,
+    "nickel": 1319,
+    "nitrate": 1320,
+    "niveau": 1321,
+    "noble": 1322,
+    "nocif": 1323,
+    "nocturne": 1324,
+    "noirceur": 1325,
+    "noisette": 1326,
+    "nomade": 1327,
+    "nombreux": 1328,
+    "nommer": 1329,
+    "normatif": 1330,
+    "notable": 1331,
+    "notifier": 1332,
+    "notoire": 1333,
+    "nourrir": 1334,
+    "nouveau": 1335,
+    "novateur": 1336,
+    "novembre": 1337,
+    "novice": 1338,
+    "nuage": 1339,
+    "nuancer": 1340,
+    "nuire": 1341,
+    "nuisible": 1342,
+    "numéro": 1343,
+    "nuptial": 1344,
+    "nuque": 1345,
+    "nutritif": 1346,
+    "obéir": 1347,
+    "objectif": 1348,
+    "obliger": 1349,
+    "obscur": 1350,
+    "observer": 1351,
+    "obstacle": 1352,
+    "obtenir": 1353,
+    "obturer": 1354,
+    "occasion": 1355,
+    "occuper": 1356,
+    "océan": 1357,
+    "octobre": 1358,
+    "octroyer": 1359,
+    "octupler": 1360,
+    "oculaire": 1361,
+    "odeur": 1362,
+    "odorant": 1363,
+    "offenser": 1364,
+    "officier": 1365,
+    "offrir": 1366,
+    "ogive": 1367,
+    "oiseau": 1368,
+    "oisillon": 1369,
+    "olfactif": 1370,
+    "olivier": 1371,
+    "ombrage": 1372,
+    "omettre": 1373,
+    "onctueux": 1374,
+    "onduler": 1375,
+    "onéreux": 1376,
+    "onirique": 1377,
+    "opale": 1378,
+    "opaque": 1379,
+    "opérer": 1380,
+    "opinion": 1381,
+    "opportun": 1382,
+    "opprimer": 1383,
+    "opter": 1384,
+    "optique": 1385,
+    "orageux": 1386,
+    "orange": 1387,
+    "orbite": 1388,
+    "ordonner": 1389,
+    "oreille": 1390,
+    "organe": 1391,
+    "orgueil": 1392,
+    "orifice": 1393,
+    "ornement": 1394,
+    "orque": 1395,
+    "ortie": 1396,
+    "osciller": 1397,
+    "osmose": 1398,
+    "ossature": 1399,
+    "otarie": 1400,
+    "ouragan": 1401,
+    "ourson": 1402,
+    "outil": 1403,
+    "outrager": 1404,
+    "ouvrage": 1405,
+    "ovation": 1406,
+    "oxyde": 1407,
+    "oxygène": 1408,
+    "ozone": 1409,
+    "paisible": 1410,
+    "palace": 1411,
+    "palmarès": 1412,
+    "palourde": 1413,
+    "palper": 1414,
+    "panache": 1415,
+    "panda": 1416,
+    "pangolin": 1417,
+    "paniquer": 1418,
+    "panneau": 1419,
+    "panorama": 1420,
+    "pantalon": 1421,
+    "papaye": 1422,
+    "papier": 1423,
+    "papoter": 1424,
+    "papyrus": 1425,
+    "paradoxe": 1426,
+    "parcelle": 1427,
+    "paresse": 1428,
+    "parfumer": 1429,
+    "parler": 1430,
+    "parole": 1431,
+    "parrain": 1432,
+    "parsemer": 1433,
+    "partager": 1434,
+    "parure": 1435,
+    "parvenir": 1436,
+    "passion": 1437,
+    "pastèque": 1438,
+    "paternel": 1439,
+    "patience": 1440,
+    "patron": 1441,
+    "pavillon": 1442,
+    "pavoiser": 1443,
+    "payer": 1444,
+    "paysage": 1445,
+    "peigne": 1446,
+    "peintre": 1447,
+    "pelage": 1448,
+    "pélican": 1449,
+    "pelle": 1450,
+    "pelouse": 1451,
+    "peluche": 1452,
+    "pendule": 1453,
+    "pénétrer": 1454,
+    "pénible": 1455,
+    "pensif": 1456,
+    "pénurie": 1457,
+    "pépite": 1458,
+    "péplum": 1459,
+    "perdrix": 1460,
+    "perforer": 1461,
+    "période": 1462,
+    "permuter": 1463,
+    "perplexe": 1464,
+    "persil": 1465,
+    "perte": 1466,
+    "peser": 1467,
+    "pétale": 1468,
+    "petit": 1469,
+    "pétrir": 1470,
+    "peuple": 1471,
+    "pharaon": 1472,
+    "phobie": 1473,
+    "phoque": 1474,
+    "photon": 1475,
+    "phrase": 1476,
+    "physique": 1477,
+    "piano": 1478,
+    "pictural": 1479,
+    "pièce": 1480,
+    "pierre": 1481,
+    "pieuvre": 1482,
+    "pilote": 1483,
+    "pinceau": 1484,
+    "pipette": 1485,
+    "piquer": 1486,
+    "pirogue": 1487,
+    "piscine": 1488,
+    "piston": 1489,
+    "pivoter": 1490,
+    "pixel": 1491,
+    "pizza": 1492,
+    "placard": 1493,
+    "plafond": 1494,
+    "plaisir": 1495,
+    "planer": 1496,
+    "plaque": 1497,
+    "plastron": 1498,
+    "plateau": 1499,
+    "pleurer": 1500,
+    "plexus": 1501,
+    "pliage": 1502,
+    "plomb": 1503,
+    "plonger": 1504,
+    "pluie": 1505,
+    "plumage": 1506,
+    "pochette": 1507,
+    "poésie": 1508,
+    "poète": 1509,
+    "pointe": 1510,
+    "poirier": 1511,
+    "poisson": 1512,
+    "poivre": 1513,
+    "polaire": 1514,
+    "policier": 1515,
+    "pollen": 1516,
+    "polygone": 1517,
+    "pommade": 1518,
+    "pompier": 1519,
+    "ponctuel": 1520,
+    "pondérer": 1521,
+    "poney": 1522,
+    "portique": 1523,
+    "position": 1524,
+    "posséder": 1525,
+    "posture": 1526,
+    "potager": 1527,
+    "poteau": 1528,
+    "potion": 1529,
+    "pouce": 1530,
+    "poulain": 1531,
+    "poumon": 1532,
+    "pourpre": 1533,
+    "poussin": 1534,
+    "pouvoir": 1535,
+    "prairie": 1536,
+    "pratique": 1537,
+    "précieux": 1538,
+    "prédire": 1539,
+    "préfixe": 1540,
+    "prélude": 1541,
+    "prénom": 1542,
+    "présence": 1543,
+    "prétexte": 1544,
+    "prévoir": 1545,
+    "primitif": 1546,
+    "prince": 1547,
+    "prison": 1548,
+    "priver": 1549,
+    "problème": 1550,
+    "procéder": 1551,
+    "prodige": 1552,
+    "profond": 1553,
+    "progrès": 1554,
+    "proie": 1555,
+    "projeter": 1556,
+    "prologue": 1557,
+    "promener": 1558,
+    "propre": 1559,
+    "prospère": 1560,
+    "protéger": 1561,
+    "prouesse": 1562,
+    "proverbe": 1563,
+    "prudence": 1564,
+    "pruneau": 1565,
+    "psychose": 1566,
+    "public": 1567,
+    "puceron": 1568,
+    "puiser": 1569,
+    "pulpe": 1570,
+    "pulsar": 1571,
+    "punaise": 1572,
+    "punitif": 1573,
+    "pupitre": 1574,
+    "purifier": 1575,
+    "puzzle": 1576,
+    "pyramide": 1577,
+    "quasar": 1578,
+    "querelle": 1579,
+    "question": 1580,
+    "quiétude": 1581,
+    "quitter": 1582,
+    "quotient": 1583,
+    "racine": 1584,
+    "raconter": 1585,
+    "radieux": 1586,
+    "ragondin": 1587,
+    "raideur": 1588,
+    "raisin": 1589,
+    "ralentir": 1590,
+    "rallonge": 1591,
+    "ramasser": 1592,
+    "rapide": 1593,
+    "rasage": 1594,
+    "ratisser": 1595,
+    "ravager": 1596,
+    "ravin": 1597,
+    "rayonner": 1598,
+    "réactif": 1599,
+    "réagir": 1600,
+    "réaliser": 1601,
+    "réanimer": 1602,
+    "recevoir": 1603,
+    "réciter": 1604,
+    "réclamer": 1605,
+    "récolter": 1606,
+    "recruter": 1607,
+    "reculer": 1608,
+    "recycler": 1609,
+    "rédiger": 1610,
+    "redouter": 1611,
+    "refaire": 1612,
+    "réflexe": 1613,
+    "réformer": 1614,
+    "refrain": 1615,
+    "refuge": 1616,
+    "régalien": 1617,
+    "région": 1618,
+    "réglage": 1619,
+    "régulier": 1620,
+    "réitérer": 1621,
+    "rejeter": 1622,
+    "rejouer": 1623,
+    "relatif": 1624,
+    "relever": 1625,
+    "relief": 1626,
+    "remarque": 1627,
+    "remède": 1628,
+    "remise": 1629,
+    "remonter": 1630,
+    "remplir": 1631,
+    "remuer": 1632,
+    "renard": 1633,
+    "renfort": 1634,
+    "renifler": 1635,
+    "renoncer": 1636,
+    "rentrer": 1637,
+    "renvoi": 1638,
+    "replier": 1639,
+    "reporter": 1640,
+    "reprise": 1641,
+    "reptile": 1642,
+    "requin": 1643,
+    "réserve": 1644,
+    "résineux": 1645,
+    "résoudre": 1646,
+    "respect": 1647,
+    "rester": 1648,
+    "résultat": 1649,
+    "rétablir": 1650,
+    "retenir": 1651,
+    "réticule": 1652,
+    "retomber": 1653,
+    "retracer": 1654,
+    "réunion": 1655,
+    "réussir": 1656,
+    "revanche": 1657,
+    "revivre": 1658,
+    "révolte": 1659,
+    "révulsif": 1660,
+    "richesse": 1661,
+    "rideau": 1662,
+    "rieur": 1663,
+    "rigide": 1664,
+    "rigoler": 1665,
+    "rincer": 1666,
+    "riposter": 1667,
+    "risible": 1668,
+    "risque": 1669,
+    "rituel": 1670,
+    "rival": 1671,
+    "rivière": 1672,
+    "rocheux": 1673,
+    "romance": 1674,
+    "rompre": 1675,
+    "ronce": 1676,
+    "rondin": 1677,
+    "roseau": 1678,
+    "rosier": 1679,
+    "rotatif": 1680,
+    "rotor": 1681,
+    "rotule": 1682,
+    "rouge": 1683,
+    "rouille": 1684,
+    "rouleau": 1685,
+    "routine": 1686,
+    "royaume": 1687,
+    "ruban": 1688,
+    "rubis": 1689,
+    "ruche": 1690,
+    "ruelle": 1691,
+    "rugueux": 1692,
+    "ruiner": 1693,
+    "ruisseau": 1694,
+    "ruser": 1695,
+    "rustique": 1696,
+    "rythme": 1697,
+    "sabler": 1698,
+    "saboter": 1699,
+    "sabre": 1700,
+    "sacoche": 1701,
+    "safari": 1702,
+    "sagesse": 1703,
+    "saisir": 1704,
+    "salade": 1705,
+    "salive": 1706,
+    "salon": 1707,
+    "saluer": 1708,
+    "samedi": 1709,
+    "sanction": 1710,
+    "sanglier": 1711,
+    "sarcasme": 1712,
+    "sardine": 1713,
+    "saturer": 1714,
+    "saugrenu": 1715,
+    "saumon": 1716,
+    "sauter": 1717,
+    "sauvage": 1718,
+    "savant": 1719,
+    "savonner": 1720,
+    "scalpel": 1721,
+    "scandale": 1722,
+    "scélérat": 1723,
+    "scénario": 1724,
+    "sceptre": 1725,
+    "schéma": 1726,
+    "science": 1727,
+    "scinder": 1728,
+    "score": 1729,
+    "scrutin": 1730,
+    "sculpter": 1731,
+    "séance": 1732,
+    "sécable": 1733,
+    "sécher": 1734,
+    "secouer": 1735,
+    "sécréter": 1736,
+    "sédatif": 1737,
+    "séduire": 1738,
+    "seigneur": 1739,
+    "séjour": 1740,
+    "sélectif": 1741,
+    "semaine": 1742,
+    "sembler": 1743,
+    "semence": 1744,
+    "séminal": 1745,
+    "sénateur": 1746,
+    "sensible": 1747,
+    "sentence": 1748,
+    "séparer": 1749,
+    "séquence": 1750,
+    "serein": 1751,
+    "sergent": 1752,
+    "sérieux": 1753,
+    "serrure": 1754,
+    "sérum": 1755,
+    "service": 1756,
+    "sésame": 1757,
+    "sévir": 1758,
+    "sevrage": 1759,
+    "sextuple": 1760,
+    "sidéral": 1761,
+    "siècle": 1762,
+    "siéger": 1763,
+    "siffler": 1764,
+    "sigle": 1765,
+    "signal": 1766,
+    "silence": 1767,
+    "silicium": 1768,
+    "simple": 1769,
+    "sincère": 1770,
+    "sinistre": 1771,
+    "siphon": 1772,
+    "sirop": 1773,
+    "sismique": 1774,
+    "situer": 1775,
+    "skier": 1776,
+    "social": 1777,
+    "socle": 1778,
+    "sodium": 1779,
+    "soigneux": 1780,
+    "soldat": 1781,
+    "soleil": 1782,
+    "solitude": 1783,
+    "soluble": 1784,
+    "sombre": 1785,
+    "sommeil": 1786,
+    "somnoler": 1787,
+    "sonde": 1788,
+    "songeur": 1789,
+    "sonnette": 1790,
+    "sonore": 1791,
+    "sorcier": 1792,
+    "sortir": 1793,
+    "sosie": 1794,
+    "sottise": 1795,
+    "soucieux": 1796,
+    "soudure": 1797,
+    "souffle": 1798,
+    "soulever": 1799,
+    "soupape": 1800,
+    "source": 1801,
+    "soutirer": 1802,
+    "souvenir": 1803,
+    "spacieux": 1804,
+    "spatial": 1805,
+    "spécial": 1806,
+    "sphère": 1807,
+    "spiral": 1808,
+    "stable": 1809,
+    "station": 1810,
+    "sternum": 1811,
+    "stimulus": 1812,
+    "stipuler": 1813,
+    "strict": 1814,
+    "studieux": 1815,
+    "stupeur": 1816,
+    "styliste": 1817,
+    "sublime": 1818,
+    "substrat": 1819,
+    "subtil": 1820,
+    "subvenir": 1821,
+    "succès": 1822,
+    "sucre": 1823,
+    "suffixe": 1824,
+    "suggérer": 1825,
+    "suiveur": 1826,
+    "sulfate": 1827,
+    "superbe": 1828,
+    "supplier": 1829,
+    "surface": 1830,
+    "suricate": 1831,
+    "surmener": 1832,
+    "surprise": 1833,
+    "sursaut": 1834,
+    "survie": 1835,
+    "suspect": 1836,
+    "syllabe": 1837,
+    "symbole": 1838,
+    "symétrie": 1839,
+    "synapse": 1840,
+    "syntaxe": 1841,
+    "système": 1842,
+    "tabac": 1843,
+    "tablier": 1844,
+    "tactile": 1845,
+    "tailler": 1846,
+    "talent": 1847,
+    "talisman": 1848,
+    "talonner": 1849,
+    "tambour": 1850,
+    "tamiser": 1851,
+    "tangible": 1852,
+    "tapis": 1853,
+    "taquiner": 1854,
+    "tarder": 1855,
+    "tarif": 1856,
+    "tartine": 1857,
+    "tasse": 1858,
+    "tatami": 1859,
+    "tatouage": 1860,
+    "taupe": 1861,
+    "taureau": 1862,
+    "taxer": 1863,
+    "témoin": 1864,
+    "temporel": 1865,
+    "tenaille": 1866,
+    "tendre": 1867,
+    "teneur": 1868,
+    "tenir": 1869,
+    "tension": 1870,
+    "terminer": 1871,
+    "terne": 1872,
+    "terrible": 1873,
+    "tétine": 1874,
+    "texte": 1875,
+    "thème": 1876,
+    "théorie": 1877,
+    "thérapie": 1878,
+    "thorax": 1879,
+    "tibia": 1880,
+    "tiède": 1881,
+    "timide": 1882,
+    "tirelire": 1883,
+    "tiroir": 1884,
+    "tissu": 1885,
+    "titane": 1886,
+    "titre": 1887,
+    "tituber": 1888,
+    "toboggan": 1889,
+    "tolérant": 1890,
+    "tomate": 1891,
+    "tonique": 1892,
+    "tonneau": 1893,
+    "toponyme": 1894,
+    "torche": 1895,
+    "tordre": 1896,
+    "tornade": 1897,
+    "torpille": 1898,
+    "torrent": 1899,
+    "torse": 1900,
+    "tortue": 1901,
+    "totem": 1902,
+    "toucher": 1903,
+    "tournage": 1904,
+    "tousser": 1905,
+    "toxine": 1906,
+    "traction": 1907,
+    "trafic": 1908,
+    "tragique": 1909,
+    "trahir": 1910,
+    "train": 1911,
+    "trancher": 1912,
+    "travail": 1913,
+    "trèfle": 1914,
+    "tremper": 1915,
+    "trésor": 1916,
+    "treuil": 1917,
+    "triage": 1918,
+    "tribunal": 1919,
+    "tricoter": 1920,
+    "trilogie": 1921,
+    "triomphe": 1922,
+    "tripler": 1923,
+    "triturer": 1924,
+    "trivial": 1925,
+    "trombone": 1926,
+    "tronc": 1927,
+    "tropical": 1928,
+    "troupeau": 1929,
+    "tuile": 1930,
+    "tulipe": 1931,
+    "tumulte": 1932,
+    "tunnel": 1933,
+    "turbine": 1934,
+    "tuteur": 1935,
+    "tutoyer": 1936,
+    "tuyau": 1937,
+    "tympan": 1938,
+    "typhon": 1939,
+    "typique": 1940,
+    "tyran": 1941,
+    "ubuesque": 1942,
+    "ultime": 1943,
+    "ultrason": 1944,
+    "unanime": 1945,
+    "unifier": 1946,
+    "union": 1947,
+    "unique": 1948,
+    "unitaire": 1949,
+    "univers": 1950,
+    "uranium": 1951,
+    "urbain": 1952,
+    "urticant": 1953,
+    "usage": 1954,
+    "usine": 1955,
+    "usuel": 1956,
+    "usure": 1957,
+    "utile": 1958,
+    "utopie": 1959,
+    "vacarme": 1960,
+    "vaccin": 1961,
+    "vagabond": 1962,
+    "vague": 1963,
+    "vaillant": 1964,
+    "vaincre": 1965,
+    "vaisseau": 1966,
+    "valable": 1967,
+    "valise": 1968,
+    "vallon": 1969,
+    "valve": 1970,
+    "vampire": 1971,
+    "vanille": 1972,
+    "vapeur": 1973,
+    "varier": 1974,
+    "vaseux": 1975,
+    "vassal": 1976,
+    "vaste": 1977,
+    "vecteur": 1978,
+    "vedette": 1979,
+    "végétal": 1980,
+    "véhicule": 1981,
+    "veinard": 1982,
+    "véloce": 1983,
+    "vendredi": 1984,
+    "vénérer": 1985,
+    "venger": 1986,
+    "venimeux": 1987,
+    "ventouse": 1988,
+    "verdure": 1989,
+    "vérin": 1990,
+    "vernir": 1991,
+    "verrou": 1992,
+    "verser": 1993,
+    "vertu": 1994,
+    "veston": 1995,
+    "vétéran": 1996,
+    "vétuste": 1997,
+    "vexant": 1998,
+    "vexer": 1999,
+    "viaduc": 2000,
+    "viande": 2001,
+    "victoire": 2002,
+    "vidange": 2003,
+    "vidéo": 2004,
+    "vignette": 2005,
+    "vigueur": 2006,
+    "vilain": 2007,
+    "village": 2008,
+    "vinaigre": 2009,
+    "violon": 2010,
+    "vipère": 2011,
+    "virement": 2012,
+    "virtuose": 2013,
+    "virus": 2014,
+    "visage": 2015,
+    "viseur": 2016,
+    "vision": 2017,
+    "visqueux": 2018,
+    "visuel": 2019,
+    "vital": 2020,
+    "vitesse": 2021,
+    "viticole": 2022,
+    "vitrine": 2023,
+    "vivace": 2024,
+    "vivipare": 2025,
+    "vocation": 2026,
+    "voguer": 2027,
+    "voile": 2028,
+    "voisin": 2029,
+    "voiture": 2030,
+    "volaille": 2031,
+    "volcan": 2032,
+    "voltiger": 2033,
+    "volume": 2034,
+    "vorace": 2035,
+    "vortex": 2036,
+    "voter": 2037,
+    "vouloir": 2038,
+    "voyage": 2039,
+    "voyelle": 2040,
+    "wagon": 2041,
+    "xénon": 2042,
+    "yacht": 2043,
+    "zèbre": 2044,
+    "zénith": 2045,
+    "zeste": 2046,
+    "zoologie": 2047,
+}
+var words_italian = [2048]string{
+    "abaco",
+    "abbaglio",
+    "abbinato",
+    "abete",
+    "abisso",
+    "abolire",
+    "abrasivo",
+    "abrogato",
+    "accadere",
+    "accenno",
+    "accusato",
+    "acetone",
+    "achille",
+    "acido",
+    "acqua",
+    "acre",
+    "acrilico",
+    "acrobata",
+    "acuto",
+    "adagio",
+    "addebito",
+    "addome",
+    "adeguato",
+    "aderire",
+    "adipe",
+    "adottare",
+    "adulare",
+    "affabile",
+    "affetto",
+    "affisso",
+    "affranto",
+    "aforisma",
+    "afoso",
+    "africano",
+    "agave",
+    "agente",
+    "agevole",
+    "aggancio",
+    "agire",
+    "agitare",
+    "agonismo",
+    "agricolo",
+    "agrumeto",
+    "aguzzo",
+    "alabarda",
+    "alato",
+    "albatro",
+    "alberato",
+    "albo",
+    "albume",
+    "alce",
+    "alcolico",
+    "alettone",
+    "alfa",
+    "algebra",
+    "aliante",
+    "alibi",
+    "alimento",
+    "allagato",
+    "allegro",
+    "allievo",
+    "allodola",
+    "allusivo",
+    "almeno",
+    "alogeno",
+    "alpaca",
+    "alpestre",
+    "altalena",
+    "alterno",
+    "alticcio",
+    "altrove",
+    "alunno",
+    "alveolo",
+    "alzare",
+    "amalgama",
+    "amanita",
+    "amarena",
+    "ambito",
+    "ambrato",
+    "ameba",
+    "america",
+    "ametista",
+    "amico",
+    "ammasso",
+    "ammenda",
+    "ammirare",
+    "ammonito",
+    "amore",
+    "ampio",
+    "ampliare",
+    "amuleto",
+    "anacardo",
+    "anagrafe",
+    "analista",
+    "anarchia",
+    "anatra",
+    "anca",
+    "ancella",
+    "ancora",
+    "andare",
+    "andrea",
+    "anello",
+    "angelo",
+    "angolare",
+    "angusto",
+    "anima",
+    "annegare",
+    "annidato",
+    "anno",
+    "annuncio",
+    "anonimo",
+    "anticipo",
+    "anzi",
+    "apatico",
+    "apertura",
+    "apode",
+    "apparire",
+    "appetito",
+    "appoggio",
+    "approdo",
+    "appunto",
+    "aprile",
+    "arabica",
+    "arachide",
+    "aragosta",
+    "araldica",
+    "arancio",
+    "aratura",
+    "arazzo",
+    "arbitro",
+    "archivio",
+    "ardito",
+    "arenile",
+    "argento",
+    "argine",
+    "arguto",
+    "aria",
+    "armonia",
+    "arnese",
+    "arredato",
+    "arringa",
+    "arrosto",
+    "arsenico",
+    "arso",
+    "artefice",
+    "arzillo",
+    "asciutto",
+    "ascolto",
+    "asepsi",
+    "asettico",
+    "asfalto",
+    "asino",
+    "asola",
+    "aspirato",
+    "aspro",
+    "assaggio",
+    "asse",
+    "assoluto",
+    "assurdo",
+    "asta",
+    "astenuto",
+    "astice",
+    "astratto",
+    "atavico",
+    "ateismo",
+    "atomico",
+    "atono",
+    "attesa",
+    "attivare",
+    "attorno",
+    "attrito",
+    "attuale",
+    "ausilio",
+    "austria",
+    "autista",
+    "autonomo",
+    "autunno",
+    "avanzato",
+    "avere",
+    "avvenire",
+    "avviso",
+    "avvolgere",
+    "azione",
+    "azoto",
+    "azzimo",
+    "azzurro",
+    "babele",
+    "baccano",
+    "bacino",
+    "baco",
+    "badessa",
+    "badilata",
+    "bagnato",
+    "baita",
+    "balcone",
+    "baldo",
+    "balena",
+    "ballata",
+    "balzano",
+    "bambino",
+    "bandire",
+    "baraonda",
+    "barbaro",
+    "barca",
+    "baritono",
+    "barlume",
+    "barocco",
+    "basilico",
+    "basso",
+    "batosta",
+    "battuto",
+    "baule",
+    "bava",
+    "bavosa",
+    "becco",
+    "beffa",
+    "belgio",
+    "belva",
+    "benda",
+    "benevole",
+    "benigno",
+    "benzina",
+    "bere",
+    "berlina",
+    "beta",
+    "bibita",
+    "bici",
+    "bidone",
+    "bifido",
+    "biga",
+    "bilancia",
+    "bimbo",
+    "binocolo",
+    "biologo",
+    "bipede",
+    "bipolare",
+    "birbante",
+    "birra",
+    "biscotto",
+    "bisesto",
+    "bisnonno",
+    "bisonte",
+    "bisturi",
+    "bizzarro",
+    "blando",
+    "blatta",
+    "bollito",
+    "bonifico",
+    "bordo",
+    "bosco",
+    "botanico",
+    "bottino",
+    "bozzolo",
+    "braccio",
+    "bradipo",
+    "brama",
+    "branca",
+    "bravura",
+    "bretella",
+    "brevetto",
+    "brezza",
+    "briglia",
+    "brillante",
+    "brindare",
+    "broccolo",
+    "brodo",
+    "bronzina",
+    "brullo",
+    "bruno",
+    "bubbone",
+    "buca",
+    "budino",
+    "buffone",
+    "buio",
+    "bulbo",
+    "buono",
+    "burlone",
+    "burrasca",
+    "bussola",
+    "busta",
+    "cadetto",
+    "caduco",
+    "calamaro",
+    "calcolo",
+    "calesse",
+    "calibro",
+    "calmo",
+    "caloria",
+    "cambusa",
+    "camerata",
+    "camicia",
+    "cammino",
+    "camola",
+    "campale",
+    "canapa",
+    "candela",
+    "cane",
+    "canino",
+    "canotto",
+    "cantina",
+    "capace",
+    "capello",
+    "capitolo",
+    "capogiro",
+    "cappero",
+    "capra",
+    "capsula",
+    "carapace",
+    "carcassa",
+    "cardo",
+    "carisma",
+    "carovana",
+    "carretto",
+    "cartolina",
+    "casaccio",
+    "cascata",
+    "caserma",
+    "caso",
+    "cassone",
+    "castello",
+    "casuale",
+    "catasta",
+    "catena",
+    "catrame",
+    "cauto",
+    "cavillo",
+    "cedibile",
+    "cedrata",
+    "cefalo",
+    "celebre",
+    "cellulare",
+    "cena",
+    "cenone",
+    "centesimo",
+    "ceramica",
+    "cercare",
+    "certo",
+    "cerume",
+    "cervello",
+    "cesoia",
+    "cespo",
+    "ceto",
+    "chela",
+    "chiaro",
+    "chicca",
+    "chiedere",
+    "chimera",
+    "china",
+    "chirurgo",
+    "chitarra",
+    "ciao",
+    "ciclismo",
+    "cifrare",
+    "cigno",
+    "cilindro",
+    "ciottolo",
+    "circa",
+    "cirrosi",
+    "citrico",
+    "cittadino",
+    "ciuffo",
+    "civetta",
+    "civile",
+    "classico",
+    "clinica",
+    "cloro",
+    "cocco",
+    "codardo",
+    "codice",
+    "coerente",
+    "cognome",
+    "collare",
+    "colmato",
+    "colore",
+    "colposo",
+    "coltivato",
+    "colza",
+    "coma",
+    "cometa",
+    "commando",
+    "comodo",
+    "computer",
+    "comune",
+    "conciso",
+    "condurre",
+    "conferma",
+    "congelare",
+    "coniuge",
+    "connesso",
+    "conoscere",
+    "consumo",
+    "continuo",
+    "convegno",
+    "coperto",
+    "copione",
+    "coppia",
+    "copricapo",
+    "corazza",
+    "cordata",
+    "coricato",
+    "cornice",
+    "corolla",
+    "corpo",
+    "corredo",
+    "corsia",
+    "cortese",
+    "cosmico",
+    "costante",
+    "cottura",
+    "covato",
+    "cratere",
+    "cravatta",
+    "creato",
+    "credere",
+    "cremoso",
+    "crescita",
+    "creta",
+    "criceto",
+    "crinale",
+    "crisi",
+    "critico",
+    "croce",
+    "cronaca",
+    "crostata",
+    "cruciale",
+    "crusca",
+    "cucire",
+    "cuculo",
+    "cugino",
+    "cullato",
+    "cupola",
+    "curatore",
+    "cursore",
+    "curvo",
+    "cuscino",
+    "custode",
+    "dado",
+    "daino",
+    "dalmata",
+    "damerino",
+    "daniela",
+    "dannoso",
+    "danzare",
+    "datato",
+    "davanti",
+    "davvero",
+    "debutto",
+    "decennio",
+    "deciso",
+    "declino",
+    "decollo",
+    "decreto",
+    "dedicato",
+    "definito",
+    "deforme",
+    "degno",
+    "delegare",
+    "delfino",
+    "delirio",
+    "delta",
+    "demenza",
+    "denotato",
+    "dentro",
+    "deposito",
+    "derapata",
+    "derivare",
+    "deroga",
+    "descritto",
+    "deserto",
+    "desiderio",
+    "desumere",
+    "detersivo",
+    "devoto",
+    "diametro",
+    "dicembre",
+    "diedro",
+    "difeso",
+    "diffuso",
+    "digerire",
+    "digitale",
+    "diluvio",
+    "dinamico",
+    "dinnanzi",
+    "dipinto",
+    "diploma",
+    "dipolo",
+    "diradare",
+    "dire",
+    "dirotto",
+    "dirupo",
+    "disagio",
+    "discreto",
+    "disfare",
+    "disgelo",
+    "disposto",
+    "distanza",
+    "disumano",
+    "dito",
+    "divano",
+    "divelto",
+    "dividere",
+    "divorato",
+    "doblone",
+    "docente",
+    "doganale",
+    "dogma",
+    "dolce",
+    "domato",
+    "domenica",
+    "dominare",
+    "dondolo",
+    "dono",
+    "dormire",
+    "dote",
+    "dottore",
+    "dovuto",
+    "dozzina",
+    "drago",
+    "druido",
+    "dubbio",
+    "dubitare",
+    "ducale",
+    "duna",
+    "duomo",
+    "duplice",
+    "duraturo",
+    "ebano",
+    "eccesso",
+    "ecco",
+    "eclissi",
+    "economia",
+    "edera",
+    "edicola",
+    "edile",
+    "editoria",
+    "educare",
+    "egemonia",
+    "egli",
+    "egoismo",
+    "egregio",
+    "elaborato",
+    "elargire",
+    "elegante",
+    "elencato",
+    "eletto",
+    "elevare",
+    "elfico",
+    "elica",
+    "elmo",
+    "elsa",
+    "eluso",
+    "emanato",
+    "emblema",
+    "emesso",
+    "emiro",
+    "emotivo",
+    "emozione",
+    "empirico",
+    "emulo",
+    "endemico",
+    "enduro",
+    "energia",
+    "enfasi",
+    "enoteca",
+    "entrare",
+    "enzima",
+    "epatite",
+    "epilogo",
+    "episodio",
+    "epocale",
+    "eppure",
+    "equatore",
+    "erario",
+    "erba",
+    "erboso",
+    "erede",
+    "eremita",
+    "erigere",
+    "ermetico",
+    "eroe",
+    "erosivo",
+    "errante",
+    "esagono",
+    "esame",
+    "esanime",
+    "esaudire",
+    "esca",
+    "esempio",
+    "esercito",
+    "esibito",
+    "esigente",
+    "esistere",
+    "esito",
+    "esofago",
+    "esortato",
+    "esoso",
+    "espanso",
+    "espresso",
+    "essenza",
+    "esso",
+    "esteso",
+    "estimare",
+    "estonia",
+    "estroso",
+    "esultare",
+    "etilico",
+    "etnico",
+    "etrusco",
+    "etto",
+    "euclideo",
+    "europa",
+    "evaso",
+    "evidenza",
+    "evitato",
+    "evoluto",
+    "evviva",
+    "fabbrica",
+    "faccenda",
+    "fachiro",
+    "falco",
+    "famiglia",
+    "fanale",
+    "fanfara",
+    "fango",
+    "fantasma",
+    "fare",
+    "farfalla",
+    "farinoso",
+    "farmaco",
+    "fascia",
+    "fastoso",
+    "fasullo",
+    "faticare",
+    "fato",
+    "favoloso",
+    "febbre",
+    "fecola",
+    "fede",
+    "fegato",
+    "felpa",
+    "feltro",
+    "femmina",
+    "fendere",
+    "fenomeno",
+    "fermento",
+    "ferro",
+    "fertile",
+    "fessura",
+    "festivo",
+    "fetta",
+    "feudo",
+    "fiaba",
+    "fiducia",
+    "fifa",
+    "figurato",
+    "filo",
+    "finanza",
+    "finestra",
+    "finire",
+    "fiore",
+    "fiscale",
+    "fisico",
+    "fiume",
+    "flacone",
+    "flamenco",
+    "flebo",
+    "flemma",
+    "florido",
+    "fluente",
+    "fluoro",
+    "fobico",
+    "focaccia",
+    "focoso",
+    "foderato",
+    "foglio",
+    "folata",
+    "folclore",
+    "folgore",
+    "fondente",
+    "fonetico",
+    "fonia",
+    "fontana",
+    "forbito",
+    "forchetta",
+    "foresta",
+    "formica",
+    "fornaio",
+    "foro",
+    "fortezza",
+    "forzare",
+    "fosfato",
+    "fosso",
+    "fracasso",
+    "frana",
+    "frassino",
+    "fratello",
+    "freccetta",
+    "frenata",
+    "fresco",
+    "frigo",
+    "frollino",
+    "fronde",
+    "frugale",
+    "frutta",
+    "fucilata",
+    "fucsia",
+    "fuggente",
+    "fulmine",
+    "fulvo",
+    "fumante",
+    "fumetto",
+    "fumoso",
+    "fune",
+    "funzione",
+    "fuoco",
+    "furbo",
+    "furgone",
+    "furore",
+    "fuso",
+    "futile",
+    "gabbiano",
+    "gaffe",
+    "galateo",
+    "gallina",
+    "galoppo",
+    "gambero",
+    "gamma",
+    "garanzia",
+    "garbo",
+    "garofano",
+    "garzone",
+    "gasdotto",
+    "gasolio",
+    "gastrico",
+    "gatto",
+    "gaudio",
+    "gazebo",
+    "gazzella",
+    "geco",
+    "gelatina",
+    "gelso",
+    "gemello",
+    "gemmato",
+    "gene",
+    "genitore",
+    "gennaio",
+    "genotipo",
+    "gergo",
+    "ghepardo",
+    "ghiaccio",
+    "ghisa",
+    "giallo",
+    "gilda",
+    "ginepro",
+    "giocare",
+    "gioiello",
+    "giorno",
+    "giove",
+    "girato",
+    "girone",
+    "gittata",
+    "giudizio",
+    "giurato",
+    "giusto",
+    "globulo",
+    "glutine",
+    "gnomo",
+    "gobba",
+    "golf",
+    "gomito",
+    "gommone",
+    "gonfio",
+    "gonna",
+    "governo",
+    "gracile",
+    "grado",
+    "grafico",
+    "grammo",
+    "grande",
+    "grattare",
+    "gravoso",
+    "grazia",
+    "greca",
+    "gregge",
+    "grifone",
+    "grigio",
+    "grinza",
+    "grotta",
+    "gruppo",
+    "guadagno",
+    "guaio",
+    "guanto",
+    "guardare",
+    "gufo",
+    "guidare",
+    "ibernato",
+    "icona",
+    "identico",
+    "idillio",
+    "idolo",
+    "idra",
+    "idrico",
+    "idrogeno",
+    "igiene",
+    "ignaro",
+    "ignorato",
+    "ilare",
+    "illeso",
+    "illogico",
+    "illudere",
+    "imballo",
+    "imbevuto",
+    "imbocco",
+    "imbuto",
+    "immane",
+    "immerso",
+    "immolato",
+    "impacco",
+    "impeto",
+    "impiego",
+    "importo",
+    "impronta",
+    "inalare",
+    "inarcare",
+    "inattivo",
+    "incanto",
+    "incendio",
+    "inchino",
+    "incisivo",
+    "incluso",
+    "incontro",
+    "incrocio",
+    "incubo",
+    "indagine",
+    "india",
+    "indole",
+    "inedito",
+    "infatti",
+    "infilare",
+    "inflitto",
+    "ingaggio",
+    "ingegno",
+    "inglese",
+    "ingordo",
+    "ingrosso",
+    "innesco",
+    "inodore",
+    "inoltrare",
+    "inondato",
+    "insano",
+    "insetto",
+    "insieme",
+    "insonnia",
+    "insulina",
+    "intasato",
+    "intero",
+    "intonaco",
+    "intuito",
+    "inumidire",
+    "invalido",
+    "invece",
+    "invito",
+    "iperbole",
+    "ipnotico",
+    "ipotesi",
+    "ippica",
+    "iride",
+    "irlanda",
+    "ironico",
+    "irrigato",
+    "irrorare",
+    "isolato",
+    "isotopo",
+    "isterico",
+    "istituto",
+    "istrice",
+    "italia",
+    "iterare",
+    "labbro",
+    "labirinto",
+    "lacca",
+    "lacerato",
+    "lacrima",
+    "lacuna",
+    "laddove",
+    "lago",
+    "lampo",
+    "lancetta",
+    "lanterna",
+    "lardoso",
+    "larga",
+    "laringe",
+    "lastra",
+    "latenza",
+    "latino",
+    "lattuga",
+    "lavagna",
+    "lavoro",
+    "legale",
+    "leggero",
+    "lembo",
+    "lentezza",
+    "lenza",
+    "leone",
+    "lepre",
+    "lesivo",
+    "lessato",
+    "lesto",
+    "letterale",
+    "leva",
+    "levigato",
+    "libero",
+    "lido",
+    "lievito",
+    "lilla",
+    "limatura",
+    "limitare",
+    "limpido",
+    "lineare",
+    "lingua",
+    "liquido",
+    "lira",
+    "lirica",
+    "lisca",
+    "lite",
+    "litigio",
+    "livrea",
+    "locanda",
+    "lode",
+    "logica",
+    "lombare",
+    "londra",
+    "longevo",
+    "loquace",
+    "lorenzo",
+    "loto",
+    "lotteria",
+    "luce",
+    "lucidato",
+    "lumaca",
+    "luminoso",
+    "lungo",
+    "lupo",
+    "luppolo",
+    "lusinga",
+    "lusso",
+    "lutto",
+    "macabro",
+    "macchina",
+    "macero",
+    "macinato",
+    "madama",
+    "magico",
+    "maglia",
+    "magnete",
+    "magro",
+    "maiolica",
+    "malafede",
+    "malgrado",
+    "malinteso",
+    "malsano",
+    "malto",
+    "malumore",
+    "mana",
+    "mancia",
+    "mandorla",
+    "mangiare",
+    "manifesto",
+    "mannaro",
+    "manovra",
+    "mansarda",
+    "mantide",
+    "manubrio",
+    "mappa",
+    "maratona",
+    "marcire",
+    "maretta",
+    "marmo",
+    "marsupio",
+    "maschera",
+    "massaia",
+    "mastino",
+    "materasso",
+    "matricola",
+    "mattone",
+    "maturo",
+    "mazurca",
+    "meandro",
+    "meccanico",
+    "mecenate",
+    "medesimo",
+    "meditare",
+    "mega",
+    "melassa",
+    "melis",
+    "melodia",
+    "meninge",
+    "meno",
+    "mensola",
+    "mercurio",
+    "merenda",
+    "merlo",
+    "meschino",
+    "mese",
+    "messere",
+    "mestolo",
+    "metallo",
+    "metodo",
+    "mettere",
+    "miagolare",
+    "mica",
+    "micelio",
+    "michele",
+    "microbo",
+    "midollo",
+    "miele",
+    "migliore",
+    "milano",
+    "milite",
+    "mimosa",
+    "minerale",
+    "mini",
+    "minore",
+    "mirino",
+    "mirtillo",
+    "miscela",
+    "missiva",
+    "misto",
+    "misurare",
+    "mitezza",
+    "mitigare",
+    "mitra",
+    "mittente",
+    "mnemonico",
+    "modello",
+    "modifica",
+    "modulo",
+    "mogano",
+    "mogio",
+    "mole",
+    "molosso",
+    "monastero",
+    "monco",
+    "mondina",
+    "monetario",
+    "monile",
+    "monotono",
+    "monsone",
+    "montato",
+    "monviso",
+    "mora",
+    "mordere",
+    "morsicato",
+    "mostro",
+    "motivato",
+    "motosega",
+    "motto",
+    "movenza",
+    "movimento",
+    "mozzo",
+    "mucca",
+    "mucosa",
+    "muffa",
+    "mughetto",
+    "mugnaio",
+    "mulatto",
+    "mulinello",
+    "multiplo",
+    "mummia",
+    "munto",
+    "muovere",
+    "murale",
+    "musa",
+    "muscolo",
+    "musica",
+    "mutevole",
+    "muto",
+    "nababbo",
+    "nafta",
+    "nanometro",
+    "narciso",
+    "narice",
+    "narrato",
+    "nascere",
+    "nastrare",
+    "naturale",
+    "nautica",
+    "naviglio",
+    "nebulosa",
+    "necrosi",
+    "negativo",
+    "negozio",
+    "nemmeno",
+    "neofita",
+    "neretto",
+    "nervo",
+    "nessuno",
+    "nettuno",
+    "neutrale",
+    "neve",
+    "nevrotico",
+    "nicchia",
+    "ninfa",
+    "nitido",
+    "nobile",
+    "nocivo",
+    "nodo",
+    "nome",
+    "nomina",
+    "nordico",
+    "normale",
+    "norvegese",
+    "nostrano",
+    "notare",
+    "notizia",
+    "notturno",
+    "novella",
+    "nucleo",
+    "nulla",
+    "numero",
+    "nuovo",
+    "nutrire",
+    "nuvola",
+    "nuziale",
+    "oasi",
+    "obbedire",
+    "obbligo",
+    "obelisco",
+    "oblio",
+    "obolo",
+    "obsoleto",
+    "occasione",
+    "occhio",
+    "occidente",
+    "occorrere",
+    "occultare",
+    "ocra",
+    "oculato",
+    "odierno",
+    "odorare",
+    "offerta",
+    "offrire",
+    "offuscato",
+    "oggetto",
+    "oggi",
+    "ognuno",
+    "olandese",
+    "olfatto",
+    "oliato",
+    "oliva",
+    "ologramma",
+    "oltre",
+    "omaggio",
+    "ombelico",
+    "ombra",
+    "omega",
+    "omissione",
+    "ondoso",
+    "onere",
+    "onice",
+    "onnivoro",
+    "onorevole",
+    "onta",
+    "operato",
+    "opinione",
+    "opposto",
+    "oracolo",
+    "orafo",
+    "ordine",
+    "orecchino",
+    "orefice",
+    "orfano",
+    "organico",
+    "origine",
+    "orizzonte",
+    "orma",
+    "ormeggio",
+    "ornativo",
+    "orologio",
+    "orrendo",
+    "orribile",
+    "ortensia",
+    "ortica",
+    "orzata",
+    "orzo",
+    "osare",
+    "oscurare",
+    "osmosi",
+    "ospedale",
+    "ospite",
+    "ossa",
+    "ossidare",
+    "ostacolo",
+    "oste",
+    "otite",
+    "otre",
+    "ottagono",
+    "ottimo",
+    "ottobre",
+    "ovale",
+    "ovest",
+    "ovino",
+    "oviparo",
+    "ovocito",
+    "ovunque",
+    "ovviare",
+    "ozio",
+    "pacchetto",
+    "pace",
+    "pacifico",
+    "padella",
+    "padrone",
+    "paese",
+    "paga",
+    "pagina",
+    "palazzina",
+    "palesare",
+    "pallido",
+    "palo",
+    "palude",
+    "pandoro",
+    "pannello",
+    "paolo",
+    "paonazzo",
+    "paprica",
+    "parabola",
+    "parcella",
+    "parere",
+    "pargolo",
+    "pari",
+    "parlato",
+    "parola",
+    "partire",
+    "parvenza",
+    "parziale",
+    "passivo",
+    "pasticca",
+    "patacca",
+    "patologia",
+    "pattume",
+    "pavone",
+    "peccato",
+    "pedalare",
+    "pedonale",
+    "peggio",
+    "peloso",
+    "penare",
+    "pendice",
+    "penisola",
+    "pennuto",
+    "penombra",
+    "pensare",
+    "pentola",
+    "pepe",
+    "pepita",
+    "perbene",
+    "percorso",
+    "perdonato",
+    "perforare",
+    "pergamena",
+    "periodo",
+    "permesso",
+    "perno",
+    "perplesso",
+    "persuaso",
+    "pertugio",
+    "pervaso",
+    "pesatore",
+    "pesista",
+    "peso",
+    "pestifero",
+    "petalo",
+    "pettine",
+    "petulante",
+    "pezzo",
+    "piacere",
+    "pianta",
+    "piattino",
+    "piccino",
+    "picozza",
+    "piega",
+    "pietra",
+    "piffero",
+    "pigiama",
+    "pigolio",
+    "pigro",
+    "pila",
+    "pilifero",
+    "pillola",
+    "pilota",
+    "pimpante",
+    "pineta",
+    "pinna",
+    "pinolo",
+    "pioggia",
+    "piombo",
+    "piramide",
+    "piretico",
+    "pirite",
+    "pirolisi",
+    "pitone",
+    "pizzico",
+    "placebo",
+    "planare",
+    "plasma",
+    "platano",
+    "plenario",
+    "pochezza",
+    "poderoso",
+    "podismo",
+    "poesia",
+    "poggiare",
+    "polenta",
+    "poligono",
+    "pollice",
+    "polmonite",
+    "polpetta",
+    "polso",
+    "poltrona",
+    "polvere",
+    "pomice",
+    "pomodoro",
+    "ponte",
+    "popoloso",
+    "porfido",
+    "poroso",
+    "porpora",
+    "porre",
+    "portata",
+    "posa",
+    "positivo",
+    "possesso",
+    "postulato",
+    "potassio",
+    "potere",
+    "pranzo",
+    "prassi",
+    "pratica",
+    "precluso",
+    "predica",
+    "prefisso",
+    "pregiato",
+    "prelievo",
+    "premere",
+    "prenotare",
+    "preparato",
+    "presenza",
+    "pretesto",
+    "prevalso",
+    "prima",
+    "principe",
+    "privato",
+    "problema",
+    "procura",
+    "produrre",
+    "profumo",
+    "progetto",
+    "prolunga",
+    "promessa",
+    "pronome",
+    "proposta",
+    "proroga",
+    "proteso",
+    "prova",
+    "prudente",
+    "prugna",
+    "prurito",
+    "psiche",
+    "pubblico",
+    "pudica",
+    "pugilato",
+    "pugno",
+    "pulce",
+    "pulito",
+    "pulsante",
+    "puntare",
+    "pupazzo",
+    "pupilla",
+    "puro",
+    "quadro",
+    "qualcosa",
+    "quasi",
+    "querela",
+    "quota",
+    "raccolto",
+    "raddoppio",
+    "radicale",
+    "radunato",
+    "raffica",
+    "ragazzo",
+    "ragione",
+    "ragno",
+    "ramarro",
+    "ramingo",
+    "ramo",
+    "randagio",
+    "rantolare",
+    "rapato",
+    "rapina",
+    "rappreso",
+    "rasatura",
+    "raschiato",
+    "rasente",
+    "rassegna",
+    "rastrello",
+    "rata",
+    "ravveduto",
+    "reale",
+    "recepire",
+    "recinto",
+    "recluta",
+    "recondito",
+    "recupero",
+    "reddito",
+    "redimere",
+    "regalato",
+    "registro",
+    "regola",
+    "regresso",
+    "relazione",
+    "remare",
+    "remoto",
+    "renna",
+    "replica",
+    "reprimere",
+    "reputare",
+    "resa",
+    "residente",
+    "responso",
+    "restauro",
+    "rete",
+    "retina",
+    "retorica",
+    "rettifica",
+    "revocato",
+    "riassunto",
+    "ribadire",
+    "ribelle",
+    "ribrezzo",
+    "ricarica",
+    "ricco",
+    "ricevere",
+    "riciclato",
+    "ricordo",
+    "ricreduto",
+    "ridicolo",
+    "ridurre",
+    "rifasare",
+    "riflesso",
+    "riforma",
+    "rifugio",
+    "rigare",
+    "rigettato",
+    "righello",
+    "rilassato",
+    "rilevato",
+    "rimanere",
+    "rimbalzo",
+    "rimedio",
+    "rimorchio",
+    "rinascita",
+    "rincaro",
+    "rinforzo",
+    "rinnovo",
+    "rinomato",
+    "rinsavito",
+    "rintocco",
+    "rinuncia",
+    "rinvenire",
+    "riparato",
+    "ripetuto",
+    "ripieno",
+    "riportare",
+    "ripresa",
+    "ripulire",
+    "risata",
+    "rischio",
+    "riserva",
+    "risibile",
+    "riso",
+    "rispetto",
+    "ristoro",
+    "risultato",
+    "risvolto",
+    "ritardo",
+    "ritegno",
+    "ritmico",
+    "ritrovo",
+    "riunione",
+    "riva",
+    "riverso",
+    "rivincita",
+    "rivolto",
+    "rizoma",
+    "roba",
+    "robotico",
+    "robusto",
+    "roccia",
+    "roco",
+    "rodaggio",
+    "rodere",
+    "roditore",
+    "rogito",
+    "rollio",
+    "romantico",
+    "rompere",
+    "ronzio",
+    "rosolare",
+    "rospo",
+    "rotante",
+    "rotondo",
+    "rotula",
+    "rovescio",
+    "rubizzo",
+    "rubrica",
+    "ruga",
+    "rullino",
+    "rumine",
+    "rumoroso",
+    "ruolo",
+    "rupe",
+    "russare",
+    "rustico",
+    "sabato",
+    "sabbiare",
+    "sabotato",
+    "sagoma",
+    "salasso",
+    "saldatura",
+    "salgemma",
+    "salivare",
+    "salmone",
+    "salone",
+    "saltare",
+    "saluto",
+    "salvo",
+    "sapere",
+    "sapido",
+    "saporito",
+    "saraceno",
+    "sarcasmo",
+    "sarto",
+    "sassoso",
+    "satellite",
+    "satira",
+    "satollo",
+    "saturno",
+    "savana",
+    "savio",
+    "saziato",
+    "sbadiglio",
+    "sbalzo",
+    "sbancato",
+    "sbarra",
+    "sbattere",
+    "sbavare",
+    "sbendare",
+    "sbirciare",
+    "sbloccato",
+    "sbocciato",
+    "sbrinare",
+    "sbruffone",
+    "sbuffare",
+    "scabroso",
+    "scadenza",
+    "scala",
+    "scambiare",
+    "scandalo",
+    "scapola",
+    "scarso",
+    "scatenare",
+    "scavato",
+    "scelto",
+    "scenico",
+    "scettro",
+    "scheda",
+    "schiena",
+    "sciarpa",
+    "scienza",
+    "scindere",
+    "scippo",
+    "sciroppo",
+    "scivolo",
+    "sclerare",
+    "scodella",
+    "scolpito",
+    "scomparto",
+    "sconforto",
+    "scoprire",
+    "scorta",
+    "scossone",
+    "scozzese",
+    "scriba",
+    "scrollare",
+    "scrutinio",
+    "scuderia",
+    "scultore",
+    "scuola",
+    "scuro",
+    "scusare",
+    "sdebitare",
+    "sdoganare",
+    "seccatura",
+    "secondo",
+    "sedano",
+    "seggiola",
+    "segnalato",
+    "segregato",
+    "seguito",
+    "selciato",
+    "selettivo",
+    "sella",
+    "selvaggio",
+    "semaforo",
+    "sembrare",
+    "seme",
+    "seminato",
+    "sempre",
+    "senso",
+    "sentire",
+    "sepolto",
+    "sequenza",
+    "serata",
+    "serbato",
+    "sereno",
+    "serio",
+    "serpente",
+    "serraglio",
+    "servire",
+    "sestina",
+    "setola",
+    "settimana",
+    "sfacelo",
+    "sfaldare",
+    "sfamato",
+    "sfarzoso",
+    "sfaticato",
+    "sfera",
+    "sfida",
+    "sfilato",
+    "sfinge",
+    "sfocato",
+    "sfoderare",
+    "sfogo",
+    "sfoltire",
+    "sforzato",
+    "sfratto",
+    "sfruttato",
+    "sfuggito",
+    "sfumare",
+    "sfuso",
+    "sgabello",
+    "sgarbato",
+    "sgonfiare",
+    "sgorbio",
+    "sgrassato",
+    "sguardo",
+    "sibilo",
+    "siccome",
+    "sierra",
+    "sigla",
+    "signore",
+    "silenzio",
+    "sillaba",
+    "simbolo",
+    "simpatico",
+    "simulato",
+    "sinfonia",
+    "singolo",
+    "sinistro",
+    "sino",
+    "sintesi",
+    "sinusoide",
+    "sipario",
+    "sisma",
+    "sistole",
+    "situato",
+    "slitta",
+    "slogatura",
+    "sloveno",
+    "smarrito",
+    "smemorato",
+    "smentito",
+    "smeraldo",
+    "smilzo",
+    "smontare",
+    "smottato",
+    "smussato",
+    "snellire",
+    "snervato",
+    "snodo",
+    "sobbalzo",
+    "sobrio",
+    "soccorso",
+    "sociale",
+    "sodale",
+    "soffitto",
+    "sogno",
+    "soldato",
+    "solenne",
+    "solido",
+    "sollazzo",
+    "solo",
+    "solubile",
+    "solvente",
+    "somatico",
+    "somma",
+    "sonda",
+    "sonetto",
+    "sonnifero",
+    "sopire",
+    "soppeso",
+    "sopra",
+    "sorgere",
+    "sorpasso",
+    "sorriso",
+    "sorso",
+    "sorteggio",
+    "sorvolato",
+    "sospiro",
+    "sosta",
+    "sottile",
+    "spada",
+    "spalla",
+    "spargere",
+    "spatola",
+    "spavento",
+    "spazzola",
+    "specie",
+    "spedire",
+    "spegnere",
+    "spelatura",
+    "speranza",
+    "spessore",
+    "spettrale",
+    "spezzato",
+    "spia",
+    "spigoloso",
+    "spillato",
+    "spinoso",
+    "spirale",
+    "splendido",
+    "sportivo",
+    "sposo",
+    "spranga",
+    "sprecare",
+    "spronato",
+    "spruzzo",
+    "spuntino",
+    "squillo",
+    "sradicare",
+    "srotolato",
+    "stabile",
+    "stacco",
+    "staffa",
+    "stagnare",
+    "stampato",
+    "stantio",
+    "starnuto",
+    "stasera",
+    "statuto",
+    "stelo",
+    "steppa",
+    "sterzo",
+    "stiletto",
+    "stima",
+    "stirpe",
+    "stivale",
+    "stizzoso",
+    "stonato",
+    "storico",
+    "strappo",
+    "stregato",
+    "stridulo",
+    "strozzare",
+    "strutto",
+    "stuccare",
+    "stufo",
+    "stupendo",
+    "subentro",
+    "succoso",
+    "sudore",
+    "suggerito",
+    "sugo",
+    "sultano",
+    "suonare",
+    "superbo",
+    "supporto",
+    "surgelato",
+    "surrogato",
+    "sussurro",
+    "sutura",
+    "svagare",
+    "svedese",
+    "sveglio",
+    "svelare",
+    "svenuto",
+    "svezia",
+    "sviluppo",
+    "svista",
+    "svizzera",
+    "svolta",
+    "svuotare",
+    "tabacco",
+    "tabulato",
+    "tacciare",
+    "taciturno",
+    "tale",
+    "talismano",
+    "tampone",
+    "tannino",
+    "tara",
+    "tardivo",
+    "targato",
+    "tariffa",
+    "tarpare",
+    "tartaruga",
+    "tasto",
+    "tattico",
+    "taverna",
+    "tavolata",
+    "tazza",
+    "teca",
+    "tecnico",
+    "telefono",
+    "temerario",
+    "tempo",
+    "temuto",
+    "tendone",
+    "tenero",
+    "tensione",
+    "tentacolo",
+    "teorema",
+    "terme",
+    "terrazzo",
+    "terzetto",
+    "tesi",
+    "tesserato",
+    "testato",
+    "tetro",
+    "tettoia",
+    "tifare",
+    "tigella",
+    "timbro",
+    "tinto",
+    "tipico",
+    "tipografo",
+    "tiraggio",
+    "tiro",
+    "titanio",
+    "titolo",
+    "titubante",
+    "tizio",
+    "tizzone",
+    "toccare",
+    "tollerare",
+    "tolto",
+    "tombola",
+    "tomo",
+    "tonfo",
+    "tonsilla",
+    "topazio",
+    "topologia",
+    "toppa",
+    "torba",
+    "tornare",
+    "torrone",
+    "tortora",
+    "toscano",
+    "tossire",
+    "tostatura",
+    "totano",
+    "trabocco",
+    "trachea",
+    "trafila",
+    "tragedia",
+    "tralcio",
+    "tramonto",
+    "transito",
+    "trapano",
+    "trarre",
+    "trasloco",
+    "trattato",
+    "trave",
+    "treccia",
+    "tremolio",
+    "trespolo",
+    "tributo",
+    "tricheco",
+    "trifoglio",
+    "trillo",
+    "trincea",
+    "trio",
+    "tristezza",
+    "triturato",
+    "trivella",
+    "tromba",
+    "trono",
+    "troppo",
+    "trottola",
+    "trovare",
+    "truccato",
+    "tubatura",
+    "tuffato",
+    "tulipano",
+    "tumulto",
+    "tunisia",
+    "turbare",
+    "turchino",
+    "tuta",
+    "tutela",
+    "ubicato",
+    "uccello",
+    "uccisore",
+    "udire",
+    "uditivo",
+    "uffa",
+    "ufficio",
+    "uguale",
+    "ulisse",
+    "ultimato",
+    "umano",
+    "umile",
+    "umorismo",
+    "uncinetto",
+    "ungere",
+    "ungherese",
+    "unicorno",
+    "unificato",
+    "unisono",
+    "unitario",
+    "unte",
+    "uovo",
+    "upupa",
+    "uragano",
+    "urgenza",
+    "urlo",
+    "usanza",
+    "usato",
+    "uscito",
+    "usignolo",
+    "usuraio",
+    "utensile",
+    "utilizzo",
+    "utopia",
+    "vacante",
+    "vaccinato",
+    "vagabondo",
+    "vagliato",
+    "valanga",
+    "valgo",
+    "valico",
+    "valletta",
+    "valoroso",
+    "valutare",
+    "valvola",
+    "vampata",
+    "vangare",
+    "vanitoso",
+    "vano",
+    "vantaggio",
+    "vanvera",
+    "vapore",
+    "varano",
+    "varcato",
+    "variante",
+    "vasca",
+    "vedetta",
+    "vedova",
+    "veduto",
+    "vegetale",
+    "veicolo",
+    "velcro",
+    "velina",
+    "velluto",
+    "veloce",
+    "venato",
+    "vendemmia",
+    "vento",
+    "verace",
+    "verbale",
+    "vergogna",
+    "verifica",
+    "vero",
+    "verruca",
+    "verticale",
+    "vescica",
+    "vessillo",
+    "vestale",
+    "veterano",
+    "vetrina",
+    "vetusto",
+    "viandante",
+    "vibrante",
+    "vicenda",
+    "vichingo",
+    "vicinanza",
+    "vidimare",
+    "vigilia",
+    "vigneto",
+    "vigore",
+    "vile",
+    "villano",
+    "vimini",
+    "vincitore",
+    "viola",
+    "vipera",
+    "virgola",
+    "virologo",
+    "virulento",
+    "viscoso",
+    "visione",
+    "vispo",
+    "vissuto",
+    "visura",
+    "vita",
+    "vitello",
+    "vittima",
+    "vivanda",
+    "vivido",
+    "viziare",
+    "voce",
+    "voga",
+    "volatile",
+    "volere",
+    "volpe",
+    "voragine",
+    "vulcano",
+    "zampogna",
+    "zanna",
+    "zappato",
+    "zattera",
+    "zavorra",
+    "zefiro",
+    "zelante",
+    "zelo",
+    "zenzero",
+    "zerbino",
+    "zibetto",
+    "zinco",
+    "zircone",
+    "zitto",
+    "zolla",
+    "zotico",
+    "zucchero",
+    "zufolo",
+    "zulu",
+    "zuppa",
+}
+var rwords_italian = map[string]int16{
+    "abaco": 0,
+    "abbaglio": 1,
+    "abbinato": 2,
+    "abete": 3,
+    "abisso": 4,
+    "abolire": 5,
+    "abrasivo": 6,
+    "abrogato": 7,
+    "accadere": 8,
+    "accenno": 9,
+    "accusato": 10,
+    "acetone": 11,
+    "achille": 12,
+    "acido": 13,
+    "acqua": 14,
+    "acre": 15,
+    "acrilico": 16,
+    "acrobata": 17,
+    "acuto": 18,
+    "adagio": 19,
+    "addebito": 20,
+    "addome": 21,
+    "adeguato": 22,
+    "aderire": 23,
+    "adipe": 24,
+    "adottare": 25,
+    "adulare": 26,
+    "affabile": 27,
+    "affetto": 28,
+    "affisso": 29,
+    "affranto": 30,
+    "aforisma": 31,
+    "afoso": 32,
+    "africano": 33,
+    "agave": 34,
+    "agente": 35,
+    "agevole": 36,
+    "aggancio": 37,
+    "agire": 38,
+    "agitare": 39,
+    "agonismo": 40,
+    "agricolo": 41,
+    "agrumeto": 42,
+    "aguzzo": 43,
+    "alabarda": 44,
+    "alato": 45,
+    "albatro": 46,
+    "alberato": 47,
+    "albo": 48,
+    "albume": 49,
+    "alce": 50,
+    "alcolico": 51,
+    "alettone": 52,
+    "alfa": 53,
+    "algebra": 54,
+    "aliante": 55,
+    "alibi": 56,
+    "alimento": 57,
+    "allagato": 58,
+    "allegro": 59,
+    "allievo": 60,
+    "allodola": 61,
+    "allusivo": 62,
+    "almeno": 63,
+    "alogeno": 64,
+    "alpaca": 65,
+    "alpestre": 66,
+    "altalena": 67,
+    "alterno": 68,
+    "alticcio": 69,
+    "altrove": 70,
+    "alunno": 71,
+    "alveolo": 72,
+    "alzare": 73,
+    "amalgama": 74,
+    "amanita": 75,
+    "amarena": 76,
+    "ambito": 77,
+    "ambrato": 78,
+    "ameba": 79,
+    "america": 80,
+    "ametista": 81,
+    "amico": 82,
+    "ammasso": 83,
+    "ammenda": 84,
+    "ammirare": 85,
+    "ammonito": 86,
+    "amore": 87,
+    "ampio": 88,
+    "ampliare": 89,
+    "amuleto": 90,
+    "anacardo": 91,
+    "anagrafe": 92,
+    "analista": 93,
+    "anarchia": 94,
+    "anatra": 95,
+    "anca": 96,
+    "ancella": 97,
+    "ancora": 98,
+    "andare": 99,
+    "andrea": 100,
+    "anello": 101,
+    "angelo": 102,
+    "angolare": 103,
+    "angusto": 104,
+    "anima": 105,
+    "annegare": 106,
+    "annidato": 107,
+    "anno": 108,
+    "annuncio": 109,
+    "anonimo": 110,
+    "anticipo": 111,
+    "anzi": 112,
+    "apatico": 113,
+    "apertura": 114,
+    "apode": 115,
+    "apparire": 116,
+    "appetito": 117,
+    "appoggio": 118,
+    "approdo": 119,
+    "appunto": 120,
+    "aprile": 121,
+    "arabica": 122,
+    "arachide": 123,
+    "aragosta": 124,
+    "araldica": 125,
+    "arancio": 126,
+    "aratura": 127,
+    "arazzo": 128,
+    "arbitro": 129,
+    "archivio": 130,
+    "ardito": 131,
+    "arenile": 132,
+    "argento": 133,
+    "argine": 134,
+    "arguto": 135,
+    "aria": 136,
+    "armonia": 137,
+    "arnese": 138,
+    "arredato": 139,
+    "arringa": 140,
+    "arrosto": 141,
+    "arsenico": 142,
+    "arso": 143,
+    "artefice": 144,
+    "arzillo": 145,
+    "asciutto": 146,
+    "ascolto": 147,
+    "asepsi": 148,
+    "asettico": 149,
+    "asfalto": 150,
+    "asino": 151,
+    "asola": 152,
+    "aspirato": 153,
+    "aspro": 154,
+    "assaggio": 155,
+    "asse": 156,
+    "assoluto": 157,
+    "assurdo": 158,
+    "asta": 159,
+    "astenuto": 160,
+    "astice": 161,
+    "astratto": 162,
+    "atavico": 163,
+    "ateismo": 164,
+    "atomico": 165,
+    "atono": 166,
+    "attesa": 167,
+    "attivare": 168,
+    "attorno": 169,
+    "attrito": 170,
+    "attuale": 171,
+    "ausilio": 172,
+    "austria": 173,
+    "autista": 174,
+    "autonomo": 175,
+    "autunno": 176,
+    "avanzato": 177,
+    "avere": 178,
+    "avvenire": 179,
+    "avviso": 180,
+    "avvolgere": 181,
+    "azione": 182,
+    "azoto": 183,
+    "azzimo": 184,
+    "azzurro": 185,
+    "babele": 186,
+    "baccano": 187,
+    "bacino": 188,
+    "baco": 189,
+    "badessa": 190,
+    "badilata": 191,
+    "bagnato": 192,
+    "baita": 193,
+    "balcone": 194,
+    "baldo": 195,
+    "balena": 196,
+    "ballata": 197,
+    "balzano": 198,
+    "bambino": 199,
+    "bandire": 200,
+    "baraonda": 201,
+    "barbaro": 202,
+    "barca": 203,
+    "baritono": 204,
+    "barlume": 205,
+    "barocco": 206,
+    "basilico": 207,
+    "basso": 208,
+    "batosta": 209,
+    "battuto": 210,
+    "baule": 211,
+    "bava": 212,
+    "bavosa": 213,
+    "becco": 214,
+    "beffa": 215,
+    "belgio": 216,
+    "belva": 217,
+    "benda": 218,
+    "benevole": 219,
+    "benigno": 220,
+    "benzina": 221,
+    "bere": 222,
+    "berlina": 223,
+    "beta": 224,
+    "bibita": 225,
+    "bici": 226,
+    "bidone": 227,
+    "bifido": 228,
+    "biga": 229,
+    "bilancia": 230,
+    "bimbo": 231,
+    "binocolo": 232,
+    "biologo": 233,
+    "bipede": 234,
+    "bipolare": 235,
+    "birbante": 236,
+    "birra": 237,
+    "biscotto": 238,
+    "bisesto": 239,
+    "bisnonno": 240,
+    "bisonte": 241,
+    "bisturi": 242,
+    "bizzarro": 243,
+    "blando": 244,
+    "blatta": 245,
+    "bollito": 246,
+    "bonifico": 247,
+    "bordo": 248,
+    "bosco": 249,
+    "botanico": 250,
+    "bottino": 251,
+    "bozzolo": 252,
+    "braccio": 253,
+    "bradipo": 254,
+    "brama": 255,
+    "branca": 256,
+    "bravura": 257,
+    "bretella": 258,
+    "brevetto": 259,
+    "brezza": 260,
+    "briglia": 261,
+    "brillante": 262,
+    "brindare": 263,
+    "broccolo": 264,
+    "brodo": 265,
+    "bronzina": 266,
+    "brullo": 267,
+    "bruno": 268,
+    "bubbone": 269,
+    "buca": 270,
+    "budino": 271,
+    "buffone": 272,
+    "buio": 273,
+    "bulbo": 274,
+    "buono": 275,
+    "burlone": 276,
+    "burrasca": 277,
+    "bussola": 278,
+    "busta": 279,
+    "cadetto": 280,
+    "caduco": 281,
+    "calamaro": 282,
+    "calcolo": 283,
+    "calesse": 284,
+    "calibro": 285,
+    "calmo": 286,
+    "caloria": 287,
+    "cambusa": 288,
+    "camerata": 289,
+    "camicia": 290,
+    "cammino": 291,
+    "camola": 292,
+    "campale": 293,
+    "canapa": 294,
+    "candela": 295,
+    "cane": 296,
+    "canino": 297,
+    "canotto": 298,
+    "cantina": 299,
+    "capace": 300,
+    "capello": 301,
+    "capitolo": 302,
+    "capogiro": 303,
+    "cappero": 304,
+    "capra": 305,
+    "capsula": 306,
+    "carapace": 307,
+    "carcassa": 308,
+    "cardo": 309,
+    "carisma": 310,
+    "carovana": 311,
+    "carretto": 312,
+    "cartolina": 313,
+    "casaccio": 314,
+    "cascata": 315,
+    "caserma": 316,
+    "caso": 317,
+    "cassone": 318,
+    "castello": 319,
+    "casuale": 320,
+    "catasta": 321,
+    "catena": 322,
+    "catrame": 323,
+    "cauto": 324,
+    "cavillo": 325,
+    "cedibile": 326,
+    "cedrata": 327,
+    "cefalo": 328,
+    "celebre": 329,
+    "cellulare": 330,
+    "cena": 331,
+    "cenone": 332,
+    "centesimo": 333,
+    "ceramica": 334,
+    "cercare": 335,
+    "certo": 336,
+    "cerume": 337,
+    "cervello": 338,
+    "cesoia": 339,
+    "cespo": 340,
+    "ceto": 341,
+    "chela": 342,
+    "chiaro": 343,
+    "chicca": 344,
+    "chiedere": 345,
+    "chimera": 346,
+    "china": 347,
+    "chirurgo": 348,
+    "chitarra": 349,
+    "ciao": 350,
+    "ciclismo": 351,
+    "cifrare": 352,
+    "cigno": 353,
+    "cilindro": 354,
+    "ciottolo": 355,
+    "circa": 356,
+    "cirrosi": 357,
+    "citrico": 358,
+    "cittadino": 359,
+    "ciuffo": 360,
+    "civetta": 361,
+    "civile": 362,
+    "classico": 363,
+    "clinica": 364,
+    "cloro": 365,
+    "cocco": 366,
+    "codardo": 367,
+    "codice": 368,
+    "coerente": 369,
+    "cognome": 370,
+    "collare": 371,
+    "colmato": 372,
+    "colore": 373,
+    "colposo": 374,
+    "coltivato": 375,
+    "colza": 376,
+    "coma": 377,
+    "cometa": 378,
+    "commando": 379,
+    "comodo": 380,
+    "computer": 381,
+    "comune": 382,
+    "conciso": 383,
+    "condurre": 384,
+    "conferma": 385,
+    "congelare": 386,
+    "coniuge": 387,
+    "connesso": 388,
+    "conoscere": 389,
+    "consumo": 390,
+    "continuo": 391,
+    "convegno": 392,
+    "coperto": 393,
+    "copione": 394,
+    "coppia": 395,
+    "copricapo": 396,
+    "corazza": 397,
+    "cordata": 398,
+    "coricato": 399,
+    "cornice": 400,
+    "corolla": 401,
+    "corpo": 402,
+    "corredo": 403,
+    "corsia": 404,
+    "cortese": 405,
+    "cosmico": 406,
+    "costante": 407,
+    "cottura": 408,
+    "covato": 409,
+    "cratere": 410,
+    "cravatta": 411,
+    "creato": 412,
+    "credere": 413,
+    "cremoso": 414,
+    "crescita": 415,
+    "creta": 416,
+    "criceto": 417,
+    "crinale": 418,
+    "crisi": 419,
+    "critico": 420,
+    "croce": 421,
+    "cronaca": 422,
+    "crostata": 423,
+    "cruciale": 424,
+    "crusca": 425,
+    "cucire": 426,
+    "cuculo": 427,
+    "cugino": 428,
+    "cullato": 429,
+    "cupola": 430,
+    "curatore": 431,
+    "cursore": 432,
+    "curvo": 433,
+    "cuscino": 434,
+    "custode": 435,
+    "dado": 436,
+    "daino": 437,
+    "dalmata": 438,
+    "damerino": 439,
+    "daniela": 440,
+    "dannoso": 441,
+    "danzare": 442,
+    "datato": 443,
+    "davanti": 444,
+    "davvero": 445,
+    "debutto": 446,
+    "decennio": 447,
+    "deciso": 448,
+    "declino": 449,
+    "decollo": 450,
+    "decreto": 451,
+    "dedicato": 452,
+    "definito": 453,
+    "deforme": 454,
+    "degno": 455,
+    "delegare": 456,
+    "delfino": 457,
+    "delirio": 458,
+    "delta": 459,
+    "demenza": 460,
+    "denotato": 461,
+    "dentro": 462,
+    "deposito": 463,
+    "derapata": 464,
+    "derivare": 465,
+    "deroga": 466,
+    "descritto": 467,
+    "deserto": 468,
+    "desiderio": 469,
+    "desumere": 470,
+    "detersivo": 471,
+    "devoto": 472,
+    "diametro": 473,
+    "dicembre": 474,
+    "diedro": 475,
+    "difeso": 476,
+    "diffuso": 477,
+    "digerire": 478,
+    "digitale": 479,
+    "diluvio": 480,
+    "dinamico": 481,
+    "dinnanzi": 482,
+    "dipinto": 483,
+    "diploma": 484,
+    "dipolo": 485,
+    "diradare": 486,
+    "dire": 487,
+    "dirotto": 488,
+    "dirupo": 489,
+    "disagio": 490,
+    "discreto": 491,
+    "disfare": 492,
+    "disgelo": 493,
+    "disposto": 494,
+    "distanza": 495,
+    "disumano": 496,
+    "dito": 497,
+    "divano": 498,
+    "divelto": 499,
+    "dividere": 500,
+    "divorato": 501,
+    "doblone": 502,
+    "docente": 503,
+    "doganale": 504,
+    "dogma": 505,
+    "dolce": 506,
+    "domato": 507,
+    "domenica": 508,
+    "dominare": 509,
+    "dondolo": 510,
+    "dono": 511,
+    "dormire": 512,
+    "dote": 513,
+    "dottore": 514,
+    "dovuto": 515,
+    "dozzina": 516,
+    "drago": 517,
+    "druido": 518,
+    "dubbio": 519,
+    "dubitare": 520,
+    "ducale": 521,
+    "duna": 522,
+    "duomo": 523,
+    "duplice": 524,
+    "duraturo": 525,
+    "ebano": 526,
+    "eccesso": 527,
+    "ecco": 528,
+    "eclissi": 529,
+    "economia": 530,
+    "edera": 531,
+    "edicola": 532,
+    "edile": 533,
+    "editoria": 534,
+    "educare": 535,
+    "egemonia": 536,
+    "egli": 537,
+    "egoismo": 538,
+    "egregio": 539,
+    "elaborato": 540,
+    "elargire": 541,
+    "elegante": 542,
+    "elencato": 543,
+    "eletto": 544,
+    "elevare": 545,
+    "elfico": 546,
+    "elica": 547,
+    "elmo": 548,
+    "elsa": 549,
+    "eluso": 550,
+    "emanato": 551,
+    "emblema": 552,
+    "emesso": 553,
+    "emiro": 554,
+    "emotivo": 555,
+    "emozione": 556,
+    "empirico": 557,
+    "emulo": 558,
+    "endemico": 559,
+    "enduro": 560,
+    "energia": 561,
+    "enfasi": 562,
+    "enoteca": 563,
+    "entrare": 564,
+    "enzima": 565,
+    "epatite": 566,
+    "epilogo": 567,
+    "episodio": 568,
+    "epocale": 569,
+    "eppure": 570,
+    "equatore": 571,
+    "erario": 572,
+    "erba": 573,
+    "erboso": 574,
+    "erede": 575,
+    "eremita": 576,
+    "erigere": 577,
+    "ermetico": 578,
+    "eroe": 579,
+    "erosivo": 580,
+    "errante": 581,
+    "esagono": 582,
+    "esame": 583,
+    "esanime": 584,
+    "esaudire": 585,
+    "esca": 586,
+    "esempio": 587,
+    "esercito": 588,
+    "esibito": 589,
+    "esigente": 590,
+    "esistere": 591,
+    "esito": 592,
+    "esofago": 593,
+    "esortato": 594,
+    "esoso": 595,
+    "espanso": 596,
+    "espresso": 597,
+    "essenza": 598,
+    "esso": 599,
+    "esteso": 600,
+    "estimare": 601,
+    "estonia": 602,
+    "estroso": 603,
+    "esultare": 604,
+    "etilico": 605,
+    "etnico": 606,
+    "etrusco": 607,
+    "etto": 608,
+    "euclideo": 609,
+    "europa": 610,
+    "evaso": 611,
+    "evidenza": 612,
+    "evitato": 613,
+    "evoluto": 614,
+    "evviva": 615,
+    "fabbrica": 616,
+    "faccenda": 617,
+    "fachiro": 618,
+    "falco": 619,
+    "famiglia": 620,
+    "fanale": 621,
+    "fanfara": 622,
+    "fango": 623,
+    "fantasma": 624,
+    "fare": 625,
+    "farfalla": 626,
+    "farinoso": 627,
+    "farmaco": 628,
+    "fascia": 629,
+    "fastoso": 630,
+    "fasullo": 631,
+    "faticare": 632,
+    "fato": 633,
+    "favoloso": 634,
+    "febbre": 635,
+    "fecola": 636,
+    "fede": 637,
+    "fegato": 638,
+    "felpa": 639,
+    "feltro": 640,
+    "femmina": 641,
+    "fendere": 642,
+    "fenomeno": 643,
+    "fermento": 644,
+    "ferro": 645,
+    "fertile": 646,
+    "fessura": 647,
+    "festivo": 648,
+    "fetta": 649,
+    "feudo": 650,
+    "fiaba": 651,
+    "fiducia": 652,
+    "fifa": 653,
+    "figurato": 654,
+    "filo": 655,
+    "finanza": 656,
+    "finestra": 657,
+    "finire": 658,
+    "fiore": 659,
+    "fiscale": 660,
+    "fisico": 661,
+    "fiume": 662,
+    "flacone": 663,
+    "flamenco": 664,
+    "flebo": 665,
+    "flemma": 666,
+    "florido": 667,
+    "fluente": 668,
+    "fluoro": 669,
+    "fobico": 670,
+    "focaccia": 671,
+    "focoso": 672,
+    "foderato": 673,
+    "foglio": 674,
+    "folata": 675,
+    "folclore": 676,
+    "folgore": 677,
+    "fondente": 678,
+    "fonetico": 679,
+    "fonia": 680,
+    "fontana": 681,
+    "forbito": 682,
+    "forchetta": 683,
+    "foresta": 684,
+    "formica": 685,
+    "fornaio": 686,
+    "foro": 687,
+    "fortezza": 688,
+    "forzare": 689,
+    "fosfato": 690,
+    "fosso": 691,
+    "fracasso": 692,
+    "frana": 693,
+    "frassino": 694,
+    "fratello": 695,
+    "freccetta": 696,
+    "frenata": 697,
+    "fresco": 698,
+    "frigo": 699,
+    "frollino": 700,
+    "fronde": 701,
+    "frugale": 702,
+    "frutta": 703,
+    "fucilata": 704,
+    "fucsia": 705,
+    "fuggente": 706,
+    "fulmine": 707,
+    "fulvo": 708,
+    "fumante": 709,
+    "fumetto": 710,
+    "fumoso": 711,
+    "fune": 712,
+    "funzione": 713,
+    "fuoco": 714,
+    "furbo": 715,
+    "furgone": 716,
+    "furore": 717,
+    "fuso": 718,
+    "futile": 719,
+    "gabbiano": 720,
+    "gaffe": 721,
+    "galateo": 722,
+    "gallina": 723,
+    "galoppo": 724,
+    "gambero": 725,
+    "gamma": 726,
+    "garanzia": 727,
+    "garbo": 728,
+    "garofano": 729,
+    "garzone": 730,
+    "gasdotto": 731,
+    "gasolio": 732,
+    "gastrico": 733,
+    "gatto": 734,
+    "gaudio": 735,
+    "gazebo": 736,
+    "gazzella": 737,
+    "geco": 738,
+    "gelatina": 739,
+    "gelso": 740,
+    "gemello": 741,
+    "gemmato": 742,
+    "gene": 743,
+    "genitore": 744,
+    "gennaio": 745,
+    "genotipo": 746,
+    "gergo": 747,
+    "ghepardo": 748,
+    "ghiaccio": 749,
+    "ghisa": 750,
+    "giallo": 751,
+    "gilda": 752,
+    "ginepro": 753,
+    "giocare": 754,
+    "gioiello": 755,
+    "giorno": 756,
+    "giove": 757,
+    "girato": 758,
+    "girone": 759,
+    "gittata": 760,
+    "giudizio": 761,
+    "giurato": 762,
+    "giusto": 763,
+    "globulo": 764,
+    "glutine": 765,
+    "gnomo": 766,
+    "gobba": 767,
+    "golf": 768,
+    "gomito": 769,
+    "gommone": 770,
+    "gonfio": 771,
+    "gonna": 772,
+    "governo": 773,
+    "gracile": 774,
+    "grado": 775,
+    "grafico": 776,
+    "grammo": 777,
+    "grande": 778,
+    "grattare": 779,
+    "gravoso": 780,
+    "grazia": 781,
+    "greca": 782,
+    "gregge": 783,
+    "grifone": 784,
+    "grigio": 785,
+    "grinza": 786,
+    "grotta": 787,
+    "gruppo": 788,
+    "guadagno": 789,
+    "guaio": 790,
+    "guanto": 791,
+    "guardare": 792,
+    "gufo": 793,
+    "guidare": 794,
+    "ibernato": 795,
+    "icona": 796,
+    "identico": 797,
+    "idillio": 798,
+    "idolo": 799,
+    "idra": 800,
+    "idrico": 801,
+    "idrogeno": 802,
+    "igiene": 803,
+    "ignaro": 804,
+    "ignorato": 805,
+    "ilare": 806,
+    "illeso": 807,
+    "illogico": 808,
+    "illudere": 809,
+    "imballo": 810,
+    "imbevuto": 811,
+    "imbocco": 812,
+    "imbuto": 813,
+    "immane": 814,
+    "immerso": 815,
+    "immolato": 816,
+    "impacco": 817,
+    "impeto": 818,
+    "impiego": 819,
+    "importo": 820,
+    "impronta": 821,
+    "inalare": 822,
+    "inarcare": 823,
+    "inattivo": 824,
+    "incanto": 825,
+    "incendio": 826,
+    "inchino": 827,
+    "incisivo": 828,
+    "incluso": 829,
+    "incontro": 830,
+    "incrocio": 831,
+    "incubo": 832,
+    "indagine": 833,
+    "india": 834,
+    "indole": 835,
+    "inedito": 836,
+    "infatti": 837,
+    "infilare": 838,
+    "inflitto": 839,
+    "ingaggio": 840,
+    "ingegno": 841,
+    "inglese": 842,
+    "ingordo": 843,
+    "ingrosso": 844,
+    "innesco": 845,
+    "inodore": 846,
+    "inoltrare": 847,
+    "inondato": 848,
+    "insano": 849,
+    "insetto": 850,
+    "insieme": 851,
+    "insonnia": 852,
+    "insulina": 853,
+    "intasato": 854,
+    "intero": 855,
+    "intonaco": 856,
+    "intuito": 857,
+    "inumidire": 858,
+    "invalido": 859,
+    "invece": 860,
+    "invito": 861,
+    "iperbole": 862,
+    "ipnotico": 863,
+    "ipotesi": 864,
+    "ippica": 865,
+    "iride": 866,
+    "irlanda": 867,
+    "ironico": 868,
+    "irrigato": 869,
+    "irrorare": 870,
+    "isolato": 871,
+    "isotopo": 872,
+    "isterico": 873,
+    "istituto": 874,
+    "istrice": 875,
+    "italia": 876,
+    "iterare": 877,
+    "labbro": 878,
+    "labirinto": 879,
+    "lacca": 880,
+    "lacerato": 881,
+    "lacrima": 882,
+    "lacuna": 883,
+    "laddove": 884,
+    "lago": 885,
+    "lampo": 886,
+    "lancetta": 887,
+    "lanterna": 888,
+    "lardoso": 889,
+    "larga": 890,
+    "laringe": 891,
+    "lastra": 892,
+    "latenza": 893,
+    "latino": 894,
+    "lattuga": 895,
+    "lavagna": 896,
+    "lavoro": 897,
+    "legale": 898,
+    "leggero": 899,
+    "lembo": 900,
+    "lentezza": 901,
+    "lenza": 902,
+    "leone": 903,
+    "lepre": 904,
+    "lesivo": 905,
+    "lessato": 906,
+    "lesto": 907,
+    "letterale": 908,
+    "leva": 909,
+    "levigato": 910,
+    "libero": 911,
+    "lido": 912,
+    "lievito": 913,
+    "lilla": 914,
+    "limatura": 915,
+    "limitare": 916,
+    "limpido": 917,
+    "lineare": 918,
+    "lingua": 919,
+    "liquido": 920,
+    "lira": 921,
+    "lirica": 922,
+    "lisca": 923,
+    "lite": 924,
+    "litigio": 925,
+    "livrea": 926,
+    "locanda": 927,
+    "lode": 928,
+    "logica": 929,
+    "lombare": 930,
+    "londra": 931,
+    "longevo": 932,
+    "loquace": 933,
+    "lorenzo": 934,
+    "loto": 935,
+    "lotteria": 936,
+    "luce": 937,
+    "lucidato": 938,
+    "lumaca": 939,
+    "luminoso": 940,
+    "lungo": 941,
+    "lupo": 942,
+    "luppolo": 943,
+    "lusinga": 944,
+    "lusso": 945,
+    "lutto": 946,
+    "macabro": 947,
+    "macchina": 948,
+    "macero": 949,
+    "macinato": 950,
+    "madama": 951,
+    "magico": 952,
+    "maglia": 953,
+    "magnete": 954,
+    "magro": 955,
+    "maiolica": 956,
+    "malafede": 957,
+    "malgrado": 958,
+    "malinteso": 959,
+    "malsano": 960,
+    "malto": 961,
+    "malumore": 962,
+    "mana": 963,
+    "mancia": 964,
+    "mandorla": 965,
+    "mangiare": 966,
+    "manifesto": 967,
+    "mannaro": 968,
+    "manovra": 969,
+    "mansarda": 970,
+    "mantide": 971,
+    "manubrio": 972,
+    "mappa": 973,
+    "maratona": 974,
+    "marcire": 975,
+    "maretta": 976,
+    "marmo": 977,
+    "marsupio": 978,
+    "maschera": 979,
+    "massaia": 980,
+    "mastino": 981,
+    "materasso": 982,
+    "matricola": 983,
+    "mattone": 984,
+    "maturo": 985,
+    "mazurca": 986,
+    "meandro": 987,
+    "meccanico": 988,
+    "mecenate": 989,
+    "medesimo": 990,
+    "meditare": 991,
+    "mega": 992,
+    "melassa": 993,
+    "melis": 994,
+    "melodia": 995,
+    "meninge": 996,
+    "meno": 997,
+    "mensola": 998,
+    "mercurio": 999,
+    "merenda": 1000,
+    "merlo": 1001,
+    "meschino": 1002,
+    "mese": 1003,
+    "messere": 1004,
+    "mestolo": 1005,
+    "metallo": 1006,
+    "metodo": 1007,
+    "mettere": 1008,
+    "miagolare": 1009,
+    "mica": 1010,
+    "micelio": 1011,
+    "michele": 1012,
+    "microbo": 1013,
+    "midollo": 1014,
+    "miele": 1015,
+    "migliore": 1016,
+    "milano": 1017,
+    "milite": 1018,
+    "mimosa": 1019,
+    "minerale": 1020,
+    "mini": 1021,
+    "minore": 1022,
+    "mirino": 1023,
+    "mirtillo": 1024,
+    "miscela": 1025,
+    "missiva": 1026,
+    "misto": 1027,
+    "misurare": 1028,
+    "mitezza": 1029,
+    "mitigare": 1030,
+    "mitra": 1031,
+    "mittente": 1032,
+    "mnemonico": 1033,
+    "modello": 1034,
+    "modifica": 1035,
+    "modulo": 1036,
+    "mogano": 1037,
+    "mogio": 1038,
+    "mole": 1039,
+    "molosso": 1040,
+    "monastero": 1041,
+    "monco": 1042,
+    "mondina": 1043,
+    "monetario": 1044,
+    "monile": 1045,
+    "monotono": 1046,
+    "monsone": 1047,
+    "montato": 1048,
+    "monviso": 1049,
+    "mora": 1050,
+    "mordere": 1051,
+    "morsicato": 1052,
+    "mostro": 1053,
+    "motivato": 1054,
+    "motosega": 1055,
+    "motto": 1056,
+    "movenza": 1057,
+    "movimento": 1058,
+    "mozzo": 1059,
+    "mucca": 1060,
+    "mucosa": 1061,
+    "muffa": 1062,
+    "mughetto": 1063,
+    "mugnaio": 1064,
+    "mulatto": 1065,
+    "mulinello": 1066,
+    "multiplo": 1067,
+    "mummia": 1068,
+    "munto": 1069,
+    "muovere": 1070,
+    "murale": 1071,
+    "musa": 1072,
+    "muscolo": 1073,
+    "musica": 1074,
+    "mutevole": 1075,
+    "muto": 1076,
+    "nababbo": 1077,
+    "nafta": 1078,
+    "nanometro": 1079,
+    "narciso": 1080,
+    "narice": 1081,
+    "narrato": 1082,
+    "nascere": 1083,
+    "nastrare": 1084,
+    "naturale": 1085,
+    "nautica": 1086,
+    "naviglio": 1087,
+    "nebulosa": 1088,
+    "necrosi": 1089,
+    "negativo": 1090,
+    "negozio": 1091,
+    "nemmeno": 1092,
+    "neofita": 1093,
+    "neretto": 1094,
+    "nervo": 1095,
+    "nessuno": 1096,
+    "nettuno": 1097,
+    "neutrale": 1098,
+    "neve": 1099,
+    "nevrotico": 1100,
+    "nicchia": 1101,
+    "ninfa": 1102,
+    "nitido": 1103,
+    "nobile": 1104,
+    "nocivo": 1105,
+    "nodo": 1106,
+    "nome": 1107,
+    "nomina": 1108,
+    "nordico": 1109,
+    "normale": 1110,
+    "norvegese": 1111,
+    "nostrano": 1112,
+    "notare": 1113,
+    "notizia": 1114,
+    "notturno": 1115,
+    "novella": 1116,
+    "nucleo": 1117,
+    "nulla": 1118,
+    "numero": 1119,
+    "nuovo": 1120,
+    "nutrire": 1121,
+    "nuvola": 1122,
+    "nuziale": 1123,
+    "oasi": 1124,
+    "obbedire": 1125,
+    "obbligo": 1126,
+    "obelisco": 1127,
+    "oblio": 1128,
+    "obolo": 1129,
+    "obsoleto": 1130,
+    "occasione": 1131,
+    "occhio": 1132,
+    "occidente": 1133,
+    "occorrere": 1134,
+    "occultare": 1135,
+    "ocra": 1136,
+    "oculato": 1137,
+    "odierno": 1138,
+    "odorare": 1139,
+    "offerta": 1140,
+    "offrire": 1141,
+    "offuscato": 1142,
+    "oggetto": 1143,
+    "oggi": 1144,
+    "ognuno": 1145,
+    "olandese": 1146,
+    "olfatto": 1147,
+    "oliato": 1148,
+    "oliva": 1149,
+    "ologramma": 1150,
+    "oltre": 1151,
+    "omaggio": 1152,
+    "ombelico": 1153,
+    "ombra": 1154,
+    "omega": 1155,
+    "omissione": 1156,
+    "ondoso": 1157,
+    "onere": 1158,
+    "onice": 1159,
+    "onnivoro": 1160,
+    "onorevole": 1161,
+    "onta": 1162,
+    "operato": 1163,
+    "opinione": 1164,
+    "opposto": 1165,
+    "oracolo": 1166,
+    "orafo": 1167,
+    "ordine": 1168,
+    "orecchino": 1169,
+    "orefice": 1170,
+    "orfano": 1171,
+    "organico": 1172,
+    "origine": 1173,
+    "orizzonte": 1174,
+    "orma": 1175,
+    "ormeggio": 1176,
+    "ornativo": 1177,
+    "orologio": 1178,
+    "orrendo": 1179,
+    "orribile": 1180,
+    "ortensia": 1181,
+    "ortica": 1182,
+    "orzata": 1183,
+    "orzo": 1184,
+    "osare": 1185,
+    "oscurare": 1186,
+    "osmosi": 1187,
+    "ospedale": 1188,
+    "ospite": 1189,
+    "ossa": 1190,
+    "ossidare": 1191,
+    "ostacolo": 1192,
+    "oste": 1193,
+    "otite": 1194,
+    "otre": 1195,
+    "ottagono": 1196,
+    "ottimo": 1197,
+    "ottobre": 1198,
+    "ovale": 1199,
+    "ovest": 1200,
+    "ovino": 1201,
+    "oviparo": 1202,
+    "ovocito": 1203,
+    "ovunque": 1204,
+    "ovviare": 1205,
+    "ozio": 1206,
+    "pacchetto": 1207,
+    "pace": 1208,
+    "pacifico": 1209,
+    "padella": 1210,
+    "padrone": 1211,
+    "paese": 1212,
+    "paga": 1213,
+    "pagina": 1214,
+    "palazzina": 1215,
+    "palesare": 1216,
+    "pallido": 1217,
+    "palo": 1218,
+    "palude": 1219,
+    "pandoro": 1220,
+    "pannello": 1221,
+    "paolo": 1222,
+    "paonazzo": 1223,
+    "paprica": 1224,
+    "parabola": 1225,
+    "parcella": 1226,
+    "parere": 1227,
+    "pargolo": 1228,
+    "pari": 1229,
+    "parlato": 1230,
+    "parola": 1231,
+    "partire": 1232,
+    "parvenza": 1233,
+    "parziale": 1234,
+    "passivo": 1235,
+    "pasticca": 1236,
+    "patacca": 1237,
+    "patologia": 1238,
+    "pattume": 1239,
+    "pavone": 1240,
+    "peccato": 1241,
+    "pedalare": 1242,
+    "pedonale": 1243,
+    "peggio": 1244,
+    "peloso": 1245,
+    "penare": 1246,
+    "pendice": 1247,
+    "penisola": 1248,
+    "pennuto": 1249,
+    "penombra": 1250,
+    "pensare": 1251,
+    "pentola": 1252,
+    "pepe": 1253,
+    "pepita": 1254,
+    "perbene": 1255,
+    "percorso": 1256,
+    "perdonato": 1257,
+    "perforare": 1258,
+    "pergamena": 1259,
+    "periodo": 1260,
+    "permesso": 1261,
+    "perno": 1262,
+    "perplesso": 1263,
+    "persuaso": 1264,
+    "pertugio": 1265,
+    "pervaso": 1266,
+    "pesatore": 1267,
+    "pesista": 1268,
+    "peso": 1269,
+    "pestifero": 1270,
+    "petalo": 1271,
+    "pettine": 1272,
+    "petulante": 1273,
+    "pezzo": 1274,
+    "piacere": 1275,
+    "pianta": 1276,
+    "piattino": 1277,
+    "piccino": 1278,
+    "picozza": 1279,
+    "piega": 1280,
+    "pietra": 1281,
+    "piffero": 1282,
+    "pigiama": 1283,
+    "pigolio": 1284,
+    "pigro": 1285,
+    "pila": 1286,
+    "pilifero": 1287,
+    "pillola": 1288,
+    "pilota": 1289,
+    "pimpante": 1290,
+    "pineta": 1291,
+    "pinna": 1292,
+    "pinolo": 1293,
+    "pioggia": 1294,
+    "piombo": 1295,
+    "piramide": 1296,
+    "piretico": 1297,
+    "pirite": 1298,
+    "pirolisi": 1299,
+    "pitone": 1300,
+    "pizzico": 1301,
+    "placebo": 1302,
+    "planare": 1303,
+    "plasma": 1304,
+    "platano": 1305,
+    "plenario": 1306,
+    "pochezza": 1307,
+    "poderoso": 1308,
+    "podismo": 1309,
+    "poesia": 1310,
+    "poggiare": 1311,
+    "polenta": 1312,
+    "poligono": 1313,
+    "pollice": 1314,
+    "polmonite": 1315,
+    "polpetta": 1316,
+    "polso": 1317,
+    "poltrona": 1318,
+    "polvere": 1319,
+    "pomice": 1320,
+    "pomodoro": 1321,
+    "ponte": 1322,
+    "popoloso": 1323,
+    "porfido": 1324,
+    "poroso": 1325,
+    "porpora": 1326,
+    "porre": 1327,
+    "portata": 1328,
+    "posa": 1329,
+    "positivo": 1330,
+    "possesso": 1331,
+    "postulato": 1332,
+    "potassio": 1333,
+    "potere": 1334,
+    "pranzo": 1335,
+    "prassi": 1336,
+    "pratica": 1337,
+    "precluso": 1338,
+    "predica": 1339,
+    "prefisso": 1340,
+    "pregiato": 1341,
+    "prelievo": 1342,
+    "premere": 1343,
+    "prenotare": 1344,
+    "preparato": 1345,
+    "presenza": 1346,
+    "pretesto": 1347,
+    "prevalso": 1348,
+    "prima": 1349,
+    "principe": 1350,
+    "privato": 1351,
+    "problema": 1352,
+    "procura": 1353,
+    "produrre": 1354,
+    "profumo": 1355,
+    "progetto": 1356,
+    "prolunga": 1357,
+    "promessa": 1358,
+    "pronome": 1359,
+    "proposta": 1360,
+    "proroga": 1361,
+    "proteso": 1362,
+    "prova": 1363,
+    "prudente": 1364,
+    "prugna": 1365,
+    "prurito": 1366,
+    "psiche": 1367,
+    "pubblico": 1368,
+    "pudica": 1369,
+    "pugilato": 1370,
+    "pugno": 1371,
+    "pulce": 1372,
+    "pulito": 1373,
+    "pulsante": 1374,
+    "puntare": 1375,
+    "pupazzo": 1376,
+    "pupilla": 1377,
+    "puro": 1378,
+    "quadro": 1379,
+    "qualcosa": 1380,
+    "quasi": 1381,
+    "querela": 1382,
+    "quota": 1383,
+    "raccolto": 1384,
+    "raddoppio": 1385,
+    "radicale": 1386,
+    "radunato": 1387,
+    "raffica": 1388,
+    "ragazzo": 1389,
+    "ragione": 1390,
+    "ragno": 1391,
+    "ramarro": 1392,
+    "ramingo": 1393,
+    "ramo": 1394,
+    "randagio": 1395,
+    "rantolare": 1396,
+    "rapato": 1397,
+    "rapina": 1398,
+    "rappreso": 1399,
+    "rasatura": 1400,
+    "raschiato": 1401,
+    "rasente": 1402,
+    "rassegna": 1403,
+    "rastrello": 1404,
+    "rata": 1405,
+    "ravveduto": 1406,
+    "reale": 1407,
+    "recepire": 1408,
+    "recinto": 1409,
+    "recluta": 1410,
+    "recondito": 1411,
+    "recupero": 1412,
+    "reddito": 1413,
+    "redimere": 1414,
+    "regalato": 1415,
+    "registro": 1416,
+    "regola": 1417,
+    "regresso": 1418,
+    "relazione": 1419,
+    "remare": 1420,
+    "remoto": 1421,
+    "renna": 1422,
+    "replica": 1423,
+    "reprimere": 1424,
+    "reputare": 1425,
+    "resa": 1426,
+    "residente": 1427,
+    "responso": 1428,
+    "restauro": 1429,
+    "rete": 1430,
+    "retina": 1431,
+    "retorica": 1432,
+    "rettifica": 1433,
+    "revocato": 1434,
+    "riassunto": 1435,
+    "ribadire": 1436,
+    "ribelle": 1437,
+    "ribrezzo": 1438,
+    "ricarica": 1439,
+    "ricco": 1440,
+    "ricevere": 1441,
+    "riciclato": 1442,
+    "ricordo": 1443,
+    "ricreduto": 1444,
+    "ridicolo": 1445,
+    "ridurre": 1446,
+    "rifasare": 1447,
+    "riflesso": 1448,
+    "riforma": 1449,
+    "rifugio": 1450,
+    "rigare": 1451,
+    "rigettato": 1452,
+    "righello": 1453,
+    "rilassato": 1454,
+    "rilevato": 1455,
+    "rimanere": 1456,
+    "rimbalzo": 1457,
+    "rimedio": 1458,
+    "rimorchio": 1459,
+    "rinascita": 1460,
+    "rincaro": 1461,
+    "rinforzo": 1462,
+    "rinnovo": 1463,
+    "rinomato": 1464,
+    "rinsavito": 1465,
+    "rintocco": 1466,
+    "rinuncia": 1467,
+    "rinvenire": 1468,
+    "riparato": 1469,
+    "ripetuto": 1470,
+    "ripieno": 1471,
+    "riportare": 1472,
+    "ripresa": 1473,
+    "ripulire": 1474,
+    "risata": 1475,
+    "rischio": 1476,
+    "riserva": 1477,
+    "risibile": 1478,
+    "riso": 1479,
+    "rispetto": 1480,
+    "ristoro": 1481,
+    "risultato": 1482,
+    "risvolto": 1483,
+    "ritardo": 1484,
+    "ritegno": 1485,
+    "ritmico": 1486,
+    "ritrovo": 1487,
+    "riunione": 1488,
+    "riva": 1489,
+    "riverso": 1490,
+    "rivincita": 1491,
+    "rivolto": 1492,
+    "rizoma": 1493,
+    "roba": 1494,
+    "robotico": 1495,
+    "robusto": 1496,
+    "roccia": 1497,
+    "roco": 1498,
+    "rodaggio": 1499,
+    "rodere": 1500,
+    "roditore": 1501,
+    "rogito": 1502,
+    "rollio": 1503,
+    "romantico": 1504,
+    "rompere": 1505,
+    "ronzio": 1506,
+    "rosolare": 1507,
+    "rospo": 1508,
+    "rotante": 1509,
+    "rotondo": 1510,
+    "rotula": 1511,
+    "rovescio": 1512,
+    "rubizzo": 1513,
+    "rubrica": 1514,
+    "ruga": 1515,
+    "rullino": 1516,
+    "rumine": 1517,
+    "rumoroso": 1518,
+    "ruolo": 1519,
+    "rupe": 1520,
+    "russare": 1521,
+    "rustico": 1522,
+    "sabato": 1523,
+    "sabbiare": 1524,
+    "sabotato": 1525,
+    "sagoma": 1526,
+    "salasso": 1527,
+    "saldatura": 1528,
+    "salgemma": 1529,
+    "salivare": 1530,
+    "salmone": 1531,
+    "salone": 1532,
+    "saltare": 1533,
+    "saluto": 1534,
+    "salvo": 1535,
+    "sapere": 1536,
+    "sapido": 1537,
+    "saporito": 1538,
+    "saraceno": 1539,
+    "sarcasmo": 1540,
+    "sarto": 1541,
+    "sassoso": 1542,
+    "satellite": 1543,
+    "satira": 1544,
+    "satollo": 1545,
+    "saturno": 1546,
+    "savana": 1547,
+    "savio": 1548,
+    "saziato": 1549,
+    "sbadiglio": 1550,
+    "sbalzo": 1551,
+    "sbancato": 1552,
+    "sbarra": 1553,
+    "sbattere": 1554,
+    "sbavare": 1555,
+    "sbendare": 1556,
+    "sbirciare": 1557,
+    "sbloccato": 1558,
+    "sbocciato": 1559,
+    "sbrinare": 1560,
+    "sbruffone": 1561,
+    "sbuffare": 1562,
+    "scabroso": 1563,
+    "scadenza": 1564,
+    "scala": 1565,
+    "scambiare": 1566,
+    "scandalo": 1567,
+    "scapola": 1568,
+    "scarso": 1569,
+    "scatenare": 1570,
+    "scavato": 1571,
+    "scelto": 1572,
+    "scenico": 1573,
+    "scettro": 1574,
+    "scheda": 1575,
+    "schiena": 1576,
+    "sciarpa": 1577,
+    "scienza": 1578,
+    "scindere": 1579,
+    "scippo": 1580,
+    "sciroppo": 1581,
+    "scivolo": 1582,
+    "sclerare": 1583,
+    "scodella": 1584,
+    "scolpito": 1585,
+    "scomparto": 1586,
+    "sconforto": 1587,
+    "scoprire": 1588,
+    "scorta": 1589,
+    "scossone": 1590,
+    "scozzese": 1591,
+    "scriba": 1592,
+    "scrollare": 1593,
+    "scrutinio": 1594,
+    "scuderia": 1595,
+    "scultore": 1596,
+    "scuola": 1597,
+    "scuro": 1598,
+    "scusare": 1599,
+    "sdebitare": 1600,
+    "sdoganare": 1601,
+    "seccatura": 1602,
+    "secondo": 1603,
+    "sedano": 1604,
+    "seggiola": 1605,
+    "segnalato": 1606,
+    "segregato": 1607,
+    "seguito": 1608,
+    "selciato": 1609,
+    "selettivo": 1610,
+    "sella": 1611,
+    "selvaggio": 1612,
+    "semaforo": 1613,
+    "sembrare": 1614,
+    "seme": 1615,
+    "seminato": 1616,
+    "sempre": 1617,
+    "senso": 1618,
+    "sentire": 1619,
+    "sepolto": 1620,
+    "sequenza": 1621,
+    "serata": 1622,
+    "serbato": 1623,
+    "sereno": 1624,
+    "serio": 1625,
+    "serpente": 1626,
+    "serraglio": 1627,
+    "servire": 1628,
+    "sestina": 1629,
+    "setola": 1630,
+    "settimana": 1631,
+    "sfacelo": 1632,
+    "sfaldare": 1633,
+    "sfamato": 1634,
+    "sfarzoso": 1635,
+    "sfaticato": 1636,
+    "sfera": 1637,
+    "sfida": 1638,
+    "sfilato": 1639,
+    "sfinge": 1640,
+    "sfocato": 1641,
+    "sfoderare": 1642,
+    "sfogo": 1643,
+    "sfoltire": 1644,
+    "sforzato": 1645,
+    "sfratto": 1646,
+    "sfruttato": 1647,
+    "sfuggito": 1648,
+    "sfumare": 1649,
+    "sfuso": 1650,
+    "sgabello": 1651,
+    "sgarbato": 1652,
+    "sgonfiare": 1653,
+    "sgorbio": 1654,
+    "sgrassato": 1655,
+    "sguardo": 1656,
+    "sibilo": 1657,
+    "siccome": 1658,
+    "sierra": 1659,
+    "sigla": 1660,
+    "signore": 1661,
+    "silenzio": 1662,
+    "sillaba": 1663,
+    "simbolo": 1664,
+    "simpatico": 1665,
+    "simulato": 1666,
+    "sinfonia": 1667,
+    "singolo": 1668,
+    "sinistro": 1669,
+    "sino": 1670,
+    "sintesi": 1671,
+    "sinusoide": 1672,
+    "sipario": 1673,
+    "sisma": 1674,
+    "sistole": 1675,
+    "situato": 1676,
+    "slitta": 1677,
+    "slogatura": 1678,
+    "sloveno": 1679,
+    "smarrito": 1680,
+    "smemorato": 1681,
+    "smentito": 1682,
+    "smeraldo": 1683,
+    "smilzo": 1684,
+    "smontare": 1685,
+    "smottato": 1686,
+    "smussato": 1687,
+    "snellire": 1688,
+    "snervato": 1689,
+    "snodo": 1690,
+    "sobbalzo": 1691,
+    "sobrio": 1692,
+    "soccorso": 1693,
+    "sociale": 1694,
+    "sodale": 1695,
+    "soffitto": 1696,
+    "sogno": 1697,
+    "soldato": 1698,
+    "solenne": 1699,
+    "solido": 1700,
+    "sollazzo": 1701,
+    "solo": 1702,
+    "solubile": 1703,
+    "solvente": 1704,
+    "somatico": 1705,
+    "somma": 1706,
+    "sonda": 1707,
+    "sonetto": 1708,
+    "sonnifero": 1709,
+    "sopire": 1710,
+    "soppeso": 1711,
+    "sopra": 1712,
+    "sorgere": 1713,
+    "sorpasso": 1714,
+    "sorriso": 1715,
+    "sorso": 1716,
+    "sorteggio": 1717,
+    "sorvolato": 1718,
+    "sospiro": 1719,
+    "sosta": 1720,
+    "sottile": 1721,
+    "spada": 1722,
+    "spalla": 1723,
+    "spargere": 1724,
+    "spatola": 1725,
+    "spavento": 1726,
+    "spazzola": 1727,
+    "specie": 1728,
+    "spedire": 1729,
+    "spegnere": 1730,
+    "spelatura": 1731,
+    "speranza": 1732,
+    "spessore": 1733,
+    "spettrale": 1734,
+    "spezzato": 1735,
+    "spia": 1736,
+    "spigoloso": 1737,
+    "spillato": 1738,
+    "spinoso": 1739,
+    "spirale": 1740,
+    "splendido": 1741,
+    "sportivo": 1742,
+    "sposo": 1743,
+    "spranga": 1744,
+    "sprecare": 1745,
+    "spronato": 1746,
+    "spruzzo": 1747,
+    "spuntino": 1748,
+    "squillo": 1749,
+    "sradicare": 1750,
+    "srotolato": 1751,
+    "stabile": 1752,
+    "stacco": 1753,
+    "staffa": 1754,
+    "stagnare": 1755,
+    "stampato": 1756,
+    "stantio": 1757,
+    "starnuto": 1758,
+    "stasera": 1759,
+    "statuto": 1760,
+    "stelo": 1761,
+    "steppa": 1762,
+    "sterzo": 1763,
+    "stiletto": 1764,
+    "stima": 1765,
+    "stirpe": 1766,
+    "stivale": 1767,
+    "stizzoso": 1768,
+    "stonato": 1769,
+    "storico": 1770,
+    "strappo": 1771,
+    "stregato": 1772,
+    "stridulo": 1773,
+    "strozzare": 1774,
+    "strutto": 1775,
+    "stuccare": 1776,
+    "stufo": 1777,
+    "stupendo": 1778,
+    "subentro": 1779,
+    "succoso": 1780,
+    "sudore": 1781,
+    "suggerito": 1782,
+    "sugo": 1783,
+    "sultano": 1784,
+    "suonare": 1785,
+    "superbo": 1786,
+    "supporto": 1787,
+    "surgelato": 1788,
+    "surrogato": 1789,
+    "sussurro": 1790,
+    "sutura": 1791,
+    "svagare": 1792,
+    "svedese": 1793,
+    "sveglio": 1794,
+    "svelare": 1795,
+    "svenuto": 1796,
+    "svezia": 1797,
+    "sviluppo": 1798,
+    "svista": 1799,
+    "svizzera": 1800,
+    "svolta": 1801,
+    "svuotare": 1802,
+    "tabacco": 1803,
+    "tabulato": 1804,
+    "tacciare": 1805,
+    "taciturno": 1806,
+    "tale": 1807,
+    "talismano": 1808,
+    "tampone": 1809,
+    "tannino": 1810,
+    "tara": 1811,
+    "tardivo": 1812,
+    "targato": 1813,
+    "tariffa": 1814,
+    "tarpare": 1815,
+    "tartaruga": 1816,
+    "tasto": 1817,
+    "tattico": 1818,
+    "taverna": 1819,
+    "tavolata": 1820,
+    "tazza": 1821,
+    "teca": 1822,
+    "tecnico": 1823,
+    "telefono": 1824,
+    "temerario": 1825,
+    "tempo": 1826,
+    "temuto": 1827,
+    "tendone": 1828,
+    "tenero": 1829,
+    "tensione": 1830,
+    "tentacolo": 1831,
+    "teorema": 1832,
+    "terme": 1833,
+    "terrazzo": 1834,
+    "terzetto": 1835,
+    "tesi": 1836,
+    "tesserato": 1837,
+    "testato": 1838,
+    "tetro": 1839,
+    "tettoia": 1840,
+    "tifare": 1841,
+    "tigella": 1842,
+    "timbro": 1843,
+    "tinto": 1844,
+    "tipico": 1845,
+    "tipografo": 1846,
+    "tiraggio": 1847,
+    "tiro": 1848,
+    "titanio": 1849,
+    "titolo": 1850,
+    "titubante": 1851,
+    "tizio": 1852,
+    "tizzone": 1853,
+    "toccare": 1854,
+    "tollerare": 1855,
+    "tolto": 1856,
+    "tombola": 1857,
+    "tomo": 1858,
+    "tonfo": 1859,
+    "tonsilla": 1860,
+    "topazio": 1861,
+    "topologia": 1862,
+    "toppa": 1863,
+    "torba": 1864,
+    "tornare": 1865,
+    "torrone": 1866,
+    "tortora": 1867,
+    "toscano": 1868,
+    "tossire": 1869,
+    "tostatura": 1870,
+    "totano": 1871,
+    "trabocco": 1872,
+    "trachea": 1873,
+    "trafila": 1874,
+    "tragedia": 1875,
+    "tralcio": 1876,
+    "tramonto": 1877,
+    "transito": 1878,
+    "trapano": 1879,
+    "trarre": 1880,
+    "trasloco": 1881,
+    "trattato": 1882,
+    "trave": 1883,
+    "treccia": 1884,
+    "tremolio": 1885,
+    "trespolo": 1886,
+    "tributo": 1887,
+    "tricheco": 1888,
+    "trifoglio": 1889,
+    "trillo": 1890,
+    "trincea": 1891,
+    "trio": 1892,
+    "tristezza": 1893,
+    "triturato": 1894,
+    "trivella": 1895,
+    "tromba": 1896,
+    "trono": 1897,
+    "troppo": 1898,
+    "trottola": 1899,
+    "trovare": 1900,
+    "truccato": 1901,
+    "tubatura": 1902,
+    "tuffato": 1903,
+    "tulipano": 1904,
+    "tumulto": 1905,
+    "tunisia": 1906,
+    "turbare": 1907,
+    "turchino": 1908,
+    "tuta": 1909,
+    "tutela": 1910,
+    "ubicato": 1911,
+    "uccello": 1912,
+    "uccisore": 1913,
+    "udire": 1914,
+    "uditivo": 1915,
+    "uffa": 1916,
+    "ufficio": 1917,
+    "uguale": 1918,
+    "ulisse": 1919,
+    "ultimato": 1920,
+    "umano": 1921,
+    "umile": 1922,
+    "umorismo": 1923,
+    "uncinetto": 1924,
+    "ungere": 1925,
+    "ungherese": 1926,
+    "unicorno": 1927,
+    "unificato": 1928,
+    "unisono": 1929,
+    "unitario": 1930,
+    "unte": 1931,
+    "uovo": 1932,
+    "upupa": 1933,
+    "uragano": 1934,
+    "urgenza": 1935,
+    "urlo": 1936,
+    "usanza": 1937,
+    "usato": 1938,
+    "uscito": 1939,
+    "usignolo": 1940,
+    "usuraio": 1941,
+    "utensile": 1942,
+    "utilizzo": 1943,
+    "utopia": 1944,
+    "vacante": 1945,
+    "vaccinato": 1946,
+    "vagabondo": 1947,
+    "vagliato": 1948,
+    "valanga": 1949,
+    "valgo": 1950,
+    "valico": 1951,
+    "valletta": 1952,
+    "valoroso": 1953,
+    "valutare": 1954,
+    "valvola": 1955,
+    "vampata": 1956,
+    "vangare": 1957,
+    "vanitoso": 1958,
+    "vano": 1959,
+    "vantaggio": 1960,
+    "vanvera": 1961,
+    "vapore": 1962,
+    "varano": 1963,
+    "varcato": 1964,
+    "variante": 1965,
+    "vasca": 1966,
+    "vedetta": 1967,
+    "vedova": 1968,
+    "veduto": 1969,
+    "vegetale": 1970,
+    "veicolo": 1971,
+    "velcro": 1972,
+    "velina": 1973,
+    "velluto": 1974,
+    "veloce": 1975,
+    "venato": 1976,
+    "vendemmia": 1977,
+    "vento": 1978,
+    "verace": 1979,
+    "verbale": 1980,
+    "vergogna": 1981,
+    "verifica": 1982,
+    "vero": 1983,
+    "verruca": 1984,
+    "verticale": 1985,
+    "vescica": 1986,
+    "vessillo": 1987,
+    "vestale": 1988,
+    "veterano": 1989,
+    "vetrina": 1990,
+    "vetusto": 1991,
+    "viandante": 1992,
+    "vibrante": 1993,
+    "vicenda": 1994,
+    "vichingo": 1995,
+    "vicinanza": 1996,
+    "vidimare": 1997,
+    "vigilia": 1998,
+    "vigneto": 1999,
+    "vigore": 2000,
+    "vile": 2001,
+    "villano": 2002,
+    "vimini": 2003,
+    "vincitore": 2004,
+    "viola": 2005,
+    "vipera": 2006,
+    "virgola": 2007,
+    "virologo": 2008,
+    "virulento": 2009,
+    "viscoso": 2010,
+    "visione": 2011,
+    "vispo": 2012,
+    "vissuto": 2013,
+    "visura": 2014,
+    "vita": 2015,
+    "vitello": 2016,
+    "vittima": 2017,
+    "vivanda": 2018,
+    "vivido": 2019,
+    "viziare": 2020,
+    "voce": 2021,
+    "voga": 2022,
+    "volatile": 2023,
+    "volere": 2024,
+    "volpe": 2025,
+    "voragine": 2026,
+    "vulcano": 2027,
+    "zampogna": 2028,
+    "zanna": 2029,
+    "zappato": 2030,
+    "zattera": 2031,
+    "zavorra": 2032,
+    "zefiro": 2033,
+    "zelante": 2034,
+    "zelo": 2035,
+    "zenzero": 2036,
+    "zerbino": 2037,
+    "zibetto": 2038,
+    "zinco": 2039,
+    "zircone": 2040,
+    "zitto": 2041,
+    "zolla": 2042,
+    "zotico": 2043,
+    "zucchero": 2044,
+    "zufolo": 2045,
+    "zulu": 2046,
+    "zuppa": 2047,
+}
+var words_japanese = [2048]string{
+    "あいこくしん",
+    "あいさつ",
+    "あいだ",
+    "あおぞら",
+    "あかちゃん",
+    "あきる",
+    "あけがた",
+    "あける",
+    "あこがれる",
+    "あさい",
+    "あさひ",
+    "あしあと",
+    "あじわう",
+    "あずかる",
+    "あずき",
+    "あそぶ",
+    "あたえる",
+    "あたためる",
+    "あたりまえ",
+    "あたる",
+    "あつい",
+    "あつかう",
+    "あっしゅく",
+    "あつまり",
+    "あつめる",
+    "あてな",
+    "あてはまる",
+    "あひる",
+    "あぶら",
+    "あぶる",
+    "あふれる",
+    "あまい",
+    "あまど",
+    "あまやかす",
+    "あまり",
+    "あみもの",
+    "あめりか",
+    "あやまる",
+    "あゆむ",
+    "あらいぐま",
+    "あらし",
+    "あらすじ",
+    "あらためる",
+    "あらゆる",
+    "あらわす",
+    "ありがとう",
+    "あわせる",
+    "あわてる",
+    "あんい",
+    "あんがい",
+    "あんこ",
+    "あんぜん",
+    "あんてい",
+    "あんない",
+    "あんまり",
+    "いいだす",
+    "いおん",
+    "いがい",
+    "いがく",
+    "いきおい",
+    "いきなり",
+    "いきもの",
+    "いきる",
+    "いくじ",
+    "いくぶん",
+    "いけばな",
+    "いけん",
+    "いこう",
+    "いこく",
+    "いこつ",
+    "いさましい",
+    "いさん",
+    "いしき",
+    "いじゅう",
+    "いじょう",
+    "いじわる",
+    "いずみ",
+    "いずれ",
+    "いせい",
+    "いせえび",
+    "いせかい",
+    "いせき",
+    "いぜん",
+    "いそうろう",
+    "いそがしい",
+    "いだい",
+    "いだく",
+    "いたずら",
+    "いたみ",
+    "いたりあ",
+    "いちおう",
+    "いちじ",
+    "いちど",
+    "いちば",
+    "いちぶ",
+    "いちりゅう",
+    "いつか",
+    "いっしゅん",
+    "いっせい",
+    "いっそう",
+    "いったん",
+    "いっち",
+    "いってい",
+    "いっぽう",
+    "いてざ",
+    "いてん",
+    "いどう",
+    "いとこ",
+    "いない",
+    "いなか",
+    "いねむり",
+    "いのち",
+    "いのる",
+    "いはつ",
+    "いばる",
+    "いはん",
+    "いびき",
+    "いひん",
+    "いふく",
+    "いへん",
+    "いほう",
+    "いみん",
+    "いもうと",
+    "いもたれ",
+    "いもり",
+    "いやがる",
+    "いやす",
+    "いよかん",
+    "いよく",
+    "いらい",
+    "いらすと",
+    "いりぐち",
+    "いりょう",
+    "いれい",
+    "いれもの",
+    "いれる",
+    "いろえんぴつ",
+    "いわい",
+    "いわう",
+    "いわかん",
+    "いわば",
+    "いわゆる",
+    "いんげんまめ",
+    "いんさつ",
+    "いんしょう",
+    "いんよう",
+    "うえき",
+    "うえる",
+    "うおざ",
+    "うがい",
+    "うかぶ",
+    "うかべる",
+    "うきわ",
+    "うくらいな",
+    "うくれれ",
+    "うけたまわる",
+    "うけつけ",
+    "うけとる",
+    "うけもつ",
+    "うける",
+    "うごかす",
+    "うごく",
+    "うこん",
+    "うさぎ",
+    "うしなう",
+    "うしろがみ",
+    "うすい",
+    "うすぎ",
+    "うすぐらい",
+    "うすめる",
+    "うせつ",
+    "うちあわせ",
+    "うちがわ",
+    "うちき",
+    "うちゅう",
+    "うっかり",
+    "うつくしい",
+    "うったえる",
+    "うつる",
+    "うどん",
+    "うなぎ",
+    "うなじ",
+    "うなずく",
+    "うなる",
+    "うねる",
+    "うのう",
+    "うぶげ",
+    "うぶごえ",
+    "うまれる",
+    "うめる",
+    "うもう",
+    "うやまう",
+    "うよく",
+    "うらがえす",
+    "うらぐち",
+    "うらない",
+    "うりあげ",
+    "うりきれ",
+    "うるさい",
+    "うれしい",
+    "うれゆき",
+    "うれる",
+    "うろこ",
+    "うわき",
+    "うわさ",
+    "うんこう",
+    "うんちん",
+    "うんてん",
+    "うんどう",
+    "えいえん",
+    "えいが",
+    "えいきょう",
+    "えいご",
+    "えいせい",
+    "えいぶん",
+    "えいよう",
+    "えいわ",
+    "えおり",
+    "えがお",
+    "えがく",
+    "えきたい",
+    "えくせる",
+    "えしゃく",
+    "えすて",
+    "えつらん",
+    "えのぐ",
+    "えほうまき",
+    "えほん",
+    "えまき",
+    "えもじ",
+    "えもの",
+    "えらい",
+    "えらぶ",
+    "えりあ",
+    "えんえん",
+    "えんかい",
+    "えんぎ",
+    "えんげき",
+    "えんしゅう",
+    "えんぜつ",
+    "えんそく",
+    "えんちょう",
+    "えんとつ",
+    "おいかける",
+    "おいこす",
+    "おいしい",
+    "おいつく",
+    "おうえん",
+    "おうさま",
+    "おうじ",
+    "おうせつ",
+    "おうたい",
+    "おうふく",
+    "おうべい",
+    "おうよう",
+    "おえる",
+    "おおい",
+    "おおう",
+    "おおどおり",
+    "おおや",
+    "おおよそ",
+    "おかえり",
+    "おかず",
+    "おがむ",
+    "おかわり",
+    "おぎなう",
+    "おきる",
+    "おくさま",
+    "おくじょう",
+    "おくりがな",
+    "おくる",
+    "おくれる",
+    "おこす",
+    "おこなう",
+    "おこる",
+    "おさえる",
+    "おさない",
+    "おさめる",
+    "おしいれ",
+    "おしえる",
+    "おじぎ",
+    "おじさん",
+    "おしゃれ",
+    "おそらく",
+    "おそわる",
+    "おたがい",
+    "おたく",
+    "おだやか",
+    "おちつく",
+    "おっと",
+    "おつり",
+    "おでかけ",
+    "おとしもの",
+    "おとなしい",
+    "おどり",
+    "おどろかす",
+    "おばさん",
+    "おまいり",
+    "おめでとう",
+    "おもいで",
+    "おもう",
+    "おもたい",
+    "おもちゃ",
+    "おやつ",
+    "おやゆび",
+    "およぼす",
+    "おらんだ",
+    "おろす",
+    "おんがく",
+    "おんけい",
+    "おんしゃ",
+    "おんせん",
+    "おんだん",
+    "おんちゅう",
+    "おんどけい",
+    "かあつ",
+    "かいが",
+    "がいき",
+    "がいけん",
+    "がいこう",
+    "かいさつ",
+    "かいしゃ",
+    "かいすいよく",
+    "かいぜん",
+    "かいぞうど",
+    "かいつう",
+    "かいてん",
+    "かいとう",
+    "かいふく",
+    "がいへき",
+    "かいほう",
+    "かいよう",
+    "がいらい",
+    "かいわ",
+    "かえる",
+    "かおり",
+    "かかえる",
+    "かがく",
+    "かがし",
+    "かがみ",
+    "かくご",
+    "かくとく",
+    "かざる",
+    "がぞう",
+    "かたい",
+    "かたち",
+    "がちょう",
+    "がっきゅう",
+    "がっこう",
+    "がっさん",
+    "がっしょう",
+    "かなざわし",
+    "かのう",
+    "がはく",
+    "かぶか",
+    "かほう",
+    "かほご",
+    "かまう",
+    "かまぼこ",
+    "かめれおん",
+    "かゆい",
+    "かようび",
+    "からい",
+    "かるい",
+    "かろう",
+    "かわく",
+    "かわら",
+    "がんか",
+    "かんけい",
+    "かんこう",
+    "かんしゃ",
+    "かんそう",
+    "かんたん",
+    "かんち",
+    "がんばる",
+    "きあい",
+    "きあつ",
+    "きいろ",
+    "ぎいん",
+    "きうい",
+    "きうん",
+    "きえる",
+    "きおう",
+    "きおく",
+    "きおち",
+    "きおん",
+    "きかい",
+    "きかく",
+    "きかんしゃ",
+    "ききて",
+    "きくばり",
+    "きくらげ",
+    "きけんせい",
+    "きこう",
+    "きこえる",
+    "きこく",
+    "きさい",
+    "きさく",
+    "きさま",
+    "きさらぎ",
+    "ぎじかがく",
+    "ぎしき",
+    "ぎじたいけん",
+    "ぎじにってい",
+    "ぎじゅつしゃ",
+    "きすう",
+    "きせい",
+    "きせき",
+    "きせつ",
+    "きそう",
+    "きぞく",
+    "きぞん",
+    "きたえる",
+    "きちょう",
+    "きつえん",
+    "ぎっちり",
+    "きつつき",
+    "きつね",
+    "きてい",
+    "きどう",
+    "きどく",
+    "きない",
+    "きなが",
+    "きなこ",
+    "きぬごし",
+    "きねん",
+    "きのう",
+    "きのした",
+    "きはく",
+    "きびしい",
+    "きひん",
+    "きふく",
+    "きぶん",
+    "きぼう",
+    "きほん",
+    "きまる",
+    "きみつ",
+    "きむずかしい",
+    "きめる",
+    "きもだめし",
+    "きもち",
+    "きもの",
+    "きゃく",
+    "きやく",
+    "ぎゅうにく",
+    "きよう",
+    "きょうりゅう",
+    "きらい",
+    "きらく",
+    "きりん",
+    "きれい",
+    "きれつ",
+    "きろく",
+    "ぎろん",
+    "きわめる",
+    "ぎんいろ",
+    "きんかくじ",
+    "きんじょ",
+    "きんようび",
+    "ぐあい",
+    "くいず",
+    "くうかん",
+    "くうき",
+    "くうぐん",
+    "くうこう",
+    "ぐうせい",
+    "くうそう",
+    "ぐうたら",
+    "くうふく",
+    "くうぼ",
+    "くかん",
+    "くきょう",
+    "くげん",
+    "ぐこう",
+    "くさい",
+    "くさき",
+    "くさばな",
+    "くさる",
+    "くしゃみ",
+    "くしょう",
+    "くすのき",
+    "くすりゆび",
+    "くせげ",
+    "くせん",
+    "ぐたいてき",
+    "くださる",
+    "くたびれる",
+    "くちこみ",
+    "くちさき",
+    "くつした",
+    "ぐっすり",
+    "くつろぐ",
+    "くとうてん",
+    "くどく",
+    "くなん",
+    "くねくね",
+    "くのう",
+    "くふう",
+    "くみあわせ",
+    "くみたてる",
+    "くめる",
+    "くやくしょ",
+    "くらす",
+    "くらべる",
+    "くるま",
+    "くれる",
+    "くろう",
+    "くわしい",
+    "ぐんかん",
+    "ぐんしょく",
+    "ぐんたい",
+    "ぐんて",
+    "けあな",
+    "けいかく",
+    "けいけん",
+    "けいこ",
+    "けいさつ",
+    "げいじゅつ",
+    "けいたい",
+    "げいのうじん",
+    "けいれき",
+    "けいろ",
+    "けおとす",
+    "けおりもの",
+    "げきか",
+    "げきげん",
+    "げきだん",
+    "げきちん",
+    "げきとつ",
+    "げきは",
+    "げきやく",
+    "げこう",
+    "げこくじょう",
+    "げざい",
+    "けさき",
+    "げざん",
+    "けしき",
+    "けしごむ",
+    "けしょう",
+    "げすと",
+    "けたば",
+    "けちゃっぷ",
+    "けちらす",
+    "けつあつ",
+    "けつい",
+    "けつえき",
+    "けっこん",
+    "けつじょ",
+    "けっせき",
+    "けってい",
+    "けつまつ",
+    "げつようび",
+    "げつれい",
+    "けつろん",
+    "げどく",
+    "けとばす",
+    "けとる",
+    "けなげ",
+    "けなす",
+    "けなみ",
+    "けぬき",
+    "げねつ",
+    "けねん",
+    "けはい",
+    "げひん",
+    "けぶかい",
+    "げぼく",
+    "けまり",
+    "けみかる",
+    "けむし",
+    "けむり",
+    "けもの",
+    "けらい",
+    "けろけろ",
+    "けわしい",
+    "けんい",
+    "けんえつ",
+    "けんお",
+    "けんか",
+    "げんき",
+    "けんげん",
+    "けんこう",
+    "けんさく",
+    "けんしゅう",
+    "けんすう",
+    "げんそう",
+    "けんちく",
+    "けんてい",
+    "けんとう",
+    "けんない",
+    "けんにん",
+    "げんぶつ",
+    "けんま",
+    "けんみん",
+    "けんめい",
+    "けんらん",
+    "けんり",
+    "こあくま",
+    "こいぬ",
+    "こいびと",
+    "ごうい",
+    "こうえん",
+    "こうおん",
+    "こうかん",
+    "ごうきゅう",
+    "ごうけい",
+    "こうこう",
+    "こうさい",
+    "こうじ",
+    "こうすい",
+    "ごうせい",
+    "こうそく",
+    "こうたい",
+    "こうちゃ",
+    "こうつう",
+    "こうてい",
+    "こうどう",
+    "こうない",
+    "こうはい",
+    "ごうほう",
+    "ごうまん",
+    "こうもく",
+    "こうりつ",
+    "こえる",
+    "こおり",
+    "ごかい",
+    "ごがつ",
+    "ごかん",
+    "こくご",
+    "こくさい",
+    "こくとう",
+    "こくない",
+    "こくはく",
+    "こぐま",
+    "こけい",
+    "こける",
+    "ここのか",
+    "こころ",
+    "こさめ",
+    "こしつ",
+    "こすう",
+    "こせい",
+    "こせき",
+    "こぜん",
+    "こそだて",
+    "こたい",
+    "こたえる",
+    "こたつ",
+    "こちょう",
+    "こっか",
+    "こつこつ",
+    "こつばん",
+    "こつぶ",
+    "こてい",
+    "こてん",
+    "ことがら",
+    "ことし",
+    "ことば",
+    "ことり",
+    "こなごな",
+    "こねこね",
+    "このまま",
+    "このみ",
+    "このよ",
+    "ごはん",
+    "こひつじ",
+    "こふう",
+    "こふん",
+    "こぼれる",
+    "ごまあぶら",
+    "こまかい",
+    "ごますり",
+    "こまつな",
+    "こまる",
+    "こむぎこ",
+    "こもじ",
+    "こもち",
+    "こもの",
+    "こもん",
+    "こやく",
+    "こやま",
+    "こゆう",
+    "こゆび",
+    "こよい",
+    "こよう",
+    "こりる",
+    "これくしょん",
+    "ころっけ",
+    "こわもて",
+    "こわれる",
+    "こんいん",
+    "こんかい",
+    "こんき",
+    "こんしゅう",
+    "こんすい",
+    "こんだて",
+    "こんとん",
+    "こんなん",
+    "こんびに",
+    "こんぽん",
+    "こんまけ",
+    "こんや",
+    "こんれい",
+    "こんわく",
+    "ざいえき",
+    "さいかい",
+    "さいきん",
+    "ざいげん",
+    "ざいこ",
+    "さいしょ",
+    "さいせい",
+    "ざいたく",
+    "ざいちゅう",
+    "さいてき",
+    "ざいりょう",
+    "さうな",
+    "さかいし",
+    "さがす",
+    "さかな",
+    "さかみち",
+    "さがる",
+    "さぎょう",
+    "さくし",
+    "さくひん",
+    "さくら",
+    "さこく",
+    "さこつ",
+    "さずかる",
+    "ざせき",
+    "さたん",
+    "さつえい",
+    "ざつおん",
+    "ざっか",
+    "ざつがく",
+    "さっきょく",
+    "ざっし",
+    "さつじん",
+    "ざっそう",
+    "さつたば",
+    "さつまいも",
+    "さてい",
+    "さといも",
+    "さとう",
+    "さとおや",
+    "さとし",
+    "さとる",
+    "さのう",
+    "さばく",
+    "さびしい",
+    "さべつ",
+    "さほう",
+    "さほど",
+    "さます",
+    "さみしい",
+    "さみだれ",
+    "さむけ",
+    "さめる",
+    "さやえんどう",
+    "さゆう",
+    "さよう",
+    "さよく",
+    "さらだ",
+    "ざるそば",
+    "さわやか",
+    "さわる",
+    "さんいん",
+    "さんか",
+    "さんきゃく",
+    "さんこう",
+    "さんさい",
+    "ざんしょ",
+    "さんすう",
+    "さんせい",
+    "さんそ",
+    "さんち",
+    "さんま",
+    "さんみ",
+    "さんらん",
+    "しあい",
+    "しあげ",
+    "しあさって",
+    "しあわせ",
+    "しいく",
+    "しいん",
+    "しうち",
+    "しえい",
+    "しおけ",
+    "しかい",
+    "しかく",
+    "じかん",
+    "しごと",
+    "しすう",
+    "じだい",
+    "したうけ",
+    "したぎ",
+    "したて",
+    "したみ",
+    "しちょう",
+    "しちりん",
+    "しっかり",
+    "しつじ",
+    "しつもん",
+    "してい",
+    "してき",
+    "してつ",
+    "じてん",
+    "じどう",
+    "しなぎれ",
+    "しなもの",
+    "しなん",
+    "しねま",
+    "しねん",
+    "しのぐ",
+    "しのぶ",
+    "しはい",
+    "しばかり",
+    "しはつ",
+    "しはらい",
+    "しはん",
+    "しひょう",
+    "しふく",
+    "じぶん",
+    "しへい",
+    "しほう",
+    "しほん",
+    "しまう",
+    "しまる",
+    "しみん",
+    "しむける",
+    "じむしょ",
+    "しめい",
+    "しめる",
+    "しもん",
+    "しゃいん",
+    "しゃうん",
+    "しゃおん",
+    "じゃがいも",
+    "しやくしょ",
+    "しゃくほう",
+    "しゃけん",
+    "しゃこ",
+    "しゃざい",
+    "しゃしん",
+    "しゃせん",
+    "しゃそう",
+    "しゃたい",
+    "しゃちょう",
+    "しゃっきん",
+    "じゃま",
+    "しゃりん",
+    "しゃれい",
+    "じゆう",
+    "じゅうしょ",
+    "しゅくはく",
+    "じゅしん",
+    "しゅっせき",
+    "しゅみ",
+    "しゅらば",
+    "じゅんばん",
+    "しょうかい",
+    "しょくたく",
+    "しょっけん",
+    "しょどう",
+    "しょもつ",
+    "しらせる",
+    "しらべる",
+    "しんか",
+    "しんこう",
+    "じんじゃ",
+    "しんせいじ",
+    "しんちく",
+    "しんりん",
+    "すあげ",
+    "すあし",
+    "すあな",
+    "ずあん",
+    "すいえい",
+    "すいか",
+    "すいとう",
+    "ずいぶん",
+    "すいようび",
+    "すうがく",
+    "すうじつ",
+    "すうせん",
+    "すおどり",
+    "すきま",
+    "すくう",
+    "すくない",
+    "すける",
+    "すごい",
+    "すこし",
+    "ずさん",
+    "すずしい",
+    "すすむ",
+    "すすめる",
+    "すっかり",
+    "ずっしり",
+    "ずっと",
+    "すてき",
+    "すてる",
+    "すねる",
+    "すのこ",
+    "すはだ",
+    "すばらしい",
+    "ずひょう",
+    "ずぶぬれ",
+    "すぶり",
+    "すふれ",
+    "すべて",
+    "すべる",
+    "ずほう",
+    "すぼん",
+    "すまい",
+    "すめし",
+    "すもう",
+    "すやき",
+    "すらすら",
+    "するめ",
+    "すれちがう",
+    "すろっと",
+    "すわる",
+    "すんぜん",
+    "すんぽう",
+    "せあぶら",
+    "せいかつ",
+    "せいげん",
+    "せいじ",
+    "せいよう",
+    "せおう",
+    "せかいかん",
+    "せきにん",
+    "せきむ",
+    "せきゆ",
+    "せきらんうん",
+    "せけん",
+    "せこう",
+    "せすじ",
+    "せたい",
+    "せたけ",
+    "せっかく",
+    "せっきゃく",
+    "ぜっく",
+    "せっけん",
+    "せっこつ",
+    "せっさたくま",
+    "せつぞく",
+    "せつだん",
+    "せつでん",
+    "せっぱん",
+    "せつび",
+    "せつぶん",
+    "せつめい",
+    "せつりつ",
+    "せなか",
+    "せのび",
+    "せはば",
+    "せびろ",
+    "せぼね",
+    "せまい",
+    "せまる",
+    "せめる",
+    "せもたれ",
+    "せりふ",
+    "ぜんあく",
+    "せんい",
+    "せんえい",
+    "せんか",
+    "せんきょ",
+    "せんく",
+    "せんげん",
+    "ぜんご",
+    "せんさい",
+    "せんしゅ",
+    "せんすい",
+    "せんせい",
+    "せんぞ",
+    "せんたく",
+    "せんちょう",
+    "せんてい",
+    "せんとう",
+    "せんぬき",
+    "せんねん",
+    "せんぱい",
+    "ぜんぶ",
+    "ぜんぽう",
+    "せんむ",
+    "せんめんじょ",
+    "せんもん",
+    "せんやく",
+    "せんゆう",
+    "せんよう",
+    "ぜんら",
+    "ぜんりゃく",
+    "せんれい",
+    "せんろ",
+    "そあく",
+    "そいとげる",
+    "そいね",
+    "そうがんきょう",
+    "そうき",
+    "そうご",
+    "そうしん",
+    "そうだん",
+    "そうなん",
+    "そうび",
+    "そうめん",
+    "そうり",
+    "そえもの",
+    "そえん",
+    "そがい",
+    "そげき",
+    "そこう",
+    "そこそこ",
+    "そざい",
+    "そしな",
+    "そせい",
+    "そせん",
+    "そそぐ",
+    "そだてる",
+    "そつう",
+    "そつえん",
+    "そっかん",
+    "そつぎょう",
+    "そっけつ",
+    "そっこう",
+    "そっせん",
+    "そっと",
+    "そとがわ",
+    "そとづら",
+    "そなえる",
+    "そなた",
+    "そふぼ",
+    "そぼく",
+    "そぼろ",
+    "そまつ",
+    "そまる",
+    "そむく",
+    "そむりえ",
+    "そめる",
+    "そもそも",
+    "そよかぜ",
+    "そらまめ",
+    "そろう",
+    "そんかい",
+    "そんけい",
+    "そんざい",
+    "そんしつ",
+    "そんぞく",
+    "そんちょう",
+    "ぞんび",
+    "ぞんぶん",
+    "そんみん",
+    "たあい",
+    "たいいん",
+    "たいうん",
+    "たいえき",
+    "たいおう",
+    "だいがく",
+    "たいき",
+    "たいぐう",
+    "たいけん",
+    "たいこ",
+    "たいざい",
+    "だいじょうぶ",
+    "だいすき",
+    "たいせつ",
+    "たいそう",
+    "だいたい",
+    "たいちょう",
+    "たいてい",
+    "だいどころ",
+    "たいない",
+    "たいねつ",
+    "たいのう",
+    "たいはん",
+    "だいひょう",
+    "たいふう",
+    "たいへん",
+    "たいほ",
+    "たいまつばな",
+    "たいみんぐ",
+    "たいむ",
+    "たいめん",
+    "たいやき",
+    "たいよう",
+    "たいら",
+    "たいりょく",
+    "たいる",
+    "たいわん",
+    "たうえ",
+    "たえる",
+    "たおす",
+    "たおる",
+    "たおれる",
+    "たかい",
+    "たかね",
+    "たきび",
+    "たくさん",
+    "たこく",
+    "たこやき",
+    "たさい",
+    "たしざん",
+    "だじゃれ",
+    "たすける",
+    "たずさわる",
+    "たそがれ",
+    "たたかう",
+    "たたく",
+    "ただしい",
+    "たたみ",
+    "たちばな",
+    "だっかい",
+    "だっきゃく",
+    "だっこ",
+    "だっしゅつ",
+    "だったい",
+    "たてる",
+    "たとえる",
+    "たなばた",
+    "たにん",
+    "たぬき",
+    "たのしみ",
+    "たはつ",
+    "たぶん",
+    "たべる",
+    "たぼう",
+    "たまご",
+    "たまる",
+    "だむる",
+    "ためいき",
+    "ためす",
+    "ためる",
+    "たもつ",
+    "たやすい",
+    "たよる",
+    "たらす",
+    "たりきほんがん",
+    "たりょう",
+    "たりる",
+    "たると",
+    "たれる",
+    "たれんと",
+    "たろっと",
+    "たわむれる",
+    "だんあつ",
+    "たんい",
+    "たんおん",
+    "たんか",
+    "たんき",
+    "たんけん",
+    "たんご",
+    "たんさん",
+    "たんじょうび",
+    "だんせい",
+    "たんそく",
+    "たんたい",
+    "だんち",
+    "たんてい",
+    "たんとう",
+    "だんな",
+    "たんにん",
+    "だんねつ",
+    "たんのう",
+    "たんぴん",
+    "だんぼう",
+    "たんまつ",
+    "たんめい",
+    "だんれつ",
+    "だんろ",
+    "だんわ",
+    "ちあい",
+    "ちあん",
+    "ちいき",
+    "ちいさい",
+    "ちえん",
+    "ちかい",
+    "ちから",
+    "ちきゅう",
+    "ちきん",
+    "ちけいず",
+    "ちけん",
+    "ちこく",
+    "ちさい",
+    "ちしき",
+    "ちしりょう",
+    "ちせい",
+    "ちそう",
+    "ちたい",
+    "ちたん",
+    "ちちおや",
+    "ちつじょ",
+    "ちてき",
+    "ちてん",
+    "ちぬき",
+    "ちぬり",
+    "ちのう",
+    "ちひょう",
+    "ちへいせん",
+    "ちほう",
+    "ちまた",
+    "ちみつ",
+    "ちみどろ",
+    "ちめいど",
+    "ちゃんこなべ",
+    "ちゅうい",
+    "ちゆりょく",
+    "ちょうし",
+    "ちょさくけん",
+    "ちらし",
+    "ちらみ",
+    "ちりがみ",
+    "ちりょう",
+    "ちるど",
+    "ちわわ",
+    "ちんたい",
+    "ちんもく",
+    "ついか",
+    "ついたち",
+    "つうか",
+    "つうじょう",
+    "つうはん",
+    "つうわ",
+    "つかう",
+    "つかれる",
+    "つくね",
+    "つくる",
+    "つけね",
+    "つける",
+    "つごう",
+    "つたえる",
+    "つづく",
+    "つつじ",
+    "つつむ",
+    "つとめる",
+    "つながる",
+    "つなみ",
+    "つねづね",
+    "つのる",
+    "つぶす",
+    "つまらない",
+    "つまる",
+    "つみき",
+    "つめたい",
+    "つもり",
+    "つもる",
+    "つよい",
+    "つるぼ",
+    "つるみく",
+    "つわもの",
+    "つわり",
+    "てあし",
+    "てあて",
+    "てあみ",
+    "ていおん",
+    "ていか",
+    "ていき",
+    "ていけい",
+    "ていこく",
+    "ていさつ",
+    "ていし",
+    "ていせい",
+    "ていたい",
+    "ていど",
+    "ていねい",
+    "ていひょう",
+    "ていへん",
+    "ていぼう",
+    "てうち",
+    "ておくれ",
+    "てきとう",
+    "てくび",
+    "でこぼこ",
+    "てさぎょう",
+    "てさげ",
+    "てすり",
+    "てそう",
+    "てちがい",
+    "てちょう",
+    "てつがく",
+    "てつづき",
+    "でっぱ",
+    "てつぼう",
+    "てつや",
+    "でぬかえ",
+    "てぬき",
+    "てぬぐい",
+    "てのひら",
+    "てはい",
+    "てぶくろ",
+    "てふだ",
+    "てほどき",
+    "てほん",
+    "てまえ",
+    "てまきずし",
+    "てみじか",
+    "てみやげ",
+    "てらす",
+    "てれび",
+    "てわけ",
+    "てわたし",
+    "でんあつ",
+    "てんいん",
+    "てんかい",
+    "てんき",
+    "てんぐ",
+    "てんけん",
+    "てんごく",
+    "てんさい",
+    "てんし",
+    "てんすう",
+    "でんち",
+    "てんてき",
+    "てんとう",
+    "てんない",
+    "てんぷら",
+    "てんぼうだい",
+    "てんめつ",
+    "てんらんかい",
+    "でんりょく",
+    "でんわ",
+    "どあい",
+    "といれ",
+    "どうかん",
+    "とうきゅう",
+    "どうぐ",
+    "とうし",
+    "とうむぎ",
+    "とおい",
+    "とおか",
+    "とおく",
+    "とおす",
+    "とおる",
+    "とかい",
+    "とかす",
+    "ときおり",
+    "ときどき",
+    "とくい",
+    "とくしゅう",
+    "とくてん",
+    "とくに",
+    "とくべつ",
+    "とけい",
+    "とける",
+    "とこや",
+    "とさか",
+    "としょかん",
+    "とそう",
+    "とたん",
+    "とちゅう",
+    "とっきゅう",
+    "とっくん",
+    "とつぜん",
+    "とつにゅう",
+    "とどける",
+    "ととのえる",
+    "とない",
+    "となえる",
+    "となり",
+    "とのさま",
+    "とばす",
+    "どぶがわ",
+    "とほう",
+    "とまる",
+    "とめる",
+    "ともだち",
+    "ともる",
+    "どようび",
+    "とらえる",
+    "とんかつ",
+    "どんぶり",
+    "ないかく",
+    "ないこう",
+    "ないしょ",
+    "ないす",
+    "ないせん",
+    "ないそう",
+    "なおす",
+    "ながい",
+    "なくす",
+    "なげる",
+    "なこうど",
+    "なさけ",
+    "なたでここ",
+    "なっとう",
+    "なつやすみ",
+    "ななおし",
+    "なにごと",
+    "なにもの",
+    "なにわ",
+    "なのか",
+    "なふだ",
+    "なまいき",
+    "なまえ",
+    "なまみ",
+    "なみだ",
+    "なめらか",
+    "なめる",
+    "なやむ",
+    "ならう",
+    "ならび",
+    "ならぶ",
+    "なれる",
+    "なわとび",
+    "なわばり",
+    "にあう",
+    "にいがた",
+    "にうけ",
+    "におい",
+    "にかい",
+    "にがて",
+    "にきび",
+    "にくしみ",
+    "にくまん",
+    "にげる",
+    "にさんかたんそ",
+    "にしき",
+    "にせもの",
+    "にちじょう",
+    "にちようび",
+    "にっか",
+    "にっき",
+    "にっけい",
+    "にっこう",
+    "にっさん",
+    "にっしょく",
+    "にっすう",
+    "にっせき",
+    "にってい",
+    "になう",
+    "にほん",
+    "にまめ",
+    "にもつ",
+    "にやり",
+    "にゅういん",
+    "にりんしゃ",
+    "にわとり",
+    "にんい",
+    "にんか",
+    "にんき",
+    "にんげん",
+    "にんしき",
+    "にんずう",
+    "にんそう",
+    "にんたい",
+    "にんち",
+    "にんてい",
+    "にんにく",
+    "にんぷ",
+    "にんまり",
+    "にんむ",
+    "にんめい",
+    "にんよう",
+    "ぬいくぎ",
+    "ぬかす",
+    "ぬぐいとる",
+    "ぬぐう",
+    "ぬくもり",
+    "ぬすむ",
+    "ぬまえび",
+    "ぬめり",
+    "ぬらす",
+    "ぬんちゃく",
+    "ねあげ",
+    "ねいき",
+    "ねいる",
+    "ねいろ",
+    "ねぐせ",
+    "ねくたい",
+    "ねくら",
+    "ねこぜ",
+    "ねこむ",
+    "ねさげ",
+    "ねすごす",
+    "ねそべる",
+    "ねだん",
+    "ねつい",
+    "ねっしん",
+    "ねつぞう",
+    "ねったいぎょ",
+    "ねぶそく",
+    "ねふだ",
+    "ねぼう",
+    "ねほりはほり",
+    "ねまき",
+    "ねまわし",
+    "ねみみ",
+    "ねむい",
+    "ねむたい",
+    "ねもと",
+    "ねらう",
+    "ねわざ",
+    "ねんいり",
+    "ねんおし",
+    "ねんかん",
+    "ねんきん",
+    "ねんぐ",
+    "ねんざ",
+    "ねんし",
+    "ねんちゃく",
+    "ねんど",
+    "ねんぴ",
+    "ねんぶつ",
+    "ねんまつ",
+    "ねんりょう",
+    "ねんれい",
+    "のいず",
+    "のおづま",
+    "のがす",
+    "のきなみ",
+    "のこぎり",
+    "のこす",
+    "のこる",
+    "のせる",
+    "のぞく",
+    "のぞむ",
+    "のたまう",
+    "のちほど",
+    "のっく",
+    "のばす",
+    "のはら",
+    "のべる",
+    "のぼる",
+    "のみもの",
+    "のやま",
+    "のらいぬ",
+    "のらねこ",
+    "のりもの",
+    "のりゆき",
+    "のれん",
+    "のんき",
+    "ばあい",
+    "はあく",
+    "ばあさん",
+    "ばいか",
+    "ばいく",
+    "はいけん",
+    "はいご",
+    "はいしん",
+    "はいすい",
+    "はいせん",
+    "はいそう",
+    "はいち",
+    "ばいばい",
+    "はいれつ",
+    "はえる",
+    "はおる",
+    "はかい",
+    "ばかり",
+    "はかる",
+    "はくしゅ",
+    "はけん",
+    "はこぶ",
+    "はさみ",
+    "はさん",
+    "はしご",
+    "ばしょ",
+    "はしる",
+    "はせる",
+    "ぱそこん",
+    "はそん",
+    "はたん",
+    "はちみつ",
+    "はつおん",
+    "はっかく",
+    "はづき",
+    "はっきり",
+    "はっくつ",
+    "はっけん",
+    "はっこう",
+    "はっさん",
+    "はっしん",
+    "はったつ",
+    "はっちゅう",
+    "はってん",
+    "はっぴょう",
+    "はっぽう",
+    "はなす",
+    "はなび",
+    "はにかむ",
+    "はぶらし",
+    "はみがき",
+    "はむかう",
+    "はめつ",
+    "はやい",
+    "はやし",
+    "はらう",
+    "はろうぃん",
+    "はわい",
+    "はんい",
+    "はんえい",
+    "はんおん",
+    "はんかく",
+    "はんきょう",
+    "ばんぐみ",
+    "はんこ",
+    "はんしゃ",
+    "はんすう",
+    "はんだん",
+    "ぱんち",
+    "ぱんつ",
+    "はんてい",
+    "はんとし",
+    "はんのう",
+    "はんぱ",
+    "はんぶん",
+    "はんぺん",
+    "はんぼうき",
+    "はんめい",
+    "はんらん",
+    "はんろん",
+    "ひいき",
+    "ひうん",
+    "ひえる",
+    "ひかく",
+    "ひかり",
+    "ひかる",
+    "ひかん",
+    "ひくい",
+    "ひけつ",
+    "ひこうき",
+    "ひこく",
+    "ひさい",
+    "ひさしぶり",
+    "ひさん",
+    "びじゅつかん",
+    "ひしょ",
+    "ひそか",
+    "ひそむ",
+    "ひたむき",
+    "ひだり",
+    "ひたる",
+    "ひつぎ",
+    "ひっこし",
+    "ひっし",
+    "ひつじゅひん",
+    "ひっす",
+    "ひつぜん",
+    "ぴったり",
+    "ぴっちり",
+    "ひつよう",
+    "ひてい",
+    "ひとごみ",
+    "ひなまつり",
+    "ひなん",
+    "ひねる",
+    "ひはん",
+    "ひびく",
+    "ひひょう",
+    "ひほう",
+    "ひまわり",
+    "ひまん",
+    "ひみつ",
+    "ひめい",
+    "ひめじし",
+    "ひやけ",
+    "ひやす",
+    "ひよう",
+    "びょうき",
+    "ひらがな",
+    "ひらく",
+    "ひりつ",
+    "ひりょう",
+    "ひるま",
+    "ひるやすみ",
+    "ひれい",
+    "ひろい",
+    "ひろう",
+    "ひろき",
+    "ひろゆき",
+    "ひんかく",
+    "ひんけつ",
+    "ひんこん",
+    "ひんしゅ",
+    "ひんそう",
+    "ぴんち",
+    "ひんぱん",
+    "びんぼう",
+    "ふあん",
+    "ふいうち",
+    "ふうけい",
+    "ふうせん",
+    "ぷうたろう",
+    "ふうとう",
+    "ふうふ",
+    "ふえる",
+    "ふおん",
+    "ふかい",
+    "ふきん",
+    "ふくざつ",
+    "ふくぶくろ",
+    "ふこう",
+    "ふさい",
+    "ふしぎ",
+    "ふじみ",
+    "ふすま",
+    "ふせい",
+    "ふせぐ",
+    "ふそく",
+    "ぶたにく",
+    "ふたん",
+    "ふちょう",
+    "ふつう",
+    "ふつか",
+    "ふっかつ",
+    "ふっき",
+    "ふっこく",
+    "ぶどう",
+    "ふとる",
+    "ふとん",
+    "ふのう",
+    "ふはい",
+    "ふひょう",
+    "ふへん",
+    "ふまん",
+    "ふみん",
+    "ふめつ",
+    "ふめん",
+    "ふよう",
+    "ふりこ",
+    "ふりる",
+    "ふるい",
+    "ふんいき",
+    "ぶんがく",
+    "ぶんぐ",
+    "ふんしつ",
+    "ぶんせき",
+    "ふんそう",
+    "ぶんぽう",
+    "へいあん",
+    "へいおん",
+    "へいがい",
+    "へいき",
+    "へいげん",
+    "へいこう",
+    "へいさ",
+    "へいしゃ",
+    "へいせつ",
+    "へいそ",
+    "へいたく",
+    "へいてん",
+    "へいねつ",
+    "へいわ",
+    "へきが",
+    "へこむ",
+    "べにいろ",
+    "べにしょうが",
+    "へらす",
+    "へんかん",
+    "べんきょう",
+    "べんごし",
+    "へんさい",
+    "へんたい",
+    "べんり",
+    "ほあん",
+    "ほいく",
+    "ぼうぎょ",
+    "ほうこく",
+    "ほうそう",
+    "ほうほう",
+    "ほうもん",
+    "ほうりつ",
+    "ほえる",
+    "ほおん",
+    "ほかん",
+    "ほきょう",
+    "ぼきん",
+    "ほくろ",
+    "ほけつ",
+    "ほけん",
+    "ほこう",
+    "ほこる",
+    "ほしい",
+    "ほしつ",
+    "ほしゅ",
+    "ほしょう",
+    "ほせい",
+    "ほそい",
+    "ほそく",
+    "ほたて",
+    "ほたる",
+    "ぽちぶくろ",
+    "ほっきょく",
+    "ほっさ",
+    "ほったん",
+    "ほとんど",
+    "ほめる",
+    "ほんい",
+    "ほんき",
+    "ほんけ",
+    "ほんしつ",
+    "ほんやく",
+    "まいにち",
+    "まかい",
+    "まかせる",
+    "まがる",
+    "まける",
+    "まこと",
+    "まさつ",
+    "まじめ",
+    "ますく",
+    "まぜる",
+    "まつり",
+    "まとめ",
+    "まなぶ",
+    "まぬけ",
+    "まねく",
+    "まほう",
+    "まもる",
+    "まゆげ",
+    "まよう",
+    "まろやか",
+    "まわす",
+    "まわり",
+    "まわる",
+    "まんが",
+    "まんきつ",
+    "まんぞく",
+    "まんなか",
+    "みいら",
+    "みうち",
+    "みえる",
+    "みがく",
+    "みかた",
+    "みかん",
+    "みけん",
+    "みこん",
+    "みじかい",
+    "みすい",
+    "みすえる",
+    "みせる",
+    "みっか",
+    "みつかる",
+    "みつける",
+    "みてい",
+    "みとめる",
+    "みなと",
+    "みなみかさい",
+    "みねらる",
+    "みのう",
+    "みのがす",
+    "みほん",
+    "みもと",
+    "みやげ",
+    "みらい",
+    "みりょく",
+    "みわく",
+    "みんか",
+    "みんぞく",
+    "むいか",
+    "むえき",
+    "むえん",
+    "むかい",
+    "むかう",
+    "むかえ",
+    "むかし",
+    "むぎちゃ",
+    "むける",
+    "むげん",
+    "むさぼる",
+    "むしあつい",
+    "むしば",
+    "むじゅん",
+    "むしろ",
+    "むすう",
+    "むすこ",
+    "むすぶ",
+    "むすめ",
+    "むせる",
+    "むせん",
+    "むちゅう",
+    "むなしい",
+    "むのう",
+    "むやみ",
+    "むよう",
+    "むらさき",
+    "むりょう",
+    "むろん",
+    "めいあん",
+    "めいうん",
+    "めいえん",
+    "めいかく",
+    "めいきょく",
+    "めいさい",
+    "めいし",
+    "めいそう",
+    "めいぶつ",
+    "めいれい",
+    "めいわく",
+    "めぐまれる",
+    "めざす",
+    "めした",
+    "めずらしい",
+    "めだつ",
+    "めまい",
+    "めやす",
+    "めんきょ",
+    "めんせき",
+    "めんどう",
+    "もうしあげる",
+    "もうどうけん",
+    "もえる",
+    "もくし",
+    "もくてき",
+    "もくようび",
+    "もちろん",
+    "もどる",
+    "もらう",
+    "もんく",
+    "もんだい",
+    "やおや",
+    "やける",
+    "やさい",
+    "やさしい",
+    "やすい",
+    "やすたろう",
+    "やすみ",
+    "やせる",
+    "やそう",
+    "やたい",
+    "やちん",
+    "やっと",
+    "やっぱり",
+    "やぶる",
+    "やめる",
+    "ややこしい",
+    "やよい",
+    "やわらかい",
+    "ゆうき",
+    "ゆうびんきょく",
+    "ゆうべ",
+    "ゆうめい",
+    "ゆけつ",
+    "ゆしゅつ",
+    "ゆせん",
+    "ゆそう",
+    "ゆたか",
+    "ゆちゃく",
+    "ゆでる",
+    "ゆにゅう",
+    "ゆびわ",
+    "ゆらい",
+    "ゆれる",
+    "ようい",
+    "ようか",
+    "ようきゅう",
+    "ようじ",
+    "ようす",
+    "ようちえん",
+    "よかぜ",
+    "よかん",
+    "よきん",
+    "よくせい",
+    "よくぼう",
+    "よけい",
+    "よごれる",
+    "よさん",
+    "よしゅう",
+    "よそう",
+    "よそく",
+    "よっか",
+    "よてい",
+    "よどがわく",
+    "よねつ",
+    "よやく",
+    "よゆう",
+    "よろこぶ",
+    "よろしい",
+    "らいう",
+    "らくがき",
+    "らくご",
+    "らくさつ",
+    "らくだ",
+    "らしんばん",
+    "らせん",
+    "らぞく",
+    "らたい",
+    "らっか",
+    "られつ",
+    "りえき",
+    "りかい",
+    "りきさく",
+    "りきせつ",
+    "りくぐん",
+    "りくつ",
+    "りけん",
+    "りこう",
+    "りせい",
+    "りそう",
+    "りそく",
+    "りてん",
+    "りねん",
+    "りゆう",
+    "りゅうがく",
+    "りよう",
+    "りょうり",
+    "りょかん",
+    "りょくちゃ",
+    "りょこう",
+    "りりく",
+    "りれき",
+    "りろん",
+    "りんご",
+    "るいけい",
+    "るいさい",
+    "るいじ",
+    "るいせき",
+    "るすばん",
+    "るりがわら",
+    "れいかん",
+    "れいぎ",
+    "れいせい",
+    "れいぞうこ",
+    "れいとう",
+    "れいぼう",
+    "れきし",
+    "れきだい",
+    "れんあい",
+    "れんけい",
+    "れんこん",
+    "れんさい",
+    "れんしゅう",
+    "れんぞく",
+    "れんらく",
+    "ろうか",
+    "ろうご",
+    "ろうじん",
+    "ろうそく",
+    "ろくが",
+    "ろこつ",
+    "ろじうら",
+    "ろしゅつ",
+    "ろせん",
+    "ろてん",
+    "ろめん",
+    "ろれつ",
+    "ろんぎ",
+    "ろんぱ",
+    "ろんぶん",
+    "ろんり",
+    "わかす",
+    "わかめ",
+    "わかやま",
+    "わかれる",
+    "わしつ",
+    "わじまし",
+    "わすれもの",
+    "わらう",
+    "われる",
+}
+var rwords_japanese = map[string]int16{
+    "あいこくしん": 0,
+    "あいさつ": 1,
+    "あいだ": 2,
+    "あおぞら": 3,
+    "あかちゃん": 4,
+    "あきる": 5,
+    "あけがた": 6,
+    "あける": 7,
+    "あこがれる": 8,
+    "あさい": 9,
+    "あさひ": 10,
+    "あしあと": 11,
+    "あじわう": 12,
+    "あずかる": 13,
+    "あずき": 14,
+    "あそぶ": 15,
+    "あたえる": 16,
+    "あたためる": 17,
+    "あたりまえ": 18,
+    "あたる": 19,
+    "あつい": 20,
+    "あつかう": 21,
+    "あっしゅく": 22,
+    "あつまり": 23,
+    "あつめる": 24,
+    "あてな": 25,
+    "あてはまる": 26,
+    "あひる": 27,
+    "あぶら": 28,
+    "あぶる": 29,
+    "あふれる": 30,
+    "あまい": 31,
+    "あまど": 32,
+    "あまやかす": 33,
+    "あまり": 34,
+    "あみもの": 35,
+    "あめりか": 36,
+    "あやまる": 37,
+    "あゆむ": 38,
+    "あらいぐま": 39,
+    "あらし": 40,
+    "あらすじ": 41,
+    "あらためる": 42,
+    "あらゆる": 43,
+    "あらわす": 44,
+    "ありがとう": 45,
+    "あわせる": 46,
+    "あわてる": 47,
+    "あんい": 48,
+    "あんがい": 49,
+    "あんこ": 50,
+    "あんぜん": 51,
+    "あんてい": 52,
+    "あんない": 53,
+    "あんまり": 54,
+    "いいだす": 55,
+    "いおん": 56,
+    "いがい": 57,
+    "いがく": 58,
+    "いきおい": 59,
+    "いきなり": 60,
+    "いきもの": 61,
+    "いきる": 62,
+    "いくじ": 63,
+    "いくぶん": 64,
+    "いけばな": 65,
+    "いけん": 66,
+    "いこう": 67,
+    "いこく": 68,
+    "いこつ": 69,
+    "いさましい": 70,
+    "いさん": 71,
+    "いしき": 72,
+    "いじゅう": 73,
+    "いじょう": 74,
+    "いじわる": 75,
+    "いずみ": 76,
+    "いずれ": 77,
+    "いせい": 78,
+    "いせえび": 79,
+    "いせかい": 80,
+    "いせき": 81,
+    "いぜん": 82,
+    "いそうろう": 83,
+    "いそがしい": 84,
+    "いだい": 85,
+    "いだく": 86,
+    "いたずら": 87,
+    "いたみ": 88,
+    "いたりあ": 89,
+    "いちおう": 90,
+    "いちじ": 91,
+    "いちど": 92,
+    "いちば": 93,
+    "いちぶ": 94,
+    "いちりゅう": 95,
+    "いつか": 96,
+    "いっしゅん": 97,
+    "いっせい": 98,
+    "いっそう": 99,
+    "いったん": 100,
+    "いっち": 101,
+    "いってい": 102,
+    "いっぽう": 103,
+    "いてざ": 104,
+    "いてん": 105,
+    "いどう": 106,
+    "いとこ": 107,
+    "いない": 108,
+    "いなか": 109,
+    "いねむり": 110,
+    "いのち": 111,
+    "いのる": 112,
+    "いはつ": 113,
+    "いばる": 114,
+    "いはん": 115,
+    "いびき": 116,
+    "いひん": 117,
+    "いふく": 118,
+    "いへん": 119,
+    "いほう": 120,
+    "いみん": 121,
+    "いもうと": 122,
+    "いもたれ": 123,
+    "いもり": 124,
+    "いやがる": 125,
+    "いやす": 126,
+    "いよかん": 127,
+    "いよく": 128,
+    "いらい": 129,
+    "いらすと": 130,
+    "いりぐち": 131,
+    "いりょう": 132,
+    "いれい": 133,
+    "いれもの": 134,
+    "いれる": 135,
+    "いろえんぴつ": 136,
+    "いわい": 137,
+    "いわう": 138,
+    "いわかん": 139,
+    "いわば": 140,
+    "いわゆる": 141,
+    "いんげんまめ": 142,
+    "いんさつ": 143,
+    "いんしょう": 144,
+    "いんよう": 145,
+    "うえき": 146,
+    "うえる": 147,
+    "うおざ": 148,
+    "うがい": 149,
+    "うかぶ": 150,
+    "うかべる": 151,
+    "うきわ": 152,
+    "うくらいな": 153,
+    "うくれれ": 154,
+    "うけたまわる": 155,
+    "うけつけ": 156,
+    "うけとる": 157,
+    "うけもつ": 158,
+    "うける": 159,
+    "うごかす": 160,
+    "うごく": 161,
+    "うこん": 162,
+    "うさぎ": 163,
+    "うしなう": 164,
+    "うしろがみ": 165,
+    "うすい": 166,
+    "うすぎ": 167,
+    "うすぐらい": 168,
+    "うすめる": 169,
+    "うせつ": 170,
+    "うちあわせ": 171,
+    "うちがわ": 172,
+    "うちき": 173,
+    "うちゅう": 174,
+    "うっかり": 175,
+    "うつくしい": 176,
+    "うったえる": 177,
+    "うつる": 178,
+    "うどん": 179,
+    "うなぎ": 180,
+    "うなじ": 181,
+    "うなずく": 182,
+    "うなる": 183,
+    "うねる": 184,
+    "うのう": 185,
+    "うぶげ": 186,
+    "うぶごえ": 187,
+    "うまれる": 188,
+    "うめる": 189,
+    "うもう": 190,
+    "うやまう": 191,
+    "うよく": 192,
+    "うらがえす": 193,
+    "うらぐち": 194,
+    "うらない": 195,
+    "うりあげ": 196,
+    "うりきれ": 197,
+    "うるさい": 198,
+    "うれしい": 199,
+    "うれゆき": 200,
+    "うれる": 201,
+    "うろこ": 202,
+    "うわき": 203,
+    "うわさ": 204,
+    "うんこう": 205,
+    "うんちん": 206,
+    "うんてん": 207,
+    "うんどう": 208,
+    "えいえん": 209,
+    "えいが": 210,
+    "えいきょう": 211,
+    "えいご": 212,
+    "えいせい": 213,
+    "えいぶん": 214,
+    "えいよう": 215,
+    "えいわ": 216,
+    "えおり": 217,
+    "えがお": 218,
+    "えがく": 219,
+    "えきたい": 220,
+    "えくせる": 221,
+    "えしゃく": 222,
+    "えすて": 223,
+    "えつらん": 224,
+    "えのぐ": 225,
+    "えほうまき": 226,
+    "えほん": 227,
+    "えまき": 228,
+    "えもじ": 229,
+    "えもの": 230,
+    "えらい": 231,
+    "えらぶ": 232,
+    "えりあ": 233,
+    "えんえん": 234,
+    "えんかい": 235,
+    "えんぎ": 236,
+    "えんげき": 237,
+    "えんしゅう": 238,
+    "えんぜつ": 239,
+    "えんそく": 240,
+    "えんちょう": 241,
+    "えんとつ": 242,
+    "おいかける": 243,
+    "おいこす": 244,
+    "おいしい": 245,
+    "おいつく": 246,
+    "おうえん": 247,
+    "おうさま": 248,
+    "おうじ": 249,
+    "おうせつ": 250,
+    "おうたい": 251,
+    "おうふく": 252,
+    "おうべい": 253,
+    "おうよう": 254,
+    "おえる": 255,
+    "おおい": 256,
+    "おおう": 257,
+    "おおどおり": 258,
+    "おおや": 259,
+    "おおよそ": 260,
+    "おかえり": 261,
+    "おかず": 262,
+    "おがむ": 263,
+    "おかわり": 264,
+    "おぎなう": 265,
+    "おきる": 266,
+    "おくさま": 267,
+    "おくじょう": 268,
+    "おくりがな": 269,
+    "おくる": 270,
+    "おくれる": 271,
+    "おこす": 272,
+    "おこなう": 273,
+    "おこる": 274,
+    "おさえる": 275,
+    "おさない": 276,
+    "おさめる": 277,
+    "おしいれ": 278,
+    "おしえる": 279,
+    "おじぎ": 280,
+    "おじさん": 281,
+    "おしゃれ": 282,
+    "おそらく": 283,
+    "おそわる": 284,
+    "おたがい": 285,
+    "おたく": 286,
+    "おだやか": 287,
+    "おちつく": 288,
+    "おっと": 289,
+    "おつり": 290,
+    "おでかけ": 291,
+    "おとしもの": 292,
+    "おとなしい": 293,
+    "おどり": 294,
+    "おどろかす": 295,
+    "おばさん": 296,
+    "おまいり": 297,
+    "おめでとう": 298,
+    "おもいで": 299,
+    "おもう": 300,
+    "おもたい": 301,
+    "おもちゃ": 302,
+    "おやつ": 303,
+    "おやゆび": 304,
+    "およぼす": 305,
+    "おらんだ": 306,
+    "おろす": 307,
+    "おんがく": 308,
+    "おんけい": 309,
+    "おんしゃ": 310,
+    "おんせん": 311,
+    "おんだん": 312,
+    "おんちゅう": 313,
+    "おんどけい": 314,
+    "かあつ": 315,
+    "かいが": 316,
+    "がいき": 317,
+    "がいけん": 318,
+    "がいこう": 319,
+    "かいさつ": 320,
+    "かいしゃ": 321,
+    "かいすいよく": 322,
+    "かいぜん": 323,
+    "かいぞうど": 324,
+    "かいつう": 325,
+    "かいてん": 326,
+    "かいとう": 327,
+    "かいふく": 328,
+    "がいへき": 329,
+    "かいほう": 330,
+    "かいよう": 331,
+    "がいらい": 332,
+    "かいわ": 333,
+    "かえる": 334,
+    "かおり": 335,
+    "かかえる": 336,
+    "かがく": 337,
+    "かがし": 338,
+    "かがみ": 339,
+    "かくご": 340,
+    "かくとく": 341,
+    "かざる": 342,
+    "がぞう": 343,
+    "かたい": 344,
+    "かたち": 345,
+    "がちょう": 346,
+    "がっきゅう": 347,
+    "がっこう": 348,
+    "がっさん": 349,
+    "がっしょう": 350,
+    "かなざわし": 351,
+    "かのう": 352,
+    "がはく": 353,
+    "かぶか": 354,
+    "かほう": 355,
+    "かほご": 356,
+    "かまう": 357,
+    "かまぼこ": 358,
+    "かめれおん": 359,
+    "かゆい": 360,
+    "かようび": 361,
+    "からい": 362,
+    "かるい": 363,
+    "かろう": 364,
+    "かわく": 365,
+    "かわら": 366,
+    "がんか": 367,
+    "かんけい": 368,
+    "かんこう": 369,
+    "かんしゃ": 370,
+    "かんそう": 371,
+    "かんたん": 372,
+    "かんち": 373,
+    "がんばる": 374,
+    "きあい": 375,
+    "きあつ": 376,
+    "きいろ": 377,
+    "ぎいん": 378,
+    "きうい": 379,
+    "きうん": 380,
+    "きえる": 381,
+    "きおう": 382,
+    "きおく": 383,
+    "きおち": 384,
+    "きおん": 385,
+    "きかい": 386,
+    "きかく": 387,
+    "きかんしゃ": 388,
+    "ききて": 389,
+    "きくばり": 390,
+    "きくらげ": 391,
+    "きけんせい": 392,
+    "きこう": 393,
+    "きこえる": 394,
+    "きこく": 395,
+    "きさい": 396,
+    "きさく": 397,
+    "きさま": 398,
+    "きさらぎ": 399,
+    "ぎじかがく": 400,
+    "ぎしき": 401,
+    "ぎじたいけん": 402,
+    "ぎじにってい": 403,
+    "ぎじゅつしゃ": 404,
+    "きすう": 405,
+    "きせい": 406,
+    "きせき": 407,
+    "きせつ": 408,
+    "きそう": 409,
+    "きぞく": 410,
+    "きぞん": 411,
+    "きたえる": 412,
+    "きちょう": 413,
+    "きつえん": 414,
+    "ぎっちり": 415,
+    "きつつき": 416,
+    "きつね": 417,
+    "きてい": 418,
+    "きどう": 419,
+    "きどく": 420,
+    "きない": 421,
+    "きなが": 422,
+    "きなこ": 423,
+    "きぬごし": 424,
+    "きねん": 425,
+    "きのう": 426,
+    "きのした": 427,
+    "きはく": 428,
+    "きびしい": 429,
+    "きひん": 430,
+    "きふく": 431,
+    "きぶん": 432,
+    "きぼう": 433,
+    "きほん": 434,
+    "きまる": 435,
+    "きみつ": 436,
+    "きむずかしい": 437,
+    "きめる": 438,
+    "きもだめし": 439,
+    "きもち": 440,
+    "きもの": 441,
+    "きゃく": 442,
+    "きやく": 443,
+    "ぎゅうにく": 444,
+    "きよう": 445,
+    "きょうりゅう": 446,
+    "きらい": 447,
+    "きらく": 448,
+    "きりん": 449,
+    "きれい": 450,
+    "きれつ": 451,
+    "きろく": 452,
+    "ぎろん": 453,
+    "きわめる": 454,
+    "ぎんいろ": 455,
+    "きんかくじ": 456,
+    "きんじょ": 457,
+    "きんようび": 458,
+    "ぐあい": 459,
+    "くいず": 460,
+    "くうかん": 461,
+    "くうき": 462,
+    "くうぐん": 463,
+    "くうこう": 464,
+    "ぐうせい": 465,
+    "くうそう": 466,
+    "ぐうたら": 467,
+    "くうふく": 468,
+    "くうぼ": 469,
+    "くかん": 470,
+    "くきょう": 471,
+    "くげん": 472,
+    "ぐこう": 473,
+    "くさい": 474,
+    "くさき": 475,
+    "くさばな": 476,
+    "くさる": 477,
+    "くしゃみ": 478,
+    "くしょう": 479,
+    "くすのき": 480,
+    "くすりゆび": 481,
+    "くせげ": 482,
+    "くせん": 483,
+    "ぐたいてき": 484,
+    "くださる": 485,
+    "くたびれる": 486,
+    "くちこみ": 487,
+    "くちさき": 488,
+    "くつした": 489,
+    "ぐっすり": 490,
+    "くつろぐ": 491,
+    "くとうてん": 492,
+    "くどく": 493,
+    "くなん": 494,
+    "くねくね": 495,
+    "くのう": 496,
+    "くふう": 497,
+    "くみあわせ": 498,
+    "くみたてる": 499,
+    "くめる": 500,
+    "くやくしょ": 501,
+    "くらす": 502,
+    "くらべる": 503,
+    "くるま": 504,
+    "くれる": 505,
+    "くろう": 506,
+    "くわしい": 507,
+    "ぐんかん": 508,
+    "ぐんしょく": 509,
+    "ぐんたい": 510,
+    "ぐんて": 511,
+    "けあな": 512,
+    "けいかく": 513,
+    "けいけん": 514,
+    "けいこ": 515,
+    "けいさつ": 516,
+    "げいじゅつ": 517,
+    "けいたい": 518,
+    "げいのうじん": 519,
+    "けいれき": 520,
+    "けいろ": 521,
+    "けおとす": 522,
+    "けおりもの": 523,
+    "げきか": 524,
+    "げきげん": 525,
+    "げきだん": 526,
+    "げきちん": 527,
+    "げきとつ": 528,
+    "げきは": 529,
+    "げきやく": 530,
+    "げこう": 531,
+    "げこくじょう": 532,
+    "げざい": 533,
+    "けさき": 534,
+    "げざん": 535,
+    "けしき": 536,
+    "けしごむ": 537,
+    "けしょう": 538,
+    "げすと": 539,
+    "けたば": 540,
+    "けちゃっぷ": 541,
+    "けちらす": 542,
+    "けつあつ": 543,
+    "けつい": 544,
+    "けつえき": 545,
+    "けっこん": 546,
+    "けつじょ": 547,
+    "けっせき": 548,
+    "けってい": 549,
+    "けつまつ": 550,
+    "げつようび": 551,
+    "げつれい": 552,
+    "けつろん": 553,
+    "げどく": 554,
+    "けとばす": 555,
+    "けとる": 556,
+    "けなげ": 557,
+    "けなす": 558,
+    "けなみ": 559,
+    "けぬき": 560,
+    "げねつ": 561,
+    "けねん": 562,
+    "けはい": 563,
+    "げひん": 564,
+    "けぶかい": 565,
+    "げぼく": 566,
+    "けまり": 567,
+    "けみかる": 568,
+    "けむし": 569,
+    "けむり": 570,
+    "けもの": 571,
+    "けらい": 572,
+    "けろけろ": 573,
+    "けわしい": 574,
+    "けんい": 575,
+    "けんえつ": 576,
+    "けんお": 577,
+    "けんか": 578,
+    "げんき": 579,
+    "けんげん": 580,
+    "けんこう": 581,
+    "けんさく": 582,
+    "けんしゅう": 583,
+    "けんすう": 584,
+    "げんそう": 585,
+    "けんちく": 586,
+    "けんてい": 587,
+    "けんとう": 588,
+    "けんない": 589,
+    "けんにん": 590,
+    "げんぶつ": 591,
+    "けんま": 592,
+    "けんみん": 593,
+    "けんめい": 594,
+    "けんらん": 595,
+    "けんり": 596,
+    "こあくま": 597,
+    "こいぬ": 598,
+    "こいびと": 599,
+    "ごうい": 600,
+    "こうえん": 601,
+    "こうおん": 602,
+    "こうかん": 603,
+    "ごうきゅう": 604,
+    "ごうけい": 605,
+    "こうこう": 606,
+    "こうさい": 607,
+    "こうじ": 608,
+    "こうすい": 609,
+    "ごうせい": 610,
+    "こうそく": 611,
+    "こうたい": 612,
+    "こうちゃ": 613,
+    "こうつう": 614,
+    "こうてい": 615,
+    "こうどう": 616,
+    "こうない": 617,
+    "こうはい": 618,
+    "ごうほう": 619,
+    "ごうまん": 620,
+    "こうもく": 621,
+    "こうりつ": 622,
+    "こえる": 623,
+    "こおり": 624,
+    "ごかい": 625,
+    "ごがつ": 626,
+    "ごかん": 627,
+    "こくご": 628,
+    "こくさい": 629,
+    "こくとう": 630,
+    "こくない": 631,
+    "こくはく": 632,
+    "こぐま": 633,
+    "こけい": 634,
+    "こける": 635,
+    "ここのか": 636,
+    "こころ": 637,
+    "こさめ": 638,
+    "こしつ": 639,
+    "こすう": 640,
+    "こせい": 641,
+    "こせき": 642,
+    "こぜん": 643,
+    "こそだて": 644,
+    "こたい": 645,
+    "こたえる": 646,
+    "こたつ": 647,
+    "こちょう": 648,
+    "こっか": 649,
+    "こつこつ": 650,
+    "こつばん": 651,
+    "こつぶ": 652,
+    "こてい": 653,
+    "こてん": 654,
+    "ことがら": 655,
+    "ことし": 656,
+    "ことば": 657,
+    "ことり": 658,
+    "こなごな": 659,
+    "こねこね": 660,
+    "このまま": 661,
+    "このみ": 662,
+    "このよ": 663,
+    "ごはん": 664,
+    "こひつじ": 665,
+    "こふう": 666,
+    "こふん": 667,
+    "こぼれる": 668,
+    "ごまあぶら": 669,
+    "こまかい": 670,
+    "ごますり": 671,
+    "こまつな": 672,
+    "こまる": 673,
+    "こむぎこ": 674,
+    "こもじ": 675,
+    "こもち": 676,
+    "こもの": 677,
+    "こもん": 678,
+    "こやく": 679,
+    "こやま": 680,
+    "こゆう": 681,
+    "こゆび": 682,
+    "こよい": 683,
+    "こよう": 684,
+    "こりる": 685,
+    "これくしょん": 686,
+    "ころっけ": 687,
+    "こわもて": 688,
+    "こわれる": 689,
+    "こんいん": 690,
+    "こんかい": 691,
+    "こんき": 692,
+    "こんしゅう": 693,
+    "こんすい": 694,
+    "こんだて": 695,
+    "こんとん": 696,
+    "こんなん": 697,
+    "こんびに": 698,
+    "こんぽん": 699,
+    "こんまけ": 700,
+    "こんや": 701,
+    "こんれい": 702,
+    "こんわく": 703,
+    "ざいえき": 704,
+    "さいかい": 705,
+    "さいきん": 706,
+    "ざいげん": 707,
+    "ざいこ": 708,
+    "さいしょ": 709,
+    "さいせい": 710,
+    "ざいたく": 711,
+    "ざいちゅう": 712,
+    "さいてき": 713,
+    "ざいりょう": 714,
+    "さうな": 715,
+    "さかいし": 716,
+    "さがす": 717,
+    "さかな": 718,
+    "さかみち": 719,
+    "さがる": 720,
+    "さぎょう": 721,
+    "さくし": 722,
+    "さくひん": 723,
+    "さくら": 724,
+    "さこく": 725,
+    "さこつ": 726,
+    "さずかる": 727,
+    "ざせき": 728,
+    "さたん": 729,
+    "さつえい": 730,
+    "ざつおん": 731,
+    "ざっか": 732,
+    "ざつがく": 733,
+    "さっきょく": 734,
+    "ざっし": 735,
+    "さつじん": 736,
+    "ざっそう": 737,
+    "さつたば": 738,
+    "さつまいも": 739,
+    "さてい": 740,
+    "さといも": 741,
+    "さとう": 742,
+    "さとおや": 743,
+    "さとし": 744,
+    "さとる": 745,
+    "さのう": 746,
+    "さばく": 747,
+    "さびしい": 748,
+    "さべつ": 749,
+    "さほう": 750,
+    "さほど": 751,
+    "さます": 752,
+    "さみしい": 753,
+    "さみだれ": 754,
+    "さむけ": 755,
+    "さめる": 756,
+    "さやえんどう": 757,
+    "さゆう": 758,
+    "さよう": 759,
+    "さよく": 760,
+    "さらだ": 761,
+    "ざるそば": 762,
+    "さわやか": 763,
+    "さわる": 764,
+    "さんいん": 765,
+    "さんか": 766,
+    "さんきゃく": 767,
+    "さんこう": 768,
+    "さんさい": 769,
+    "ざんしょ": 770,
+    "さんすう": 771,
+    "さんせい": 772,
+    "さんそ": 773,
+    "さんち": 774,
+    "さんま": 775,
+    "さんみ": 776,
+    "さんらん": 777,
+    "しあい": 778,
+    "しあげ": 779,
+    "しあさって": 780,
+    "しあわせ": 781,
+    "しいく": 782,
+    "しいん": 783,
+    "しうち": 784,
+    "しえい": 785,
+    "しおけ": 786,
+    "しかい": 787,
+    "しかく": 788,
+    "じかん": 789,
+    "しごと": 790,
+    "しすう": 791,
+    "じだい": 792,
+    "したうけ": 793,
+    "したぎ": 794,
+    "したて": 795,
+    "したみ": 796,
+    "しちょう": 797,
+    "しちりん": 798,
+    "しっかり": 799,
+    "しつじ": 800,
+    "しつもん": 801,
+    "してい": 802,
+    "してき": 803,
+    "してつ": 804,
+    "じてん": 805,
+    "じどう": 806,
+    "しなぎれ": 807,
+    "しなもの": 808,
+    "しなん": 809,
+    "しねま": 810,
+    "しねん": 811,
+    "しのぐ": 812,
+    "しのぶ": 813,
+    "しはい": 814,
+    "しばかり": 815,
+    "しはつ": 816,
+    "しはらい": 817,
+    "しはん": 818,
+    "しひょう": 819,
+    "しふく": 820,
+    "じぶん": 821,
+    "しへい": 822,
+    "しほう": 823,
+    "しほん": 824,
+    "しまう": 825,
+    "しまる": 826,
+    "しみん": 827,
+    "しむける": 828,
+    "じむしょ": 829,
+    "しめい": 830,
+    "しめる": 831,
+    "しもん": 832,
+    "しゃいん": 833,
+    "しゃうん": 834,
+    "しゃおん": 835,
+    "じゃがいも": 836,
+    "しやくしょ": 837,
+    "しゃくほう": 838,
+    "しゃけん": 839,
+    "しゃこ": 840,
+    "しゃざい": 841,
+    "しゃしん": 842,
+    "しゃせん": 843,
+    "しゃそう": 844,
+    "しゃたい": 845,
+    "しゃちょう": 846,
+    "しゃっきん": 847,
+    "じゃま": 848,
+    "しゃりん": 849,
+    "しゃれい": 850,
+    "じゆう": 851,
+    "じゅうしょ": 852,
+    "しゅくはく": 853,
+    "じゅしん": 854,
+    "しゅっせき": 855,
+    "しゅみ": 856,
+    "しゅらば": 857,
+    "じゅんばん": 858,
+    "しょうかい": 859,
+    "しょくたく": 860,
+    "しょっけん": 861,
+    "しょどう": 862,
+    "しょもつ": 863,
+    "しらせる": 864,
+    "しらべる": 865,
+    "しんか": 866,
+    "しんこう": 867,
+    "じんじゃ": 868,
+    "しんせいじ": 869,
+    "しんちく": 870,
+    "しんりん": 871,
+    "すあげ": 872,
+    "すあし": 873,
+    "すあな": 874,
+    "ずあん": 875,
+    "すいえい": 876,
+    "すいか": 877,
+    "すいとう": 878,
+    "ずいぶん": 879,
+    "すいようび": 880,
+    "すうがく": 881,
+    "すうじつ": 882,
+    "すうせん": 883,
+    "すおどり": 884,
+    "すきま": 885,
+    "すくう": 886,
+    "すくない": 887,
+    "すける": 888,
+    "すごい": 889,
+    "すこし": 890,
+    "ずさん": 891,
+    "すずしい": 892,
+    "すすむ": 893,
+    "すすめる": 894,
+    "すっかり": 895,
+    "ずっしり": 896,
+    "ずっと": 897,
+    "すてき": 898,
+    "すてる": 899,
+    "すねる": 900,
+    "すのこ": 901,
+    "すはだ": 902,
+    "すばらしい": 903,
+    "ずひょう": 904,
+    "ずぶぬれ": 905,
+    "すぶり": 906,
+    "すふれ": 907,
+    "すべて": 908,
+    "すべる": 909,
+    "ずほう": 910,
+    "すぼん": 911,
+    "すまい": 912,
+    "すめし": 913,
+    "すもう": 914,
+    "すやき": 915,
+    "すらすら": 916,
+    "するめ": 917,
+    "すれちがう": 918,
+    "すろっと": 919,
+    "すわる": 920,
+    "すんぜん": 921,
+    "すんぽう": 922,
+    "せあぶら": 923,
+    "せいかつ": 924,
+    "せいげん": 925,
+    "せいじ": 926,
+    "せいよう": 927,
+    "せおう": 928,
+    "せかいかん": 929,
+    "せきにん": 930,
+    "せきむ": 931,
+    "せきゆ": 932,
+    "せきらんうん": 933,
+    "せけん": 934,
+    "せこう": 935,
+    "せすじ": 936,
+    "せたい": 937,
+    "せたけ": 938,
+    "せっかく": 939,
+    "せっきゃく": 940,
+    "ぜっく": 941,
+    "せっけん": 942,
+    "せっこつ": 943,
+    "せっさたくま": 944,
+    "せつぞく": 945,
+    "せつだん": 946,
+    "せつでん": 947,
+    "せっぱん": 948,
+    "せつび": 949,
+    "せつぶん": 950,
+    "せつめい": 951,
+    "せつりつ": 952,
+    "せなか": 953,
+    "せのび": 954,
+    "せはば": 955,
+    "せびろ": 956,
+    "せぼね": 957,
+    "せまい": 958,
+    "せまる": 959,
+    "せめる": 960,
+    "せもたれ": 961,
+    "せりふ": 962,
+    "ぜんあく": 963,
+    "せんい": 964,
+    "せんえい": 965,
+    "せんか": 966,
+    "せんきょ": 967,
+    "せんく": 968,
+    "せんげん": 969,
+    "ぜんご": 970,
+    "せんさい": 971,
+    "せんしゅ": 972,
+    "せんすい": 973,
+    "せんせい": 974,
+    "せんぞ": 975,
+    "せんたく": 976,
+    "せんちょう": 977,
+    "せんてい": 978,
+    "せんとう": 979,
+    "せんぬき": 980,
+    "せんねん": 981,
+    "せんぱい": 982,
+    "ぜんぶ": 983,
+    "ぜんぽう": 984,
+    "せんむ": 985,
+    "せんめんじょ": 986,
+    "せんもん": 987,
+    "せんやく": 988,
+    "せんゆう": 989,
+    "せんよう": 990,
+    "ぜんら": 991,
+    "ぜんりゃく": 992,
+    "せんれい": 993,
+    "せんろ": 994,
+    "そあく": 995,
+    "そいとげる": 996,
+    "そいね": 997,
+    "そうがんきょう": 998,
+    "そうき": 999,
+    "そうご": 1000,
+    "そうしん": 1001,
+    "そうだん": 1002,
+    "そうなん": 1003,
+    "そうび": 1004,
+    "そうめん": 1005,
+    "そうり": 1006,
+    "そえもの": 1007,
+    "そえん": 1008,
+    "そがい": 1009,
+    "そげき": 1010,
+    "そこう": 1011,
+    "そこそこ": 1012,
+    "そざい": 1013,
+    "そしな": 1014,
+    "そせい": 1015,
+    "そせん": 1016,
+    "そそぐ": 1017,
+    "そだてる": 1018,
+    "そつう": 1019,
+    "そつえん": 1020,
+    "そっかん": 1021,
+    "そつぎょう": 1022,
+    "そっけつ": 1023,
+    "そっこう": 1024,
+    "そっせん": 1025,
+    "そっと": 1026,
+    "そとがわ": 1027,
+    "そとづら": 1028,
+    "そなえる": 1029,
+    "そなた": 1030,
+    "そふぼ": 1031,
+    "そぼく": 1032,
+    "そぼろ": 1033,
+    "そまつ": 1034,
+    "そまる": 1035,
+    "そむく": 1036,
+    "そむりえ": 1037,
+    "そめる": 1038,
+    "そもそも": 1039,
+    "そよかぜ": 1040,
+    "そらまめ": 1041,
+    "そろう": 1042,
+    "そんかい": 1043,
+    "そんけい": 1044,
+    "そんざい": 1045,
+    "そんしつ": 1046,
+    "そんぞく": 1047,
+    "そんちょう": 1048,
+    "ぞんび": 1049,
+    "ぞんぶん": 1050,
+    "そんみん": 1051,
+    "たあい": 1052,
+    "たいいん": 1053,
+    "たいうん": 1054,
+    "たいえき": 1055,
+    "たいおう": 1056,
+    "だいがく": 1057,
+    "たいき": 1058,
+    "たいぐう": 1059,
+    "たいけん": 1060,
+    "たいこ": 1061,
+    "たいざい": 1062,
+    "だいじょうぶ": 1063,
+    "だいすき": 1064,
+    "たいせつ": 1065,
+    "たいそう": 1066,
+    "だいたい": 1067,
+    "たいちょう": 1068,
+    "たいてい": 1069,
+    "だいどころ": 1070,
+    "たいない": 1071,
+    "たいねつ": 1072,
+    "たいのう": 1073,
+    "たいはん": 1074,
+    "だいひょう": 1075,
+    "たいふう": 1076,
+    "たいへん": 1077,
+    "たいほ": 1078,
+    "たいまつばな": 1079,
+    "たいみんぐ": 1080,
+    "たいむ": 1081,
+    "たいめん": 1082,
+    "たいやき": 1083,
+    "たいよう": 1084,
+    "たいら": 1085,
+    "たいりょく": 1086,
+    "たいる": 1087,
+    "たいわん": 1088,
+    "たうえ": 1089,
+    "たえる": 1090,
+    "たおす": 1091,
+    "たおる": 1092,
+    "たおれる": 1093,
+    "たかい": 1094,
+    "たかね": 1095,
+    "たきび": 1096,
+    "たくさん": 1097,
+    "たこく": 1098,
+    "たこやき": 1099,
+    "たさい": 1100,
+    "たしざん": 1101,
+    "だじゃれ": 1102,
+    "たすける": 1103,
+    "たずさわる": 1104,
+    "たそがれ": 1105,
+    "たたかう": 1106,
+    "たたく": 1107,
+    "ただしい": 1108,
+    "たたみ": 1109,
+    "たちばな": 1110,
+    "だっかい": 1111,
+    "だっきゃく": 1112,
+    "だっこ": 1113,
+    "だっしゅつ": 1114,
+    "だったい": 1115,
+    "たてる": 1116,
+    "たとえる": 1117,
+    "たなばた": 1118,
+    "たにん": 1119,
+    "たぬき": 1120,
+    "たのしみ": 1121,
+    "たはつ": 1122,
+    "たぶん": 1123,
+    "たべる": 1124,
+    "たぼう": 1125,
+    "たまご": 1126,
+    "たまる": 1127,
+    "だむる": 1128,
+    "ためいき": 1129,
+    "ためす": 1130,
+    "ためる": 1131,
+    "たもつ": 1132,
+    "たやすい": 1133,
+    "たよる": 1134,
+    "たらす": 1135,
+    "たりきほんがん": 1136,
+    "たりょう": 1137,
+    "たりる": 1138,
+    "たると": 1139,
+    "たれる": 1140,
+    "たれんと": 1141,
+    "たろっと": 1142,
+    "たわむれる": 1143,
+    "だんあつ": 1144,
+    "たんい": 1145,
+    "たんおん": 1146,
+    "たんか": 1147,
+    "たんき": 1148,
+    "たんけん": 1149,
+    "たんご": 1150,
+    "たんさん": 1151,
+    "たんじょうび": 1152,
+    "だんせい": 1153,
+    "たんそく": 1154,
+    "たんたい": 1155,
+    "だんち": 1156,
+    "たんてい": 1157,
+    "たんとう": 1158,
+    "だんな": 1159,
+    "たんにん": 1160,
+    "だんねつ": 1161,
+    "たんのう": 1162,
+    "たんぴん": 1163,
+    "だんぼう": 1164,
+    "たんまつ": 1165,
+    "たんめい": 1166,
+    "だんれつ": 1167,
+    "だんろ": 1168,
+    "だんわ": 1169,
+    "ちあい": 1170,
+    "ちあん": 1171,
+    "ちいき": 1172,
+    "ちいさい": 1173,
+    "ちえん": 1174,
+    "ちかい": 1175,
+    "ちから": 1176,
+    "ちきゅう": 1177,
+    "ちきん": 1178,
+    "ちけいず": 1179,
+    "ちけん": 1180,
+    "ちこく": 1181,
+    "ちさい": 1182,
+    "ちしき": 1183,
+    "ちしりょう": 1184,
+    "ちせい": 1185,
+    "ちそう": 1186,
+    "ちたい": 1187,
+    "ちたん": 1188,
+    "ちちおや": 1189,
+    "ちつじょ": 1190,
+    "ちてき": 1191,
+    "ちてん": 1192,
+    "ちぬき": 1193,
+    "ちぬり": 1194,
+    "ちのう": 1195,
+    "ちひょう": 1196,
+    "ちへいせん": 1197,
+    "ちほう": 1198,
+    "ちまた": 1199,
+    "ちみつ": 1200,
+    "ちみどろ": 1201,
+    "ちめいど": 1202,
+    "ちゃんこなべ": 1203,
+    "ちゅうい": 1204,
+    "ちゆりょく": 1205,
+    "ちょうし": 1206,
+    "ちょさくけん": 1207,
+    "ちらし": 1208,
+    "ちらみ": 1209,
+    "ちりがみ": 1210,
+    "ちりょう": 1211,
+    "ちるど": 1212,
+    "ちわわ": 1213,
+    "ちんたい": 1214,
+    "ちんもく": 1215,
+    "ついか": 1216,
+    "ついたち": 1217,
+    "つうか": 1218,
+    "つうじょう": 1219,
+    "つうはん": 1220,
+    "つうわ": 1221,
+    "つかう": 1222,
+    "つかれる": 1223,
+    "つくね": 1224,
+    "つくる": 1225,
+    "つけね": 1226,
+    "つける": 1227,
+    "つごう": 1228,
+    "つたえる": 1229,
+    "つづく": 1230,
+    "つつじ": 1231,
+    "つつむ": 1232,
+    "つとめる": 1233,
+    "つながる": 1234,
+    "つなみ": 1235,
+    "つねづね": 1236,
+    "つのる": 1237,
+    "つぶす": 1238,
+    "つまらない": 1239,
+    "つまる": 1240,
+    "つみき": 1241,
+    "つめたい": 1242,
+    "つもり": 1243,
+    "つもる": 1244,
+    "つよい": 1245,
+    "つるぼ": 1246,
+    "つるみく": 1247,
+    "つわもの": 1248,
+    "つわり": 1249,
+    "てあし": 1250,
+    "てあて": 1251,
+    "てあみ": 1252,
+    "ていおん": 1253,
+    "ていか": 1254,
+    "ていき": 1255,
+    "ていけい": 1256,
+    "ていこく": 1257,
+    "ていさつ": 1258,
+    "ていし": 1259,
+    "ていせい": 1260,
+    "ていたい": 1261,
+    "ていど": 1262,
+    "ていねい": 1263,
+    "ていひょう": 1264,
+    "ていへん": 1265,
+    "ていぼう": 1266,
+    "てうち": 1267,
+    "ておくれ": 1268,
+    "てきとう": 1269,
+    "てくび": 1270,
+    "でこぼこ": 1271,
+    "てさぎょう": 1272,
+    "てさげ": 1273,
+    "てすり": 1274,
+    "てそう": 1275,
+    "てちがい": 1276,
+    "てちょう": 1277,
+    "てつがく": 1278,
+    "てつづき": 1279,
+    "でっぱ": 1280,
+    "てつぼう": 1281,
+    "てつや": 1282,
+    "でぬかえ": 1283,
+    "てぬき": 1284,
+    "てぬぐい": 1285,
+    "てのひら": 1286,
+    "てはい": 1287,
+    "てぶくろ": 1288,
+    "てふだ": 1289,
+    "てほどき": 1290,
+    "てほん": 1291,
+    "てまえ": 1292,
+    "てまきずし": 1293,
+    "てみじか": 1294,
+    "てみやげ": 1295,
+    "てらす": 1296,
+    "てれび": 1297,
+    "てわけ": 1298,
+    "てわたし": 1299,
+    "でんあつ": 1300,
+    "てんいん": 1301,
+    "てんかい": 1302,
+    "てんき": 1303,
+    "てんぐ": 1304,
+    "てんけん": 1305,
+    "てんごく": 1306,
+    "てんさい": 1307,
+    "てんし": 1308,
+    "てんすう": 1309,
+    "でんち": 1310,
+    "てんてき": 1311,
+    "てんとう": 1312,
+    "てんない": 1313,
+    "てんぷら": 1314,
+    "てんぼうだい": 1315,
+    "てんめつ": 1316,
+    "てんらんかい": 1317,
+    "でんりょく": 1318,
+    "でんわ": 1319,
+    "どあい": 1320,
+    "といれ": 1321,
+    "どうかん": 1322,
+    "とうきゅう": 1323,
+    "どうぐ": 1324,
+    "とうし": 1325,
+    "とうむぎ": 1326,
+    "とおい": 1327,
+    "とおか": 1328,
+    "とおく": 1329,
+    "とおす": 1330,
+    "とおる": 1331,
+    "とかい": 1332,
+    "とかす": 1333,
+    "ときおり": 1334,
+    "ときどき": 1335,
+    "とくい": 1336,
+    "とくしゅう": 1337,
+    "とくてん": 1338,
+    "とくに": 1339,
+    "とくべつ": 1340,
+    "とけい": 1341,
+    "とける": 1342,
+    "とこや": 1343,
+    "とさか": 1344,
+    "としょかん": 1345,
+    "とそう": 1346,
+    "とたん": 1347,
+    "とちゅう": 1348,
+    "とっきゅう": 1349,
+    "とっくん": 1350,
+    "とつぜん": 1351,
+    "とつにゅう": 1352,
+    "とどける": 1353,
+    "ととのえる": 1354,
+    "とない": 1355,
+    "となえる": 1356,
+    "となり": 1357,
+    "とのさま": 1358,
+    "とばす": 1359,
+    "どぶがわ": 1360,
+    "とほう": 1361,
+    "とまる": 1362,
+    "とめる": 1363,
+    "ともだち": 1364,
+    "ともる": 1365,
+    "どようび": 1366,
+    "とらえる": 1367,
+    "とんかつ": 1368,
+    "どんぶり": 1369,
+    "ないかく": 1370,
+    "ないこう": 1371,
+    "ないしょ": 1372,
+    "ないす": 1373,
+    "ないせん": 1374,
+    "ないそう": 1375,
+    "なおす": 1376,
+    "ながい": 1377,
+    "なくす": 1378,
+    "なげる": 1379,
+    "なこうど": 1380,
+    "なさけ": 1381,
+    "なたでここ": 1382,
+    "なっとう": 1383,
+    "なつやすみ": 1384,
+    "ななおし": 1385,
+    "なにごと": 1386,
+    "なにもの": 1387,
+    "なにわ": 1388,
+    "なのか": 1389,
+    "なふだ": 1390,
+    "なまいき": 1391,
+    "なまえ": 1392,
+    "なまみ": 1393,
+    "なみだ": 1394,
+    "なめらか": 1395,
+    "なめる": 1396,
+    "なやむ": 1397,
+    "ならう": 1398,
+    "ならび": 1399,
+    "ならぶ": 1400,
+    "なれる": 1401,
+    "なわとび": 1402,
+    "なわばり": 1403,
+    "にあう": 1404,
+    "にいがた": 1405,
+    "にうけ": 1406,
+    "におい": 1407,
+    "にかい": 1408,
+    "にがて": 1409,
+    "にきび": 1410,
+    "にくしみ": 1411,
+    "にくまん": 1412,
+    "にげる": 1413,
+    "にさんかたんそ": 1414,
+    "にしき": 1415,
+    "にせもの": 1416,
+    "にちじょう": 1417,
+    "にちようび": 1418,
+    "にっか": 1419,
+    "にっき": 1420,
+    "にっけい": 1421,
+    "にっこう": 1422,
+    "にっさん": 1423,
+    "にっしょく": 1424,
+    "にっすう": 1425,
+    "にっせき": 1426,
+    "にってい": 1427,
+    "になう": 1428,
+    "にほん": 1429,
+    "にまめ": 1430,
+    "にもつ": 1431,
+    "にやり": 1432,
+    "にゅういん": 1433,
+    "にりんしゃ": 1434,
+    "にわとり": 1435,
+    "にんい": 1436,
+    "にんか": 1437,
+    "にんき": 1438,
+    "にんげん": 1439,
+    "にんしき": 1440,
+    "にんずう": 1441,
+    "にんそう": 1442,
+    "にんたい": 1443,
+    "にんち": 1444,
+    "にんてい": 1445,
+    "にんにく": 1446,
+    "にんぷ": 1447,
+    "にんまり": 1448,
+    "にんむ": 1449,
+    "にんめい": 1450,
+    "にんよう": 1451,
+    "ぬいくぎ": 1452,
+    "ぬかす": 1453,
+    "ぬぐいとる": 1454,
+    "ぬぐう": 1455,
+    "ぬくもり": 1456,
+    "ぬすむ": 1457,
+    "ぬまえび": 1458,
+    "ぬめり": 1459,
+    "ぬらす": 1460,
+    "ぬんちゃく": 1461,
+    "ねあげ": 1462,
+    "ねいき": 1463,
+    "ねいる": 1464,
+    "ねいろ": 1465,
+    "ねぐせ": 1466,
+    "ねくたい": 1467,
+    "ねくら": 1468,
+    "ねこぜ": 1469,
+    "ねこむ": 1470,
+    "ねさげ": 1471,
+    "ねすごす": 1472,
+    "ねそべる": 1473,
+    "ねだん": 1474,
+    "ねつい": 1475,
+    "ねっしん": 1476,
+    "ねつぞう": 1477,
+    "ねったいぎょ": 1478,
+    "ねぶそく": 1479,
+    "ねふだ": 1480,
+    "ねぼう": 1481,
+    "ねほりはほり": 1482,
+    "ねまき": 1483,
+    "ねまわし": 1484,
+    "ねみみ": 1485,
+    "ねむい": 1486,
+    "ねむたい": 1487,
+    "ねもと": 1488,
+    "ねらう": 1489,
+    "ねわざ": 1490,
+    "ねんいり": 1491,
+    "ねんおし": 1492,
+    "ねんかん": 1493,
+    "ねんきん": 1494,
+    "ねんぐ": 1495,
+    "ねんざ": 1496,
+    "ねんし": 1497,
+    "ねんちゃく": 1498,
+    "ねんど": 1499,
+    "ねんぴ": 1500,
+    "ねんぶつ": 1501,
+    "ねんまつ": 1502,
+    "ねんりょう": 1503,
+    "ねんれい": 1504,
+    "のいず": 1505,
+    "のおづま": 1506,
+    "のがす": 1507,
+    "のきなみ": 1508,
+    "のこぎり": 1509,
+    "のこす": 1510,
+    "のこる": 1511,
+    "のせる": 1512,
+    "のぞく": 1513,
+    "のぞむ": 1514,
+    "のたまう": 1515,
+    "のちほど": 1516,
+    "のっく": 1517,
+    "のばす": 1518,
+    "のはら": 1519,
+    "のべる": 1520,
+    "のぼる": 1521,
+    "のみもの": 1522,
+    "のやま": 1523,
+    "のらいぬ": 1524,
+    "のらねこ": 1525,
+    "のりもの": 1526,
+    "のりゆき": 1527,
+    "のれん": 1528,
+    "のんき": 1529,
+    "ばあい": 1530,
+    "はあく": 1531,
+    "ばあさん": 1532,
+    "ばいか": 1533,
+    "ばいく": 1534,
+    "はいけん": 1535,
+    "はいご": 1536,
+    "はいしん": 1537,
+    "はいすい": 1538,
+    "はいせん": 1539,
+    "はいそう": 1540,
+    "はいち": 1541,
+    "ばいばい": 1542,
+    "はいれつ": 1543,
+    "はえる": 1544,
+    "はおる": 1545,
+    "はかい": 1546,
+    "ばかり": 1547,
+    "はかる": 1548,
+    "はくしゅ": 1549,
+    "はけん": 1550,
+    "はこぶ": 1551,
+    "はさみ": 1552,
+    "はさん": 1553,
+    "はしご": 1554,
+    "ばしょ": 1555,
+    "はしる": 1556,
+    "はせる": 1557,
+    "ぱそこん": 1558,
+    "はそん": 1559,
+    "はたん": 1560,
+    "はちみつ": 1561,
+    "はつおん": 1562,
+    "はっかく": 1563,
+    "はづき": 1564,
+    "はっきり": 1565,
+    "はっくつ": 1566,
+    "はっけん": 1567,
+    "はっこう": 1568,
+    "はっさん": 1569,
+    "はっしん": 1570,
+    "はったつ": 1571,
+    "はっちゅう": 1572,
+    "はってん": 1573,
+    "はっぴょう": 1574,
+    "はっぽう": 1575,
+    "はなす": 1576,
+    "はなび": 1577,
+    "はにかむ": 1578,
+    "はぶらし": 1579,
+    "はみがき": 1580,
+    "はむかう": 1581,
+    "はめつ": 1582,
+    "はやい": 1583,
+    "はやし": 1584,
+    "はらう": 1585,
+    "はろうぃん": 1586,
+    "はわい": 1587,
+    "はんい": 1588,
+    "はんえい": 1589,
+    "はんおん": 1590,
+    "はんかく": 1591,
+    "はんきょう": 1592,
+    "ばんぐみ": 1593,
+    "はんこ": 1594,
+    "はんしゃ": 1595,
+    "はんすう": 1596,
+    "はんだん": 1597,
+    "ぱんち": 1598,
+    "ぱんつ": 1599,
+    "はんてい": 1600,
+    "はんとし": 1601,
+    "はんのう": 1602,
+    "はんぱ": 1603,
+    "はんぶん": 1604,
+    "はんぺん": 1605,
+    "はんぼうき": 1606,
+    "はんめい": 1607,
+    "はんらん": 1608,
+    "はんろん": 1609,
+    "ひいき": 1610,
+    "ひうん": 1611,
+    "ひえる": 1612,
+    "ひかく": 1613,
+    "ひかり": 1614,
+    "ひかる": 1615,
+    "ひかん": 1616,
+    "ひくい": 1617,
+    "ひけつ": 1618,
+    "ひこうき": 1619,
+    "ひこく": 1620,
+    "ひさい": 1621,
+    "ひさしぶり": 1622,
+    "ひさん": 1623,
+    "びじゅつかん": 1624,
+    "ひしょ": 1625,
+    "ひそか": 1626,
+    "ひそむ": 1627,
+    "ひたむき": 1628,
+    "ひだり": 1629,
+    "ひたる": 1630,
+    "ひつぎ": 1631,
+    "ひっこし": 1632,
+    "ひっし": 1633,
+    "ひつじゅひん": 1634,
+    "ひっす": 1635,
+    "ひつぜん": 1636,
+    "ぴったり": 1637,
+    "ぴっちり": 1638,
+    "ひつよう": 1639,
+    "ひてい": 1640,
+    "ひとごみ": 1641,
+    "ひなまつり": 1642,
+    "ひなん": 1643,
+    "ひねる": 1644,
+    "ひはん": 1645,
+    "ひびく": 1646,
+    "ひひょう": 1647,
+    "ひほう": 1648,
+    "ひまわり": 1649,
+    "ひまん": 1650,
+    "ひみつ": 1651,
+    "ひめい": 1652,
+    "ひめじし": 1653,
+    "ひやけ": 1654,
+    "ひやす": 1655,
+    "ひよう": 1656,
+    "びょうき": 1657,
+    "ひらがな": 1658,
+    "ひらく": 1659,
+    "ひりつ": 1660,
+    "ひりょう": 1661,
+    "ひるま": 1662,
+    "ひるやすみ": 1663,
+    "ひれい": 1664,
+    "ひろい": 1665,
+    "ひろう": 1666,
+    "ひろき": 1667,
+    "ひろゆき": 1668,
+    "ひんかく": 1669,
+    "ひんけつ": 1670,
+    "ひんこん": 1671,
+    "ひんしゅ": 1672,
+    "ひんそう": 1673,
+    "ぴんち": 1674,
+    "ひんぱん": 1675,
+    "びんぼう": 1676,
+    "ふあん": 1677,
+    "ふいうち": 1678,
+    "ふうけい": 1679,
+    "ふうせん": 1680,
+    "ぷうたろう": 1681,
+    "ふうとう": 1682,
+    "ふうふ": 1683,
+    "ふえる": 1684,
+    "ふおん": 1685,
+    "ふかい": 1686,
+    "ふきん": 1687,
+    "ふくざつ": 1688,
+    "ふくぶくろ": 1689,
+    "ふこう": 1690,
+    "ふさい": 1691,
+    "ふしぎ": 1692,
+    "ふじみ": 1693,
+    "ふすま": 1694,
+    "ふせい": 1695,
+    "ふせぐ": 1696,
+    "ふそく": 1697,
+    "ぶたにく": 1698,
+    "ふたん": 1699,
+    "ふちょう": 1700,
+    "ふつう": 1701,
+    "ふつか": 1702,
+    "ふっかつ": 1703,
+    "ふっき": 1704,
+    "ふっこく": 1705,
+    "ぶどう": 1706,
+    "ふとる": 1707,
+    "ふとん": 1708,
+    "ふのう": 1709,
+    "ふはい": 1710,
+    "ふひょう": 1711,
+    "ふへん": 1712,
+    "ふまん": 1713,
+    "ふみん": 1714,
+    "ふめつ": 1715,
+    "ふめん": 1716,
+    "ふよう": 1717,
+    "ふりこ": 1718,
+    "ふりる": 1719,
+    "ふるい": 1720,
+    "ふんいき": 1721,
+    "ぶんがく": 1722,
+    "ぶんぐ": 1723,
+    "ふんしつ": 1724,
+    "ぶんせき": 1725,
+    "ふんそう": 1726,
+    "ぶんぽう": 1727,
+    "へいあん": 1728,
+    "へいおん": 1729,
+    "へいがい": 1730,
+    "へいき": 1731,
+    "へいげん": 1732,
+    "へいこう": 1733,
+    "へいさ": 1734,
+    "へいしゃ": 1735,
+    "へいせつ": 1736,
+    "へいそ": 1737,
+    "へいたく": 1738,
+    "へいてん": 1739,
+    "へいねつ": 1740,
+    "へいわ": 1741,
+    "へきが": 1742,
+    "へこむ": 1743,
+    "べにいろ": 1744,
+    "べにしょうが": 1745,
+    "へらす": 1746,
+    "へんかん": 1747,
+    "べんきょう": 1748,
+    "べんごし": 1749,
+    "へんさい": 1750,
+    "へんたい": 1751,
+    "べんり": 1752,
+    "ほあん": 1753,
+    "ほいく": 1754,
+    "ぼうぎょ": 1755,
+    "ほうこく": 1756,
+    "ほうそう": 1757,
+    "ほうほう": 1758,
+    "ほうもん": 1759,
+    "ほうりつ": 1760,
+    "ほえる": 1761,
+    "ほおん": 1762,
+    "ほかん": 1763,
+    "ほきょう": 1764,
+    "ぼきん": 1765,
+    "ほくろ": 1766,
+    "ほけつ": 1767,
+    "ほけん": 1768,
+    "ほこう": 1769,
+    "ほこる": 1770,
+    "ほしい": 1771,
+    "ほしつ": 1772,
+    "ほしゅ": 1773,
+    "ほしょう": 1774,
+    "ほせい": 1775,
+    "ほそい": 1776,
+    "ほそく": 1777,
+    "ほたて": 1778,
+    "ほたる": 1779,
+    "ぽちぶくろ": 1780,
+    "ほっきょく": 1781,
+    "ほっさ": 1782,
+    "ほったん": 1783,
+    "ほとんど": 1784,
+    "ほめる": 1785,
+    "ほんい": 1786,
+    "ほんき": 1787,
+    "ほんけ": 1788,
+    "ほんしつ": 1789,
+    "ほんやく": 1790,
+    "まいにち": 1791,
+    "まかい": 1792,
+    "まかせる": 1793,
+    "まがる": 1794,
+    "まける": 1795,
+    "まこと": 1796,
+    "まさつ": 1797,
+    "まじめ": 1798,
+    "ますく": 1799,
+    "まぜる": 1800,
+    "まつり": 1801,
+    "まとめ": 1802,
+    "まなぶ": 1803,
+    "まぬけ": 1804,
+    "まねく": 1805,
+    "まほう": 1806,
+    "まもる": 1807,
+    "まゆげ": 1808,
+    "まよう": 1809,
+    "まろやか": 1810,
+    "まわす": 1811,
+    "まわり": 1812,
+    "まわる": 1813,
+    "まんが": 1814,
+    "まんきつ": 1815,
+    "まんぞく": 1816,
+    "まんなか": 1817,
+    "みいら": 1818,
+    "みうち": 1819,
+    "みえる": 1820,
+    "みがく": 1821,
+    "みかた": 1822,
+    "みかん": 1823,
+    "みけん": 1824,
+    "みこん": 1825,
+    "みじかい": 1826,
+    "みすい": 1827,
+    "みすえる": 1828,
+    "みせる": 1829,
+    "みっか": 1830,
+    "みつかる": 1831,
+    "みつける": 1832,
+    "みてい": 1833,
+    "みとめる": 1834,
+    "みなと": 1835,
+    "みなみかさい": 1836,
+    "みねらる": 1837,
+    "みのう": 1838,
+    "みのがす": 1839,
+    "みほん": 1840,
+    "みもと": 1841,
+    "みやげ": 1842,
+    "みらい": 1843,
+    "みりょく": 1844,
+    "みわく": 1845,
+    "みんか": 1846,
+    "みんぞく": 1847,
+    "むいか": 1848,
+    "むえき": 1849,
+    "むえん": 1850,
+    "むかい": 1851,
+    "むかう": 1852,
+    "むかえ": 1853,
+    "むかし": 1854,
+    "むぎちゃ": 1855,
+    "むける": 1856,
+    "むげん": 1857,
+    "むさぼる": 1858,
+    "むしあつい": 1859,
+    "むしば": 1860,
+    "むじゅん": 1861,
+    "むしろ": 1862,
+    "むすう": 1863,
+    "むすこ": 1864,
+    "むすぶ": 1865,
+    "むすめ": 1866,
+    "むせる": 1867,
+    "むせん": 1868,
+    "むちゅう": 1869,
+    "むなしい": 1870,
+    "むのう": 1871,
+    "むやみ": 1872,
+    "むよう": 1873,
+    "むらさき": 1874,
+    "むりょう": 1875,
+    "むろん": 1876,
+    "めいあん": 1877,
+    "めいうん": 1878,
+    "めいえん": 1879,
+    "めいかく": 1880,
+    "めいきょく": 1881,
+    "めいさい": 1882,
+    "めいし": 1883,
+    "めいそう": 1884,
+    "めいぶつ": 1885,
+    "めいれい": 1886,
+    "めいわく": 1887,
+    "めぐまれる": 1888,
+    "めざす": 1889,
+    "めした": 1890,
+    "めずらしい": 1891,
+    "めだつ": 1892,
+    "めまい": 1893,
+    "めやす": 1894,
+    "めんきょ": 1895,
+    "めんせき": 1896,
+    "めんどう": 1897,
+    "もうしあげる": 1898,
+    "もうどうけん": 1899,
+    "もえる": 1900,
+    "もくし": 1901,
+    "もくてき": 1902,
+    "もくようび": 1903,
+    "もちろん": 1904,
+    "もどる": 1905,
+    "もらう": 1906,
+    "もんく": 1907,
+    "もんだい": 1908,
+    "やおや": 1909,
+    "やける": 1910,
+    "やさい": 1911,
+    "やさしい": 1912,
+    "やすい": 1913,
+    "やすたろう": 1914,
+    "やすみ": 1915,
+    "やせる": 1916,
+    "やそう": 1917,
+    "やたい": 1918,
+    "やちん": 1919,
+    "やっと": 1920,
+    "やっぱり": 1921,
+    "やぶる": 1922,
+    "やめる": 1923,
+    "ややこしい": 1924,
+    "やよい": 1925,
+    "やわらかい": 1926,
+    "ゆうき": 1927,
+    "ゆうびんきょく": 1928,
+    "ゆうべ": 1929,
+    "ゆうめい": 1930,
+    "ゆけつ": 1931,
+    "ゆしゅつ": 1932,
+    "ゆせん": 1933,
+    "ゆそう": 1934,
+    "ゆたか": 1935,
+    "ゆちゃく": 1936,
+    "ゆでる": 1937,
+    "ゆにゅう": 1938,
+    "ゆびわ": 1939,
+    "ゆらい": 1940,
+    "ゆれる": 1941,
+    "ようい": 1942,
+    "ようか": 1943,
+    "ようきゅう": 1944,
+    "ようじ": 1945,
+    "ようす": 1946,
+    "ようちえん": 1947,
+    "よかぜ": 1948,
+    "よかん": 1949,
+    "よきん": 1950,
+    "よくせい": 1951,
+    "よくぼう": 1952,
+    "よけい": 1953,
+    "よごれる": 1954,
+    "よさん": 1955,
+    "よしゅう": 1956,
+    "よそう": 1957,
+    "よそく": 1958,
+    "よっか": 1959,
+    "よてい": 1960,
+    "よどがわく": 1961,
+    "よねつ": 1962,
+    "よやく": 1963,
+    "よゆう": 1964,
+    "よろこぶ": 1965,
+    "よろしい": 1966,
+    "らいう": 1967,
+    "らくがき": 1968,
+    "らくご": 1969,
+    "らくさつ": 1970,
+    "らくだ": 1971,
+    "らしんばん": 1972,
+    "らせん": 1973,
+    "らぞく": 1974,
+    "らたい": 1975,
+    "らっか": 1976,
+    "られつ": 1977,
+    "りえき": 1978,
+    "りかい": 1979,
+    "りきさく": 1980,
+    "りきせつ": 1981,
+    "りくぐん": 1982,
+    "りくつ": 1983,
+    "りけん": 1984,
+    "りこう": 1985,
+    "りせい": 1986,
+    "りそう": 1987,
+    "りそく": 1988,
+    "りてん": 1989,
+    "りねん": 1990,
+    "りゆう": 1991,
+    "りゅうがく": 1992,
+    "りよう": 1993,
+    "りょうり": 1994,
+    "りょかん": 1995,
+    "りょくちゃ": 1996,
+    "りょこう": 1997,
+    "りりく": 1998,
+    "りれき": 1999,
+    "りろん": 2000,
+    "りんご": 2001,
+    "るいけい": 2002,
+    "るいさい": 2003,
+    "るいじ": 2004,
+    "るいせき": 2005,
+    "るすばん": 2006,
+    "るりがわら": 2007,
+    "れいかん": 2008,
+    "れいぎ": 2009,
+    "れいせい": 2010,
+    "れいぞうこ": 2011,
+    "れいとう": 2012,
+    "れいぼう": 2013,
+    "れきし": 2014,
+    "れきだい": 2015,
+    "れんあい": 2016,
+    "れんけい": 2017,
+    "れんこん": 2018,
+    "れんさい": 2019,
+    "れんしゅう": 2020,
+    "れんぞく": 2021,
+    "れんらく": 2022,
+    "ろうか": 2023,
+    "ろうご": 2024,
+    "ろうじん": 2025,
+    "ろうそく": 2026,
+    "ろくが": 2027,
+    "ろこつ": 2028,
+    "ろじうら": 2029,
+    "ろしゅつ": 2030,
+    "ろせん": 2031,
+    "ろてん": 2032,
+    "ろめん": 2033,
+    "ろれつ": 2034,
+    "ろんぎ": 2035,
+    "ろんぱ": 2036,
+    "ろんぶん": 2037,
+    "ろんり": 2038,
+    "わかす": 2039,
+    "わかめ": 2040,
+    "わかやま": 2041,
+    "わかれる": 2042,
+    "わしつ": 2043,
+    "わじまし": 2044,
+    "わすれもの": 2045,
+    "わらう": 2046,
+    "われる": 2047,
+}
+var words_korean = [2048]string{
+    "가격",
+    "가끔",
+    "가난",
+    "가능",
+    "가득",
+    "가르침",
+    "가뭄",
+    "가방",
+    "가상",
+    "가슴",
+    "가운데",
+    "가을",
+    "가이드",
+    "가입",
+    "가장",
+    "가정",
+    "가족",
+    "가죽",
+    "각오",
+    "각자",
+    "간격",
+    "간부",
+    "간섭",
+    "간장",
+    "간접",
+    "간판",
+    "갈등",
+    "갈비",
+    "갈색",
+    "갈증",
+    "감각",
+    "감기",
+    "감소",
+    "감수성",
+    "감자",
+    "감정",
+    "갑자기",
+    "강남",
+    "강당",
+    "강도",
+    "강력히",
+    "강변",
+    "강북",
+    "강사",
+    "강수량",
+    "강아지",
+    "강원도",
+    "강의",
+    "강제",
+    "강조",
+    "같이",
+    "개구리",
+    "개나리",
+    "개방",
+    "개별",
+    "개선",
+    "개성",
+    "개인",
+    "객관적",
+    "거실",
+    "거액",
+    "거울",
+    "거짓",
+    "거품",
+    "걱정",
+    "건강",
+    "건물",
+    "건설",
+    "건조",
+    "건축",
+    "걸음",
+    "검사",
+    "검토",
+    "게시판",
+    "게임",
+    "겨울",
+    "견해",
+    "결과",
+    "결국",
+    "결론",
+    "결석",
+    "결승",
+    "결심",
+    "결정",
+    "결혼",
+    "경계",
+    "경고",
+    "경기",
+    "경력",
+    "경복궁",
+    "경비",
+    "경상도",
+    "경영",
+    "경우",
+    "경쟁",
+    "경제",
+    "경주",
+    "경찰",
+    "경치",
+    "경향",
+    "경험",
+    "계곡",
+    "계단",
+    "계란",
+    "계산",
+    "계속",
+    "계약",
+    "계절",
+    "계층",
+    "계획",
+    "고객",
+    "고구려",
+    "고궁",
+    "고급",
+    "고등학생",
+    "고무신",
+    "고민",
+    "고양이",
+    "고장",
+    "고전",
+    "고집",
+    "고춧가루",
+    "고통",
+    "고향",
+    "곡식",
+    "골목",
+    "골짜기",
+    "골프",
+    "공간",
+    "공개",
+    "공격",
+    "공군",
+    "공급",
+    "공기",
+    "공동",
+    "공무원",
+    "공부",
+    "공사",
+    "공식",
+    "공업",
+    "공연",
+    "공원",
+    "공장",
+    "공짜",
+    "공책",
+    "공통",
+    "공포",
+    "공항",
+    "공휴일",
+    "과목",
+    "과일",
+    "과장",
+    "과정",
+    "과학",
+    "관객",
+    "관계",
+    "관광",
+    "관념",
+    "관람",
+    "관련",
+    "관리",
+    "관습",
+    "관심",
+    "관점",
+    "관찰",
+    "광경",
+    "광고",
+    "광장",
+    "광주",
+    "괴로움",
+    "굉장히",
+    "교과서",
+    "교문",
+    "교복",
+    "교실",
+    "교양",
+    "교육",
+    "교장",
+    "교직",
+    "교통",
+    "교환",
+    "교훈",
+    "구경",
+    "구름",
+    "구멍",
+    "구별",
+    "구분",
+    "구석",
+    "구성",
+    "구속",
+    "구역",
+    "구입",
+    "구청",
+    "구체적",
+    "국가",
+    "국기",
+    "국내",
+    "국립",
+    "국물",
+    "국민",
+    "국수",
+    "국어",
+    "국왕",
+    "국적",
+    "국제",
+    "국회",
+    "군대",
+    "군사",
+    "군인",
+    "궁극적",
+    "권리",
+    "권위",
+    "권투",
+    "귀국",
+    "귀신",
+    "규정",
+    "규칙",
+    "균형",
+    "그날",
+    "그냥",
+    "그늘",
+    "그러나",
+    "그룹",
+    "그릇",
+    "그림",
+    "그제서야",
+    "그토록",
+    "극복",
+    "극히",
+    "근거",
+    "근교",
+    "근래",
+    "근로",
+    "근무",
+    "근본",
+    "근원",
+    "근육",
+    "근처",
+    "글씨",
+    "글자",
+    "금강산",
+    "금고",
+    "금년",
+    "금메달",
+    "금액",
+    "금연",
+    "금요일",
+    "금지",
+    "긍정적",
+    "기간",
+    "기관",
+    "기념",
+    "기능",
+    "기독교",
+    "기둥",
+    "기록",
+    "기름",
+    "기법",
+    "기본",
+    "기분",
+    "기쁨",
+    "기숙사",
+    "기술",
+    "기억",
+    "기업",
+    "기온",
+    "기운",
+    "기원",
+    "기적",
+    "기준",
+    "기침",
+    "기혼",
+    "기획",
+    "긴급",
+    "긴장",
+    "길이",
+    "김밥",
+    "김치",
+    "김포공항",
+    "깍두기",
+    "깜빡",
+    "깨달음",
+    "깨소금",
+    "껍질",
+    "꼭대기",
+    "꽃잎",
+    "나들이",
+    "나란히",
+    "나머지",
+    "나물",
+    "나침반",
+    "나흘",
+    "낙엽",
+    "난방",
+    "날개",
+    "날씨",
+    "날짜",
+    "남녀",
+    "남대문",
+    "남매",
+    "남산",
+    "남자",
+    "남편",
+    "남학생",
+    "낭비",
+    "낱말",
+    "내년",
+    "내용",
+    "내일",
+    "냄비",
+    "냄새",
+    "냇물",
+    "냉동",
+    "냉면",
+    "냉방",
+    "냉장고",
+    "넥타이",
+    "넷째",
+    "노동",
+    "노란색",
+    "노력",
+    "노인",
+    "녹음",
+    "녹차",
+    "녹화",
+    "논리",
+    "논문",
+    "논쟁",
+    "놀이",
+    "농구",
+    "농담",
+    "농민",
+    "농부",
+    "농업",
+    "농장",
+    "농촌",
+    "높이",
+    "눈동자",
+    "눈물",
+    "눈썹",
+    "뉴욕",
+    "느낌",
+    "늑대",
+    "능동적",
+    "능력",
+    "다방",
+    "다양성",
+    "다음",
+    "다이어트",
+    "다행",
+    "단계",
+    "단골",
+    "단독",
+    "단맛",
+    "단순",
+    "단어",
+    "단위",
+    "단점",
+    "단체",
+    "단추",
+    "단편",
+    "단풍",
+    "달걀",
+    "달러",
+    "달력",
+    "달리",
+    "닭고기",
+    "담당",
+    "담배",
+    "담요",
+    "담임",
+    "답변",
+    "답장",
+    "당근",
+    "당분간",
+    "당연히",
+    "당장",
+    "대규모",
+    "대낮",
+    "대단히",
+    "대답",
+    "대도시",
+    "대략",
+    "대량",
+    "대륙",
+    "대문",
+    "대부분",
+    "대신",
+    "대응",
+    "대장",
+    "대전",
+    "대접",
+    "대중",
+    "대책",
+    "대출",
+    "대충",
+    "대통령",
+    "대학",
+    "대한민국",
+    "대합실",
+    "대형",
+    "덩어리",
+    "데이트",
+    "도대체",
+    "도덕",
+    "도둑",
+    "도망",
+    "도서관",
+    "도심",
+    "도움",
+    "도입",
+    "도자기",
+    "도저히",
+    "도전",
+    "도중",
+    "도착",
+    "독감",
+    "독립",
+    "독서",
+    "독일",
+    "독창적",
+    "동화책",
+    "뒷모습",
+    "뒷산",
+    "딸아이",
+    "마누라",
+    "마늘",
+    "마당",
+    "마라톤",
+    "마련",
+    "마무리",
+    "마사지",
+    "마약",
+    "마요네즈",
+    "마을",
+    "마음",
+    "마이크",
+    "마중",
+    "마지막",
+    "마찬가지",
+    "마찰",
+    "마흔",
+    "막걸리",
+    "막내",
+    "막상",
+    "만남",
+    "만두",
+    "만세",
+    "만약",
+    "만일",
+    "만점",
+    "만족",
+    "만화",
+    "많이",
+    "말기",
+    "말씀",
+    "말투",
+    "맘대로",
+    "망원경",
+    "매년",
+    "매달",
+    "매력",
+    "매번",
+    "매스컴",
+    "매일",
+    "매장",
+    "맥주",
+    "먹이",
+    "먼저",
+    "먼지",
+    "멀리",
+    "메일",
+    "며느리",
+    "며칠",
+    "면담",
+    "멸치",
+    "명단",
+    "명령",
+    "명예",
+    "명의",
+    "명절",
+    "명칭",
+    "명함",
+    "모금",
+    "모니터",
+    "모델",
+    "모든",
+    "모범",
+    "모습",
+    "모양",
+    "모임",
+    "모조리",
+    "모집",
+    "모퉁이",
+    "목걸이",
+    "목록",
+    "목사",
+    "목소리",
+    "목숨",
+    "목적",
+    "목표",
+    "몰래",
+    "몸매",
+    "몸무게",
+    "몸살",
+    "몸속",
+    "몸짓",
+    "몸통",
+    "몹시",
+    "무관심",
+    "무궁화",
+    "무더위",
+    "무덤",
+    "무릎",
+    "무슨",
+    "무엇",
+    "무역",
+    "무용",
+    "무조건",
+    "무지개",
+    "무척",
+    "문구",
+    "문득",
+    "문법",
+    "문서",
+    "문제",
+    "문학",
+    "문화",
+    "물가",
+    "물건",
+    "물결",
+    "물고기",
+    "물론",
+    "물리학",
+    "물음",
+    "물질",
+    "물체",
+    "미국",
+    "미디어",
+    "미사일",
+    "미술",
+    "미역",
+    "미용실",
+    "미움",
+    "미인",
+    "미팅",
+    "미혼",
+    "민간",
+    "민족",
+    "민주",
+    "믿음",
+    "밀가루",
+    "밀리미터",
+    "밑바닥",
+    "바가지",
+    "바구니",
+    "바나나",
+    "바늘",
+    "바닥",
+    "바닷가",
+    "바람",
+    "바이러스",
+    "바탕",
+    "박물관",
+    "박사",
+    "박수",
+    "반대",
+    "반드시",
+    "반말",
+    "반발",
+    "반성",
+    "반응",
+    "반장",
+    "반죽",
+    "반지",
+    "반찬",
+    "받침",
+    "발가락",
+    "발걸음",
+    "발견",
+    "발달",
+    "발레",
+    "발목",
+    "발바닥",
+    "발생",
+    "발음",
+    "발자국",
+    "발전",
+    "발톱",
+    "발표",
+    "밤하늘",
+    "밥그릇",
+    "밥맛",
+    "밥상",
+    "밥솥",
+    "방금",
+    "방면",
+    "방문",
+    "방바닥",
+    "방법",
+    "방송",
+    "방식",
+    "방안",
+    "방울",
+    "방지",
+    "방학",
+    "방해",
+    "방향",
+    "배경",
+    "배꼽",
+    "배달",
+    "배드민턴",
+    "백두산",
+    "백색",
+    "백성",
+    "백인",
+    "백제",
+    "백화점",
+    "버릇",
+    "버섯",
+    "버튼",
+    "번개",
+    "번역",
+    "번지",
+    "번호",
+    "벌금",
+    "벌레",
+    "벌써",
+    "범위",
+    "범인",
+    "범죄",
+    "법률",
+    "법원",
+    "법적",
+    "법칙",
+    "베이징",
+    "벨트",
+    "변경",
+    "변동",
+    "변명",
+    "변신",
+    "변호사",
+    "변화",
+    "별도",
+    "별명",
+    "별일",
+    "병실",
+    "병아리",
+    "병원",
+    "보관",
+    "보너스",
+    "보라색",
+    "보람",
+    "보름",
+    "보상",
+    "보안",
+    "보자기",
+    "보장",
+    "보전",
+    "보존",
+    "보통",
+    "보편적",
+    "보험",
+    "복도",
+    "복사",
+    "복숭아",
+    "복습",
+    "볶음",
+    "본격적",
+    "본래",
+    "본부",
+    "본사",
+    "본성",
+    "본인",
+    "본질",
+    "볼펜",
+    "봉사",
+    "봉지",
+    "봉투",
+    "부근",
+    "부끄러움",
+    "부담",
+    "부동산",
+    "부문",
+    "부분",
+    "부산",
+    "부상",
+    "부엌",
+    "부인",
+    "부작용",
+    "부장",
+    "부정",
+    "부족",
+    "부지런히",
+    "부친",
+    "부탁",
+    "부품",
+    "부회장",
+    "북부",
+    "북한",
+    "분노",
+    "분량",
+    "분리",
+    "분명",
+    "분석",
+    "분야",
+    "분위기",
+    "분필",
+    "분홍색",
+    "불고기",
+    "불과",
+    "불교",
+    "불꽃",
+    "불만",
+    "불법",
+    "불빛",
+    "불안",
+    "불이익",
+    "불행",
+    "브랜드",
+    "비극",
+    "비난",
+    "비닐",
+    "비둘기",
+    "비디오",
+    "비로소",
+    "비만",
+    "비명",
+    "비밀",
+    "비바람",
+    "비빔밥",
+    "비상",
+    "비용",
+    "비율",
+    "비중",
+    "비타민",
+    "비판",
+    "빌딩",
+    "빗물",
+    "빗방울",
+    "빗줄기",
+    "빛깔",
+    "빨간색",
+    "빨래",
+    "빨리",
+    "사건",
+    "사계절",
+    "사나이",
+    "사냥",
+    "사람",
+    "사랑",
+    "사립",
+    "사모님",
+    "사물",
+    "사방",
+    "사상",
+    "사생활",
+    "사설",
+    "사슴",
+    "사실",
+    "사업",
+    "사용",
+    "사월",
+    "사장",
+    "사전",
+    "사진",
+    "사촌",
+    "사춘기",
+    "사탕",
+    "사투리",
+    "사흘",
+    "산길",
+    "산부인과",
+    "산업",
+    "산책",
+    "살림",
+    "살인",
+    "살짝",
+    "삼계탕",
+    "삼국",
+    "삼십",
+    "삼월",
+    "삼촌",
+    "상관",
+    "상금",
+    "상대",
+    "상류",
+    "상반기",
+    "상상",
+    "상식",
+    "상업",
+    "상인",
+    "상자",
+    "상점",
+    "상처",
+    "상추",
+    "상태",
+    "상표",
+    "상품",
+    "상황",
+    "새벽",
+    "색깔",
+    "색연필",
+    "생각",
+    "생명",
+    "생물",
+    "생방송",
+    "생산",
+    "생선",
+    "생신",
+    "생일",
+    "생활",
+    "서랍",
+    "서른",
+    "서명",
+    "서민",
+    "서비스",
+    "서양",
+    "서울",
+    "서적",
+    "서점",
+    "서쪽",
+    "서클",
+    "석사",
+    "석유",
+    "선거",
+    "선물",
+    "선배",
+    "선생",
+    "선수",
+    "선원",
+    "선장",
+    "선전",
+    "선택",
+    "선풍기",
+    "설거지",
+    "설날",
+    "설렁탕",
+    "설명",
+    "설문",
+    "설사",
+    "설악산",
+    "설치",
+    "설탕",
+    "섭씨",
+    "성공",
+    "성당",
+    "성명",
+    "성별",
+    "성인",
+    "성장",
+    "성적",
+    "성질",
+    "성함",
+    "세금",
+    "세미나",
+    "세상",
+    "세월",
+    "세종대왕",
+    "세탁",
+    "센터",
+    "센티미터",
+    "셋째",
+    "소규모",
+    "소극적",
+    "소금",
+    "소나기",
+    "소년",
+    "소득",
+    "소망",
+    "소문",
+    "소설",
+    "소속",
+    "소아과",
+    "소용",
+    "소원",
+    "소음",
+    "소중히",
+    "소지품",
+    "소질",
+    "소풍",
+    "소형",
+    "속담",
+    "속도",
+    "속옷",
+    "손가락",
+    "손길",
+    "손녀",
+    "손님",
+    "손등",
+    "손목",
+    "손뼉",
+    "손실",
+    "손질",
+    "손톱",
+    "손해",
+    "솔직히",
+    "솜씨",
+    "송아지",
+    "송이",
+    "송편",
+    "쇠고기",
+    "쇼핑",
+    "수건",
+    "수년",
+    "수단",
+    "수돗물",
+    "수동적",
+    "수면",
+    "수명",
+    "수박",
+    "수상",
+    "수석",
+    "수술",
+    "수시로",
+    "수업",
+    "수염",
+    "수영",
+    "수입",
+    "수준",
+    "수집",
+    "수출",
+    "수컷",
+    "수필",
+    "수학",
+    "수험생",
+    "수화기",
+    "숙녀",
+    "숙소",
+    "숙제",
+    "순간",
+    "순서",
+    "순수",
+    "순식간",
+    "순위",
+    "숟가락",
+    "술병",
+    "술집",
+    "숫자",
+    "스님",
+    "스물",
+    "스스로",
+    "스승",
+    "스웨터",
+    "스위치",
+    "스케이트",
+    "스튜디오",
+    "스트레스",
+    "스포츠",
+    "슬쩍",
+    "슬픔",
+    "습관",
+    "습기",
+    "승객",
+    "승리",
+    "승부",
+    "승용차",
+    "승진",
+    "시각",
+    "시간",
+    "시골",
+    "시금치",
+    "시나리오",
+    "시댁",
+    "시리즈",
+    "시멘트",
+    "시민",
+    "시부모",
+    "시선",
+    "시설",
+    "시스템",
+    "시아버지",
+    "시어머니",
+    "시월",
+    "시인",
+    "시일",
+    "시작",
+    "시장",
+    "시절",
+    "시점",
+    "시중",
+    "시즌",
+    "시집",
+    "시청",
+    "시합",
+    "시험",
+    "식구",
+    "식기",
+    "식당",
+    "식량",
+    "식료품",
+    "식물",
+    "식빵",
+    "식사",
+    "식생활",
+    "식초",
+    "식탁",
+    "식품",
+    "신고",
+    "신규",
+    "신념",
+    "신문",
+    "신발",
+    "신비",
+    "신사",
+    "신세",
+    "신용",
+    "신제품",
+    "신청",
+    "신체",
+    "신화",
+    "실감",
+    "실내",
+    "실력",
+    "실례",
+    "실망",
+    "실수",
+    "실습",
+    "실시",
+    "실장",
+    "실정",
+    "실질적",
+    "실천",
+    "실체",
+    "실컷",
+    "실태",
+    "실패",
+    "실험",
+    "실현",
+    "심리",
+    "심부름",
+    "심사",
+    "심장",
+    "심정",
+    "심판",
+    "쌍둥이",
+    "씨름",
+    "씨앗",
+    "아가씨",
+    "아나운서",
+    "아드님",
+    "아들",
+    "아쉬움",
+    "아스팔트",
+    "아시아",
+    "아울러",
+    "아저씨",
+    "아줌마",
+    "아직",
+    "아침",
+    "아파트",
+    "아프리카",
+    "아픔",
+    "아홉",
+    "아흔",
+    "악기",
+    "악몽",
+    "악수",
+    "안개",
+    "안경",
+    "안과",
+    "안내",
+    "안녕",
+    "안동",
+    "안방",
+    "안부",
+    "안주",
+    "알루미늄",
+    "알코올",
+    "암시",
+    "암컷",
+    "압력",
+    "앞날",
+    "앞문",
+    "애인",
+    "애정",
+    "액수",
+    "앨범",
+    "야간",
+    "야단",
+    "야옹",
+    "약간",
+    "약국",
+    "약속",
+    "약수",
+    "약점",
+    "약품",
+    "약혼녀",
+    "양념",
+    "양력",
+    "양말",
+    "양배추",
+    "양주",
+    "양파",
+    "어둠",
+    "어려움",
+    "어른",
+    "어젯밤",
+    "어쨌든",
+    "어쩌다가",
+    "어쩐지",
+    "언니",
+    "언덕",
+    "언론",
+    "언어",
+    "얼굴",
+    "얼른",
+    "얼음",
+    "얼핏",
+    "엄마",
+    "업무",
+    "업종",
+    "업체",
+    "엉덩이",
+    "엉망",
+    "엉터리",
+    "엊그제",
+    "에너지",
+    "에어컨",
+    "엔진",
+    "여건",
+    "여고생",
+    "여관",
+    "여군",
+    "여권",
+    "여대생",
+    "여덟",
+    "여동생",
+    "여든",
+    "여론",
+    "여름",
+    "여섯",
+    "여성",
+    "여왕",
+    "여인",
+    "여전히",
+    "여직원",
+    "여학생",
+    "여행",
+    "역사",
+    "역시",
+    "역할",
+    "연결",
+    "연구",
+    "연극",
+    "연기",
+    "연락",
+    "연설",
+    "연세",
+    "연속",
+    "연습",
+    "연애",
+    "연예인",
+    "연인",
+    "연장",
+    "연주",
+    "연출",
+    "연필",
+    "연합",
+    "연휴",
+    "열기",
+    "열매",
+    "열쇠",
+    "열심히",
+    "열정",
+    "열차",
+    "열흘",
+    "염려",
+    "엽서",
+    "영국",
+    "영남",
+    "영상",
+    "영양",
+    "영역",
+    "영웅",
+    "영원히",
+    "영하",
+    "영향",
+    "영혼",
+    "영화",
+    "옆구리",
+    "옆방",
+    "옆집",
+    "예감",
+    "예금",
+    "예방",
+    "예산",
+    "예상",
+    "예선",
+    "예술",
+    "예습",
+    "예식장",
+    "예약",
+    "예전",
+    "예절",
+    "예정",
+    "예컨대",
+    "옛날",
+    "오늘",
+    "오락",
+    "오랫동안",
+    "오렌지",
+    "오로지",
+    "오른발",
+    "오븐",
+    "오십",
+    "오염",
+    "오월",
+    "오전",
+    "오직",
+    "오징어",
+    "오페라",
+    "오피스텔",
+    "오히려",
+    "옥상",
+    "옥수수",
+    "온갖",
+    "온라인",
+    "온몸",
+    "온종일",
+    "온통",
+    "올가을",
+    "올림픽",
+    "올해",
+    "옷차림",
+    "와이셔츠",
+    "와인",
+    "완성",
+    "완전",
+    "왕비",
+    "왕자",
+    "왜냐하면",
+    "왠지",
+    "외갓집",
+    "외국",
+    "외로움",
+    "외삼촌",
+    "외출",
+    "외침",
+    "외할머니",
+    "왼발",
+    "왼손",
+    "왼쪽",
+    "요금",
+    "요일",
+    "요즘",
+    "요청",
+    "용기",
+    "용서",
+    "용어",
+    "우산",
+    "우선",
+    "우승",
+    "우연히",
+    "우정",
+    "우체국",
+    "우편",
+    "운동",
+    "운명",
+    "운반",
+    "운전",
+    "운행",
+    "울산",
+    "울음",
+    "움직임",
+    "웃어른",
+    "웃음",
+    "워낙",
+    "원고",
+    "원래",
+    "원서",
+    "원숭이",
+    "원인",
+    "원장",
+    "원피스",
+    "월급",
+    "월드컵",
+    "월세",
+    "월요일",
+    "웨이터",
+    "위반",
+    "위법",
+    "위성",
+    "위원",
+    "위험",
+    "위협",
+    "윗사람",
+    "유난히",
+    "유럽",
+    "유명",
+    "유물",
+    "유산",
+    "유적",
+    "유치원",
+    "유학",
+    "유행",
+    "유형",
+    "육군",
+    "육상",
+    "육십",
+    "육체",
+    "은행",
+    "음력",
+    "음료",
+    "음반",
+    "음성",
+    "음식",
+    "음악",
+    "음주",
+    "의견",
+    "의논",
+    "의문",
+    "의복",
+    "의식",
+    "의심",
+    "의외로",
+    "의욕",
+    "의원",
+    "의학",
+    "이것",
+    "이곳",
+    "이념",
+    "이놈",
+    "이달",
+    "이대로",
+    "이동",
+    "이렇게",
+    "이력서",
+    "이론적",
+    "이름",
+    "이민",
+    "이발소",
+    "이별",
+    "이불",
+    "이빨",
+    "이상",
+    "이성",
+    "이슬",
+    "이야기",
+    "이용",
+    "이웃",
+    "이월",
+    "이윽고",
+    "이익",
+    "이전",
+    "이중",
+    "이튿날",
+    "이틀",
+    "이혼",
+    "인간",
+    "인격",
+    "인공",
+    "인구",
+    "인근",
+    "인기",
+    "인도",
+    "인류",
+    "인물",
+    "인생",
+    "인쇄",
+    "인연",
+    "인원",
+    "인재",
+    "인종",
+    "인천",
+    "인체",
+    "인터넷",
+    "인하",
+    "인형",
+    "일곱",
+    "일기",
+    "일단",
+    "일대",
+    "일등",
+    "일반",
+    "일본",
+    "일부",
+    "일상",
+    "일생",
+    "일손",
+    "일요일",
+    "일월",
+    "일정",
+    "일종",
+    "일주일",
+    "일찍",
+    "일체",
+    "일치",
+    "일행",
+    "일회용",
+    "임금",
+    "임무",
+    "입대",
+    "입력",
+    "입맛",
+    "입사",
+    "입술",
+    "입시",
+    "입원",
+    "입장",
+    "입학",
+    "자가용",
+    "자격",
+    "자극",
+    "자동",
+    "자랑",
+    "자부심",
+    "자식",
+    "자신",
+    "자연",
+    "자원",
+    "자율",
+    "자전거",
+    "자정",
+    "자존심",
+    "자판",
+    "작가",
+    "작년",
+    "작성",
+    "작업",
+    "작용",
+    "작은딸",
+    "작품",
+    "잔디",
+    "잔뜩",
+    "잔치",
+    "잘못",
+    "잠깐",
+    "잠수함",
+    "잠시",
+    "잠옷",
+    "잠자리",
+    "잡지",
+    "장관",
+    "장군",
+    "장기간",
+    "장래",
+    "장례",
+    "장르",
+    "장마",
+    "장면",
+    "장모",
+    "장미",
+    "장비",
+    "장사",
+    "장소",
+    "장식",
+    "장애인",
+    "장인",
+    "장점",
+    "장차",
+    "장학금",
+    "재능",
+    "재빨리",
+    "재산",
+    "재생",
+    "재작년",
+    "재정",
+    "재채기",
+    "재판",
+    "재학",
+    "재활용",
+    "저것",
+    "저고리",
+    "저곳",
+    "저녁",
+    "저런",
+    "저렇게",
+    "저번",
+    "저울",
+    "저절로",
+    "저축",
+    "적극",
+    "적당히",
+    "적성",
+    "적용",
+    "적응",
+    "전개",
+    "전공",
+    "전기",
+    "전달",
+    "전라도",
+    "전망",
+    "전문",
+    "전반",
+    "전부",
+    "전세",
+    "전시",
+    "전용",
+    "전자",
+    "전쟁",
+    "전주",
+    "전철",
+    "전체",
+    "전통",
+    "전혀",
+    "전후",
+    "절대",
+    "절망",
+    "절반",
+    "절약",
+    "절차",
+    "점검",
+    "점수",
+    "점심",
+    "점원",
+    "점점",
+    "점차",
+    "접근",
+    "접시",
+    "접촉",
+    "젓가락",
+    "정거장",
+    "정도",
+    "정류장",
+    "정리",
+    "정말",
+    "정면",
+    "정문",
+    "정반대",
+    "정보",
+    "정부",
+    "정비",
+    "정상",
+    "정성",
+    "정오",
+    "정원",
+    "정장",
+    "정지",
+    "정치",
+    "정확히",
+    "제공",
+    "제과점",
+    "제대로",
+    "제목",
+    "제발",
+    "제법",
+    "제삿날",
+    "제안",
+    "제일",
+    "제작",
+    "제주도",
+    "제출",
+    "제품",
+    "제한",
+    "조각",
+    "조건",
+    "조금",
+    "조깅",
+    "조명",
+    "조미료",
+    "조상",
+    "조선",
+    "조용히",
+    "조절",
+    "조정",
+    "조직",
+    "존댓말",
+    "존재",
+    "졸업",
+    "졸음",
+    "종교",
+    "종로",
+    "종류",
+    "종소리",
+    "종업원",
+    "종종",
+    "종합",
+    "좌석",
+    "죄인",
+    "주관적",
+    "주름",
+    "주말",
+    "주머니",
+    "주먹",
+    "주문",
+    "주민",
+    "주방",
+    "주변",
+    "주식",
+    "주인",
+    "주일",
+    "주장",
+    "주전자",
+    "주택",
+    "준비",
+    "줄거리",
+    "줄기",
+    "줄무늬",
+    "중간",
+    "중계방송",
+    "중국",
+    "중년",
+    "중단",
+    "중독",
+    "중반",
+    "중부",
+    "중세",
+    "중소기업",
+    "중순",
+    "중앙",
+    "중요",
+    "중학교",
+    "즉석",
+    "즉시",
+    "즐거움",
+    "증가",
+    "증거",
+    "증권",
+    "증상",
+    "증세",
+    "지각",
+    "지갑",
+    "지경",
+    "지극히",
+    "지금",
+    "지급",
+    "지능",
+    "지름길",
+    "지리산",
+    "지방",
+    "지붕",
+    "지식",
+    "지역",
+    "지우개",
+    "지원",
+    "지적",
+    "지점",
+    "지진",
+    "지출",
+    "직선",
+    "직업",
+    "직원",
+    "직장",
+    "진급",
+    "진동",
+    "진로",
+    "진료",
+    "진리",
+    "진짜",
+    "진찰",
+    "진출",
+    "진통",
+    "진행",
+    "질문",
+    "질병",
+    "질서",
+    "짐작",
+    "집단",
+    "집안",
+    "집중",
+    "짜증",
+    "찌꺼기",
+    "차남",
+    "차라리",
+    "차량",
+    "차림",
+    "차별",
+    "차선",
+    "차츰",
+    "착각",
+    "찬물",
+    "찬성",
+    "참가",
+    "참기름",
+    "참새",
+    "참석",
+    "참여",
+    "참외",
+    "참조",
+    "찻잔",
+    "창가",
+    "창고",
+    "창구",
+    "창문",
+    "창밖",
+    "창작",
+    "창조",
+    "채널",
+    "채점",
+    "책가방",
+    "책방",
+    "책상",
+    "책임",
+    "챔피언",
+    "처벌",
+    "처음",
+    "천국",
+    "천둥",
+    "천장",
+    "천재",
+    "천천히",
+    "철도",
+    "철저히",
+    "철학",
+    "첫날",
+    "첫째",
+    "청년",
+    "청바지",
+    "청소",
+    "청춘",
+    "체계",
+    "체력",
+    "체온",
+    "체육",
+    "체중",
+    "체험",
+    "초등학생",
+    "초반",
+    "초밥",
+    "초상화",
+    "초순",
+    "초여름",
+    "초원",
+    "초저녁",
+    "초점",
+    "초청",
+    "초콜릿",
+    "촛불",
+    "총각",
+    "총리",
+    "총장",
+    "촬영",
+    "최근",
+    "최상",
+    "최선",
+    "최신",
+    "최악",
+    "최종",
+    "추석",
+    "추억",
+    "추진",
+    "추천",
+    "추측",
+    "축구",
+    "축소",
+    "축제",
+    "축하",
+    "출근",
+    "출발",
+    "출산",
+    "출신",
+    "출연",
+    "출입",
+    "출장",
+    "출판",
+    "충격",
+    "충고",
+    "충돌",
+    "충분히",
+    "충청도",
+    "취업",
+    "취직",
+    "취향",
+    "치약",
+    "친구",
+    "친척",
+    "칠십",
+    "칠월",
+    "칠판",
+    "침대",
+    "침묵",
+    "침실",
+    "칫솔",
+    "칭찬",
+    "카메라",
+    "카운터",
+    "칼국수",
+    "캐릭터",
+    "캠퍼스",
+    "캠페인",
+    "커튼",
+    "컨디션",
+    "컬러",
+    "컴퓨터",
+    "코끼리",
+    "코미디",
+    "콘서트",
+    "콜라",
+    "콤플렉스",
+    "콩나물",
+    "쾌감",
+    "쿠데타",
+    "크림",
+    "큰길",
+    "큰딸",
+    "큰소리",
+    "큰아들",
+    "큰어머니",
+    "큰일",
+    "큰절",
+    "클래식",
+    "클럽",
+    "킬로",
+    "타입",
+    "타자기",
+    "탁구",
+    "탁자",
+    "탄생",
+    "태권도",
+    "태양",
+    "태풍",
+    "택시",
+    "탤런트",
+    "터널",
+    "터미널",
+    "테니스",
+    "테스트",
+    "테이블",
+    "텔레비전",
+    "토론",
+    "토마토",
+    "토요일",
+    "통계",
+    "통과",
+    "통로",
+    "통신",
+    "통역",
+    "통일",
+    "통장",
+    "통제",
+    "통증",
+    "통합",
+    "통화",
+    "퇴근",
+    "퇴원",
+    "퇴직금",
+    "튀김",
+    "트럭",
+    "특급",
+    "특별",
+    "특성",
+    "특수",
+    "특징",
+    "특히",
+    "튼튼히",
+    "티셔츠",
+    "파란색",
+    "파일",
+    "파출소",
+    "판결",
+    "판단",
+    "판매",
+    "판사",
+    "팔십",
+    "팔월",
+    "팝송",
+    "패션",
+    "팩스",
+    "팩시밀리",
+    "팬티",
+    "퍼센트",
+    "페인트",
+    "편견",
+    "편의",
+    "편지",
+    "편히",
+    "평가",
+    "평균",
+    "평생",
+    "평소",
+    "평양",
+    "평일",
+    "평화",
+    "포스터",
+    "포인트",
+    "포장",
+    "포함",
+    "표면",
+    "표정",
+    "표준",
+    "표현",
+    "품목",
+    "품질",
+    "풍경",
+    "풍속",
+    "풍습",
+    "프랑스",
+    "프린터",
+    "플라스틱",
+    "피곤",
+    "피망",
+    "피아노",
+    "필름",
+    "필수",
+    "필요",
+    "필자",
+    "필통",
+    "핑계",
+    "하느님",
+    "하늘",
+    "하드웨어",
+    "하룻밤",
+    "하반기",
+    "하숙집",
+    "하순",
+    "하여튼",
+    "하지만",
+    "하천",
+    "하품",
+    "하필",
+    "학과",
+    "학교",
+    "학급",
+    "학기",
+    "학년",
+    "학력",
+    "학번",
+    "학부모",
+    "학비",
+    "학생",
+    "학술",
+    "학습",
+    "학용품",
+    "학원",
+    "학위",
+    "학자",
+    "학점",
+    "한계",
+    "한글",
+    "한꺼번에",
+    "한낮",
+    "한눈",
+    "한동안",
+    "한때",
+    "한라산",
+    "한마디",
+    "한문",
+    "한번",
+    "한복",
+    "한식",
+    "한여름",
+    "한쪽",
+    "할머니",
+    "할아버지",
+    "할인",
+    "함께",
+    "함부로",
+    "합격",
+    "합리적",
+    "항공",
+    "항구",
+    "항상",
+    "항의",
+    "해결",
+    "해군",
+    "해답",
+    "해당",
+    "해물",
+    "해석",
+    "해설",
+    "해수욕장",
+    "해안",
+    "핵심",
+    "핸드백",
+    "햄버거",
+    "햇볕",
+    "햇살",
+    "행동",
+    "행복",
+    "행사",
+    "행운",
+    "행위",
+    "향기",
+    "향상",
+    "향수",
+    "허락",
+    "허용",
+    "헬기",
+    "현관",
+    "현금",
+    "현대",
+    "현상",
+    "현실",
+    "현장",
+    "현재",
+    "현지",
+    "혈액",
+    "협력",
+    "형부",
+    "형사",
+    "형수",
+    "형식",
+    "형제",
+    "형태",
+    "형편",
+    "혜택",
+    "호기심",
+    "호남",
+    "호랑이",
+    "호박",
+    "호텔",
+    "호흡",
+    "혹시",
+    "홀로",
+    "홈페이지",
+    "홍보",
+    "홍수",
+    "홍차",
+    "화면",
+    "화분",
+    "화살",
+    "화요일",
+    "화장",
+    "화학",
+    "확보",
+    "확인",
+    "확장",
+    "확정",
+    "환갑",
+    "환경",
+    "환영",
+    "환율",
+    "환자",
+    "활기",
+    "활동",
+    "활발히",
+    "활용",
+    "활짝",
+    "회견",
+    "회관",
+    "회복",
+    "회색",
+    "회원",
+    "회장",
+    "회전",
+    "횟수",
+    "횡단보도",
+    "효율적",
+    "후반",
+    "후춧가루",
+    "훈련",
+    "훨씬",
+    "휴식",
+    "휴일",
+    "흉내",
+    "흐름",
+    "흑백",
+    "흑인",
+    "흔적",
+    "흔히",
+    "흥미",
+    "흥분",
+    "희곡",
+    "희망",
+    "희생",
+    "흰색",
+    "힘껏",
+}
+var rwords_korean = map[string]int16{
+    "가격": 0,
+    "가끔": 1,
+    "가난": 2,
+    "가능": 3,
+    "가득": 4,
+    "가르침": 5,
+    "가뭄": 6,
+    "가방": 7,
+    "가상": 8,
+    "가슴": 9,
+    "가운데": 10,
+    "가을": 11,
+    "가이드": 12,
+    "가입": 13,
+    "가장": 14,
+    "가정": 15,
+    "가족": 16,
+    "가죽": 17,
+    "각오": 18,
+    "각자": 19,
+    "간격": 20,
+    "간부": 21,
+    "간섭": 22,
+    "간장": 23,
+    "간접": 24,
+    "간판": 25,
+    "갈등": 26,
+    "갈비": 27,
+    "갈색": 28,
+    "갈증": 29,
+    "감각": 30,
+    "감기": 31,
+    "감소": 32,
+    "감수성": 33,
+    "감자": 34,
+    "감정": 35,
+    "갑자기": 36,
+    "강남": 37,
+    "강당": 38,
+    "강도": 39,
+    "강력히": 40,
+    "강변": 41,
+    "강북": 42,
+    "강사": 43,
+    "강수량": 44,
+    "강아지": 45,
+    "강원도": 46,
+    "강의": 47,
+    "강제": 48,
+    "강조": 49,
+    "같이": 50,
+    "개구리": 51,
+    "개나리": 52,
+    "개방": 53,
+    "개별": 54,
+    "개선": 55,
+    "개성": 56,
+    "개인": 57,
+    "객관적": 58,
+    "거실": 59,
+    "거액": 60,
+    "거울": 61,
+    "거짓": 62,
+    "거품": 63,
+    "걱정": 64,
+    "건강": 65,
+    "건물": 66,
+    "건설": 67,
+    "건조": 68,
+    "건축": 69,
+    "걸음": 70,
+    "검사": 71,
+    "검토": 72,
+    "게시판": 73,
+    "게임": 74,
+    "겨울": 75,
+    "견해": 76,
+    "결과": 77,
+    "결국": 78,
+    "결론": 79,
+    "결석": 80,
+    "결승": 81,
+    "결심": 82,
+    "결정": 83,
+    "결혼": 84,
+    "경계": 85,
+    "경고": 86,
+    "경기": 87,
+    "경력": 88,
+    "경복궁": 89,
+    "경비": 90,
+    "경상도": 91,
+    "경영": 92,
+    "경우": 93,
+    "경쟁": 94,
+    "경제": 95,
+    "경주": 96,
+    "경찰": 97,
+    "경치": 98,
+    "경향": 99,
+    "경험": 100,
+    "계곡": 101,
+    "계단": 102,
+    "계란": 103,
+    "계산": 104,
+    "계속": 105,
+    "계약": 106,
+    "계절": 107,
+    "계층": 108,
+    "계획": 109,
+    "고객": 110,
+    "고구려": 111,
+    "고궁": 112,
+    "고급": 113,
+    "고등학생": 114,
+    "고무신": 115,
+    "고민": 116,
+    "고양이": 117,
+    "고장": 118,
+    "고전": 119,
+    "고집": 120,
+    "고춧가루": 121,
+    "고통": 122,
+    "고향": 123,
+    "곡식": 124,
+    "골목": 125,
+    "골짜기": 126,
+    "골프": 127,
+    "공간": 128,
+    "공개": 129,
+    "공격": 130,
+    "공군": 131,
+    "공급": 132,
+    "공기": 133,
+    "공동": 134,
+    "공무원": 135,
+    "공부": 136,
+    "공사": 137,
+    "공식": 138,
+    "공업": 139,
+    "공연": 140,
+    "공원": 141,
+    "공장": 142,
+    "공짜": 143,
+    "공책": 144,
+    "공통": 145,
+    "공포": 146,
+    "공항": 147,
+    "공휴일": 148,
+    "과목": 149,
+    "과일": 150,
+    "과장": 151,
+    "과정": 152,
+    "과학": 153,
+    "관객": 154,
+    "관계": 155,
+    "관광": 156,
+    "관념": 157,
+    "관람": 158,
+    "관련": 159,
+    "관리": 160,
+    "관습": 161,
+    "관심": 162,
+    "관점": 163,
+    "관찰": 164,
+    "광경": 165,
+    "광고": 166,
+    "광장": 167,
+    "광주": 168,
+    "괴로움": 169,
+    "굉장히": 170,
+    "교과서": 171,
+    "교문": 172,
+    "교복": 173,
+    "교실": 174,
+    "교양": 175,
+    "교육": 176,
+    "교장": 177,
+    "교직": 178,
+    "교통": 179,
+    "교환": 180,
+    "교훈": 181,
+    "구경": 182,
+    "구름": 183,
+    "구멍": 184,
+    "구별": 185,
+    "구분": 186,
+    "구석": 187,
+    "구성": 188,
+    "구속": 189,
+    "구역": 190,
+    "구입": 191,
+    "구청": 192,
+    "구체적": 193,
+    "국가": 194,
+    "국기": 195,
+    "국내": 196,
+    "국립": 197,
+    "국물": 198,
+    "국민": 199,
+    "국수": 200,
+    "국어": 201,
+    "국왕": 202,
+    "국적": 203,
+    "국제": 204,
+    "국회": 205,
+    "군대": 206,
+    "군사": 207,
+    "군인": 208,
+    "궁극적": 209,
+    "권리": 210,
+    "권위": 211,
+    "권투": 212,
+    "귀국": 213,
+    "귀신": 214,
+    "규정": 215,
+    "규칙": 216,
+    "균형": 217,
+    "그날": 218,
+    "그냥": 219,
+    "그늘": 220,
+    "그러나": 221,
+    "그룹": 222,
+    "그릇": 223,
+    "그림": 224,
+    "그제서야": 225,
+    "그토록": 226,
+    "극복": 227,
+    "극히": 228,
+    "근거": 229,
+    "근교": 230,
+    "근래": 231,
+    "근로": 232,
+    "근무": 233,
+    "근본": 234,
+    "근원": 235,
+    "근육": 236,
+    "근처": 237,
+    "글씨": 238,
+    "글자": 239,
+    "금강산": 240,
+    "금고": 241,
+    "금년": 242,
+    "금메달": 243,
+    "금액": 244,
+    "금연": 245,
+    "금요일": 246,
+    "금지": 247,
+    "긍정적": 248,
+    "기간": 249,
+    "기관": 250,
+    "기념": 251,
+    "기능": 252,
+    "기독교": 253,
+    "기둥": 254,
+    "기록": 255,
+    "기름": 256,
+    "기법": 257,
+    "기본": 258,
+    "기분": 259,
+    "기쁨": 260,
+    "기숙사": 261,
+    "기술": 262,
+    "기억": 263,
+    "기업": 264,
+    "기온": 265,
+    "기운": 266,
+    "기원": 267,
+    "기적": 268,
+    "기준": 269,
+    "기침": 270,
+    "기혼": 271,
+    "기획": 272,
+    "긴급": 273,
+    "긴장": 274,
+    "길이": 275,
+    "김밥": 276,
+    "김치": 277,
+    "김포공항": 278,
+    "깍두기": 279,
+    "깜빡": 280,
+    "깨달음": 281,
+    "깨소금": 282,
+    "껍질": 283,
+    "꼭대기": 284,
+    "꽃잎": 285,
+    "나들이": 286,
+    "나란히": 287,
+    "나머지": 288,
+    "나물": 289,
+    "나침반": 290,
+    "나흘": 291,
+    "낙엽": 292,
+    "난방": 293,
+    "날개": 294,
+    "날씨": 295,
+    "날짜": 296,
+    "남녀": 297,
+    "남대문": 298,
+    "남매": 299,
+    "남산": 300,
+    "남자": 301,
+    "남편": 302,
+    "남학생": 303,
+    "낭비": 304,
+    "낱말": 305,
+    "내년": 306,
+    "내용": 307,
+    "내일": 308,
+    "냄비": 309,
+    "냄새": 310,
+    "냇물": 311,
+    "냉동": 312,
+    "냉면": 313,
+    "냉방": 314,
+    "냉장고": 315,
+    "넥타이": 316,
+    "넷째": 317,
+    "노동": 318,
+    "노란색": 319,
+    "노력": 320,
+    "노인": 321,
+    "녹음": 322,
+    "녹차": 323,
+    "녹화": 324,
+    "논리": 325,
+    "논문": 326,
+    "논쟁": 327,
+    "놀이": 328,
+    "농구": 329,
+    "농담": 330,
+    "농민": 331,
+    "농부": 332,
+    "농업": 333,
+    "농장": 334,
+    "농촌": 335,
+    "높이": 336,
+    "눈동자": 337,
+    "눈물": 338,
+    "눈썹": 339,
+    "뉴욕": 340,
+    "느낌": 341,
+    "늑대": 342,
+    "능동적": 343,
+    "능력": 344,
+    "다방": 345,
+    "다양성": 346,
+    "다음": 347,
+    "다이어트": 348,
+    "다행": 349,
+    "단계": 350,
+    "단골": 351,
+    "단독": 352,
+    "단맛": 353,
+    "단순": 354,
+    "단어": 355,
+    "단위": 356,
+    "단점": 357,
+    "단체": 358,
+    "단추": 359,
+    "단편": 360,
+    "단풍": 361,
+    "달걀": 362,
+    "달러": 363,
+    "달력": 364,
+    "달리": 365,
+    "닭고기": 366,
+    "담당": 367,
+    "담배": 368,
+    "담요": 369,
+    "담임": 370,
+    "답변": 371,
+    "답장": 372,
+    "당근": 373,
+    "당분간": 374,
+    "당연히": 375,
+    "당장": 376,
+    "대규모": 377,
+    "대낮": 378,
+    "대단히": 379,
+    "대답": 380,
+    "대도시": 381,
+    "대략": 382,
+    "대량": 383,
+    "대륙": 384,
+    "대문": 385,
+    "대부분": 386,
+    "대신": 387,
+    "대응": 388,
+    "대장": 389,
+    "대전": 390,
+    "대접": 391,
+    "대중": 392,
+    "대책": 393,
+    "대출": 394,
+    "대충": 395,
+    "대통령": 396,
+    "대학": 397,
+    "대한민국": 398,
+    "대합실": 399,
+    "대형": 400,
+    "덩어리": 401,
+    "데이트": 402,
+    "도대체": 403,
+    "도덕": 404,
+    "도둑": 405,
+    "도망": 406,
+    "도서관": 407,
+    "도심": 408,
+    "도움": 409,
+    "도입": 410,
+    "도자기": 411,
+    "도저히": 412,
+    "도전": 413,
+    "도중": 414,
+    "도착": 415,
+    "독감": 416,
+    "독립": 417,
+    "독서": 418,
+    "독일": 419,
+    "독창적": 420,
+    "동화책": 421,
+    "뒷모습": 422,
+    "뒷산": 423,
+    "딸아이": 424,
+    "마누라": 425,
+    "마늘": 426,
+    "마당": 427,
+    "마라톤": 428,
+    "마련": 429,
+    "마무리": 430,
+    "마사지": 431,
+    "마약": 432,
+    "마요네즈": 433,
+    "마을": 434,
+    "마음": 435,
+    "마이크": 436,
+    "마중": 437,
+    "마지막": 438,
+    "마찬가지": 439,
+    "마찰": 440,
+    "마흔": 441,
+    "막걸리": 442,
+    "막내": 443,
+    "막상": 444,
+    "만남": 445,
+    "만두": 446,
+    "만세": 447,
+    "만약": 448,
+    "만일": 449,
+    "만점": 450,
+    "만족": 451,
+    "만화": 452,
+    "많이": 453,
+    "말기": 454,
+    "말씀": 455,
+    "말투": 456,
+    "맘대로": 457,
+    "망원경": 458,
+    "매년": 459,
+    "매달": 460,
+    "매력": 461,
+    "매번": 462,
+    "매스컴": 463,
+    "매일": 464,
+    "매장": 465,
+    "맥주": 466,
+    "먹이": 467,
+    "먼저": 468,
+    "먼지": 469,
+    "멀리": 470,
+    "메일": 471,
+    "며느리": 472,
+    "며칠": 473,
+    "면담": 474,
+    "멸치": 475,
+    "명단": 476,
+    "명령": 477,
+    "명예": 478,
+    "명의": 479,
+    "명절": 480,
+    "명칭": 481,
+    "명함": 482,
+    "모금": 483,
+    "모니터": 484,
+    "모델": 485,
+    "모든": 486,
+    "모범": 487,
+    "모습": 488,
+    "모양": 489,
+    "모임": 490,
+    "모조리": 491,
+    "모집": 492,
+    "모퉁이": 493,
+    "목걸이": 494,
+    "목록": 495,
+    "목사": 496,
+    "목소리": 497,
+    "목숨": 498,
+    "목적": 499,
+    "목표": 500,
+    "몰래": 501,
+    "몸매": 502,
+    "몸무게": 503,
+    "몸살": 504,
+    "몸속": 505,
+    "몸짓": 506,
+    "몸통": 507,
+    "몹시": 508,
+    "무관심": 509,
+    "무궁화": 510,
+    "무더위": 511,
+    "무덤": 512,
+    "무릎": 513,
+    "무슨": 514,
+    "무엇": 515,
+    "무역": 516,
+    "무용": 517,
+    "무조건": 518,
+    "무지개": 519,
+    "무척": 520,
+    "문구": 521,
+    "문득": 522,
+    "문법": 523,
+    "문서": 524,
+    "문제": 525,
+    "문학": 526,
+    "문화": 527,
+    "물가": 528,
+    "물건": 529,
+    "물결": 530,
+    "물고기": 531,
+    "물론": 532,
+    "물리학": 533,
+    "물음": 534,
+    "물질": 535,
+    "물체": 536,
+    "미국": 537,
+    "미디어": 538,
+    "미사일": 539,
+    "미술": 540,
+    "미역": 541,
+    "미용실": 542,
+    "미움": 543,
+    "미인": 544,
+    "미팅": 545,
+    "미혼": 546,
+    "민간": 547,
+    "민족": 548,
+    "민주": 549,
+    "믿음": 550,
+    "밀가루": 551,
+    "밀리미터": 552,
+    "밑바닥": 553,
+    "바가지": 554,
+    "바구니": 555,
+    "바나나": 556,
+    "바늘": 557,
+    "바닥": 558,
+    "바닷가": 559,
+    "바람": 560,
+    "바이러스": 561,
+    "바탕": 562,
+    "박물관": 563,
+    "박사": 564,
+    "박수": 565,
+    "반대": 566,
+    "반드시": 567,
+    "반말": 568,
+    "반발": 569,
+    "반성": 570,
+    "반응": 571,
+    "반장": 572,
+    "반죽": 573,
+    "반지": 574,
+    "반찬": 575,
+    "받침": 576,
+    "발가락": 577,
+    "발걸음": 578,
+    "발견": 579,
+    "발달": 580,
+    "발레": 581,
+    "발목": 582,
+    "발바닥": 583,
+    "발생": 584,
+    "발음": 585,
+    "발자국": 586,
+    "발전": 587,
+    "발톱": 588,
+    "발표": 589,
+    "밤하늘": 590,
+    "밥그릇": 591,
+    "밥맛": 592,
+    "밥상": 593,
+    "밥솥": 594,
+    "방금": 595,
+    "방면": 596,
+    "방문": 597,
+    "방바닥": 598,
+    "방법": 599,
+    "방송": 600,
+    "방식": 601,
+    "방안": 602,
+    "방울": 603,
+    "방지": 604,
+    "방학": 605,
+    "방해": 606,
+    "방향": 607,
+    "배경": 608,
+    "배꼽": 609,
+    "배달": 610,
+    "배드민턴": 611,
+    "백두산": 612,
+    "백색": 613,
+    "백성": 614,
+    "백인": 615,
+    "백제": 616,
+    "백화점": 617,
+    "버릇": 618,
+    "버섯": 619,
+    "버튼": 620,
+    "번개": 621,
+    "번역": 622,
+    "번지": 623,
+    "번호": 624,
+    "벌금": 625,
+    "벌레": 626,
+    "벌써": 627,
+    "범위": 628,
+    "범인": 629,
+    "범죄": 630,
+    "법률": 631,
+    "법원": 632,
+    "법적": 633,
+    "법칙": 634,
+    "베이징": 635,
+    "벨트": 636,
+    "변경": 637,
+    "변동": 638,
+    "변명": 639,
+    "변신": 640,
+    "변호사": 641,
+    "변화": 642,
+    "별도": 643,
+    "별명": 644,
+    "별일": 645,
+    "병실": 646,
+    "병아리": 647,
+    "병원": 648,
+    "보관": 649,
+    "보너스": 650,
+    "보라색": 651,
+    "보람": 652,
+    "보름": 653,
+    "보상": 654,
+    "보안": 655,
+    "보자기": 656,
+    "보장": 657,
+    "보전": 658,
+    "보존": 659,
+    "보통": 660,
+    "보편적": 661,
+    "보험": 662,
+    "복도": 663,
+    "복사": 664,
+    "복숭아": 665,
+    "복습": 666,
+    "볶음": 667,
+    "본격적": 668,
+    "본래": 669,
+    "본부": 670,
+    "본사": 671,
+    "본성": 672,
+    "본인": 673,
+    "본질": 674,
+    "볼펜": 675,
+    "봉사": 676,
+    "봉지": 677,
+    "봉투": 678,
+    "부근": 679,
+    "부끄러움": 680,
+    "부담": 681,
+    "부동산": 682,
+    "부문": 683,
+    "부분": 684,
+    "부산": 685,
+    "부상": 686,
+    "부엌": 687,
+    "부인": 688,
+    "부작용": 689,
+    "부장": 690,
+    "부정": 691,
+    "부족": 692,
+    "부지런히": 693,
+    "부친": 694,
+    "부탁": 695,
+    "부품": 696,
+    "부회장": 697,
+    "북부": 698,
+    "북한": 699,
+    "분노": 700,
+    "분량": 701,
+    "분리": 702,
+    "분명": 703,
+    "분석": 704,
+    "분야": 705,
+    "분위기": 706,
+    "분필": 707,
+    "분홍색": 708,
+    "불고기": 709,
+    "불과": 710,
+    "불교": 711,
+    "불꽃": 712,
+    "불만": 713,
+    "불법": 714,
+    "불빛": 715,
+    "불안": 716,
+    "불이익": 717,
+    "불행": 718,
+    "브랜드": 719,
+    "비극": 720,
+    "비난": 721,
+    "비닐": 722,
+    "비둘기": 723,
+    "비디오": 724,
+    "비로소": 725,
+    "비만": 726,
+    "비명": 727,
+    "비밀": 728,
+    "비바람": 729,
+    "비빔밥": 730,
+    "비상": 731,
+    "비용": 732,
+    "비율": 733,
+    "비중": 734,
+    "비타민": 735,
+    "비판": 736,
+    "빌딩": 737,
+    "빗물": 738,
+    "빗방울": 739,
+    "빗줄기": 740,
+    "빛깔": 741,
+    "빨간색": 742,
+    "빨래": 743,
+    "빨리": 744,
+    "사건": 745,
+    "사계절": 746,
+    "사나이": 747,
+    "사냥": 748,
+    "사람": 749,
+    "사랑": 750,
+    "사립": 751,
+    "사모님": 752,
+    "사물": 753,
+    "사방": 754,
+    "사상": 755,
+    "사생활": 756,
+    "사설": 757,
+    "사슴": 758,
+    "사실": 759,
+    "사업": 760,
+    "사용": 761,
+    "사월": 762,
+    "사장": 763,
+    "사전": 764,
+    "사진": 765,
+    "사촌": 766,
+    "사춘기": 767,
+    "사탕": 768,
+    "사투리": 769,
+    "사흘": 770,
+    "산길": 771,
+    "산부인과": 772,
+    "산업": 773,
+    "산책": 774,
+    "살림": 775,
+    "살인": 776,
+    "살짝": 777,
+    "삼계탕": 778,
+    "삼국": 779,
+    "삼십": 780,
+    "삼월": 781,
+    "삼촌": 782,
+    "상관": 783,
+    "상금": 784,
+    "상대": 785,
+    "상류": 786,
+    "상반기": 787,
+    "상상": 788,
+    "상식": 789,
+    "상업": 790,
+    "상인": 791,
+    "상자": 792,
+    "상점": 793,
+    "상처": 794,
+    "상추": 795,
+    "상태": 796,
+    "상표": 797,
+    "상품": 798,
+    "상황": 799,
+    "새벽": 800,
+    "색깔": 801,
+    "색연필": 802,
+    "생각": 803,
+    "생명": 804,
+    "생물": 805,
+    "생방송": 806,
+    "생산": 807,
+    "생선": 808,
+    "생신": 809,
+    "생일": 810,
+    "생활": 811,
+    "서랍": 812,
+    "서른": 813,
+    "서명": 814,
+    "서민": 815,
+    "서비스": 816,
+    "서양": 817,
+    "서울": 818,
+    "서적": 819,
+    "서점": 820,
+    "서쪽": 821,
+    "서클": 822,
+    "석사": 823,
+    "석유": 824,
+    "선거": 825,
+    "선물": 826,
+    "선배": 827,
+    "선생": 828,
+    "선수": 829,
+    "선원": 830,
+    "선장": 831,
+    "선전": 832,
+    "선택": 833,
+    "선풍기": 834,
+    "설거지": 835,
+    "설날": 836,
+    "설렁탕": 837,
+    "설명": 838,
+    "설문": 839,
+    "설사": 840,
+    "설악산": 841,
+    "설치": 842,
+    "설탕": 843,
+    "섭씨": 844,
+    "성공": 845,
+    "성당": 846,
+    "성명": 847,
+    "성별": 848,
+    "성인": 849,
+    "성장": 850,
+    "성적": 851,
+    "성질": 852,
+    "성함": 853,
+    "세금": 854,
+    "세미나": 855,
+    "세상": 856,
+    "세월": 857,
+    "세종대왕": 858,
+    "세탁": 859,
+    "센터": 860,
+    "센티미터": 861,
+    "셋째": 862,
+    "소규모": 863,
+    "소극적": 864,
+    "소금": 865,
+    "소나기": 866,
+    "소년": 867,
+    "소득": 868,
+    "소망": 869,
+    "소문": 870,
+    "소설": 871,
+    "소속": 872,
+    "소아과": 873,
+    "소용": 874,
+    "소원": 875,
+    "소음": 876,
+    "소중히": 877,
+    "소지품": 878,
+    "소질": 879,
+    "소풍": 880,
+    "소형": 881,
+    "속담": 882,
+    "속도": 883,
+    "속옷": 884,
+    "손가락": 885,
+    "손길": 886,
+    "손녀": 887,
+    "손님": 888,
+    "손등": 889,
+    "손목": 890,
+    "손뼉": 891,
+    "손실": 892,
+    "손질": 893,
+    "손톱": 894,
+    "손해": 895,
+    "솔직히": 896,
+    "솜씨": 897,
+    "송아지": 898,
+    "송이": 899,
+    "송편": 900,
+    "쇠고기": 901,
+    "쇼핑": 902,
+    "수건": 903,
+    "수년": 904,
+    "수단": 905,
+    "수돗물": 906,
+    "수동적": 907,
+    "수면": 908,
+    "수명": 909,
+    "수박": 910,
+    "수상": 911,
+    "수석": 912,
+    "수술": 913,
+    "수시로": 914,
+    "수업": 915,
+    "수염": 916,
+    "수영": 917,
+    "수입": 918,
+    "수준": 919,
+    "수집": 920,
+    "수출": 921,
+    "수컷": 922,
+    "수필": 923,
+    "수학": 924,
+    "수험생": 925,
+    "수화기": 926,
+    "숙녀": 927,
+    "숙소": 928,
+    "숙제": 929,
+    "순간": 930,
+    "순서": 931,
+    "순수": 932,
+    "순식간": 933,
+    "순위": 934,
+    "숟가락": 935,
+    "술병": 936,
+    "술집": 937,
+    "숫자": 938,
+    "스님": 939,
+    "스물": 940,
+    "스스로": 941,
+    "스승": 942,
+    "스웨터": 943,
+    "스위치": 944,
+    "스케이트": 945,
+    "스튜디오": 946,
+    "스트레스": 947,
+    "스포츠": 948,
+    "슬쩍": 949,
+    "슬픔": 950,
+    "습관": 951,
+    "습기": 952,
+    "승객": 953,
+    "승리": 954,
+    "승부": 955,
+    "승용차": 956,
+    "승진": 957,
+    "시각": 958,
+    "시간": 959,
+    "시골": 960,
+    "시금치": 961,
+    "시나리오": 962,
+    "시댁": 963,
+    "시리즈": 964,
+    "시멘트": 965,
+    "시민": 966,
+    "시부모": 967,
+    "시선": 968,
+    "시설": 969,
+    "시스템": 970,
+    "시아버지": 971,
+    "시어머니": 972,
+    "시월": 973,
+    "시인": 974,
+    "시일": 975,
+    "시작": 976,
+    "시장": 977,
+    "시절": 978,
+    "시점": 979,
+    "시중": 980,
+    "시즌": 981,
+    "시집": 982,
+    "시청": 983,
+    "시합": 984,
+    "시험": 985,
+    "식구": 986,
+    "식기": 987,
+    "식당": 988,
+    "식량": 989,
+    "식료품": 990,
+    "식물": 991,
+    "식빵": 992,
+    "식사": 993,
+    "식생활": 994,
+    "식초": 995,
+    "식탁": 996,
+    "식품": 997,
+    "신고": 998,
+    "신규": 999,
+    "신념": 1000,
+    "신문": 1001,
+    "신발": 1002,
+    "신비": 1003,
+    "신사": 1004,
+    "신세": 1005,
+    "신용": 1006,
+    "신제품": 1007,
+    "신청": 1008,
+    "신체": 1009,
+    "신화": 1010,
+    "실감": 1011,
+    "실내": 1012,
+    "실력": 1013,
+    "실례": 1014,
+    "실망": 1015,
+    "실수": 1016,
+    "실습": 1017,
+    "실시": 1018,
+    "실장": 1019,
+    "실정": 1020,
+    "실질적": 1021,
+    "실천": 1022,
+    "실체": 1023,
+    "실컷": 1024,
+    "실태": 1025,
+    "실패": 1026,
+    "실험": 1027,
+    "실현": 1028,
+    "심리": 1029,
+    "심부름": 1030,
+    "심사": 1031,
+    "심장": 1032,
+    "심정": 1033,
+    "심판": 1034,
+    "쌍둥이": 1035,
+    "씨름": 1036,
+    "씨앗": 1037,
+    "아가씨": 1038,
+    "아나운서": 1039,
+    "아드님": 1040,
+    "아들": 1041,
+    "아쉬움": 1042,
+    "아스팔트": 1043,
+    "아시아": 1044,
+    "아울러": 1045,
+    "아저씨": 1046,
+    "아줌마": 1047,
+    "아직": 1048,
+    "아침": 1049,
+    "아파트": 1050,
+    "아프리카": 1051,
+    "아픔": 1052,
+    "아홉": 1053,
+    "아흔": 1054,
+    "악기": 1055,
+    "악몽": 1056,
+    "악수": 1057,
+    "안개": 1058,
+    "안경": 1059,
+    "안과": 1060,
+    "안내": 1061,
+    "안녕": 1062,
+    "안동": 1063,
+    "안방": 1064,
+    "안부": 1065,
+    "안주": 1066,
+    "알루미늄": 1067,
+    "알코올": 1068,
+    "암시": 1069,
+    "암컷": 1070,
+    "압력": 1071,
+    "앞날": 1072,
+    "앞문": 1073,
+    "애인": 1074,
+    "애정": 1075,
+    "액수": 1076,
+    "앨범": 1077,
+    "야간": 1078,
+    "야단": 1079,
+    "야옹": 1080,
+    "약간": 1081,
+    "약국": 1082,
+    "약속": 1083,
+    "약수": 1084,
+    "약점": 1085,
+    "약품": 1086,
+    "약혼녀": 1087,
+    "양념": 1088,
+    "양력": 1089,
+    "양말": 1090,
+    "양배추": 1091,
+    "양주": 1092,
+    "양파": 1093,
+    "어둠": 1094,
+    "어려움": 1095,
+    "어른": 1096,
+    "어젯밤": 1097,
+    "어쨌든": 1098,
+    "어쩌다가": 1099,
+    "어쩐지": 1100,
+    "언니": 1101,
+    "언덕": 1102,
+    "언론": 1103,
+    "언어": 1104,
+    "얼굴": 1105,
+    "얼른": 1106,
+    "얼음": 1107,
+    "얼핏": 1108,
+    "엄마": 1109,
+    "업무": 1110,
+    "업종": 1111,
+    "업체": 1112,
+    "엉덩이": 1113,
+    "엉망": 1114,
+    "엉터리": 1115,
+    "엊그제": 1116,
+    "에너지": 1117,
+    "에어컨": 1118,
+    "엔진": 1119,
+    "여건": 1120,
+    "여고생": 1121,
+    "여관": 1122,
+    "여군": 1123,
+    "여권": 1124,
+    "여대생": 1125,
+    "여덟": 1126,
+    "여동생": 1127,
+    "여든": 1128,
+    "여론": 1129,
+    "여름": 1130,
+    "여섯": 1131,
+    "여성": 1132,
+    "여왕": 1133,
+    "여인": 1134,
+    "여전히": 1135,
+    "여직원": 1136,
+    "여학생": 1137,
+    "여행": 1138,
+    "역사": 1139,
+    "역시": 1140,
+    "역할": 1141,
+    "연결": 1142,
+    "연구": 1143,
+    "연극": 1144,
+    "연기": 1145,
+    "연락": 1146,
+    "연설": 1147,
+    "연세": 1148,
+    "연속": 1149,
+    "연습": 1150,
+    "연애": 1151,
+    "연예인": 1152,
+    "연인": 1153,
+    "연장": 1154,
+    "연주": 1155,
+    "연출": 1156,
+    "연필": 1157,
+    "연합": 1158,
+    "연휴": 1159,
+    "열기": 1160,
+    "열매": 1161,
+    "열쇠": 1162,
+    "열심히": 1163,
+    "열정": 1164,
+    "열차": 1165,
+    "열흘": 1166,
+    "염려": 1167,
+    "엽서": 1168,
+    "영국": 1169,
+    "영남": 1170,
+    "영상": 1171,
+    "영양": 1172,
+    "영역": 1173,
+    "영웅": 1174,
+    "영원히": 1175,
+    "영하": 1176,
+    "영향": 1177,
+    "영혼": 1178,
+    "영화": 1179,
+    "옆구리": 1180,
+    "옆방": 1181,
+    "옆집": 1182,
+    "예감": 1183,
+    "예금": 1184,
+    "예방": 1185,
+    "예산": 1186,
+    "예상": 1187,
+    "예선": 1188,
+    "예술": 1189,
+    "예습": 1190,
+    "예식장": 1191,
+    "예약": 1192,
+    "예전": 1193,
+    "예절": 1194,
+    "예정": 1195,
+    "예컨대": 1196,
+    "옛날": 1197,
+    "오늘": 1198,
+    "오락": 1199,
+    "오랫동안": 1200,
+    "오렌지": 1201,
+    "오로지": 1202,
+    "오른발": 1203,
+    "오븐": 1204,
+    "오십": 1205,
+    "오염": 1206,
+    "오월": 1207,
+    "오전": 1208,
+    "오직": 1209,
+    "오징어": 1210,
+    "오페라": 1211,
+    "오피스텔": 1212,
+    "오히려": 1213,
+    "옥상": 1214,
+    "옥수수": 1215,
+    "온갖": 1216,
+    "온라인": 1217,
+    "온몸": 1218,
+    "온종일": 1219,
+    "온통": 1220,
+    "올가을": 1221,
+    "올림픽": 1222,
+    "올해": 1223,
+    "옷차림": 1224,
+    "와이셔츠": 1225,
+    "와인": 1226,
+    "완성": 1227,
+    "완전": 1228,
+    "왕비": 1229,
+    "왕자": 1230,
+    "왜냐하면": 1231,
+    "왠지": 1232,
+    "외갓집": 1233,
+    "외국": 1234,
+    "외로움": 1235,
+    "외삼촌": 1236,
+    "외출": 1237,
+    "외침": 1238,
+    "외할머니": 1239,
+    "왼발": 1240,
+    "왼손": 1241,
+    "왼쪽": 1242,
+    "요금": 1243,
+    "요일": 1244,
+    "요즘": 1245,
+    "요청": 1246,
+    "용기": 1247,
+    "용서": 1248,
+    "용어": 1249,
+    "우산": 1250,
+    "우선": 1251,
+    "우승": 1252,
+    "우연히": 1253,
+    "우정": 1254,
+    "우체국": 1255,
+    "우편": 1256,
+    "운동": 1257,
+    "운명": 1258,
+    "운반": 1259,
+    "운전": 1260,
+    "운행": 1261,
+    "울산": 1262,
+    "울음": 1263,
+    "움직임": 1264,
+    "웃어른": 1265,
+    "웃음": 1266,
+    "워낙": 1267,
+    "원고": 1268,
+    "원래": 1269,
+    "원서": 1270,
+    "원숭이": 1271,
+    "원인": 1272,
+    "원장": 1273,
+    "원피스": 1274,
+    "월급": 1275,
+    "월드컵": 1276,
+    "월세": 1277,
+    "월요일": 1278,
+    "웨이터": 1279,
+    "위반": 1280,
+    "위법": 1281,
+    "위성": 1282,
+    "위원": 1283,
+    "위험": 1284,
+    "위협": 1285,
+    "윗사람": 1286,
+    "유난히": 1287,
+    "유럽": 1288,
+    "유명": 1289,
+    "유물": 1290,
+    "유산": 1291,
+    "유적": 1292,
+    "유치원": 1293,
+    "유학": 1294,
+    "유행": 1295,
+    "유형": 1296,
+    "육군": 1297,
+    "육상": 1298,
+    "육십": 1299,
+    "육체": 1300,
+    "은행": 1301,
+    "음력": 1302,
+    "음료": 1303,
+    "음반": 1304,
+    "음성": 1305,
+    "음식": 1306,
+    "음악": 1307,
+    "음주": 1308,
+    "의견": 1309,
+    "의논": 1310,
+    "의문": 1311,
+    "의복": 1312,
+    "의식": 1313,
+    "의심": 1314,
+    "의외로": 1315,
+    "의욕": 1316,
+    "의원": 1317,
+    "의학": 1318,
+    "이것": 1319,
+    "이곳": 1320,
+    "이념": 1321,
+    "이놈": 1322,
+    "이달": 1323,
+    "이대로": 1324,
+    "이동": 1325,
+    "이렇게": 1326,
+    "이력서": 1327,
+    "이론적": 1328,
+    "이름": 1329,
+    "이민": 1330,
+    "이발소": 1331,
+    "이별": 1332,
+    "이불": 1333,
+    "이빨": 1334,
+    "이상": 1335,
+    "이성": 1336,
+    "이슬": 1337,
+    "이야기": 1338,
+    "이용": 1339,
+    "이웃": 1340,
+    "이월": 1341,
+    "이윽고": 1342,
+    "이익": 1343,
+    "이전": 1344,
+    "이중": 1345,
+    "이튿날": 1346,
+    "이틀": 1347,
+    "이혼": 1348,
+    "인간": 1349,
+    "인격": 1350,
+    "인공": 1351,
+    "인구": 1352,
+    "인근": 1353,
+    "인기": 1354,
+    "인도": 1355,
+    "인류": 1356,
+    "인물": 1357,
+    "인생": 1358,
+    "인쇄": 1359,
+    "인연": 1360,
+    "인원": 1361,
+    "인재": 1362,
+    "인종": 1363,
+    "인천": 1364,
+    "인체": 1365,
+    "인터넷": 1366,
+    "인하": 1367,
+    "인형": 1368,
+    "일곱": 1369,
+    "일기": 1370,
+    "일단": 1371,
+    "일대": 1372,
+    "일등": 1373,
+    "일반": 1374,
+    "일본": 1375,
+    "일부": 1376,
+    "일상": 1377,
+    "일생": 1378,
+    "일손": 1379,
+    "일요일": 1380,
+    "일월": 1381,
+    "일정": 1382,
+    "일종": 1383,
+    "일주일": 1384,
+    "일찍": 1385,
+    "일체": 1386,
+    "일치": 1387,
+    "일행": 1388,
+    "일회용": 1389,
+    "임금": 1390,
+    "임무": 1391,
+    "입대": 1392,
+    "입력": 1393,
+    "입맛": 1394,
+    "입사": 1395,
+    "입술": 1396,
+    "입시": 1397,
+    "입원": 1398,
+    "입장": 1399,
+    "입학": 1400,
+    "자가용": 1401,
+    "자격": 1402,
+    "자극": 1403,
+    "자동": 1404,
+    "자랑": 1405,
+    "자부심": 1406,
+    "자식": 1407,
+    "자신": 1408,
+    "자연": 1409,
+    "자원": 1410,
+    "자율": 1411,
+    "자전거": 1412,
+    "자정": 1413,
+    "자존심": 1414,
+    "자판": 1415,
+    "작가": 1416,
+    "작년": 1417,
+    "작성": 1418,
+    "작업": 1419,
+    "작용": 1420,
+    "작은딸": 1421,
+    "작품": 1422,
+    "잔디": 1423,
+    "잔뜩": 1424,
+    "잔치": 1425,
+    "잘못": 1426,
+    "잠깐": 1427,
+    "잠수함": 1428,
+    "잠시": 1429,
+    "잠옷": 1430,
+    "잠자리": 1431,
+    "잡지": 1432,
+    "장관": 1433,
+    "장군": 1434,
+    "장기간": 1435,
+    "장래": 1436,
+    "장례": 1437,
+    "장르": 1438,
+    "장마": 1439,
+    "장면": 1440,
+    "장모": 1441,
+    "장미": 1442,
+    "장비": 1443,
+    "장사": 1444,
+    "장소": 1445,
+    "장식": 1446,
+    "장애인": 1447,
+    "장인": 1448,
+    "장점": 1449,
+    "장차": 1450,
+    "장학금": 1451,
+    "재능": 1452,
+    "재빨리": 1453,
+    "재산": 1454,
+    "재생": 1455,
+    "재작년": 1456,
+    "재정": 1457,
+    "재채기": 1458,
+    "재판": 1459,
+    "재학": 1460,
+    "재활용": 1461,
+    "저것": 1462,
+    "저고리": 1463,
+    "저곳": 1464,
+    "저녁": 1465,
+    "저런": 1466,
+    "저렇게": 1467,
+    "저번": 1468,
+    "저울": 1469,
+    "저절로": 1470,
+    "저축": 1471,
+    "적극": 1472,
+    "적당히": 1473,
+    "적성": 1474,
+    "적용": 1475,
+    "적응": 1476,
+    "전개": 1477,
+    "전공": 1478,
+    "전기": 1479,
+    "전달": 1480,
+    "전라도": 1481,
+    "전망": 1482,
+    "전문": 1483,
+    "전반": 1484,
+    "전부": 1485,
+    "전세": 1486,
+    "전시": 1487,
+    "전용": 1488,
+    "전자": 1489,
+    "전쟁": 1490,
+    "전주": 1491,
+    "전철": 1492,
+    "전체": 1493,
+    "전통": 1494,
+    "전혀": 1495,
+    "전후": 1496,
+    "절대": 1497,
+    "절망": 1498,
+    "절반": 1499,
+    "절약": 1500,
+    "절차": 1501,
+    "점검": 1502,
+    "점수": 1503,
+    "점심": 1504,
+    "점원": 1505,
+    "점점": 1506,
+    "점차": 1507,
+    "접근": 1508,
+    "접시": 1509,
+    "접촉": 1510,
+    "젓가락": 1511,
+    "정거장": 1512,
+    "정도": 1513,
+    "정류장": 1514,
+    "정리": 1515,
+    "정말": 1516,
+    "정면": 1517,
+    "정문": 1518,
+    "정반대": 1519,
+    "정보": 1520,
+    "정부": 1521,
+    "정비": 1522,
+    "정상": 1523,
+    "정성": 1524,
+    "정오": 1525,
+    "정원": 1526,
+    "정장": 1527,
+    "정지": 1528,
+    "정치": 1529,
+    "정확히": 1530,
+    "제공": 1531,
+    "제과점": 1532,
+    "제대로": 1533,
+    "제목": 1534,
+    "제발": 1535,
+    "제법": 1536,
+    "제삿날": 1537,
+    "제안": 1538,
+    "제일": 1539,
+    "제작": 1540,
+    "제주도": 1541,
+    "제출": 1542,
+    "제품": 1543,
+    "제한": 1544,
+    "조각": 1545,
+    "조건": 1546,
+    "조금": 1547,
+    "조깅": 1548,
+    "조명": 1549,
+    "조미료": 1550,
+    "조상": 1551,
+    "조선": 1552,
+    "조용히": 1553,
+    "조절": 1554,
+    "조정": 1555,
+    "조직": 1556,
+    "존댓말": 1557,
+    "존재": 1558,
+    "졸업": 1559,
+    "졸음": 1560,
+    "종교": 1561,
+    "종로": 1562,
+    "종류": 1563,
+    "종소리": 1564,
+    "종업원": 1565,
+    "종종": 1566,
+    "종합": 1567,
+    "좌석": 1568,
+    "죄인": 1569,
+    "주관적": 1570,
+    "주름": 1571,
+    "주말": 1572,
+    "주머니": 1573,
+    "주먹": 1574,
+    "주문": 1575,
+    "주민": 1576,
+    "주방": 1577,
+    "주변": 1578,
+    "주식": 1579,
+    "주인": 1580,
+    "주일": 1581,
+    "주장": 1582,
+    "주전자": 1583,
+    "주택": 1584,
+    "준비": 1585,
+    "줄거리": 1586,
+    "줄기": 1587,
+    "줄무늬": 1588,
+    "중간": 1589,
+    "중계방송": 1590,
+    "중국": 1591,
+    "중년": 1592,
+    "중단": 1593,
+    "중독": 1594,
+    "중반": 1595,
+    "중부": 1596,
+    "중세": 1597,
+    "중소기업": 1598,
+    "중순": 1599,
+    "중앙": 1600,
+    "중요": 1601,
+    "중학교": 1602,
+    "즉석": 1603,
+    "즉시": 1604,
+    "즐거움": 1605,
+    "증가": 1606,
+    "증거": 1607,
+    "증권": 1608,
+    "증상": 1609,
+    "증세": 1610,
+    "지각": 1611,
+    "지갑": 1612,
+    "지경": 1613,
+    "지극히": 1614,
+    "지금": 1615,
+    "지급": 1616,
+    "지능": 1617,
+    "지름길": 1618,
+    "지리산": 1619,
+    "지방": 1620,
+    "지붕": 1621,
+    "지식": 1622,
+    "지역": 1623,
+    "지우개": 1624,
+    "지원": 1625,
+    "지적": 1626,
+    "지점": 1627,
+    "지진": 1628,
+    "지출": 1629,
+    "직선": 1630,
+    "직업": 1631,
+    "직원": 1632,
+    "직장": 1633,
+    "진급": 1634,
+    "진동": 1635,
+    "진로": 1636,
+    "진료": 1637,
+    "진리": 1638,
+    "진짜": 1639,
+    "진찰": 1640,
+    "진출": 1641,
+    "진통": 1642,
+    "진행": 1643,
+    "질문": 1644,
+    "질병": 1645,
+    "질서": 1646,
+    "짐작": 1647,
+    "집단": 1648,
+    "집안": 1649,
+    "집중": 1650,
+    "짜증": 1651,
+    "찌꺼기": 1652,
+    "차남": 1653,
+    "차라리": 1654,
+    "차량": 1655,
+    "차림": 1656,
+    "차별": 1657,
+    "차선": 1658,
+    "차츰": 1659,
+    "착각": 1660,
+    "찬물": 1661,
+    "찬성": 1662,
+    "참가": 1663,
+    "참기름": 1664,
+    "참새": 1665,
+    "참석": 1666,
+    "참여": 1667,
+    "참외": 1668,
+    "참조": 1669,
+    "찻잔": 1670,
+    "창가": 1671,
+    "창고": 1672,
+    "창구": 1673,
+    "창문": 1674,
+    "창밖": 1675,
+    "창작": 1676,
+    "창조": 1677,
+    "채널": 1678,
+    "채점": 1679,
+    "책가방": 1680,
+    "책방": 1681,
+    "책상": 1682,
+    "책임": 1683,
+    "챔피언": 1684,
+    "처벌": 1685,
+    "처음": 1686,
+    "천국": 1687,
+    "천둥": 1688,
+    "천장": 1689,
+    "천재": 1690,
+    "천천히": 1691,
+    "철도": 1692,
+    "철저히": 1693,
+    "철학": 1694,
+    "첫날": 1695,
+    "첫째": 1696,
+    "청년": 1697,
+    "청바지": 1698,
+    "청소": 1699,
+    "청춘": 1700,
+    "체계": 1701,
+    "체력": 1702,
+    "체온": 1703,
+    "체육": 1704,
+    "체중": 1705,
+    "체험": 1706,
+    "초등학생": 1707,
+    "초반": 1708,
+    "초밥": 1709,
+    "초상화": 1710,
+    "초순": 1711,
+    "초여름": 1712,
+    "초원": 1713,
+    "초저녁": 1714,
+    "초점": 1715,
+    "초청": 1716,
+    "초콜릿": 1717,
+    "촛불": 1718,
+    "총각": 1719,
+    "총리": 1720,
+    "총장": 1721,
+    "촬영": 1722,
+    "최근": 1723,
+    "최상": 1724,
+    "최선": 1725,
+    "최신": 1726,
+    "최악": 1727,
+    "최종": 1728,
+    "추석": 1729,
+    "추억": 1730,
+    "추진": 1731,
+    "추천": 1732,
+    "추측": 1733,
+    "축구": 1734,
+    "축소": 1735,
+    "축제": 1736,
+    "축하": 1737,
+    "출근": 1738,
+    "출발": 1739,
+    "출산": 1740,
+    "출신": 1741,
+    "출연": 1742,
+    "출입": 1743,
+    "출장": 1744,
+    "출판": 1745,
+    "충격": 1746,
+    "충고": 1747,
+    "충돌": 1748,
+    "충분히": 1749,
+    "충청도": 1750,
+    "취업": 1751,
+    "취직": 1752,
+    "취향": 1753,
+    "치약": 1754,
+    "친구": 1755,
+    "친척": 1756,
+    "칠십": 1757,
+    "칠월": 1758,
+    "칠판": 1759,
+    "침대": 1760,
+    "침묵": 1761,
+    "침실": 1762,
+    "칫솔": 1763,
+    "칭찬": 1764,
+    "카메라": 1765,
+    "카운터": 1766,
+    "칼국수": 1767,
+    "캐릭터": 1768,
+    "캠퍼스": 1769,
+    "캠페인": 1770,
+    "커튼": 1771,
+    "컨디션": 1772,
+    "컬러": 1773,
+    "컴퓨터": 1774,
+    "코끼리": 1775,
+    "코미디": 1776,
+    "콘서트": 1777,
+    "콜라": 1778,
+    "콤플렉스": 1779,
+    "콩나물": 1780,
+    "쾌감": 1781,
+    "쿠데타": 1782,
+    "크림": 1783,
+    "큰길": 1784,
+    "큰딸": 1785,
+    "큰소리": 1786,
+    "큰아들": 1787,
+    "큰어머니": 1788,
+    "큰일": 1789,
+    "큰절": 1790,
+    "클래식": 1791,
+    "클럽": 1792,
+    "킬로": 1793,
+    "타입": 1794,
+    "타자기": 1795,
+    "탁구": 1796,
+    "탁자": 1797,
+    "탄생": 1798,
+    "태권도": 1799,
+    "태양": 1800,
+    "태풍": 1801,
+    "택시": 1802,
+    "탤런트": 1803,
+    "터널": 1804,
+    "터미널": 1805,
+    "테니스": 1806,
+    "테스트": 1807,
+    "테이블": 1808,
+    "텔레비전": 1809,
+    "토론": 1810,
+    "토마토": 1811,
+    "토요일": 1812,
+    "통계": 1813,
+    "통과": 1814,
+    "통로": 1815,
+    "통신": 1816,
+    "통역": 1817,
+    "통일": 1818,
+    "통장": 1819,
+    "통제": 1820,
+    "통증": 1821,
+    "통합": 1822,
+    "통화": 1823,
+    "퇴근": 1824,
+    "퇴원": 1825,
+    "퇴직금": 1826,
+    "튀김": 1827,
+    "트럭": 1828,
+    "특급": 1829,
+    "특별": 1830,
+    "특성": 1831,
+    "특수": 1832,
+    "특징": 1833,
+    "특히": 1834,
+    "튼튼히": 1835,
+    "티셔츠": 1836,
+    "파란색": 1837,
+    "파일": 1838,
+    "파출소": 1839,
+    "판결": 1840,
+    "판단": 1841,
+    "판매": 1842,
+    "판사": 1843,
+    "팔십": 1844,
+    "팔월": 1845,
+    "팝송": 1846,
+    "패션": 1847,
+    "팩스": 1848,
+    "팩시밀리": 1849,
+    "팬티": 1850,
+    "퍼센트": 1851,
+    "페인트": 1852,
+    "편견": 1853,
+    "편의": 1854,
+    "편지": 1855,
+    "편히": 1856,
+    "평가": 1857,
+    "평균": 1858,
+    "평생": 1859,
+    "평소": 1860,
+    "평양": 1861,
+    "평일": 1862,
+    "평화": 1863,
+    "포스터": 1864,
+    "포인트": 1865,
+    "포장": 1866,
+    "포함": 1867,
+    "표면": 1868,
+    "표정": 1869,
+    "표준": 1870,
+    "표현": 1871,
+    "품목": 1872,
+    "품질": 1873,
+    "풍경": 1874,
+    "풍속": 1875,
+    "풍습": 1876,
+    "프랑스": 1877,
+    "프린터": 1878,
+    "플라스틱": 1879,
+    "피곤": 1880,
+    "피망": 1881,
+    "피아노": 1882,
+    "필름": 1883,
+    "필수": 1884,
+    "필요": 1885,
+    "필자": 1886,
+    "필통": 1887,
+    "핑계": 1888,
+    "하느님": 1889,
+    "하늘": 1890,
+    "하드웨어": 1891,
+    "하룻밤": 1892,
+    "하반기": 1893,
+    "하숙집": 1894,
+    "하순": 1895,
+    "하여튼": 1896,
+    "하지만": 1897,
+    "하천": 1898,
+    "하품": 1899,
+    "하필": 1900,
+    "학과": 1901,
+    "학교": 1902,
+    "학급": 1903,
+    "학기": 1904,
+    "학년": 1905,
+    "학력": 1906,
+    "학번": 1907,
+    "학부모": 1908,
+    "학비": 1909,
+    "학생": 1910,
+    "학술": 1911,
+    "학습": 1912,
+    "학용품": 1913,
+    "학원": 1914,
+    "학위": 1915,
+    "학자": 1916,
+    "학점": 1917,
+    "한계": 1918,
+    "한글": 1919,
+    "한꺼번에": 1920,
+    "한낮": 1921,
+    "한눈": 1922,
+    "한동안": 1923,
+    "한때": 1924,
+    "한라산": 1925,
+    "한마디": 1926,
+    "한문": 1927,
+    "한번": 1928,
+    "한복": 1929,
+    "한식": 1930,
+    "한여름": 1931,
+    "한쪽": 1932,
+    "할머니": 1933,
+    "할아버지": 1934,
+    "할인": 1935,
+    "함께": 1936,
+    "함부로": 1937,
+    "합격": 1938,
+    "합리적": 1939,
+    "항공": 1940,
+    "항구": 1941,
+    "항상": 1942,
+    "항의": 1943,
+    "해결": 1944,
+    "해군": 1945,
+    "해답": 1946,
+    "해당": 1947,
+    "해물": 1948,
+    "해석": 1949,
+    "해설": 1950,
+    "해수욕장": 1951,
+    "해안": 1952,
+    "핵심": 1953,
+    "핸드백": 1954,
+    "햄버거": 1955,
+    "햇볕": 1956,
+    "햇살": 1957,
+    "행동": 1958,
+    "행복": 1959,
+    "행사": 1960,
+    "행운": 1961,
+    "행위": 1962,
+    "향기": 1963,
+    "향상": 1964,
+    "향수": 1965,
+    "허락": 1966,
+    "허용": 1967,
+    "헬기": 1968,
+    "현관": 1969,
+    "현금": 1970,
+    "현대": 1971,
+    "현상": 1972,
+    "현실": 1973,
+    "현장": 1974,
+    "현재": 1975,
+    "현지": 1976,
+    "혈액": 1977,
+    "협력": 1978,
+    "형부": 1979,
+    "형사": 1980,
+    "형수": 1981,
+    "형식": 1982,
+    "형제": 1983,
+    "형태": 1984,
+    "형편": 1985,
+    "혜택": 1986,
+    "호기심": 1987,
+    "호남": 1988,
+    "호랑이": 1989,
+    "호박": 1990,
+    "호텔": 1991,
+    "호흡": 1992,
+    "혹시": 1993,
+    "홀로": 1994,
+    "홈페이지": 1995,
+    "홍보": 1996,
+    "홍수": 1997,
+    "홍차": 1998,
+    "화면": 1999,
+    "화분": 2000,
+    "화살": 2001,
+    "화요일": 2002,
+    "화장": 2003,
+    "화학": 2004,
+    "확보": 2005,
+    "확인": 2006,
+    "확장": 2007,
+    "확정": 2008,
+    "환갑": 2009,
+    "환경": 2010,
+    "환영": 2011,
+    "환율": 2012,
+    "환자": 2013,
+    "활기": 2014,
+    "활동": 2015,
+    "활발히": 2016,
+    "활용": 2017,
+    "활짝": 2018,
+    "회견": 2019,
+    "회관": 2020,
+    "회복": 2021,
+    "회색": 2022,
+    "회원": 2023,
+    "회장": 2024,
+    "회전": 2025,
+    "횟수": 2026,
+    "횡단보도": 2027,
+    "효율적": 2028,
+    "후반": 2029,
+    "후춧가루": 2030,
+    "훈련": 2031,
+    "훨씬": 2032,
+    "휴식": 2033,
+    "휴일": 2034,
+    "흉내": 2035,
+    "흐름": 2036,
+    "흑백": 2037,
+    "흑인": 2038,
+    "흔적": 2039,
+    "흔히": 2040,
+    "흥미": 2041,
+    "흥분": 2042,
+    "희곡": 2043,
+    "희망": 2044,
+    "희생": 2045,
+    "흰색": 2046,
+    "힘껏": 2047,
+}
+var words_spanish = [2048]string{
+    "ábaco",
+    "abdomen",
+    "abeja",
+    "abierto",
+    "abogado",
+    "abono",
+    "aborto",
+    "abrazo",
+    "abrir",
+    "abuelo",
+    "abuso",
+    "acabar",
+    "academia",
+    "acceso",
+    "acción",
+    "aceite",
+    "acelga",
+    "acento",
+    "aceptar",
+    "ácido",
+    "aclarar",
+    "acné",
+    "acoger",
+    "acoso",
+    "activo",
+    "acto",
+    "actriz",
+    "actuar",
+    "acudir",
+    "acuerdo",
+    "acusar",
+    "adicto",
+    "admitir",
+    "adoptar",
+    "adorno",
+    "aduana",
+    "adulto",
+    "aéreo",
+    "afectar",
+    "afición",
+    "afinar",
+    "afirmar",
+    "ágil",
+    "agitar",
+    "agonía",
+    "agosto",
+    "agotar",
+    "agregar",
+    "agrio",
+    "agua",
+    "agudo",
+    "águila",
+    "aguja",
+    "ahogo",
+    "ahorro",
+    "aire",
+    "aislar",
+    "ajedrez",
+    "ajeno",
+    "ajuste",
+    "alacrán",
+    "alambre",
+    "alarma",
+    "alba",
+    "álbum",
+    "alcalde",
+    "aldea",
+    "alegre",
+    "alejar",
+    "alerta",
+    "aleta",
+    "alfiler",
+    "alga",
+    "algodón",
+    "aliado",
+    "aliento",
+    "alivio",
+    "alma",
+    "almeja",
+    "almíbar",
+    "altar",
+    "alteza",
+    "altivo",
+    "alto",
+    "altura",
+    "alumno",
+    "alzar",
+    "amable",
+    "amante",
+    "amapola",
+    "amargo",
+    "amasar",
+    "ámbar",
+    "ámbito",
+    "ameno",
+    "amigo",
+    "amistad",
+    "amor",
+    "amparo",
+    "amplio",
+    "ancho",
+    "anciano",
+    "ancla",
+    "andar",
+    "andén",
+    "anemia",
+    "ángulo",
+    "anillo",
+    "ánimo",
+    "anís",
+    "anotar",
+    "antena",
+    "antiguo",
+    "antojo",
+    "anual",
+    "anular",
+    "anuncio",
+    "añadir",
+    "añejo",
+    "año",
+    "apagar",
+    "aparato",
+    "apetito",
+    "apio",
+    "aplicar",
+    "apodo",
+    "aporte",
+    "apoyo",
+    "aprender",
+    "aprobar",
+    "apuesta",
+    "apuro",
+    "arado",
+    "araña",
+    "arar",
+    "árbitro",
+    "árbol",
+    "arbusto",
+    "archivo",
+    "arco",
+    "arder",
+    "ardilla",
+    "arduo",
+    "área",
+    "árido",
+    "aries",
+    "armonía",
+    "arnés",
+    "aroma",
+    "arpa",
+    "arpón",
+    "arreglo",
+    "arroz",
+    "arruga",
+    "arte",
+    "artista",
+    "asa",
+    "asado",
+    "asalto",
+    "ascenso",
+    "asegurar",
+    "aseo",
+    "asesor",
+    "asiento",
+    "asilo",
+    "asistir",
+    "asno",
+    "asombro",
+    "áspero",
+    "astilla",
+    "astro",
+    "astuto",
+    "asumir",
+    "asunto",
+    "atajo",
+    "ataque",
+    "atar",
+    "atento",
+    "ateo",
+    "ático",
+    "atleta",
+    "átomo",
+    "atraer",
+    "atroz",
+    "atún",
+    "audaz",
+    "audio",
+    "auge",
+    "aula",
+    "aumento",
+    "ausente",
+    "autor",
+    "aval",
+    "avance",
+    "avaro",
+    "ave",
+    "avellana",
+    "avena",
+    "avestruz",
+    "avión",
+    "aviso",
+    "ayer",
+    "ayuda",
+    "ayuno",
+    "azafrán",
+    "azar",
+    "azote",
+    "azúcar",
+    "azufre",
+    "azul",
+    "baba",
+    "babor",
+    "bache",
+    "bahía",
+    "baile",
+    "bajar",
+    "balanza",
+    "balcón",
+    "balde",
+    "bambú",
+    "banco",
+    "banda",
+    "baño",
+    "barba",
+    "barco",
+    "barniz",
+    "barro",
+    "báscula",
+    "bastón",
+    "basura",
+    "batalla",
+    "batería",
+    "batir",
+    "batuta",
+    "baúl",
+    "bazar",
+    "bebé",
+    "bebida",
+    "bello",
+    "besar",
+    "beso",
+    "bestia",
+    "bicho",
+    "bien",
+    "bingo",
+    "blanco",
+    "bloque",
+    "blusa",
+    "boa",
+    "bobina",
+    "bobo",
+    "boca",
+    "bocina",
+    "boda",
+    "bodega",
+    "boina",
+    "bola",
+    "bolero",
+    "bolsa",
+    "bomba",
+    "bondad",
+    "bonito",
+    "bono",
+    "bonsái",
+    "borde",
+    "borrar",
+    "bosque",
+    "bote",
+    "botín",
+    "bóveda",
+    "bozal",
+    "bravo",
+    "brazo",
+    "brecha",
+    "breve",
+    "brillo",
+    "brinco",
+    "brisa",
+    "broca",
+    "broma",
+    "bronce",
+    "brote",
+    "bruja",
+    "brusco",
+    "bruto",
+    "buceo",
+    "bucle",
+    "bueno",
+    "buey",
+    "bufanda",
+    "bufón",
+    "búho",
+    "buitre",
+    "bulto",
+    "burbuja",
+    "burla",
+    "burro",
+    "buscar",
+    "butaca",
+    "buzón",
+    "caballo",
+    "cabeza",
+    "cabina",
+    "cabra",
+    "cacao",
+    "cadáver",
+    "cadena",
+    "caer",
+    "café",
+    "caída",
+    "caimán",
+    "caja",
+    "cajón",
+    "cal",
+    "calamar",
+    "calcio",
+    "caldo",
+    "calidad",
+    "calle",
+    "calma",
+    "calor",
+    "calvo",
+    "cama",
+    "cambio",
+    "camello",
+    "camino",
+    "campo",
+    "cáncer",
+    "candil",
+    "canela",
+    "canguro",
+    "canica",
+    "canto",
+    "caña",
+    "cañón",
+    "caoba",
+    "caos",
+    "capaz",
+    "capitán",
+    "capote",
+    "captar",
+    "capucha",
+    "cara",
+    "carbón",
+    "cárcel",
+    "careta",
+    "carga",
+    "cariño",
+    "carne",
+    "carpeta",
+    "carro",
+    "carta",
+    "casa",
+    "casco",
+    "casero",
+    "caspa",
+    "castor",
+    "catorce",
+    "catre",
+    "caudal",
+    "causa",
+    "cazo",
+    "cebolla",
+    "ceder",
+    "cedro",
+    "celda",
+    "célebre",
+    "celoso",
+    "célula",
+    "cemento",
+    "ceniza",
+    "centro",
+    "cerca",
+    "cerdo",
+    "cereza",
+    "cero",
+    "cerrar",
+    "certeza",
+    "césped",
+    "cetro",
+    "chacal",
+    "chaleco",
+    "champú",
+    "chancla",
+    "chapa",
+    "charla",
+    "chico",
+    "chiste",
+    "chivo",
+    "choque",
+    "choza",
+    "chuleta",
+    "chupar",
+    "ciclón",
+    "ciego",
+    "cielo",
+    "cien",
+    "cierto",
+    "cifra",
+    "cigarro",
+    "cima",
+    "cinco",
+    "cine",
+    "cinta",
+    "ciprés",
+    "circo",
+    "ciruela",
+    "cisne",
+    "cita",
+    "ciudad",
+    "clamor",
+    "clan",
+    "claro",
+    "clase",
+    "clave",
+    "cliente",
+    "clima",
+    "clínica",
+    "cobre",
+    "cocción",
+    "cochino",
+    "cocina",
+    "coco",
+    "código",
+    "codo",
+    "cofre",
+    "coger",
+    "cohete",
+    "cojín",
+    "cojo",
+    "cola",
+    "colcha",
+    "colegio",
+    "colgar",
+    "colina",
+    "collar",
+    "colmo",
+    "columna",
+    "combate",
+    "comer",
+    "comida",
+    "cómodo",
+    "compra",
+    "conde",
+    "conejo",
+    "conga",
+    "conocer",
+    "consejo",
+    "contar",
+    "copa",
+    "copia",
+    "corazón",
+    "corbata",
+    "corcho",
+    "cordón",
+    "corona",
+    "correr",
+    "coser",
+    "cosmos",
+    "costa",
+    "cráneo",
+    "cráter",
+    "crear",
+    "crecer",
+    "creído",
+    "crema",
+    "cría",
+    "crimen",
+    "cripta",
+    "crisis",
+    "cromo",
+    "crónica",
+    "croqueta",
+    "crudo",
+    "cruz",
+    "cuadro",
+    "cuarto",
+    "cuatro",
+    "cubo",
+    "cubrir",
+    "cuchara",
+    "cuello",
+    "cuento",
+    "cuerda",
+    "cuesta",
+    "cueva",
+    "cuidar",
+    "culebra",
+    "culpa",
+    "culto",
+    "cumbre",
+    "cumplir",
+    "cuna",
+    "cuneta",
+    "cuota",
+    "cupón",
+    "cúpula",
+    "curar",
+    "curioso",
+    "curso",
+    "curva",
+    "cutis",
+    "dama",
+    "danza",
+    "dar",
+    "dardo",
+    "dátil",
+    "deber",
+    "débil",
+    "década",
+    "decir",
+    "dedo",
+    "defensa",
+    "definir",
+    "dejar",
+    "delfín",
+    "delgado",
+    "delito",
+    "demora",
+    "denso",
+    "dental",
+    "deporte",
+    "derecho",
+    "derrota",
+    "desayuno",
+    "deseo",
+    "desfile",
+    "desnudo",
+    "destino",
+    "desvío",
+    "detalle",
+    "detener",
+    "deuda",
+    "día",
+    "diablo",
+    "diadema",
+    "diamante",
+    "diana",
+    "diario",
+    "dibujo",
+    "dictar",
+    "diente",
+    "dieta",
+    "diez",
+    "difícil",
+    "digno",
+    "dilema",
+    "diluir",
+    "dinero",
+    "directo",
+    "dirigir",
+    "disco",
+    "diseño",
+    "disfraz",
+    "diva",
+    "divino",
+    "doble",
+    "doce",
+    "dolor",
+    "domingo",
+    "don",
+    "donar",
+    "dorado",
+    "dormir",
+    "dorso",
+    "dos",
+    "dosis",
+    "dragón",
+    "droga",
+    "ducha",
+    "duda",
+    "duelo",
+    "dueño",
+    "dulce",
+    "dúo",
+    "duque",
+    "durar",
+    "dureza",
+    "duro",
+    "ébano",
+    "ebrio",
+    "echar",
+    "eco",
+    "ecuador",
+    "edad",
+    "edición",
+    "edificio",
+    "editor",
+    "educar",
+    "efecto",
+    "eficaz",
+    "eje",
+    "ejemplo",
+    "elefante",
+    "elegir",
+    "elemento",
+    "elevar",
+    "elipse",
+    "élite",
+    "elixir",
+    "elogio",
+    "eludir",
+    "embudo",
+    "emitir",
+    "emoción",
+    "empate",
+    "empeño",
+    "empleo",
+    "empresa",
+    "enano",
+    "encargo",
+    "enchufe",
+    "encía",
+    "enemigo",
+    "enero",
+    "enfado",
+    "enfermo",
+    "engaño",
+    "enigma",
+    "enlace",
+    "enorme",
+    "enredo",
+    "ensayo",
+    "enseñar",
+    "entero",
+    "entrar",
+    "envase",
+    "envío",
+    "época",
+    "equipo",
+    "erizo",
+    "escala",
+    "escena",
+    "escolar",
+    "escribir",
+    "escudo",
+    "esencia",
+    "esfera",
+    "esfuerzo",
+    "espada",
+    "espejo",
+    "espía",
+    "esposa",
+    "espuma",
+    "esquí",
+    "estar",
+    "este",
+    "estilo",
+    "estufa",
+    "etapa",
+    "eterno",
+    "ética",
+    "etnia",
+    "evadir",
+    "evaluar",
+    "evento",
+    "evitar",
+    "exacto",
+    "examen",
+    "exceso",
+    "excusa",
+    "exento",
+    "exigir",
+    "exilio",
+    "existir",
+    "éxito",
+    "experto",
+    "explicar",
+    "exponer",
+    "extremo",
+    "fábrica",
+    "fábula",
+    "fachada",
+    "fácil",
+    "factor",
+    "faena",
+    "faja",
+    "falda",
+    "fallo",
+    "falso",
+    "faltar",
+    "fama",
+    "familia",
+    "famoso",
+    "faraón",
+    "farmacia",
+    "farol",
+    "farsa",
+    "fase",
+    "fatiga",
+    "fauna",
+    "favor",
+    "fax",
+    "febrero",
+    "fecha",
+    "feliz",
+    "feo",
+    "feria",
+    "feroz",
+    "fértil",
+    "fervor",
+    "festín",
+    "fiable",
+    "fianza",
+    "fiar",
+    "fibra",
+    "ficción",
+    "ficha",
+    "fideo",
+    "fiebre",
+    "fiel",
+    "fiera",
+    "fiesta",
+    "figura",
+    "fijar",
+    "fijo",
+    "fila",
+    "filete",
+    "filial",
+    "filtro",
+    "fin",
+    "finca",
+    "fingir",
+    "finito",
+    "firma",
+    "flaco",
+    "flauta",
+    "flecha",
+    "flor",
+    "flota",
+    "fluir",
+    "flujo",
+    "flúor",
+    "fobia",
+    "foca",
+    "fogata",
+    "fogón",
+    "folio",
+    "folleto",
+    "fondo",
+    "forma",
+    "forro",
+    "fortuna",
+    "forzar",
+    "fosa",
+    "foto",
+    "fracaso",
+    "frágil",
+    "franja",
+    "frase",
+    "fraude",
+    "freír",
+    "freno",
+    "fresa",
+    "frío",
+    "frito",
+    "fruta",
+    "fuego",
+    "fuente",
+    "fuerza",
+    "fuga",
+    "fumar",
+    "función",
+    "funda",
+    "furgón",
+    "furia",
+    "fusil",
+    "fútbol",
+    "futuro",
+    "gacela",
+    "gafas",
+    "gaita",
+    "gajo",
+    "gala",
+    "galería",
+    "gallo",
+    "gamba",
+    "ganar",
+    "gancho",
+    "ganga",
+    "ganso",
+    "garaje",
+    "garza",
+    "gasolina",
+    "gastar",
+    "gato",
+    "gavilán",
+    "gemelo",
+    "gemir",
+    "gen",
+    "género",
+    "genio",
+    "gente",
+    "geranio",
+    "gerente",
+    "germen",
+    "gesto",
+    "gigante",
+    "gimnasio",
+    "girar",
+    "giro",
+    "glaciar",
+    "globo",
+    "gloria",
+    "gol",
+    "golfo",
+    "goloso",
+    "golpe",
+    "goma",
+    "gordo",
+    "gorila",
+    "gorra",
+    "gota",
+    "goteo",
+    "gozar",
+    "grada",
+    "gráfico",
+    "grano",
+    "grasa",
+    "gratis",
+    "grave",
+    "grieta",
+    "grillo",
+    "gripe",
+    "gris",
+    "grito",
+    "grosor",
+    "grúa",
+    "grueso",
+    "grumo",
+    "grupo",
+    "guante",
+    "guapo",
+    "guardia",
+    "guerra",
+    "guía",
+    "guiño",
+    "guion",
+    "guiso",
+    "guitarra",
+    "gusano",
+    "gustar",
+    "haber",
+    "hábil",
+    "hablar",
+    "hacer",
+    "hacha",
+    "hada",
+    "hallar",
+    "hamaca",
+    "harina",
+    "haz",
+    "hazaña",
+    "hebilla",
+    "hebra",
+    "hecho",
+    "helado",
+    "helio",
+    "hembra",
+    "herir",
+    "hermano",
+    "héroe",
+    "hervir",
+    "hielo",
+    "hierro",
+    "hígado",
+    "higiene",
+    "hijo",
+    "himno",
+    "historia",
+    "hocico",
+    "hogar",
+    "hoguera",
+    "hoja",
+    "hombre",
+    "hongo",
+    "honor",
+    "honra",
+    "hora",
+    "hormiga",
+    "horno",
+    "hostil",
+    "hoyo",
+    "hueco",
+    "huelga",
+    "huerta",
+    "hueso",
+    "huevo",
+    "huida",
+    "huir",
+    "humano",
+    "húmedo",
+    "humilde",
+    "humo",
+    "hundir",
+    "huracán",
+    "hurto",
+    "icono",
+    "ideal",
+    "idioma",
+    "ídolo",
+    "iglesia",
+    "iglú",
+    "igual",
+    "ilegal",
+    "ilusión",
+    "imagen",
+    "imán",
+    "imitar",
+    "impar",
+    "imperio",
+    "imponer",
+    "impulso",
+    "incapaz",
+    "índice",
+    "inerte",
+    "infiel",
+    "informe",
+    "ingenio",
+    "inicio",
+    "inmenso",
+    "inmune",
+    "innato",
+    "insecto",
+    "instante",
+    "interés",
+    "íntimo",
+    "intuir",
+    "inútil",
+    "invierno",
+    "ira",
+    "iris",
+    "ironía",
+    "isla",
+    "islote",
+    "jabalí",
+    "jabón",
+    "jamón",
+    "jarabe",
+    "jardín",
+    "jarra",
+    "jaula",
+    "jazmín",
+    "jefe",
+    "jeringa",
+    "jinete",
+    "jornada",
+    "joroba",
+    "joven",
+    "joya",
+    "juerga",
+    "jueves",
+    "juez",
+    "jugador",
+    "jugo",
+    "juguete",
+    "juicio",
+    "junco",
+    "jungla",
+    "junio",
+    "juntar",
+    "júpiter",
+    "jurar",
+    "justo",
+    "juvenil",
+    "juzgar",
+    "kilo",
+    "koala",
+    "labio",
+    "lacio",
+    "lacra",
+    "lado",
+    "ladrón",
+    "lagarto",
+    "lágrima",
+    "laguna",
+    "laico",
+    "lamer",
+    "lámina",
+    "lámpara",
+    "lana",
+    "lancha",
+    "langosta",
+    "lanza",
+    "lápiz",
+    "largo",
+    "larva",
+    "lástima",
+    "lata",
+    "látex",
+    "latir",
+    "laurel",
+    "lavar",
+    "lazo",
+    "leal",
+    "lección",
+    "leche",
+    "lector",
+    "leer",
+    "legión",
+    "legumbre",
+    "lejano",
+    "lengua",
+    "lento",
+    "leña",
+    "león",
+    "leopardo",
+    "lesión",
+    "letal",
+    "letra",
+    "leve",
+    "leyenda",
+    "libertad",
+    "libro",
+    "licor",
+    "líder",
+    "lidiar",
+    "lienzo",
+    "liga",
+    "ligero",
+    "lima",
+    "límite",
+    "limón",
+    "limpio",
+    "lince",
+    "lindo",
+    "línea",
+    "lingote",
+    "lino",
+    "linterna",
+    "líquido",
+    "liso",
+    "lista",
+    "litera",
+    "litio",
+    "litro",
+    "llaga",
+    "llama",
+    "llanto",
+    "llave",
+    "llegar",
+    "llenar",
+    "llevar",
+    "llorar",
+    "llover",
+    "lluvia",
+    "lobo",
+    "loción",
+    "loco",
+    "locura",
+    "lógica",
+    "logro",
+    "lombriz",
+    "lomo",
+    "lonja",
+    "lote",
+    "lucha",
+    "lucir",
+    "lugar",
+    "lujo",
+    "luna",
+    "lunes",
+    "lupa",
+    "lustro",
+    "luto",
+    "luz",
+    "maceta",
+    "macho",
+    "madera",
+    "madre",
+    "maduro",
+    "maestro",
+    "mafia",
+    "magia",
+    "mago",
+    "maíz",
+    "maldad",
+    "maleta",
+    "malla",
+    "malo",
+    "mamá",
+    "mambo",
+    "mamut",
+    "manco",
+    "mando",
+    "manejar",
+    "manga",
+    "maniquí",
+    "manjar",
+    "mano",
+    "manso",
+    "manta",
+    "mañana",
+    "mapa",
+    "máquina",
+    "mar",
+    "marco",
+    "marea",
+    "marfil",
+    "margen",
+    "marido",
+    "mármol",
+    "marrón",
+    "martes",
+    "marzo",
+    "masa",
+    "máscara",
+    "masivo",
+    "matar",
+    "materia",
+    "matiz",
+    "matriz",
+    "máximo",
+    "mayor",
+    "mazorca",
+    "mecha",
+    "medalla",
+    "medio",
+    "médula",
+    "mejilla",
+    "mejor",
+    "melena",
+    "melón",
+    "memoria",
+    "menor",
+    "mensaje",
+    "mente",
+    "menú",
+    "mercado",
+    "merengue",
+    "mérito",
+    "mes",
+    "mesón",
+    "meta",
+    "meter",
+    "método",
+    "metro",
+    "mezcla",
+    "miedo",
+    "miel",
+    "miembro",
+    "miga",
+    "mil",
+    "milagro",
+    "militar",
+    "millón",
+    "mimo",
+    "mina",
+    "minero",
+    "mínimo",
+    "minuto",
+    "miope",
+    "mirar",
+    "misa",
+    "miseria",
+    "misil",
+    "mismo",
+    "mitad",
+    "mito",
+    "mochila",
+    "moción",
+    "moda",
+    "modelo",
+    "moho",
+    "mojar",
+    "molde",
+    "moler",
+    "molino",
+    "momento",
+    "momia",
+    "monarca",
+    "moneda",
+    "monja",
+    "monto",
+    "moño",
+    "morada",
+    "morder",
+    "moreno",
+    "morir",
+    "morro",
+    "morsa",
+    "mortal",
+    "mosca",
+    "mostrar",
+    "motivo",
+    "mover",
+    "móvil",
+    "mozo",
+    "mucho",
+    "mudar",
+    "mueble",
+    "muela",
+    "muerte",
+    "muestra",
+    "mugre",
+    "mujer",
+    "mula",
+    "muleta",
+    "multa",
+    "mundo",
+    "muñeca",
+    "mural",
+    "muro",
+    "músculo",
+    "museo",
+    "musgo",
+    "música",
+    "muslo",
+    "nácar",
+    "nación",
+    "nadar",
+    "naipe",
+    "naranja",
+    "nariz",
+    "narrar",
+    "nasal",
+    "natal",
+    "nativo",
+    "natural",
+    "náusea",
+    "naval",
+    "nave",
+    "navidad",
+    "necio",
+    "néctar",
+    "negar",
+    "negocio",
+    "negro",
+    "neón",
+    "nervio",
+    "neto",
+    "neutro",
+    "nevar",
+    "nevera",
+    "nicho",
+    "nido",
+    "niebla",
+    "nieto",
+    "niñez",
+    "niño",
+    "nítido",
+    "nivel",
+    "nobleza",
+    "noche",
+    "nómina",
+    "noria",
+    "norma",
+    "norte",
+    "nota",
+    "noticia",
+    "novato",
+    "novela",
+    "novio",
+    "nube",
+    "nuca",
+    "núcleo",
+    "nudillo",
+    "nudo",
+    "nuera",
+    "nueve",
+    "nuez",
+    "nulo",
+    "número",
+    "nutria",
+    "oasis",
+    "obeso",
+    "obispo",
+    "objeto",
+    "obra",
+    "obrero",
+    "observar",
+    "obtener",
+    "obvio",
+    "oca",
+    "ocaso",
+    "océano",
+    "ochenta",
+    "ocho",
+    "ocio",
+    "ocre",
+    "octavo",
+    "octubre",
+    "oculto",
+    "ocupar",
+    "ocurrir",
+    "odiar",
+    "odio",
+    "odisea",
+    "oeste",
+    "ofensa",
+    "oferta",
+    "oficio",
+    "ofrecer",
+    "ogro",
+    "oído",
+    "oír",
+    "ojo",
+    "ola",
+    "oleada",
+    "olfato",
+    "olivo",
+    "olla",
+    "olmo",
+    "olor",
+    "olvido",
+    "ombligo",
+    "onda",
+    "onza",
+    "opaco",
+    "opción",
+    "ópera",
+    "opinar",
+    "oponer",
+    "optar",
+    "óptica",
+    "opuesto",
+    "oración",
+    "orador",
+    "oral",
+    "órbita",
+    "orca",
+    "orden",
+    "oreja",
+    "órgano",
+    "orgía",
+    "orgullo",
+    "oriente",
+    "origen",
+    "orilla",
+    "oro",
+    "orquesta",
+    "oruga",
+    "osadía",
+    "oscuro",
+    "osezno",
+    "oso",
+    "ostra",
+    "otoño",
+    "otro",
+    "oveja",
+    "óvulo",
+    "óxido",
+    "oxígeno",
+    "oyente",
+    "ozono",
+    "pacto",
+    "padre",
+    "paella",
+    "página",
+    "pago",
+    "país",
+    "pájaro",
+    "palabra",
+    "palco",
+    "paleta",
+    "pálido",
+    "palma",
+    "paloma",
+    "palpar",
+    "pan",
+    "panal",
+    "pánico",
+    "pantera",
+    "pañuelo",
+    "papá",
+    "papel",
+    "papilla",
+    "paquete",
+    "parar",
+    "parcela",
+    "pared",
+    "parir",
+    "paro",
+    "párpado",
+    "parque",
+    "párrafo",
+    "parte",
+    "pasar",
+    "paseo",
+    "pasión",
+    "paso",
+    "pasta",
+    "pata",
+    "patio",
+    "patria",
+    "pausa",
+    "pauta",
+    "pavo",
+    "payaso",
+    "peatón",
+    "pecado",
+    "pecera",
+    "pecho",
+    "pedal",
+    "pedir",
+    "pegar",
+    "peine",
+    "pelar",
+    "peldaño",
+    "pelea",
+    "peligro",
+    "pellejo",
+    "pelo",
+    "peluca",
+    "pena",
+    "pensar",
+    "peñón",
+    "peón",
+    "peor",
+    "pepino",
+    "pequeño",
+    "pera",
+    "percha",
+    "perder",
+    "pereza",
+    "perfil",
+    "perico",
+    "perla",
+    "permiso",
+    "perro",
+    "persona",
+    "pesa",
+    "pesca",
+    "pésimo",
+    "pestaña",
+    "pétalo",
+    "petróleo",
+    "pez",
+    "pezuña",
+    "picar",
+    "pichón",
+    "pie",
+    "piedra",
+    "pierna",
+    "pieza",
+    "pijama",
+    "pilar",
+    "piloto",
+    "pimienta",
+    "pino",
+    "pintor",
+    "pinza",
+    "piña",
+    "piojo",
+    "pipa",
+    "pirata",
+    "pisar",
+    "piscina",
+    "piso",
+    "pista",
+    "pitón",
+    "pizca",
+    "placa",
+    "plan",
+    "plata",
+    "playa",
+    "plaza",
+    "pleito",
+    "pleno",
+    "plomo",
+    "pluma",
+    "plural",
+    "pobre",
+    "poco",
+    "poder",
+    "podio",
+    "poema",
+    "poesía",
+    "poeta",
+    "polen",
+    "policía",
+    "pollo",
+    "polvo",
+    "pomada",
+    "pomelo",
+    "pomo",
+    "pompa",
+    "poner",
+    "porción",
+    "portal",
+    "posada",
+    "poseer",
+    "posible",
+    "poste",
+    "potencia",
+    "potro",
+    "pozo",
+    "prado",
+    "precoz",
+    "pregunta",
+    "premio",
+    "prensa",
+    "preso",
+    "previo",
+    "primo",
+    "príncipe",
+    "prisión",
+    "privar",
+    "proa",
+    "probar",
+    "proceso",
+    "producto",
+    "proeza",
+    "profesor",
+    "programa",
+    "prole",
+    "promesa",
+    "pronto",
+    "propio",
+    "próximo",
+    "prueba",
+    "público",
+    "puchero",
+    "pudor",
+    "pueblo",
+    "puerta",
+    "puesto",
+    "pulga",
+    "pulir",
+    "pulmón",
+    "pulpo",
+    "pulso",
+    "puma",
+    "punto",
+    "puñal",
+    "puño",
+    "pupa",
+    "pupila",
+    "puré",
+    "quedar",
+    "queja",
+    "quemar",
+    "querer",
+    "queso",
+    "quieto",
+    "química",
+    "quince",
+    "quitar",
+    "rábano",
+    "rabia",
+    "rabo",
+    "ración",
+    "radical",
+    "raíz",
+    "rama",
+    "rampa",
+    "rancho",
+    "rango",
+    "rapaz",
+    "rápido",
+    "rapto",
+    "rasgo",
+    "raspa",
+    "rato",
+    "rayo",
+    "raza",
+    "razón",
+    "reacción",
+    "realidad",
+    "rebaño",
+    "rebote",
+    "recaer",
+    "receta",
+    "rechazo",
+    "recoger",
+    "recreo",
+    "recto",
+    "recurso",
+    "red",
+    "redondo",
+    "reducir",
+    "reflejo",
+    "reforma",
+    "refrán",
+    "refugio",
+    "regalo",
+    "regir",
+    "regla",
+    "regreso",
+    "rehén",
+    "reino",
+    "reír",
+    "reja",
+    "relato",
+    "relevo",
+    "relieve",
+    "relleno",
+    "reloj",
+    "remar",
+    "remedio",
+    "remo",
+    "rencor",
+    "rendir",
+    "renta",
+    "reparto",
+    "repetir",
+    "reposo",
+    "reptil",
+    "res",
+    "rescate",
+    "resina",
+    "respeto",
+    "resto",
+    "resumen",
+    "retiro",
+    "retorno",
+    "retrato",
+    "reunir",
+    "revés",
+    "revista",
+    "rey",
+    "rezar",
+    "rico",
+    "riego",
+    "rienda",
+    "riesgo",
+    "rifa",
+    "rígido",
+    "rigor",
+    "rincón",
+    "riñón",
+    "río",
+    "riqueza",
+    "risa",
+    "ritmo",
+    "rito",
+    "rizo",
+    "roble",
+    "roce",
+    "rociar",
+    "rodar",
+    "rodeo",
+    "rodilla",
+    "roer",
+    "rojizo",
+    "rojo",
+    "romero",
+    "romper",
+    "ron",
+    "ronco",
+    "ronda",
+    "ropa",
+    "ropero",
+    "rosa",
+    "rosca",
+    "rostro",
+    "rotar",
+    "rubí",
+    "rubor",
+    "rudo",
+    "rueda",
+    "rugir",
+    "ruido",
+    "ruina",
+    "ruleta",
+    "rulo",
+    "rumbo",
+    "rumor",
+    "ruptura",
+    "ruta",
+    "rutina",
+    "sábado",
+    "saber",
+    "sabio",
+    "sable",
+    "sacar",
+    "sagaz",
+    "sagrado",
+    "sala",
+    "saldo",
+    "salero",
+    "salir",
+    "salmón",
+    "salón",
+    "salsa",
+    "salto",
+    "salud",
+    "salvar",
+    "samba",
+    "sanción",
+    "sandía",
+    "sanear",
+    "sangre",
+    "sanidad",
+    "sano",
+    "santo",
+    "sapo",
+    "saque",
+    "sardina",
+    "sartén",
+    "sastre",
+    "satán",
+    "sauna",
+    "saxofón",
+    "sección",
+    "seco",
+    "secreto",
+    "secta",
+    "sed",
+    "seguir",
+    "seis",
+    "sello",
+    "selva",
+    "semana",
+    "semilla",
+    "senda",
+    "sensor",
+    "señal",
+    "señor",
+    "separar",
+    "sepia",
+    "sequía",
+    "ser",
+    "serie",
+    "sermón",
+    "servir",
+    "sesenta",
+    "sesión",
+    "seta",
+    "setenta",
+    "severo",
+    "sexo",
+    "sexto",
+    "sidra",
+    "siesta",
+    "siete",
+    "siglo",
+    "signo",
+    "sílaba",
+    "silbar",
+    "silencio",
+    "silla",
+    "símbolo",
+    "simio",
+    "sirena",
+    "sistema",
+    "sitio",
+    "situar",
+    "sobre",
+    "socio",
+    "sodio",
+    "sol",
+    "solapa",
+    "soldado",
+    "soledad",
+    "sólido",
+    "soltar",
+    "solución",
+    "sombra",
+    "sondeo",
+    "sonido",
+    "sonoro",
+    "sonrisa",
+    "sopa",
+    "soplar",
+    "soporte",
+    "sordo",
+    "sorpresa",
+    "sorteo",
+    "sostén",
+    "sótano",
+    "suave",
+    "subir",
+    "suceso",
+    "sudor",
+    "suegra",
+    "suelo",
+    "sueño",
+    "suerte",
+    "sufrir",
+    "sujeto",
+    "sultán",
+    "sumar",
+    "superar",
+    "suplir",
+    "suponer",
+    "supremo",
+    "sur",
+    "surco",
+    "sureño",
+    "surgir",
+    "susto",
+    "sutil",
+    "tabaco",
+    "tabique",
+    "tabla",
+    "tabú",
+    "taco",
+    "tacto",
+    "tajo",
+    "talar",
+    "talco",
+    "talento",
+    "talla",
+    "talón",
+    "tamaño",
+    "tambor",
+    "tango",
+    "tanque",
+    "tapa",
+    "tapete",
+    "tapia",
+    "tapón",
+    "taquilla",
+    "tarde",
+    "tarea",
+    "tarifa",
+    "tarjeta",
+    "tarot",
+    "tarro",
+    "tarta",
+    "tatuaje",
+    "tauro",
+    "taza",
+    "tazón",
+    "teatro",
+    "techo",
+    "tecla",
+    "técnica",
+    "tejado",
+    "tejer",
+    "tejido",
+    "tela",
+    "teléfono",
+    "tema",
+    "temor",
+    "templo",
+    "tenaz",
+    "tender",
+    "tener",
+    "tenis",
+    "tenso",
+    "teoría",
+    "terapia",
+    "terco",
+    "término",
+    "ternura",
+    "terror",
+    "tesis",
+    "tesoro",
+    "testigo",
+    "tetera",
+    "texto",
+    "tez",
+    "tibio",
+    "tiburón",
+    "tiempo",
+    "tienda",
+    "tierra",
+    "tieso",
+    "tigre",
+    "tijera",
+    "tilde",
+    "timbre",
+    "tímido",
+    "timo",
+    "tinta",
+    "tío",
+    "típico",
+    "tipo",
+    "tira",
+    "tirón",
+    "titán",
+    "títere",
+    "título",
+    "tiza",
+    "toalla",
+    "tobillo",
+    "tocar",
+    "tocino",
+    "todo",
+    "toga",
+    "toldo",
+    "tomar",
+    "tono",
+    "tonto",
+    "topar",
+    "tope",
+    "toque",
+    "tórax",
+    "torero",
+    "tormenta",
+    "torneo",
+    "toro",
+    "torpedo",
+    "torre",
+    "torso",
+    "tortuga",
+    "tos",
+    "tosco",
+    "toser",
+    "tóxico",
+    "trabajo",
+    "tractor",
+    "traer",
+    "tráfico",
+    "trago",
+    "traje",
+    "tramo",
+    "trance",
+    "trato",
+    "trauma",
+    "trazar",
+    "trébol",
+    "tregua",
+    "treinta",
+    "tren",
+    "trepar",
+    "tres",
+    "tribu",
+    "trigo",
+    "tripa",
+    "triste",
+    "triunfo",
+    "trofeo",
+    "trompa",
+    "tronco",
+    "tropa",
+    "trote",
+    "trozo",
+    "truco",
+    "trueno",
+    "trufa",
+    "tubería",
+    "tubo",
+    "tuerto",
+    "tumba",
+    "tumor",
+    "túnel",
+    "túnica",
+    "turbina",
+    "turismo",
+    "turno",
+    "tutor",
+    "ubicar",
+    "úlcera",
+    "umbral",
+    "unidad",
+    "unir",
+    "universo",
+    "uno",
+    "untar",
+    "uña",
+    "urbano",
+    "urbe",
+    "urgente",
+    "urna",
+    "usar",
+    "usuario",
+    "útil",
+    "utopía",
+    "uva",
+    "vaca",
+    "vacío",
+    "vacuna",
+    "vagar",
+    "vago",
+    "vaina",
+    "vajilla",
+    "vale",
+    "válido",
+    "valle",
+    "valor",
+    "válvula",
+    "vampiro",
+    "vara",
+    "variar",
+    "varón",
+    "vaso",
+    "vecino",
+    "vector",
+    "vehículo",
+    "veinte",
+    "vejez",
+    "vela",
+    "velero",
+    "veloz",
+    "vena",
+    "vencer",
+    "venda",
+    "veneno",
+    "vengar",
+    "venir",
+    "venta",
+    "venus",
+    "ver",
+    "verano",
+    "verbo",
+    "verde",
+    "vereda",
+    "verja",
+    "verso",
+    "verter",
+    "vía",
+    "viaje",
+    "vibrar",
+    "vicio",
+    "víctima",
+    "vida",
+    "vídeo",
+    "vidrio",
+    "viejo",
+    "viernes",
+    "vigor",
+    "vil",
+    "villa",
+    "vinagre",
+    "vino",
+    "viñedo",
+    "violín",
+    "viral",
+    "virgo",
+    "virtud",
+    "visor",
+    "víspera",
+    "vista",
+    "vitamina",
+    "viudo",
+    "vivaz",
+    "vivero",
+    "vivir",
+    "vivo",
+    "volcán",
+    "volumen",
+    "volver",
+    "voraz",
+    "votar",
+    "voto",
+    "voz",
+    "vuelo",
+    "vulgar",
+    "yacer",
+    "yate",
+    "yegua",
+    "yema",
+    "yerno",
+    "yeso",
+    "yodo",
+    "yoga",
+    "yogur",
+    "zafiro",
+    "zanja",
+    "zapato",
+    "zarza",
+    "zona",
+    "zorro",
+    "zumo",
+    "zurdo",
+}
+var rwords_spanish = map[string]int16{
+    "ábaco": 0,
+    "abdomen": 1,
+    "abeja": 2,
+    "abierto": 3,
+    "abogado": 4,
+    "abono": 5,
+    "aborto": 6,
+    "abrazo": 7,
+    "abrir": 8,
+    "abuelo": 9,
+    "abuso": 10,
+    "acabar": 11,
+    "academia": 12,
+    "acceso": 13,
+    "acción": 14,
+    "aceite": 15,
+    "acelga": 16,
+    "acento": 17,
+    "aceptar": 18,
+    "ácido": 19,
+    "aclarar": 20,
+    "acné": 21,
+    "acoger": 22,
+    "acoso": 23,
+    "activo": 24,
+    "acto": 25,
+    "actriz": 26,
+    "actuar": 27,
+    "acudir": 28,
+    "acuerdo": 29,
+    "acusar": 30,
+    "adicto": 31,
+    "admitir": 32,
+    "adoptar": 33,
+    "adorno": 34,
+    "aduana": 35,
+    "adulto": 36,
+    "aéreo": 37,
+    "afectar": 38,
+    "afición": 39,
+    "afinar": 40,
+    "afirmar": 41,
+    "ágil": 42,
+    "agitar": 43,
+    "agonía": 44,
+    "agosto": 45,
+    "agotar": 46,
+    "agregar": 47,
+    "agrio": 48,
+    "agua": 49,
+    "agudo": 50,
+    "águila": 51,
+    "aguja": 52,
+    "ahogo": 53,
+    "ahorro": 54,
+    "aire": 55,
+    "aislar": 56,
+    "ajedrez": 57,
+    "ajeno": 58,
+    "ajuste": 59,
+    "alacrán": 60,
+    "alambre": 61,
+    "alarma": 62,
+    "alba": 63,
+    "álbum": 64,
+    "alcalde": 65,
+    "aldea": 66,
+    "alegre": 67,
+    "alejar": 68,
+    "alerta": 69,
+    "aleta": 70,
+    "alfiler": 71,
+    "alga": 72,
+    "algodón": 73,
+    "aliado": 74,
+    "aliento": 75,
+    "alivio": 76,
+    "alma": 77,
+    "almeja": 78,
+    "almíbar": 79,
+    "altar": 80,
+    "alteza": 81,
+    "altivo": 82,
+    "alto": 83,
+    "altura": 84,
+    "alumno": 85,
+    "alzar": 86,
+    "amable": 87,
+    "amante": 88,
+    "amapola": 89,
+    "amargo": 90,
+    "amasar": 91,
+    "ámbar": 92,
+    "ámbito": 93,
+    "ameno": 94,
+    "amigo": 95,
+    "amistad": 96,
+    "amor": 97,
+    "amparo": 98,
+    "amplio": 99,
+    "ancho": 100,
+    "anciano": 101,
+    "ancla": 102,
+    "andar": 103,
+    "andén": 104,
+    "anemia": 105,
+    "ángulo": 106,
+    "anillo": 107,
+    "ánimo": 108,
+    "anís": 109,
+    "anotar": 110,
+    "antena": 111,
+    "antiguo": 112,
+    "antojo": 113,
+    "anual": 114,
+    "anular": 115,
+    "anuncio": 116,
+    "añadir": 117,
+    "añejo": 118,
+    "año": 119,
+    "apagar": 120,
+    "aparato": 121,
+    "apetito": 122,
+    "apio": 123,
+    "aplicar": 124,
+    "apodo": 125,
+    "aporte": 126,
+    "apoyo": 127,
+    "aprender": 128,
+    "aprobar": 129,
+    "apuesta": 130,
+    "apuro": 131,
+    "arado": 132,
+    "araña": 133,
+    "arar": 134,
+    "árbitro": 135,
+    "árbol": 136,
+    "arbusto": 137,
+    "archivo": 138,
+    "arco": 139,
+    "arder": 140,
+    "ardilla": 141,
+    "arduo": 142,
+    "área": 143,
+    "árido": 144,
+    "aries": 145,
+    "armonía": 146,
+    "arnés": 147,
+    "aroma": 148,
+    "arpa": 149,
+    "arpón": 150,
+    "arreglo": 151,
+    "arroz": 152,
+    "arruga": 153,
+    "arte": 154,
+    "artista": 155,
+    "asa": 156,
+    "asado": 157,
+    "asalto": 158,
+    "ascenso": 159,
+    "asegurar": 160,
+    "aseo": 161,
+    "asesor": 162,
+    "asiento": 163,
+    "asilo": 164,
+    "asistir": 165,
+    "asno": 166,
+    "asombro": 167,
+    "áspero": 168,
+    "astilla": 169,
+    "astro": 170,
+    "astuto": 171,
+    "asumir": 172,
+    "asunto": 173,
+    "atajo": 174,
+    "ataque": 175,
+    "atar": 176,
+    "atento": 177,
+    "ateo": 178,
+    "ático": 179,
+    "atleta": 180,
+    "átomo": 181,
+    "atraer": 182,
+    "atroz": 183,
+    "atún": 184,
+    "audaz": 185,
+    "audio": 186,
+    "auge": 187,
+    "aula": 188,
+    "aumento": 189,
+    "ausente": 190,
+    "autor": 191,
+    "aval": 192,
+    "avance": 193,
+    "avaro": 194,
+    "ave": 195,
+    "avellana": 196,
+    "avena": 197,
+    "avestruz": 198,
+    "avión": 199,
+    "aviso": 200,
+    "ayer": 201,
+    "ayuda": 202,
+    "ayuno": 203,
+    "azafrán": 204,
+    "azar": 205,
+    "azote": 206,
+    "azúcar": 207,
+    "azufre": 208,
+    "azul": 209,
+    "baba": 210,
+    "babor": 211,
+    "bache": 212,
+    "bahía": 213,
+    "baile": 214,
+    "bajar": 215,
+    "balanza": 216,
+    "balcón": 217,
+    "balde": 218,
+    "bambú": 219,
+    "banco": 220,
+    "banda": 221,
+    "baño": 222,
+    "barba": 223,
+    "barco": 224,
+    "barniz": 225,
+    "barro": 226,
+    "báscula": 227,
+    "bastón": 228,
+    "basura": 229,
+    "batalla": 230,
+    "batería": 231,
+    "batir": 232,
+    "batuta": 233,
+    "baúl": 234,
+    "bazar": 235,
+    "bebé": 236,
+    "bebida": 237,
+    "bello": 238,
+    "besar": 239,
+    "beso": 240,
+    "bestia": 241,
+    "bicho": 242,
+    "bien": 243,
+    "bingo": 244,
+    "blanco": 245,
+    "bloque": 246,
+    "blusa": 247,
+    "boa": 248,
+    "bobina": 249,
+    "bobo": 250,
+    "boca": 251,
+    "bocina": 252,
+    "boda": 253,
+    "bodega": 254,
+    "boina": 255,
+    "bola": 256,
+    "bolero": 257,
+    "bolsa": 258,
+    "bomba": 259,
+    "bondad": 260,
+    "bonito": 261,
+    "bono": 262,
+    "bonsái": 263,
+    "borde": 264,
+    "borrar": 265,
+    "bosque": 266,
+    "bote": 267,
+    "botín": 268,
+    "bóveda": 269,
+    "bozal": 270,
+    "bravo": 271,
+    "brazo": 272,
+    "brecha": 273,
+    "breve": 274,
+    "brillo": 275,
+    "brinco": 276,
+    "brisa": 277,
+    "broca": 278,
+    "broma": 279,
+    "bronce": 280,
+    "brote": 281,
+    "bruja": 282,
+    "brusco": 283,
+    "bruto": 284,
+    "buceo": 285,
+    "bucle": 286,
+    "bueno": 287,
+    "buey": 288,
+    "bufanda": 289,
+    "bufón": 290,
+    "búho": 291,
+    "buitre": 292,
+    "bulto": 293,
+    "burbuja": 294,
+    "burla": 295,
+    "burro": 296,
+    "buscar": 297,
+    "butaca": 298,
+    "buzón": 299,
+    "caballo": 300,
+    "cabeza": 301,
+    "cabina": 302,
+    "cabra": 303,
+    "cacao": 304,
+    "cadáver": 305,
+    "cadena": 306,
+    "caer": 307,
+    "café": 308,
+    "caída": 309,
+    "caimán": 310,
+    "caja": 311,
+    "cajón": 312,
+    "cal": 313,
+    "calamar": 314,
+    "calcio": 315,
+    "caldo": 316,
+    "calidad": 317,
+    "calle": 318,
+    "calma": 319,
+    "calor": 320,
+    "calvo": 321,
+    "cama": 322,
+    "cambio": 323,
+    "camello": 324,
+    "camino": 325,
+    "campo": 326,
+    "cáncer": 327,
+    "candil": 328,
+    "canela": 329,
+    "canguro": 330,
+    "canica": 331,
+    "canto": 332,
+    "caña": 333,
+    "cañón": 334,
+    "caoba": 335,
+    "caos": 336,
+    "capaz": 337,
+    "capitán": 338,
+    "capote": 339,
+    "captar": 340,
+    "capucha": 341,
+    "cara": 342,
+    "carbón": 343,
+    "cárcel": 344,
+    "careta": 345,
+    "carga": 346,
+    "cariño": 347,
+    "carne": 348,
+    "carpeta": 349,
+    "carro": 350,
+    "carta": 351,
+    "casa": 352,
+    "casco": 353,
+    "casero": 354,
+    "caspa": 355,
+    "castor": 356,
+    "catorce": 357,
+    "catre": 358,
+    "caudal": 359,
+    "causa": 360,
+    "cazo": 361,
+    "cebolla": 362,
+    "ceder": 363,
+    "cedro": 364,
+    "celda": 365,
+    "célebre": 366,
+    "celoso": 367,
+    "célula": 368,
+    "cemento": 369,
+    "ceniza": 370,
+    "centro": 371,
+    "cerca": 372,
+    "cerdo": 373,
+    "cereza": 374,
+    "cero": 375,
+    "cerrar": 376,
+    "certeza": 377,
+    "césped": 378,
+    "cetro": 379,
+    "chacal": 380,
+    "chaleco": 381,
+    "champú": 382,
+    "chancla": 383,
+    "chapa": 384,
+    "charla": 385,
+    "chico": 386,
+    "chiste": 387,
+    "chivo": 388,
+    "choque": 389,
+    "choza": 390,
+    "chuleta": 391,
+    "chupar": 392,
+    "ciclón": 393,
+    "ciego": 394,
+    "cielo": 395,
+    "cien": 396,
+    "cierto": 397,
+    "cifra": 398,
+    "cigarro": 399,
+    "cima": 400,
+    "cinco": 401,
+    "cine": 402,
+    "cinta": 403,
+    "ciprés": 404,
+    "circo": 405,
+    "ciruela": 406,
+    "cisne": 407,
+    "cita": 408,
+    "ciudad": 409,
+    "clamor": 410,
+    "clan": 411,
+    "claro": 412,
+    "clase": 413,
+    "clave": 414,
+    "cliente": 415,
+    "clima": 416,
+    "clínica": 417,
+    "cobre": 418,
+    "cocción": 419,
+    "cochino": 420,
+    "cocina": 421,
+    "coco": 422,
+    "código": 423,
+    "codo": 424,
+    "cofre": 425,
+    "coger": 426,
+    "cohete": 427,
+    "cojín": 428,
+    "cojo": 429,
+    "cola": 430,
+    "colcha": 431,
+    "colegio": 432,
+    "colgar": 433,
+    "colina": 434,
+    "collar": 435,
+    "colmo": 436,
+    "columna": 437,
+    "combate": 438,
+    "comer": 439,
+    "comida": 440,
+    "cómodo": 441,
+    "compra": 442,
+    "conde": 443,
+    "conejo": 444,
+    "conga": 445,
+    "conocer": 446,
+    "consejo": 447,
+    "contar": 448,
+    "copa": 449,
+    "copia": 450,
+    "corazón": 451,
+    "corbata": 452,
+    "corcho": 453,
+    "cordón": 454,
+    "corona": 455,
+    "correr": 456,
+    "coser": 457,
+    "cosmos": 458,
+    "costa": 459,
+    "cráneo": 460,
+    "cráter": 461,
+    "crear": 462,
+    "crecer": 463,
+    "creído": 464,
+    "crema": 465,
+    "cría": 466,
+    "crimen": 467,
+    "cripta": 468,
+    "crisis": 469,
+    "cromo": 470,
+    "crónica": 471,
+    "croqueta": 472,
+    "crudo": 473,
+    "cruz": 474,
+    "cuadro": 475,
+    "cuarto": 476,
+    "cuatro": 477,
+    "cubo": 478,
+    "cubrir": 479,
+    "cuchara": 480,
+    "cuello": 481,
+    "cuento": 482,
+    "cuerda": 483,
+    "cuesta": 484,
+    "cueva": 485,
+    "cuidar": 486,
+    "culebra": 487,
+    "culpa": 488,
+    "culto": 489,
+    "cumbre": 490,
+    "cumplir": 491,
+    "cuna": 492,
+    "cuneta": 493,
+    "cuota": 494,
+    "cupón": 495,
+    "cúpula": 496,
+    "curar": 497,
+    "curioso": 498,
+    "curso": 499,
+    "curva": 500,
+    "cutis": 501,
+    "dama": 502,
+    "danza": 503,
+    "dar": 504,
+    "dardo": 505,
+    "dátil": 506,
+    "deber": 507,
+    "débil": 508,
+    "década": 509,
+    "decir": 510,
+    "dedo": 511,
+    "defensa": 512,
+    "definir": 513,
+    "dejar": 514,
+    "delfín": 515,
+    "delgado": 516,
+    "delito": 517,
+    "demora": 518,
+    "denso": 519,
+    "dental": 520,
+    "deporte": 521,
+    "derecho": 522,
+    "derrota": 523,
+    "desayuno": 524,
+    "deseo": 525,
+    "desfile": 526,
+    "desnudo": 527,
+    "destino": 528,
+    "desvío": 529,
+    "detalle": 530,
+    "detener": 531,
+    "deuda": 532,
+    "día": 533,
+    "diablo": 534,
+    "diadema": 535,
+    "diamante": 536,
+    "diana": 537,
+    "diario": 538,
+    "dibujo": 539,
+    "dictar": 540,
+    "diente": 541,
+    "dieta": 542,
+    "diez": 543,
+    "difícil": 544,
+    "digno": 545,
+    "dilema": 546,
+    "diluir": 547,
+    "dinero": 548,
+    "directo": 549,
+    "dirigir": 550,
+    "disco": 551,
+    "diseño": 552,
+    "disfraz": 553,
+    "diva": 554,
+    "divino": 555,
+    "doble": 556,
+    "doce": 557,
+    "dolor": 558,
+    "domingo": 559,
+    "don": 560,
+    "donar": 561,
+    "dorado": 562,
+    "dormir": 563,
+    "dorso": 564,
+    "dos": 565,
+    "dosis": 566,
+    "dragón": 567,
+    "droga": 568,
+    "ducha": 569,
+    "duda": 570,
+    "duelo": 571,
+    "dueño": 572,
+    "dulce": 573,
+    "dúo": 574,
+    "duque": 575,
+    "durar": 576,
+    "dureza": 577,
+    "duro": 578,
+    "ébano": 579,
+    "ebrio": 580,
+    "echar": 581,
+    "eco": 582,
+    "ecuador": 583,
+    "edad": 584,
+    "edición": 585,
+    "edificio": 586,
+    "editor": 587,
+    "educar": 588,
+    "efecto": 589,
+    "eficaz": 590,
+    "eje": 591,
+    "ejemplo": 592,
+    "elefante": 593,
+    "elegir": 594,
+    "elemento": 595,
+    "elevar": 596,
+    "elipse": 597,
+    "élite": 598,
+    "elixir": 599,
+    "elogio": 600,
+    "eludir": 601,
+    "embudo": 602,
+    "emitir": 603,
+    "emoción": 604,
+    "empate": 605,
+    "empeño": 606,
+    "empleo": 607,
+    "empresa": 608,
+    "enano": 609,
+    "encargo": 610,
+    "enchufe": 611,
+    "encía": 612,
+    "enemigo": 613,
+    "enero": 614,
+    "enfado": 615,
+    "enfermo": 616,
+    "engaño": 617,
+    "enigma": 618,
+    "enlace": 619,
+    "enorme": 620,
+    "enredo": 621,
+    "ensayo": 622,
+    "enseñar": 623,
+    "entero": 624,
+    "entrar": 625,
+    "envase": 626,
+    "envío": 627,
+    "época": 628,
+    "equipo": 629,
+    "erizo": 630,
+    "escala": 631,
+    "escena": 632,
+    "escolar": 633,
+    "escribir": 634,
+    "escudo": 635,
+    "esencia": 636,
+    "esfera": 637,
+    "esfuerzo": 638,
+    "espada": 639,
+    "espejo": 640,
+    "espía": 641,
+    "esposa": 642,
+    "espuma": 643,
+    "esquí": 644,
+    "estar": 645,
+    "este": 646,
+    "estilo": 647,
+    "estufa": 648,
+    "etapa": 649,
+    "eterno": 650,
+    "ética": 651,
+    "etnia": 652,
+    "evadir": 653,
+    "evaluar": 654,
+    "evento": 655,
+    "evitar": 656,
+    "exacto": 657,
+    "examen": 658,
+    "exceso": 659,
+    "excusa": 660,
+    "exento": 661,
+    "exigir": 662,
+    "exilio": 663,
+    "existir": 664,
+    "éxito": 665,
+    "experto": 666,
+    "explicar": 667,
+    "exponer": 668,
+    "extremo": 669,
+    "fábrica": 670,
+    "fábula": 671,
+    "fachada": 672,
+    "fácil": 673,
+    "factor": 674,
+    "faena": 675,
+    "faja": 676,
+    "falda": 677,
+    "fallo": 678,
+    "falso": 679,
+    "faltar": 680,
+    "fama": 681,
+    "familia": 682,
+    "famoso": 683,
+    "faraón": 684,
+    "farmacia": 685,
+    "farol": 686,
+    "farsa": 687,
+    "fase": 688,
+    "fatiga": 689,
+    "fauna": 690,
+    "favor": 691,
+    "fax": 692,
+    "febrero": 693,
+    "fecha": 694,
+    "feliz": 695,
+    "feo": 696,
+    "feria": 697,
+    "feroz": 698,
+    "fértil": 699,
+    "fervor": 700,
+    "festín": 701,
+    "fiable": 702,
+    "fianza": 703,
+    "fiar": 704,
+    "fibra": 705,
+    "ficción": 706,
+    "ficha": 707,
+    "fideo": 708,
+    "fiebre": 709,
+    "fiel": 710,
+    "fiera": 711,
+    "fiesta": 712,
+    "figura": 713,
+    "fijar": 714,
+    "fijo": 715,
+    "fila": 716,
+    "filete": 717,
+    "filial": 718,
+    "filtro": 719,
+    "fin": 720,
+    "finca": 721,
+    "fingir": 722,
+    "finito": 723,
+    "firma": 724,
+    "flaco": 725,
+    "flauta": 726,
+    "flecha": 727,
+    "flor": 728,
+    "flota": 729,
+    "fluir": 730,
+    "flujo": 731,
+    "flúor": 732,
+    "fobia": 733,
+    "foca": 734,
+    "fogata": 735,
+    "fogón": 736,
+    "folio": 737,
+    "folleto": 738,
+    "fondo": 739,
+    "forma": 740,
+    "forro": 741,
+    "fortuna": 742,
+    "forzar": 743,
+    "fosa": 744,
+    "foto": 745,
+    "fracaso": 746,
+    "frágil": 747,
+    "franja": 748,
+    "frase": 749,
+    "fraude": 750,
+    "freír": 751,
+    "freno": 752,
+    "fresa": 753,
+    "frío": 754,
+    "frito": 755,
+    "fruta": 756,
+    "fuego": 757,
+    "fuente": 758,
+    "fuerza": 759,
+    "fuga": 760,
+    "fumar": 761,
+    "función": 762,
+    "funda": 763,
+    "furgón": 764,
+    "furia": 765,
+    "fusil": 766,
+    "fútbol": 767,
+    "futuro": 768,
+    "gacela": 769,
+    "gafas": 770,
+    "gaita": 771,
+    "gajo": 772,
+    "gala": 773,
+    "galería": 774,
+    "gallo": 775,
+    "gamba": 776,
+    "ganar": 777,
+    "gancho": 778,
+    "ganga": 779,
+    "ganso": 780,
+    "garaje": 781,
+    "garza": 782,
+    "gasolina": 783,
+    "gastar": 784,
+    "gato": 785,
+    "gavilán": 786,
+    "gemelo": 787,
+    "gemir": 788,
+    "gen": 789,
+    "género": 790,
+    "genio": 791,
+    "gente": 792,
+    "geranio": 793,
+    "gerente": 794,
+    "germen": 795,
+    "gesto": 796,
+    "gigante": 797,
+    "gimnasio": 798,
+    "girar": 799,
+    "giro": 800,
+    "glaciar": 801,
+    "globo": 802,
+    "gloria": 803,
+    "gol": 804,
+    "golfo": 805,
+    "goloso": 806,
+    "golpe": 807,
+    "goma": 808,
+    "gordo": 809,
+    "gorila": 810,
+    "gorra": 811,
+    "gota": 812,
+    "goteo": 813,
+    "gozar": 814,
+    "grada": 815,
+    "gráfico": 816,
+    "grano": 817,
+    "grasa": 818,
+    "gratis": 819,
+    "grave": 820,
+    "grieta": 821,
+    "grillo": 822,
+    "gripe": 823,
+    "gris": 824,
+    "grito": 825,
+    "grosor": 826,
+    "grúa": 827,
+    "grueso": 828,
+    "grumo": 829,
+    "grupo": 830,
+    "guante": 831,
+    "guapo": 832,
+    "guardia": 833,
+    "guerra": 834,
+    "guía": 835,
+    "guiño": 836,
+    "guion": 837,
+    "guiso": 838,
+    "guitarra": 839,
+    "gusano": 840,
+    "gustar": 841,
+    "haber": 842,
+    "hábil": 843,
+    "hablar": 844,
+    "hacer": 845,
+    "hacha": 846,
+    "hada": 847,
+    "hallar": 848,
+    "hamaca": 849,
+    "harina": 850,
+    "haz": 851,
+    "hazaña": 852,
+    "hebilla": 853,
+    "hebra": 854,
+    "hecho": 855,
+    "helado": 856,
+    "helio": 857,
+    "hembra": 858,
+    "herir": 859,
+    "hermano": 860,
+    "héroe": 861,
+    "hervir": 862,
+    "hielo": 863,
+    "hierro": 864,
+    "hígado": 865,
+    "higiene": 866,
+    "hijo": 867,
+    "himno": 868,
+    "historia": 869,
+    "hocico": 870,
+    "hogar": 871,
+    "hoguera": 872,
+    "hoja": 873,
+    "hombre": 874,
+    "hongo": 875,
+    "honor": 876,
+    "honra": 877,
+    "hora": 878,
+    "hormiga": 879,
+    "horno": 880,
+    "hostil": 881,
+    "hoyo": 882,
+    "hueco": 883,
+    "huelga": 884,
+    "huerta": 885,
+    "hueso": 886,
+    "huevo": 887,
+    "huida": 888,
+    "huir": 889,
+    "humano": 890,
+    "húmedo": 891,
+    "humilde": 892,
+    "humo": 893,
+    "hundir": 894,
+    "huracán": 895,
+    "hurto": 896,
+    "icono": 897,
+    "ideal": 898,
+    "idioma": 899,
+    "ídolo": 900,
+    "iglesia": 901,
+    "iglú": 902,
+    "igual": 903,
+    "ilegal": 904,
+    "ilusión": 905,
+    "imagen": 906,
+    "imán": 907,
+    "imitar": 908,
+    "impar": 909,
+    "imperio": 910,
+    "imponer": 911,
+    "impulso": 912,
+    "incapaz": 913,
+    "índice": 914,
+    "inerte": 915,
+    "infiel": 916,
+    "informe": 917,
+    "ingenio": 918,
+    "inicio": 919,
+    "inmenso": 920,
+    "inmune": 921,
+    "innato": 922,
+    "insecto": 923,
+    "instante": 924,
+    "interés": 925,
+    "íntimo": 926,
+    "intuir": 927,
+    "inútil": 928,
+    "invierno": 929,
+    "ira": 930,
+    "iris": 931,
+    "ironía": 932,
+    "isla": 933,
+    "islote": 934,
+    "jabalí": 935,
+    "jabón": 936,
+    "jamón": 937,
+    "jarabe": 938,
+    "jardín": 939,
+    "jarra": 940,
+    "jaula": 941,
+    "jazmín": 942,
+    "jefe": 943,
+    "jeringa": 944,
+    "jinete": 945,
+    "jornada": 946,
+    "joroba": 947,
+    "joven": 948,
+    "joya": 949,
+    "juerga": 950,
+    "jueves": 951,
+    "juez": 952,
+    "jugador": 953,
+    "jugo": 954,
+    "juguete": 955,
+    "juicio": 956,
+    "junco": 957,
+    "jungla": 958,
+    "junio": 959,
+    "juntar": 960,
+    "júpiter": 961,
+    "jurar": 962,
+    "justo": 963,
+    "juvenil": 964,
+    "juzgar": 965,
+    "kilo": 966,
+    "koala": 967,
+    "labio": 968,
+    "lacio": 969,
+    "lacra": 970,
+    "lado": 971,
+    "ladrón": 972,
+    "lagarto": 973,
+    "lágrima": 974,
+    "laguna": 975,
+    "laico": 976,
+    "lamer": 977,
+    "lámina": 978,
+    "lámpara": 979,
+    "lana": 980,
+    "lancha": 981,
+    "langosta": 982,
+    "lanza": 983,
+    "lápiz": 984,
+    "largo": 985,
+    "larva": 986,
+    "lástima": 987,
+    "lata": 988,
+    "látex": 989,
+    "latir": 990,
+    "laurel": 991,
+    "lavar": 992,
+    "lazo": 993,
+    "leal": 994,
+    "lección": 995,
+    "leche": 996,
+    "lector": 997,
+    "leer": 998,
+    "legión": 999,
+    "legumbre": 1000,
+    "lejano": 1001,
+    "lengua": 1002,
+    "lento": 1003,
+    "leña": 1004,
+    "león": 1005,
+    "leopardo": 1006,
+    "lesión": 1007,
+    "letal": 1008,
+    "letra": 1009,
+    "leve": 1010,
+    "leyenda": 1011,
+    "libertad": 1012,
+    "libro": 1013,
+    "licor": 1014,
+    "líder": 1015,
+    "lidiar": 1016,
+    "lienzo": 1017,
+    "liga": 1018,
+    "ligero": 1019,
+    "lima": 1020,
+    "límite": 1021,
+    "limón": 1022,
+    "limpio": 1023,
+    "lince": 1024,
+    "lindo": 1025,
+    "línea": 1026,
+    "lingote": 1027,
+    "lino": 1028,
+    "linterna": 1029,
+    "líquido": 1030,
+    "liso": 1031,
+    "lista": 1032,
+    "litera": 1033,
+    "litio": 1034,
+    "litro": 1035,
+    "llaga": 1036,
+    "llama": 1037,
+    "llanto": 1038,
+    "llave": 1039,
+    "llegar": 1040,
+    "llenar": 1041,
+    "llevar": 1042,
+    "llorar": 1043,
+    "llover": 1044,
+    "lluvia": 1045,
+    "lobo": 1046,
+    "loción": 1047,
+    "loco": 1048,
+    "locura": 1049,
+    "lógica": 1050,
+    "logro": 1051,
+    "lombriz": 1052,
+    "lomo": 1053,
+    "lonja": 1054,
+    "lote": 1055,
+    "lucha": 1056,
+    "lucir": 1057,
+    "lugar": 1058,
+    "lujo": 1059,
+    "luna": 1060,
+    "lunes": 1061,
+    "lupa": 1062,
+    "lustro": 1063,
+    "luto": 1064,
+    "luz": 1065,
+    "maceta": 1066,
+    "macho": 1067,
+    "madera": 1068,
+    "madre": 1069,
+    "maduro": 1070,
+    "maestro": 1071,
+    "mafia": 1072,
+    "magia": 1073,
+    "mago": 1074,
+    "maíz": 1075,
+    "maldad": 1076,
+    "maleta": 1077,
+    "malla": 1078,
+    "malo": 1079,
+    "mamá": 1080,
+    "mambo": 1081,
+    "mamut": 1082,
+    "manco": 1083,
+    "mando": 1084,
+    "manejar": 1085,
+    "manga": 1086,
+    "maniquí": 1087,
+    "manjar": 1088,
+    "mano": 1089,
+    "manso": 1090,
+    "manta": 1091,
+    "mañana": 1092,
+    "mapa": 1093,
+    "máquina": 1094,
+    "mar": 1095,
+    "marco": 1096,
+    "marea": 1097,
+    "marfil": 1098,
+    "margen": 1099,
+    "marido": 1100,
+    "mármol": 1101,
+    "marrón": 1102,
+    "martes": 1103,
+    "marzo": 1104,
+    "masa": 1105,
+    "máscara": 1106,
+    "masivo": 1107,
+    "matar": 1108,
+    "materia": 1109,
+    "matiz": 1110,
+    "matriz": 1111,
+    "máximo": 1112,
+    "mayor": 1113,
+    "mazorca": 1114,
+    "mecha": 1115,
+    "medalla": 1116,
+    "medio": 1117,
+    "médula": 1118,
+    "mejilla": 1119,
+    "mejor": 1120,
+    "melena": 1121,
+    "melón": 1122,
+    "memoria": 1123,
+    "menor": 1124,
+    "mensaje": 1125,
+    "mente": 1126,
+    "menú": 1127,
+    "mercado": 1128,
+    "merengue": 1129,
+    "mérito": 1130,
+    "mes": 1131,
+    "mesón": 1132,
+    "meta": 1133,
+    "meter": 1134,
+    "método": 1135,
+    "metro": 1136,
+    "mezcla": 1137,
+    "miedo": 1138,
+    "miel": 1139,
+    "miembro": 1140,
+    "miga": 1141,
+    "mil": 1142,
+    "milagro": 1143,
+    "militar": 1144,
+    "millón": 1145,
+    "mimo": 1146,
+    "mina": 1147,
+    "minero": 1148,
+    "mínimo": 1149,
+    "minuto": 1150,
+    "miope": 1151,
+    "mirar": 1152,
+    "misa": 1153,
+    "miseria": 1154,
+    "misil": 1155,
+    "mismo": 1156,
+    "mitad": 1157,
+    "mito": 1158,
+    "mochila": 1159,
+    "moción": 1160,
+    "moda": 1161,
+    "modelo": 1162,
+    "moho": 1163,
+    "mojar": 1164,
+    "molde": 1165,
+    "moler": 1166,
+    "molino": 1167,
+    "momento": 1168,
+    "momia": 1169,
+    "monarca": 1170,
+    "moneda": 1171,
+    "monja": 1172,
+    "monto": 1173,
+    "moño": 1174,
+    "morada": 1175,
+    "morder": 1176,
+    "moreno": 1177,
+    "morir": 1178,
+    "morro": 1179,
+    "morsa": 1180,
+    "mortal": 1181,
+    "mosca": 1182,
+    "mostrar": 1183,
+    "motivo": 1184,
+    "mover": 1185,
+    "móvil": 1186,
+    "mozo": 1187,
+    "mucho": 1188,
+    "mudar": 1189,
+    "mueble": 1190,
+    "muela": 1191,
+    "muerte": 1192,
+    "muestra": 1193,
+    "mugre": 1194,
+    "mujer": 1195,
+    "mula": 1196,
+    "muleta": 1197,
+    "multa": 1198,
+    "mundo": 1199,
+    "muñeca": 1200,
+    "mural": 1201,
+    "muro": 1202,
+    "músculo": 1203,
+    "museo": 1204,
+    "musgo": 1205,
+    "música": 1206,
+    "muslo": 1207,
+    "nácar": 1208,
+    "nación": 1209,
+    "nadar": 1210,
+    "naipe": 1211,
+    "naranja": 1212,
+    "nariz": 1213,
+    "narrar": 1214,
+    "nasal": 1215,
+    "natal": 1216,
+    "nativo": 1217,
+    "natural": 1218,
+    "náusea": 1219,
+    "naval": 1220,
+    "nave": 1221,
+    "navidad": 1222,
+    "necio": 1223,
+    "néctar": 1224,
+    "negar": 1225,
+    "negocio": 1226,
+    "negro": 1227,
+    "neón": 1228,
+    "nervio": 1229,
+    "neto": 1230,
+    "neutro": 1231,
+    "nevar": 1232,
+    "nevera": 1233,
+    "nicho": 1234,
+    "nido": 1235,
+    "niebla": 1236,
+    "nieto": 1237,
+    "niñez": 1238,
+    "niño": 1239,
+    "nítido": 1240,
+    "nivel": 1241,
+    "nobleza": 1242,
+    "noche": 1243,
+    "nómina": 1244,
+    "noria": 1245,
+    "norma": 1246,
+    "norte": 1247,
+    "nota": 1248,
+    "noticia": 1249,
+    "novato": 1250,
+    "novela": 1251,
+    "novio": 1252,
+    "nube": 1253,
+    "nuca": 1254,
+    "núcleo": 1255,
+    "nudillo": 1256,
+    "nudo": 1257,
+    "nuera": 1258,
+    "nueve": 1259,
+    "nuez": 1260,
+    "nulo": 1261,
+    "número": 1262,
+    "nutria": 1263,
+    "oasis": 1264,
+    "obeso": 1265,
+    "obispo": 1266,
+    "objeto": 1267,
+    "obra": 1268,
+    "obrero": 1269,
+    "observar": 1270,
+    "obtener": 1271,
+    "obvio": 1272,
+    "oca": 1273,
+    "ocaso": 1274,
+    "océano": 1275,
+    "ochenta": 1276,
+    "ocho": 1277,
+    "ocio": 1278,
+    "ocre": 1279,
+    "octavo": 1280,
+    "octubre": 1281,
+    "oculto": 1282,
+    "ocupar": 1283,
+    "ocurrir": 1284,
+    "odiar": 1285,
+    "odio": 1286,
+    "odisea": 1287,
+    "oeste": 1288,
+    "ofensa": 1289,
+    "oferta": 1290,
+    "oficio": 1291,
+    "ofrecer": 1292,
+    "ogro": 1293,
+    "oído": 1294,
+    "oír": 1295,
+    "ojo": 1296,
+    "ola": 1297,
+    "oleada": 1298,
+    "olfato": 1299,
+    "olivo": 1300,
+    "olla": 1301,
+    "olmo": 1302,
+    "olor": 1303,
+    "olvido": 1304,
+    "ombligo": 1305,
+    "onda": 1306,
+    "onza": 1307,
+    "opaco": 1308,
+    "opción": 1309,
+    "ópera": 1310,
+    "opinar": 1311,
+    "oponer": 1312,
+    "optar": 1313,
+    "óptica": 1314,
+    "opuesto": 1315,
+    "oración": 1316,
+    "orador": 1317,
+    "oral": 1318,
+    "órbita": 1319,
+    "orca": 1320,
+    "orden": 1321,
+    "oreja": 1322,
+    "órgano": 1323,
+    "orgía": 1324,
+    "orgullo": 1325,
+    "oriente": 1326,
+    "origen": 1327,
+    "orilla": 1328,
+    "oro": 1329,
+    "orquesta": 1330,
+    "oruga": 1331,
+    "osadía": 1332,
+    "oscuro": 1333,
+    "osezno": 1334,
+    "oso": 1335,
+    "ostra": 1336,
+    "otoño": 1337,
+    "otro": 1338,
+    "oveja": 1339,
+    "óvulo": 1340,
+    "óxido": 1341,
+    "oxígeno": 1342,
+    "oyente": 1343,
+    "ozono": 1344,
+    "pacto": 1345,
+    "padre": 1346,
+    "paella": 1347,
+    "página": 1348,
+    "pago": 1349,
+    "país": 1350,
+    "pájaro": 1351,
+    "palabra": 1352,
+    "palco": 1353,
+    "paleta": 1354,
+    "pálido": 1355,
+    "palma": 1356,
+    "paloma": 1357,
+    "palpar": 1358,
+    "pan": 1359,
+    "panal": 1360,
+    "pánico": 1361,
+    "pantera": 1362,
+    "pañuelo": 1363,
+    "papá": 1364,
+    "papel": 1365,
+    "papilla": 1366,
+    "paquete": 1367,
+    "parar": 1368,
+    "parcela": 1369,
+    "pared": 1370,
+    "parir": 1371,
+    "paro": 1372,
+    "párpado": 1373,
+    "parque": 1374,
+    "párrafo": 1375,
+    "parte": 1376,
+    "pasar": 1377,
+    "paseo": 1378,
+    "pasión": 1379,
+    "paso": 1380,
+    "pasta": 1381,
+    "pata": 1382,
+    "patio": 1383,
+    "patria": 1384,
+    "pausa": 1385,
+    "pauta": 1386,
+    "pavo": 1387,
+    "payaso": 1388,
+    "peatón": 1389,
+    "pecado": 1390,
+    "pecera": 1391,
+    "pecho": 1392,
+    "pedal": 1393,
+    "pedir": 1394,
+    "pegar": 1395,
+    "peine": 1396,
+    "pelar": 1397,
+    "peldaño": 1398,
+    "pelea": 1399,
+    "peligro": 1400,
+    "pellejo": 1401,
+    "pelo": 1402,
+    "peluca": 1403,
+    "pena": 1404,
+    "pensar": 1405,
+    "peñón": 1406,
+    "peón": 1407,
+    "peor": 1408,
+    "pepino": 1409,
+    "pequeño": 1410,
+    "pera": 1411,
+    "percha": 1412,
+    "perder": 1413,
+    "pereza": 1414,
+    "perfil": 1415,
+    "perico": 1416,
+    "perla": 1417,
+    "permiso": 1418,
+    "perro": 1419,
+    "persona": 1420,
+    "pesa": 1421,
+    "pesca": 1422,
+    "pésimo": 1423,
+    "pestaña": 1424,
+    "pétalo": 1425,
+    "petróleo": 1426,
+    "pez": 1427,
+    "pezuña": 1428,
+    "picar": 1429,
+    "pichón": 1430,
+    "pie": 1431,
+    "piedra": 1432,
+    "pierna": 1433,
+    "pieza": 1434,
+    "pijama": 1435,
+    "pilar": 1436,
+    "piloto": 1437,
+    "pimienta": 1438,
+    "pino": 1439,
+    "pintor": 1440,
+    "pinza": 1441,
+    "piña": 1442,
+    "piojo": 1443,
+    "pipa": 1444,
+    "pirata": 1445,
+    "pisar": 1446,
+    "piscina": 1447,
+    "piso": 1448,
+    "pista": 1449,
+    "pitón": 1450,
+    "pizca": 1451,
+    "placa": 1452,
+    "plan": 1453,
+    "plata": 1454,
+    "playa": 1455,
+    "plaza": 1456,
+    "pleito": 1457,
+    "pleno": 1458,
+    "plomo": 1459,
+    "pluma": 1460,
+    "plural": 1461,
+    "pobre": 1462,
+    "poco": 1463,
+    "poder": 1464,
+    "podio": 1465,
+    "poema": 1466,
+    "poesía": 1467,
+    "poeta": 1468,
+    "polen": 1469,
+    "policía": 1470,
+    "pollo": 1471,
+    "polvo": 1472,
+    "pomada": 1473,
+    "pomelo": 1474,
+    "pomo": 1475,
+    "pompa": 1476,
+    "poner": 1477,
+    "porción": 1478,
+    "portal": 1479,
+    "posada": 1480,
+    "poseer": 1481,
+    "posible": 1482,
+    "poste": 1483,
+    "potencia": 1484,
+    "potro": 1485,
+    "pozo": 1486,
+    "prado": 1487,
+    "precoz": 1488,
+    "pregunta": 1489,
+    "premio": 1490,
+    "prensa": 1491,
+    "preso": 1492,
+    "previo": 1493,
+    "primo": 1494,
+    "príncipe": 1495,
+    "prisión": 1496,
+    "privar": 1497,
+    "proa": 1498,
+    "probar": 1499,
+    "proceso": 1500,
+    "producto": 1501,
+    "proeza": 1502,
+    "profesor": 1503,
+    "programa": 1504,
+    "prole": 1505,
+    "promesa": 1506,
+    "pronto": 1507,
+    "propio": 1508,
+    "próximo": 1509,
+    "prueba": 1510,
+    "público": 1511,
+    "puchero": 1512,
+    "pudor": 1513,
+    "pueblo": 1514,
+    "puerta": 1515,
+    "puesto": 1516,
+    "pulga": 1517,
+    "pulir": 1518,
+    "pulmón": 1519,
+    "pulpo": 1520,
+    "pulso": 1521,
+    "puma": 1522,
+    "punto": 1523,
+    "puñal": 1524,
+    "puño": 1525,
+    "pupa": 1526,
+    "pupila": 1527,
+    "puré": 1528,
+    "quedar": 1529,
+    "queja": 1530,
+    "quemar": 1531,
+    "querer": 1532,
+    "queso": 1533,
+    "quieto": 1534,
+    "química": 1535,
+    "quince": 1536,
+    "quitar": 1537,
+    "rábano": 1538,
+    "rabia": 1539,
+    "rabo": 1540,
+    "ración": 1541,
+    "radical": 1542,
+    "raíz": 1543,
+    "rama": 1544,
+    "rampa": 1545,
+    "rancho": 1546,
+    "rango": 1547,
+    "rapaz": 1548,
+    "rápido": 1549,
+    "rapto": 1550,
+    "rasgo": 1551,
+    "raspa": 1552,
+    "rato": 1553,
+    "rayo": 1554,
+    "raza": 1555,
+    "razón": 1556,
+    "reacción": 1557,
+    "realidad": 1558,
+    "rebaño": 1559,
+    "rebote": 1560,
+    "recaer": 1561,
+    "receta": 1562,
+    "rechazo": 1563,
+    "recoger": 1564,
+    "recreo": 1565,
+    "recto": 1566,
+    "recurso": 1567,
+    "red": 1568,
+    "redondo": 1569,
+    "reducir": 1570,
+    "reflejo": 1571,
+    "reforma": 1572,
+    "refrán": 1573,
+    "refugio": 1574,
+    "regalo": 1575,
+    "regir": 1576,
+    "regla": 1577,
+    "regreso": 1578,
+    "rehén": 1579,
+    "reino": 1580,
+    "reír": 1581,
+    "reja": 1582,
+    "relato": 1583,
+    "relevo": 1584,
+    "relieve": 1585,
+    "relleno": 1586,
+    "reloj": 1587,
+    "remar": 1588,
+    "remedio": 1589,
+    "remo": 1590,
+    "rencor": 1591,
+    "rendir": 1592,
+    "renta": 1593,
+    "reparto": 1594,
+    "repetir": 1595,
+    "reposo": 1596,
+    "reptil": 1597,
+    "res": 1598,
+    "rescate": 1599,
+    "resina": 1600,
+    "respeto": 1601,
+    "resto": 1602,
+    "resumen": 1603,
+    "retiro": 1604,
+    "retorno": 1605,
+    "retrato": 1606,
+    "reunir": 1607,
+    "revés": 1608,
+    "revista": 1609,
+    "rey": 1610,
+    "rezar": 1611,
+    "rico": 1612,
+    "riego": 1613,
+    "rienda": 1614,
+    "riesgo": 1615,
+    "rifa": 1616,
+    "rígido": 1617,
+    "rigor": 1618,
+    "rincón": 1619,
+    "riñón": 1620,
+    "río": 1621,
+    "riqueza": 1622,
+    "risa": 1623,
+    "ritmo": 1624,
+    "rito": 1625,
+    "rizo": 1626,
+    "roble": 1627,
+    "roce": 1628,
+    "rociar": 1629,
+    "rodar": 1630,
+    "rodeo": 1631,
+    "rodilla": 1632,
+    "roer": 1633,
+    "rojizo": 1634,
+    "rojo": 1635,
+    "romero": 1636,
+    "romper": 1637,
+    "ron": 1638,
+    "ronco": 1639,
+    "ronda": 1640,
+    "ropa": 1641,
+    "ropero": 1642,
+    "rosa": 1643,
+    "rosca": 1644,
+    "rostro": 1645,
+    "rotar": 1646,
+    "rubí": 1647,
+    "rubor": 1648,
+    "rudo": 1649,
+    "rueda": 1650,
+    "rugir": 1651,
+    "ruido": 1652,
+    "ruina": 1653,
+    "ruleta": 1654,
+    "rulo": 1655,
+    "rumbo": 1656,
+    "rumor": 1657,
+    "ruptura": 1658,
+    "ruta": 1659,
+    "rutina": 1660,
+    "sábado": 1661,
+    "saber": 1662,
+    "sabio": 1663,
+    "sable": 1664,
+    "sacar": 1665,
+    "sagaz": 1666,
+    "sagrado": 1667,
+    "sala": 1668,
+    "saldo": 1669,
+    "salero": 1670,
+    "salir": 1671,
+    "salmón": 1672,
+    "salón": 1673,
+    "salsa": 1674,
+    "salto": 1675,
+    "salud": 1676,
+    "salvar": 1677,
+    "samba": 1678,
+    "sanción": 1679,
+    "sandía": 1680,
+    "sanear": 1681,
+    "sangre": 1682,
+    "sanidad": 1683,
+    "sano": 1684,
+    "santo": 1685,
+    "sapo": 1686,
+    "saque": 1687,
+    "sardina": 1688,
+    "sartén": 1689,
+    "sastre": 1690,
+    "satán": 1691,
+    "sauna": 1692,
+    "saxofón": 1693,
+    "sección": 1694,
+    "seco": 1695,
+    "secreto": 1696,
+    "secta": 1697,
+    "sed": 1698,
+    "seguir": 1699,
+    "seis": 1700,
+    "sello": 1701,
+    "selva": 1702,
+    "semana": 1703,
+    "semilla": 1704,
+    "senda": 1705,
+    "sensor": 1706,
+    "señal": 1707,
+    "señor": 1708,
+    "separar": 1709,
+    "sepia": 1710,
+    "sequía": 1711,
+    "ser": 1712,
+    "serie": 1713,
+    "sermón": 1714,
+    "servir": 1715,
+    "sesenta": 1716,
+    "sesión": 1717,
+    "seta": 1718,
+    "setenta": 1719,
+    "severo": 1720,
+    "sexo": 1721,
+    "sexto": 1722,
+    "sidra": 1723,
+    "siesta": 1724,
+    "siete": 1725,
+    "siglo": 1726,
+    "signo": 1727,
+    "sílaba": 1728,
+    "silbar": 1729,
+    "silencio": 1730,
+    "silla": 1731,
+    "símbolo": 1732,
+    "simio": 1733,
+    "sirena": 1734,
+    "sistema": 1735,
+    "sitio": 1736,
+    "situar": 1737,
+    "sobre": 1738,
+    "socio": 1739,
+    "sodio": 1740,
+    "sol": 1741,
+    "solapa": 1742,
+    "soldado": 1743,
+    "soledad": 1744,
+    "sólido": 1745,
+    "soltar": 1746,
+    "solución": 1747,
+    "sombra": 1748,
+    "sondeo": 1749,
+    "sonido": 1750,
+    "sonoro": 1751,
+    "sonrisa": 1752,
+    "sopa": 1753,
+    "soplar": 1754,
+    "soporte": 1755,
+    "sordo": 1756,
+    "sorpresa": 1757,
+    "sorteo": 1758,
+    "sostén": 1759,
+    "sótano": 1760,
+    "suave": 1761,
+    "subir": 1762,
+    "suceso": 1763,
+    "sudor": 1764,
+    "suegra": 1765,
+    "suelo": 1766,
+    "sueño": 1767,
+    "suerte": 1768,
+    "sufrir": 1769,
+    "sujeto": 1770,
+    "sultán": 1771,
+    "sumar": 1772,
+    "superar": 1773,
+    "suplir": 1774,
+    "suponer": 1775,
+    "supremo": 1776,
+    "sur": 1777,
+    "surco": 1778,
+    "sureño": 1779,
+    "surgir": 1780,
+    "susto": 1781,
+    "sutil": 1782,
+    "tabaco": 1783,
+    "tabique": 1784,
+    "tabla": 1785,
+    "tabú": 1786,
+    "taco": 1787,
+    "tacto": 1788,
+    "tajo": 1789,
+    "talar": 1790,
+    "talco": 1791,
+    "talento": 1792,
+    "talla": 1793,
+    "talón": 1794,
+    "tamaño": 1795,
+    "tambor": 1796,
+    "tango": 1797,
+    "tanque": 1798,
+    "tapa": 1799,
+    "tapete": 1800,
+    "tapia": 1801,
+    "tapón": 1802,
+    "taquilla": 1803,
+    "tarde": 1804,
+    "tarea": 1805,
+    "tarifa": 1806,
+    "tarjeta": 1807,
+    "tarot": 1808,
+    "tarro": 1809,
+    "tarta": 1810,
+    "tatuaje": 1811,
+    "tauro": 1812,
+    "taza": 1813,
+    "tazón": 1814,
+    "teatro": 1815,
+    "techo": 1816,
+    "tecla": 1817,
+    "técnica": 1818,
+    "tejado": 1819,
+    "tejer": 1820,
+    "tejido": 1821,
+    "tela": 1822,
+    "teléfono": 1823,
+    "tema": 1824,
+    "temor": 1825,
+    "templo": 1826,
+    "tenaz": 1827,
+    "tender": 1828,
+    "tener": 1829,
+    "tenis": 1830,
+    "tenso": 1831,
+    "teoría": 1832,
+    "terapia": 1833,
+    "terco": 1834,
+    "término": 1835,
+    "ternura": 1836,
+    "terror": 1837,
+    "tesis": 1838,
+    "tesoro": 1839,
+    "testigo": 1840,
+    "tetera": 1841,
+    "texto": 1842,
+    "tez": 1843,
+    "tibio": 1844,
+    "tiburón": 1845,
+    "tiempo": 1846,
+    "tienda": 1847,
+    "tierra": 1848,
+    "tieso": 1849,
+    "tigre": 1850,
+    "tijera": 1851,
+    "tilde": 1852,
+    "timbre": 1853,
+    "tímido": 1854,
+    "timo": 1855,
+    "tinta": 1856,
+    "tío": 1857,
+    "típico": 1858,
+    "tipo": 1859,
+    "tira": 1860,
+    "tirón": 1861,
+    "titán": 1862,
+    "títere": 1863,
+    "título": 1864,
+    "tiza": 1865,
+    "toalla": 1866,
+    "tobillo": 1867,
+    "tocar": 1868,
+    "tocino": 1869,
+    "todo": 1870,
+    "toga": 1871,
+    "toldo": 1872,
+    "tomar": 1873,
+    "tono": 1874,
+    "tonto": 1875,
+    "topar": 1876,
+    "tope": 1877,
+    "toque": 1878,
+    "tórax": 1879,
+    "torero": 1880,
+    "tormenta": 1881,
+    "torneo": 1882,
+    "toro": 1883,
+    "torpedo": 1884,
+    "torre": 1885,
+    "torso": 1886,
+    "tortuga": 1887,
+    "tos": 1888,
+    "tosco": 1889,
+    "toser": 1890,
+    "tóxico": 1891,
+    "trabajo": 1892,
+    "tractor": 1893,
+    "traer": 1894,
+    "tráfico": 1895,
+    "trago": 1896,
+    "traje": 1897,
+    "tramo": 1898,
+    "trance": 1899,
+    "trato": 1900,
+    "trauma": 1901,
+    "trazar": 1902,
+    "trébol": 1903,
+    "tregua": 1904,
+    "treinta": 1905,
+    "tren": 1906,
+    "trepar": 1907,
+    "tres": 1908,
+    "tribu": 1909,
+    "trigo": 1910,
+    "tripa": 1911,
+    "triste": 1912,
+    "triunfo": 1913,
+    "trofeo": 1914,
+    "trompa": 1915,
+    "tronco": 1916,
+    "tropa": 1917,
+    "trote": 1918,
+    "trozo": 1919,
+    "truco": 1920,
+    "trueno": 1921,
+    "trufa": 1922,
+    "tubería": 1923,
+    "tubo": 1924,
+    "tuerto": 1925,
+    "tumba": 1926,
+    "tumor": 1927,
+    "túnel": 1928,
+    "túnica": 1929,
+    "turbina": 1930,
+    "turismo": 1931,
+    "turno": 1932,
+    "tutor": 1933,
+    "ubicar": 1934,
+    "úlcera": 1935,
+    "umbral": 1936,
+    "unidad": 1937,
+    "unir": 1938,
+    "universo": 1939,
+    "uno": 1940,
+    "untar": 1941,
+    "uña": 1942,
+    "urbano": 1943,
+    "urbe": 1944,
+    "urgente": 1945,
+    "urna": 1946,
+    "usar": 1947,
+    "usuario": 1948,
+    "útil": 1949,
+    "utopía": 1950,
+    "uva": 1951,
+    "vaca": 1952,
+    "vacío": 1953,
+    "vacuna": 1954,
+    "vagar": 1955,
+    "vago": 1956,
+    "vaina": 1957,
+    "vajilla": 1958,
+    "vale": 1959,
+    "válido": 1960,
+    "valle": 1961,
+    "valor": 1962,
+    "válvula": 1963,
+    "vampiro": 1964,
+    "vara": 1965,
+    "variar": 1966,
+    "varón": 1967,
+    "vaso": 1968,
+    "vecino": 1969,
+    "vector": 1970,
+    "vehículo": 1971,
+    "veinte": 1972,
+    "vejez": 1973,
+    "vela": 1974,
+    "velero": 1975,
+    "veloz": 1976,
+    "vena": 1977,
+    "vencer": 1978,
+    "venda": 1979,
+    "veneno": 1980,
+    "vengar": 1981,
+    "venir": 1982,
+    "venta": 1983,
+    "venus": 1984,
+    "ver": 1985,
+    "verano": 1986,
+    "verbo": 1987,
+    "verde": 1988,
+    "vereda": 1989,
+    "verja": 1990,
+    "verso": 1991,
+    "verter": 1992,
+    "vía": 1993,
+    "viaje": 1994,
+    "vibrar": 1995,
+    "vicio": 1996,
+    "víctima": 1997,
+    "vida": 1998,
+    "vídeo": 1999,
+    "vidrio": 2000,
+    "viejo": 2001,
+    "viernes": 2002,
+    "vigor": 2003,
+    "vil": 2004,
+    "villa": 2005,
+    "vinagre": 2006,
+    "vino": 2007,
+    "viñedo": 2008,
+    "violín": 2009,
+    "viral": 2010,
+    "virgo": 2011,
+    "virtud": 2012,
+    "visor": 2013,
+    "víspera": 2014,
+    "vista": 2015,
+    "vitamina": 2016,
+    "viudo": 2017,
+    "vivaz": 2018,
+    "vivero": 2019,
+    "vivir": 2020,
+    "vivo": 2021,
+    "volcán": 2022,
+    "volumen": 2023,
+    "volver": 2024,
+    "voraz": 2025,
+    "votar": 2026,
+    "voto": 2027,
+    "voz": 2028,
+    "vuelo": 2029,
+    "vulgar": 2030,
+    "yacer": 2031,
+    "yate": 2032,
+    "yegua": 2033,
+    "yema": 2034,
+    "yerno": 2035,
+    "yeso": 2036,
+    "yodo": 2037,
+    "yoga": 2038,
+    "yogur": 2039,
+    "zafiro": 2040,
+    "zanja": 2041,
+    "zapato": 2042,
+    "zarza": 2043,
+    "zona": 2044,
+    "zorro": 2045,
+    "zumo": 2046,
+    "zurdo": 2047,
+}
 func init() {
+    allWords["chinese_simplified"] = &wordsDesc{ words: words_chinese_simplified, rwords: rwords_chinese_simplified, lang: "chinese_simplified" }
+    allWords["chinese_traditional"] = &wordsDesc{ words: words_chinese_traditional, rwords: rwords_chinese_traditional, lang: "chinese_traditional" }
+    allWords["czech"] = &wordsDesc{ words: words_czech, rwords: rwords_czech, lang: "czech" }
     allWords["english"] = &wordsDesc{ words: words_english, rwords: rwords_english, lang: "english" }
+    allWords["french"] = &wordsDesc{ words: words_french, rwords: rwords_french, lang: "french" }
+    allWords["italian"] = &wordsDesc{ words: words_italian, rwords: rwords_italian, lang: "italian" }
+    allWords["japanese"] = &wordsDesc{ words: words_japanese, rwords: rwords_japanese, lang: "japanese" }
+    allWords["korean"] = &wordsDesc{ words: words_korean, rwords: rwords_korean, lang: "korean" }
+    allWords["spanish"] = &wordsDesc{ words: words_spanish, rwords: rwords_spanish, lang: "spanish" }
 }
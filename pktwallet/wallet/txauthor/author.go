@@ -48,6 +48,15 @@ type AuthoredTx struct {
 	Tx          *wire.MsgTx
 	TotalInput  btcutil.Amount
 	ChangeIndex int // negative if no change
+
+	// Name of the coin selection strategy which chose the inputs and the
+	// waste of the selection, these are filled in by the wallet.
+	CoinSelection string
+	Waste         btcutil.Amount
+
+	// Scheduled is set by the wallet if the transaction is time locked and
+	// was stored to be broadcast once it becomes final, rather than sent.
+	Scheduled bool
 }
 
 // ChangeSource provides P2PKH change output scripts for transaction creation.
@@ -76,12 +85,48 @@ type ChangeSource func() ([]byte, er.R)
 // TODO(cjd): Fee estimation will be off when redeeming segwit multisigs, we need the redeem script...
 func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb btcutil.Amount,
 	fetchInputs InputSource, fetchChange ChangeSource, partialOk bool) (*AuthoredTx, er.R) {
+	return NewUnsignedTransactionSubtractFee(outputs, relayFeePerKb, fetchInputs,
+		fetchChange, partialOk, nil)
+}
+
+// NewUnsignedTransactionSubtractFee is NewUnsignedTransaction except that the
+// fee is not paid by the inputs, it is subtracted from the outputs whose
+// indexes are listed in subtractFeeFrom. The fee is shared equally between
+// them and the first one pays the remainder. If subtractFeeFrom is empty, this
+// is the same as NewUnsignedTransaction.
+func NewUnsignedTransactionSubtractFee(outputs []*wire.TxOut, relayFeePerKb btcutil.Amount,
+	fetchInputs InputSource, fetchChange ChangeSource, partialOk bool,
+	subtractFeeFrom []int) (*AuthoredTx, er.R) {
 
 	targetAmount := h.SumOutputValues(outputs)
 	estimatedSize := txsizes.EstimateVirtualSize(0, 1, 0, outputs, true)
 	targetFee := txrules.FeeForSerializeSize(relayFeePerKb, estimatedSize)
 
 	sweepTo := enough.GetSweepOutput(outputs)
+	subtractFee := len(subtractFeeFrom) > 0
+	if subtractFee {
+		if sweepTo != nil {
+			return nil, er.New("the fee cannot be subtracted from outputs when sweeping")
+		}
+		for _, i := range subtractFeeFrom {
+			if i < 0 || i >= len(outputs) {
+				return nil, er.Errorf("cannot subtract fee from output [%d], "+
+					"there are only [%d] outputs", i, len(outputs))
+			}
+		}
+		// The inputs only need to pay for the outputs.
+		targetFee = 0
+		partialOk = false
+
+		// The outputs are adjusted, so copy them to leave the caller's
+		// outputs as they were.
+		copied := make([]*wire.TxOut, len(outputs))
+		for i, o := range outputs {
+			out := *o
+			copied[i] = &out
+		}
+		outputs = copied
+	}
 	for {
 		synthTargetAmount := targetAmount + targetFee
 		if sweepTo != nil {
@@ -122,7 +167,18 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb btcutil.Amount,
 			nested, outputs, true)
 		maxRequiredFee := txrules.FeeForSerializeSize(relayFeePerKb, maxSignedSize)
 		remainingAmount := inputAmount - targetAmount
-		if remainingAmount < maxRequiredFee {
+		if subtractFee {
+			if err := subtractFeeFromOutputs(outputs, subtractFeeFrom, maxRequiredFee); err != nil {
+				return nil, err
+			}
+			targetAmount -= maxRequiredFee
+		} else if remainingAmount < maxRequiredFee {
+			// A sweep already has every input, more can't be fetched.
+			if sweepTo != nil {
+				return nil, ImpossibleTxError.New(fmt.Sprintf("sweeping [%s] "+
+					"cannot pay the fee of [%s]", inputAmount.String(),
+					maxRequiredFee.String()), nil)
+			}
 			targetFee = maxRequiredFee
 			continue
 		}
@@ -166,6 +222,25 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb btcutil.Amount,
 	}
 }
 
+// subtractFeeFromOutputs takes the fee from the outputs at the indexes given,
+// failing if any of them would become dust.
+func subtractFeeFromOutputs(outputs []*wire.TxOut, indexes []int, fee btcutil.Amount) er.R {
+	share := int64(fee) / int64(len(indexes))
+	remainder := int64(fee) % int64(len(indexes))
+	for n, i := range indexes {
+		out := outputs[i]
+		out.Value -= share
+		if n == 0 {
+			out.Value -= remainder
+		}
+		if out.Value <= 0 || txrules.IsDustOutput(out, txrules.DefaultRelayFeePerKb) {
+			return er.Errorf("output [%d] is too small to pay its share "+
+				"of the fee of [%s]", i, fee.String())
+		}
+	}
+	return nil
+}
+
 // RandomizeOutputPosition randomizes the position of a transaction's output by
 // swapping it with a random output.  The new index is returned.  This should be
 // done before signing.
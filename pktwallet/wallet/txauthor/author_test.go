@@ -223,3 +223,40 @@ func TestNewUnsignedTransaction(t *testing.T) {
 		}
 	}
 }
+
+func TestNewUnsignedTransactionSubtractFee(t *testing.T) {
+	changeSource := func() ([]byte, er.R) {
+		return make([]byte, txsizes.P2WPKHPkScriptSize), nil
+	}
+	outputs := p2pkhOutputs(1e6, 2e6, 3e6)
+	tx, err := NewUnsignedTransactionSubtractFee(outputs, 1e3,
+		makeInputSource(p2pkhOutputs(6e6)), changeSource, false, []int{0, 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tx.ChangeIndex >= 0 {
+		t.Fatalf("Expected no change, the inputs pay exactly for the outputs")
+	}
+	if outputs[0].Value != 1e6 || outputs[2].Value != 3e6 {
+		t.Fatalf("The outputs which were passed in were changed")
+	}
+	txOut := tx.Tx.TxOut
+	fee := tx.TotalInput - btcutil.Amount(txOut[0].Value+txOut[1].Value+txOut[2].Value)
+	expectFee := txrules.FeeForSerializeSize(1e3, txsizes.EstimateVirtualSize(1, 0, 0, txOut, true))
+	if fee != expectFee {
+		t.Fatalf("Got fee %v, Expected %v", fee, expectFee)
+	}
+	if txOut[1].Value != 2e6 {
+		t.Fatalf("The fee was subtracted from output 1")
+	}
+	if 1e6-txOut[0].Value != 3e6-txOut[2].Value+int64(fee%2) {
+		t.Fatalf("The fee was not shared, got outputs %d and %d", txOut[0].Value, txOut[2].Value)
+	}
+
+	// An output which can't pay its share of the fee fails.
+	_, err = NewUnsignedTransactionSubtractFee(p2pkhOutputs(1e6, 100), 1e3,
+		makeInputSource(p2pkhOutputs(6e6)), changeSource, false, []int{1})
+	if err == nil {
+		t.Fatalf("Expected the fee to be too large for output 1")
+	}
+}
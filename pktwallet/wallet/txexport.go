@@ -0,0 +1,330 @@
+package wallet
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// stableOrder sorts the transactions of one block by txid, except that a
+// transaction which spends another one in the block is placed after it. The
+// order which the transactions were discovered in depends on how the wallet
+// was synced, this order does not, so an export is the same after a resync.
+func stableOrder(dets []wtxmgr.TxDetails) []wtxmgr.TxDetails {
+	sort.Slice(dets, func(i, j int) bool {
+		return bytes.Compare(dets[i].Hash[:], dets[j].Hash[:]) < 0
+	})
+	pending := make(map[chainhash.Hash]struct{}, len(dets))
+	for i := range dets {
+		pending[dets[i].Hash] = struct{}{}
+	}
+	out := make([]wtxmgr.TxDetails, 0, len(dets))
+	for len(out) < len(dets) {
+		progress := false
+		for i := range dets {
+			if _, ok := pending[dets[i].Hash]; !ok {
+				continue
+			}
+			ready := true
+			for _, in := range dets[i].MsgTx.TxIn {
+				if _, ok := pending[in.PreviousOutPoint.Hash]; ok {
+					ready = false
+				}
+			}
+			if ready {
+				out = append(out, dets[i])
+				delete(pending, dets[i].Hash)
+				progress = true
+			}
+		}
+		if !progress {
+			// Transactions can't spend each other in a loop, but be safe.
+			for i := range dets {
+				if _, ok := pending[dets[i].Hash]; ok {
+					out = append(out, dets[i])
+				}
+			}
+			break
+		}
+	}
+	return out
+}
+
+// exportTx is the change which one transaction made to each account.
+type exportTx struct {
+	credits map[uint32]int64
+	debits  map[uint32]int64
+	// The outputs which each account received
+	received map[uint32][]int
+}
+
+func (w *Wallet) exportTx(dbtx walletdb.ReadTx, d *wtxmgr.TxDetails) *exportTx {
+	et := &exportTx{
+		credits:  make(map[uint32]int64),
+		debits:   make(map[uint32]int64),
+		received: make(map[uint32][]int),
+	}
+	for _, c := range d.Credits {
+		acct, _ := lookupOutputChain(dbtx, w, d, c)
+		et.credits[acct] += int64(c.Amount)
+		et.received[acct] = append(et.received[acct], int(c.Index))
+	}
+	for _, deb := range d.Debits {
+		et.debits[lookupInputAccount(dbtx, w, d, deb)] += int64(deb.Amount)
+	}
+	return et
+}
+
+// exportBlockRange is the number of blocks which are read in one db
+// transaction when the history is exported.
+const exportBlockRange = 2000
+
+// txExporter turns transactions into export rows, it keeps the running
+// balances between the block ranges of an export.
+type txExporter struct {
+	w          *Wallet
+	req        *rpc_pb.TransactionExportRequest
+	accounts   map[uint32]struct{}
+	balances   map[uint32]int64
+	names      map[uint32]string
+	ab         *addressBook
+	syncHeight int32
+}
+
+func (e *txExporter) include(d *wtxmgr.TxDetails) bool {
+	req := e.req
+	if d.Block.Height == -1 {
+		return req.IncludeUnconfirmed && req.EndHeight == 0 && req.EndTime == 0
+	}
+	t := d.Block.Time.Unix()
+	return d.Block.Height >= req.StartHeight &&
+		(req.EndHeight == 0 || d.Block.Height <= req.EndHeight) &&
+		t >= req.StartTime && (req.EndTime == 0 || t < req.EndTime)
+}
+
+func (e *txExporter) accountName(addrmgrNs walletdb.ReadBucket, scope *waddrmgr.ScopedKeyManager, acct uint32) string {
+	if n, ok := e.names[acct]; ok {
+		return n
+	}
+	n, err := scope.AccountName(addrmgrNs, acct)
+	if err != nil {
+		n = ""
+	}
+	e.names[acct] = n
+	return n
+}
+
+// rows returns the rows of one transaction.
+func (e *txExporter) rows(
+	dbtx walletdb.ReadTx,
+	scope *waddrmgr.ScopedKeyManager,
+	d *wtxmgr.TxDetails,
+) []*rpc_pb.TransactionExportRow {
+	w := e.w
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	et := w.exportTx(dbtx, d)
+
+	// The fee is only known if all of the inputs are the wallet's.
+	fee := int64(0)
+	totalDebit := int64(0)
+	for _, v := range et.debits {
+		totalDebit += v
+	}
+	if len(d.Debits) > 0 && len(d.Debits) == len(d.MsgTx.TxIn) {
+		fee = totalDebit
+		for _, out := range d.MsgTx.TxOut {
+			fee -= out.Value
+		}
+	}
+
+	accts := make([]uint32, 0, len(et.credits)+len(et.debits))
+	for a := range et.credits {
+		accts = append(accts, a)
+	}
+	for a := range et.debits {
+		if _, ok := et.credits[a]; !ok {
+			accts = append(accts, a)
+		}
+	}
+	sort.Slice(accts, func(i, j int) bool { return accts[i] < accts[j] })
+
+	// The fee is shared between the accounts which paid the
+	// inputs in proportion to what they paid, the remainder of
+	// the division goes to the first of them.
+	fees := make(map[uint32]int64)
+	if fee > 0 {
+		shared := int64(0)
+		for _, a := range accts {
+			fees[a] = fee * et.debits[a] / totalDebit
+			shared += fees[a]
+		}
+		for _, a := range accts {
+			if et.debits[a] > 0 {
+				fees[a] += fee - shared
+				break
+			}
+		}
+	}
+
+	var rows []*rpc_pb.TransactionExportRow
+	coinbase := blockchain.IsCoinBaseTx(&d.MsgTx)
+	for _, a := range accts {
+		amount := et.credits[a] - et.debits[a]
+		e.balances[a] += amount
+		if _, ok := e.accounts[a]; len(e.accounts) > 0 && !ok {
+			continue
+		} else if !e.include(d) {
+			continue
+		}
+		row := &rpc_pb.TransactionExportRow{
+			Txid:        d.Hash.String(),
+			Height:      d.Block.Height,
+			Time:        d.Received.Unix(),
+			Account:     a,
+			AccountName: e.accountName(addrmgrNs, scope, a),
+			Amount:      amount,
+			Fee:         fees[a],
+			Balance:     e.balances[a],
+			Label:       d.Label,
+			Contacts:    e.ab.contactsOf(&d.MsgTx, w.chainParams),
+		}
+		if d.Block.Height != -1 {
+			row.BlockHash = d.Block.Hash.String()
+			row.Time = d.Block.Time.Unix()
+		}
+		row.Date = time.Unix(row.Time, 0).UTC().Format(time.RFC3339)
+
+		// For a send, the outputs which the account didn't
+		// receive are listed, for a receive those which it did.
+		var outs []int
+		if coinbase {
+			row.Category = RecvCategory(d, e.syncHeight, w.chainParams).String()
+			outs = et.received[a]
+		} else if et.debits[a] > 0 {
+			row.Category = "send"
+			mine := make(map[int]struct{})
+			for _, i := range et.received[a] {
+				mine[i] = struct{}{}
+			}
+			for i := range d.MsgTx.TxOut {
+				if _, ok := mine[i]; !ok {
+					outs = append(outs, i)
+				}
+			}
+		} else {
+			row.Category = CreditReceive.String()
+			outs = et.received[a]
+		}
+		for _, i := range outs {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+				d.MsgTx.TxOut[i].PkScript, w.chainParams)
+			if err != nil || len(addrs) != 1 {
+				continue
+			}
+			addr := addrs[0].EncodeAddress()
+			row.Addresses = append(row.Addresses, addr)
+			op := wire.OutPoint{Hash: d.Hash, Index: uint32(i)}
+			if l := e.ab.outputLabel(&op, addr); l != "" {
+				row.OutputLabels = append(row.OutputLabels, l)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// ExportTransactions returns the history of the wallet as one row for each
+// account which each transaction changed, see StreamTransactions.
+func (w *Wallet) ExportTransactions(req *rpc_pb.TransactionExportRequest) ([]*rpc_pb.TransactionExportRow, er.R) {
+	var rows []*rpc_pb.TransactionExportRow
+	err := w.StreamTransactions(req, func(row *rpc_pb.TransactionExportRow) bool {
+		rows = append(rows, row)
+		return true
+	})
+	return rows, err
+}
+
+// StreamTransactions calls f with one row for each account which each
+// transaction changed, in an order which is stable across resyncs: by height,
+// then by txid within a block. The running balance of each account is computed
+// over the whole history, so it is the same whatever the filters of the
+// request. The history is read exportBlockRange blocks at a time and the rows
+// of each range are passed to f once they are read, if f returns false no more
+// rows are read.
+func (w *Wallet) StreamTransactions(
+	req *rpc_pb.TransactionExportRequest,
+	f func(row *rpc_pb.TransactionExportRow) bool,
+) er.R {
+	e := &txExporter{
+		w:        w,
+		req:      req,
+		accounts: make(map[uint32]struct{}),
+		balances: make(map[uint32]int64),
+		names:    make(map[uint32]string),
+	}
+	for _, a := range req.Accounts {
+		e.accounts[a] = struct{}{}
+	}
+
+	for begin := int32(0); ; begin += exportBlockRange {
+		// The last range includes the unconfirmed transactions.
+		e.syncHeight = w.Manager.SyncedTo().Height
+		end := begin + exportBlockRange - 1
+		last := end >= e.syncHeight
+		if last {
+			end = -1
+		}
+
+		var rows []*rpc_pb.TransactionExportRow
+		if err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			if e.ab == nil {
+				ab, err := loadAddressBook(dbtx)
+				if err != nil {
+					return err
+				}
+				e.ab = ab
+			}
+			scope, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0084)
+			if err != nil {
+				return err
+			}
+			return w.TxStore.RangeTransactions(txmgrNs, begin, end, func(details []wtxmgr.TxDetails) (bool, er.R) {
+				dets := make([]wtxmgr.TxDetails, len(details))
+				copy(dets, details)
+				if dets[0].Block.Height == -1 {
+					sort.SliceStable(dets, func(i, j int) bool {
+						return dets[i].Received.Before(dets[j].Received)
+					})
+				} else {
+					dets = stableOrder(dets)
+				}
+				for i := range dets {
+					rows = append(rows, e.rows(dbtx, scope, &dets[i])...)
+				}
+				return false, nil
+			})
+		}); err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			if !f(row) {
+				return nil
+			}
+		}
+		if last || (req.EndHeight > 0 && end >= req.EndHeight) {
+			return nil
+		}
+	}
+}
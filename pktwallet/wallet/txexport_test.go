@@ -0,0 +1,146 @@
+package wallet
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/dbstructs"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestExportTransactions exports a mined coinbase, a payment and a spend of
+// the payment in the same block, and a payment some block ranges later, and
+// checks the running balances, the fee and the filters.
+func TestExportTransactions(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	script := func() []byte {
+		addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+		util.RequireNoErr(t, err)
+		pkScript, err := txscript.PayToAddrScript(addr)
+		util.RequireNoErr(t, err)
+		return pkScript
+	}
+	other, err := btcutil.NewAddressWitnessPubKeyHash(make([]byte, 20), w.chainParams)
+	util.RequireNoErr(t, err)
+	otherScript, err := txscript.PayToAddrScript(other)
+	util.RequireNoErr(t, err)
+
+	coinbase := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Index: math.MaxUint32}}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(500000000, script())},
+	}
+	payment := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}}}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(1000000, script())},
+	}
+	spend := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Hash: payment.TxHash()}}},
+		TxOut: []*wire.TxOut{
+			wire.NewTxOut(600000, otherScript),
+			wire.NewTxOut(390000, script()),
+		},
+	}
+	late := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{2}}}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(2000000, script())},
+	}
+	lateHeight := int32(2*exportBlockRange + 1)
+	blockTime := time.Unix(1650000000, 0)
+	for _, tx := range []struct {
+		tx     *wire.MsgTx
+		height int32
+	}{{coinbase, 100}, {payment, 101}, {spend, 101}, {late, lateHeight}} {
+		rec, err := wtxmgr.NewTxRecordFromMsgTx(tx.tx, time.Now())
+		util.RequireNoErr(t, err)
+		block := &wtxmgr.BlockMeta{
+			Block: dbstructs.Block{Hash: chainhash.Hash{byte(tx.height)}, Height: tx.height},
+			Time:  blockTime.Add(time.Duration(tx.height) * time.Minute),
+		}
+		util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			return w.addRelevantTx(dbtx, rec, block)
+		}))
+	}
+	util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.Manager.SetSyncedTo(dbtx.ReadWriteBucket(waddrmgrNamespaceKey),
+			&waddrmgr.BlockStamp{Height: 3 * exportBlockRange, Hash: *testBlockHash})
+	}))
+	util.RequireNoErr(t, w.SetLabel(&rpc_pb.AddressBookLabel{
+		Type: LabelTypeOutput, Ref: spend.TxHash().String() + ":0", Label: "Invoice 7"}))
+
+	rows, err := w.ExportTransactions(&rpc_pb.TransactionExportRequest{})
+	util.RequireNoErr(t, err)
+	expect := []struct {
+		tx       *wire.MsgTx
+		category string
+		amount   int64
+		fee      int64
+		balance  int64
+	}{
+		{coinbase, "generate", 500000000, 0, 500000000},
+		{payment, "receive", 1000000, 0, 501000000},
+		{spend, "send", -610000, 10000, 500390000},
+		{late, "receive", 2000000, 0, 502390000},
+	}
+	if len(rows) != len(expect) {
+		t.Fatalf("expected %d rows, got %v", len(expect), rows)
+	}
+	for i, e := range expect {
+		r := rows[i]
+		if r.Txid != e.tx.TxHash().String() || r.Category != e.category || r.Amount != e.amount ||
+			r.Fee != e.fee || r.Balance != e.balance || r.AccountName != "default" {
+			t.Fatalf("row %d: expected %+v, got %v", i, e, r)
+		}
+	}
+	if r := rows[2]; len(r.Addresses) != 1 || r.Addresses[0] != other.EncodeAddress() ||
+		len(r.OutputLabels) != 1 || r.OutputLabels[0] != "Invoice 7" ||
+		r.Date != "2022-04-15T07:01:00Z" {
+		t.Fatalf("expected the payee and the label of the send, got %v", r)
+	}
+
+	// Filters don't change the running balance.
+	rows, err = w.ExportTransactions(&rpc_pb.TransactionExportRequest{StartHeight: 101})
+	util.RequireNoErr(t, err)
+	if len(rows) != 3 || rows[0].Balance != 501000000 {
+		t.Fatalf("expected 3 rows from height 101, got %v", rows)
+	}
+	rows, err = w.ExportTransactions(&rpc_pb.TransactionExportRequest{EndHeight: 101})
+	util.RequireNoErr(t, err)
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows up to height 101, got %v", rows)
+	}
+
+	// The stream stops when asked to.
+	n := 0
+	util.RequireNoErr(t, w.StreamTransactions(&rpc_pb.TransactionExportRequest{},
+		func(row *rpc_pb.TransactionExportRow) bool {
+			n++
+			return false
+		}))
+	if n != 1 {
+		t.Fatalf("expected the stream to stop after one row, got %d", n)
+	}
+	rows, err = w.ExportTransactions(&rpc_pb.TransactionExportRequest{
+		EndTime: blockTime.Add(101 * time.Minute).Unix(),
+	})
+	util.RequireNoErr(t, err)
+	if len(rows) != 1 || rows[0].Height != 100 {
+		t.Fatalf("expected the coinbase only, got %v", rows)
+	}
+	rows, err = w.ExportTransactions(&rpc_pb.TransactionExportRequest{Accounts: []uint32{1}})
+	util.RequireNoErr(t, err)
+	if len(rows) != 0 {
+		t.Fatalf("expected no rows for account 1, got %v", rows)
+	}
+}
@@ -115,8 +115,9 @@ type Wallet struct {
 	chainClientSynced  bool
 	chainClientSyncMtx sync.Mutex
 
-	lockedOutpoints    map[wire.OutPoint]string
-	lockedOutpointsMtx sync.Mutex
+	lockedOutpoints         map[wire.OutPoint]wtxmgr.NamedLock
+	lockedOutpointsMtx      sync.Mutex
+	lockedOutpointsWriteMtx sync.Mutex
 
 	recoveryWindow uint32
 
@@ -153,6 +154,10 @@ type Wallet struct {
 	looseTransactionsStop   event.Emitter[struct{}]
 	looseTransactionsActive lock.AtomicBool
 
+	fold lock.GenMutex[foldJob]
+
+	// The wallet category of the API, the wallet registers the endpoints
+	// which it serves by itself under this category.
 	api *apiv1.Apiv1
 }
 
@@ -198,9 +203,11 @@ func (w *Wallet) Start() {
 	}
 	w.quitMu.Unlock()
 
-	w.wg.Add(2)
+	w.wg.Add(4)
 	go w.txCreator()
 	go w.walletLocker()
+	go w.webhookLoop()
+	go w.scheduleLoop()
 }
 
 // SynchronizeRPC associates the wallet with the consensus RPC client,
@@ -234,6 +241,7 @@ func (w *Wallet) SynchronizeRPC(chainClient *neutrino.ChainService) {
 	// separately from the wallet (use wallet mutator functions to
 	// make changes from the RPC client) and not have to stop and
 	// restart them each time the client disconnects and reconnets.
+	w.wg.Add(1)
 	go w.goMainLoop()
 }
 
@@ -290,6 +298,23 @@ func (w *Wallet) Stop() {
 	}
 }
 
+// Detach signals all wallet goroutines to shutdown, like Stop, but the chain
+// client is left running because it may be shared with other wallets.
+func (w *Wallet) Detach() {
+	w.quitMu.Lock()
+	quit := w.quit
+	w.quitMu.Unlock()
+
+	select {
+	case <-quit:
+	default:
+		close(quit)
+		if err := w.StopWatchLooseTransactions(); err != nil {
+			log.Warnf("Unable to stop watching loose transactions: [%v]", err)
+		}
+	}
+}
+
 // ShuttingDown returns whether the wallet is currently in the process of
 // shutting down or not.
 func (w *Wallet) ShuttingDown() bool {
@@ -660,6 +685,36 @@ type (
 		InputComparator utils.Comparator
 		MaxInputs       int
 		Label           string
+		Replaceable     bool
+
+		// CoinSelection is the strategy for choosing inputs, when it is not
+		// CoinSelectDefault, the InputComparator is not used.
+		CoinSelection CoinSelection
+
+		// LongTermFeeSatPerKB is the fee rate which outputs are expected to
+		// cost to spend in the future, it is used to compute the waste of the
+		// selected inputs. If zero, the minimum relay fee is assumed.
+		LongTermFeeSatPerKB btcutil.Amount
+
+		// SubtractFeeFrom lists the indexes of the Outputs which pay the fee,
+		// if empty the fee is paid by the inputs.
+		SubtractFeeFrom []int
+
+		// LockTime is the nLockTime of the transaction, a block height if it
+		// is less than txscript.LockTimeThreshold, otherwise a unix time.
+		LockTime uint32
+
+		// Sequence, if non-zero, is the sequence number of every input, which
+		// encodes a relative (CSV) lock time per BIP-68.
+		Sequence uint32
+
+		// Autolock, if set, is the name of a lock which is put on every input
+		// before another transaction can select coins.
+		Autolock string
+
+		// ExcludeWatchOnly leaves out the coins of watch-only accounts when
+		// the inputs are not explicitly chosen, whatever the SendMode.
+		ExcludeWatchOnly bool
 	}
 	createTxRequest struct {
 		req  CreateTxReq
@@ -693,13 +748,24 @@ out:
 	for {
 		select {
 		case txr := <-w.createTxRequests:
-			heldUnlock, err := w.holdUnlock()
-			if err != nil {
-				txr.resp <- createTxResponse{nil, err}
-				continue
+			// Unsigned transactions don't need the private keys, so
+			// they are created while the wallet is locked as well.
+			var heldUnlock heldUnlock
+			if txr.req.SendMode != SendModeUnsigned {
+				var err er.R
+				heldUnlock, err = w.holdUnlock()
+				if err != nil {
+					txr.resp <- createTxResponse{nil, err}
+					continue
+				}
 			}
 			tx, err := w.txToOutputs(txr.req)
-			heldUnlock.release()
+			if err == nil && txr.req.Autolock != "" {
+				err = w.lockInputs(tx.Tx.TxIn, txr.req.Autolock)
+			}
+			if heldUnlock != nil {
+				heldUnlock.release()
+			}
 			txr.resp <- createTxResponse{tx, err}
 		case <-quit:
 			break out
@@ -1653,10 +1719,34 @@ func (w *Wallet) GetTransactions1(req *rpc_pb.GetTransactionsRequest) (*rpc_pb.T
 	start := NewBlockIdentifierFromHeight(req.StartHeight)
 	stop := NewBlockIdentifierFromHeight(req.EndHeight)
 	coinbase := int32(req.Coinbase.Number())
-	txns, err := w.GetTransactions(start, stop, req.TxnsLimit, req.TxnsSkip, coinbase, req.Reversed, nil)
+	ab, err := w.loadAddressBook()
 	if err != nil {
 		return nil, err
 	}
+	txns, err := w.getTransactions(start, stop, req.TxnsLimit, req.TxnsSkip, coinbase, req.Reversed,
+		ab.matcher(req.Label, req.Contact, w.chainParams), nil)
+	if err != nil {
+		return nil, err
+	}
+	describe := func(txn *TransactionSummary) (*rpc_pb.ContextualTransaction, er.R) {
+		tx, err := w.describeTxn(txn.Transaction, req.VinDetail)
+		if err != nil {
+			return nil, err
+		}
+		var mtx wire.MsgTx
+		if err := mtx.Deserialize(bytes.NewReader(txn.Transaction)); err != nil {
+			return nil, err
+		}
+		for _, vout := range tx.Vout {
+			vout.Label = ab.outputLabel(wire.NewOutPoint(txn.Hash, vout.N), vout.Address)
+		}
+		return &rpc_pb.ContextualTransaction{
+			Tx:       tx,
+			TxBin:    util.If(req.TxBin, txn.Transaction, nil),
+			Label:    txn.Label,
+			Contacts: ab.contactsOf(&mtx, w.chainParams),
+		}, nil
+	}
 	txDetails := &rpc_pb.TransactionDetails{
 		Transactions: make([]*rpc_pb.ContextualTransaction, 0,
 			len(txns.MinedTransactions)+len(txns.UnminedTransactions)),
@@ -1664,34 +1754,27 @@ func (w *Wallet) GetTransactions1(req *rpc_pb.GetTransactionsRequest) (*rpc_pb.T
 	bs := w.Manager.SyncedTo()
 	for _, blk := range txns.MinedTransactions {
 		blkHash := blk.Hash.String()
-		for _, txn := range blk.Transactions {
-			tx, err := w.describeTxn(txn.Transaction, req.VinDetail)
+		for i := range blk.Transactions {
+			txn := &blk.Transactions[i]
+			ct, err := describe(txn)
 			if err != nil {
 				return nil, err
 			}
-			txDetails.Transactions = append(txDetails.Transactions, &rpc_pb.ContextualTransaction{
-				Tx:               tx,
-				TxBin:            util.If(req.TxBin, txn.Transaction, nil),
-				NumConfirmations: bs.Height - blk.Height,
-				BlockHash:        blkHash,
-				BlockHeight:      blk.Height,
-				Time:             blk.Timestamp,
-			})
+			ct.NumConfirmations = bs.Height - blk.Height
+			ct.BlockHash = blkHash
+			ct.BlockHeight = blk.Height
+			ct.Time = blk.Timestamp
+			txDetails.Transactions = append(txDetails.Transactions, ct)
 		}
 	}
-	for _, txn := range txns.UnminedTransactions {
-		tx, err := w.describeTxn(txn.Transaction, req.VinDetail)
+	for i := range txns.UnminedTransactions {
+		txn := &txns.UnminedTransactions[i]
+		ct, err := describe(txn)
 		if err != nil {
 			return nil, err
 		}
-		txDetails.Transactions = append(txDetails.Transactions, &rpc_pb.ContextualTransaction{
-			Tx:               tx,
-			TxBin:            util.If(req.TxBin, txn.Transaction, nil),
-			NumConfirmations: 0,
-			BlockHash:        "",
-			BlockHeight:      0,
-			Time:             txn.Timestamp,
-		})
+		ct.Time = txn.Timestamp
+		txDetails.Transactions = append(txDetails.Transactions, ct)
 	}
 
 	// Sort transactions by number of confirmations rather than height so
@@ -1713,7 +1796,7 @@ func (w *Wallet) GetTransactions1(req *rpc_pb.GetTransactionsRequest) (*rpc_pb.T
 
 // GetWalletSeed
 func (w *Wallet) registerRpc() {
-	walletLoosetxns := apiv1.DefineCategory(w.api.Category("wallet"), "loosetxns",
+	walletLoosetxns := apiv1.DefineCategory(w.api, "loosetxns",
 		`
 		Loose transactions which have not yet been logged in the blockchain
 
@@ -1751,6 +1834,247 @@ func (w *Wallet) registerRpc() {
 			return &rpc_pb.LooseTxnRes{IsWatching: w.WatchingLooseTransactions()}, nil
 		},
 	)
+
+	walletFold := apiv1.DefineCategory(w.api, "fold",
+		`
+		Fold (consolidate) small outputs into larger ones
+
+		Mining payouts leave many small outputs which make transactions large
+		and expensive. When the fold job is running, the wallet periodically
+		spends the smallest outputs of an address back to the same address,
+		until there is nothing left to fold or the fee budget is spent.
+		Locked outputs are never folded. The job continues when the wallet is
+		restarted.
+		`,
+	)
+	apiv1.Endpoint(walletFold,
+		"start",
+		`
+		Start folding small outputs
+
+		If the fold job is already running, it is restarted with the new
+		configuration and the fees spent are counted from zero.
+		`,
+		w.StartFold,
+	)
+	apiv1.Endpoint(walletFold,
+		"stop",
+		`
+		Stop folding small outputs
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.WalletFoldStatus, er.R) {
+			return w.StopFold()
+		},
+	)
+	apiv1.Endpoint(walletFold,
+		"",
+		`
+		Get the status of the fold job
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.WalletFoldStatus, er.R) {
+			return w.FoldStatus()
+		},
+	)
+
+	walletWebhook := apiv1.DefineCategory(w.api, "webhook",
+		`
+		Notify an HTTP server of payments to the wallet
+
+		Each webhook is a URL and a filter of addresses, accounts and the
+		number of confirmations. When a matching transaction is seen
+		unconfirmed (only if min_conf is zero), when it reaches min_conf
+		confirmations, and when it is removed by a reorg, the wallet POSTs
+		a JSON event to the URL. The event is signed with HMAC-SHA256 using
+		the secret of the webhook, the hex signature is in the
+		X-Pkt-Signature header. Deliveries which are not answered with a
+		2xx status are retried with a backoff, up to 10 times. Events to a
+		URL are sent in order, while a delivery waits to be retried the
+		later events to the same URL wait as well.
+		`,
+	)
+	apiv1.Endpoint(walletWebhook,
+		"create",
+		`
+		Register a new webhook
+
+		The secret which signs the events is returned only once, keep it.
+		`,
+		w.CreateWebhook,
+	)
+	apiv1.Endpoint(walletWebhook,
+		"delete",
+		`
+		Remove a webhook
+
+		Deliveries to the webhook which are still pending will fail.
+		`,
+		func(req *rpc_pb.WalletWebhookRequest) (*rpc_pb.Null, er.R) {
+			return nil, w.DeleteWebhook(req.Id)
+		},
+	)
+	apiv1.Endpoint(walletWebhook,
+		"",
+		`
+		List the webhooks
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.WalletWebhookList, er.R) {
+			return w.Webhooks()
+		},
+	)
+	apiv1.Endpoint(walletWebhook,
+		"deliveries",
+		`
+		Get the log of deliveries, most recent first
+
+		The log can be filtered by webhook and by status, which is one of
+		pending, delivered or failed.
+		`,
+		w.WebhookDeliveries,
+	)
+	apiv1.Endpoint(walletWebhook,
+		"test",
+		`
+		Send a test event to a webhook
+		`,
+		func(req *rpc_pb.WalletWebhookRequest) (*rpc_pb.WalletWebhookDelivery, er.R) {
+			return w.TestWebhook(req.Id)
+		},
+	)
+
+	walletAddressbook := apiv1.DefineCategory(w.api, "addressbook",
+		`
+		Contacts and labels of transactions, addresses and outputs
+
+		A contact names a counterparty and lists its addresses, an address
+		belongs to at most one contact. Labels and contacts can be used to
+		filter /wallet/transaction/query, and they can be exported and
+		imported in the BIP-329 format.
+		`,
+	)
+	apiv1.Endpoint(walletAddressbook,
+		"",
+		`
+		Get all contacts and labels
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.AddressBook, er.R) {
+			return w.AddressBook()
+		},
+	)
+	apiv1.Endpoint(walletAddressbook,
+		"setcontact",
+		`
+		Create a contact, or replace the contact with the same name
+		`,
+		w.SetContact,
+	)
+	apiv1.Endpoint(walletAddressbook,
+		"deletecontact",
+		`
+		Remove a contact
+
+		The labels of the addresses of the contact are kept.
+		`,
+		func(req *rpc_pb.AddressBookContactRequest) (*rpc_pb.Null, er.R) {
+			return nil, w.DeleteContact(req.Name)
+		},
+	)
+	apiv1.Endpoint(walletAddressbook,
+		"label",
+		`
+		Label a transaction, an address or an output
+
+		The type is tx, addr or output and the ref is respectively a txid,
+		an address or txid:vout. An empty label removes the label.
+		`,
+		func(req *rpc_pb.AddressBookLabel) (*rpc_pb.Null, er.R) {
+			return nil, w.SetLabel(req)
+		},
+	)
+	apiv1.Endpoint(walletAddressbook,
+		"export",
+		`
+		Export all labels as BIP-329 JSON lines
+
+		The addresses of contacts are exported with a non-standard contact
+		field, which other wallets ignore. An address which has no label is
+		labelled with the name of its contact.
+		`,
+		func(_ *rpc_pb.Null) (*rpc_pb.AddressBookExport, er.R) {
+			return w.ExportLabels()
+		},
+	)
+	apiv1.Endpoint(walletAddressbook,
+		"import",
+		`
+		Import BIP-329 JSON lines
+
+		Records of the types tx, addr and output are imported, other types
+		and the labels of transactions which are not in the wallet are
+		skipped. Existing labels are kept unless overwrite is set.
+		`,
+		w.ImportLabels,
+	)
+
+	walletSchedule := apiv1.DefineCategory(w.api, "schedule",
+		`
+		Time locked transactions which are waiting to be broadcast
+
+		A transaction whose lock time (nLockTime or a relative CSV lock time)
+		is not reached can't be relayed. /wallet/transaction/sendfrom and
+		/wallet/transaction/sendmany store such a transaction here, and it is
+		broadcast once the next block can contain it. The inputs are locked
+		until then, if one is spent anyway the transaction fails.
+		`,
+	)
+	apiv1.Endpoint(walletSchedule,
+		"",
+		`
+		List the scheduled transactions
+
+		The status is pending, published, failed or cancelled.
+		`,
+		w.ScheduledTransactions,
+	)
+	apiv1.Endpoint(walletSchedule,
+		"add",
+		`
+		Schedule a signed transaction to be broadcast once it is final
+
+		All inputs must be unspent outputs of the wallet, they are locked until
+		the transaction is broadcast.
+		`,
+		func(req *rpc_pb.ScheduleTransactionRequest) (*rpc_pb.ScheduledTransaction, er.R) {
+			serializedTx := req.Tx
+			if len(serializedTx) == 0 {
+				stx, err := util.DecodeHex(req.HexTx)
+				if err != nil {
+					return nil, err
+				}
+				serializedTx = stx
+			}
+			tx := &wire.MsgTx{}
+			if err := tx.Deserialize(bytes.NewReader(serializedTx)); err != nil {
+				return nil, err
+			}
+			return w.ScheduleTransaction(tx, req.Label)
+		},
+	)
+	apiv1.Endpoint(walletSchedule,
+		"cancel",
+		`
+		Cancel a pending scheduled transaction and unlock its inputs
+
+		The transaction is signed, so anyone who has a copy of it can still
+		broadcast it. To be sure that it is never mined, spend one of its inputs.
+		`,
+		func(req *rpc_pb.ScheduledTransactionRequest) (*rpc_pb.ScheduledTransaction, er.R) {
+			txid, err := chainhash.NewHashFromStr(req.Txid)
+			if err != nil {
+				return nil, err
+			}
+			return w.CancelScheduledTransaction(txid)
+		},
+	)
 }
 
 // GetTransactions returns transaction results between a starting and ending
@@ -1769,6 +2093,19 @@ func (w *Wallet) GetTransactions(
 	limit, skip, //0 means no limit imposed
 	coinbase int32, reversed bool,
 	cancel <-chan struct{},
+) (*GetTransactionsResult, er.R) {
+	return w.getTransactions(startBlock, endBlock, limit, skip, coinbase, reversed, nil, cancel)
+}
+
+// getTransactions is GetTransactions with a filter, if the filter is non-nil
+// then only the transactions which it matches are returned and counted
+// against the skip and the limit.
+func (w *Wallet) getTransactions(
+	startBlock, endBlock *BlockIdentifier,
+	limit, skip int32,
+	coinbase int32, reversed bool,
+	filter func(*wtxmgr.TxDetails) bool,
+	cancel <-chan struct{},
 ) (*GetTransactionsResult, er.R) {
 	var start, end int32 = 0, -1
 
@@ -1824,6 +2161,20 @@ func (w *Wallet) GetTransactions(
 			for i := range details {
 				txs = append(txs, makeTxSummary(dbtx, w, &details[i]))
 			}
+			filterTxs := func(txs []TransactionSummary) []TransactionSummary {
+				if filter == nil {
+					return txs
+				}
+				out := txs[:0]
+				for _, tx := range txs {
+					for i := range details {
+						if details[i].Hash == *tx.Hash && filter(&details[i]) {
+							out = append(out, tx)
+						}
+					}
+				}
+				return out
+			}
 
 			if details[0].Block.Height != -1 {
 				blockHash := details[0].Block.Hash
@@ -1834,6 +2185,9 @@ func (w *Wallet) GetTransactions(
 						txs = txs[:1]
 					}
 				}
+				if txs = filterTxs(txs); filter != nil && len(txs) == 0 {
+					return false, nil
+				}
 				//Skipping transactions
 				if (skippedtxns + int32(len(txs))) < skip {
 					skippedtxns += int32(len(txs))
@@ -1852,6 +2206,7 @@ func (w *Wallet) GetTransactions(
 					totalTxns += len(txs)
 				}
 			} else if coinbase != coinbaseOnly {
+				txs = filterTxs(txs)
 				if (skippedtxns + int32(len(txs))) < skip {
 					skippedtxns += int32(len(txs))
 				} else {
@@ -2026,22 +2381,63 @@ func (w *Wallet) ImportPrivateKey(scope waddrmgr.KeyScope, wif *btcutil.WIF,
 		return "", err
 	}
 
-	// The starting block for the key is the genesis block unless otherwise
-	// specified.
+	bs, err = w.importBlockStamp(bs)
+	if err != nil {
+		return "", err
+	}
+
+	// Attempt to import private key into wallet.
+	var addr btcutil.Address
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		maddr, err := manager.ImportPrivateKey(addrmgrNs, wif, bs)
+		if err != nil {
+			return err
+		}
+		addr = maddr.Address()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Rescan blockchain for transactions with txout scripts paying to the
+	// imported address.
+	if rescan {
+		// Submit rescan job and log when the import has completed.
+		// Do not block on finishing the rescan.  The rescan success
+		// or failure is logged elsewhere, and the channel is not
+		// required to be read, so discard the return value.
+		name := fmt.Sprintf("import-%s-resync", addr.EncodeAddress())
+		w.beginImportRescan(name, bs.Height, []btcutil.Address{addr})
+	}
+	w.watch.WatchAddr(addr)
+
+	addrStr := addr.EncodeAddress()
+	log.Infof("Imported payment address %s", addrStr)
+
+	// Return the payment address string of the imported private key.
+	return addrStr, nil
+}
+
+// importBlockStamp returns the block from which the history of imported keys
+// should be scanned.  The starting block is the genesis block unless otherwise
+// specified.
+func (w *Wallet) importBlockStamp(bs *waddrmgr.BlockStamp) (*waddrmgr.BlockStamp, er.R) {
 	if bs == nil {
 		const secondBlockIndex = int64(1)
 
 		secondBlockHash, err := w.chainClient.GetBlockHash(secondBlockIndex)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		secondBlockHeader, err := w.chainClient.GetBlockHeader(secondBlockHash)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		secondBlockTimestamp := secondBlockHeader.Timestamp
 
-		log.Debugf("ImportPrivateKey() [1] second block -> height: %v; hash: %v; timestamp: %v", secondBlockIndex, secondBlockHash, secondBlockTimestamp)
+		log.Debugf("importBlockStamp() second block -> height: %v; hash: %v; timestamp: %v", secondBlockIndex, secondBlockHash, secondBlockTimestamp)
 
 		bs = &waddrmgr.BlockStamp{
 			Hash:      *secondBlockHash,
@@ -2056,47 +2452,127 @@ func (w *Wallet) ImportPrivateKey(scope waddrmgr.KeyScope, wif *btcutil.WIF,
 			bs.Timestamp = header.Timestamp
 		}
 	}
+	return bs, nil
+}
 
-	// Attempt to import private key into wallet.
-	var addr btcutil.Address
+// beginImportRescan submits a rescan job for newly imported addresses.
+// The caller must hold rescanJLock.
+func (w *Wallet) beginImportRescan(name string, height int32, addrs []btcutil.Address) {
+	watch := watcher.New()
+	watch.WatchAddrs(addrs)
+	w.rescanJ = &rescanJob{
+		name:       name,
+		height:     height,
+		stopHeight: -1,
+		watch:      &watch,
+	}
+	w.rescanBegan(w.rescanJ)
+}
+
+// DefaultAccountGapLimit is the gap limit of a watch-only account if none is
+// specified.  The wallet only detects payments to addresses which have been
+// derived, so it keeps this many unused receive and change addresses after the
+// last one which has been paid.
+const DefaultAccountGapLimit = 20
+
+// ImportAccountPubKey creates a watch-only account from an account level
+// extended public key (xpub, ypub or zpub) and derives gapLimit receive and
+// change addresses for it.  More addresses are derived as they are used, so
+// that there are always gapLimit unused addresses on each branch.  If the
+// purpose of the scope is zero then it is inferred from the version bytes of
+// the key, xpub keys default to BIP0044.  The wallet can track the balance and
+// history of the account and create unsigned transactions which spend from it,
+// but it cannot sign them.
+//
+// NOTE: If a block stamp is not provided, then the rescan will start from the
+// genesis block of the corresponding chain.
+func (w *Wallet) ImportAccountPubKey(scope waddrmgr.KeyScope, name, extPubKey string,
+	gapLimit uint32, bs *waddrmgr.BlockStamp, rescan bool,
+) (*waddrmgr.AccountProperties, []btcutil.Address, er.R) {
+
+	if rescan {
+		w.rescanJLock.Lock()
+		defer w.rescanJLock.Unlock()
+		if w.rescanJ != nil {
+			return nil, nil, er.Errorf(
+				"You requested a rescan but there is already a rescan job"+
+					" ([%v]) running, use `stopresync` to stop it", w.rescanJ.name)
+		}
+	}
+
+	pubKey, purpose, err := waddrmgr.ParseExtendedPubKey(extPubKey, w.chainParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	if scope.Purpose == 0 {
+		scope.Purpose = purpose
+		if scope.Purpose == 0 {
+			scope.Purpose = waddrmgr.KeyScopeBIP0044.Purpose
+		}
+	} else if purpose != 0 && purpose != scope.Purpose {
+		return nil, nil, er.Errorf("The extended public key is for purpose [%d] "+
+			"but scope [%s] was requested", purpose, scope.String())
+	}
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, nil, err
+	}
+	if gapLimit == 0 {
+		gapLimit = DefaultAccountGapLimit
+	}
+
+	if rescan {
+		if bs, err = w.importBlockStamp(bs); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var props *waddrmgr.AccountProperties
+	var addrs []btcutil.Address
 	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-		maddr, err := manager.ImportPrivateKey(addrmgrNs, wif, bs)
+		account, err := manager.NewAccountWatchingOnly(addrmgrNs, name, pubKey, gapLimit)
 		if err != nil {
 			return err
 		}
-		addr = maddr.Address()
-		return nil
+		ext, err := manager.NextExternalAddresses(addrmgrNs, account, gapLimit)
+		if err != nil {
+			return err
+		}
+		internal, err := manager.NextInternalAddresses(addrmgrNs, account, gapLimit)
+		if err != nil {
+			return err
+		}
+		for _, ma := range append(ext, internal...) {
+			addrs = append(addrs, ma.Address())
+		}
+		props, err = manager.AccountProperties(addrmgrNs, account)
+		return err
 	})
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
 
-	// Rescan blockchain for transactions with txout scripts paying to the
-	// imported address.
 	if rescan {
-		// Submit rescan job and log when the import has completed.
-		// Do not block on finishing the rescan.  The rescan success
-		// or failure is logged elsewhere, and the channel is not
-		// required to be read, so discard the return value.
-		name := fmt.Sprintf("import-%s-resync", addr.EncodeAddress())
-		watch := watcher.New()
-		watch.WatchAddr(addr)
-		w.rescanJ = &rescanJob{
-			name:       name,
-			height:     bs.Height,
-			stopHeight: -1,
-			watch:      &watch,
-		}
-		w.rescanBegan(w.rescanJ)
+		name := fmt.Sprintf("import-account-%s-resync", props.AccountName)
+		w.beginImportRescan(name, bs.Height, addrs)
 	}
-	w.watch.WatchAddr(addr)
+	w.watch.WatchAddrs(addrs)
 
-	addrStr := addr.EncodeAddress()
-	log.Infof("Imported payment address %s", addrStr)
+	log.Infof("Imported watch-only account [%s] with scope [%s]",
+		props.AccountName, scope.String())
+	return props, addrs, nil
+}
 
-	// Return the payment address string of the imported private key.
-	return addrStr, nil
+// watchOnlyAddresses returns the addresses of all watch-only accounts, the
+// wallet is not able to sign for coins which are paid to these addresses.
+func (w *Wallet) watchOnlyAddresses(addrmgrNs walletdb.ReadBucket) (map[string]struct{}, er.R) {
+	out := make(map[string]struct{})
+	err := w.Manager.ForEachWatchOnlyAddress(addrmgrNs, func(ma waddrmgr.ManagedAddress) er.R {
+		out[ma.Address().EncodeAddress()] = struct{}{}
+		return nil
+	})
+	return out, err
 }
 
 // LockedOutpoint returns whether an outpoint has been marked as locked and
@@ -2105,53 +2581,154 @@ func (w *Wallet) LockedOutpoint(op wire.OutPoint) bool {
 	w.lockedOutpointsMtx.Lock()
 	defer w.lockedOutpointsMtx.Unlock()
 
-	_, locked := w.lockedOutpoints[op]
-	return locked
+	l, locked := w.lockedOutpoints[op]
+	return locked && !l.Expired(time.Now())
 }
 
 // LockOutpoint marks an outpoint as locked, that is, it should not be used as
-// an input for newly created transactions.
-func (w *Wallet) LockOutpoint(op wire.OutPoint, name string) {
-	w.lockedOutpoints[op] = name
+// an input for newly created transactions. The lock is stored in the wallet db
+// so it remains after a restart.
+func (w *Wallet) LockOutpoint(op wire.OutPoint, name string) er.R {
+	return w.LockOutpointUntil(op, name, time.Time{})
+}
+
+// LockOutpointUntil marks an outpoint as locked until the time expiry, if
+// expiry is zero then the lock never expires.
+func (w *Wallet) LockOutpointUntil(op wire.OutPoint, name string, expiry time.Time) er.R {
+	// Coin selection checks the locks while holding a db transaction, so the
+	// db must never be written while holding lockedOutpointsMtx.
+	w.lockedOutpointsWriteMtx.Lock()
+	defer w.lockedOutpointsWriteMtx.Unlock()
+
+	l := wtxmgr.NamedLock{OutPoint: op, Name: name, Expiry: expiry}
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		return w.TxStore.PutNamedLock(tx.ReadWriteBucket(wtxmgrNamespaceKey), &l)
+	}); err != nil {
+		return err
+	}
+
+	w.lockedOutpointsMtx.Lock()
+	w.lockedOutpoints[op] = l
+	w.lockedOutpointsMtx.Unlock()
+	return nil
+}
+
+// lockInputs locks the outpoints spent by inputs under the name.
+func (w *Wallet) lockInputs(inputs []*wire.TxIn, name string) er.R {
+	for _, in := range inputs {
+		if err := w.LockOutpoint(in.PreviousOutPoint, name); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // UnlockOutpoint marks an outpoint as unlocked, that is, it may be used as an
 // input for newly created transactions.
-func (w *Wallet) UnlockOutpoint(op wire.OutPoint) {
-	w.lockedOutpointsMtx.Lock()
-	defer w.lockedOutpointsMtx.Unlock()
-	delete(w.lockedOutpoints, op)
+func (w *Wallet) UnlockOutpoint(op wire.OutPoint) er.R {
+	return w.unlockOutpoints(func(l *wtxmgr.NamedLock) bool {
+		return l.OutPoint == op
+	})
 }
 
 // ResetLockedOutpoints resets the set of locked outpoints so all may be used
-// as inputs for new transactions.
-func (w *Wallet) ResetLockedOutpoints(lockName *string) {
+// as inputs for new transactions. If lockName is not nil, only the outpoints
+// which are locked with that name are unlocked.
+func (w *Wallet) ResetLockedOutpoints(lockName *string) er.R {
+	return w.unlockOutpoints(func(l *wtxmgr.NamedLock) bool {
+		return lockName == nil || l.Name == *lockName
+	})
+}
+
+func (w *Wallet) unlockOutpoints(match func(l *wtxmgr.NamedLock) bool) er.R {
+	w.lockedOutpointsWriteMtx.Lock()
+	defer w.lockedOutpointsWriteMtx.Unlock()
+
+	var ops []wire.OutPoint
 	w.lockedOutpointsMtx.Lock()
-	defer w.lockedOutpointsMtx.Unlock()
-	if lockName != nil {
-		for op, ln := range w.lockedOutpoints {
-			if ln == *lockName {
-				delete(w.lockedOutpoints, op)
+	for op, l := range w.lockedOutpoints {
+		if match(&l) {
+			ops = append(ops, op)
+		}
+	}
+	w.lockedOutpointsMtx.Unlock()
+	if len(ops) == 0 {
+		return nil
+	}
+
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, op := range ops {
+			if err := w.TxStore.DeleteNamedLock(ns, op); err != nil {
+				return err
 			}
 		}
-	} else {
-		w.lockedOutpoints = map[wire.OutPoint]string{}
+		return nil
+	}); err != nil {
+		return err
 	}
+
+	w.lockedOutpointsMtx.Lock()
+	for _, op := range ops {
+		delete(w.lockedOutpoints, op)
+	}
+	w.lockedOutpointsMtx.Unlock()
+	return nil
+}
+
+// purgeLockedOutpoints deletes the locks which have expired from the db and
+// drops those which are not in the db any more, because the output was spent.
+func (w *Wallet) purgeLockedOutpoints() er.R {
+	w.lockedOutpointsWriteMtx.Lock()
+	defer w.lockedOutpointsWriteMtx.Unlock()
+
+	var locks []wtxmgr.NamedLock
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		var err er.R
+		locks, err = w.TxStore.DeleteExpiredNamedLocks(tx.ReadWriteBucket(wtxmgrNamespaceKey))
+		return err
+	}); err != nil {
+		return err
+	}
+
+	lockedOutpoints := make(map[wire.OutPoint]wtxmgr.NamedLock, len(locks))
+	for _, l := range locks {
+		lockedOutpoints[l.OutPoint] = l
+	}
+	w.lockedOutpointsMtx.Lock()
+	w.lockedOutpoints = lockedOutpoints
+	w.lockedOutpointsMtx.Unlock()
+	return nil
 }
 
 // LockedOutpoints returns a slice of currently locked outpoints.  This is
 // intended to be used by marshaling the result as a JSON array for
-// listlockunspent RPC results.
-func (w *Wallet) LockedOutpoints() []btcjson.LockedUnspent {
-	locked := make([]btcjson.LockedUnspent, len(w.lockedOutpoints))
-	i := 0
-	for op, ln := range w.lockedOutpoints {
-		locked[i] = btcjson.LockedUnspent{
+// listlockunspent RPC results. If lockName is not nil, only the outpoints
+// which are locked with that name are returned. The locks which expired or
+// whose output was spent are purged first.
+func (w *Wallet) LockedOutpoints(lockName *string) []btcjson.LockedUnspent {
+	if err := w.purgeLockedOutpoints(); err != nil {
+		log.Warnf("Unable to purge the locked outpoints: %v", err)
+	}
+
+	w.lockedOutpointsMtx.Lock()
+	defer w.lockedOutpointsMtx.Unlock()
+
+	now := time.Now()
+	locked := make([]btcjson.LockedUnspent, 0, len(w.lockedOutpoints))
+	for op, l := range w.lockedOutpoints {
+		if l.Expired(now) || (lockName != nil && l.Name != *lockName) {
+			continue
+		}
+		lu := btcjson.LockedUnspent{
 			Txid:     op.Hash.String(),
 			Vout:     op.Index,
-			LockName: ln,
+			LockName: l.Name,
 		}
-		i++
+		if !l.Expiry.IsZero() {
+			lu.Expires = l.Expiry.Unix()
+		}
+		locked = append(locked, lu)
 	}
 	return locked
 }
@@ -2357,6 +2934,20 @@ func (w *Wallet) SendOutputs(txr CreateTxReq) (*txauthor.AuthoredTx, er.R) {
 		return createdTx, nil
 	}
 
+	// A transaction which is not final yet can't be relayed, the scheduler
+	// broadcasts it later.
+	if txr.LockTime != 0 || txr.Sequence != 0 {
+		if final, err := w.txFinal(createdTx.Tx); err != nil {
+			return nil, err
+		} else if !final {
+			if _, err := w.ScheduleTransaction(createdTx.Tx, txr.Label); err != nil {
+				return nil, err
+			}
+			createdTx.Scheduled = true
+			return createdTx, nil
+		}
+	}
+
 	txHash, err := w.ReliablyPublishTransaction(createdTx.Tx, txr.Label)
 	if err != nil {
 		return nil, err
@@ -3445,7 +4036,6 @@ func (w *Wallet) walletInit() {
 }
 
 func (w *Wallet) goMainLoop() {
-	w.wg.Add(1)
 	for {
 		if w.ChainClient() != nil {
 			break
@@ -3459,6 +4049,7 @@ func (w *Wallet) goMainLoop() {
 		rapidCycle := w.rescan()
 		rapidCycle = w.checkBlock() || rapidCycle
 		w.checkTxns()
+		w.foldCoins()
 		if w.ShuttingDown() {
 			break
 		}
@@ -3511,8 +4102,9 @@ func Open(
 ) (*Wallet, er.R) {
 
 	var (
-		addrMgr *waddrmgr.Manager
-		txMgr   *wtxmgr.Store
+		addrMgr    *waddrmgr.Manager
+		txMgr      *wtxmgr.Store
+		namedLocks []wtxmgr.NamedLock
 	)
 
 	// Before attempting to open the wallet, we'll check if there are any
@@ -3545,7 +4137,8 @@ func Open(
 			return err
 		}
 
-		return nil
+		namedLocks, err = txMgr.DeleteExpiredNamedLocks(txMgrBucket)
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -3553,12 +4146,17 @@ func Open(
 
 	log.Infof("Opened wallet") // TODO: log balance? last sync height?
 
+	lockedOutpoints := make(map[wire.OutPoint]wtxmgr.NamedLock, len(namedLocks))
+	for _, l := range namedLocks {
+		lockedOutpoints[l.OutPoint] = l
+	}
+
 	w := &Wallet{
 		publicPassphrase:   pubPass,
 		db:                 db,
 		Manager:            addrMgr,
 		TxStore:            txMgr,
-		lockedOutpoints:    map[wire.OutPoint]string{},
+		lockedOutpoints:    lockedOutpoints,
 		recoveryWindow:     recoveryWindow,
 		createTxRequests:   make(chan createTxRequest),
 		unlockRequests:     make(chan unlockRequest),
@@ -3575,6 +4173,7 @@ func Open(
 		looseTransactions:       lock.NewGenMutex[[]wire.MsgTx](nil, "looseTransactions"),
 		looseTransactionsStop:   event.NewEmitter[struct{}]("looseTransactionsStop"),
 		looseTransactionsActive: lock.AtomicBool{},
+		fold:                    lock.NewGenMutex(foldJob{}, "fold"),
 		api:                     api,
 	}
 
@@ -1,15 +1,24 @@
 package wallet
 
 import (
+	"bytes"
 	"encoding/hex"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
+	"github.com/pkt-cash/pktd/btcutil/psbt"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/chaincfg"
 	"github.com/pkt-cash/pktd/chaincfg/genesis"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
 	"github.com/pkt-cash/pktd/pktwallet/walletdb"
 	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
 )
 
 var (
@@ -203,3 +212,172 @@ func TestLabelTransaction(t *testing.T) {
 		})
 	}
 }
+
+// TestImportAccountPubKey tests that coins paid to a watch-only account are
+// tracked and can be used to fund a PSBT, but are never selected for a
+// transaction which the wallet must sign.
+func TestImportAccountPubKey(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	master, err := hdkeychain.NewMaster(
+		bytes.Repeat([]byte{0x09}, 32), &chaincfg.TestNet3Params,
+	)
+	util.RequireNoErr(t, err)
+	acctKey := master
+	for _, i := range []uint32{84, 1, 0} {
+		acctKey, err = acctKey.Derive(hdkeychain.HardenedKeyStart + i)
+		util.RequireNoErr(t, err)
+	}
+	acctPub, err := acctKey.Neuter()
+	util.RequireNoErr(t, err)
+
+	// A tpub does not say which scope it belongs to, so asking for one
+	// which does not match is not an error.
+	props, addrs, err := w.ImportAccountPubKey(
+		waddrmgr.KeyScopeBIP0084, "treasury", acctPub.String(), 2, nil, false,
+	)
+	util.RequireNoErr(t, err)
+	if !props.WatchOnly || len(addrs) != 4 {
+		t.Fatalf("unexpected import result %+v with %d addresses", props, len(addrs))
+	}
+
+	pkScript, err := txscript.PayToAddrScript(addrs[0])
+	util.RequireNoErr(t, err)
+	incomingTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(1000000, pkScript)},
+	}
+	addUtxo(t, w, incomingTx)
+
+	dest, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	destScript, err := txscript.PayToAddrScript(dest)
+	util.RequireNoErr(t, err)
+	outputs := []*wire.TxOut{wire.NewTxOut(500000, destScript)}
+
+	// The only coins are watch-only so the wallet can't pay by itself.
+	_, err = w.CreateSimpleTx(CreateTxReq{
+		Outputs:     outputs,
+		Minconf:     1,
+		FeeSatPerKB: 1000,
+		MaxInputs:   -1,
+		SendMode:    SendModeSigned,
+	})
+	if !InsufficientFundsError.Is(err) {
+		t.Fatalf("expected InsufficientFundsError, got %v", err)
+	}
+
+	// Nor can a PSBT unless the watch-only addresses are asked for.
+	packet, err := psbt.New(nil, outputs, 2, 0, nil)
+	util.RequireNoErr(t, err)
+	_, err = w.FundPsbt(packet, 0, 1000)
+	if err == nil || !strings.Contains(err.Message(), "insufficient funds") {
+		t.Fatalf("expected InsufficientFundsError, got %v", err)
+	}
+
+	packet, err = psbt.New(nil, outputs, 2, 0, nil)
+	util.RequireNoErr(t, err)
+	_, err = w.FundPsbtFrom(packet, 0, addrs[:1], 1000, "psbt")
+	util.RequireNoErr(t, err)
+	if len(packet.Inputs) != 1 ||
+		packet.UnsignedTx.TxIn[0].PreviousOutPoint.Hash != incomingTx.TxHash() {
+
+		t.Fatalf("expected the watch-only coin to be spent")
+	}
+	if !w.LockedOutpoint(packet.UnsignedTx.TxIn[0].PreviousOutPoint) {
+		t.Fatalf("expected the selected coin to be locked while funding")
+	}
+	signed, err := w.SignPsbt(packet)
+	util.RequireNoErr(t, err)
+	if len(signed) != 0 {
+		t.Fatalf("signed %d watch-only inputs", len(signed))
+	}
+
+	// A payment to the last receive address derives 2 more.
+	pkScript, err = txscript.PayToAddrScript(addrs[1])
+	util.RequireNoErr(t, err)
+	b := new(bytes.Buffer)
+	util.RequireNoErr(t, (&wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(2000000, pkScript)},
+	}).Serialize(b))
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	util.RequireNoErr(t, err)
+	util.RequireNoErr(t, walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		return w.addRelevantTx(tx, rec, nil)
+	}))
+	count := 0
+	util.RequireNoErr(t, walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		return w.Manager.ForEachAccountAddress(tx.ReadBucket(waddrmgrNamespaceKey),
+			props.AccountNumber, func(waddrmgr.ManagedAddress) er.R {
+				count++
+				return nil
+			})
+	}))
+	if count != 6 {
+		t.Fatalf("expected 6 addresses after the gap was extended, got %d", count)
+	}
+}
+
+// TestLockOutpointPersisted ensures that named locks are kept in the database,
+// so that they remain when the wallet is opened again, and that expired locks
+// are released.
+func TestLockOutpointPersisted(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	op1 := wire.OutPoint{Hash: *TstTxHash, Index: 0}
+	op2 := wire.OutPoint{Hash: *TstTxHash, Index: 1}
+	op3 := wire.OutPoint{Hash: *TstTxHash, Index: 2}
+
+	// Locks are placed concurrently with coin selection checking them.
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			w.LockedOutpoint(op1)
+			w.LockedOutpoints(nil)
+		}
+		close(done)
+	}()
+	util.RequireNoErr(t, w.LockOutpoint(op1, "batch"))
+	util.RequireNoErr(t, w.LockOutpoint(op2, "batch"))
+	util.RequireNoErr(t, w.LockOutpointUntil(op3, "other", time.Now().Add(-time.Second)))
+	<-done
+
+	if w.LockedOutpoint(op3) {
+		t.Fatal("expired lock is still in effect")
+	}
+	batch := "batch"
+	if locked := w.LockedOutpoints(&batch); len(locked) != 2 {
+		t.Fatalf("expected 2 outpoints locked with name batch, got %v", locked)
+	}
+
+	// Listing the locks deleted the expired one from the db.
+	var stored []wtxmgr.NamedLock
+	util.RequireNoErr(t, walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		var err er.R
+		stored, err = w.TxStore.NamedLocks(tx.ReadBucket(wtxmgrNamespaceKey))
+		return err
+	}))
+	if len(stored) != 2 {
+		t.Fatalf("expected the expired lock to be purged, got %v", stored)
+	}
+
+	w2, err := Open(w.db, []byte("hello"), nil, &chaincfg.TestNet3Params, 250, nil)
+	util.RequireNoErr(t, err)
+	if !w2.LockedOutpoint(op1) || !w2.LockedOutpoint(op2) {
+		t.Fatal("locks were not persisted")
+	}
+	if locked := w2.LockedOutpoints(nil); len(locked) != 2 {
+		t.Fatalf("expected the expired lock to be removed, got %v", locked)
+	}
+
+	util.RequireNoErr(t, w2.UnlockOutpoint(op1))
+	util.RequireNoErr(t, w2.ResetLockedOutpoints(&batch))
+	w3, err := Open(w.db, []byte("hello"), nil, &chaincfg.TestNet3Params, 250, nil)
+	util.RequireNoErr(t, err)
+	if locked := w3.LockedOutpoints(nil); len(locked) != 0 {
+		t.Fatalf("expected no locks, got %v", locked)
+	}
+}
@@ -0,0 +1,732 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Webhooks tell an application about payments to the wallet without polling.
+// Every transaction which is stored by addRelevantTx is tracked in the wallet
+// db, and the webhook loop turns changes of its confirmation status into events
+// which are POSTed to each webhook whose filter matches. The events are kept in
+// a delivery log and retried with a backoff until the receiver accepts them.
+//
+// Each tracked transaction records which events were sent to which webhook:
+//
+//   [0:8]  Id of the webhook
+//   [8]    Flags of the events which were sent
+//
+// repeated for each webhook which was notified.
+
+var (
+	whookNamespaceKey = []byte("whook")
+	whookHooksKey     = []byte("hooks")
+	whookTrackedKey   = []byte("tracked")
+	whookLogKey       = []byte("log")
+	whookPendingKey   = []byte("pending")
+)
+
+const (
+	WebhookEventUnconfirmed = "unconfirmed"
+	WebhookEventConfirmed   = "confirmed"
+	WebhookEventReorged     = "reorged"
+	WebhookEventTest        = "test"
+
+	WebhookStatusPending   = "pending"
+	WebhookStatusDelivered = "delivered"
+	WebhookStatusFailed    = "failed"
+)
+
+const (
+	webhookIdLen = 8
+
+	// Transactions deeper than this are no longer watched for reorgs.
+	webhookTrackConfirms = 100
+
+	webhookInterval    = 2 * time.Second
+	webhookTimeout     = 10 * time.Second
+	webhookRetryBase   = 10 * time.Second
+	webhookRetryMax    = time.Hour
+	webhookMaxAttempts = 10
+
+	// Number of deliveries which are kept in the log, pending deliveries are
+	// never removed.
+	webhookLogSize = 1000
+
+	// Maximum number of deliveries attempted for one receiver in one cycle of
+	// the loop.
+	webhookBatch = 50
+)
+
+const (
+	webhookSentUnconfirmed byte = 1 << iota
+	webhookSentConfirmed
+)
+
+var webhookClient = &http.Client{Timeout: webhookTimeout}
+
+func webhookBuckets(dbtx walletdb.ReadWriteTx) (walletdb.ReadWriteBucket, er.R) {
+	b, err := dbtx.CreateTopLevelBucket(whookNamespaceKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range [][]byte{whookHooksKey, whookTrackedKey, whookLogKey, whookPendingKey} {
+		if _, err := b.CreateBucketIfNotExists(k); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func parseWebhookId(id string) ([]byte, er.R) {
+	b, errr := hex.DecodeString(id)
+	if errr != nil || len(b) != webhookIdLen {
+		return nil, er.Errorf("invalid webhook id [%s]", id)
+	}
+	return b, nil
+}
+
+func webhookLogKey(seq uint64) []byte {
+	var k [8]byte
+	binary.BigEndian.PutUint64(k[:], seq)
+	return k[:]
+}
+
+func loadWebhooks(hooks walletdb.ReadBucket) ([]*rpc_pb.WalletWebhook, er.R) {
+	var out []*rpc_pb.WalletWebhook
+	err := hooks.ForEach(func(k, v []byte) er.R {
+		h := &rpc_pb.WalletWebhook{}
+		if err := proto.Unmarshal(v, h); err != nil {
+			return er.E(err)
+		}
+		out = append(out, h)
+		return nil
+	})
+	return out, err
+}
+
+// CreateWebhook registers a URL which will be notified of transactions that
+// match the filter. The returned webhook carries the secret which signs the
+// events, it is not returned again.
+func (w *Wallet) CreateWebhook(req *rpc_pb.WalletWebhook) (*rpc_pb.WalletWebhook, er.R) {
+	u, errr := url.Parse(req.Url)
+	if errr != nil {
+		return nil, er.E(errr)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, er.Errorf("webhook url must be http or https, got [%s]", req.Url)
+	}
+	for _, a := range req.Addresses {
+		if _, err := btcutil.DecodeAddress(a, w.chainParams); err != nil {
+			return nil, err
+		}
+	}
+	id := make([]byte, webhookIdLen)
+	secret := make([]byte, 32)
+	if _, errr := rand.Read(id); errr != nil {
+		return nil, er.E(errr)
+	} else if _, errr := rand.Read(secret); errr != nil {
+		return nil, er.E(errr)
+	}
+	h := &rpc_pb.WalletWebhook{
+		Id:         hex.EncodeToString(id),
+		Url:        req.Url,
+		Addresses:  req.Addresses,
+		Accounts:   req.Accounts,
+		MinConf:    req.MinConf,
+		Secret:     hex.EncodeToString(secret),
+		CreatedSec: time.Now().Unix(),
+	}
+	v, errr := proto.Marshal(h)
+	if errr != nil {
+		return nil, er.E(errr)
+	}
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := webhookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		return b.NestedReadWriteBucket(whookHooksKey).Put(id, v)
+	}); err != nil {
+		return nil, err
+	}
+	log.Infof("Created webhook [%s] for [%s]", h.Id, h.Url)
+	return h, nil
+}
+
+// DeleteWebhook removes a webhook, deliveries to it which are still pending fail
+// when they are next attempted.
+func (w *Wallet) DeleteWebhook(id string) er.R {
+	rawId, err := parseWebhookId(id)
+	if err != nil {
+		return err
+	}
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := webhookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		hooks := b.NestedReadWriteBucket(whookHooksKey)
+		if hooks.Get(rawId) == nil {
+			return er.Errorf("no such webhook [%s]", id)
+		}
+		return hooks.Delete(rawId)
+	})
+}
+
+// Webhooks returns all of the registered webhooks, without their secrets.
+func (w *Wallet) Webhooks() (*rpc_pb.WalletWebhookList, er.R) {
+	out := &rpc_pb.WalletWebhookList{}
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		b := dbtx.ReadBucket(whookNamespaceKey)
+		if b == nil {
+			return nil
+		}
+		hooks, err := loadWebhooks(b.NestedReadBucket(whookHooksKey))
+		for _, h := range hooks {
+			h.Secret = ""
+		}
+		out.Webhooks = hooks
+		return err
+	})
+	return out, err
+}
+
+// WebhookDeliveries returns entries of the delivery log, most recent first.
+func (w *Wallet) WebhookDeliveries(req *rpc_pb.WalletWebhookDeliveriesRequest) (*rpc_pb.WalletWebhookDeliveries, er.R) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+	out := &rpc_pb.WalletWebhookDeliveries{}
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		b := dbtx.ReadBucket(whookNamespaceKey)
+		if b == nil {
+			return nil
+		}
+		c := b.NestedReadBucket(whookLogKey).ReadCursor()
+		for k, v := c.Last(); k != nil && len(out.Deliveries) < limit; k, v = c.Prev() {
+			d := &rpc_pb.WalletWebhookDelivery{}
+			if err := proto.Unmarshal(v, d); err != nil {
+				return er.E(err)
+			}
+			if (req.WebhookId == "" || d.Event.WebhookId == req.WebhookId) &&
+				(req.Status == "" || d.Status == req.Status) {
+				out.Deliveries = append(out.Deliveries, d)
+			}
+		}
+		return nil
+	})
+	return out, err
+}
+
+// TestWebhook queues a "test" event for the webhook.
+func (w *Wallet) TestWebhook(id string) (*rpc_pb.WalletWebhookDelivery, er.R) {
+	rawId, err := parseWebhookId(id)
+	if err != nil {
+		return nil, err
+	}
+	var out *rpc_pb.WalletWebhookDelivery
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := webhookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		if b.NestedReadWriteBucket(whookHooksKey).Get(rawId) == nil {
+			return er.Errorf("no such webhook [%s]", id)
+		}
+		out, err = enqueueWebhookEvent(b, &rpc_pb.WalletWebhookEvent{
+			WebhookId:   id,
+			Event:       WebhookEventTest,
+			BlockHeight: -1,
+		}, time.Now())
+		return err
+	})
+	return out, err
+}
+
+func enqueueWebhookEvent(
+	b walletdb.ReadWriteBucket,
+	ev *rpc_pb.WalletWebhookEvent,
+	now time.Time,
+) (*rpc_pb.WalletWebhookDelivery, er.R) {
+	logb := b.NestedReadWriteBucket(whookLogKey)
+	pending := b.NestedReadWriteBucket(whookPendingKey)
+	seq, err := logb.NextSequence()
+	if err != nil {
+		return nil, err
+	}
+	ev.DeliveryId = fmt.Sprintf("%d", seq)
+	ev.TimeSec = now.Unix()
+	d := &rpc_pb.WalletWebhookDelivery{
+		Id:             ev.DeliveryId,
+		Event:          ev,
+		Status:         WebhookStatusPending,
+		CreatedSec:     now.Unix(),
+		NextAttemptSec: now.Unix(),
+	}
+	if err := putWebhookDelivery(logb, seq, d); err != nil {
+		return nil, err
+	}
+	if err := pending.Put(webhookLogKey(seq), []byte{}); err != nil {
+		return nil, err
+	}
+
+	// Forget the oldest deliveries which are no longer pending.
+	if seq <= webhookLogSize {
+		return d, nil
+	}
+	// Deleting with the cursor would skip the entry after each deleted one.
+	var old [][]byte
+	c := logb.ReadCursor()
+	oldest := webhookLogKey(seq - webhookLogSize + 1)
+	for k, _ := c.First(); k != nil && bytes.Compare(k, oldest) < 0; k, _ = c.Next() {
+		if pending.Get(k) == nil {
+			old = append(old, append([]byte{}, k...))
+		}
+	}
+	for _, k := range old {
+		if err := logb.Delete(k); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func putWebhookDelivery(logb walletdb.ReadWriteBucket, seq uint64, d *rpc_pb.WalletWebhookDelivery) er.R {
+	v, errr := proto.Marshal(d)
+	if errr != nil {
+		return er.E(errr)
+	}
+	return logb.Put(webhookLogKey(seq), v)
+}
+
+// trackWebhookTx is called by addRelevantTx so that changes of the status of
+// the transaction will be notified, if there are any webhooks.
+func (w *Wallet) trackWebhookTx(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash, block *wtxmgr.BlockMeta) er.R {
+	b := dbtx.ReadWriteBucket(whookNamespaceKey)
+	if b == nil {
+		return nil
+	}
+	if hooks := b.NestedReadBucket(whookHooksKey); hooks == nil || walletdb.BucketIsEmpty(hooks) {
+		return nil
+	}
+	// Don't notify old transactions which are found by a rescan.
+	if block != nil {
+		if chainClient, err := w.requireChainClient(); err == nil {
+			if bs, err := chainClient.BestBlock(); err == nil &&
+				confirms(block.Height, bs.Height) > webhookTrackConfirms {
+				return nil
+			}
+		}
+	}
+	tracked := b.NestedReadWriteBucket(whookTrackedKey)
+	if tracked.Get(hash[:]) != nil {
+		return nil
+	}
+	return tracked.Put(hash[:], []byte{})
+}
+
+// webhookOutputs returns the outputs of a transaction which pay to the wallet.
+func (w *Wallet) webhookOutputs(addrmgrNs walletdb.ReadBucket, details *wtxmgr.TxDetails) []*rpc_pb.WalletWebhookOutput {
+	var out []*rpc_pb.WalletWebhookOutput
+	for _, c := range details.Credits {
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			details.MsgTx.TxOut[c.Index].PkScript, w.chainParams)
+		if err != nil || len(addrs) != 1 {
+			continue
+		}
+		o := &rpc_pb.WalletWebhookOutput{
+			Vout:        c.Index,
+			Address:     addrs[0].EncodeAddress(),
+			AmountUnits: uint64(c.Amount),
+		}
+		if _, acct, err := w.Manager.AddrAccount(addrmgrNs, addrs[0]); err == nil {
+			o.Account = acct
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
+// webhookMatch returns the outputs which match the filter of the webhook, and
+// whether the transaction should be notified at all.
+func webhookMatch(h *rpc_pb.WalletWebhook, outputs []*rpc_pb.WalletWebhookOutput) ([]*rpc_pb.WalletWebhookOutput, bool) {
+	if len(h.Addresses) == 0 && len(h.Accounts) == 0 {
+		return outputs, true
+	}
+	var out []*rpc_pb.WalletWebhookOutput
+	for _, o := range outputs {
+		addrOk := len(h.Addresses) == 0
+		for _, a := range h.Addresses {
+			addrOk = addrOk || a == o.Address
+		}
+		acctOk := len(h.Accounts) == 0
+		for _, a := range h.Accounts {
+			acctOk = acctOk || a == o.Account
+		}
+		if addrOk && acctOk {
+			out = append(out, o)
+		}
+	}
+	return out, len(out) > 0
+}
+
+// scanWebhooks checks each tracked transaction and queues the events which
+// are due for each webhook. The check only reads the db, it is written to when
+// an event is due or a transaction stops being tracked.
+func (w *Wallet) scanWebhooks(now time.Time) er.R {
+	height := w.Manager.SyncedTo().Height
+
+	type trackedTx struct {
+		hash chainhash.Hash
+		raw  []byte
+		sent map[string]byte
+	}
+	type trackedUpdate struct {
+		hash   chainhash.Hash
+		v      []byte
+		remove bool
+	}
+	var events []*rpc_pb.WalletWebhookEvent
+	var updates []trackedUpdate
+	if err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		b := dbtx.ReadBucket(whookNamespaceKey)
+		if b == nil {
+			return nil
+		}
+		hooks, err := loadWebhooks(b.NestedReadBucket(whookHooksKey))
+		if err != nil {
+			return err
+		}
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+		var txns []trackedTx
+		if err := b.NestedReadBucket(whookTrackedKey).ForEach(func(k, v []byte) er.R {
+			t := trackedTx{raw: append([]byte{}, v...), sent: make(map[string]byte)}
+			copy(t.hash[:], k)
+			for ; len(v) >= webhookIdLen+1; v = v[webhookIdLen+1:] {
+				t.sent[hex.EncodeToString(v[:webhookIdLen])] = v[webhookIdLen]
+			}
+			txns = append(txns, t)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, t := range txns {
+			details, err := w.TxStore.TxDetails(txmgrNs, &t.hash)
+			if err != nil {
+				return err
+			}
+			var outputs []*rpc_pb.WalletWebhookOutput
+			var debits btcutil.Amount
+			if details != nil {
+				outputs = w.webhookOutputs(addrmgrNs, details)
+				for _, d := range details.Debits {
+					debits += d.Amount
+				}
+			}
+			v := []byte{}
+			for _, h := range hooks {
+				flags := t.sent[h.Id]
+				event := ""
+				matched, ok := webhookMatch(h, outputs)
+				switch {
+				case details == nil:
+					// The transaction was removed by a reorg or double spend
+					if flags != 0 {
+						event = WebhookEventReorged
+						flags = 0
+					}
+				case !ok:
+				case details.Block.Height < 0:
+					if flags&webhookSentConfirmed != 0 {
+						event = WebhookEventReorged
+						flags &^= webhookSentConfirmed
+					} else if h.MinConf == 0 && flags&webhookSentUnconfirmed == 0 {
+						event = WebhookEventUnconfirmed
+						flags |= webhookSentUnconfirmed
+					}
+				default:
+					minConf := int32(h.MinConf)
+					if minConf < 1 {
+						minConf = 1
+					}
+					if flags&webhookSentConfirmed == 0 &&
+						confirms(details.Block.Height, height) >= minConf {
+						event = WebhookEventConfirmed
+						flags |= webhookSentConfirmed
+					}
+				}
+				if event != "" {
+					ev := &rpc_pb.WalletWebhookEvent{
+						WebhookId:   h.Id,
+						Event:       event,
+						Txid:        t.hash.String(),
+						BlockHeight: -1,
+						Outputs:     matched,
+						DebitUnits:  uint64(debits),
+					}
+					if details != nil && details.Block.Height >= 0 {
+						ev.BlockHeight = details.Block.Height
+						ev.BlockHash = details.Block.Hash.String()
+						ev.Confirmations = uint32(confirms(details.Block.Height, height))
+					}
+					events = append(events, ev)
+				}
+				if flags != 0 {
+					id, _ := hex.DecodeString(h.Id)
+					v = append(append(v, id...), flags)
+				}
+			}
+
+			if details == nil || (details.Block.Height >= 0 &&
+				confirms(details.Block.Height, height) > webhookTrackConfirms) {
+				updates = append(updates, trackedUpdate{hash: t.hash, remove: true})
+			} else if !bytes.Equal(v, t.raw) {
+				updates = append(updates, trackedUpdate{hash: t.hash, v: v})
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(events) == 0 && len(updates) == 0 {
+		return nil
+	}
+
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := webhookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if _, err := enqueueWebhookEvent(b, ev, now); err != nil {
+				return err
+			}
+		}
+		tracked := b.NestedReadWriteBucket(whookTrackedKey)
+		for _, u := range updates {
+			if u.remove {
+				err = tracked.Delete(u.hash[:])
+			} else {
+				err = tracked.Put(u.hash[:], u.v)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// postWebhook delivers one event, returning the HTTP status if there was a
+// response.
+func postWebhook(h *rpc_pb.WalletWebhook, ev *rpc_pb.WalletWebhookEvent) (int, er.R) {
+	body, errr := protojson.Marshal(ev)
+	if errr != nil {
+		return 0, er.E(errr)
+	}
+	secret, errr := hex.DecodeString(h.Secret)
+	if errr != nil {
+		return 0, er.E(errr)
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	req, errr := http.NewRequest("POST", h.Url, bytes.NewReader(body))
+	if errr != nil {
+		return 0, er.E(errr)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Pkt-Event", ev.Event)
+	req.Header.Set("X-Pkt-Delivery", ev.DeliveryId)
+	req.Header.Set("X-Pkt-Signature", hex.EncodeToString(mac.Sum(nil)))
+	resp, errr := webhookClient.Do(req)
+	if errr != nil {
+		return 0, er.E(errr)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, er.Errorf("webhook responded [%s]", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// deliverWebhooks attempts the deliveries which are due, at most webhookBatch
+// of them for each receiver. The receivers are posted to at the same time so
+// that one which doesn't respond doesn't delay the others, and a receiver is
+// not posted to again until its failed delivery is due to be retried.
+func (w *Wallet) deliverWebhooks(now time.Time) er.R {
+	todo := make(map[string][]webhookDue)
+	if err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) er.R {
+		b := dbtx.ReadBucket(whookNamespaceKey)
+		if b == nil {
+			return nil
+		}
+		hooks := b.NestedReadBucket(whookHooksKey)
+		logb := b.NestedReadBucket(whookLogKey)
+		backingOff := make(map[string]bool)
+		return b.NestedReadBucket(whookPendingKey).ForEach(func(k, _ []byte) er.R {
+			d := &rpc_pb.WalletWebhookDelivery{}
+			if err := proto.Unmarshal(logb.Get(k), d); err != nil {
+				return er.E(err)
+			}
+			t := webhookDue{seq: binary.BigEndian.Uint64(k), delivery: d}
+			if id, err := parseWebhookId(d.Event.WebhookId); err != nil {
+				return err
+			} else if v := hooks.Get(id); v != nil {
+				t.hook = &rpc_pb.WalletWebhook{}
+				if err := proto.Unmarshal(v, t.hook); err != nil {
+					return er.E(err)
+				}
+			}
+			// Deliveries to deleted webhooks are grouped under the empty url.
+			receiver := ""
+			if t.hook != nil {
+				receiver = t.hook.Url
+			}
+			// The pending deliveries are in the order they were queued, while
+			// one of them is waiting to be retried the later deliveries to the
+			// same receiver wait too.
+			if d.NextAttemptSec > now.Unix() {
+				if d.Attempts > 0 && t.hook != nil {
+					backingOff[receiver] = true
+				}
+				return nil
+			} else if backingOff[receiver] || len(todo[receiver]) >= webhookBatch {
+				return nil
+			}
+			todo[receiver] = append(todo[receiver], t)
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr er.R
+	for _, group := range todo {
+		wg.Add(1)
+		go func(group []webhookDue) {
+			defer wg.Done()
+			for _, t := range group {
+				if w.ShuttingDown() {
+					return
+				}
+				if err := w.deliverWebhook(t); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					return
+				} else if t.delivery.Status == WebhookStatusPending {
+					return
+				}
+			}
+		}(group)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+type webhookDue struct {
+	seq      uint64
+	delivery *rpc_pb.WalletWebhookDelivery
+	hook     *rpc_pb.WalletWebhook
+}
+
+// deliverWebhook makes one attempt at a delivery and stores the outcome.
+func (w *Wallet) deliverWebhook(t webhookDue) er.R {
+	d := t.delivery
+	if t.hook == nil {
+		d.Status = WebhookStatusFailed
+		d.LastError = "the webhook was deleted"
+	} else {
+		d.Attempts++
+		status, err := postWebhook(t.hook, d.Event)
+		d.LastHttpStatus = int32(status)
+		if err == nil {
+			d.Status = WebhookStatusDelivered
+			d.LastError = ""
+			d.DeliveredSec = time.Now().Unix()
+		} else {
+			log.Debugf("Delivery [%s] to webhook [%s] failed: %v",
+				d.Id, t.hook.Id, err)
+			d.LastError = err.Message()
+			if d.Attempts >= webhookMaxAttempts {
+				d.Status = WebhookStatusFailed
+				log.Warnf("Giving up on delivery [%s] to webhook [%s] after [%d] attempts",
+					d.Id, t.hook.Id, d.Attempts)
+			} else {
+				backoff := webhookRetryBase << (d.Attempts - 1)
+				if backoff > webhookRetryMax {
+					backoff = webhookRetryMax
+				}
+				d.NextAttemptSec = time.Now().Add(backoff).Unix()
+			}
+		}
+	}
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := webhookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		if err := putWebhookDelivery(b.NestedReadWriteBucket(whookLogKey), t.seq, d); err != nil {
+			return err
+		}
+		if d.Status != WebhookStatusPending {
+			return b.NestedReadWriteBucket(whookPendingKey).Delete(webhookLogKey(t.seq))
+		}
+		return nil
+	})
+}
+
+// webhookLoop runs for as long as the wallet is started, queueing and
+// delivering webhook events.
+func (w *Wallet) webhookLoop() {
+	defer w.wg.Done()
+	quit := w.quitChan()
+	t := time.NewTicker(webhookInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-t.C:
+		}
+		now := time.Now()
+		if err := w.scanWebhooks(now); err != nil {
+			log.Warnf("Unable to check transactions for webhooks: %v", err)
+		}
+		if err := w.deliverWebhooks(now); err != nil {
+			log.Warnf("Unable to deliver webhooks: %v", err)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/util"
+	"github.com/pkt-cash/pktd/generated/proto/rpc_pb"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/dbstructs"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// TestWebhook follows a payment through the unconfirmed, confirmed and
+// reorged events and checks that a failed delivery is retried.
+func TestWebhook(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	var mu sync.Mutex
+	var events []*rpc_pb.WalletWebhookEvent
+	var secret []byte
+	fail := true
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			fail = false
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(body)
+		if r.Header.Get("X-Pkt-Signature") != hex.EncodeToString(mac.Sum(nil)) {
+			t.Errorf("bad signature on event %s", body)
+		}
+		ev := &rpc_pb.WalletWebhookEvent{}
+		if err := protojson.Unmarshal(body, ev); err != nil {
+			t.Errorf("unable to decode event %s: %v", body, err)
+		}
+		events = append(events, ev)
+	}))
+	defer srv.Close()
+
+	h, err := w.CreateWebhook(&rpc_pb.WalletWebhook{Url: srv.URL, MinConf: 0})
+	util.RequireNoErr(t, err)
+	secret, _ = hex.DecodeString(h.Secret)
+	if list, err := w.Webhooks(); err != nil || len(list.Webhooks) != 1 || list.Webhooks[0].Secret != "" {
+		t.Fatalf("expected one webhook without a secret, got %v %v", list, err)
+	}
+
+	addr, err := w.CurrentAddress(0, waddrmgr.KeyScopeBIP0084)
+	util.RequireNoErr(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	util.RequireNoErr(t, err)
+	rec, err := wtxmgr.NewTxRecordFromMsgTx(&wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(1000000, pkScript)},
+	}, time.Now())
+	util.RequireNoErr(t, err)
+
+	const height = 500000
+	block := &wtxmgr.BlockMeta{
+		Block: dbstructs.Block{Hash: *testBlockHash, Height: height - 5},
+		Time:  time.Now(),
+	}
+	util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.Manager.SetSyncedTo(dbtx.ReadWriteBucket(waddrmgrNamespaceKey),
+			&waddrmgr.BlockStamp{Height: height, Hash: *testBlockHash})
+	}))
+
+	step := func(f func(dbtx walletdb.ReadWriteTx) er.R) {
+		if f != nil {
+			util.RequireNoErr(t, walletdb.Update(w.db, f))
+		}
+		now := time.Now()
+		util.RequireNoErr(t, w.scanWebhooks(now))
+		util.RequireNoErr(t, w.deliverWebhooks(now))
+	}
+	expect := func(names ...string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(events) != len(names) {
+			t.Fatalf("expected events %v, got %v", names, events)
+		}
+		for i, n := range names {
+			if events[i].Event != n || events[i].Txid != rec.Hash.String() {
+				t.Fatalf("expected event %d to be %s, got %v", i, n, events[i])
+			}
+		}
+	}
+
+	// The first delivery is refused, so it is retried later.
+	step(func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.addRelevantTx(dbtx, rec, nil)
+	})
+	expect()
+	d, err := w.WebhookDeliveries(&rpc_pb.WalletWebhookDeliveriesRequest{})
+	util.RequireNoErr(t, err)
+	if len(d.Deliveries) != 1 || d.Deliveries[0].Status != WebhookStatusPending ||
+		d.Deliveries[0].Attempts != 1 || d.Deliveries[0].LastHttpStatus != 503 {
+		t.Fatalf("expected a pending delivery after one attempt, got %v", d.Deliveries)
+	}
+	util.RequireNoErr(t, w.deliverWebhooks(time.Now().Add(webhookRetryBase)))
+	expect(WebhookEventUnconfirmed)
+	if ev := events[0]; len(ev.Outputs) != 1 || ev.Outputs[0].Address != addr.EncodeAddress() ||
+		ev.Outputs[0].AmountUnits != 1000000 || ev.BlockHeight != -1 {
+		t.Fatalf("unexpected unconfirmed event %v", ev)
+	}
+
+	step(func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.addRelevantTx(dbtx, rec, block)
+	})
+	expect(WebhookEventUnconfirmed, WebhookEventConfirmed)
+	if ev := events[1]; ev.BlockHeight != height-5 || ev.Confirmations != 6 {
+		t.Fatalf("unexpected confirmed event %v", ev)
+	}
+
+	// Nothing more is sent until the block is reorged out.
+	step(nil)
+	expect(WebhookEventUnconfirmed, WebhookEventConfirmed)
+	step(func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.TxStore.RollbackOne(dbtx.ReadWriteBucket(wtxmgrNamespaceKey), height-5)
+	})
+	expect(WebhookEventUnconfirmed, WebhookEventConfirmed, WebhookEventReorged)
+
+	d, err = w.WebhookDeliveries(&rpc_pb.WalletWebhookDeliveriesRequest{
+		WebhookId: h.Id,
+		Status:    WebhookStatusDelivered,
+	})
+	util.RequireNoErr(t, err)
+	if len(d.Deliveries) != 3 || d.Deliveries[0].Event.Event != WebhookEventReorged {
+		t.Fatalf("expected three deliveries, most recent first, got %v", d.Deliveries)
+	}
+
+	// A deleted webhook fails its pending deliveries.
+	_, err = w.TestWebhook(h.Id)
+	util.RequireNoErr(t, err)
+	util.RequireNoErr(t, w.DeleteWebhook(h.Id))
+	step(nil)
+	expect(WebhookEventUnconfirmed, WebhookEventConfirmed, WebhookEventReorged)
+	d, err = w.WebhookDeliveries(&rpc_pb.WalletWebhookDeliveriesRequest{Status: WebhookStatusFailed})
+	util.RequireNoErr(t, err)
+	if len(d.Deliveries) != 1 || d.Deliveries[0].Event.Event != WebhookEventTest {
+		t.Fatalf("expected the test event to fail, got %v", d.Deliveries)
+	}
+}
+
+// TestWebhookBackoff checks that while a delivery is waiting to be retried,
+// nothing else is posted to the same receiver.
+func TestWebhookBackoff(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	var mu sync.Mutex
+	posts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		posts++
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	h, err := w.CreateWebhook(&rpc_pb.WalletWebhook{Url: srv.URL})
+	util.RequireNoErr(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = w.TestWebhook(h.Id)
+		util.RequireNoErr(t, err)
+	}
+	now := time.Now()
+	util.RequireNoErr(t, w.deliverWebhooks(now))
+	util.RequireNoErr(t, w.deliverWebhooks(now.Add(time.Second)))
+	if posts != 1 {
+		t.Fatalf("expected one post before the retry is due, got %d", posts)
+	}
+	util.RequireNoErr(t, w.deliverWebhooks(now.Add(webhookRetryBase+time.Second)))
+	if posts != 2 {
+		t.Fatalf("expected only the failed delivery to be retried, got %d posts", posts)
+	}
+	d, err := w.WebhookDeliveries(&rpc_pb.WalletWebhookDeliveriesRequest{})
+	util.RequireNoErr(t, err)
+	if len(d.Deliveries) != 3 || d.Deliveries[2].Attempts != 2 ||
+		d.Deliveries[1].Attempts != 0 || d.Deliveries[0].Attempts != 0 {
+		t.Fatalf("expected the oldest delivery to be attempted twice, got %v", d.Deliveries)
+	}
+}
+
+// TestWebhookLogSize checks that the log is trimmed to webhookLogSize once
+// the deliveries are no longer pending.
+func TestWebhookLogSize(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	enqueue := func(n int) {
+		util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+			b, err := webhookBuckets(dbtx)
+			if err != nil {
+				return err
+			}
+			for i := 0; i < n; i++ {
+				ev := &rpc_pb.WalletWebhookEvent{Event: WebhookEventTest, BlockHeight: -1}
+				if _, err := enqueueWebhookEvent(b, ev, time.Now()); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+	}
+	enqueue(webhookLogSize + 100)
+
+	// Nothing is pending any more, so the next event trims the log.
+	util.RequireNoErr(t, walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		b, err := webhookBuckets(dbtx)
+		if err != nil {
+			return err
+		}
+		if err := b.DeleteNestedBucket(whookPendingKey); err != nil {
+			return err
+		}
+		_, err = b.CreateBucket(whookPendingKey)
+		return err
+	}))
+	enqueue(1)
+
+	d, err := w.WebhookDeliveries(&rpc_pb.WalletWebhookDeliveriesRequest{Limit: 2 * webhookLogSize})
+	util.RequireNoErr(t, err)
+	if len(d.Deliveries) != webhookLogSize {
+		t.Fatalf("expected %d deliveries in the log, got %d", webhookLogSize, len(d.Deliveries))
+	}
+	if d.Deliveries[webhookLogSize-1].Id != "102" {
+		t.Fatalf("expected the oldest delivery to be 102, got %s", d.Deliveries[webhookLogSize-1].Id)
+	}
+}
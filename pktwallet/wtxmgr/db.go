@@ -74,6 +74,8 @@ var (
 	bucketUnminedCredits = []byte("mc")
 	bucketUnminedInputs  = []byte("mi")
 	bucketLockedOutputs  = []byte("lo")
+	bucketReplacedTxs    = []byte("rp")
+	bucketNamedLocks     = []byte("nl")
 )
 
 // Root (namespace) bucket keys
@@ -1318,6 +1320,15 @@ func deleteBuckets(ns walletdb.ReadWriteBucket) er.R {
 		str := "failed to delete locked outputs bucket"
 		return storeError(ErrDatabase, str, err)
 	}
+	// The replaced transactions bucket is only created when first needed.
+	if ns.NestedReadWriteBucket(bucketReplacedTxs) != nil {
+		if err := ns.DeleteNestedBucket(bucketReplacedTxs); err != nil {
+			str := "failed to delete replaced transactions bucket"
+			return storeError(ErrDatabase, str, err)
+		}
+	}
+	// Named locks are kept because they were placed by the user and they
+	// remain valid when the transaction history is rebuilt.
 
 	return nil
 }
@@ -0,0 +1,127 @@
+package wtxmgr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/utilfun"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// Named locks are placed on outputs by the user to keep them out of coin
+// selection. Unlike leases, they are identified by a name which is shared by a
+// group of outputs and they need not expire. They are keyed by the canonical
+// outpoint and the value is serialized as such:
+//
+//   [0:8]  Unix time when the lock expires, 0 if it never expires (8 bytes)
+//   [8:]   Name of the lock
+
+// NamedLock is a lock on an output which was placed by the user.
+type NamedLock struct {
+	OutPoint wire.OutPoint
+	Name     string
+
+	// Zero if the lock never expires
+	Expiry time.Time
+}
+
+// Expired returns whether the lock has expired at the time now.
+func (l *NamedLock) Expired(now time.Time) bool {
+	return !l.Expiry.IsZero() && !now.Before(l.Expiry)
+}
+
+func serializeNamedLock(l *NamedLock) []byte {
+	v := make([]byte, 8+len(l.Name))
+	if !l.Expiry.IsZero() {
+		byteOrder.PutUint64(v, uint64(l.Expiry.Unix()))
+	}
+	copy(v[8:], l.Name)
+	return v
+}
+
+func deserializeNamedLock(k, v []byte, l *NamedLock) er.R {
+	if len(v) < 8 {
+		str := fmt.Sprintf("%s: short read (expected %d bytes, read %d)",
+			bucketNamedLocks, 8, len(v))
+		return storeError(ErrData, str, nil)
+	}
+	if err := utilfun.ReadCanonicalOutPoint(k, &l.OutPoint); err != nil {
+		return err
+	}
+	l.Expiry = time.Time{}
+	if exp := byteOrder.Uint64(v); exp != 0 {
+		l.Expiry = time.Unix(int64(exp), 0)
+	}
+	l.Name = string(v[8:])
+	return nil
+}
+
+// PutNamedLock stores a named lock, replacing any named lock which was already
+// placed on the same output.
+func (s *Store) PutNamedLock(ns walletdb.ReadWriteBucket, l *NamedLock) er.R {
+	locks, err := ns.CreateBucketIfNotExists(bucketNamedLocks)
+	if err != nil {
+		str := "failed to create named locks bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+	k := utilfun.CanonicalOutPoint(&l.OutPoint.Hash, l.OutPoint.Index)
+	if err := locks.Put(k, serializeNamedLock(l)); err != nil {
+		str := fmt.Sprintf("%s: put failed for %v", bucketNamedLocks,
+			l.OutPoint)
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// DeleteNamedLock removes the named lock from an output, if there is one.
+func (s *Store) DeleteNamedLock(ns walletdb.ReadWriteBucket, op wire.OutPoint) er.R {
+	locks := ns.NestedReadWriteBucket(bucketNamedLocks)
+	if locks == nil {
+		return nil
+	}
+	k := utilfun.CanonicalOutPoint(&op.Hash, op.Index)
+	if err := locks.Delete(k); err != nil {
+		str := fmt.Sprintf("%s: delete failed for %v", bucketNamedLocks, op)
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// NamedLocks returns all named locks, including the ones which have expired.
+func (s *Store) NamedLocks(ns walletdb.ReadBucket) ([]NamedLock, er.R) {
+	locks := ns.NestedReadBucket(bucketNamedLocks)
+	if locks == nil {
+		return nil, nil
+	}
+	var out []NamedLock
+	err := locks.ForEach(func(k, v []byte) er.R {
+		var l NamedLock
+		if err := deserializeNamedLock(k, v, &l); err != nil {
+			return err
+		}
+		out = append(out, l)
+		return nil
+	})
+	return out, err
+}
+
+// DeleteExpiredNamedLocks removes all named locks which have expired and
+// returns the ones which remain.
+func (s *Store) DeleteExpiredNamedLocks(ns walletdb.ReadWriteBucket) ([]NamedLock, er.R) {
+	locks, err := s.NamedLocks(ns)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
+	out := locks[:0]
+	for _, l := range locks {
+		if !l.Expired(now) {
+			out = append(out, l)
+		} else if err := s.DeleteNamedLock(ns, l.OutPoint); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
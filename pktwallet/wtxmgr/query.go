@@ -263,6 +263,26 @@ func (s *Store) UniqueTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash,
 	return s.minedTxDetails(ns, txHash, k, v)
 }
 
+// OutputSpenders returns the hashes of the transactions which spend an output
+// of the wallet, the mined spender if there is one and then any unmined ones.
+// An output which is not known or not spent has no spenders.
+func (s *Store) OutputSpenders(ns walletdb.ReadBucket, op *wire.OutPoint) []chainhash.Hash {
+	var spenders []chainhash.Hash
+	if k, _ := latestTxRecord(ns, &op.Hash); k != nil {
+		ck := make([]byte, 72)
+		copy(ck, k)
+		byteOrder.PutUint32(ck[68:72], op.Index)
+		v := existsRawCredit(ns, ck)
+		if len(v) >= 41 && v[8]&(1<<0) != 0 {
+			var spender chainhash.Hash
+			copy(spender[:], v[9:41])
+			spenders = append(spenders, spender)
+		}
+	}
+	k := utilfun.CanonicalOutPoint(&op.Hash, op.Index)
+	return append(spenders, fetchUnminedInputSpendTxHashes(ns, k)...)
+}
+
 // rangeUnminedTransactions executes the function f with TxDetails for every
 // unmined transaction.  f is not executed if no unmined transactions exist.
 // Error returns from f (if any) are propigated to the caller.  Returns true
@@ -0,0 +1,132 @@
+package wtxmgr
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/pktlog/log"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// When an unmined transaction is replaced by fee, the original transaction is
+// removed from the unmined set and kept in the replaced bucket so that it can
+// still be looked up.  Records are keyed by the hash of the original
+// transaction and the value is serialized as such:
+//
+//   [0:32]  Hash of the replacement transaction (32 bytes)
+//   [32:40] Received time of the original transaction (8 bytes)
+//   [40:]   Serialized original transaction
+
+// ReplacedTx is an unmined transaction which was replaced by another
+// transaction paying a higher fee.
+type ReplacedTx struct {
+	TxRecord
+	ReplacedBy chainhash.Hash
+}
+
+// ReplaceUnminedTx removes the unmined transaction rec, along with every
+// unmined transaction which spends from it, and records that it has been
+// replaced by the transaction with hash replacement.  The replacement should
+// already have been inserted into the store.
+func (s *Store) ReplaceUnminedTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
+	replacement *chainhash.Hash) er.R {
+
+	if rec.Hash == *replacement {
+		str := "a transaction cannot replace itself"
+		return storeError(ErrInput, str, nil)
+	}
+	if existsRawUnmined(ns, rec.Hash[:]) == nil {
+		str := fmt.Sprintf("transaction %v is not unmined", rec.Hash)
+		return storeError(ErrInput, str, nil)
+	}
+	if err := removeConflict(ns, rec); err != nil {
+		return err
+	}
+
+	replaced, err := ns.CreateBucketIfNotExists(bucketReplacedTxs)
+	if err != nil {
+		str := "failed to create replaced transactions bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+	recV, err := valueTxRecord(rec)
+	if err != nil {
+		return err
+	}
+	v := make([]byte, 32+len(recV))
+	copy(v, replacement[:])
+	copy(v[32:], recV)
+	if err := replaced.Put(rec.Hash[:], v); err != nil {
+		str := fmt.Sprintf("%s: put failed for %v", bucketReplacedTxs,
+			rec.Hash)
+		return storeError(ErrDatabase, str, err)
+	}
+
+	log.Infof("Transaction [%s] replaced by [%s]",
+		log.Txid(rec.Hash.String()), log.Txid(replacement.String()))
+	return nil
+}
+
+// ReplacedTx returns the record of a transaction which was replaced by fee,
+// or nil if the transaction was never replaced.
+func (s *Store) ReplacedTx(ns walletdb.ReadBucket, txHash *chainhash.Hash) (*ReplacedTx, er.R) {
+	replaced := ns.NestedReadBucket(bucketReplacedTxs)
+	if replaced == nil {
+		return nil, nil
+	}
+	v := replaced.Get(txHash[:])
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) < 32 {
+		str := fmt.Sprintf("%s: short read (expected %d bytes, read %d)",
+			bucketReplacedTxs, 32, len(v))
+		return nil, storeError(ErrData, str, nil)
+	}
+	var out ReplacedTx
+	copy(out.ReplacedBy[:], v[:32])
+	if err := readRawTxRecord(txHash, v[32:], &out.TxRecord); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Replaces returns the hashes of all transactions which were replaced by the
+// transaction with hash txHash.
+func (s *Store) Replaces(ns walletdb.ReadBucket, txHash *chainhash.Hash) ([]chainhash.Hash, er.R) {
+	replaced := ns.NestedReadBucket(bucketReplacedTxs)
+	if replaced == nil {
+		return nil, nil
+	}
+	var out []chainhash.Hash
+	err := replaced.ForEach(func(k, v []byte) er.R {
+		if len(k) != 32 || len(v) < 32 {
+			str := fmt.Sprintf("%s: bad record", bucketReplacedTxs)
+			return storeError(ErrData, str, nil)
+		}
+		if !bytes.Equal(v[:32], txHash[:]) {
+			return nil
+		}
+		var h chainhash.Hash
+		copy(h[:], k)
+		out = append(out, h)
+		return nil
+	})
+	return out, err
+}
+
+// forgetReplacement deletes the replacement record of a transaction, this is
+// needed if a replaced transaction ends up being mined after all.
+func forgetReplacement(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) er.R {
+	replaced := ns.NestedReadWriteBucket(bucketReplacedTxs)
+	if replaced == nil || replaced.Get(txHash[:]) == nil {
+		return nil
+	}
+	if err := replaced.Delete(txHash[:]); err != nil {
+		str := fmt.Sprintf("%s: delete failed for %v", bucketReplacedTxs,
+			txHash)
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package wtxmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr/dbstructs"
+)
+
+// TestReplaceUnminedTx ensures that replacing an unmined transaction removes
+// it from the unmined set while keeping a record of which transaction
+// replaced it, and that the record is forgotten if the original transaction is
+// mined after all.
+func TestReplaceUnminedTx(t *testing.T) {
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	b100 := &BlockMeta{
+		Block: dbstructs.Block{Height: 100},
+		Time:  time.Now(),
+	}
+	cb := newCoinBase(1e8)
+	cbRec, err := NewTxRecordFromMsgTx(cb, b100.Time)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, cbRec, b100); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.AddCredit(ns, cbRec, b100, 0, false); err != nil {
+			t.Fatal(err)
+		}
+	})
+	maturityHeight := b100.Block.Height +
+		int32(chaincfg.TestNet3Params.CoinbaseMaturity)
+
+	// The original pays 4e7 of change back to us, the replacement takes a
+	// higher fee from the change.
+	origRec, err := NewTxRecordFromMsgTx(
+		spendOutput(&cbRec.Hash, 0, 5e7, 4e7), time.Now(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replRec, err := NewTxRecordFromMsgTx(
+		spendOutput(&cbRec.Hash, 0, 5e7, 3e7), time.Now(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		for _, rec := range []*TxRecord{origRec, replRec} {
+			if err := store.InsertTx(ns, rec, nil); err != nil {
+				t.Fatal(err)
+			}
+			if err := store.AddCredit(ns, rec, nil, 1, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := store.ReplaceUnminedTx(ns, origRec, &replRec.Hash); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.ReplaceUnminedTx(ns, origRec, &replRec.Hash); !ErrInput.Is(err) {
+			t.Fatalf("expected ErrInput replacing twice, got %v", err)
+		}
+	})
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		details, err := store.TxDetails(ns, &origRec.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if details != nil {
+			t.Fatal("replaced transaction is still in the store")
+		}
+		hashes, err := store.UnminedTxHashes(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hashes) != 1 || *hashes[0] != replRec.Hash {
+			t.Fatalf("expected only %v unmined, got %v", replRec.Hash, hashes)
+		}
+		bal, err := store.Balance(ns, 0, maturityHeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bal != btcutil.Amount(3e7) {
+			t.Fatalf("expected balance of %d, got %d", int64(3e7), bal)
+		}
+
+		replaced, err := store.ReplacedTx(ns, &origRec.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replaced == nil {
+			t.Fatal("no record of the replaced transaction")
+		}
+		if replaced.ReplacedBy != replRec.Hash {
+			t.Fatalf("expected replaced by %v, got %v",
+				replRec.Hash, replaced.ReplacedBy)
+		}
+		if replaced.MsgTx.TxHash() != origRec.Hash {
+			t.Fatal("stored transaction does not match the original")
+		}
+		replaces, err := store.Replaces(ns, &replRec.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(replaces) != 1 || replaces[0] != origRec.Hash {
+			t.Fatalf("expected %v to replace %v, got %v",
+				replRec.Hash, origRec.Hash, replaces)
+		}
+	})
+
+	// If the original is mined anyway, the replacement is a double spend and
+	// the original is no longer considered replaced.
+	b101 := &BlockMeta{
+		Block: dbstructs.Block{Height: maturityHeight},
+		Time:  time.Now(),
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.InsertTx(ns, origRec, b101); err != nil {
+			t.Fatal(err)
+		}
+	})
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		replaced, err := store.ReplacedTx(ns, &origRec.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replaced != nil {
+			t.Fatal("mined transaction is still recorded as replaced")
+		}
+		hashes, err := store.UnminedTxHashes(ns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hashes) != 0 {
+			t.Fatalf("expected no unmined transactions, got %v", hashes)
+		}
+	})
+}
@@ -420,12 +420,21 @@ func (s *Store) insertMinedTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
 		return err
 	}
 
+	// If this transaction was replaced by fee but got mined anyway, it is
+	// no longer replaced.
+	if err := forgetReplacement(ns, &rec.Hash); err != nil {
+		return err
+	}
+
 	// Clear any locked outputs since we now have a confirmed spend for
 	// them, making them not eligible for coin selection anyway.
 	for _, txIn := range rec.MsgTx.TxIn {
 		if err := unlockOutput(ns, txIn.PreviousOutPoint); err != nil {
 			return err
 		}
+		if err := s.DeleteNamedLock(ns, txIn.PreviousOutPoint); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -967,6 +976,15 @@ func PutTxLabel(labelBucket walletdb.ReadWriteBucket, txid chainhash.Hash,
 	return labelBucket.Put(txid[:], buf.Bytes())
 }
 
+// DeleteTxLabel removes the label of a transaction, if it has one.
+func DeleteTxLabel(ns walletdb.ReadWriteBucket, txid chainhash.Hash) er.R {
+	labelBucket := ns.NestedReadWriteBucket(bucketTxLabels)
+	if labelBucket == nil {
+		return nil
+	}
+	return labelBucket.Delete(txid[:])
+}
+
 // FetchTxLabel reads a transaction label from the tx labels bucket. If a label
 // with 0 length was written, we return an error, since this is unexpected.
 func FetchTxLabel(ns walletdb.ReadBucket, txid chainhash.Hash) (string, er.R) {
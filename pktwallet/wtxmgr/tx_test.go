@@ -2623,9 +2623,16 @@ func TestOutputLocks(t *testing.T) {
 			// database doesn't store stale data.
 			name: "clear locked outputs after confirmed spend",
 			run: func(t *testing.T, s *Store, ns walletdb.ReadWriteBucket) {
-				// Lock an output.
+				// Lock an output, with a lease and a named lock.
 				lockID := LockID{1}
 				lock(t, s, ns, lockID, confirmedOutPoint, nil)
+				err := s.PutNamedLock(ns, &NamedLock{
+					OutPoint: confirmedOutPoint,
+					Name:     "batch",
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
 
 				// Create a spend and add it to the store as
 				// confirmed.
@@ -2647,6 +2654,14 @@ func TestOutputLocks(t *testing.T) {
 					t, ns, confirmedOutPoint, s.clock.Now(),
 					false,
 				)
+				locks, err := s.NamedLocks(ns)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(locks) != 0 {
+					t.Fatalf("expected the named lock to be "+
+						"removed, got %v", locks)
+				}
 			},
 		},
 		{
@@ -12,6 +12,11 @@ const (
 	// of a transaction input can be.
 	MaxTxInSequenceNum uint32 = 0xffffffff
 
+	// MaxRBFSequence is the maximum sequence number an input can use to
+	// signal that the transaction spending it can be replaced using the
+	// Replace-By-Fee (RBF) policy.
+	MaxRBFSequence uint32 = 0xfffffffd
+
 	// MaxPrevOutIndex is the maximum index the index field of a previous
 	// outpoint can be.
 	MaxPrevOutIndex uint32 = 0xffffffff